@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mindhit/api/internal/infrastructure/ai"
+)
+
+// Check scores the output of a replayed request from 0 (worst) to 1 (best).
+// New checks are added to checks.
+type Check interface {
+	// Score scores output, the response to req. reference is the logged
+	// response to req, empty when the logged response itself is scored. ok
+	// is false when the check doesn't apply.
+	Score(req ai.ChatRequest, output, reference string) (score float64, ok bool)
+}
+
+// checks are the available checks by name.
+var checks = map[string]Check{
+	"schema":   schemaCheck{},
+	"keywords": keywordCheck{},
+	"url_ids":  urlIDCheck{},
+	"length":   lengthCheck{},
+}
+
+// defaultChecks are run when no checks are named, in report order.
+const defaultChecks = "schema,keywords,url_ids,length"
+
+// parseChecks returns the names of the checks in a comma-separated list.
+func parseChecks(list string) ([]string, error) {
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := checks[name]; !ok {
+			return nil, fmt.Errorf("unknown check %q", name)
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no checks")
+	}
+	return names, nil
+}
+
+// schemaCheck scores 1 when the output matches the output schema of the
+// request's prompt template, and 0 otherwise.
+type schemaCheck struct{}
+
+func (schemaCheck) Score(req ai.ChatRequest, output, _ string) (float64, bool) {
+	if req.Schema == nil {
+		return 0, false
+	}
+	if len(req.Schema.Validate(output)) > 0 {
+		return 0, true
+	}
+	return 1, true
+}
+
+// keywordCheck scores the overlap (Jaccard index) of the keywords and
+// entities of the output with those of the logged response.
+type keywordCheck struct{}
+
+func (keywordCheck) Score(_ ai.ChatRequest, output, reference string) (float64, bool) {
+	if reference == "" {
+		return 0, false
+	}
+	want := jsonKeywords(reference)
+	if len(want) == 0 {
+		return 0, false
+	}
+	got := jsonKeywords(output)
+
+	shared := 0
+	for k := range got {
+		if want[k] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(want)+len(got)-shared), true
+}
+
+// keywordKeys are the JSON keys of keyword lists in the outputs of the
+// prompt templates.
+var keywordKeys = map[string]bool{"keywords": true, "entities": true}
+
+// jsonKeywords returns the lowercased strings of every keyword list in a
+// JSON document, at any depth.
+func jsonKeywords(content string) map[string]bool {
+	var doc any
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return nil
+	}
+
+	keywords := make(map[string]bool)
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for key, value := range v {
+				if list, ok := value.([]any); ok && keywordKeys[key] {
+					for _, item := range list {
+						if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+							keywords[strings.ToLower(strings.TrimSpace(s))] = true
+						}
+					}
+					continue
+				}
+				walk(value)
+			}
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(doc)
+	return keywords
+}
+
+var uuidPattern = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+// urlIDCheck scores the share of the IDs in the prompt, such as the url_id
+// of every page of a mindmap, that the output refers to.
+type urlIDCheck struct{}
+
+func (urlIDCheck) Score(req ai.ChatRequest, output, _ string) (float64, bool) {
+	want := make(map[string]bool)
+	for _, id := range uuidPattern.FindAllString(req.UserPrompt, -1) {
+		want[strings.ToLower(id)] = true
+	}
+	if len(want) == 0 {
+		return 0, false
+	}
+
+	covered := make(map[string]bool)
+	for _, id := range uuidPattern.FindAllString(output, -1) {
+		if id = strings.ToLower(id); want[id] {
+			covered[id] = true
+		}
+	}
+	return float64(len(covered)) / float64(len(want)), true
+}
+
+// lengthCheck scores how close the length of the output is to that of the
+// logged response, as the ratio of the shorter to the longer.
+type lengthCheck struct{}
+
+func (lengthCheck) Score(_ ai.ChatRequest, output, reference string) (float64, bool) {
+	if reference == "" {
+		return 0, false
+	}
+	got, want := utf8.RuneCountInString(output), utf8.RuneCountInString(reference)
+	return float64(min(got, want)) / float64(max(got, want)), true
+}
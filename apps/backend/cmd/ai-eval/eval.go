@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/service"
+)
+
+// candidate is a provider and model a task could be configured with. Without
+// a model the provider's default model is used.
+type candidate struct {
+	provider ai.ProviderType
+	model    string
+}
+
+// parseCandidate parses provider[:model], e.g. "gemini:gemini-2.0-flash" or
+// "openai_compatible:llama3.1:8b".
+func parseCandidate(s string) (candidate, error) {
+	provider, model, _ := strings.Cut(strings.TrimSpace(s), ":")
+	if !ai.IsKnownProvider(provider) {
+		return candidate{}, fmt.Errorf("unknown ai provider %q", provider)
+	}
+	return candidate{provider: ai.ProviderType(provider), model: model}, nil
+}
+
+func (c candidate) String() string {
+	if c.model == "" {
+		return string(c.provider)
+	}
+	return string(c.provider) + ":" + c.model
+}
+
+// candidateList is a repeatable -candidate flag.
+type candidateList []candidate
+
+func (l *candidateList) String() string {
+	names := make([]string, len(*l))
+	for i, c := range *l {
+		names[i] = c.String()
+	}
+	return strings.Join(names, ",")
+}
+
+func (l *candidateList) Set(s string) error {
+	c, err := parseCandidate(s)
+	if err != nil {
+		return err
+	}
+	*l = append(*l, c)
+	return nil
+}
+
+// staticConfig serves one AI config for every task.
+type staticConfig struct {
+	cfg *ent.AIConfig
+}
+
+func (s staticConfig) GetConfigForTask(context.Context, string) (*ent.AIConfig, error) {
+	return s.cfg, nil
+}
+
+// candidateConfig returns the task's config with the candidate's provider and
+// model. Fallbacks and the response cache are left out, so every response
+// comes from the candidate.
+func candidateConfig(base *ent.AIConfig, c candidate) *ent.AIConfig {
+	cfg := *base
+	cfg.Provider = string(c.provider)
+	cfg.Model = c.model
+	cfg.FallbackProviders = nil
+	cfg.CacheTTLSeconds = 0
+	return &cfg
+}
+
+// result is the outcome of one sample, for a candidate or as logged.
+type result struct {
+	LogID          uuid.UUID          `json:"ai_log_id"`
+	Model          string             `json:"model,omitempty"`
+	Error          string             `json:"error,omitempty"`
+	LatencyMs      int64              `json:"latency_ms"`
+	InputTokens    int                `json:"input_tokens"`
+	OutputTokens   int                `json:"output_tokens"`
+	ThinkingTokens int                `json:"thinking_tokens"`
+	CostMicroCents *int64             `json:"cost_micro_cents,omitempty"`
+	Scores         map[string]float64 `json:"scores,omitempty"`
+}
+
+// evaluator replays samples and scores the responses.
+type evaluator struct {
+	task    ai.TaskType
+	checks  []string
+	pricing *service.ModelPricingService // optional, costs are unset without it
+	timeout time.Duration                // per request
+}
+
+// score runs the checks on output. reference is the logged response, empty
+// when output is the logged response.
+func (e *evaluator) score(req ai.ChatRequest, output, reference string) map[string]float64 {
+	scores := make(map[string]float64, len(e.checks))
+	for _, name := range e.checks {
+		if score, ok := checks[name].Score(req, output, reference); ok {
+			scores[name] = score
+		}
+	}
+	return scores
+}
+
+// logged scores the logged responses of the samples, the baseline the
+// candidates are compared with.
+func (e *evaluator) logged(samples []sample) []result {
+	results := make([]result, 0, len(samples))
+	for _, s := range samples {
+		results = append(results, result{
+			LogID:          s.log.ID,
+			Model:          s.log.Provider + ":" + s.log.Model,
+			LatencyMs:      s.log.LatencyMs,
+			InputTokens:    s.log.InputTokens,
+			OutputTokens:   s.log.OutputTokens,
+			ThinkingTokens: s.log.ThinkingTokens,
+			CostMicroCents: s.log.CostMicroCents,
+			Scores:         e.score(s.request, s.log.Content, ""),
+		})
+	}
+	return results
+}
+
+// replay sends the samples to the provider manager of a candidate, one at a
+// time, and scores the responses against the logged ones.
+func (e *evaluator) replay(ctx context.Context, pm *ai.ProviderManager, name string, samples []sample) []result {
+	results := make([]result, 0, len(samples))
+	for i, s := range samples {
+		reqCtx, cancel := context.WithTimeout(ctx, e.timeout)
+		resp, err := pm.Chat(reqCtx, e.task, s.request)
+		cancel()
+
+		r := result{LogID: s.log.ID}
+		if err != nil {
+			slog.Warn("replayed request failed", "candidate", name, "ai_log_id", s.log.ID, "error", err)
+			r.Error = err.Error()
+			results = append(results, r)
+			continue
+		}
+
+		r.Model = string(resp.Provider) + ":" + resp.Model
+		r.LatencyMs = resp.LatencyMs
+		r.InputTokens = resp.InputTokens
+		r.OutputTokens = resp.OutputTokens
+		r.ThinkingTokens = resp.ThinkingTokens
+		if e.pricing != nil {
+			r.CostMicroCents, err = e.pricing.Cost(ctx, string(resp.Provider), resp.Model, time.Now(), service.TokenUsage{
+				Input:       resp.InputTokens,
+				CachedInput: resp.CachedInputTokens,
+				Output:      resp.OutputTokens,
+				Thinking:    resp.ThinkingTokens,
+			})
+			if err != nil {
+				slog.Warn("failed to price replayed request", "candidate", name, "error", err)
+			}
+		}
+		r.Scores = e.score(s.request, resp.Content, s.log.Content)
+		results = append(results, r)
+
+		slog.Info("replayed request", "candidate", name, "sample", i+1, "of", len(samples))
+	}
+	return results
+}
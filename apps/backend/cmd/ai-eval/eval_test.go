@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/config"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+)
+
+func TestParseCandidate(t *testing.T) {
+	c, err := parseCandidate("openai_compatible:llama3.1:8b")
+	require.NoError(t, err)
+	assert.Equal(t, candidate{provider: ai.ProviderOpenAICompatible, model: "llama3.1:8b"}, c)
+	assert.Equal(t, "openai_compatible:llama3.1:8b", c.String())
+
+	c, err = parseCandidate("fake")
+	require.NoError(t, err)
+	assert.Equal(t, "fake", c.String())
+
+	_, err = parseCandidate("acme:model")
+	assert.Error(t, err)
+}
+
+func TestParseChecks(t *testing.T) {
+	names, err := parseChecks(defaultChecks)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"schema", "keywords", "url_ids", "length"}, names)
+
+	_, err = parseChecks("schema,vibes")
+	assert.Error(t, err)
+	_, err = parseChecks(" , ")
+	assert.Error(t, err)
+}
+
+func TestChecks(t *testing.T) {
+	schema, err := ai.NewOutputSchema(prompt.TagExtraction, prompt.Embedded().Schema(prompt.TagExtraction))
+	require.NoError(t, err)
+	req := ai.ChatRequest{
+		UserPrompt: "- ID: 2f0f4a4e-8a8a-4b8e-9a52-4b1f1c1d1e1f\n- ID: 7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		Schema:     schema,
+	}
+	reference := `{"keywords": ["Go", "generics", "types"], "summary": "About Go generics."}`
+
+	tests := []struct {
+		check    string
+		output   string
+		ref      string
+		expected float64
+		ok       bool
+	}{
+		{"schema", `{"keywords": ["go"], "summary": "Go."}`, "", 1, true},
+		{"schema", `{"keywords": [], "summary": "Go."}`, "", 0, true},
+		{"keywords", `{"keywords": ["go", "Generics", "interfaces"], "summary": "Go."}`, reference, 0.5, true},
+		{"keywords", `not json`, reference, 0, true},
+		{"keywords", reference, "", 0, false},
+		{"url_ids", `{"url_id": "2F0F4A4E-8A8A-4B8E-9A52-4B1F1C1D1E1F"}`, "", 0.5, true},
+		{"length", "abcd", "가나", 0.5, true},
+		{"length", "abcd", "", 0, false},
+	}
+	for _, tt := range tests {
+		score, ok := checks[tt.check].Score(req, tt.output, tt.ref)
+		assert.Equal(t, tt.ok, ok, "%s of %s", tt.check, tt.output)
+		assert.InDelta(t, tt.expected, score, 1e-9, "%s of %s", tt.check, tt.output)
+	}
+
+	_, ok := checks["schema"].Score(ai.ChatRequest{}, "{}", "")
+	assert.False(t, ok, "requests without a schema")
+	_, ok = checks["url_ids"].Score(ai.ChatRequest{UserPrompt: "no ids"}, "{}", "")
+	assert.False(t, ok, "prompts without ids")
+}
+
+func TestEvaluator_ReplayWithFakeProvider(t *testing.T) {
+	ctx := context.Background()
+	base := &ent.AIConfig{
+		TaskType:          string(ai.TaskTagExtraction),
+		Provider:          string(ai.ProviderGemini),
+		FallbackProviders: []string{string(ai.ProviderOpenAI)},
+		Temperature:       0.3,
+		MaxTokens:         1024,
+		JSONMode:          true,
+	}
+	pm, err := candidateManager(ctx, &config.Config{}, candidateConfig(base, candidate{provider: ai.ProviderFake}))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = pm.Close() })
+
+	log := &ent.AILog{
+		ID:          uuid.New(),
+		UserPrompt:  "Page title: Go generics tutorial",
+		Content:     `{"keywords": ["go", "generics"], "summary": "About Go generics."}`,
+		LatencyMs:   800,
+		InputTokens: 100,
+		Metadata:    map[string]any{"prompt_name": prompt.TagExtraction},
+		CreatedAt:   time.Now(),
+	}
+	req, err := replayRequest(log, prompt.Embedded())
+	require.NoError(t, err)
+	require.NotNil(t, req.Schema)
+	samples := []sample{{log: log, request: req}}
+
+	e := &evaluator{task: ai.TaskTagExtraction, checks: []string{"schema", "keywords", "length"}, timeout: time.Minute}
+	logged := newConfigRun(loggedRun, e.logged(samples))
+	replayed := newConfigRun("fake", e.replay(ctx, pm, "fake", samples))
+
+	assert.Equal(t, 0, replayed.Summary.Errors)
+	assert.Equal(t, 1.0, replayed.Summary.Scores["schema"].Mean)
+	assert.InDelta(t, 2.0/3, replayed.Summary.Scores["keywords"].Mean, 1e-9, "go and generics of go, generics and tutorial")
+	assert.Nil(t, replayed.Summary.CostMicroCents, "costs are unset without pricing")
+	assert.NotContains(t, logged.Summary.Scores, "keywords", "the logged response is the reference")
+	assert.Equal(t, int64(800), logged.Summary.AvgLatencyMs)
+
+	var out bytes.Buffer
+	require.NoError(t, writeMarkdown(&out, report{
+		Task:   ai.TaskTagExtraction,
+		Checks: e.checks,
+		Runs:   []configRun{logged, replayed},
+	}))
+	assert.Contains(t, out.String(), "| fake | 1.00 (1) | 0.67 (1) |")
+	assert.Contains(t, out.String(), "| logged | 1.00 (1) | - | - |")
+}
@@ -0,0 +1,189 @@
+// Package main replays logged AI requests against candidate provider and
+// model configs, and reports how their responses compare, to back changes
+// of the AI config of a task with data.
+//
+// Usage:
+//
+//	go run ./cmd/ai-eval -task tag_extraction -n 50 \
+//		-candidate gemini:gemini-2.0-flash \
+//		-candidate openai_compatible:llama3.1:8b \
+//		-out report.md
+//
+// Requests are sampled from ai_logs, then sent to each candidate with the
+// task's current config otherwise. Replays are not logged. The responses are
+// scored by the checks in check.go and summarized next to the logged
+// responses.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/lib/pq"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/config"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+	"github.com/mindhit/api/internal/service"
+)
+
+func main() {
+	if err := run(); err != nil {
+		slog.Error("ai eval error", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var candidates candidateList
+	task := flag.String("task", "", "task type of the requests: tag_extraction, summarize, mindmap")
+	promptName := flag.String("prompt", "", "only requests of this prompt template, e.g. mindmap_merge")
+	size := flag.Int("n", 20, "number of requests to sample")
+	since := flag.Duration("since", 30*24*time.Hour, "sample requests logged within this duration")
+	seed := flag.Uint64("seed", 1, "random seed, the same seed samples the same requests")
+	checkList := flag.String("checks", defaultChecks, "comma-separated checks to score responses with")
+	timeout := flag.Duration("timeout", 2*time.Minute, "timeout of each replayed request")
+	format := flag.String("format", "markdown", "report format: markdown or json")
+	out := flag.String("out", "", "report file, stdout when empty")
+	flag.Var(&candidates, "candidate", "provider[:model] to replay the requests with, repeatable")
+	flag.Parse()
+
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	switch ai.TaskType(*task) {
+	case ai.TaskTagExtraction, ai.TaskSummarize, ai.TaskMindmap, ai.TaskGeneral:
+	default:
+		return fmt.Errorf("unknown task %q", *task)
+	}
+	if len(candidates) == 0 {
+		return fmt.Errorf("no candidates, use -candidate provider[:model]")
+	}
+	if *format != "markdown" && *format != "json" {
+		return fmt.Errorf("unknown report format %q", *format)
+	}
+	checkNames, err := parseChecks(*checkList)
+	if err != nil {
+		return err
+	}
+
+	cfg := config.Load()
+
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+
+	baseConfig, err := service.NewAIConfigService(client).GetConfigForTask(ctx, *task)
+	if err != nil {
+		return fmt.Errorf("failed to get ai config of task %s: %w", *task, err)
+	}
+
+	opts := sampleOptions{
+		task:   ai.TaskType(*task),
+		prompt: *promptName,
+		since:  time.Now().Add(-*since),
+		size:   *size,
+		seed:   *seed,
+	}
+	samples, err := sampleLogs(ctx, client, prompt.Embedded(), opts)
+	if err != nil {
+		return err
+	}
+	if len(samples) == 0 {
+		return fmt.Errorf("no logged %s requests to replay", *task)
+	}
+	slog.Info("sampled logged requests", "task", *task, "samples", len(samples))
+
+	e := &evaluator{
+		task:    opts.task,
+		checks:  checkNames,
+		pricing: service.NewModelPricingService(client),
+		timeout: *timeout,
+	}
+
+	rep := report{
+		Task:        opts.task,
+		Prompt:      opts.prompt,
+		Since:       opts.since,
+		GeneratedAt: time.Now(),
+		Samples:     sampleIDs(samples),
+		Checks:      checkNames,
+		Runs:        []configRun{newConfigRun(loggedRun, e.logged(samples))},
+	}
+	for _, c := range candidates {
+		pm, err := candidateManager(ctx, cfg, candidateConfig(baseConfig, c))
+		if err != nil {
+			return fmt.Errorf("candidate %s: %w", c, err)
+		}
+		results := e.replay(ctx, pm, c.String(), samples)
+		if err := pm.Close(); err != nil {
+			slog.Warn("failed to close ai manager", "candidate", c, "error", err)
+		}
+		rep.Runs = append(rep.Runs, newConfigRun(c.String(), results))
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed to create report: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		w = f
+	}
+	if *format == "json" {
+		return writeJSON(w, rep)
+	}
+	return writeMarkdown(w, rep)
+}
+
+// candidateManager creates a provider manager serving a candidate config for
+// every task. It has no log, cache or rate limiter, and its circuit breakers
+// never open, so every sample is sent and failures show up as errors.
+func candidateManager(ctx context.Context, cfg *config.Config, candidateCfg *ent.AIConfig) (*ai.ProviderManager, error) {
+	aiCfg := ai.Config{
+		OpenAIAPIKey: cfg.AI.OpenAIAPIKey,
+		GeminiAPIKey: cfg.AI.GeminiAPIKey,
+		ClaudeAPIKey: cfg.AI.ClaudeAPIKey,
+		OpenAICompatible: ai.OpenAICompatibleConfig{
+			BaseURL:  cfg.AI.OpenAICompatibleBaseURL,
+			APIKey:   cfg.AI.OpenAICompatibleAPIKey,
+			Model:    cfg.AI.OpenAICompatibleModel,
+			JSONMode: ai.ParseCapability(cfg.AI.OpenAICompatibleJSONMode),
+			Thinking: ai.ParseCapability(cfg.AI.OpenAICompatibleThinking),
+		},
+		Fake: ai.FakeConfig{
+			Enabled:    cfg.AI.FakeEnabled || candidateCfg.Provider == string(ai.ProviderFake),
+			ScriptPath: cfg.AI.FakeScriptPath,
+		},
+		Breaker: ai.BreakerConfig{
+			MinRequests: math.MaxInt,
+			SlowCall:    24 * time.Hour,
+		},
+	}
+
+	pm, err := ai.NewProviderManager(ctx, aiCfg, staticConfig{cfg: candidateCfg}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(pm.GetAvailableProviders(), ai.ProviderType(candidateCfg.Provider)) {
+		_ = pm.Close()
+		return nil, fmt.Errorf("ai provider %s is not configured", candidateCfg.Provider)
+	}
+	return pm, nil
+}
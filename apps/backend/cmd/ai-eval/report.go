@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/internal/infrastructure/ai"
+)
+
+// loggedRun is the name of the run of the logged responses.
+const loggedRun = "logged"
+
+// report compares the candidates of a task on the same samples.
+type report struct {
+	Task        ai.TaskType `json:"task"`
+	Prompt      string      `json:"prompt,omitempty"`
+	Since       time.Time   `json:"since"`
+	GeneratedAt time.Time   `json:"generated_at"`
+	Samples     []uuid.UUID `json:"samples"`
+	Checks      []string    `json:"checks"`
+	Runs        []configRun `json:"runs"`
+}
+
+// configRun holds the results of the samples for one candidate, or as logged.
+type configRun struct {
+	Name    string   `json:"name"`
+	Summary summary  `json:"summary"`
+	Results []result `json:"results"`
+}
+
+// summary aggregates the results of a run.
+type summary struct {
+	Requests       int   `json:"requests"`
+	Errors         int   `json:"errors"`
+	AvgLatencyMs   int64 `json:"avg_latency_ms"`
+	InputTokens    int   `json:"input_tokens"`
+	OutputTokens   int   `json:"output_tokens"`
+	ThinkingTokens int   `json:"thinking_tokens"`
+	// CostMicroCents is unset when a response has no price.
+	CostMicroCents *int64                   `json:"cost_micro_cents,omitempty"`
+	Scores         map[string]*checkSummary `json:"scores"`
+}
+
+// checkSummary is the mean score of a check over the samples it applies to.
+type checkSummary struct {
+	Mean    float64 `json:"mean"`
+	Samples int     `json:"samples"`
+}
+
+// newConfigRun summarizes results.
+func newConfigRun(name string, results []result) configRun {
+	s := summary{
+		Requests:       len(results),
+		CostMicroCents: new(int64),
+		Scores:         make(map[string]*checkSummary),
+	}
+	var latency int64
+	for _, r := range results {
+		if r.Error != "" {
+			s.Errors++
+			continue
+		}
+		latency += r.LatencyMs
+		s.InputTokens += r.InputTokens
+		s.OutputTokens += r.OutputTokens
+		s.ThinkingTokens += r.ThinkingTokens
+		if r.CostMicroCents == nil {
+			s.CostMicroCents = nil
+		} else if s.CostMicroCents != nil {
+			*s.CostMicroCents += *r.CostMicroCents
+		}
+		for name, score := range r.Scores {
+			cs := s.Scores[name]
+			if cs == nil {
+				cs = &checkSummary{}
+				s.Scores[name] = cs
+			}
+			cs.Mean += score
+			cs.Samples++
+		}
+	}
+	if ok := s.Requests - s.Errors; ok > 0 {
+		s.AvgLatencyMs = latency / int64(ok)
+	}
+	for _, cs := range s.Scores {
+		cs.Mean /= float64(cs.Samples)
+	}
+	return configRun{Name: name, Summary: s, Results: results}
+}
+
+// writeJSON writes the report with every result.
+func writeJSON(w io.Writer, r report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// writeMarkdown writes the summaries of the report as tables, and the
+// errors of the candidates.
+func writeMarkdown(w io.Writer, r report) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# AI evaluation: %s\n\n", r.Task)
+	fmt.Fprintf(&b, "%d requests logged since %s", len(r.Samples), r.Since.Format(time.DateOnly))
+	if r.Prompt != "" {
+		fmt.Fprintf(&b, " from the %s prompt", r.Prompt)
+	}
+	fmt.Fprintf(&b, ", replayed %s.\n\n", r.GeneratedAt.Format(time.DateTime))
+
+	b.WriteString("| Config | Errors | Avg latency | Input tokens | Output tokens | Thinking tokens | Cost (cents) |\n")
+	b.WriteString("|---|---|---|---|---|---|---|\n")
+	for _, run := range r.Runs {
+		s := run.Summary
+		cost := "-"
+		if s.CostMicroCents != nil {
+			cost = fmt.Sprintf("%.4f", float64(*s.CostMicroCents)/1e6)
+		}
+		fmt.Fprintf(&b, "| %s | %d/%d | %d ms | %d | %d | %d | %s |\n",
+			run.Name, s.Errors, s.Requests, s.AvgLatencyMs, s.InputTokens, s.OutputTokens, s.ThinkingTokens, cost)
+	}
+
+	b.WriteString("\n| Config |")
+	for _, name := range r.Checks {
+		fmt.Fprintf(&b, " %s |", name)
+	}
+	b.WriteString("\n|---|" + strings.Repeat("---|", len(r.Checks)) + "\n")
+	for _, run := range r.Runs {
+		fmt.Fprintf(&b, "| %s |", run.Name)
+		for _, name := range r.Checks {
+			if cs := run.Summary.Scores[name]; cs != nil {
+				fmt.Fprintf(&b, " %.2f (%d) |", cs.Mean, cs.Samples)
+			} else {
+				b.WriteString(" - |")
+			}
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\nScores are means from 0 to 1, over the number of samples a check applies to in parentheses. " +
+		"keywords and length compare with the logged response, so they don't apply to it.\n")
+
+	for _, run := range r.Runs {
+		if run.Summary.Errors == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## Errors of %s\n\n", run.Name)
+		for _, res := range run.Results {
+			if res.Error != "" {
+				fmt.Fprintf(&b, "- `%s`: %s\n", res.LogID, res.Error)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
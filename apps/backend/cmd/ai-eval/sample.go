@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/ailog"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+)
+
+// sampleOptions selects the logged requests to replay.
+type sampleOptions struct {
+	task   ai.TaskType
+	prompt string // prompt template name, any when empty
+	since  time.Time
+	size   int
+	seed   uint64
+}
+
+// sample is a logged request, rebuilt to be replayed.
+type sample struct {
+	log     *ent.AILog
+	request ai.ChatRequest
+}
+
+// sampleLogs picks up to size successful requests of the task at random,
+// leaving out cache hits which carry no response of their own. The same
+// seed picks the same requests.
+func sampleLogs(ctx context.Context, client *ent.Client, prompts *prompt.Registry, opts sampleOptions) ([]sample, error) {
+	where := []predicate.AILog{
+		ailog.TaskTypeEQ(string(opts.task)),
+		ailog.StatusEQ(ailog.StatusSuccess),
+		ailog.CachedEQ(false),
+		ailog.UserPromptNotNil(),
+		ailog.UserPromptNEQ(""),
+		ailog.CreatedAtGTE(opts.since),
+	}
+	if opts.prompt != "" {
+		where = append(where, func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(ailog.FieldMetadata, opts.prompt, sqljson.Path("prompt_name")))
+		})
+	}
+
+	ids, err := client.AILog.Query().
+		Where(where...).
+		Order(ent.Asc(ailog.FieldCreatedAt)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query ai logs: %w", err)
+	}
+	rng := rand.New(rand.NewPCG(opts.seed, opts.seed))
+	rng.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	ids = ids[:min(opts.size, len(ids))]
+
+	logs, err := client.AILog.Query().
+		Where(ailog.IDIn(ids...)).
+		Order(ent.Asc(ailog.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query sampled ai logs: %w", err)
+	}
+
+	samples := make([]sample, 0, len(logs))
+	for _, log := range logs {
+		req, err := replayRequest(log, prompts)
+		if err != nil {
+			return nil, fmt.Errorf("rebuild request of ai log %s: %w", log.ID, err)
+		}
+		samples = append(samples, sample{log: log, request: req})
+	}
+	return samples, nil
+}
+
+// replayRequest rebuilds the request of a log. Options come from the config
+// it is replayed with, the output schema from the prompt template it was
+// rendered from.
+func replayRequest(log *ent.AILog, prompts *prompt.Registry) (ai.ChatRequest, error) {
+	req := ai.ChatRequest{
+		SystemPrompt: log.SystemPrompt,
+		UserPrompt:   log.UserPrompt,
+		Metadata:     make(map[string]string, len(log.Metadata)),
+	}
+	for k, v := range log.Metadata {
+		req.Metadata[k] = fmt.Sprint(v)
+	}
+
+	name := req.Metadata["prompt_name"]
+	if schema := prompts.Schema(name); schema != nil {
+		var err error
+		if req.Schema, err = ai.NewOutputSchema(name, schema); err != nil {
+			return ai.ChatRequest{}, err
+		}
+	}
+	return req, nil
+}
+
+// sampleIDs returns the IDs of the logs of samples.
+func sampleIDs(samples []sample) []uuid.UUID {
+	ids := make([]uuid.UUID, len(samples))
+	for i, s := range samples {
+		ids[i] = s.log.ID
+	}
+	return ids
+}
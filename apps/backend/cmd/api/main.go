@@ -19,9 +19,11 @@ import (
 	"github.com/mindhit/api/ent"
 	"github.com/mindhit/api/internal/controller"
 	"github.com/mindhit/api/internal/generated"
+	"github.com/mindhit/api/internal/infrastructure/cache"
 	"github.com/mindhit/api/internal/infrastructure/config"
 	"github.com/mindhit/api/internal/infrastructure/logger"
 	"github.com/mindhit/api/internal/infrastructure/middleware"
+	"github.com/mindhit/api/internal/infrastructure/progress"
 	"github.com/mindhit/api/internal/infrastructure/queue"
 	"github.com/mindhit/api/internal/service"
 )
@@ -81,8 +83,19 @@ func run() error {
 		}
 	}()
 
+	// Redis for caches shared across API instances
+	redisClient := cache.NewRedisClient(cfg.RedisAddr)
+	defer func() {
+		if err := redisClient.Close(); err != nil {
+			slog.Error("failed to close redis client", "error", err)
+		}
+	}()
+
 	// Services
+	revocationList := cache.NewRevocationList(redisClient)
 	jwtService := service.NewJWTService(cfg.JWTSecret)
+	jwtService.SetRevocationList(revocationList)
+	tokenService := service.NewTokenService(client, jwtService, revocationList)
 	authService := service.NewAuthService(client)
 	sessionService := service.NewSessionService(client, queueClient)
 	urlService := service.NewURLService(client)
@@ -90,19 +103,34 @@ func run() error {
 	subscriptionService := service.NewSubscriptionService(client)
 	usageService := service.NewUsageService(client)
 	oauthService := service.NewOAuthService(client)
-	mindmapService := service.NewMindmapService(client)
+	mindmapService := service.NewMindmapService(client, queueClient)
+	knowledgeService := service.NewKnowledgeService(client)
+	modelPricingService := service.NewModelPricingService(client)
+	emailService := service.NewEmailService(client, queueClient, cfg.Mail.AppURL)
+	stripeService := service.NewStripeService(client, subscriptionService, service.StripeConfig{
+		SecretKey:       cfg.Stripe.SecretKey,
+		WebhookSecret:   cfg.Stripe.WebhookSecret,
+		PriceIDs:        map[string]string{"pro": cfg.Stripe.ProPriceID},
+		SuccessURL:      cfg.Stripe.SuccessURL,
+		CancelURL:       cfg.Stripe.CancelURL,
+		PortalReturnURL: cfg.Stripe.PortalReturnURL,
+	})
 
 	// Controllers
-	authController := controller.NewAuthController(authService, jwtService)
+	authController := controller.NewAuthController(authService, jwtService, tokenService, emailService)
 	sessionController := controller.NewSessionController(sessionService, jwtService)
 	eventController := controller.NewEventController(eventService, sessionService, jwtService)
-	subscriptionController := controller.NewSubscriptionController(subscriptionService, jwtService)
+	subscriptionController := controller.NewSubscriptionController(subscriptionService, stripeService, jwtService)
 	usageController := controller.NewUsageController(usageService, jwtService)
-	oauthController := controller.NewOAuthController(oauthService, jwtService, subscriptionService)
+	oauthController := controller.NewOAuthController(oauthService, tokenService, subscriptionService)
 	mindmapController := controller.NewMindmapController(mindmapService, jwtService)
+	knowledgeController := controller.NewKnowledgeController(knowledgeService, jwtService)
+	stripeWebhookController := controller.NewStripeWebhookController(stripeService)
+	mindmapStreamController := controller.NewMindmapStreamController(mindmapService, jwtService, progress.NewBroker(redisClient))
+	adminAIController := controller.NewAdminAIController(cache.NewBreakerStates(redisClient), modelPricingService)
 
 	// Combined handler implementing StrictServerInterface
-	handler := controller.NewHandler(authController, sessionController, eventController, subscriptionController, usageController, oauthController, mindmapController)
+	handler := controller.NewHandler(authController, sessionController, eventController, subscriptionController, usageController, oauthController, mindmapController, knowledgeController)
 
 	// Router
 	r := gin.New()
@@ -120,6 +148,22 @@ func run() error {
 	// Metrics endpoint
 	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
+	// Stripe webhooks are authenticated by signature, not by bearer token
+	r.POST("/v1/webhooks/stripe", stripeWebhookController.Handle)
+
+	// Mindmap generation progress is streamed as server-sent events
+	stream := r.Group("/v1/sessions/:id/mindmap/stream", middleware.StreamRateLimit())
+	stream.GET("", mindmapStreamController.Stream)
+	stream.POST("/ticket", mindmapStreamController.Ticket)
+
+	// Admin routes, for the users listed in ADMIN_EMAILS
+	admin := r.Group("/v1/admin", middleware.Auth(jwtService, authService), middleware.Admin(authService, cfg.AdminEmails))
+	admin.GET("/ai/breakers", adminAIController.GetBreakers)
+	admin.GET("/ai/pricing", adminAIController.ListPricing)
+	admin.POST("/ai/pricing", adminAIController.CreatePricing)
+	admin.PUT("/ai/pricing/:id", adminAIController.UpdatePricing)
+	admin.DELETE("/ai/pricing/:id", adminAIController.DeletePricing)
+
 	// Register API handlers using generated code with rate limiting middleware
 	strictHandler := generated.NewStrictHandler(handler, nil)
 	generated.RegisterHandlersWithOptions(r, strictHandler, generated.GinServerOptions{
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/ailog"
+	"github.com/mindhit/api/internal/service"
+)
+
+// aiLogBatchSize is how many AI logs are priced at a time.
+const aiLogBatchSize = 500
+
+// backfillAILogCost recomputes the cost of every AI log from the model prices,
+// at the price effective when the request was made.
+//
+// Costs used to be estimated in whole cents from a price per provider, so most
+// requests were recorded as free. Logs of models without a price are left
+// without a cost, and get one when the command runs again after their price
+// is added; logs whose price was removed lose theirs. It is safe to re-run.
+func backfillAILogCost(ctx context.Context, client *ent.Client, dryRun bool) error {
+	pricing := service.NewModelPricingService(client)
+
+	var (
+		lastID                   uuid.UUID
+		scanned, updated, priced int
+		unpriced                 = make(map[string]int)
+	)
+	for {
+		logs, err := client.AILog.Query().
+			Where(ailog.IDGT(lastID)).
+			Order(ent.Asc(ailog.FieldID)).
+			Limit(aiLogBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query ai logs: %w", err)
+		}
+		if len(logs) == 0 {
+			break
+		}
+		lastID = logs[len(logs)-1].ID
+
+		for _, log := range logs {
+			scanned++
+			cost, err := aiLogCost(ctx, pricing, log)
+			if err != nil {
+				return fmt.Errorf("price ai log %s: %w", log.ID, err)
+			}
+			if cost == nil {
+				unpriced[log.Provider+"/"+log.Model]++
+			} else {
+				priced++
+			}
+			if equalCost(log.CostMicroCents, cost) {
+				continue
+			}
+
+			if dryRun {
+				slog.Debug("would update ai log cost", "ai_log_id", log.ID, "cost_micro_cents", cost)
+				updated++
+				continue
+			}
+			update := client.AILog.UpdateOneID(log.ID)
+			if cost == nil {
+				update.ClearCostMicroCents() // Its price was removed
+			} else {
+				update.SetCostMicroCents(*cost)
+			}
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("update cost of ai log %s: %w", log.ID, err)
+			}
+			updated++
+		}
+	}
+
+	for model, count := range unpriced {
+		slog.Warn("ai logs of a model without a price", "model", model, "logs", count)
+	}
+	slog.Info("ai log cost backfill finished",
+		"scanned", scanned,
+		"priced", priced,
+		"updated", updated,
+		"dry_run", dryRun,
+	)
+	return nil
+}
+
+// aiLogCost returns the cost of an AI log, the way AILogService.Log prices it.
+func aiLogCost(ctx context.Context, pricing *service.ModelPricingService, log *ent.AILog) (*int64, error) {
+	if log.Cached {
+		var free int64
+		return &free, nil
+	}
+	return pricing.Cost(ctx, log.Provider, log.Model, log.CreatedAt, service.TokenUsage{
+		Input:       log.InputTokens,
+		CachedInput: log.CachedInputTokens,
+		Output:      log.OutputTokens,
+		Thinking:    log.ThinkingTokens,
+	})
+}
+
+// equalCost reports whether two optional costs are the same.
+func equalCost(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
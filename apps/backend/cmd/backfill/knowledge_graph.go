@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/internal/service"
+)
+
+// backfillKnowledgeGraph merges every completed mindmap of a session that
+// isn't deleted and has no knowledge mentions into its user's knowledge
+// graph.
+//
+// Knowledge graphs are updated when a mindmap completes, so mindmaps that
+// completed before they existed are missing from them. It is safe to
+// re-run.
+func backfillKnowledgeGraph(ctx context.Context, client *ent.Client, dryRun bool) error {
+	knowledgeService := service.NewKnowledgeService(client)
+
+	var (
+		lastID          uuid.UUID
+		scanned, merged int
+	)
+	for {
+		mindmaps, err := client.MindmapGraph.Query().
+			Where(
+				mindmapgraph.IDGT(lastID),
+				mindmapgraph.StatusEQ(mindmapgraph.StatusCompleted),
+				mindmapgraph.HasSessionWith(
+					session.StatusEQ(session.StatusActive),
+					session.Not(session.HasKnowledgeMentions()),
+				),
+			).
+			Order(ent.Asc(mindmapgraph.FieldID)).
+			Limit(mindmapBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query mindmaps: %w", err)
+		}
+		if len(mindmaps) == 0 {
+			break
+		}
+		lastID = mindmaps[len(mindmaps)-1].ID
+
+		for _, m := range mindmaps {
+			scanned++
+			if dryRun {
+				slog.Debug("would merge mindmap into knowledge graph", "mindmap_id", m.ID)
+				merged++
+				continue
+			}
+			if err := knowledgeService.UpdateFromMindmap(ctx, m.ID); err != nil {
+				return fmt.Errorf("merge mindmap %s: %w", m.ID, err)
+			}
+			merged++
+		}
+	}
+
+	slog.Info("knowledge graph backfill finished",
+		"scanned", scanned,
+		"merged", merged,
+		"dry_run", dryRun,
+	)
+	return nil
+}
@@ -0,0 +1,78 @@
+// Package main provides one-off data backfills that accompany schema changes.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/lib/pq"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/config"
+)
+
+func main() {
+	if err := run(); err != nil {
+		slog.Error("backfill error", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if len(os.Args) < 2 {
+		fmt.Println("Usage: go run ./cmd/backfill <command> [-dry-run]")
+		fmt.Println("Commands:")
+		fmt.Println("  url-content        Move captured page content from urls into per-user url_contents")
+		fmt.Println("  ai-log-cost        Recompute the cost of AI logs from the model prices")
+		fmt.Println("  mindmap-revisions  Record the current graph of completed mindmaps as their first revision")
+		fmt.Println("  knowledge-graph    Merge completed mindmaps into their users' knowledge graphs")
+		return fmt.Errorf("no command specified")
+	}
+
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "report what would change without writing")
+	if err := flags.Parse(os.Args[2:]); err != nil {
+		return err
+	}
+
+	cfg := config.Load()
+
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+
+	switch os.Args[1] {
+	case "url-content":
+		if err := backfillURLContent(ctx, db, client, *dryRun); err != nil {
+			return fmt.Errorf("failed to backfill url content: %w", err)
+		}
+	case "ai-log-cost":
+		if err := backfillAILogCost(ctx, client, *dryRun); err != nil {
+			return fmt.Errorf("failed to backfill ai log cost: %w", err)
+		}
+	case "mindmap-revisions":
+		if err := backfillMindmapRevisions(ctx, client, *dryRun); err != nil {
+			return fmt.Errorf("failed to backfill mindmap revisions: %w", err)
+		}
+	case "knowledge-graph":
+		if err := backfillKnowledgeGraph(ctx, client, *dryRun); err != nil {
+			return fmt.Errorf("failed to backfill knowledge graph: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown command: %s", os.Args[1])
+	}
+
+	return nil
+}
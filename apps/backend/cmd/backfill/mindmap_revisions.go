@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/mindmapgraph"
+)
+
+// mindmapBatchSize is how many mindmaps are read at a time.
+const mindmapBatchSize = 200
+
+// backfillMindmapRevisions records the current graph of every completed
+// mindmap without revisions as the revision of its version.
+//
+// Revisions are recorded when a generation completes, so mindmaps generated
+// before they existed have none and could not be restored after a
+// regeneration. It is safe to re-run.
+func backfillMindmapRevisions(ctx context.Context, client *ent.Client, dryRun bool) error {
+	var (
+		lastID            uuid.UUID
+		scanned, recorded int
+	)
+	for {
+		mindmaps, err := client.MindmapGraph.Query().
+			Where(
+				mindmapgraph.IDGT(lastID),
+				mindmapgraph.StatusEQ(mindmapgraph.StatusCompleted),
+				mindmapgraph.Not(mindmapgraph.HasRevisions()),
+			).
+			Order(ent.Asc(mindmapgraph.FieldID)).
+			Limit(mindmapBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query mindmaps: %w", err)
+		}
+		if len(mindmaps) == 0 {
+			break
+		}
+		lastID = mindmaps[len(mindmaps)-1].ID
+
+		for _, m := range mindmaps {
+			scanned++
+			if dryRun {
+				slog.Debug("would record mindmap revision", "mindmap_id", m.ID, "version", m.Version)
+				recorded++
+				continue
+			}
+			if err := client.MindmapRevision.Create().
+				SetMindmapID(m.ID).
+				SetVersion(m.Version).
+				SetNodes(m.Nodes).
+				SetGraphEdges(m.GraphEdges).
+				SetLayout(m.Layout).
+				SetGeneratedAt(m.GeneratedAt).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("record revision of mindmap %s: %w", m.ID, err)
+			}
+			recorded++
+		}
+	}
+
+	slog.Info("mindmap revision backfill finished",
+		"scanned", scanned,
+		"recorded", recorded,
+		"dry_run", dryRun,
+	)
+	return nil
+}
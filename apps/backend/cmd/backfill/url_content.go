@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/pagevisit"
+	"github.com/mindhit/api/ent/session"
+	enturl "github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
+)
+
+// legacyURLColumns are the captured-content columns that used to live on urls,
+// with the type used to select NULL in their place when a column is missing.
+var legacyURLColumns = []struct {
+	name     string
+	nullType string
+}{
+	{"title", "text"},
+	{"content", "text"},
+	{"summary", "text"},
+	{"abstract", "text"},
+	{"keywords", "jsonb"},
+	{"entities", "jsonb"},
+	{"summarized_at", "timestamptz"},
+}
+
+// legacyURL is one urls row that still carries captured content.
+type legacyURL struct {
+	id           uuid.UUID
+	title        sql.NullString
+	content      sql.NullString
+	summary      sql.NullString
+	abstract     sql.NullString
+	keywords     []byte
+	entities     []byte
+	summarizedAt sql.NullTime
+}
+
+// backfillURLContent moves content captured into the shared urls table into
+// per-user url_contents rows, then clears the legacy columns.
+//
+// Which user captured a page was never recorded, so the content is assigned
+// to the user with the earliest visit to the URL (the one whose capture
+// created it). Every other visitor gets an empty copy that is filled in the
+// next time they capture the page. Run it after url_contents exists and
+// before the legacy columns are dropped; it is safe to re-run.
+func backfillURLContent(ctx context.Context, db *sql.DB, client *ent.Client, dryRun bool) error {
+	present, err := existingColumns(ctx, db, "urls")
+	if err != nil {
+		return err
+	}
+
+	var selects, clears []string
+	for _, col := range legacyURLColumns {
+		if present[col.name] {
+			selects = append(selects, col.name)
+			clears = append(clears, col.name+" = NULL")
+		} else {
+			selects = append(selects, "NULL::"+col.nullType)
+		}
+	}
+	if len(clears) == 0 {
+		slog.Info("no legacy url content columns, nothing to backfill")
+		return nil
+	}
+
+	var conditions []string
+	for _, col := range legacyURLColumns {
+		if present[col.name] {
+			conditions = append(conditions, col.name+" IS NOT NULL")
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(
+		"SELECT id, %s FROM urls WHERE %s",
+		strings.Join(selects, ", "),
+		strings.Join(conditions, " OR "),
+	))
+	if err != nil {
+		return fmt.Errorf("query legacy urls: %w", err)
+	}
+
+	var legacy []legacyURL
+	for rows.Next() {
+		var l legacyURL
+		if err := rows.Scan(&l.id, &l.title, &l.content, &l.summary, &l.abstract, &l.keywords, &l.entities, &l.summarizedAt); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("scan legacy url: %w", err)
+		}
+		legacy = append(legacy, l)
+	}
+	if err := rows.Close(); err != nil {
+		return fmt.Errorf("read legacy urls: %w", err)
+	}
+
+	clearQuery := fmt.Sprintf("UPDATE urls SET %s WHERE id = $1", strings.Join(clears, ", "))
+
+	migrated, orphaned := 0, 0
+	for _, l := range legacy {
+		ownerID, visitorIDs, err := urlVisitors(ctx, client, l.id)
+		if err != nil {
+			return err
+		}
+		if ownerID == uuid.Nil {
+			// Nobody visited it, so nobody may see the content
+			orphaned++
+		}
+
+		if dryRun {
+			slog.Info("would migrate url content",
+				"url_id", l.id,
+				"owner_id", ownerID,
+				"visitors", len(visitorIDs),
+			)
+			continue
+		}
+
+		for _, userID := range visitorIDs {
+			if userID == ownerID {
+				err = saveLegacyContent(ctx, client, userID, l)
+			} else {
+				err = ensureContent(ctx, client, userID, l.id)
+			}
+			if err != nil {
+				return fmt.Errorf("migrate url %s: %w", l.id, err)
+			}
+		}
+
+		if _, err := db.ExecContext(ctx, clearQuery, l.id); err != nil {
+			return fmt.Errorf("clear legacy content of url %s: %w", l.id, err)
+		}
+		migrated++
+	}
+
+	slog.Info("url content backfill finished",
+		"candidates", len(legacy),
+		"migrated", migrated,
+		"without_visits", orphaned,
+		"dry_run", dryRun,
+	)
+	return nil
+}
+
+// existingColumns returns the set of column names of a table.
+func existingColumns(ctx context.Context, db *sql.DB, table string) (map[string]bool, error) {
+	names := make([]string, 0, len(legacyURLColumns))
+	for _, col := range legacyURLColumns {
+		names = append(names, col.name)
+	}
+
+	rows, err := db.QueryContext(ctx,
+		`SELECT column_name FROM information_schema.columns
+		 WHERE table_schema = current_schema() AND table_name = $1 AND column_name = ANY($2)`,
+		table, pq.Array(names),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query columns of %s: %w", table, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	present := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("scan column name: %w", err)
+		}
+		present[name] = true
+	}
+	return present, rows.Err()
+}
+
+// urlVisitors returns the user with the earliest visit to a URL and every
+// user who visited it.
+func urlVisitors(ctx context.Context, client *ent.Client, urlID uuid.UUID) (uuid.UUID, []uuid.UUID, error) {
+	first, err := client.PageVisit.
+		Query().
+		Where(pagevisit.HasURLWith(enturl.IDEQ(urlID))).
+		Order(ent.Asc(pagevisit.FieldEnteredAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return uuid.Nil, nil, nil
+	}
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("query first visit of url %s: %w", urlID, err)
+	}
+
+	ownerID, err := first.QuerySession().QueryUser().OnlyID(ctx)
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("query owner of url %s: %w", urlID, err)
+	}
+
+	visitorIDs, err := client.User.
+		Query().
+		Where(user.HasSessionsWith(session.HasPageVisitsWith(pagevisit.HasURLWith(enturl.IDEQ(urlID))))).
+		IDs(ctx)
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("query visitors of url %s: %w", urlID, err)
+	}
+
+	return ownerID, visitorIDs, nil
+}
+
+// saveLegacyContent gives the owner the legacy content, keeping anything the
+// owner already captured into their own copy.
+func saveLegacyContent(ctx context.Context, client *ent.Client, userID uuid.UUID, l legacyURL) error {
+	existing, err := findContent(ctx, client, userID, l.id)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if existing == nil {
+		existing, err = client.URLContent.
+			Create().
+			SetUserID(userID).
+			SetURLID(l.id).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create url content: %w", err)
+		}
+	}
+
+	update := client.URLContent.UpdateOne(existing)
+	if existing.Title == "" && l.title.Valid {
+		update.SetTitle(l.title.String)
+	}
+	if existing.Content == "" && l.content.Valid {
+		update.SetContent(l.content.String)
+	}
+	if existing.Summary == "" && l.summary.Valid {
+		update.SetSummary(l.summary.String)
+	}
+	if existing.Abstract == "" && l.abstract.Valid {
+		update.SetAbstract(l.abstract.String)
+	}
+	if len(existing.Keywords) == 0 {
+		if keywords := decodeStrings(l.keywords); len(keywords) > 0 {
+			update.SetKeywords(keywords)
+		}
+	}
+	if len(existing.Entities) == 0 {
+		if entities := decodeStrings(l.entities); len(entities) > 0 {
+			update.SetEntities(entities)
+		}
+	}
+	if existing.SummarizedAt == nil && l.summarizedAt.Valid {
+		update.SetSummarizedAt(l.summarizedAt.Time)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("update url content: %w", err)
+	}
+	return nil
+}
+
+// ensureContent creates an empty copy of a URL for a user if none exists.
+func ensureContent(ctx context.Context, client *ent.Client, userID, urlID uuid.UUID) error {
+	_, err := findContent(ctx, client, userID, urlID)
+	if !ent.IsNotFound(err) {
+		return err
+	}
+
+	err = client.URLContent.
+		Create().
+		SetUserID(userID).
+		SetURLID(urlID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create url content: %w", err)
+	}
+	return nil
+}
+
+func findContent(ctx context.Context, client *ent.Client, userID, urlID uuid.UUID) (*ent.URLContent, error) {
+	return client.URLContent.
+		Query().
+		Where(
+			urlcontent.HasUserWith(user.IDEQ(userID)),
+			urlcontent.HasURLWith(enturl.IDEQ(urlID)),
+		).
+		Only(ctx)
+}
+
+// decodeStrings decodes a legacy JSON string array, ignoring malformed values.
+func decodeStrings(raw []byte) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var values []string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil
+	}
+	return values
+}
@@ -4,6 +4,8 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -16,7 +18,11 @@ import (
 
 	"github.com/mindhit/api/ent"
 	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/cache"
 	"github.com/mindhit/api/internal/infrastructure/config"
+	"github.com/mindhit/api/internal/infrastructure/mail"
+	"github.com/mindhit/api/internal/infrastructure/progress"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
 	"github.com/mindhit/api/internal/infrastructure/queue"
 	"github.com/mindhit/api/internal/service"
 	"github.com/mindhit/api/internal/worker/handler"
@@ -74,12 +80,44 @@ func run() error {
 	aiConfigService := service.NewAIConfigService(client)
 	aiLogService := service.NewAILogService(client)
 
+	// Price the default models, admins manage prices from then on
+	if err := service.NewModelPricingService(client).SeedDefaultPricing(ctx); err != nil {
+		slog.Error("failed to seed model pricing", "error", err)
+	}
+
 	// Initialize AI Provider Manager
+	if cfg.Environment == "production" && cfg.AI.FakeEnabled {
+		return errors.New("AI_FAKE_ENABLED must not be set in production")
+	}
+	rateLimits, err := ai.ParseRateLimits(cfg.AI.RateLimits)
+	if err != nil {
+		return fmt.Errorf("AI_RATE_LIMITS: %w", err)
+	}
+
 	var aiManager *ai.ProviderManager
 	aiCfg := ai.Config{
 		OpenAIAPIKey: cfg.AI.OpenAIAPIKey,
 		GeminiAPIKey: cfg.AI.GeminiAPIKey,
 		ClaudeAPIKey: cfg.AI.ClaudeAPIKey,
+		OpenAICompatible: ai.OpenAICompatibleConfig{
+			BaseURL:  cfg.AI.OpenAICompatibleBaseURL,
+			APIKey:   cfg.AI.OpenAICompatibleAPIKey,
+			Model:    cfg.AI.OpenAICompatibleModel,
+			JSONMode: ai.ParseCapability(cfg.AI.OpenAICompatibleJSONMode),
+			Thinking: ai.ParseCapability(cfg.AI.OpenAICompatibleThinking),
+		},
+		Fake: ai.FakeConfig{
+			Enabled:    cfg.AI.FakeEnabled,
+			ScriptPath: cfg.AI.FakeScriptPath,
+		},
+		Breaker: ai.BreakerConfig{
+			MinRequests: cfg.AI.BreakerMinRequests,
+			FailureRate: float64(cfg.AI.BreakerFailurePercent) / 100,
+			SlowCall:    time.Duration(cfg.AI.BreakerSlowCallSeconds) * time.Second,
+			OpenTimeout: time.Duration(cfg.AI.BreakerOpenSeconds) * time.Second,
+		},
+		RateLimits:       rateLimits,
+		RateLimitMaxWait: time.Duration(cfg.AI.RateLimitMaxWaitSeconds) * time.Second,
 	}
 
 	configAdapter := service.NewAIConfigAdapter(aiConfigService)
@@ -97,8 +135,52 @@ func run() error {
 		}()
 	}
 
-	// Initialize usage service for token tracking
+	// Queue client for follow-up jobs (tag extraction -> mindmap generation)
+	queueClient := queue.NewClient(cfg.RedisAddr)
+	defer func() {
+		if err := queueClient.Close(); err != nil {
+			slog.Error("failed to close queue client", "error", err)
+		}
+	}()
+
+	// Initialize usage service for token tracking, with usage warning emails
 	usageService := service.NewUsageService(client)
+	usageService.SetEmailService(service.NewEmailService(client, queueClient, cfg.Mail.AppURL))
+
+	// Initialize mailer
+	mailer, err := newMailer(cfg)
+	if err != nil {
+		return err
+	}
+	mailRenderer, err := mail.NewRenderer()
+	if err != nil {
+		return err
+	}
+
+	// Prompt templates, overridable from the database
+	prompts, err := prompt.NewRegistry(service.NewPromptTemplateService(client))
+	if err != nil {
+		return err
+	}
+
+	// Redis client for publishing mindmap generation progress
+	redisClient := cache.NewRedisClient(cfg.RedisAddr)
+	defer func() {
+		if err := redisClient.Close(); err != nil {
+			slog.Error("failed to close redis client", "error", err)
+		}
+	}()
+	progressBroker := progress.NewBroker(redisClient)
+
+	if aiManager != nil {
+		// Rate limit budgets are shared by every worker
+		aiManager.SetLimiter(cache.NewAIRateLimiter(redisClient))
+		// Tasks with a cache TTL share responses to identical requests
+		aiManager.SetResponseCache(cache.NewAIResponseCache(redisClient))
+
+		// Share the AI circuit breaker states, kept in memory, with the admin API
+		go publishBreakerStates(ctx, cache.NewBreakerStates(redisClient), aiManager)
+	}
 
 	// Create worker server
 	server := queue.NewServer(queue.ServerConfig{
@@ -107,7 +189,7 @@ func run() error {
 	})
 
 	// Register handlers
-	handler.RegisterHandlers(server, client, aiManager, usageService)
+	handler.RegisterHandlers(server, client, queueClient, aiManager, usageService, mailer, mailRenderer, progressBroker, prompts)
 
 	// Create scheduler for periodic tasks
 	scheduler, err := queue.NewScheduler(cfg.RedisAddr)
@@ -115,7 +197,14 @@ func run() error {
 		return err
 	}
 
-	if err := scheduler.RegisterPeriodicTasks(); err != nil {
+	if err := scheduler.RegisterPeriodicTasks(queue.PeriodicConfig{
+		SummaryBackfillInterval:    cfg.SummaryBackfill.Interval,
+		SummaryBackfillBatchSize:   cfg.SummaryBackfill.BatchSize,
+		SummaryBackfillDailyTokens: cfg.SummaryBackfill.DailyTokens,
+		RetentionInterval:          cfg.Retention.Interval,
+		RetentionPurgeAfterDays:    cfg.Retention.PurgeAfterDays,
+		RetentionDryRun:            cfg.Retention.DryRun,
+	}); err != nil {
 		return err
 	}
 
@@ -139,3 +228,44 @@ func run() error {
 	// Start server (blocking)
 	return server.Run()
 }
+
+// publishBreakerStates periodically publishes the circuit breaker states of
+// this worker. They expire if the worker stops.
+func publishBreakerStates(ctx context.Context, states *cache.BreakerStates, aiManager *ai.ProviderManager) {
+	const interval = 15 * time.Second
+
+	hostname, _ := os.Hostname()
+	instance := fmt.Sprintf("%s-%d", hostname, os.Getpid())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := states.Publish(ctx, instance, aiManager.BreakerStatus(), 4*interval); err != nil {
+			slog.Warn("failed to publish ai circuit breaker states", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newMailer returns the SMTP mailer when SMTP is configured and the
+// development sink otherwise.
+func newMailer(cfg *config.Config) (mail.Mailer, error) {
+	if cfg.Mail.SMTPHost == "" {
+		if cfg.Environment == "production" {
+			slog.Warn("SMTP_HOST not set, emails will not be delivered")
+		}
+		return mail.NewSinkMailer(cfg.Mail.SinkDir)
+	}
+
+	return mail.NewSMTPMailer(mail.SMTPConfig{
+		Host:     cfg.Mail.SMTPHost,
+		Port:     cfg.Mail.SMTPPort,
+		Username: cfg.Mail.SMTPUsername,
+		Password: cfg.Mail.SMTPPassword,
+		From:     cfg.Mail.From,
+	})
+}
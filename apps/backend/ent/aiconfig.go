@@ -18,11 +18,11 @@ type AIConfig struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
-	// Task type: 'default', 'tag_extraction', 'mindmap'
+	// Task type: 'default', 'tag_extraction', 'summarize', 'mindmap'
 	TaskType string `json:"task_type,omitempty"`
-	// AI provider: 'openai', 'claude', 'gemini'
+	// AI provider: 'openai', 'claude', 'gemini', 'openai_compatible', 'fake'
 	Provider string `json:"provider,omitempty"`
-	// Model name: 'gpt-4o', 'claude-sonnet-4', 'gemini-2.0-flash'
+	// Model name: 'gpt-4o', 'claude-sonnet-4', 'gemini-2.0-flash', 'llama3.1:8b'
 	Model string `json:"model,omitempty"`
 	// Ordered list of fallback providers
 	FallbackProviders []string `json:"fallback_providers,omitempty"`
@@ -34,6 +34,8 @@ type AIConfig struct {
 	ThinkingBudget int `json:"thinking_budget,omitempty"`
 	// Force JSON output
 	JSONMode bool `json:"json_mode,omitempty"`
+	// How long identical requests are answered from the response cache, 0 disables it
+	CacheTTLSeconds int `json:"cache_ttl_seconds,omitempty"`
 	// Whether this config is active
 	Enabled bool `json:"enabled,omitempty"`
 	// Admin who last updated this config
@@ -56,7 +58,7 @@ func (*AIConfig) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case aiconfig.FieldTemperature:
 			values[i] = new(sql.NullFloat64)
-		case aiconfig.FieldID, aiconfig.FieldMaxTokens, aiconfig.FieldThinkingBudget:
+		case aiconfig.FieldID, aiconfig.FieldMaxTokens, aiconfig.FieldThinkingBudget, aiconfig.FieldCacheTTLSeconds:
 			values[i] = new(sql.NullInt64)
 		case aiconfig.FieldTaskType, aiconfig.FieldProvider, aiconfig.FieldModel, aiconfig.FieldUpdatedBy:
 			values[i] = new(sql.NullString)
@@ -133,6 +135,12 @@ func (_m *AIConfig) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.JSONMode = value.Bool
 			}
+		case aiconfig.FieldCacheTTLSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_ttl_seconds", values[i])
+			} else if value.Valid {
+				_m.CacheTTLSeconds = int(value.Int64)
+			}
 		case aiconfig.FieldEnabled:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enabled", values[i])
@@ -217,6 +225,9 @@ func (_m *AIConfig) String() string {
 	builder.WriteString("json_mode=")
 	builder.WriteString(fmt.Sprintf("%v", _m.JSONMode))
 	builder.WriteString(", ")
+	builder.WriteString("cache_ttl_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CacheTTLSeconds))
+	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
 	builder.WriteString(", ")
@@ -29,6 +29,8 @@ const (
 	FieldThinkingBudget = "thinking_budget"
 	// FieldJSONMode holds the string denoting the json_mode field in the database.
 	FieldJSONMode = "json_mode"
+	// FieldCacheTTLSeconds holds the string denoting the cache_ttl_seconds field in the database.
+	FieldCacheTTLSeconds = "cache_ttl_seconds"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
 	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
@@ -52,6 +54,7 @@ var Columns = []string{
 	FieldMaxTokens,
 	FieldThinkingBudget,
 	FieldJSONMode,
+	FieldCacheTTLSeconds,
 	FieldEnabled,
 	FieldUpdatedBy,
 	FieldCreatedAt,
@@ -83,6 +86,10 @@ var (
 	DefaultThinkingBudget int
 	// DefaultJSONMode holds the default value on creation for the "json_mode" field.
 	DefaultJSONMode bool
+	// DefaultCacheTTLSeconds holds the default value on creation for the "cache_ttl_seconds" field.
+	DefaultCacheTTLSeconds int
+	// CacheTTLSecondsValidator is a validator for the "cache_ttl_seconds" field. It is called by the builders before save.
+	CacheTTLSecondsValidator func(int) error
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -136,6 +143,11 @@ func ByJSONMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldJSONMode, opts...).ToFunc()
 }
 
+// ByCacheTTLSeconds orders the results by the cache_ttl_seconds field.
+func ByCacheTTLSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheTTLSeconds, opts...).ToFunc()
+}
+
 // ByEnabled orders the results by the enabled field.
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
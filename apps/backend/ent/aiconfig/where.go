@@ -89,6 +89,11 @@ func JSONMode(v bool) predicate.AIConfig {
 	return predicate.AIConfig(sql.FieldEQ(FieldJSONMode, v))
 }
 
+// CacheTTLSeconds applies equality check predicate on the "cache_ttl_seconds" field. It's identical to CacheTTLSecondsEQ.
+func CacheTTLSeconds(v int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldEQ(FieldCacheTTLSeconds, v))
+}
+
 // Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
 func Enabled(v bool) predicate.AIConfig {
 	return predicate.AIConfig(sql.FieldEQ(FieldEnabled, v))
@@ -444,6 +449,46 @@ func JSONModeNEQ(v bool) predicate.AIConfig {
 	return predicate.AIConfig(sql.FieldNEQ(FieldJSONMode, v))
 }
 
+// CacheTTLSecondsEQ applies the EQ predicate on the "cache_ttl_seconds" field.
+func CacheTTLSecondsEQ(v int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldEQ(FieldCacheTTLSeconds, v))
+}
+
+// CacheTTLSecondsNEQ applies the NEQ predicate on the "cache_ttl_seconds" field.
+func CacheTTLSecondsNEQ(v int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldNEQ(FieldCacheTTLSeconds, v))
+}
+
+// CacheTTLSecondsIn applies the In predicate on the "cache_ttl_seconds" field.
+func CacheTTLSecondsIn(vs ...int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldIn(FieldCacheTTLSeconds, vs...))
+}
+
+// CacheTTLSecondsNotIn applies the NotIn predicate on the "cache_ttl_seconds" field.
+func CacheTTLSecondsNotIn(vs ...int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldNotIn(FieldCacheTTLSeconds, vs...))
+}
+
+// CacheTTLSecondsGT applies the GT predicate on the "cache_ttl_seconds" field.
+func CacheTTLSecondsGT(v int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldGT(FieldCacheTTLSeconds, v))
+}
+
+// CacheTTLSecondsGTE applies the GTE predicate on the "cache_ttl_seconds" field.
+func CacheTTLSecondsGTE(v int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldGTE(FieldCacheTTLSeconds, v))
+}
+
+// CacheTTLSecondsLT applies the LT predicate on the "cache_ttl_seconds" field.
+func CacheTTLSecondsLT(v int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldLT(FieldCacheTTLSeconds, v))
+}
+
+// CacheTTLSecondsLTE applies the LTE predicate on the "cache_ttl_seconds" field.
+func CacheTTLSecondsLTE(v int) predicate.AIConfig {
+	return predicate.AIConfig(sql.FieldLTE(FieldCacheTTLSeconds, v))
+}
+
 // EnabledEQ applies the EQ predicate on the "enabled" field.
 func EnabledEQ(v bool) predicate.AIConfig {
 	return predicate.AIConfig(sql.FieldEQ(FieldEnabled, v))
@@ -100,6 +100,20 @@ func (_c *AIConfigCreate) SetNillableJSONMode(v *bool) *AIConfigCreate {
 	return _c
 }
 
+// SetCacheTTLSeconds sets the "cache_ttl_seconds" field.
+func (_c *AIConfigCreate) SetCacheTTLSeconds(v int) *AIConfigCreate {
+	_c.mutation.SetCacheTTLSeconds(v)
+	return _c
+}
+
+// SetNillableCacheTTLSeconds sets the "cache_ttl_seconds" field if the given value is not nil.
+func (_c *AIConfigCreate) SetNillableCacheTTLSeconds(v *int) *AIConfigCreate {
+	if v != nil {
+		_c.SetCacheTTLSeconds(*v)
+	}
+	return _c
+}
+
 // SetEnabled sets the "enabled" field.
 func (_c *AIConfigCreate) SetEnabled(v bool) *AIConfigCreate {
 	_c.mutation.SetEnabled(v)
@@ -207,6 +221,10 @@ func (_c *AIConfigCreate) defaults() {
 		v := aiconfig.DefaultJSONMode
 		_c.mutation.SetJSONMode(v)
 	}
+	if _, ok := _c.mutation.CacheTTLSeconds(); !ok {
+		v := aiconfig.DefaultCacheTTLSeconds
+		_c.mutation.SetCacheTTLSeconds(v)
+	}
 	if _, ok := _c.mutation.Enabled(); !ok {
 		v := aiconfig.DefaultEnabled
 		_c.mutation.SetEnabled(v)
@@ -259,6 +277,14 @@ func (_c *AIConfigCreate) check() error {
 	if _, ok := _c.mutation.JSONMode(); !ok {
 		return &ValidationError{Name: "json_mode", err: errors.New(`ent: missing required field "AIConfig.json_mode"`)}
 	}
+	if _, ok := _c.mutation.CacheTTLSeconds(); !ok {
+		return &ValidationError{Name: "cache_ttl_seconds", err: errors.New(`ent: missing required field "AIConfig.cache_ttl_seconds"`)}
+	}
+	if v, ok := _c.mutation.CacheTTLSeconds(); ok {
+		if err := aiconfig.CacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "AIConfig.cache_ttl_seconds": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "AIConfig.enabled"`)}
 	}
@@ -326,6 +352,10 @@ func (_c *AIConfigCreate) createSpec() (*AIConfig, *sqlgraph.CreateSpec) {
 		_spec.SetField(aiconfig.FieldJSONMode, field.TypeBool, value)
 		_node.JSONMode = value
 	}
+	if value, ok := _c.mutation.CacheTTLSeconds(); ok {
+		_spec.SetField(aiconfig.FieldCacheTTLSeconds, field.TypeInt, value)
+		_node.CacheTTLSeconds = value
+	}
 	if value, ok := _c.mutation.Enabled(); ok {
 		_spec.SetField(aiconfig.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
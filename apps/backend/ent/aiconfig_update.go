@@ -166,6 +166,27 @@ func (_u *AIConfigUpdate) SetNillableJSONMode(v *bool) *AIConfigUpdate {
 	return _u
 }
 
+// SetCacheTTLSeconds sets the "cache_ttl_seconds" field.
+func (_u *AIConfigUpdate) SetCacheTTLSeconds(v int) *AIConfigUpdate {
+	_u.mutation.ResetCacheTTLSeconds()
+	_u.mutation.SetCacheTTLSeconds(v)
+	return _u
+}
+
+// SetNillableCacheTTLSeconds sets the "cache_ttl_seconds" field if the given value is not nil.
+func (_u *AIConfigUpdate) SetNillableCacheTTLSeconds(v *int) *AIConfigUpdate {
+	if v != nil {
+		_u.SetCacheTTLSeconds(*v)
+	}
+	return _u
+}
+
+// AddCacheTTLSeconds adds value to the "cache_ttl_seconds" field.
+func (_u *AIConfigUpdate) AddCacheTTLSeconds(v int) *AIConfigUpdate {
+	_u.mutation.AddCacheTTLSeconds(v)
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *AIConfigUpdate) SetEnabled(v bool) *AIConfigUpdate {
 	_u.mutation.SetEnabled(v)
@@ -264,6 +285,11 @@ func (_u *AIConfigUpdate) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIConfig.model": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CacheTTLSeconds(); ok {
+		if err := aiconfig.CacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "AIConfig.cache_ttl_seconds": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -320,6 +346,12 @@ func (_u *AIConfigUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.JSONMode(); ok {
 		_spec.SetField(aiconfig.FieldJSONMode, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.CacheTTLSeconds(); ok {
+		_spec.SetField(aiconfig.FieldCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCacheTTLSeconds(); ok {
+		_spec.AddField(aiconfig.FieldCacheTTLSeconds, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(aiconfig.FieldEnabled, field.TypeBool, value)
 	}
@@ -489,6 +521,27 @@ func (_u *AIConfigUpdateOne) SetNillableJSONMode(v *bool) *AIConfigUpdateOne {
 	return _u
 }
 
+// SetCacheTTLSeconds sets the "cache_ttl_seconds" field.
+func (_u *AIConfigUpdateOne) SetCacheTTLSeconds(v int) *AIConfigUpdateOne {
+	_u.mutation.ResetCacheTTLSeconds()
+	_u.mutation.SetCacheTTLSeconds(v)
+	return _u
+}
+
+// SetNillableCacheTTLSeconds sets the "cache_ttl_seconds" field if the given value is not nil.
+func (_u *AIConfigUpdateOne) SetNillableCacheTTLSeconds(v *int) *AIConfigUpdateOne {
+	if v != nil {
+		_u.SetCacheTTLSeconds(*v)
+	}
+	return _u
+}
+
+// AddCacheTTLSeconds adds value to the "cache_ttl_seconds" field.
+func (_u *AIConfigUpdateOne) AddCacheTTLSeconds(v int) *AIConfigUpdateOne {
+	_u.mutation.AddCacheTTLSeconds(v)
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *AIConfigUpdateOne) SetEnabled(v bool) *AIConfigUpdateOne {
 	_u.mutation.SetEnabled(v)
@@ -600,6 +653,11 @@ func (_u *AIConfigUpdateOne) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIConfig.model": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CacheTTLSeconds(); ok {
+		if err := aiconfig.CacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "AIConfig.cache_ttl_seconds": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -673,6 +731,12 @@ func (_u *AIConfigUpdateOne) sqlSave(ctx context.Context) (_node *AIConfig, err
 	if value, ok := _u.mutation.JSONMode(); ok {
 		_spec.SetField(aiconfig.FieldJSONMode, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.CacheTTLSeconds(); ok {
+		_spec.SetField(aiconfig.FieldCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCacheTTLSeconds(); ok {
+		_spec.AddField(aiconfig.FieldCacheTTLSeconds, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(aiconfig.FieldEnabled, field.TypeBool, value)
 	}
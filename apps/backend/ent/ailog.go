@@ -25,7 +25,7 @@ type AILog struct {
 	UserID *uuid.UUID `json:"user_id,omitempty"`
 	// SessionID holds the value of the "session_id" field.
 	SessionID *uuid.UUID `json:"session_id,omitempty"`
-	// tag_extraction, mindmap, general
+	// tag_extraction, summarize, mindmap, general
 	TaskType string `json:"task_type,omitempty"`
 	// openai, claude, gemini
 	Provider string `json:"provider,omitempty"`
@@ -41,6 +41,8 @@ type AILog struct {
 	Content string `json:"content,omitempty"`
 	// InputTokens holds the value of the "input_tokens" field.
 	InputTokens int `json:"input_tokens,omitempty"`
+	// Input tokens read from the provider's prompt cache
+	CachedInputTokens int `json:"cached_input_tokens,omitempty"`
 	// OutputTokens holds the value of the "output_tokens" field.
 	OutputTokens int `json:"output_tokens,omitempty"`
 	// ThinkingTokens holds the value of the "thinking_tokens" field.
@@ -51,12 +53,14 @@ type AILog struct {
 	LatencyMs int64 `json:"latency_ms,omitempty"`
 	// Provider request ID for debugging
 	RequestID string `json:"request_id,omitempty"`
+	// Answered from the response cache, without calling the provider
+	Cached bool `json:"cached,omitempty"`
 	// Status holds the value of the "status" field.
 	Status ailog.Status `json:"status,omitempty"`
 	// ErrorMessage holds the value of the "error_message" field.
 	ErrorMessage string `json:"error_message,omitempty"`
-	// Estimated cost in cents
-	EstimatedCostCents int `json:"estimated_cost_cents,omitempty"`
+	// Cost in micro-cents, unset when the model has no price
+	CostMicroCents *int64 `json:"cost_micro_cents,omitempty"`
 	// Additional tracking metadata
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -109,7 +113,9 @@ func (*AILog) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case ailog.FieldMetadata:
 			values[i] = new([]byte)
-		case ailog.FieldInputTokens, ailog.FieldOutputTokens, ailog.FieldThinkingTokens, ailog.FieldTotalTokens, ailog.FieldLatencyMs, ailog.FieldEstimatedCostCents:
+		case ailog.FieldCached:
+			values[i] = new(sql.NullBool)
+		case ailog.FieldInputTokens, ailog.FieldCachedInputTokens, ailog.FieldOutputTokens, ailog.FieldThinkingTokens, ailog.FieldTotalTokens, ailog.FieldLatencyMs, ailog.FieldCostMicroCents:
 			values[i] = new(sql.NullInt64)
 		case ailog.FieldTaskType, ailog.FieldProvider, ailog.FieldModel, ailog.FieldSystemPrompt, ailog.FieldUserPrompt, ailog.FieldThinking, ailog.FieldContent, ailog.FieldRequestID, ailog.FieldStatus, ailog.FieldErrorMessage:
 			values[i] = new(sql.NullString)
@@ -200,6 +206,12 @@ func (_m *AILog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.InputTokens = int(value.Int64)
 			}
+		case ailog.FieldCachedInputTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cached_input_tokens", values[i])
+			} else if value.Valid {
+				_m.CachedInputTokens = int(value.Int64)
+			}
 		case ailog.FieldOutputTokens:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field output_tokens", values[i])
@@ -230,6 +242,12 @@ func (_m *AILog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RequestID = value.String
 			}
+		case ailog.FieldCached:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cached", values[i])
+			} else if value.Valid {
+				_m.Cached = value.Bool
+			}
 		case ailog.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -242,11 +260,12 @@ func (_m *AILog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ErrorMessage = value.String
 			}
-		case ailog.FieldEstimatedCostCents:
+		case ailog.FieldCostMicroCents:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for field estimated_cost_cents", values[i])
+				return fmt.Errorf("unexpected type %T for field cost_micro_cents", values[i])
 			} else if value.Valid {
-				_m.EstimatedCostCents = int(value.Int64)
+				_m.CostMicroCents = new(int64)
+				*_m.CostMicroCents = value.Int64
 			}
 		case ailog.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
@@ -342,6 +361,9 @@ func (_m *AILog) String() string {
 	builder.WriteString("input_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", _m.InputTokens))
 	builder.WriteString(", ")
+	builder.WriteString("cached_input_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CachedInputTokens))
+	builder.WriteString(", ")
 	builder.WriteString("output_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OutputTokens))
 	builder.WriteString(", ")
@@ -357,14 +379,19 @@ func (_m *AILog) String() string {
 	builder.WriteString("request_id=")
 	builder.WriteString(_m.RequestID)
 	builder.WriteString(", ")
+	builder.WriteString("cached=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cached))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("error_message=")
 	builder.WriteString(_m.ErrorMessage)
 	builder.WriteString(", ")
-	builder.WriteString("estimated_cost_cents=")
-	builder.WriteString(fmt.Sprintf("%v", _m.EstimatedCostCents))
+	if v := _m.CostMicroCents; v != nil {
+		builder.WriteString("cost_micro_cents=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
@@ -36,6 +36,8 @@ const (
 	FieldContent = "content"
 	// FieldInputTokens holds the string denoting the input_tokens field in the database.
 	FieldInputTokens = "input_tokens"
+	// FieldCachedInputTokens holds the string denoting the cached_input_tokens field in the database.
+	FieldCachedInputTokens = "cached_input_tokens"
 	// FieldOutputTokens holds the string denoting the output_tokens field in the database.
 	FieldOutputTokens = "output_tokens"
 	// FieldThinkingTokens holds the string denoting the thinking_tokens field in the database.
@@ -46,12 +48,14 @@ const (
 	FieldLatencyMs = "latency_ms"
 	// FieldRequestID holds the string denoting the request_id field in the database.
 	FieldRequestID = "request_id"
+	// FieldCached holds the string denoting the cached field in the database.
+	FieldCached = "cached"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
 	FieldErrorMessage = "error_message"
-	// FieldEstimatedCostCents holds the string denoting the estimated_cost_cents field in the database.
-	FieldEstimatedCostCents = "estimated_cost_cents"
+	// FieldCostMicroCents holds the string denoting the cost_micro_cents field in the database.
+	FieldCostMicroCents = "cost_micro_cents"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -91,14 +95,16 @@ var Columns = []string{
 	FieldThinking,
 	FieldContent,
 	FieldInputTokens,
+	FieldCachedInputTokens,
 	FieldOutputTokens,
 	FieldThinkingTokens,
 	FieldTotalTokens,
 	FieldLatencyMs,
 	FieldRequestID,
+	FieldCached,
 	FieldStatus,
 	FieldErrorMessage,
-	FieldEstimatedCostCents,
+	FieldCostMicroCents,
 	FieldMetadata,
 	FieldCreatedAt,
 }
@@ -122,6 +128,8 @@ var (
 	ModelValidator func(string) error
 	// DefaultInputTokens holds the default value on creation for the "input_tokens" field.
 	DefaultInputTokens int
+	// DefaultCachedInputTokens holds the default value on creation for the "cached_input_tokens" field.
+	DefaultCachedInputTokens int
 	// DefaultOutputTokens holds the default value on creation for the "output_tokens" field.
 	DefaultOutputTokens int
 	// DefaultThinkingTokens holds the default value on creation for the "thinking_tokens" field.
@@ -130,8 +138,8 @@ var (
 	DefaultTotalTokens int
 	// DefaultLatencyMs holds the default value on creation for the "latency_ms" field.
 	DefaultLatencyMs int64
-	// DefaultEstimatedCostCents holds the default value on creation for the "estimated_cost_cents" field.
-	DefaultEstimatedCostCents int
+	// DefaultCached holds the default value on creation for the "cached" field.
+	DefaultCached bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -223,6 +231,11 @@ func ByInputTokens(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInputTokens, opts...).ToFunc()
 }
 
+// ByCachedInputTokens orders the results by the cached_input_tokens field.
+func ByCachedInputTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCachedInputTokens, opts...).ToFunc()
+}
+
 // ByOutputTokens orders the results by the output_tokens field.
 func ByOutputTokens(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOutputTokens, opts...).ToFunc()
@@ -248,6 +261,11 @@ func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
 }
 
+// ByCached orders the results by the cached field.
+func ByCached(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCached, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -258,9 +276,9 @@ func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
 }
 
-// ByEstimatedCostCents orders the results by the estimated_cost_cents field.
-func ByEstimatedCostCents(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldEstimatedCostCents, opts...).ToFunc()
+// ByCostMicroCents orders the results by the cost_micro_cents field.
+func ByCostMicroCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostMicroCents, opts...).ToFunc()
 }
 
 // ByCreatedAt orders the results by the created_at field.
@@ -106,6 +106,11 @@ func InputTokens(v int) predicate.AILog {
 	return predicate.AILog(sql.FieldEQ(FieldInputTokens, v))
 }
 
+// CachedInputTokens applies equality check predicate on the "cached_input_tokens" field. It's identical to CachedInputTokensEQ.
+func CachedInputTokens(v int) predicate.AILog {
+	return predicate.AILog(sql.FieldEQ(FieldCachedInputTokens, v))
+}
+
 // OutputTokens applies equality check predicate on the "output_tokens" field. It's identical to OutputTokensEQ.
 func OutputTokens(v int) predicate.AILog {
 	return predicate.AILog(sql.FieldEQ(FieldOutputTokens, v))
@@ -131,14 +136,19 @@ func RequestID(v string) predicate.AILog {
 	return predicate.AILog(sql.FieldEQ(FieldRequestID, v))
 }
 
+// Cached applies equality check predicate on the "cached" field. It's identical to CachedEQ.
+func Cached(v bool) predicate.AILog {
+	return predicate.AILog(sql.FieldEQ(FieldCached, v))
+}
+
 // ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
 func ErrorMessage(v string) predicate.AILog {
 	return predicate.AILog(sql.FieldEQ(FieldErrorMessage, v))
 }
 
-// EstimatedCostCents applies equality check predicate on the "estimated_cost_cents" field. It's identical to EstimatedCostCentsEQ.
-func EstimatedCostCents(v int) predicate.AILog {
-	return predicate.AILog(sql.FieldEQ(FieldEstimatedCostCents, v))
+// CostMicroCents applies equality check predicate on the "cost_micro_cents" field. It's identical to CostMicroCentsEQ.
+func CostMicroCents(v int64) predicate.AILog {
+	return predicate.AILog(sql.FieldEQ(FieldCostMicroCents, v))
 }
 
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
@@ -741,6 +751,46 @@ func InputTokensLTE(v int) predicate.AILog {
 	return predicate.AILog(sql.FieldLTE(FieldInputTokens, v))
 }
 
+// CachedInputTokensEQ applies the EQ predicate on the "cached_input_tokens" field.
+func CachedInputTokensEQ(v int) predicate.AILog {
+	return predicate.AILog(sql.FieldEQ(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensNEQ applies the NEQ predicate on the "cached_input_tokens" field.
+func CachedInputTokensNEQ(v int) predicate.AILog {
+	return predicate.AILog(sql.FieldNEQ(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensIn applies the In predicate on the "cached_input_tokens" field.
+func CachedInputTokensIn(vs ...int) predicate.AILog {
+	return predicate.AILog(sql.FieldIn(FieldCachedInputTokens, vs...))
+}
+
+// CachedInputTokensNotIn applies the NotIn predicate on the "cached_input_tokens" field.
+func CachedInputTokensNotIn(vs ...int) predicate.AILog {
+	return predicate.AILog(sql.FieldNotIn(FieldCachedInputTokens, vs...))
+}
+
+// CachedInputTokensGT applies the GT predicate on the "cached_input_tokens" field.
+func CachedInputTokensGT(v int) predicate.AILog {
+	return predicate.AILog(sql.FieldGT(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensGTE applies the GTE predicate on the "cached_input_tokens" field.
+func CachedInputTokensGTE(v int) predicate.AILog {
+	return predicate.AILog(sql.FieldGTE(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensLT applies the LT predicate on the "cached_input_tokens" field.
+func CachedInputTokensLT(v int) predicate.AILog {
+	return predicate.AILog(sql.FieldLT(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensLTE applies the LTE predicate on the "cached_input_tokens" field.
+func CachedInputTokensLTE(v int) predicate.AILog {
+	return predicate.AILog(sql.FieldLTE(FieldCachedInputTokens, v))
+}
+
 // OutputTokensEQ applies the EQ predicate on the "output_tokens" field.
 func OutputTokensEQ(v int) predicate.AILog {
 	return predicate.AILog(sql.FieldEQ(FieldOutputTokens, v))
@@ -976,6 +1026,16 @@ func RequestIDContainsFold(v string) predicate.AILog {
 	return predicate.AILog(sql.FieldContainsFold(FieldRequestID, v))
 }
 
+// CachedEQ applies the EQ predicate on the "cached" field.
+func CachedEQ(v bool) predicate.AILog {
+	return predicate.AILog(sql.FieldEQ(FieldCached, v))
+}
+
+// CachedNEQ applies the NEQ predicate on the "cached" field.
+func CachedNEQ(v bool) predicate.AILog {
+	return predicate.AILog(sql.FieldNEQ(FieldCached, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.AILog {
 	return predicate.AILog(sql.FieldEQ(FieldStatus, v))
@@ -1071,44 +1131,54 @@ func ErrorMessageContainsFold(v string) predicate.AILog {
 	return predicate.AILog(sql.FieldContainsFold(FieldErrorMessage, v))
 }
 
-// EstimatedCostCentsEQ applies the EQ predicate on the "estimated_cost_cents" field.
-func EstimatedCostCentsEQ(v int) predicate.AILog {
-	return predicate.AILog(sql.FieldEQ(FieldEstimatedCostCents, v))
+// CostMicroCentsEQ applies the EQ predicate on the "cost_micro_cents" field.
+func CostMicroCentsEQ(v int64) predicate.AILog {
+	return predicate.AILog(sql.FieldEQ(FieldCostMicroCents, v))
+}
+
+// CostMicroCentsNEQ applies the NEQ predicate on the "cost_micro_cents" field.
+func CostMicroCentsNEQ(v int64) predicate.AILog {
+	return predicate.AILog(sql.FieldNEQ(FieldCostMicroCents, v))
+}
+
+// CostMicroCentsIn applies the In predicate on the "cost_micro_cents" field.
+func CostMicroCentsIn(vs ...int64) predicate.AILog {
+	return predicate.AILog(sql.FieldIn(FieldCostMicroCents, vs...))
 }
 
-// EstimatedCostCentsNEQ applies the NEQ predicate on the "estimated_cost_cents" field.
-func EstimatedCostCentsNEQ(v int) predicate.AILog {
-	return predicate.AILog(sql.FieldNEQ(FieldEstimatedCostCents, v))
+// CostMicroCentsNotIn applies the NotIn predicate on the "cost_micro_cents" field.
+func CostMicroCentsNotIn(vs ...int64) predicate.AILog {
+	return predicate.AILog(sql.FieldNotIn(FieldCostMicroCents, vs...))
 }
 
-// EstimatedCostCentsIn applies the In predicate on the "estimated_cost_cents" field.
-func EstimatedCostCentsIn(vs ...int) predicate.AILog {
-	return predicate.AILog(sql.FieldIn(FieldEstimatedCostCents, vs...))
+// CostMicroCentsGT applies the GT predicate on the "cost_micro_cents" field.
+func CostMicroCentsGT(v int64) predicate.AILog {
+	return predicate.AILog(sql.FieldGT(FieldCostMicroCents, v))
 }
 
-// EstimatedCostCentsNotIn applies the NotIn predicate on the "estimated_cost_cents" field.
-func EstimatedCostCentsNotIn(vs ...int) predicate.AILog {
-	return predicate.AILog(sql.FieldNotIn(FieldEstimatedCostCents, vs...))
+// CostMicroCentsGTE applies the GTE predicate on the "cost_micro_cents" field.
+func CostMicroCentsGTE(v int64) predicate.AILog {
+	return predicate.AILog(sql.FieldGTE(FieldCostMicroCents, v))
 }
 
-// EstimatedCostCentsGT applies the GT predicate on the "estimated_cost_cents" field.
-func EstimatedCostCentsGT(v int) predicate.AILog {
-	return predicate.AILog(sql.FieldGT(FieldEstimatedCostCents, v))
+// CostMicroCentsLT applies the LT predicate on the "cost_micro_cents" field.
+func CostMicroCentsLT(v int64) predicate.AILog {
+	return predicate.AILog(sql.FieldLT(FieldCostMicroCents, v))
 }
 
-// EstimatedCostCentsGTE applies the GTE predicate on the "estimated_cost_cents" field.
-func EstimatedCostCentsGTE(v int) predicate.AILog {
-	return predicate.AILog(sql.FieldGTE(FieldEstimatedCostCents, v))
+// CostMicroCentsLTE applies the LTE predicate on the "cost_micro_cents" field.
+func CostMicroCentsLTE(v int64) predicate.AILog {
+	return predicate.AILog(sql.FieldLTE(FieldCostMicroCents, v))
 }
 
-// EstimatedCostCentsLT applies the LT predicate on the "estimated_cost_cents" field.
-func EstimatedCostCentsLT(v int) predicate.AILog {
-	return predicate.AILog(sql.FieldLT(FieldEstimatedCostCents, v))
+// CostMicroCentsIsNil applies the IsNil predicate on the "cost_micro_cents" field.
+func CostMicroCentsIsNil() predicate.AILog {
+	return predicate.AILog(sql.FieldIsNull(FieldCostMicroCents))
 }
 
-// EstimatedCostCentsLTE applies the LTE predicate on the "estimated_cost_cents" field.
-func EstimatedCostCentsLTE(v int) predicate.AILog {
-	return predicate.AILog(sql.FieldLTE(FieldEstimatedCostCents, v))
+// CostMicroCentsNotNil applies the NotNil predicate on the "cost_micro_cents" field.
+func CostMicroCentsNotNil() predicate.AILog {
+	return predicate.AILog(sql.FieldNotNull(FieldCostMicroCents))
 }
 
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
@@ -139,6 +139,20 @@ func (_c *AILogCreate) SetNillableInputTokens(v *int) *AILogCreate {
 	return _c
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (_c *AILogCreate) SetCachedInputTokens(v int) *AILogCreate {
+	_c.mutation.SetCachedInputTokens(v)
+	return _c
+}
+
+// SetNillableCachedInputTokens sets the "cached_input_tokens" field if the given value is not nil.
+func (_c *AILogCreate) SetNillableCachedInputTokens(v *int) *AILogCreate {
+	if v != nil {
+		_c.SetCachedInputTokens(*v)
+	}
+	return _c
+}
+
 // SetOutputTokens sets the "output_tokens" field.
 func (_c *AILogCreate) SetOutputTokens(v int) *AILogCreate {
 	_c.mutation.SetOutputTokens(v)
@@ -209,6 +223,20 @@ func (_c *AILogCreate) SetNillableRequestID(v *string) *AILogCreate {
 	return _c
 }
 
+// SetCached sets the "cached" field.
+func (_c *AILogCreate) SetCached(v bool) *AILogCreate {
+	_c.mutation.SetCached(v)
+	return _c
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (_c *AILogCreate) SetNillableCached(v *bool) *AILogCreate {
+	if v != nil {
+		_c.SetCached(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *AILogCreate) SetStatus(v ailog.Status) *AILogCreate {
 	_c.mutation.SetStatus(v)
@@ -237,16 +265,16 @@ func (_c *AILogCreate) SetNillableErrorMessage(v *string) *AILogCreate {
 	return _c
 }
 
-// SetEstimatedCostCents sets the "estimated_cost_cents" field.
-func (_c *AILogCreate) SetEstimatedCostCents(v int) *AILogCreate {
-	_c.mutation.SetEstimatedCostCents(v)
+// SetCostMicroCents sets the "cost_micro_cents" field.
+func (_c *AILogCreate) SetCostMicroCents(v int64) *AILogCreate {
+	_c.mutation.SetCostMicroCents(v)
 	return _c
 }
 
-// SetNillableEstimatedCostCents sets the "estimated_cost_cents" field if the given value is not nil.
-func (_c *AILogCreate) SetNillableEstimatedCostCents(v *int) *AILogCreate {
+// SetNillableCostMicroCents sets the "cost_micro_cents" field if the given value is not nil.
+func (_c *AILogCreate) SetNillableCostMicroCents(v *int64) *AILogCreate {
 	if v != nil {
-		_c.SetEstimatedCostCents(*v)
+		_c.SetCostMicroCents(*v)
 	}
 	return _c
 }
@@ -334,6 +362,10 @@ func (_c *AILogCreate) defaults() {
 		v := ailog.DefaultInputTokens
 		_c.mutation.SetInputTokens(v)
 	}
+	if _, ok := _c.mutation.CachedInputTokens(); !ok {
+		v := ailog.DefaultCachedInputTokens
+		_c.mutation.SetCachedInputTokens(v)
+	}
 	if _, ok := _c.mutation.OutputTokens(); !ok {
 		v := ailog.DefaultOutputTokens
 		_c.mutation.SetOutputTokens(v)
@@ -350,14 +382,14 @@ func (_c *AILogCreate) defaults() {
 		v := ailog.DefaultLatencyMs
 		_c.mutation.SetLatencyMs(v)
 	}
+	if _, ok := _c.mutation.Cached(); !ok {
+		v := ailog.DefaultCached
+		_c.mutation.SetCached(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := ailog.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
-	if _, ok := _c.mutation.EstimatedCostCents(); !ok {
-		v := ailog.DefaultEstimatedCostCents
-		_c.mutation.SetEstimatedCostCents(v)
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := ailog.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -397,6 +429,9 @@ func (_c *AILogCreate) check() error {
 	if _, ok := _c.mutation.InputTokens(); !ok {
 		return &ValidationError{Name: "input_tokens", err: errors.New(`ent: missing required field "AILog.input_tokens"`)}
 	}
+	if _, ok := _c.mutation.CachedInputTokens(); !ok {
+		return &ValidationError{Name: "cached_input_tokens", err: errors.New(`ent: missing required field "AILog.cached_input_tokens"`)}
+	}
 	if _, ok := _c.mutation.OutputTokens(); !ok {
 		return &ValidationError{Name: "output_tokens", err: errors.New(`ent: missing required field "AILog.output_tokens"`)}
 	}
@@ -409,6 +444,9 @@ func (_c *AILogCreate) check() error {
 	if _, ok := _c.mutation.LatencyMs(); !ok {
 		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "AILog.latency_ms"`)}
 	}
+	if _, ok := _c.mutation.Cached(); !ok {
+		return &ValidationError{Name: "cached", err: errors.New(`ent: missing required field "AILog.cached"`)}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AILog.status"`)}
 	}
@@ -417,9 +455,6 @@ func (_c *AILogCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AILog.status": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.EstimatedCostCents(); !ok {
-		return &ValidationError{Name: "estimated_cost_cents", err: errors.New(`ent: missing required field "AILog.estimated_cost_cents"`)}
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AILog.created_at"`)}
 	}
@@ -490,6 +525,10 @@ func (_c *AILogCreate) createSpec() (*AILog, *sqlgraph.CreateSpec) {
 		_spec.SetField(ailog.FieldInputTokens, field.TypeInt, value)
 		_node.InputTokens = value
 	}
+	if value, ok := _c.mutation.CachedInputTokens(); ok {
+		_spec.SetField(ailog.FieldCachedInputTokens, field.TypeInt, value)
+		_node.CachedInputTokens = value
+	}
 	if value, ok := _c.mutation.OutputTokens(); ok {
 		_spec.SetField(ailog.FieldOutputTokens, field.TypeInt, value)
 		_node.OutputTokens = value
@@ -510,6 +549,10 @@ func (_c *AILogCreate) createSpec() (*AILog, *sqlgraph.CreateSpec) {
 		_spec.SetField(ailog.FieldRequestID, field.TypeString, value)
 		_node.RequestID = value
 	}
+	if value, ok := _c.mutation.Cached(); ok {
+		_spec.SetField(ailog.FieldCached, field.TypeBool, value)
+		_node.Cached = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(ailog.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -518,9 +561,9 @@ func (_c *AILogCreate) createSpec() (*AILog, *sqlgraph.CreateSpec) {
 		_spec.SetField(ailog.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = value
 	}
-	if value, ok := _c.mutation.EstimatedCostCents(); ok {
-		_spec.SetField(ailog.FieldEstimatedCostCents, field.TypeInt, value)
-		_node.EstimatedCostCents = value
+	if value, ok := _c.mutation.CostMicroCents(); ok {
+		_spec.SetField(ailog.FieldCostMicroCents, field.TypeInt64, value)
+		_node.CostMicroCents = &value
 	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(ailog.FieldMetadata, field.TypeJSON, value)
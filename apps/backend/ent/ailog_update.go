@@ -213,6 +213,27 @@ func (_u *AILogUpdate) AddInputTokens(v int) *AILogUpdate {
 	return _u
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (_u *AILogUpdate) SetCachedInputTokens(v int) *AILogUpdate {
+	_u.mutation.ResetCachedInputTokens()
+	_u.mutation.SetCachedInputTokens(v)
+	return _u
+}
+
+// SetNillableCachedInputTokens sets the "cached_input_tokens" field if the given value is not nil.
+func (_u *AILogUpdate) SetNillableCachedInputTokens(v *int) *AILogUpdate {
+	if v != nil {
+		_u.SetCachedInputTokens(*v)
+	}
+	return _u
+}
+
+// AddCachedInputTokens adds value to the "cached_input_tokens" field.
+func (_u *AILogUpdate) AddCachedInputTokens(v int) *AILogUpdate {
+	_u.mutation.AddCachedInputTokens(v)
+	return _u
+}
+
 // SetOutputTokens sets the "output_tokens" field.
 func (_u *AILogUpdate) SetOutputTokens(v int) *AILogUpdate {
 	_u.mutation.ResetOutputTokens()
@@ -317,6 +338,20 @@ func (_u *AILogUpdate) ClearRequestID() *AILogUpdate {
 	return _u
 }
 
+// SetCached sets the "cached" field.
+func (_u *AILogUpdate) SetCached(v bool) *AILogUpdate {
+	_u.mutation.SetCached(v)
+	return _u
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (_u *AILogUpdate) SetNillableCached(v *bool) *AILogUpdate {
+	if v != nil {
+		_u.SetCached(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *AILogUpdate) SetStatus(v ailog.Status) *AILogUpdate {
 	_u.mutation.SetStatus(v)
@@ -351,24 +386,30 @@ func (_u *AILogUpdate) ClearErrorMessage() *AILogUpdate {
 	return _u
 }
 
-// SetEstimatedCostCents sets the "estimated_cost_cents" field.
-func (_u *AILogUpdate) SetEstimatedCostCents(v int) *AILogUpdate {
-	_u.mutation.ResetEstimatedCostCents()
-	_u.mutation.SetEstimatedCostCents(v)
+// SetCostMicroCents sets the "cost_micro_cents" field.
+func (_u *AILogUpdate) SetCostMicroCents(v int64) *AILogUpdate {
+	_u.mutation.ResetCostMicroCents()
+	_u.mutation.SetCostMicroCents(v)
 	return _u
 }
 
-// SetNillableEstimatedCostCents sets the "estimated_cost_cents" field if the given value is not nil.
-func (_u *AILogUpdate) SetNillableEstimatedCostCents(v *int) *AILogUpdate {
+// SetNillableCostMicroCents sets the "cost_micro_cents" field if the given value is not nil.
+func (_u *AILogUpdate) SetNillableCostMicroCents(v *int64) *AILogUpdate {
 	if v != nil {
-		_u.SetEstimatedCostCents(*v)
+		_u.SetCostMicroCents(*v)
 	}
 	return _u
 }
 
-// AddEstimatedCostCents adds value to the "estimated_cost_cents" field.
-func (_u *AILogUpdate) AddEstimatedCostCents(v int) *AILogUpdate {
-	_u.mutation.AddEstimatedCostCents(v)
+// AddCostMicroCents adds value to the "cost_micro_cents" field.
+func (_u *AILogUpdate) AddCostMicroCents(v int64) *AILogUpdate {
+	_u.mutation.AddCostMicroCents(v)
+	return _u
+}
+
+// ClearCostMicroCents clears the value of the "cost_micro_cents" field.
+func (_u *AILogUpdate) ClearCostMicroCents() *AILogUpdate {
+	_u.mutation.ClearCostMicroCents()
 	return _u
 }
 
@@ -514,6 +555,12 @@ func (_u *AILogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedInputTokens(); ok {
 		_spec.AddField(ailog.FieldInputTokens, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.CachedInputTokens(); ok {
+		_spec.SetField(ailog.FieldCachedInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCachedInputTokens(); ok {
+		_spec.AddField(ailog.FieldCachedInputTokens, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.OutputTokens(); ok {
 		_spec.SetField(ailog.FieldOutputTokens, field.TypeInt, value)
 	}
@@ -544,6 +591,9 @@ func (_u *AILogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RequestIDCleared() {
 		_spec.ClearField(ailog.FieldRequestID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Cached(); ok {
+		_spec.SetField(ailog.FieldCached, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(ailog.FieldStatus, field.TypeEnum, value)
 	}
@@ -553,11 +603,14 @@ func (_u *AILogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(ailog.FieldErrorMessage, field.TypeString)
 	}
-	if value, ok := _u.mutation.EstimatedCostCents(); ok {
-		_spec.SetField(ailog.FieldEstimatedCostCents, field.TypeInt, value)
+	if value, ok := _u.mutation.CostMicroCents(); ok {
+		_spec.SetField(ailog.FieldCostMicroCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCostMicroCents(); ok {
+		_spec.AddField(ailog.FieldCostMicroCents, field.TypeInt64, value)
 	}
-	if value, ok := _u.mutation.AddedEstimatedCostCents(); ok {
-		_spec.AddField(ailog.FieldEstimatedCostCents, field.TypeInt, value)
+	if _u.mutation.CostMicroCentsCleared() {
+		_spec.ClearField(ailog.FieldCostMicroCents, field.TypeInt64)
 	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(ailog.FieldMetadata, field.TypeJSON, value)
@@ -826,6 +879,27 @@ func (_u *AILogUpdateOne) AddInputTokens(v int) *AILogUpdateOne {
 	return _u
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (_u *AILogUpdateOne) SetCachedInputTokens(v int) *AILogUpdateOne {
+	_u.mutation.ResetCachedInputTokens()
+	_u.mutation.SetCachedInputTokens(v)
+	return _u
+}
+
+// SetNillableCachedInputTokens sets the "cached_input_tokens" field if the given value is not nil.
+func (_u *AILogUpdateOne) SetNillableCachedInputTokens(v *int) *AILogUpdateOne {
+	if v != nil {
+		_u.SetCachedInputTokens(*v)
+	}
+	return _u
+}
+
+// AddCachedInputTokens adds value to the "cached_input_tokens" field.
+func (_u *AILogUpdateOne) AddCachedInputTokens(v int) *AILogUpdateOne {
+	_u.mutation.AddCachedInputTokens(v)
+	return _u
+}
+
 // SetOutputTokens sets the "output_tokens" field.
 func (_u *AILogUpdateOne) SetOutputTokens(v int) *AILogUpdateOne {
 	_u.mutation.ResetOutputTokens()
@@ -930,6 +1004,20 @@ func (_u *AILogUpdateOne) ClearRequestID() *AILogUpdateOne {
 	return _u
 }
 
+// SetCached sets the "cached" field.
+func (_u *AILogUpdateOne) SetCached(v bool) *AILogUpdateOne {
+	_u.mutation.SetCached(v)
+	return _u
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (_u *AILogUpdateOne) SetNillableCached(v *bool) *AILogUpdateOne {
+	if v != nil {
+		_u.SetCached(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *AILogUpdateOne) SetStatus(v ailog.Status) *AILogUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -964,24 +1052,30 @@ func (_u *AILogUpdateOne) ClearErrorMessage() *AILogUpdateOne {
 	return _u
 }
 
-// SetEstimatedCostCents sets the "estimated_cost_cents" field.
-func (_u *AILogUpdateOne) SetEstimatedCostCents(v int) *AILogUpdateOne {
-	_u.mutation.ResetEstimatedCostCents()
-	_u.mutation.SetEstimatedCostCents(v)
+// SetCostMicroCents sets the "cost_micro_cents" field.
+func (_u *AILogUpdateOne) SetCostMicroCents(v int64) *AILogUpdateOne {
+	_u.mutation.ResetCostMicroCents()
+	_u.mutation.SetCostMicroCents(v)
 	return _u
 }
 
-// SetNillableEstimatedCostCents sets the "estimated_cost_cents" field if the given value is not nil.
-func (_u *AILogUpdateOne) SetNillableEstimatedCostCents(v *int) *AILogUpdateOne {
+// SetNillableCostMicroCents sets the "cost_micro_cents" field if the given value is not nil.
+func (_u *AILogUpdateOne) SetNillableCostMicroCents(v *int64) *AILogUpdateOne {
 	if v != nil {
-		_u.SetEstimatedCostCents(*v)
+		_u.SetCostMicroCents(*v)
 	}
 	return _u
 }
 
-// AddEstimatedCostCents adds value to the "estimated_cost_cents" field.
-func (_u *AILogUpdateOne) AddEstimatedCostCents(v int) *AILogUpdateOne {
-	_u.mutation.AddEstimatedCostCents(v)
+// AddCostMicroCents adds value to the "cost_micro_cents" field.
+func (_u *AILogUpdateOne) AddCostMicroCents(v int64) *AILogUpdateOne {
+	_u.mutation.AddCostMicroCents(v)
+	return _u
+}
+
+// ClearCostMicroCents clears the value of the "cost_micro_cents" field.
+func (_u *AILogUpdateOne) ClearCostMicroCents() *AILogUpdateOne {
+	_u.mutation.ClearCostMicroCents()
 	return _u
 }
 
@@ -1157,6 +1251,12 @@ func (_u *AILogUpdateOne) sqlSave(ctx context.Context) (_node *AILog, err error)
 	if value, ok := _u.mutation.AddedInputTokens(); ok {
 		_spec.AddField(ailog.FieldInputTokens, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.CachedInputTokens(); ok {
+		_spec.SetField(ailog.FieldCachedInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCachedInputTokens(); ok {
+		_spec.AddField(ailog.FieldCachedInputTokens, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.OutputTokens(); ok {
 		_spec.SetField(ailog.FieldOutputTokens, field.TypeInt, value)
 	}
@@ -1187,6 +1287,9 @@ func (_u *AILogUpdateOne) sqlSave(ctx context.Context) (_node *AILog, err error)
 	if _u.mutation.RequestIDCleared() {
 		_spec.ClearField(ailog.FieldRequestID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Cached(); ok {
+		_spec.SetField(ailog.FieldCached, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(ailog.FieldStatus, field.TypeEnum, value)
 	}
@@ -1196,11 +1299,14 @@ func (_u *AILogUpdateOne) sqlSave(ctx context.Context) (_node *AILog, err error)
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(ailog.FieldErrorMessage, field.TypeString)
 	}
-	if value, ok := _u.mutation.EstimatedCostCents(); ok {
-		_spec.SetField(ailog.FieldEstimatedCostCents, field.TypeInt, value)
+	if value, ok := _u.mutation.CostMicroCents(); ok {
+		_spec.SetField(ailog.FieldCostMicroCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCostMicroCents(); ok {
+		_spec.AddField(ailog.FieldCostMicroCents, field.TypeInt64, value)
 	}
-	if value, ok := _u.mutation.AddedEstimatedCostCents(); ok {
-		_spec.AddField(ailog.FieldEstimatedCostCents, field.TypeInt, value)
+	if _u.mutation.CostMicroCentsCleared() {
+		_spec.ClearField(ailog.FieldCostMicroCents, field.TypeInt64)
 	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(ailog.FieldMetadata, field.TypeJSON, value)
@@ -18,16 +18,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mindhit/api/ent/aiconfig"
 	"github.com/mindhit/api/ent/ailog"
+	"github.com/mindhit/api/ent/emailverificationtoken"
 	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/mindmapedit"
 	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
+	"github.com/mindhit/api/ent/modelpricing"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/passwordresettoken"
 	"github.com/mindhit/api/ent/plan"
+	"github.com/mindhit/api/ent/prompttemplate"
 	"github.com/mindhit/api/ent/rawevent"
+	"github.com/mindhit/api/ent/refreshtoken"
 	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/ent/stripeevent"
 	"github.com/mindhit/api/ent/subscription"
 	"github.com/mindhit/api/ent/tokenusage"
 	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
 	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/ent/usersettings"
 )
@@ -41,26 +51,46 @@ type Client struct {
 	AIConfig *AIConfigClient
 	// AILog is the client for interacting with the AILog builders.
 	AILog *AILogClient
+	// EmailVerificationToken is the client for interacting with the EmailVerificationToken builders.
+	EmailVerificationToken *EmailVerificationTokenClient
 	// Highlight is the client for interacting with the Highlight builders.
 	Highlight *HighlightClient
+	// KnowledgeMention is the client for interacting with the KnowledgeMention builders.
+	KnowledgeMention *KnowledgeMentionClient
+	// KnowledgeTopic is the client for interacting with the KnowledgeTopic builders.
+	KnowledgeTopic *KnowledgeTopicClient
+	// MindmapEdit is the client for interacting with the MindmapEdit builders.
+	MindmapEdit *MindmapEditClient
 	// MindmapGraph is the client for interacting with the MindmapGraph builders.
 	MindmapGraph *MindmapGraphClient
+	// MindmapRevision is the client for interacting with the MindmapRevision builders.
+	MindmapRevision *MindmapRevisionClient
+	// ModelPricing is the client for interacting with the ModelPricing builders.
+	ModelPricing *ModelPricingClient
 	// PageVisit is the client for interacting with the PageVisit builders.
 	PageVisit *PageVisitClient
 	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
 	PasswordResetToken *PasswordResetTokenClient
 	// Plan is the client for interacting with the Plan builders.
 	Plan *PlanClient
+	// PromptTemplate is the client for interacting with the PromptTemplate builders.
+	PromptTemplate *PromptTemplateClient
 	// RawEvent is the client for interacting with the RawEvent builders.
 	RawEvent *RawEventClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
+	// StripeEvent is the client for interacting with the StripeEvent builders.
+	StripeEvent *StripeEventClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
 	// TokenUsage is the client for interacting with the TokenUsage builders.
 	TokenUsage *TokenUsageClient
 	// URL is the client for interacting with the URL builders.
 	URL *URLClient
+	// URLContent is the client for interacting with the URLContent builders.
+	URLContent *URLContentClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserSettings is the client for interacting with the UserSettings builders.
@@ -78,16 +108,26 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AIConfig = NewAIConfigClient(c.config)
 	c.AILog = NewAILogClient(c.config)
+	c.EmailVerificationToken = NewEmailVerificationTokenClient(c.config)
 	c.Highlight = NewHighlightClient(c.config)
+	c.KnowledgeMention = NewKnowledgeMentionClient(c.config)
+	c.KnowledgeTopic = NewKnowledgeTopicClient(c.config)
+	c.MindmapEdit = NewMindmapEditClient(c.config)
 	c.MindmapGraph = NewMindmapGraphClient(c.config)
+	c.MindmapRevision = NewMindmapRevisionClient(c.config)
+	c.ModelPricing = NewModelPricingClient(c.config)
 	c.PageVisit = NewPageVisitClient(c.config)
 	c.PasswordResetToken = NewPasswordResetTokenClient(c.config)
 	c.Plan = NewPlanClient(c.config)
+	c.PromptTemplate = NewPromptTemplateClient(c.config)
 	c.RawEvent = NewRawEventClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
 	c.Session = NewSessionClient(c.config)
+	c.StripeEvent = NewStripeEventClient(c.config)
 	c.Subscription = NewSubscriptionClient(c.config)
 	c.TokenUsage = NewTokenUsageClient(c.config)
 	c.URL = NewURLClient(c.config)
+	c.URLContent = NewURLContentClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserSettings = NewUserSettingsClient(c.config)
 }
@@ -180,22 +220,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		AIConfig:           NewAIConfigClient(cfg),
-		AILog:              NewAILogClient(cfg),
-		Highlight:          NewHighlightClient(cfg),
-		MindmapGraph:       NewMindmapGraphClient(cfg),
-		PageVisit:          NewPageVisitClient(cfg),
-		PasswordResetToken: NewPasswordResetTokenClient(cfg),
-		Plan:               NewPlanClient(cfg),
-		RawEvent:           NewRawEventClient(cfg),
-		Session:            NewSessionClient(cfg),
-		Subscription:       NewSubscriptionClient(cfg),
-		TokenUsage:         NewTokenUsageClient(cfg),
-		URL:                NewURLClient(cfg),
-		User:               NewUserClient(cfg),
-		UserSettings:       NewUserSettingsClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AIConfig:               NewAIConfigClient(cfg),
+		AILog:                  NewAILogClient(cfg),
+		EmailVerificationToken: NewEmailVerificationTokenClient(cfg),
+		Highlight:              NewHighlightClient(cfg),
+		KnowledgeMention:       NewKnowledgeMentionClient(cfg),
+		KnowledgeTopic:         NewKnowledgeTopicClient(cfg),
+		MindmapEdit:            NewMindmapEditClient(cfg),
+		MindmapGraph:           NewMindmapGraphClient(cfg),
+		MindmapRevision:        NewMindmapRevisionClient(cfg),
+		ModelPricing:           NewModelPricingClient(cfg),
+		PageVisit:              NewPageVisitClient(cfg),
+		PasswordResetToken:     NewPasswordResetTokenClient(cfg),
+		Plan:                   NewPlanClient(cfg),
+		PromptTemplate:         NewPromptTemplateClient(cfg),
+		RawEvent:               NewRawEventClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		Session:                NewSessionClient(cfg),
+		StripeEvent:            NewStripeEventClient(cfg),
+		Subscription:           NewSubscriptionClient(cfg),
+		TokenUsage:             NewTokenUsageClient(cfg),
+		URL:                    NewURLClient(cfg),
+		URLContent:             NewURLContentClient(cfg),
+		User:                   NewUserClient(cfg),
+		UserSettings:           NewUserSettingsClient(cfg),
 	}, nil
 }
 
@@ -213,22 +263,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		AIConfig:           NewAIConfigClient(cfg),
-		AILog:              NewAILogClient(cfg),
-		Highlight:          NewHighlightClient(cfg),
-		MindmapGraph:       NewMindmapGraphClient(cfg),
-		PageVisit:          NewPageVisitClient(cfg),
-		PasswordResetToken: NewPasswordResetTokenClient(cfg),
-		Plan:               NewPlanClient(cfg),
-		RawEvent:           NewRawEventClient(cfg),
-		Session:            NewSessionClient(cfg),
-		Subscription:       NewSubscriptionClient(cfg),
-		TokenUsage:         NewTokenUsageClient(cfg),
-		URL:                NewURLClient(cfg),
-		User:               NewUserClient(cfg),
-		UserSettings:       NewUserSettingsClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AIConfig:               NewAIConfigClient(cfg),
+		AILog:                  NewAILogClient(cfg),
+		EmailVerificationToken: NewEmailVerificationTokenClient(cfg),
+		Highlight:              NewHighlightClient(cfg),
+		KnowledgeMention:       NewKnowledgeMentionClient(cfg),
+		KnowledgeTopic:         NewKnowledgeTopicClient(cfg),
+		MindmapEdit:            NewMindmapEditClient(cfg),
+		MindmapGraph:           NewMindmapGraphClient(cfg),
+		MindmapRevision:        NewMindmapRevisionClient(cfg),
+		ModelPricing:           NewModelPricingClient(cfg),
+		PageVisit:              NewPageVisitClient(cfg),
+		PasswordResetToken:     NewPasswordResetTokenClient(cfg),
+		Plan:                   NewPlanClient(cfg),
+		PromptTemplate:         NewPromptTemplateClient(cfg),
+		RawEvent:               NewRawEventClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		Session:                NewSessionClient(cfg),
+		StripeEvent:            NewStripeEventClient(cfg),
+		Subscription:           NewSubscriptionClient(cfg),
+		TokenUsage:             NewTokenUsageClient(cfg),
+		URL:                    NewURLClient(cfg),
+		URLContent:             NewURLContentClient(cfg),
+		User:                   NewUserClient(cfg),
+		UserSettings:           NewUserSettingsClient(cfg),
 	}, nil
 }
 
@@ -258,9 +318,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AIConfig, c.AILog, c.Highlight, c.MindmapGraph, c.PageVisit,
-		c.PasswordResetToken, c.Plan, c.RawEvent, c.Session, c.Subscription,
-		c.TokenUsage, c.URL, c.User, c.UserSettings,
+		c.AIConfig, c.AILog, c.EmailVerificationToken, c.Highlight, c.KnowledgeMention,
+		c.KnowledgeTopic, c.MindmapEdit, c.MindmapGraph, c.MindmapRevision,
+		c.ModelPricing, c.PageVisit, c.PasswordResetToken, c.Plan, c.PromptTemplate,
+		c.RawEvent, c.RefreshToken, c.Session, c.StripeEvent, c.Subscription,
+		c.TokenUsage, c.URL, c.URLContent, c.User, c.UserSettings,
 	} {
 		n.Use(hooks...)
 	}
@@ -270,9 +332,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AIConfig, c.AILog, c.Highlight, c.MindmapGraph, c.PageVisit,
-		c.PasswordResetToken, c.Plan, c.RawEvent, c.Session, c.Subscription,
-		c.TokenUsage, c.URL, c.User, c.UserSettings,
+		c.AIConfig, c.AILog, c.EmailVerificationToken, c.Highlight, c.KnowledgeMention,
+		c.KnowledgeTopic, c.MindmapEdit, c.MindmapGraph, c.MindmapRevision,
+		c.ModelPricing, c.PageVisit, c.PasswordResetToken, c.Plan, c.PromptTemplate,
+		c.RawEvent, c.RefreshToken, c.Session, c.StripeEvent, c.Subscription,
+		c.TokenUsage, c.URL, c.URLContent, c.User, c.UserSettings,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -285,26 +349,46 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AIConfig.mutate(ctx, m)
 	case *AILogMutation:
 		return c.AILog.mutate(ctx, m)
+	case *EmailVerificationTokenMutation:
+		return c.EmailVerificationToken.mutate(ctx, m)
 	case *HighlightMutation:
 		return c.Highlight.mutate(ctx, m)
+	case *KnowledgeMentionMutation:
+		return c.KnowledgeMention.mutate(ctx, m)
+	case *KnowledgeTopicMutation:
+		return c.KnowledgeTopic.mutate(ctx, m)
+	case *MindmapEditMutation:
+		return c.MindmapEdit.mutate(ctx, m)
 	case *MindmapGraphMutation:
 		return c.MindmapGraph.mutate(ctx, m)
+	case *MindmapRevisionMutation:
+		return c.MindmapRevision.mutate(ctx, m)
+	case *ModelPricingMutation:
+		return c.ModelPricing.mutate(ctx, m)
 	case *PageVisitMutation:
 		return c.PageVisit.mutate(ctx, m)
 	case *PasswordResetTokenMutation:
 		return c.PasswordResetToken.mutate(ctx, m)
 	case *PlanMutation:
 		return c.Plan.mutate(ctx, m)
+	case *PromptTemplateMutation:
+		return c.PromptTemplate.mutate(ctx, m)
 	case *RawEventMutation:
 		return c.RawEvent.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
 	case *SessionMutation:
 		return c.Session.mutate(ctx, m)
+	case *StripeEventMutation:
+		return c.StripeEvent.mutate(ctx, m)
 	case *SubscriptionMutation:
 		return c.Subscription.mutate(ctx, m)
 	case *TokenUsageMutation:
 		return c.TokenUsage.mutate(ctx, m)
 	case *URLMutation:
 		return c.URL.mutate(ctx, m)
+	case *URLContentMutation:
+		return c.URLContent.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserSettingsMutation:
@@ -612,6 +696,155 @@ func (c *AILogClient) mutate(ctx context.Context, m *AILogMutation) (Value, erro
 	}
 }
 
+// EmailVerificationTokenClient is a client for the EmailVerificationToken schema.
+type EmailVerificationTokenClient struct {
+	config
+}
+
+// NewEmailVerificationTokenClient returns a client for the EmailVerificationToken from the given config.
+func NewEmailVerificationTokenClient(c config) *EmailVerificationTokenClient {
+	return &EmailVerificationTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailverificationtoken.Hooks(f(g(h())))`.
+func (c *EmailVerificationTokenClient) Use(hooks ...Hook) {
+	c.hooks.EmailVerificationToken = append(c.hooks.EmailVerificationToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailverificationtoken.Intercept(f(g(h())))`.
+func (c *EmailVerificationTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailVerificationToken = append(c.inters.EmailVerificationToken, interceptors...)
+}
+
+// Create returns a builder for creating a EmailVerificationToken entity.
+func (c *EmailVerificationTokenClient) Create() *EmailVerificationTokenCreate {
+	mutation := newEmailVerificationTokenMutation(c.config, OpCreate)
+	return &EmailVerificationTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailVerificationToken entities.
+func (c *EmailVerificationTokenClient) CreateBulk(builders ...*EmailVerificationTokenCreate) *EmailVerificationTokenCreateBulk {
+	return &EmailVerificationTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailVerificationTokenClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationTokenCreate, int)) *EmailVerificationTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailVerificationTokenCreateBulk{err: fmt.Errorf("calling to EmailVerificationTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailVerificationTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailVerificationTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailVerificationToken.
+func (c *EmailVerificationTokenClient) Update() *EmailVerificationTokenUpdate {
+	mutation := newEmailVerificationTokenMutation(c.config, OpUpdate)
+	return &EmailVerificationTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailVerificationTokenClient) UpdateOne(_m *EmailVerificationToken) *EmailVerificationTokenUpdateOne {
+	mutation := newEmailVerificationTokenMutation(c.config, OpUpdateOne, withEmailVerificationToken(_m))
+	return &EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailVerificationTokenClient) UpdateOneID(id uuid.UUID) *EmailVerificationTokenUpdateOne {
+	mutation := newEmailVerificationTokenMutation(c.config, OpUpdateOne, withEmailVerificationTokenID(id))
+	return &EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailVerificationToken.
+func (c *EmailVerificationTokenClient) Delete() *EmailVerificationTokenDelete {
+	mutation := newEmailVerificationTokenMutation(c.config, OpDelete)
+	return &EmailVerificationTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailVerificationTokenClient) DeleteOne(_m *EmailVerificationToken) *EmailVerificationTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailVerificationTokenClient) DeleteOneID(id uuid.UUID) *EmailVerificationTokenDeleteOne {
+	builder := c.Delete().Where(emailverificationtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailVerificationTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailVerificationToken.
+func (c *EmailVerificationTokenClient) Query() *EmailVerificationTokenQuery {
+	return &EmailVerificationTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailVerificationToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailVerificationToken entity by its id.
+func (c *EmailVerificationTokenClient) Get(ctx context.Context, id uuid.UUID) (*EmailVerificationToken, error) {
+	return c.Query().Where(emailverificationtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailVerificationTokenClient) GetX(ctx context.Context, id uuid.UUID) *EmailVerificationToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a EmailVerificationToken.
+func (c *EmailVerificationTokenClient) QueryUser(_m *EmailVerificationToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailverificationtoken.Table, emailverificationtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailverificationtoken.UserTable, emailverificationtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailVerificationTokenClient) Hooks() []Hook {
+	return c.hooks.EmailVerificationToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailVerificationTokenClient) Interceptors() []Interceptor {
+	return c.inters.EmailVerificationToken
+}
+
+func (c *EmailVerificationTokenClient) mutate(ctx context.Context, m *EmailVerificationTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailVerificationTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailVerificationTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailVerificationTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailVerificationToken mutation op: %q", m.Op())
+	}
+}
+
 // HighlightClient is a client for the Highlight schema.
 type HighlightClient struct {
 	config
@@ -742,9 +975,834 @@ func (c *HighlightClient) QueryPageVisit(_m *Highlight) *PageVisitQuery {
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(highlight.Table, highlight.FieldID, id),
-			sqlgraph.To(pagevisit.Table, pagevisit.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, highlight.PageVisitTable, highlight.PageVisitColumn),
+			sqlgraph.From(highlight.Table, highlight.FieldID, id),
+			sqlgraph.To(pagevisit.Table, pagevisit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, highlight.PageVisitTable, highlight.PageVisitColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HighlightClient) Hooks() []Hook {
+	return c.hooks.Highlight
+}
+
+// Interceptors returns the client interceptors.
+func (c *HighlightClient) Interceptors() []Interceptor {
+	return c.inters.Highlight
+}
+
+func (c *HighlightClient) mutate(ctx context.Context, m *HighlightMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HighlightCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HighlightUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HighlightUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HighlightDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Highlight mutation op: %q", m.Op())
+	}
+}
+
+// KnowledgeMentionClient is a client for the KnowledgeMention schema.
+type KnowledgeMentionClient struct {
+	config
+}
+
+// NewKnowledgeMentionClient returns a client for the KnowledgeMention from the given config.
+func NewKnowledgeMentionClient(c config) *KnowledgeMentionClient {
+	return &KnowledgeMentionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `knowledgemention.Hooks(f(g(h())))`.
+func (c *KnowledgeMentionClient) Use(hooks ...Hook) {
+	c.hooks.KnowledgeMention = append(c.hooks.KnowledgeMention, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `knowledgemention.Intercept(f(g(h())))`.
+func (c *KnowledgeMentionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KnowledgeMention = append(c.inters.KnowledgeMention, interceptors...)
+}
+
+// Create returns a builder for creating a KnowledgeMention entity.
+func (c *KnowledgeMentionClient) Create() *KnowledgeMentionCreate {
+	mutation := newKnowledgeMentionMutation(c.config, OpCreate)
+	return &KnowledgeMentionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KnowledgeMention entities.
+func (c *KnowledgeMentionClient) CreateBulk(builders ...*KnowledgeMentionCreate) *KnowledgeMentionCreateBulk {
+	return &KnowledgeMentionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KnowledgeMentionClient) MapCreateBulk(slice any, setFunc func(*KnowledgeMentionCreate, int)) *KnowledgeMentionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KnowledgeMentionCreateBulk{err: fmt.Errorf("calling to KnowledgeMentionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KnowledgeMentionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KnowledgeMentionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KnowledgeMention.
+func (c *KnowledgeMentionClient) Update() *KnowledgeMentionUpdate {
+	mutation := newKnowledgeMentionMutation(c.config, OpUpdate)
+	return &KnowledgeMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KnowledgeMentionClient) UpdateOne(_m *KnowledgeMention) *KnowledgeMentionUpdateOne {
+	mutation := newKnowledgeMentionMutation(c.config, OpUpdateOne, withKnowledgeMention(_m))
+	return &KnowledgeMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KnowledgeMentionClient) UpdateOneID(id uuid.UUID) *KnowledgeMentionUpdateOne {
+	mutation := newKnowledgeMentionMutation(c.config, OpUpdateOne, withKnowledgeMentionID(id))
+	return &KnowledgeMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KnowledgeMention.
+func (c *KnowledgeMentionClient) Delete() *KnowledgeMentionDelete {
+	mutation := newKnowledgeMentionMutation(c.config, OpDelete)
+	return &KnowledgeMentionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KnowledgeMentionClient) DeleteOne(_m *KnowledgeMention) *KnowledgeMentionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KnowledgeMentionClient) DeleteOneID(id uuid.UUID) *KnowledgeMentionDeleteOne {
+	builder := c.Delete().Where(knowledgemention.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KnowledgeMentionDeleteOne{builder}
+}
+
+// Query returns a query builder for KnowledgeMention.
+func (c *KnowledgeMentionClient) Query() *KnowledgeMentionQuery {
+	return &KnowledgeMentionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKnowledgeMention},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KnowledgeMention entity by its id.
+func (c *KnowledgeMentionClient) Get(ctx context.Context, id uuid.UUID) (*KnowledgeMention, error) {
+	return c.Query().Where(knowledgemention.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KnowledgeMentionClient) GetX(ctx context.Context, id uuid.UUID) *KnowledgeMention {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTopic queries the topic edge of a KnowledgeMention.
+func (c *KnowledgeMentionClient) QueryTopic(_m *KnowledgeMention) *KnowledgeTopicQuery {
+	query := (&KnowledgeTopicClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knowledgemention.Table, knowledgemention.FieldID, id),
+			sqlgraph.To(knowledgetopic.Table, knowledgetopic.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, knowledgemention.TopicTable, knowledgemention.TopicColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySession queries the session edge of a KnowledgeMention.
+func (c *KnowledgeMentionClient) QuerySession(_m *KnowledgeMention) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knowledgemention.Table, knowledgemention.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, knowledgemention.SessionTable, knowledgemention.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUrls queries the urls edge of a KnowledgeMention.
+func (c *KnowledgeMentionClient) QueryUrls(_m *KnowledgeMention) *URLQuery {
+	query := (&URLClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knowledgemention.Table, knowledgemention.FieldID, id),
+			sqlgraph.To(url.Table, url.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, knowledgemention.UrlsTable, knowledgemention.UrlsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KnowledgeMentionClient) Hooks() []Hook {
+	return c.hooks.KnowledgeMention
+}
+
+// Interceptors returns the client interceptors.
+func (c *KnowledgeMentionClient) Interceptors() []Interceptor {
+	return c.inters.KnowledgeMention
+}
+
+func (c *KnowledgeMentionClient) mutate(ctx context.Context, m *KnowledgeMentionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KnowledgeMentionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KnowledgeMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KnowledgeMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KnowledgeMentionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KnowledgeMention mutation op: %q", m.Op())
+	}
+}
+
+// KnowledgeTopicClient is a client for the KnowledgeTopic schema.
+type KnowledgeTopicClient struct {
+	config
+}
+
+// NewKnowledgeTopicClient returns a client for the KnowledgeTopic from the given config.
+func NewKnowledgeTopicClient(c config) *KnowledgeTopicClient {
+	return &KnowledgeTopicClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `knowledgetopic.Hooks(f(g(h())))`.
+func (c *KnowledgeTopicClient) Use(hooks ...Hook) {
+	c.hooks.KnowledgeTopic = append(c.hooks.KnowledgeTopic, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `knowledgetopic.Intercept(f(g(h())))`.
+func (c *KnowledgeTopicClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KnowledgeTopic = append(c.inters.KnowledgeTopic, interceptors...)
+}
+
+// Create returns a builder for creating a KnowledgeTopic entity.
+func (c *KnowledgeTopicClient) Create() *KnowledgeTopicCreate {
+	mutation := newKnowledgeTopicMutation(c.config, OpCreate)
+	return &KnowledgeTopicCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KnowledgeTopic entities.
+func (c *KnowledgeTopicClient) CreateBulk(builders ...*KnowledgeTopicCreate) *KnowledgeTopicCreateBulk {
+	return &KnowledgeTopicCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KnowledgeTopicClient) MapCreateBulk(slice any, setFunc func(*KnowledgeTopicCreate, int)) *KnowledgeTopicCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KnowledgeTopicCreateBulk{err: fmt.Errorf("calling to KnowledgeTopicClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KnowledgeTopicCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KnowledgeTopicCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KnowledgeTopic.
+func (c *KnowledgeTopicClient) Update() *KnowledgeTopicUpdate {
+	mutation := newKnowledgeTopicMutation(c.config, OpUpdate)
+	return &KnowledgeTopicUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KnowledgeTopicClient) UpdateOne(_m *KnowledgeTopic) *KnowledgeTopicUpdateOne {
+	mutation := newKnowledgeTopicMutation(c.config, OpUpdateOne, withKnowledgeTopic(_m))
+	return &KnowledgeTopicUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KnowledgeTopicClient) UpdateOneID(id uuid.UUID) *KnowledgeTopicUpdateOne {
+	mutation := newKnowledgeTopicMutation(c.config, OpUpdateOne, withKnowledgeTopicID(id))
+	return &KnowledgeTopicUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KnowledgeTopic.
+func (c *KnowledgeTopicClient) Delete() *KnowledgeTopicDelete {
+	mutation := newKnowledgeTopicMutation(c.config, OpDelete)
+	return &KnowledgeTopicDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KnowledgeTopicClient) DeleteOne(_m *KnowledgeTopic) *KnowledgeTopicDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KnowledgeTopicClient) DeleteOneID(id uuid.UUID) *KnowledgeTopicDeleteOne {
+	builder := c.Delete().Where(knowledgetopic.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KnowledgeTopicDeleteOne{builder}
+}
+
+// Query returns a query builder for KnowledgeTopic.
+func (c *KnowledgeTopicClient) Query() *KnowledgeTopicQuery {
+	return &KnowledgeTopicQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKnowledgeTopic},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KnowledgeTopic entity by its id.
+func (c *KnowledgeTopicClient) Get(ctx context.Context, id uuid.UUID) (*KnowledgeTopic, error) {
+	return c.Query().Where(knowledgetopic.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KnowledgeTopicClient) GetX(ctx context.Context, id uuid.UUID) *KnowledgeTopic {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a KnowledgeTopic.
+func (c *KnowledgeTopicClient) QueryUser(_m *KnowledgeTopic) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knowledgetopic.Table, knowledgetopic.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, knowledgetopic.UserTable, knowledgetopic.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentions queries the mentions edge of a KnowledgeTopic.
+func (c *KnowledgeTopicClient) QueryMentions(_m *KnowledgeTopic) *KnowledgeMentionQuery {
+	query := (&KnowledgeMentionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knowledgetopic.Table, knowledgetopic.FieldID, id),
+			sqlgraph.To(knowledgemention.Table, knowledgemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, knowledgetopic.MentionsTable, knowledgetopic.MentionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KnowledgeTopicClient) Hooks() []Hook {
+	return c.hooks.KnowledgeTopic
+}
+
+// Interceptors returns the client interceptors.
+func (c *KnowledgeTopicClient) Interceptors() []Interceptor {
+	return c.inters.KnowledgeTopic
+}
+
+func (c *KnowledgeTopicClient) mutate(ctx context.Context, m *KnowledgeTopicMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KnowledgeTopicCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KnowledgeTopicUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KnowledgeTopicUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KnowledgeTopicDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KnowledgeTopic mutation op: %q", m.Op())
+	}
+}
+
+// MindmapEditClient is a client for the MindmapEdit schema.
+type MindmapEditClient struct {
+	config
+}
+
+// NewMindmapEditClient returns a client for the MindmapEdit from the given config.
+func NewMindmapEditClient(c config) *MindmapEditClient {
+	return &MindmapEditClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mindmapedit.Hooks(f(g(h())))`.
+func (c *MindmapEditClient) Use(hooks ...Hook) {
+	c.hooks.MindmapEdit = append(c.hooks.MindmapEdit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mindmapedit.Intercept(f(g(h())))`.
+func (c *MindmapEditClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MindmapEdit = append(c.inters.MindmapEdit, interceptors...)
+}
+
+// Create returns a builder for creating a MindmapEdit entity.
+func (c *MindmapEditClient) Create() *MindmapEditCreate {
+	mutation := newMindmapEditMutation(c.config, OpCreate)
+	return &MindmapEditCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MindmapEdit entities.
+func (c *MindmapEditClient) CreateBulk(builders ...*MindmapEditCreate) *MindmapEditCreateBulk {
+	return &MindmapEditCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MindmapEditClient) MapCreateBulk(slice any, setFunc func(*MindmapEditCreate, int)) *MindmapEditCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MindmapEditCreateBulk{err: fmt.Errorf("calling to MindmapEditClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MindmapEditCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MindmapEditCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MindmapEdit.
+func (c *MindmapEditClient) Update() *MindmapEditUpdate {
+	mutation := newMindmapEditMutation(c.config, OpUpdate)
+	return &MindmapEditUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MindmapEditClient) UpdateOne(_m *MindmapEdit) *MindmapEditUpdateOne {
+	mutation := newMindmapEditMutation(c.config, OpUpdateOne, withMindmapEdit(_m))
+	return &MindmapEditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MindmapEditClient) UpdateOneID(id int) *MindmapEditUpdateOne {
+	mutation := newMindmapEditMutation(c.config, OpUpdateOne, withMindmapEditID(id))
+	return &MindmapEditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MindmapEdit.
+func (c *MindmapEditClient) Delete() *MindmapEditDelete {
+	mutation := newMindmapEditMutation(c.config, OpDelete)
+	return &MindmapEditDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MindmapEditClient) DeleteOne(_m *MindmapEdit) *MindmapEditDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MindmapEditClient) DeleteOneID(id int) *MindmapEditDeleteOne {
+	builder := c.Delete().Where(mindmapedit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MindmapEditDeleteOne{builder}
+}
+
+// Query returns a query builder for MindmapEdit.
+func (c *MindmapEditClient) Query() *MindmapEditQuery {
+	return &MindmapEditQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMindmapEdit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MindmapEdit entity by its id.
+func (c *MindmapEditClient) Get(ctx context.Context, id int) (*MindmapEdit, error) {
+	return c.Query().Where(mindmapedit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MindmapEditClient) GetX(ctx context.Context, id int) *MindmapEdit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMindmap queries the mindmap edge of a MindmapEdit.
+func (c *MindmapEditClient) QueryMindmap(_m *MindmapEdit) *MindmapGraphQuery {
+	query := (&MindmapGraphClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mindmapedit.Table, mindmapedit.FieldID, id),
+			sqlgraph.To(mindmapgraph.Table, mindmapgraph.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mindmapedit.MindmapTable, mindmapedit.MindmapColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MindmapEditClient) Hooks() []Hook {
+	return c.hooks.MindmapEdit
+}
+
+// Interceptors returns the client interceptors.
+func (c *MindmapEditClient) Interceptors() []Interceptor {
+	return c.inters.MindmapEdit
+}
+
+func (c *MindmapEditClient) mutate(ctx context.Context, m *MindmapEditMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MindmapEditCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MindmapEditUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MindmapEditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MindmapEditDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MindmapEdit mutation op: %q", m.Op())
+	}
+}
+
+// MindmapGraphClient is a client for the MindmapGraph schema.
+type MindmapGraphClient struct {
+	config
+}
+
+// NewMindmapGraphClient returns a client for the MindmapGraph from the given config.
+func NewMindmapGraphClient(c config) *MindmapGraphClient {
+	return &MindmapGraphClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mindmapgraph.Hooks(f(g(h())))`.
+func (c *MindmapGraphClient) Use(hooks ...Hook) {
+	c.hooks.MindmapGraph = append(c.hooks.MindmapGraph, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mindmapgraph.Intercept(f(g(h())))`.
+func (c *MindmapGraphClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MindmapGraph = append(c.inters.MindmapGraph, interceptors...)
+}
+
+// Create returns a builder for creating a MindmapGraph entity.
+func (c *MindmapGraphClient) Create() *MindmapGraphCreate {
+	mutation := newMindmapGraphMutation(c.config, OpCreate)
+	return &MindmapGraphCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MindmapGraph entities.
+func (c *MindmapGraphClient) CreateBulk(builders ...*MindmapGraphCreate) *MindmapGraphCreateBulk {
+	return &MindmapGraphCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MindmapGraphClient) MapCreateBulk(slice any, setFunc func(*MindmapGraphCreate, int)) *MindmapGraphCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MindmapGraphCreateBulk{err: fmt.Errorf("calling to MindmapGraphClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MindmapGraphCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MindmapGraphCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MindmapGraph.
+func (c *MindmapGraphClient) Update() *MindmapGraphUpdate {
+	mutation := newMindmapGraphMutation(c.config, OpUpdate)
+	return &MindmapGraphUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MindmapGraphClient) UpdateOne(_m *MindmapGraph) *MindmapGraphUpdateOne {
+	mutation := newMindmapGraphMutation(c.config, OpUpdateOne, withMindmapGraph(_m))
+	return &MindmapGraphUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MindmapGraphClient) UpdateOneID(id uuid.UUID) *MindmapGraphUpdateOne {
+	mutation := newMindmapGraphMutation(c.config, OpUpdateOne, withMindmapGraphID(id))
+	return &MindmapGraphUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MindmapGraph.
+func (c *MindmapGraphClient) Delete() *MindmapGraphDelete {
+	mutation := newMindmapGraphMutation(c.config, OpDelete)
+	return &MindmapGraphDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MindmapGraphClient) DeleteOne(_m *MindmapGraph) *MindmapGraphDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MindmapGraphClient) DeleteOneID(id uuid.UUID) *MindmapGraphDeleteOne {
+	builder := c.Delete().Where(mindmapgraph.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MindmapGraphDeleteOne{builder}
+}
+
+// Query returns a query builder for MindmapGraph.
+func (c *MindmapGraphClient) Query() *MindmapGraphQuery {
+	return &MindmapGraphQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMindmapGraph},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MindmapGraph entity by its id.
+func (c *MindmapGraphClient) Get(ctx context.Context, id uuid.UUID) (*MindmapGraph, error) {
+	return c.Query().Where(mindmapgraph.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MindmapGraphClient) GetX(ctx context.Context, id uuid.UUID) *MindmapGraph {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a MindmapGraph.
+func (c *MindmapGraphClient) QuerySession(_m *MindmapGraph) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mindmapgraph.Table, mindmapgraph.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, mindmapgraph.SessionTable, mindmapgraph.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a MindmapGraph.
+func (c *MindmapGraphClient) QueryRevisions(_m *MindmapGraph) *MindmapRevisionQuery {
+	query := (&MindmapRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mindmapgraph.Table, mindmapgraph.FieldID, id),
+			sqlgraph.To(mindmaprevision.Table, mindmaprevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, mindmapgraph.RevisionsTable, mindmapgraph.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEdits queries the edits edge of a MindmapGraph.
+func (c *MindmapGraphClient) QueryEdits(_m *MindmapGraph) *MindmapEditQuery {
+	query := (&MindmapEditClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mindmapgraph.Table, mindmapgraph.FieldID, id),
+			sqlgraph.To(mindmapedit.Table, mindmapedit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, mindmapgraph.EditsTable, mindmapgraph.EditsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MindmapGraphClient) Hooks() []Hook {
+	return c.hooks.MindmapGraph
+}
+
+// Interceptors returns the client interceptors.
+func (c *MindmapGraphClient) Interceptors() []Interceptor {
+	return c.inters.MindmapGraph
+}
+
+func (c *MindmapGraphClient) mutate(ctx context.Context, m *MindmapGraphMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MindmapGraphCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MindmapGraphUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MindmapGraphUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MindmapGraphDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MindmapGraph mutation op: %q", m.Op())
+	}
+}
+
+// MindmapRevisionClient is a client for the MindmapRevision schema.
+type MindmapRevisionClient struct {
+	config
+}
+
+// NewMindmapRevisionClient returns a client for the MindmapRevision from the given config.
+func NewMindmapRevisionClient(c config) *MindmapRevisionClient {
+	return &MindmapRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mindmaprevision.Hooks(f(g(h())))`.
+func (c *MindmapRevisionClient) Use(hooks ...Hook) {
+	c.hooks.MindmapRevision = append(c.hooks.MindmapRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mindmaprevision.Intercept(f(g(h())))`.
+func (c *MindmapRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MindmapRevision = append(c.inters.MindmapRevision, interceptors...)
+}
+
+// Create returns a builder for creating a MindmapRevision entity.
+func (c *MindmapRevisionClient) Create() *MindmapRevisionCreate {
+	mutation := newMindmapRevisionMutation(c.config, OpCreate)
+	return &MindmapRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MindmapRevision entities.
+func (c *MindmapRevisionClient) CreateBulk(builders ...*MindmapRevisionCreate) *MindmapRevisionCreateBulk {
+	return &MindmapRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MindmapRevisionClient) MapCreateBulk(slice any, setFunc func(*MindmapRevisionCreate, int)) *MindmapRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MindmapRevisionCreateBulk{err: fmt.Errorf("calling to MindmapRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MindmapRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MindmapRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MindmapRevision.
+func (c *MindmapRevisionClient) Update() *MindmapRevisionUpdate {
+	mutation := newMindmapRevisionMutation(c.config, OpUpdate)
+	return &MindmapRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MindmapRevisionClient) UpdateOne(_m *MindmapRevision) *MindmapRevisionUpdateOne {
+	mutation := newMindmapRevisionMutation(c.config, OpUpdateOne, withMindmapRevision(_m))
+	return &MindmapRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MindmapRevisionClient) UpdateOneID(id uuid.UUID) *MindmapRevisionUpdateOne {
+	mutation := newMindmapRevisionMutation(c.config, OpUpdateOne, withMindmapRevisionID(id))
+	return &MindmapRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MindmapRevision.
+func (c *MindmapRevisionClient) Delete() *MindmapRevisionDelete {
+	mutation := newMindmapRevisionMutation(c.config, OpDelete)
+	return &MindmapRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MindmapRevisionClient) DeleteOne(_m *MindmapRevision) *MindmapRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MindmapRevisionClient) DeleteOneID(id uuid.UUID) *MindmapRevisionDeleteOne {
+	builder := c.Delete().Where(mindmaprevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MindmapRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for MindmapRevision.
+func (c *MindmapRevisionClient) Query() *MindmapRevisionQuery {
+	return &MindmapRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMindmapRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MindmapRevision entity by its id.
+func (c *MindmapRevisionClient) Get(ctx context.Context, id uuid.UUID) (*MindmapRevision, error) {
+	return c.Query().Where(mindmaprevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MindmapRevisionClient) GetX(ctx context.Context, id uuid.UUID) *MindmapRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMindmap queries the mindmap edge of a MindmapRevision.
+func (c *MindmapRevisionClient) QueryMindmap(_m *MindmapRevision) *MindmapGraphQuery {
+	query := (&MindmapGraphClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mindmaprevision.Table, mindmaprevision.FieldID, id),
+			sqlgraph.To(mindmapgraph.Table, mindmapgraph.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mindmaprevision.MindmapTable, mindmaprevision.MindmapColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -753,131 +1811,131 @@ func (c *HighlightClient) QueryPageVisit(_m *Highlight) *PageVisitQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *HighlightClient) Hooks() []Hook {
-	return c.hooks.Highlight
+func (c *MindmapRevisionClient) Hooks() []Hook {
+	return c.hooks.MindmapRevision
 }
 
 // Interceptors returns the client interceptors.
-func (c *HighlightClient) Interceptors() []Interceptor {
-	return c.inters.Highlight
+func (c *MindmapRevisionClient) Interceptors() []Interceptor {
+	return c.inters.MindmapRevision
 }
 
-func (c *HighlightClient) mutate(ctx context.Context, m *HighlightMutation) (Value, error) {
+func (c *MindmapRevisionClient) mutate(ctx context.Context, m *MindmapRevisionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HighlightCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MindmapRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HighlightUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MindmapRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HighlightUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MindmapRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HighlightDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MindmapRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Highlight mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MindmapRevision mutation op: %q", m.Op())
 	}
 }
 
-// MindmapGraphClient is a client for the MindmapGraph schema.
-type MindmapGraphClient struct {
+// ModelPricingClient is a client for the ModelPricing schema.
+type ModelPricingClient struct {
 	config
 }
 
-// NewMindmapGraphClient returns a client for the MindmapGraph from the given config.
-func NewMindmapGraphClient(c config) *MindmapGraphClient {
-	return &MindmapGraphClient{config: c}
+// NewModelPricingClient returns a client for the ModelPricing from the given config.
+func NewModelPricingClient(c config) *ModelPricingClient {
+	return &ModelPricingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `mindmapgraph.Hooks(f(g(h())))`.
-func (c *MindmapGraphClient) Use(hooks ...Hook) {
-	c.hooks.MindmapGraph = append(c.hooks.MindmapGraph, hooks...)
+// A call to `Use(f, g, h)` equals to `modelpricing.Hooks(f(g(h())))`.
+func (c *ModelPricingClient) Use(hooks ...Hook) {
+	c.hooks.ModelPricing = append(c.hooks.ModelPricing, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `mindmapgraph.Intercept(f(g(h())))`.
-func (c *MindmapGraphClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MindmapGraph = append(c.inters.MindmapGraph, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `modelpricing.Intercept(f(g(h())))`.
+func (c *ModelPricingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModelPricing = append(c.inters.ModelPricing, interceptors...)
 }
 
-// Create returns a builder for creating a MindmapGraph entity.
-func (c *MindmapGraphClient) Create() *MindmapGraphCreate {
-	mutation := newMindmapGraphMutation(c.config, OpCreate)
-	return &MindmapGraphCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ModelPricing entity.
+func (c *ModelPricingClient) Create() *ModelPricingCreate {
+	mutation := newModelPricingMutation(c.config, OpCreate)
+	return &ModelPricingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MindmapGraph entities.
-func (c *MindmapGraphClient) CreateBulk(builders ...*MindmapGraphCreate) *MindmapGraphCreateBulk {
-	return &MindmapGraphCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ModelPricing entities.
+func (c *ModelPricingClient) CreateBulk(builders ...*ModelPricingCreate) *ModelPricingCreateBulk {
+	return &ModelPricingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MindmapGraphClient) MapCreateBulk(slice any, setFunc func(*MindmapGraphCreate, int)) *MindmapGraphCreateBulk {
+func (c *ModelPricingClient) MapCreateBulk(slice any, setFunc func(*ModelPricingCreate, int)) *ModelPricingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MindmapGraphCreateBulk{err: fmt.Errorf("calling to MindmapGraphClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ModelPricingCreateBulk{err: fmt.Errorf("calling to ModelPricingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MindmapGraphCreate, rv.Len())
+	builders := make([]*ModelPricingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MindmapGraphCreateBulk{config: c.config, builders: builders}
+	return &ModelPricingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MindmapGraph.
-func (c *MindmapGraphClient) Update() *MindmapGraphUpdate {
-	mutation := newMindmapGraphMutation(c.config, OpUpdate)
-	return &MindmapGraphUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ModelPricing.
+func (c *ModelPricingClient) Update() *ModelPricingUpdate {
+	mutation := newModelPricingMutation(c.config, OpUpdate)
+	return &ModelPricingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MindmapGraphClient) UpdateOne(_m *MindmapGraph) *MindmapGraphUpdateOne {
-	mutation := newMindmapGraphMutation(c.config, OpUpdateOne, withMindmapGraph(_m))
-	return &MindmapGraphUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ModelPricingClient) UpdateOne(_m *ModelPricing) *ModelPricingUpdateOne {
+	mutation := newModelPricingMutation(c.config, OpUpdateOne, withModelPricing(_m))
+	return &ModelPricingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MindmapGraphClient) UpdateOneID(id uuid.UUID) *MindmapGraphUpdateOne {
-	mutation := newMindmapGraphMutation(c.config, OpUpdateOne, withMindmapGraphID(id))
-	return &MindmapGraphUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ModelPricingClient) UpdateOneID(id int) *ModelPricingUpdateOne {
+	mutation := newModelPricingMutation(c.config, OpUpdateOne, withModelPricingID(id))
+	return &ModelPricingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MindmapGraph.
-func (c *MindmapGraphClient) Delete() *MindmapGraphDelete {
-	mutation := newMindmapGraphMutation(c.config, OpDelete)
-	return &MindmapGraphDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ModelPricing.
+func (c *ModelPricingClient) Delete() *ModelPricingDelete {
+	mutation := newModelPricingMutation(c.config, OpDelete)
+	return &ModelPricingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MindmapGraphClient) DeleteOne(_m *MindmapGraph) *MindmapGraphDeleteOne {
+func (c *ModelPricingClient) DeleteOne(_m *ModelPricing) *ModelPricingDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MindmapGraphClient) DeleteOneID(id uuid.UUID) *MindmapGraphDeleteOne {
-	builder := c.Delete().Where(mindmapgraph.ID(id))
+func (c *ModelPricingClient) DeleteOneID(id int) *ModelPricingDeleteOne {
+	builder := c.Delete().Where(modelpricing.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MindmapGraphDeleteOne{builder}
+	return &ModelPricingDeleteOne{builder}
 }
 
-// Query returns a query builder for MindmapGraph.
-func (c *MindmapGraphClient) Query() *MindmapGraphQuery {
-	return &MindmapGraphQuery{
+// Query returns a query builder for ModelPricing.
+func (c *ModelPricingClient) Query() *ModelPricingQuery {
+	return &ModelPricingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMindmapGraph},
+		ctx:    &QueryContext{Type: TypeModelPricing},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MindmapGraph entity by its id.
-func (c *MindmapGraphClient) Get(ctx context.Context, id uuid.UUID) (*MindmapGraph, error) {
-	return c.Query().Where(mindmapgraph.ID(id)).Only(ctx)
+// Get returns a ModelPricing entity by its id.
+func (c *ModelPricingClient) Get(ctx context.Context, id int) (*ModelPricing, error) {
+	return c.Query().Where(modelpricing.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MindmapGraphClient) GetX(ctx context.Context, id uuid.UUID) *MindmapGraph {
+func (c *ModelPricingClient) GetX(ctx context.Context, id int) *ModelPricing {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -885,44 +1943,28 @@ func (c *MindmapGraphClient) GetX(ctx context.Context, id uuid.UUID) *MindmapGra
 	return obj
 }
 
-// QuerySession queries the session edge of a MindmapGraph.
-func (c *MindmapGraphClient) QuerySession(_m *MindmapGraph) *SessionQuery {
-	query := (&SessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(mindmapgraph.Table, mindmapgraph.FieldID, id),
-			sqlgraph.To(session.Table, session.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, mindmapgraph.SessionTable, mindmapgraph.SessionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *MindmapGraphClient) Hooks() []Hook {
-	return c.hooks.MindmapGraph
+func (c *ModelPricingClient) Hooks() []Hook {
+	return c.hooks.ModelPricing
 }
 
 // Interceptors returns the client interceptors.
-func (c *MindmapGraphClient) Interceptors() []Interceptor {
-	return c.inters.MindmapGraph
+func (c *ModelPricingClient) Interceptors() []Interceptor {
+	return c.inters.ModelPricing
 }
 
-func (c *MindmapGraphClient) mutate(ctx context.Context, m *MindmapGraphMutation) (Value, error) {
+func (c *ModelPricingClient) mutate(ctx context.Context, m *ModelPricingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MindmapGraphCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ModelPricingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MindmapGraphUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ModelPricingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MindmapGraphUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ModelPricingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MindmapGraphDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ModelPricingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MindmapGraph mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ModelPricing mutation op: %q", m.Op())
 	}
 }
 
@@ -1389,6 +2431,139 @@ func (c *PlanClient) mutate(ctx context.Context, m *PlanMutation) (Value, error)
 	}
 }
 
+// PromptTemplateClient is a client for the PromptTemplate schema.
+type PromptTemplateClient struct {
+	config
+}
+
+// NewPromptTemplateClient returns a client for the PromptTemplate from the given config.
+func NewPromptTemplateClient(c config) *PromptTemplateClient {
+	return &PromptTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `prompttemplate.Hooks(f(g(h())))`.
+func (c *PromptTemplateClient) Use(hooks ...Hook) {
+	c.hooks.PromptTemplate = append(c.hooks.PromptTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `prompttemplate.Intercept(f(g(h())))`.
+func (c *PromptTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PromptTemplate = append(c.inters.PromptTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a PromptTemplate entity.
+func (c *PromptTemplateClient) Create() *PromptTemplateCreate {
+	mutation := newPromptTemplateMutation(c.config, OpCreate)
+	return &PromptTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PromptTemplate entities.
+func (c *PromptTemplateClient) CreateBulk(builders ...*PromptTemplateCreate) *PromptTemplateCreateBulk {
+	return &PromptTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PromptTemplateClient) MapCreateBulk(slice any, setFunc func(*PromptTemplateCreate, int)) *PromptTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PromptTemplateCreateBulk{err: fmt.Errorf("calling to PromptTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PromptTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PromptTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PromptTemplate.
+func (c *PromptTemplateClient) Update() *PromptTemplateUpdate {
+	mutation := newPromptTemplateMutation(c.config, OpUpdate)
+	return &PromptTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromptTemplateClient) UpdateOne(_m *PromptTemplate) *PromptTemplateUpdateOne {
+	mutation := newPromptTemplateMutation(c.config, OpUpdateOne, withPromptTemplate(_m))
+	return &PromptTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromptTemplateClient) UpdateOneID(id int) *PromptTemplateUpdateOne {
+	mutation := newPromptTemplateMutation(c.config, OpUpdateOne, withPromptTemplateID(id))
+	return &PromptTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PromptTemplate.
+func (c *PromptTemplateClient) Delete() *PromptTemplateDelete {
+	mutation := newPromptTemplateMutation(c.config, OpDelete)
+	return &PromptTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PromptTemplateClient) DeleteOne(_m *PromptTemplate) *PromptTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PromptTemplateClient) DeleteOneID(id int) *PromptTemplateDeleteOne {
+	builder := c.Delete().Where(prompttemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromptTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for PromptTemplate.
+func (c *PromptTemplateClient) Query() *PromptTemplateQuery {
+	return &PromptTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePromptTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PromptTemplate entity by its id.
+func (c *PromptTemplateClient) Get(ctx context.Context, id int) (*PromptTemplate, error) {
+	return c.Query().Where(prompttemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromptTemplateClient) GetX(ctx context.Context, id int) *PromptTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PromptTemplateClient) Hooks() []Hook {
+	return c.hooks.PromptTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *PromptTemplateClient) Interceptors() []Interceptor {
+	return c.inters.PromptTemplate
+}
+
+func (c *PromptTemplateClient) mutate(ctx context.Context, m *PromptTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PromptTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PromptTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PromptTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PromptTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PromptTemplate mutation op: %q", m.Op())
+	}
+}
+
 // RawEventClient is a client for the RawEvent schema.
 type RawEventClient struct {
 	config
@@ -1526,15 +2701,164 @@ func (c *RawEventClient) Interceptors() []Interceptor {
 func (c *RawEventClient) mutate(ctx context.Context, m *RawEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RawEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RawEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RawEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RawEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RawEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RawEvent mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id uuid.UUID) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id uuid.UUID) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id uuid.UUID) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id uuid.UUID) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RefreshToken.
+func (c *RefreshTokenClient) QueryUser(_m *RefreshToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.UserTable, refreshtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RawEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RawEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RawEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RawEvent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
 	}
 }
 
@@ -1758,6 +3082,22 @@ func (c *SessionClient) QueryAiLogs(_m *Session) *AILogQuery {
 	return query
 }
 
+// QueryKnowledgeMentions queries the knowledge_mentions edge of a Session.
+func (c *SessionClient) QueryKnowledgeMentions(_m *Session) *KnowledgeMentionQuery {
+	query := (&KnowledgeMentionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(knowledgemention.Table, knowledgemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, session.KnowledgeMentionsTable, session.KnowledgeMentionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SessionClient) Hooks() []Hook {
 	return c.hooks.Session
@@ -1783,6 +3123,139 @@ func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value,
 	}
 }
 
+// StripeEventClient is a client for the StripeEvent schema.
+type StripeEventClient struct {
+	config
+}
+
+// NewStripeEventClient returns a client for the StripeEvent from the given config.
+func NewStripeEventClient(c config) *StripeEventClient {
+	return &StripeEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stripeevent.Hooks(f(g(h())))`.
+func (c *StripeEventClient) Use(hooks ...Hook) {
+	c.hooks.StripeEvent = append(c.hooks.StripeEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stripeevent.Intercept(f(g(h())))`.
+func (c *StripeEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StripeEvent = append(c.inters.StripeEvent, interceptors...)
+}
+
+// Create returns a builder for creating a StripeEvent entity.
+func (c *StripeEventClient) Create() *StripeEventCreate {
+	mutation := newStripeEventMutation(c.config, OpCreate)
+	return &StripeEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StripeEvent entities.
+func (c *StripeEventClient) CreateBulk(builders ...*StripeEventCreate) *StripeEventCreateBulk {
+	return &StripeEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StripeEventClient) MapCreateBulk(slice any, setFunc func(*StripeEventCreate, int)) *StripeEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StripeEventCreateBulk{err: fmt.Errorf("calling to StripeEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StripeEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StripeEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StripeEvent.
+func (c *StripeEventClient) Update() *StripeEventUpdate {
+	mutation := newStripeEventMutation(c.config, OpUpdate)
+	return &StripeEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StripeEventClient) UpdateOne(_m *StripeEvent) *StripeEventUpdateOne {
+	mutation := newStripeEventMutation(c.config, OpUpdateOne, withStripeEvent(_m))
+	return &StripeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StripeEventClient) UpdateOneID(id string) *StripeEventUpdateOne {
+	mutation := newStripeEventMutation(c.config, OpUpdateOne, withStripeEventID(id))
+	return &StripeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StripeEvent.
+func (c *StripeEventClient) Delete() *StripeEventDelete {
+	mutation := newStripeEventMutation(c.config, OpDelete)
+	return &StripeEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StripeEventClient) DeleteOne(_m *StripeEvent) *StripeEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StripeEventClient) DeleteOneID(id string) *StripeEventDeleteOne {
+	builder := c.Delete().Where(stripeevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StripeEventDeleteOne{builder}
+}
+
+// Query returns a query builder for StripeEvent.
+func (c *StripeEventClient) Query() *StripeEventQuery {
+	return &StripeEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStripeEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StripeEvent entity by its id.
+func (c *StripeEventClient) Get(ctx context.Context, id string) (*StripeEvent, error) {
+	return c.Query().Where(stripeevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StripeEventClient) GetX(ctx context.Context, id string) *StripeEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StripeEventClient) Hooks() []Hook {
+	return c.hooks.StripeEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *StripeEventClient) Interceptors() []Interceptor {
+	return c.inters.StripeEvent
+}
+
+func (c *StripeEventClient) mutate(ctx context.Context, m *StripeEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StripeEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StripeEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StripeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StripeEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StripeEvent mutation op: %q", m.Op())
+	}
+}
+
 // SubscriptionClient is a client for the Subscription schema.
 type SubscriptionClient struct {
 	config
@@ -2237,6 +3710,38 @@ func (c *URLClient) QueryPageVisits(_m *URL) *PageVisitQuery {
 	return query
 }
 
+// QueryContents queries the contents edge of a URL.
+func (c *URLClient) QueryContents(_m *URL) *URLContentQuery {
+	query := (&URLContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(url.Table, url.FieldID, id),
+			sqlgraph.To(urlcontent.Table, urlcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, url.ContentsTable, url.ContentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKnowledgeMentions queries the knowledge_mentions edge of a URL.
+func (c *URLClient) QueryKnowledgeMentions(_m *URL) *KnowledgeMentionQuery {
+	query := (&KnowledgeMentionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(url.Table, url.FieldID, id),
+			sqlgraph.To(knowledgemention.Table, knowledgemention.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, url.KnowledgeMentionsTable, url.KnowledgeMentionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *URLClient) Hooks() []Hook {
 	return c.hooks.URL
@@ -2262,6 +3767,171 @@ func (c *URLClient) mutate(ctx context.Context, m *URLMutation) (Value, error) {
 	}
 }
 
+// URLContentClient is a client for the URLContent schema.
+type URLContentClient struct {
+	config
+}
+
+// NewURLContentClient returns a client for the URLContent from the given config.
+func NewURLContentClient(c config) *URLContentClient {
+	return &URLContentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `urlcontent.Hooks(f(g(h())))`.
+func (c *URLContentClient) Use(hooks ...Hook) {
+	c.hooks.URLContent = append(c.hooks.URLContent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `urlcontent.Intercept(f(g(h())))`.
+func (c *URLContentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.URLContent = append(c.inters.URLContent, interceptors...)
+}
+
+// Create returns a builder for creating a URLContent entity.
+func (c *URLContentClient) Create() *URLContentCreate {
+	mutation := newURLContentMutation(c.config, OpCreate)
+	return &URLContentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of URLContent entities.
+func (c *URLContentClient) CreateBulk(builders ...*URLContentCreate) *URLContentCreateBulk {
+	return &URLContentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *URLContentClient) MapCreateBulk(slice any, setFunc func(*URLContentCreate, int)) *URLContentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &URLContentCreateBulk{err: fmt.Errorf("calling to URLContentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*URLContentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &URLContentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for URLContent.
+func (c *URLContentClient) Update() *URLContentUpdate {
+	mutation := newURLContentMutation(c.config, OpUpdate)
+	return &URLContentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *URLContentClient) UpdateOne(_m *URLContent) *URLContentUpdateOne {
+	mutation := newURLContentMutation(c.config, OpUpdateOne, withURLContent(_m))
+	return &URLContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *URLContentClient) UpdateOneID(id uuid.UUID) *URLContentUpdateOne {
+	mutation := newURLContentMutation(c.config, OpUpdateOne, withURLContentID(id))
+	return &URLContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for URLContent.
+func (c *URLContentClient) Delete() *URLContentDelete {
+	mutation := newURLContentMutation(c.config, OpDelete)
+	return &URLContentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *URLContentClient) DeleteOne(_m *URLContent) *URLContentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *URLContentClient) DeleteOneID(id uuid.UUID) *URLContentDeleteOne {
+	builder := c.Delete().Where(urlcontent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &URLContentDeleteOne{builder}
+}
+
+// Query returns a query builder for URLContent.
+func (c *URLContentClient) Query() *URLContentQuery {
+	return &URLContentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeURLContent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a URLContent entity by its id.
+func (c *URLContentClient) Get(ctx context.Context, id uuid.UUID) (*URLContent, error) {
+	return c.Query().Where(urlcontent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *URLContentClient) GetX(ctx context.Context, id uuid.UUID) *URLContent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a URLContent.
+func (c *URLContentClient) QueryUser(_m *URLContent) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(urlcontent.Table, urlcontent.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, urlcontent.UserTable, urlcontent.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryURL queries the url edge of a URLContent.
+func (c *URLContentClient) QueryURL(_m *URLContent) *URLQuery {
+	query := (&URLClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(urlcontent.Table, urlcontent.FieldID, id),
+			sqlgraph.To(url.Table, url.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, urlcontent.URLTable, urlcontent.URLColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *URLContentClient) Hooks() []Hook {
+	return c.hooks.URLContent
+}
+
+// Interceptors returns the client interceptors.
+func (c *URLContentClient) Interceptors() []Interceptor {
+	return c.inters.URLContent
+}
+
+func (c *URLContentClient) mutate(ctx context.Context, m *URLContentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&URLContentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&URLContentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&URLContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&URLContentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown URLContent mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -2418,6 +4088,38 @@ func (c *UserClient) QueryPasswordResetTokens(_m *User) *PasswordResetTokenQuery
 	return query
 }
 
+// QueryEmailVerificationTokens queries the email_verification_tokens edge of a User.
+func (c *UserClient) QueryEmailVerificationTokens(_m *User) *EmailVerificationTokenQuery {
+	query := (&EmailVerificationTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(emailverificationtoken.Table, emailverificationtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EmailVerificationTokensTable, user.EmailVerificationTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefreshTokens queries the refresh_tokens edge of a User.
+func (c *UserClient) QueryRefreshTokens(_m *User) *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshTokensTable, user.RefreshTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QuerySubscriptions queries the subscriptions edge of a User.
 func (c *UserClient) QuerySubscriptions(_m *User) *SubscriptionQuery {
 	query := (&SubscriptionClient{config: c.config}).Query()
@@ -2466,6 +4168,38 @@ func (c *UserClient) QueryAiLogs(_m *User) *AILogQuery {
 	return query
 }
 
+// QueryURLContents queries the url_contents edge of a User.
+func (c *UserClient) QueryURLContents(_m *User) *URLContentQuery {
+	query := (&URLContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(urlcontent.Table, urlcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.URLContentsTable, user.URLContentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKnowledgeTopics queries the knowledge_topics edge of a User.
+func (c *UserClient) QueryKnowledgeTopics(_m *User) *KnowledgeTopicQuery {
+	query := (&KnowledgeTopicClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(knowledgetopic.Table, knowledgetopic.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.KnowledgeTopicsTable, user.KnowledgeTopicsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -2643,12 +4377,17 @@ func (c *UserSettingsClient) mutate(ctx context.Context, m *UserSettingsMutation
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AIConfig, AILog, Highlight, MindmapGraph, PageVisit, PasswordResetToken, Plan,
-		RawEvent, Session, Subscription, TokenUsage, URL, User, UserSettings []ent.Hook
+		AIConfig, AILog, EmailVerificationToken, Highlight, KnowledgeMention,
+		KnowledgeTopic, MindmapEdit, MindmapGraph, MindmapRevision, ModelPricing,
+		PageVisit, PasswordResetToken, Plan, PromptTemplate, RawEvent, RefreshToken,
+		Session, StripeEvent, Subscription, TokenUsage, URL, URLContent, User,
+		UserSettings []ent.Hook
 	}
 	inters struct {
-		AIConfig, AILog, Highlight, MindmapGraph, PageVisit, PasswordResetToken, Plan,
-		RawEvent, Session, Subscription, TokenUsage, URL, User,
+		AIConfig, AILog, EmailVerificationToken, Highlight, KnowledgeMention,
+		KnowledgeTopic, MindmapEdit, MindmapGraph, MindmapRevision, ModelPricing,
+		PageVisit, PasswordResetToken, Plan, PromptTemplate, RawEvent, RefreshToken,
+		Session, StripeEvent, Subscription, TokenUsage, URL, URLContent, User,
 		UserSettings []ent.Interceptor
 	}
 )
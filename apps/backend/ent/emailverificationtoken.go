@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/emailverificationtoken"
+	"github.com/mindhit/api/ent/user"
+)
+
+// EmailVerificationToken is the model entity for the EmailVerificationToken schema.
+type EmailVerificationToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Secure verification token
+	Token string `json:"token,omitempty"`
+	// Owner user ID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Token expiration time
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Token creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Whether the token has been used
+	Used bool `json:"used,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailVerificationTokenQuery when eager-loading is set.
+	Edges        EmailVerificationTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmailVerificationTokenEdges holds the relations/edges for other nodes in the graph.
+type EmailVerificationTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailVerificationTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailVerificationToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailverificationtoken.FieldUsed:
+			values[i] = new(sql.NullBool)
+		case emailverificationtoken.FieldToken:
+			values[i] = new(sql.NullString)
+		case emailverificationtoken.FieldExpiresAt, emailverificationtoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case emailverificationtoken.FieldID, emailverificationtoken.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailVerificationToken fields.
+func (_m *EmailVerificationToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailverificationtoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case emailverificationtoken.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case emailverificationtoken.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case emailverificationtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case emailverificationtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case emailverificationtoken.FieldUsed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field used", values[i])
+			} else if value.Valid {
+				_m.Used = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailVerificationToken.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmailVerificationToken) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the EmailVerificationToken entity.
+func (_m *EmailVerificationToken) QueryUser() *UserQuery {
+	return NewEmailVerificationTokenClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this EmailVerificationToken.
+// Note that you need to call EmailVerificationToken.Unwrap() before calling this method if this EmailVerificationToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmailVerificationToken) Update() *EmailVerificationTokenUpdateOne {
+	return NewEmailVerificationTokenClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmailVerificationToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmailVerificationToken) Unwrap() *EmailVerificationToken {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailVerificationToken is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmailVerificationToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailVerificationToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("used=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Used))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailVerificationTokens is a parsable slice of EmailVerificationToken.
+type EmailVerificationTokens []*EmailVerificationToken
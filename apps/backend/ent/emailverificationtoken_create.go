@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/emailverificationtoken"
+	"github.com/mindhit/api/ent/user"
+)
+
+// EmailVerificationTokenCreate is the builder for creating a EmailVerificationToken entity.
+type EmailVerificationTokenCreate struct {
+	config
+	mutation *EmailVerificationTokenMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (_c *EmailVerificationTokenCreate) SetToken(v string) *EmailVerificationTokenCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EmailVerificationTokenCreate) SetUserID(v uuid.UUID) *EmailVerificationTokenCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *EmailVerificationTokenCreate) SetExpiresAt(v time.Time) *EmailVerificationTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailVerificationTokenCreate) SetCreatedAt(v time.Time) *EmailVerificationTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailVerificationTokenCreate) SetNillableCreatedAt(v *time.Time) *EmailVerificationTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUsed sets the "used" field.
+func (_c *EmailVerificationTokenCreate) SetUsed(v bool) *EmailVerificationTokenCreate {
+	_c.mutation.SetUsed(v)
+	return _c
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (_c *EmailVerificationTokenCreate) SetNillableUsed(v *bool) *EmailVerificationTokenCreate {
+	if v != nil {
+		_c.SetUsed(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmailVerificationTokenCreate) SetID(v uuid.UUID) *EmailVerificationTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EmailVerificationTokenCreate) SetNillableID(v *uuid.UUID) *EmailVerificationTokenCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *EmailVerificationTokenCreate) SetUser(v *User) *EmailVerificationTokenCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the EmailVerificationTokenMutation object of the builder.
+func (_c *EmailVerificationTokenCreate) Mutation() *EmailVerificationTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailVerificationToken in the database.
+func (_c *EmailVerificationTokenCreate) Save(ctx context.Context) (*EmailVerificationToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailVerificationTokenCreate) SaveX(ctx context.Context) *EmailVerificationToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailVerificationTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailVerificationTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailVerificationTokenCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emailverificationtoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Used(); !ok {
+		v := emailverificationtoken.DefaultUsed
+		_c.mutation.SetUsed(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := emailverificationtoken.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailVerificationTokenCreate) check() error {
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "EmailVerificationToken.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := emailverificationtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailVerificationToken.user_id"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "EmailVerificationToken.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailVerificationToken.created_at"`)}
+	}
+	if _, ok := _c.mutation.Used(); !ok {
+		return &ValidationError{Name: "used", err: errors.New(`ent: missing required field "EmailVerificationToken.used"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailVerificationToken.user"`)}
+	}
+	return nil
+}
+
+func (_c *EmailVerificationTokenCreate) sqlSave(ctx context.Context) (*EmailVerificationToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailVerificationTokenCreate) createSpec() (*EmailVerificationToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailVerificationToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailverificationtoken.Table, sqlgraph.NewFieldSpec(emailverificationtoken.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(emailverificationtoken.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Used(); ok {
+		_spec.SetField(emailverificationtoken.FieldUsed, field.TypeBool, value)
+		_node.Used = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.UserTable,
+			Columns: []string{emailverificationtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmailVerificationTokenCreateBulk is the builder for creating many EmailVerificationToken entities in bulk.
+type EmailVerificationTokenCreateBulk struct {
+	config
+	err      error
+	builders []*EmailVerificationTokenCreate
+}
+
+// Save creates the EmailVerificationToken entities in the database.
+func (_c *EmailVerificationTokenCreateBulk) Save(ctx context.Context) ([]*EmailVerificationToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailVerificationToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailVerificationTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailVerificationTokenCreateBulk) SaveX(ctx context.Context) []*EmailVerificationToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailVerificationTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailVerificationTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/emailverificationtoken"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// EmailVerificationTokenDelete is the builder for deleting a EmailVerificationToken entity.
+type EmailVerificationTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmailVerificationTokenMutation
+}
+
+// Where appends a list predicates to the EmailVerificationTokenDelete builder.
+func (_d *EmailVerificationTokenDelete) Where(ps ...predicate.EmailVerificationToken) *EmailVerificationTokenDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EmailVerificationTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmailVerificationTokenDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EmailVerificationTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emailverificationtoken.Table, sqlgraph.NewFieldSpec(emailverificationtoken.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EmailVerificationTokenDeleteOne is the builder for deleting a single EmailVerificationToken entity.
+type EmailVerificationTokenDeleteOne struct {
+	_d *EmailVerificationTokenDelete
+}
+
+// Where appends a list predicates to the EmailVerificationTokenDelete builder.
+func (_d *EmailVerificationTokenDeleteOne) Where(ps ...predicate.EmailVerificationToken) *EmailVerificationTokenDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EmailVerificationTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emailverificationtoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmailVerificationTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
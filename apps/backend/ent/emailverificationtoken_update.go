@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/emailverificationtoken"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/user"
+)
+
+// EmailVerificationTokenUpdate is the builder for updating EmailVerificationToken entities.
+type EmailVerificationTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailVerificationTokenMutation
+}
+
+// Where appends a list predicates to the EmailVerificationTokenUpdate builder.
+func (_u *EmailVerificationTokenUpdate) Where(ps ...predicate.EmailVerificationToken) *EmailVerificationTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *EmailVerificationTokenUpdate) SetToken(v string) *EmailVerificationTokenUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdate) SetNillableToken(v *string) *EmailVerificationTokenUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmailVerificationTokenUpdate) SetUserID(v uuid.UUID) *EmailVerificationTokenUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdate) SetNillableUserID(v *uuid.UUID) *EmailVerificationTokenUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EmailVerificationTokenUpdate) SetExpiresAt(v time.Time) *EmailVerificationTokenUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdate) SetNillableExpiresAt(v *time.Time) *EmailVerificationTokenUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsed sets the "used" field.
+func (_u *EmailVerificationTokenUpdate) SetUsed(v bool) *EmailVerificationTokenUpdate {
+	_u.mutation.SetUsed(v)
+	return _u
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdate) SetNillableUsed(v *bool) *EmailVerificationTokenUpdate {
+	if v != nil {
+		_u.SetUsed(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EmailVerificationTokenUpdate) SetUser(v *User) *EmailVerificationTokenUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the EmailVerificationTokenMutation object of the builder.
+func (_u *EmailVerificationTokenUpdate) Mutation() *EmailVerificationTokenMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EmailVerificationTokenUpdate) ClearUser() *EmailVerificationTokenUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailVerificationTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailVerificationTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailVerificationTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailVerificationTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailVerificationTokenUpdate) check() error {
+	if v, ok := _u.mutation.Token(); ok {
+		if err := emailverificationtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.token": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailVerificationToken.user"`)
+	}
+	return nil
+}
+
+func (_u *EmailVerificationTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverificationtoken.Table, emailverificationtoken.Columns, sqlgraph.NewFieldSpec(emailverificationtoken.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(emailverificationtoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Used(); ok {
+		_spec.SetField(emailverificationtoken.FieldUsed, field.TypeBool, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.UserTable,
+			Columns: []string{emailverificationtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.UserTable,
+			Columns: []string{emailverificationtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverificationtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailVerificationTokenUpdateOne is the builder for updating a single EmailVerificationToken entity.
+type EmailVerificationTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailVerificationTokenMutation
+}
+
+// SetToken sets the "token" field.
+func (_u *EmailVerificationTokenUpdateOne) SetToken(v string) *EmailVerificationTokenUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdateOne) SetNillableToken(v *string) *EmailVerificationTokenUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmailVerificationTokenUpdateOne) SetUserID(v uuid.UUID) *EmailVerificationTokenUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdateOne) SetNillableUserID(v *uuid.UUID) *EmailVerificationTokenUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EmailVerificationTokenUpdateOne) SetExpiresAt(v time.Time) *EmailVerificationTokenUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdateOne) SetNillableExpiresAt(v *time.Time) *EmailVerificationTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsed sets the "used" field.
+func (_u *EmailVerificationTokenUpdateOne) SetUsed(v bool) *EmailVerificationTokenUpdateOne {
+	_u.mutation.SetUsed(v)
+	return _u
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdateOne) SetNillableUsed(v *bool) *EmailVerificationTokenUpdateOne {
+	if v != nil {
+		_u.SetUsed(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EmailVerificationTokenUpdateOne) SetUser(v *User) *EmailVerificationTokenUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the EmailVerificationTokenMutation object of the builder.
+func (_u *EmailVerificationTokenUpdateOne) Mutation() *EmailVerificationTokenMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EmailVerificationTokenUpdateOne) ClearUser() *EmailVerificationTokenUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the EmailVerificationTokenUpdate builder.
+func (_u *EmailVerificationTokenUpdateOne) Where(ps ...predicate.EmailVerificationToken) *EmailVerificationTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailVerificationTokenUpdateOne) Select(field string, fields ...string) *EmailVerificationTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailVerificationToken entity.
+func (_u *EmailVerificationTokenUpdateOne) Save(ctx context.Context) (*EmailVerificationToken, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailVerificationTokenUpdateOne) SaveX(ctx context.Context) *EmailVerificationToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailVerificationTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailVerificationTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailVerificationTokenUpdateOne) check() error {
+	if v, ok := _u.mutation.Token(); ok {
+		if err := emailverificationtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.token": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailVerificationToken.user"`)
+	}
+	return nil
+}
+
+func (_u *EmailVerificationTokenUpdateOne) sqlSave(ctx context.Context) (_node *EmailVerificationToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverificationtoken.Table, emailverificationtoken.Columns, sqlgraph.NewFieldSpec(emailverificationtoken.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailVerificationToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverificationtoken.FieldID)
+		for _, f := range fields {
+			if !emailverificationtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailverificationtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(emailverificationtoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Used(); ok {
+		_spec.SetField(emailverificationtoken.FieldUsed, field.TypeBool, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.UserTable,
+			Columns: []string{emailverificationtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.UserTable,
+			Columns: []string{emailverificationtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmailVerificationToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverificationtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,16 +14,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mindhit/api/ent/aiconfig"
 	"github.com/mindhit/api/ent/ailog"
+	"github.com/mindhit/api/ent/emailverificationtoken"
 	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/mindmapedit"
 	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
+	"github.com/mindhit/api/ent/modelpricing"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/passwordresettoken"
 	"github.com/mindhit/api/ent/plan"
+	"github.com/mindhit/api/ent/prompttemplate"
 	"github.com/mindhit/api/ent/rawevent"
+	"github.com/mindhit/api/ent/refreshtoken"
 	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/ent/stripeevent"
 	"github.com/mindhit/api/ent/subscription"
 	"github.com/mindhit/api/ent/tokenusage"
 	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
 	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/ent/usersettings"
 )
@@ -86,20 +96,30 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			aiconfig.Table:           aiconfig.ValidColumn,
-			ailog.Table:              ailog.ValidColumn,
-			highlight.Table:          highlight.ValidColumn,
-			mindmapgraph.Table:       mindmapgraph.ValidColumn,
-			pagevisit.Table:          pagevisit.ValidColumn,
-			passwordresettoken.Table: passwordresettoken.ValidColumn,
-			plan.Table:               plan.ValidColumn,
-			rawevent.Table:           rawevent.ValidColumn,
-			session.Table:            session.ValidColumn,
-			subscription.Table:       subscription.ValidColumn,
-			tokenusage.Table:         tokenusage.ValidColumn,
-			url.Table:                url.ValidColumn,
-			user.Table:               user.ValidColumn,
-			usersettings.Table:       usersettings.ValidColumn,
+			aiconfig.Table:               aiconfig.ValidColumn,
+			ailog.Table:                  ailog.ValidColumn,
+			emailverificationtoken.Table: emailverificationtoken.ValidColumn,
+			highlight.Table:              highlight.ValidColumn,
+			knowledgemention.Table:       knowledgemention.ValidColumn,
+			knowledgetopic.Table:         knowledgetopic.ValidColumn,
+			mindmapedit.Table:            mindmapedit.ValidColumn,
+			mindmapgraph.Table:           mindmapgraph.ValidColumn,
+			mindmaprevision.Table:        mindmaprevision.ValidColumn,
+			modelpricing.Table:           modelpricing.ValidColumn,
+			pagevisit.Table:              pagevisit.ValidColumn,
+			passwordresettoken.Table:     passwordresettoken.ValidColumn,
+			plan.Table:                   plan.ValidColumn,
+			prompttemplate.Table:         prompttemplate.ValidColumn,
+			rawevent.Table:               rawevent.ValidColumn,
+			refreshtoken.Table:           refreshtoken.ValidColumn,
+			session.Table:                session.ValidColumn,
+			stripeevent.Table:            stripeevent.ValidColumn,
+			subscription.Table:           subscription.ValidColumn,
+			tokenusage.Table:             tokenusage.ValidColumn,
+			url.Table:                    url.ValidColumn,
+			urlcontent.Table:             urlcontent.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			usersettings.Table:           usersettings.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
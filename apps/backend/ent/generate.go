@@ -1,4 +1,4 @@
 // Package ent provides the database models and client for MindHit.
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/lock ./schema
@@ -33,6 +33,18 @@ func (f AILogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AILogMutation", m)
 }
 
+// The EmailVerificationTokenFunc type is an adapter to allow the use of ordinary
+// function as EmailVerificationToken mutator.
+type EmailVerificationTokenFunc func(context.Context, *ent.EmailVerificationTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailVerificationTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailVerificationTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailVerificationTokenMutation", m)
+}
+
 // The HighlightFunc type is an adapter to allow the use of ordinary
 // function as Highlight mutator.
 type HighlightFunc func(context.Context, *ent.HighlightMutation) (ent.Value, error)
@@ -45,6 +57,42 @@ func (f HighlightFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HighlightMutation", m)
 }
 
+// The KnowledgeMentionFunc type is an adapter to allow the use of ordinary
+// function as KnowledgeMention mutator.
+type KnowledgeMentionFunc func(context.Context, *ent.KnowledgeMentionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KnowledgeMentionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KnowledgeMentionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KnowledgeMentionMutation", m)
+}
+
+// The KnowledgeTopicFunc type is an adapter to allow the use of ordinary
+// function as KnowledgeTopic mutator.
+type KnowledgeTopicFunc func(context.Context, *ent.KnowledgeTopicMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KnowledgeTopicFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KnowledgeTopicMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KnowledgeTopicMutation", m)
+}
+
+// The MindmapEditFunc type is an adapter to allow the use of ordinary
+// function as MindmapEdit mutator.
+type MindmapEditFunc func(context.Context, *ent.MindmapEditMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MindmapEditFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MindmapEditMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MindmapEditMutation", m)
+}
+
 // The MindmapGraphFunc type is an adapter to allow the use of ordinary
 // function as MindmapGraph mutator.
 type MindmapGraphFunc func(context.Context, *ent.MindmapGraphMutation) (ent.Value, error)
@@ -57,6 +105,30 @@ func (f MindmapGraphFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MindmapGraphMutation", m)
 }
 
+// The MindmapRevisionFunc type is an adapter to allow the use of ordinary
+// function as MindmapRevision mutator.
+type MindmapRevisionFunc func(context.Context, *ent.MindmapRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MindmapRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MindmapRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MindmapRevisionMutation", m)
+}
+
+// The ModelPricingFunc type is an adapter to allow the use of ordinary
+// function as ModelPricing mutator.
+type ModelPricingFunc func(context.Context, *ent.ModelPricingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModelPricingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ModelPricingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ModelPricingMutation", m)
+}
+
 // The PageVisitFunc type is an adapter to allow the use of ordinary
 // function as PageVisit mutator.
 type PageVisitFunc func(context.Context, *ent.PageVisitMutation) (ent.Value, error)
@@ -93,6 +165,18 @@ func (f PlanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PlanMutation", m)
 }
 
+// The PromptTemplateFunc type is an adapter to allow the use of ordinary
+// function as PromptTemplate mutator.
+type PromptTemplateFunc func(context.Context, *ent.PromptTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PromptTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PromptTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PromptTemplateMutation", m)
+}
+
 // The RawEventFunc type is an adapter to allow the use of ordinary
 // function as RawEvent mutator.
 type RawEventFunc func(context.Context, *ent.RawEventMutation) (ent.Value, error)
@@ -105,6 +189,18 @@ func (f RawEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RawEventMutation", m)
 }
 
+// The RefreshTokenFunc type is an adapter to allow the use of ordinary
+// function as RefreshToken mutator.
+type RefreshTokenFunc func(context.Context, *ent.RefreshTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefreshTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RefreshTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefreshTokenMutation", m)
+}
+
 // The SessionFunc type is an adapter to allow the use of ordinary
 // function as Session mutator.
 type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
@@ -117,6 +213,18 @@ func (f SessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionMutation", m)
 }
 
+// The StripeEventFunc type is an adapter to allow the use of ordinary
+// function as StripeEvent mutator.
+type StripeEventFunc func(context.Context, *ent.StripeEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StripeEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StripeEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StripeEventMutation", m)
+}
+
 // The SubscriptionFunc type is an adapter to allow the use of ordinary
 // function as Subscription mutator.
 type SubscriptionFunc func(context.Context, *ent.SubscriptionMutation) (ent.Value, error)
@@ -153,6 +261,18 @@ func (f URLFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.URLMutation", m)
 }
 
+// The URLContentFunc type is an adapter to allow the use of ordinary
+// function as URLContent mutator.
+type URLContentFunc func(context.Context, *ent.URLContentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f URLContentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.URLContentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.URLContentMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
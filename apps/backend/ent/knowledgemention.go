@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/session"
+)
+
+// KnowledgeMention is the model entity for the KnowledgeMention schema.
+type KnowledgeMention struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// ID of the topic node in the mindmap
+	NodeID string `json:"node_id,omitempty"`
+	// Label of the topic node
+	Label string `json:"label,omitempty"`
+	// Keywords of the topic node
+	Keywords []string `json:"keywords,omitempty"`
+	// Start of the session
+	SeenAt time.Time `json:"seen_at,omitempty"`
+	// Record creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KnowledgeMentionQuery when eager-loading is set.
+	Edges                      KnowledgeMentionEdges `json:"edges"`
+	knowledge_topic_mentions   *uuid.UUID
+	session_knowledge_mentions *uuid.UUID
+	selectValues               sql.SelectValues
+}
+
+// KnowledgeMentionEdges holds the relations/edges for other nodes in the graph.
+type KnowledgeMentionEdges struct {
+	// Topic holds the value of the topic edge.
+	Topic *KnowledgeTopic `json:"topic,omitempty"`
+	// Session holds the value of the session edge.
+	Session *Session `json:"session,omitempty"`
+	// Urls holds the value of the urls edge.
+	Urls []*URL `json:"urls,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TopicOrErr returns the Topic value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KnowledgeMentionEdges) TopicOrErr() (*KnowledgeTopic, error) {
+	if e.Topic != nil {
+		return e.Topic, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: knowledgetopic.Label}
+	}
+	return nil, &NotLoadedError{edge: "topic"}
+}
+
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KnowledgeMentionEdges) SessionOrErr() (*Session, error) {
+	if e.Session != nil {
+		return e.Session, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: session.Label}
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// UrlsOrErr returns the Urls value or an error if the edge
+// was not loaded in eager-loading.
+func (e KnowledgeMentionEdges) UrlsOrErr() ([]*URL, error) {
+	if e.loadedTypes[2] {
+		return e.Urls, nil
+	}
+	return nil, &NotLoadedError{edge: "urls"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KnowledgeMention) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case knowledgemention.FieldKeywords:
+			values[i] = new([]byte)
+		case knowledgemention.FieldNodeID, knowledgemention.FieldLabel:
+			values[i] = new(sql.NullString)
+		case knowledgemention.FieldSeenAt, knowledgemention.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case knowledgemention.FieldID:
+			values[i] = new(uuid.UUID)
+		case knowledgemention.ForeignKeys[0]: // knowledge_topic_mentions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case knowledgemention.ForeignKeys[1]: // session_knowledge_mentions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KnowledgeMention fields.
+func (_m *KnowledgeMention) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case knowledgemention.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case knowledgemention.FieldNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				_m.NodeID = value.String
+			}
+		case knowledgemention.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case knowledgemention.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case knowledgemention.FieldSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field seen_at", values[i])
+			} else if value.Valid {
+				_m.SeenAt = value.Time
+			}
+		case knowledgemention.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case knowledgemention.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field knowledge_topic_mentions", values[i])
+			} else if value.Valid {
+				_m.knowledge_topic_mentions = new(uuid.UUID)
+				*_m.knowledge_topic_mentions = *value.S.(*uuid.UUID)
+			}
+		case knowledgemention.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field session_knowledge_mentions", values[i])
+			} else if value.Valid {
+				_m.session_knowledge_mentions = new(uuid.UUID)
+				*_m.session_knowledge_mentions = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KnowledgeMention.
+// This includes values selected through modifiers, order, etc.
+func (_m *KnowledgeMention) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTopic queries the "topic" edge of the KnowledgeMention entity.
+func (_m *KnowledgeMention) QueryTopic() *KnowledgeTopicQuery {
+	return NewKnowledgeMentionClient(_m.config).QueryTopic(_m)
+}
+
+// QuerySession queries the "session" edge of the KnowledgeMention entity.
+func (_m *KnowledgeMention) QuerySession() *SessionQuery {
+	return NewKnowledgeMentionClient(_m.config).QuerySession(_m)
+}
+
+// QueryUrls queries the "urls" edge of the KnowledgeMention entity.
+func (_m *KnowledgeMention) QueryUrls() *URLQuery {
+	return NewKnowledgeMentionClient(_m.config).QueryUrls(_m)
+}
+
+// Update returns a builder for updating this KnowledgeMention.
+// Note that you need to call KnowledgeMention.Unwrap() before calling this method if this KnowledgeMention
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *KnowledgeMention) Update() *KnowledgeMentionUpdateOne {
+	return NewKnowledgeMentionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the KnowledgeMention entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *KnowledgeMention) Unwrap() *KnowledgeMention {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KnowledgeMention is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *KnowledgeMention) String() string {
+	var builder strings.Builder
+	builder.WriteString("KnowledgeMention(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("node_id=")
+	builder.WriteString(_m.NodeID)
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Keywords))
+	builder.WriteString(", ")
+	builder.WriteString("seen_at=")
+	builder.WriteString(_m.SeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KnowledgeMentions is a parsable slice of KnowledgeMention.
+type KnowledgeMentions []*KnowledgeMention
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package knowledgemention
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the knowledgemention type in the database.
+	Label = "knowledge_mention"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNodeID holds the string denoting the node_id field in the database.
+	FieldNodeID = "node_id"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldKeywords holds the string denoting the keywords field in the database.
+	FieldKeywords = "keywords"
+	// FieldSeenAt holds the string denoting the seen_at field in the database.
+	FieldSeenAt = "seen_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTopic holds the string denoting the topic edge name in mutations.
+	EdgeTopic = "topic"
+	// EdgeSession holds the string denoting the session edge name in mutations.
+	EdgeSession = "session"
+	// EdgeUrls holds the string denoting the urls edge name in mutations.
+	EdgeUrls = "urls"
+	// Table holds the table name of the knowledgemention in the database.
+	Table = "knowledge_mentions"
+	// TopicTable is the table that holds the topic relation/edge.
+	TopicTable = "knowledge_mentions"
+	// TopicInverseTable is the table name for the KnowledgeTopic entity.
+	// It exists in this package in order to avoid circular dependency with the "knowledgetopic" package.
+	TopicInverseTable = "knowledge_topics"
+	// TopicColumn is the table column denoting the topic relation/edge.
+	TopicColumn = "knowledge_topic_mentions"
+	// SessionTable is the table that holds the session relation/edge.
+	SessionTable = "knowledge_mentions"
+	// SessionInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionInverseTable = "sessions"
+	// SessionColumn is the table column denoting the session relation/edge.
+	SessionColumn = "session_knowledge_mentions"
+	// UrlsTable is the table that holds the urls relation/edge. The primary key declared below.
+	UrlsTable = "knowledge_mention_urls"
+	// UrlsInverseTable is the table name for the URL entity.
+	// It exists in this package in order to avoid circular dependency with the "url" package.
+	UrlsInverseTable = "ur_ls"
+)
+
+// Columns holds all SQL columns for knowledgemention fields.
+var Columns = []string{
+	FieldID,
+	FieldNodeID,
+	FieldLabel,
+	FieldKeywords,
+	FieldSeenAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "knowledge_mentions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"knowledge_topic_mentions",
+	"session_knowledge_mentions",
+}
+
+var (
+	// UrlsPrimaryKey and UrlsColumn2 are the table columns denoting the
+	// primary key for the urls relation (M2M).
+	UrlsPrimaryKey = []string{"knowledge_mention_id", "url_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NodeIDValidator is a validator for the "node_id" field. It is called by the builders before save.
+	NodeIDValidator func(string) error
+	// LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	LabelValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the KnowledgeMention queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNodeID orders the results by the node_id field.
+func ByNodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeID, opts...).ToFunc()
+}
+
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// BySeenAt orders the results by the seen_at field.
+func BySeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeenAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTopicField orders the results by topic field.
+func ByTopicField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTopicStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySessionField orders the results by session field.
+func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUrlsCount orders the results by urls count.
+func ByUrlsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUrlsStep(), opts...)
+	}
+}
+
+// ByUrls orders the results by urls terms.
+func ByUrls(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUrlsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTopicStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TopicInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TopicTable, TopicColumn),
+	)
+}
+func newSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+	)
+}
+func newUrlsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UrlsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, UrlsTable, UrlsPrimaryKey...),
+	)
+}
@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/ent/url"
+)
+
+// KnowledgeMentionCreate is the builder for creating a KnowledgeMention entity.
+type KnowledgeMentionCreate struct {
+	config
+	mutation *KnowledgeMentionMutation
+	hooks    []Hook
+}
+
+// SetNodeID sets the "node_id" field.
+func (_c *KnowledgeMentionCreate) SetNodeID(v string) *KnowledgeMentionCreate {
+	_c.mutation.SetNodeID(v)
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *KnowledgeMentionCreate) SetLabel(v string) *KnowledgeMentionCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetKeywords sets the "keywords" field.
+func (_c *KnowledgeMentionCreate) SetKeywords(v []string) *KnowledgeMentionCreate {
+	_c.mutation.SetKeywords(v)
+	return _c
+}
+
+// SetSeenAt sets the "seen_at" field.
+func (_c *KnowledgeMentionCreate) SetSeenAt(v time.Time) *KnowledgeMentionCreate {
+	_c.mutation.SetSeenAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *KnowledgeMentionCreate) SetCreatedAt(v time.Time) *KnowledgeMentionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *KnowledgeMentionCreate) SetNillableCreatedAt(v *time.Time) *KnowledgeMentionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *KnowledgeMentionCreate) SetID(v uuid.UUID) *KnowledgeMentionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *KnowledgeMentionCreate) SetNillableID(v *uuid.UUID) *KnowledgeMentionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTopicID sets the "topic" edge to the KnowledgeTopic entity by ID.
+func (_c *KnowledgeMentionCreate) SetTopicID(id uuid.UUID) *KnowledgeMentionCreate {
+	_c.mutation.SetTopicID(id)
+	return _c
+}
+
+// SetTopic sets the "topic" edge to the KnowledgeTopic entity.
+func (_c *KnowledgeMentionCreate) SetTopic(v *KnowledgeTopic) *KnowledgeMentionCreate {
+	return _c.SetTopicID(v.ID)
+}
+
+// SetSessionID sets the "session" edge to the Session entity by ID.
+func (_c *KnowledgeMentionCreate) SetSessionID(id uuid.UUID) *KnowledgeMentionCreate {
+	_c.mutation.SetSessionID(id)
+	return _c
+}
+
+// SetSession sets the "session" edge to the Session entity.
+func (_c *KnowledgeMentionCreate) SetSession(v *Session) *KnowledgeMentionCreate {
+	return _c.SetSessionID(v.ID)
+}
+
+// AddURLIDs adds the "urls" edge to the URL entity by IDs.
+func (_c *KnowledgeMentionCreate) AddURLIDs(ids ...uuid.UUID) *KnowledgeMentionCreate {
+	_c.mutation.AddURLIDs(ids...)
+	return _c
+}
+
+// AddUrls adds the "urls" edges to the URL entity.
+func (_c *KnowledgeMentionCreate) AddUrls(v ...*URL) *KnowledgeMentionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddURLIDs(ids...)
+}
+
+// Mutation returns the KnowledgeMentionMutation object of the builder.
+func (_c *KnowledgeMentionCreate) Mutation() *KnowledgeMentionMutation {
+	return _c.mutation
+}
+
+// Save creates the KnowledgeMention in the database.
+func (_c *KnowledgeMentionCreate) Save(ctx context.Context) (*KnowledgeMention, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *KnowledgeMentionCreate) SaveX(ctx context.Context) *KnowledgeMention {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KnowledgeMentionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KnowledgeMentionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *KnowledgeMentionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := knowledgemention.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := knowledgemention.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *KnowledgeMentionCreate) check() error {
+	if _, ok := _c.mutation.NodeID(); !ok {
+		return &ValidationError{Name: "node_id", err: errors.New(`ent: missing required field "KnowledgeMention.node_id"`)}
+	}
+	if v, ok := _c.mutation.NodeID(); ok {
+		if err := knowledgemention.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "KnowledgeMention.node_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "KnowledgeMention.label"`)}
+	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := knowledgemention.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "KnowledgeMention.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SeenAt(); !ok {
+		return &ValidationError{Name: "seen_at", err: errors.New(`ent: missing required field "KnowledgeMention.seen_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KnowledgeMention.created_at"`)}
+	}
+	if len(_c.mutation.TopicIDs()) == 0 {
+		return &ValidationError{Name: "topic", err: errors.New(`ent: missing required edge "KnowledgeMention.topic"`)}
+	}
+	if len(_c.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "KnowledgeMention.session"`)}
+	}
+	return nil
+}
+
+func (_c *KnowledgeMentionCreate) sqlSave(ctx context.Context) (*KnowledgeMention, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *KnowledgeMentionCreate) createSpec() (*KnowledgeMention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KnowledgeMention{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(knowledgemention.Table, sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.NodeID(); ok {
+		_spec.SetField(knowledgemention.FieldNodeID, field.TypeString, value)
+		_node.NodeID = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(knowledgemention.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.Keywords(); ok {
+		_spec.SetField(knowledgemention.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := _c.mutation.SeenAt(); ok {
+		_spec.SetField(knowledgemention.FieldSeenAt, field.TypeTime, value)
+		_node.SeenAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(knowledgemention.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TopicIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   knowledgemention.TopicTable,
+			Columns: []string{knowledgemention.TopicColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgetopic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.knowledge_topic_mentions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   knowledgemention.SessionTable,
+			Columns: []string{knowledgemention.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.session_knowledge_mentions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UrlsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   knowledgemention.UrlsTable,
+			Columns: knowledgemention.UrlsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// KnowledgeMentionCreateBulk is the builder for creating many KnowledgeMention entities in bulk.
+type KnowledgeMentionCreateBulk struct {
+	config
+	err      error
+	builders []*KnowledgeMentionCreate
+}
+
+// Save creates the KnowledgeMention entities in the database.
+func (_c *KnowledgeMentionCreateBulk) Save(ctx context.Context) ([]*KnowledgeMention, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*KnowledgeMention, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KnowledgeMentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *KnowledgeMentionCreateBulk) SaveX(ctx context.Context) []*KnowledgeMention {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KnowledgeMentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KnowledgeMentionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
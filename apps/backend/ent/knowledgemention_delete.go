@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// KnowledgeMentionDelete is the builder for deleting a KnowledgeMention entity.
+type KnowledgeMentionDelete struct {
+	config
+	hooks    []Hook
+	mutation *KnowledgeMentionMutation
+}
+
+// Where appends a list predicates to the KnowledgeMentionDelete builder.
+func (_d *KnowledgeMentionDelete) Where(ps ...predicate.KnowledgeMention) *KnowledgeMentionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *KnowledgeMentionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *KnowledgeMentionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *KnowledgeMentionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(knowledgemention.Table, sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// KnowledgeMentionDeleteOne is the builder for deleting a single KnowledgeMention entity.
+type KnowledgeMentionDeleteOne struct {
+	_d *KnowledgeMentionDelete
+}
+
+// Where appends a list predicates to the KnowledgeMentionDelete builder.
+func (_d *KnowledgeMentionDeleteOne) Where(ps ...predicate.KnowledgeMention) *KnowledgeMentionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *KnowledgeMentionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{knowledgemention.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *KnowledgeMentionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,833 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/ent/url"
+)
+
+// KnowledgeMentionQuery is the builder for querying KnowledgeMention entities.
+type KnowledgeMentionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []knowledgemention.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.KnowledgeMention
+	withTopic   *KnowledgeTopicQuery
+	withSession *SessionQuery
+	withUrls    *URLQuery
+	withFKs     bool
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KnowledgeMentionQuery builder.
+func (_q *KnowledgeMentionQuery) Where(ps ...predicate.KnowledgeMention) *KnowledgeMentionQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *KnowledgeMentionQuery) Limit(limit int) *KnowledgeMentionQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *KnowledgeMentionQuery) Offset(offset int) *KnowledgeMentionQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *KnowledgeMentionQuery) Unique(unique bool) *KnowledgeMentionQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *KnowledgeMentionQuery) Order(o ...knowledgemention.OrderOption) *KnowledgeMentionQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryTopic chains the current query on the "topic" edge.
+func (_q *KnowledgeMentionQuery) QueryTopic() *KnowledgeTopicQuery {
+	query := (&KnowledgeTopicClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knowledgemention.Table, knowledgemention.FieldID, selector),
+			sqlgraph.To(knowledgetopic.Table, knowledgetopic.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, knowledgemention.TopicTable, knowledgemention.TopicColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySession chains the current query on the "session" edge.
+func (_q *KnowledgeMentionQuery) QuerySession() *SessionQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knowledgemention.Table, knowledgemention.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, knowledgemention.SessionTable, knowledgemention.SessionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUrls chains the current query on the "urls" edge.
+func (_q *KnowledgeMentionQuery) QueryUrls() *URLQuery {
+	query := (&URLClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knowledgemention.Table, knowledgemention.FieldID, selector),
+			sqlgraph.To(url.Table, url.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, knowledgemention.UrlsTable, knowledgemention.UrlsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first KnowledgeMention entity from the query.
+// Returns a *NotFoundError when no KnowledgeMention was found.
+func (_q *KnowledgeMentionQuery) First(ctx context.Context) (*KnowledgeMention, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{knowledgemention.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *KnowledgeMentionQuery) FirstX(ctx context.Context) *KnowledgeMention {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KnowledgeMention ID from the query.
+// Returns a *NotFoundError when no KnowledgeMention ID was found.
+func (_q *KnowledgeMentionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{knowledgemention.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *KnowledgeMentionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KnowledgeMention entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KnowledgeMention entity is found.
+// Returns a *NotFoundError when no KnowledgeMention entities are found.
+func (_q *KnowledgeMentionQuery) Only(ctx context.Context) (*KnowledgeMention, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{knowledgemention.Label}
+	default:
+		return nil, &NotSingularError{knowledgemention.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *KnowledgeMentionQuery) OnlyX(ctx context.Context) *KnowledgeMention {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KnowledgeMention ID in the query.
+// Returns a *NotSingularError when more than one KnowledgeMention ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *KnowledgeMentionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{knowledgemention.Label}
+	default:
+		err = &NotSingularError{knowledgemention.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *KnowledgeMentionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KnowledgeMentions.
+func (_q *KnowledgeMentionQuery) All(ctx context.Context) ([]*KnowledgeMention, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KnowledgeMention, *KnowledgeMentionQuery]()
+	return withInterceptors[[]*KnowledgeMention](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *KnowledgeMentionQuery) AllX(ctx context.Context) []*KnowledgeMention {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KnowledgeMention IDs.
+func (_q *KnowledgeMentionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(knowledgemention.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *KnowledgeMentionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *KnowledgeMentionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*KnowledgeMentionQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *KnowledgeMentionQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *KnowledgeMentionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *KnowledgeMentionQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KnowledgeMentionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *KnowledgeMentionQuery) Clone() *KnowledgeMentionQuery {
+	if _q == nil {
+		return nil
+	}
+	return &KnowledgeMentionQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]knowledgemention.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.KnowledgeMention{}, _q.predicates...),
+		withTopic:   _q.withTopic.Clone(),
+		withSession: _q.withSession.Clone(),
+		withUrls:    _q.withUrls.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithTopic tells the query-builder to eager-load the nodes that are connected to
+// the "topic" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *KnowledgeMentionQuery) WithTopic(opts ...func(*KnowledgeTopicQuery)) *KnowledgeMentionQuery {
+	query := (&KnowledgeTopicClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTopic = query
+	return _q
+}
+
+// WithSession tells the query-builder to eager-load the nodes that are connected to
+// the "session" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *KnowledgeMentionQuery) WithSession(opts ...func(*SessionQuery)) *KnowledgeMentionQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSession = query
+	return _q
+}
+
+// WithUrls tells the query-builder to eager-load the nodes that are connected to
+// the "urls" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *KnowledgeMentionQuery) WithUrls(opts ...func(*URLQuery)) *KnowledgeMentionQuery {
+	query := (&URLClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUrls = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		NodeID string `json:"node_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KnowledgeMention.Query().
+//		GroupBy(knowledgemention.FieldNodeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *KnowledgeMentionQuery) GroupBy(field string, fields ...string) *KnowledgeMentionGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KnowledgeMentionGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = knowledgemention.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		NodeID string `json:"node_id,omitempty"`
+//	}
+//
+//	client.KnowledgeMention.Query().
+//		Select(knowledgemention.FieldNodeID).
+//		Scan(ctx, &v)
+func (_q *KnowledgeMentionQuery) Select(fields ...string) *KnowledgeMentionSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &KnowledgeMentionSelect{KnowledgeMentionQuery: _q}
+	sbuild.label = knowledgemention.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KnowledgeMentionSelect configured with the given aggregations.
+func (_q *KnowledgeMentionQuery) Aggregate(fns ...AggregateFunc) *KnowledgeMentionSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *KnowledgeMentionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !knowledgemention.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *KnowledgeMentionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KnowledgeMention, error) {
+	var (
+		nodes       = []*KnowledgeMention{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withTopic != nil,
+			_q.withSession != nil,
+			_q.withUrls != nil,
+		}
+	)
+	if _q.withTopic != nil || _q.withSession != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, knowledgemention.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KnowledgeMention).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KnowledgeMention{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withTopic; query != nil {
+		if err := _q.loadTopic(ctx, query, nodes, nil,
+			func(n *KnowledgeMention, e *KnowledgeTopic) { n.Edges.Topic = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSession; query != nil {
+		if err := _q.loadSession(ctx, query, nodes, nil,
+			func(n *KnowledgeMention, e *Session) { n.Edges.Session = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUrls; query != nil {
+		if err := _q.loadUrls(ctx, query, nodes,
+			func(n *KnowledgeMention) { n.Edges.Urls = []*URL{} },
+			func(n *KnowledgeMention, e *URL) { n.Edges.Urls = append(n.Edges.Urls, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *KnowledgeMentionQuery) loadTopic(ctx context.Context, query *KnowledgeTopicQuery, nodes []*KnowledgeMention, init func(*KnowledgeMention), assign func(*KnowledgeMention, *KnowledgeTopic)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*KnowledgeMention)
+	for i := range nodes {
+		if nodes[i].knowledge_topic_mentions == nil {
+			continue
+		}
+		fk := *nodes[i].knowledge_topic_mentions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(knowledgetopic.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "knowledge_topic_mentions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *KnowledgeMentionQuery) loadSession(ctx context.Context, query *SessionQuery, nodes []*KnowledgeMention, init func(*KnowledgeMention), assign func(*KnowledgeMention, *Session)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*KnowledgeMention)
+	for i := range nodes {
+		if nodes[i].session_knowledge_mentions == nil {
+			continue
+		}
+		fk := *nodes[i].session_knowledge_mentions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(session.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "session_knowledge_mentions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *KnowledgeMentionQuery) loadUrls(ctx context.Context, query *URLQuery, nodes []*KnowledgeMention, init func(*KnowledgeMention), assign func(*KnowledgeMention, *URL)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*KnowledgeMention)
+	nids := make(map[uuid.UUID]map[*KnowledgeMention]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(knowledgemention.UrlsTable)
+		s.Join(joinT).On(s.C(url.FieldID), joinT.C(knowledgemention.UrlsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(knowledgemention.UrlsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(knowledgemention.UrlsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*KnowledgeMention]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*URL](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "urls" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (_q *KnowledgeMentionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *KnowledgeMentionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(knowledgemention.Table, knowledgemention.Columns, sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, knowledgemention.FieldID)
+		for i := range fields {
+			if fields[i] != knowledgemention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *KnowledgeMentionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(knowledgemention.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = knowledgemention.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *KnowledgeMentionQuery) ForUpdate(opts ...sql.LockOption) *KnowledgeMentionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *KnowledgeMentionQuery) ForShare(opts ...sql.LockOption) *KnowledgeMentionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// KnowledgeMentionGroupBy is the group-by builder for KnowledgeMention entities.
+type KnowledgeMentionGroupBy struct {
+	selector
+	build *KnowledgeMentionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *KnowledgeMentionGroupBy) Aggregate(fns ...AggregateFunc) *KnowledgeMentionGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *KnowledgeMentionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KnowledgeMentionQuery, *KnowledgeMentionGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *KnowledgeMentionGroupBy) sqlScan(ctx context.Context, root *KnowledgeMentionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KnowledgeMentionSelect is the builder for selecting fields of KnowledgeMention entities.
+type KnowledgeMentionSelect struct {
+	*KnowledgeMentionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *KnowledgeMentionSelect) Aggregate(fns ...AggregateFunc) *KnowledgeMentionSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *KnowledgeMentionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KnowledgeMentionQuery, *KnowledgeMentionSelect](ctx, _s.KnowledgeMentionQuery, _s, _s.inters, v)
+}
+
+func (_s *KnowledgeMentionSelect) sqlScan(ctx context.Context, root *KnowledgeMentionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
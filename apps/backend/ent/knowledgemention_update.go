@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/url"
+)
+
+// KnowledgeMentionUpdate is the builder for updating KnowledgeMention entities.
+type KnowledgeMentionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KnowledgeMentionMutation
+}
+
+// Where appends a list predicates to the KnowledgeMentionUpdate builder.
+func (_u *KnowledgeMentionUpdate) Where(ps ...predicate.KnowledgeMention) *KnowledgeMentionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// AddURLIDs adds the "urls" edge to the URL entity by IDs.
+func (_u *KnowledgeMentionUpdate) AddURLIDs(ids ...uuid.UUID) *KnowledgeMentionUpdate {
+	_u.mutation.AddURLIDs(ids...)
+	return _u
+}
+
+// AddUrls adds the "urls" edges to the URL entity.
+func (_u *KnowledgeMentionUpdate) AddUrls(v ...*URL) *KnowledgeMentionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddURLIDs(ids...)
+}
+
+// Mutation returns the KnowledgeMentionMutation object of the builder.
+func (_u *KnowledgeMentionUpdate) Mutation() *KnowledgeMentionMutation {
+	return _u.mutation
+}
+
+// ClearUrls clears all "urls" edges to the URL entity.
+func (_u *KnowledgeMentionUpdate) ClearUrls() *KnowledgeMentionUpdate {
+	_u.mutation.ClearUrls()
+	return _u
+}
+
+// RemoveURLIDs removes the "urls" edge to URL entities by IDs.
+func (_u *KnowledgeMentionUpdate) RemoveURLIDs(ids ...uuid.UUID) *KnowledgeMentionUpdate {
+	_u.mutation.RemoveURLIDs(ids...)
+	return _u
+}
+
+// RemoveUrls removes "urls" edges to URL entities.
+func (_u *KnowledgeMentionUpdate) RemoveUrls(v ...*URL) *KnowledgeMentionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveURLIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *KnowledgeMentionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KnowledgeMentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *KnowledgeMentionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KnowledgeMentionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KnowledgeMentionUpdate) check() error {
+	if _u.mutation.TopicCleared() && len(_u.mutation.TopicIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KnowledgeMention.topic"`)
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KnowledgeMention.session"`)
+	}
+	return nil
+}
+
+func (_u *KnowledgeMentionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(knowledgemention.Table, knowledgemention.Columns, sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(knowledgemention.FieldKeywords, field.TypeJSON)
+	}
+	if _u.mutation.UrlsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   knowledgemention.UrlsTable,
+			Columns: knowledgemention.UrlsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUrlsIDs(); len(nodes) > 0 && !_u.mutation.UrlsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   knowledgemention.UrlsTable,
+			Columns: knowledgemention.UrlsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UrlsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   knowledgemention.UrlsTable,
+			Columns: knowledgemention.UrlsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{knowledgemention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// KnowledgeMentionUpdateOne is the builder for updating a single KnowledgeMention entity.
+type KnowledgeMentionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KnowledgeMentionMutation
+}
+
+// AddURLIDs adds the "urls" edge to the URL entity by IDs.
+func (_u *KnowledgeMentionUpdateOne) AddURLIDs(ids ...uuid.UUID) *KnowledgeMentionUpdateOne {
+	_u.mutation.AddURLIDs(ids...)
+	return _u
+}
+
+// AddUrls adds the "urls" edges to the URL entity.
+func (_u *KnowledgeMentionUpdateOne) AddUrls(v ...*URL) *KnowledgeMentionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddURLIDs(ids...)
+}
+
+// Mutation returns the KnowledgeMentionMutation object of the builder.
+func (_u *KnowledgeMentionUpdateOne) Mutation() *KnowledgeMentionMutation {
+	return _u.mutation
+}
+
+// ClearUrls clears all "urls" edges to the URL entity.
+func (_u *KnowledgeMentionUpdateOne) ClearUrls() *KnowledgeMentionUpdateOne {
+	_u.mutation.ClearUrls()
+	return _u
+}
+
+// RemoveURLIDs removes the "urls" edge to URL entities by IDs.
+func (_u *KnowledgeMentionUpdateOne) RemoveURLIDs(ids ...uuid.UUID) *KnowledgeMentionUpdateOne {
+	_u.mutation.RemoveURLIDs(ids...)
+	return _u
+}
+
+// RemoveUrls removes "urls" edges to URL entities.
+func (_u *KnowledgeMentionUpdateOne) RemoveUrls(v ...*URL) *KnowledgeMentionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveURLIDs(ids...)
+}
+
+// Where appends a list predicates to the KnowledgeMentionUpdate builder.
+func (_u *KnowledgeMentionUpdateOne) Where(ps ...predicate.KnowledgeMention) *KnowledgeMentionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *KnowledgeMentionUpdateOne) Select(field string, fields ...string) *KnowledgeMentionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated KnowledgeMention entity.
+func (_u *KnowledgeMentionUpdateOne) Save(ctx context.Context) (*KnowledgeMention, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KnowledgeMentionUpdateOne) SaveX(ctx context.Context) *KnowledgeMention {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *KnowledgeMentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KnowledgeMentionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KnowledgeMentionUpdateOne) check() error {
+	if _u.mutation.TopicCleared() && len(_u.mutation.TopicIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KnowledgeMention.topic"`)
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KnowledgeMention.session"`)
+	}
+	return nil
+}
+
+func (_u *KnowledgeMentionUpdateOne) sqlSave(ctx context.Context) (_node *KnowledgeMention, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(knowledgemention.Table, knowledgemention.Columns, sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KnowledgeMention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, knowledgemention.FieldID)
+		for _, f := range fields {
+			if !knowledgemention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != knowledgemention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(knowledgemention.FieldKeywords, field.TypeJSON)
+	}
+	if _u.mutation.UrlsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   knowledgemention.UrlsTable,
+			Columns: knowledgemention.UrlsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUrlsIDs(); len(nodes) > 0 && !_u.mutation.UrlsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   knowledgemention.UrlsTable,
+			Columns: knowledgemention.UrlsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UrlsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   knowledgemention.UrlsTable,
+			Columns: knowledgemention.UrlsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &KnowledgeMention{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{knowledgemention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
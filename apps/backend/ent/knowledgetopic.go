@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/user"
+)
+
+// KnowledgeTopic is the model entity for the KnowledgeTopic schema.
+type KnowledgeTopic struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// Record creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Record last update timestamp
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Label the topic goes by most often
+	Label string `json:"label,omitempty"`
+	// Most frequent keywords of the mentions
+	Keywords []string `json:"keywords,omitempty"`
+	// Start of the earliest session that mentions the topic
+	FirstSeenAt time.Time `json:"first_seen_at,omitempty"`
+	// Start of the latest session that mentions the topic
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KnowledgeTopicQuery when eager-loading is set.
+	Edges                 KnowledgeTopicEdges `json:"edges"`
+	user_knowledge_topics *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// KnowledgeTopicEdges holds the relations/edges for other nodes in the graph.
+type KnowledgeTopicEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*KnowledgeMention `json:"mentions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KnowledgeTopicEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e KnowledgeTopicEdges) MentionsOrErr() ([]*KnowledgeMention, error) {
+	if e.loadedTypes[1] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KnowledgeTopic) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case knowledgetopic.FieldKeywords:
+			values[i] = new([]byte)
+		case knowledgetopic.FieldLabel:
+			values[i] = new(sql.NullString)
+		case knowledgetopic.FieldCreatedAt, knowledgetopic.FieldUpdatedAt, knowledgetopic.FieldFirstSeenAt, knowledgetopic.FieldLastSeenAt:
+			values[i] = new(sql.NullTime)
+		case knowledgetopic.FieldID:
+			values[i] = new(uuid.UUID)
+		case knowledgetopic.ForeignKeys[0]: // user_knowledge_topics
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KnowledgeTopic fields.
+func (_m *KnowledgeTopic) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case knowledgetopic.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case knowledgetopic.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case knowledgetopic.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case knowledgetopic.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case knowledgetopic.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case knowledgetopic.FieldFirstSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen_at", values[i])
+			} else if value.Valid {
+				_m.FirstSeenAt = value.Time
+			}
+		case knowledgetopic.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		case knowledgetopic.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_knowledge_topics", values[i])
+			} else if value.Valid {
+				_m.user_knowledge_topics = new(uuid.UUID)
+				*_m.user_knowledge_topics = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KnowledgeTopic.
+// This includes values selected through modifiers, order, etc.
+func (_m *KnowledgeTopic) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the KnowledgeTopic entity.
+func (_m *KnowledgeTopic) QueryUser() *UserQuery {
+	return NewKnowledgeTopicClient(_m.config).QueryUser(_m)
+}
+
+// QueryMentions queries the "mentions" edge of the KnowledgeTopic entity.
+func (_m *KnowledgeTopic) QueryMentions() *KnowledgeMentionQuery {
+	return NewKnowledgeTopicClient(_m.config).QueryMentions(_m)
+}
+
+// Update returns a builder for updating this KnowledgeTopic.
+// Note that you need to call KnowledgeTopic.Unwrap() before calling this method if this KnowledgeTopic
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *KnowledgeTopic) Update() *KnowledgeTopicUpdateOne {
+	return NewKnowledgeTopicClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the KnowledgeTopic entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *KnowledgeTopic) Unwrap() *KnowledgeTopic {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KnowledgeTopic is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *KnowledgeTopic) String() string {
+	var builder strings.Builder
+	builder.WriteString("KnowledgeTopic(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Keywords))
+	builder.WriteString(", ")
+	builder.WriteString("first_seen_at=")
+	builder.WriteString(_m.FirstSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KnowledgeTopics is a parsable slice of KnowledgeTopic.
+type KnowledgeTopics []*KnowledgeTopic
@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package knowledgetopic
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FirstSeenAt applies equality check predicate on the "first_seen_at" field. It's identical to FirstSeenAtEQ.
+func FirstSeenAt(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// KeywordsIsNil applies the IsNil predicate on the "keywords" field.
+func KeywordsIsNil() predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldIsNull(FieldKeywords))
+}
+
+// KeywordsNotNil applies the NotNil predicate on the "keywords" field.
+func KeywordsNotNil() predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNotNull(FieldKeywords))
+}
+
+// FirstSeenAtEQ applies the EQ predicate on the "first_seen_at" field.
+func FirstSeenAtEQ(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtNEQ applies the NEQ predicate on the "first_seen_at" field.
+func FirstSeenAtNEQ(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtIn applies the In predicate on the "first_seen_at" field.
+func FirstSeenAtIn(vs ...time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtNotIn applies the NotIn predicate on the "first_seen_at" field.
+func FirstSeenAtNotIn(vs ...time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNotIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtGT applies the GT predicate on the "first_seen_at" field.
+func FirstSeenAtGT(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtGTE applies the GTE predicate on the "first_seen_at" field.
+func FirstSeenAtGTE(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGTE(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLT applies the LT predicate on the "first_seen_at" field.
+func FirstSeenAtLT(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLTE applies the LTE predicate on the "first_seen_at" field.
+func FirstSeenAtLTE(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLTE(FieldFirstSeenAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentions applies the HasEdge predicate on the "mentions" edge.
+func HasMentions() predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MentionsTable, MentionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionsWith applies the HasEdge predicate on the "mentions" edge with a given conditions (other predicates).
+func HasMentionsWith(preds ...predicate.KnowledgeMention) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(func(s *sql.Selector) {
+		step := newMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KnowledgeTopic) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KnowledgeTopic) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KnowledgeTopic) predicate.KnowledgeTopic {
+	return predicate.KnowledgeTopic(sql.NotPredicates(p))
+}
@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/user"
+)
+
+// KnowledgeTopicCreate is the builder for creating a KnowledgeTopic entity.
+type KnowledgeTopicCreate struct {
+	config
+	mutation *KnowledgeTopicMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *KnowledgeTopicCreate) SetCreatedAt(v time.Time) *KnowledgeTopicCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *KnowledgeTopicCreate) SetNillableCreatedAt(v *time.Time) *KnowledgeTopicCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *KnowledgeTopicCreate) SetUpdatedAt(v time.Time) *KnowledgeTopicCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *KnowledgeTopicCreate) SetNillableUpdatedAt(v *time.Time) *KnowledgeTopicCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *KnowledgeTopicCreate) SetLabel(v string) *KnowledgeTopicCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetKeywords sets the "keywords" field.
+func (_c *KnowledgeTopicCreate) SetKeywords(v []string) *KnowledgeTopicCreate {
+	_c.mutation.SetKeywords(v)
+	return _c
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_c *KnowledgeTopicCreate) SetFirstSeenAt(v time.Time) *KnowledgeTopicCreate {
+	_c.mutation.SetFirstSeenAt(v)
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *KnowledgeTopicCreate) SetLastSeenAt(v time.Time) *KnowledgeTopicCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *KnowledgeTopicCreate) SetID(v uuid.UUID) *KnowledgeTopicCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *KnowledgeTopicCreate) SetNillableID(v *uuid.UUID) *KnowledgeTopicCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *KnowledgeTopicCreate) SetUserID(id uuid.UUID) *KnowledgeTopicCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *KnowledgeTopicCreate) SetUser(v *User) *KnowledgeTopicCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddMentionIDs adds the "mentions" edge to the KnowledgeMention entity by IDs.
+func (_c *KnowledgeTopicCreate) AddMentionIDs(ids ...uuid.UUID) *KnowledgeTopicCreate {
+	_c.mutation.AddMentionIDs(ids...)
+	return _c
+}
+
+// AddMentions adds the "mentions" edges to the KnowledgeMention entity.
+func (_c *KnowledgeTopicCreate) AddMentions(v ...*KnowledgeMention) *KnowledgeTopicCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionIDs(ids...)
+}
+
+// Mutation returns the KnowledgeTopicMutation object of the builder.
+func (_c *KnowledgeTopicCreate) Mutation() *KnowledgeTopicMutation {
+	return _c.mutation
+}
+
+// Save creates the KnowledgeTopic in the database.
+func (_c *KnowledgeTopicCreate) Save(ctx context.Context) (*KnowledgeTopic, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *KnowledgeTopicCreate) SaveX(ctx context.Context) *KnowledgeTopic {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KnowledgeTopicCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KnowledgeTopicCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *KnowledgeTopicCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := knowledgetopic.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := knowledgetopic.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := knowledgetopic.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *KnowledgeTopicCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KnowledgeTopic.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "KnowledgeTopic.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "KnowledgeTopic.label"`)}
+	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := knowledgetopic.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "KnowledgeTopic.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FirstSeenAt(); !ok {
+		return &ValidationError{Name: "first_seen_at", err: errors.New(`ent: missing required field "KnowledgeTopic.first_seen_at"`)}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "KnowledgeTopic.last_seen_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "KnowledgeTopic.user"`)}
+	}
+	return nil
+}
+
+func (_c *KnowledgeTopicCreate) sqlSave(ctx context.Context) (*KnowledgeTopic, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *KnowledgeTopicCreate) createSpec() (*KnowledgeTopic, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KnowledgeTopic{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(knowledgetopic.Table, sqlgraph.NewFieldSpec(knowledgetopic.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(knowledgetopic.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(knowledgetopic.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(knowledgetopic.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.Keywords(); ok {
+		_spec.SetField(knowledgetopic.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := _c.mutation.FirstSeenAt(); ok {
+		_spec.SetField(knowledgetopic.FieldFirstSeenAt, field.TypeTime, value)
+		_node.FirstSeenAt = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(knowledgetopic.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   knowledgetopic.UserTable,
+			Columns: []string{knowledgetopic.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_knowledge_topics = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knowledgetopic.MentionsTable,
+			Columns: []string{knowledgetopic.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// KnowledgeTopicCreateBulk is the builder for creating many KnowledgeTopic entities in bulk.
+type KnowledgeTopicCreateBulk struct {
+	config
+	err      error
+	builders []*KnowledgeTopicCreate
+}
+
+// Save creates the KnowledgeTopic entities in the database.
+func (_c *KnowledgeTopicCreateBulk) Save(ctx context.Context) ([]*KnowledgeTopic, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*KnowledgeTopic, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KnowledgeTopicMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *KnowledgeTopicCreateBulk) SaveX(ctx context.Context) []*KnowledgeTopic {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KnowledgeTopicCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KnowledgeTopicCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
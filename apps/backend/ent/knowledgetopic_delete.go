@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// KnowledgeTopicDelete is the builder for deleting a KnowledgeTopic entity.
+type KnowledgeTopicDelete struct {
+	config
+	hooks    []Hook
+	mutation *KnowledgeTopicMutation
+}
+
+// Where appends a list predicates to the KnowledgeTopicDelete builder.
+func (_d *KnowledgeTopicDelete) Where(ps ...predicate.KnowledgeTopic) *KnowledgeTopicDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *KnowledgeTopicDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *KnowledgeTopicDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *KnowledgeTopicDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(knowledgetopic.Table, sqlgraph.NewFieldSpec(knowledgetopic.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// KnowledgeTopicDeleteOne is the builder for deleting a single KnowledgeTopic entity.
+type KnowledgeTopicDeleteOne struct {
+	_d *KnowledgeTopicDelete
+}
+
+// Where appends a list predicates to the KnowledgeTopicDelete builder.
+func (_d *KnowledgeTopicDeleteOne) Where(ps ...predicate.KnowledgeTopic) *KnowledgeTopicDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *KnowledgeTopicDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{knowledgetopic.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *KnowledgeTopicDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
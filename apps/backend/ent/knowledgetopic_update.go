@@ -0,0 +1,569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// KnowledgeTopicUpdate is the builder for updating KnowledgeTopic entities.
+type KnowledgeTopicUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KnowledgeTopicMutation
+}
+
+// Where appends a list predicates to the KnowledgeTopicUpdate builder.
+func (_u *KnowledgeTopicUpdate) Where(ps ...predicate.KnowledgeTopic) *KnowledgeTopicUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *KnowledgeTopicUpdate) SetUpdatedAt(v time.Time) *KnowledgeTopicUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *KnowledgeTopicUpdate) SetLabel(v string) *KnowledgeTopicUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *KnowledgeTopicUpdate) SetNillableLabel(v *string) *KnowledgeTopicUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *KnowledgeTopicUpdate) SetKeywords(v []string) *KnowledgeTopicUpdate {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *KnowledgeTopicUpdate) AppendKeywords(v []string) *KnowledgeTopicUpdate {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (_u *KnowledgeTopicUpdate) ClearKeywords() *KnowledgeTopicUpdate {
+	_u.mutation.ClearKeywords()
+	return _u
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_u *KnowledgeTopicUpdate) SetFirstSeenAt(v time.Time) *KnowledgeTopicUpdate {
+	_u.mutation.SetFirstSeenAt(v)
+	return _u
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (_u *KnowledgeTopicUpdate) SetNillableFirstSeenAt(v *time.Time) *KnowledgeTopicUpdate {
+	if v != nil {
+		_u.SetFirstSeenAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *KnowledgeTopicUpdate) SetLastSeenAt(v time.Time) *KnowledgeTopicUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *KnowledgeTopicUpdate) SetNillableLastSeenAt(v *time.Time) *KnowledgeTopicUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// AddMentionIDs adds the "mentions" edge to the KnowledgeMention entity by IDs.
+func (_u *KnowledgeTopicUpdate) AddMentionIDs(ids ...uuid.UUID) *KnowledgeTopicUpdate {
+	_u.mutation.AddMentionIDs(ids...)
+	return _u
+}
+
+// AddMentions adds the "mentions" edges to the KnowledgeMention entity.
+func (_u *KnowledgeTopicUpdate) AddMentions(v ...*KnowledgeMention) *KnowledgeTopicUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionIDs(ids...)
+}
+
+// Mutation returns the KnowledgeTopicMutation object of the builder.
+func (_u *KnowledgeTopicUpdate) Mutation() *KnowledgeTopicMutation {
+	return _u.mutation
+}
+
+// ClearMentions clears all "mentions" edges to the KnowledgeMention entity.
+func (_u *KnowledgeTopicUpdate) ClearMentions() *KnowledgeTopicUpdate {
+	_u.mutation.ClearMentions()
+	return _u
+}
+
+// RemoveMentionIDs removes the "mentions" edge to KnowledgeMention entities by IDs.
+func (_u *KnowledgeTopicUpdate) RemoveMentionIDs(ids ...uuid.UUID) *KnowledgeTopicUpdate {
+	_u.mutation.RemoveMentionIDs(ids...)
+	return _u
+}
+
+// RemoveMentions removes "mentions" edges to KnowledgeMention entities.
+func (_u *KnowledgeTopicUpdate) RemoveMentions(v ...*KnowledgeMention) *KnowledgeTopicUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *KnowledgeTopicUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KnowledgeTopicUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *KnowledgeTopicUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KnowledgeTopicUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *KnowledgeTopicUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := knowledgetopic.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KnowledgeTopicUpdate) check() error {
+	if v, ok := _u.mutation.Label(); ok {
+		if err := knowledgetopic.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "KnowledgeTopic.label": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KnowledgeTopic.user"`)
+	}
+	return nil
+}
+
+func (_u *KnowledgeTopicUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(knowledgetopic.Table, knowledgetopic.Columns, sqlgraph.NewFieldSpec(knowledgetopic.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(knowledgetopic.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(knowledgetopic.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(knowledgetopic.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, knowledgetopic.FieldKeywords, value)
+		})
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(knowledgetopic.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FirstSeenAt(); ok {
+		_spec.SetField(knowledgetopic.FieldFirstSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(knowledgetopic.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knowledgetopic.MentionsTable,
+			Columns: []string{knowledgetopic.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knowledgetopic.MentionsTable,
+			Columns: []string{knowledgetopic.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knowledgetopic.MentionsTable,
+			Columns: []string{knowledgetopic.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{knowledgetopic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// KnowledgeTopicUpdateOne is the builder for updating a single KnowledgeTopic entity.
+type KnowledgeTopicUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KnowledgeTopicMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *KnowledgeTopicUpdateOne) SetUpdatedAt(v time.Time) *KnowledgeTopicUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *KnowledgeTopicUpdateOne) SetLabel(v string) *KnowledgeTopicUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *KnowledgeTopicUpdateOne) SetNillableLabel(v *string) *KnowledgeTopicUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *KnowledgeTopicUpdateOne) SetKeywords(v []string) *KnowledgeTopicUpdateOne {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *KnowledgeTopicUpdateOne) AppendKeywords(v []string) *KnowledgeTopicUpdateOne {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (_u *KnowledgeTopicUpdateOne) ClearKeywords() *KnowledgeTopicUpdateOne {
+	_u.mutation.ClearKeywords()
+	return _u
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_u *KnowledgeTopicUpdateOne) SetFirstSeenAt(v time.Time) *KnowledgeTopicUpdateOne {
+	_u.mutation.SetFirstSeenAt(v)
+	return _u
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (_u *KnowledgeTopicUpdateOne) SetNillableFirstSeenAt(v *time.Time) *KnowledgeTopicUpdateOne {
+	if v != nil {
+		_u.SetFirstSeenAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *KnowledgeTopicUpdateOne) SetLastSeenAt(v time.Time) *KnowledgeTopicUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *KnowledgeTopicUpdateOne) SetNillableLastSeenAt(v *time.Time) *KnowledgeTopicUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// AddMentionIDs adds the "mentions" edge to the KnowledgeMention entity by IDs.
+func (_u *KnowledgeTopicUpdateOne) AddMentionIDs(ids ...uuid.UUID) *KnowledgeTopicUpdateOne {
+	_u.mutation.AddMentionIDs(ids...)
+	return _u
+}
+
+// AddMentions adds the "mentions" edges to the KnowledgeMention entity.
+func (_u *KnowledgeTopicUpdateOne) AddMentions(v ...*KnowledgeMention) *KnowledgeTopicUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionIDs(ids...)
+}
+
+// Mutation returns the KnowledgeTopicMutation object of the builder.
+func (_u *KnowledgeTopicUpdateOne) Mutation() *KnowledgeTopicMutation {
+	return _u.mutation
+}
+
+// ClearMentions clears all "mentions" edges to the KnowledgeMention entity.
+func (_u *KnowledgeTopicUpdateOne) ClearMentions() *KnowledgeTopicUpdateOne {
+	_u.mutation.ClearMentions()
+	return _u
+}
+
+// RemoveMentionIDs removes the "mentions" edge to KnowledgeMention entities by IDs.
+func (_u *KnowledgeTopicUpdateOne) RemoveMentionIDs(ids ...uuid.UUID) *KnowledgeTopicUpdateOne {
+	_u.mutation.RemoveMentionIDs(ids...)
+	return _u
+}
+
+// RemoveMentions removes "mentions" edges to KnowledgeMention entities.
+func (_u *KnowledgeTopicUpdateOne) RemoveMentions(v ...*KnowledgeMention) *KnowledgeTopicUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionIDs(ids...)
+}
+
+// Where appends a list predicates to the KnowledgeTopicUpdate builder.
+func (_u *KnowledgeTopicUpdateOne) Where(ps ...predicate.KnowledgeTopic) *KnowledgeTopicUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *KnowledgeTopicUpdateOne) Select(field string, fields ...string) *KnowledgeTopicUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated KnowledgeTopic entity.
+func (_u *KnowledgeTopicUpdateOne) Save(ctx context.Context) (*KnowledgeTopic, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KnowledgeTopicUpdateOne) SaveX(ctx context.Context) *KnowledgeTopic {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *KnowledgeTopicUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KnowledgeTopicUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *KnowledgeTopicUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := knowledgetopic.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KnowledgeTopicUpdateOne) check() error {
+	if v, ok := _u.mutation.Label(); ok {
+		if err := knowledgetopic.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "KnowledgeTopic.label": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KnowledgeTopic.user"`)
+	}
+	return nil
+}
+
+func (_u *KnowledgeTopicUpdateOne) sqlSave(ctx context.Context) (_node *KnowledgeTopic, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(knowledgetopic.Table, knowledgetopic.Columns, sqlgraph.NewFieldSpec(knowledgetopic.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KnowledgeTopic.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, knowledgetopic.FieldID)
+		for _, f := range fields {
+			if !knowledgetopic.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != knowledgetopic.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(knowledgetopic.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(knowledgetopic.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(knowledgetopic.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, knowledgetopic.FieldKeywords, value)
+		})
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(knowledgetopic.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FirstSeenAt(); ok {
+		_spec.SetField(knowledgetopic.FieldFirstSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(knowledgetopic.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knowledgetopic.MentionsTable,
+			Columns: []string{knowledgetopic.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knowledgetopic.MentionsTable,
+			Columns: []string{knowledgetopic.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knowledgetopic.MentionsTable,
+			Columns: []string{knowledgetopic.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &KnowledgeTopic{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{knowledgetopic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,6 +19,7 @@ var (
 		{Name: "max_tokens", Type: field.TypeInt, Default: 4096},
 		{Name: "thinking_budget", Type: field.TypeInt, Default: 0},
 		{Name: "json_mode", Type: field.TypeBool, Default: false},
+		{Name: "cache_ttl_seconds", Type: field.TypeInt, Default: 0},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -53,14 +54,16 @@ var (
 		{Name: "thinking", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "input_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "cached_input_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "output_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "thinking_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "total_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "latency_ms", Type: field.TypeInt64, Default: 0},
 		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "cached", Type: field.TypeBool, Default: false},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"success", "error", "timeout"}, Default: "success"},
 		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
-		{Name: "estimated_cost_cents", Type: field.TypeInt, Default: 0},
+		{Name: "cost_micro_cents", Type: field.TypeInt64, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "session_id", Type: field.TypeUUID, Nullable: true},
@@ -74,13 +77,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ai_logs_sessions_ai_logs",
-				Columns:    []*schema.Column{AiLogsColumns[19]},
+				Columns:    []*schema.Column{AiLogsColumns[21]},
 				RefColumns: []*schema.Column{SessionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "ai_logs_users_ai_logs",
-				Columns:    []*schema.Column{AiLogsColumns[20]},
+				Columns:    []*schema.Column{AiLogsColumns[22]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -89,27 +92,67 @@ var (
 			{
 				Name:    "ailog_user_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{AiLogsColumns[20], AiLogsColumns[18]},
+				Columns: []*schema.Column{AiLogsColumns[22], AiLogsColumns[20]},
 			},
 			{
 				Name:    "ailog_session_id",
 				Unique:  false,
-				Columns: []*schema.Column{AiLogsColumns[19]},
+				Columns: []*schema.Column{AiLogsColumns[21]},
 			},
 			{
 				Name:    "ailog_task_type_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{AiLogsColumns[1], AiLogsColumns[18]},
+				Columns: []*schema.Column{AiLogsColumns[1], AiLogsColumns[20]},
 			},
 			{
 				Name:    "ailog_provider_model_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{AiLogsColumns[2], AiLogsColumns[3], AiLogsColumns[18]},
+				Columns: []*schema.Column{AiLogsColumns[2], AiLogsColumns[3], AiLogsColumns[20]},
 			},
 			{
 				Name:    "ailog_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{AiLogsColumns[14], AiLogsColumns[18]},
+				Columns: []*schema.Column{AiLogsColumns[16], AiLogsColumns[20]},
+			},
+		},
+	}
+	// EmailVerificationTokensColumns holds the columns for the "email_verification_tokens" table.
+	EmailVerificationTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "used", Type: field.TypeBool, Default: false},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// EmailVerificationTokensTable holds the schema information for the "email_verification_tokens" table.
+	EmailVerificationTokensTable = &schema.Table{
+		Name:       "email_verification_tokens",
+		Columns:    EmailVerificationTokensColumns,
+		PrimaryKey: []*schema.Column{EmailVerificationTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_verification_tokens_users_email_verification_tokens",
+				Columns:    []*schema.Column{EmailVerificationTokensColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailverificationtoken_token",
+				Unique:  false,
+				Columns: []*schema.Column{EmailVerificationTokensColumns[1]},
+			},
+			{
+				Name:    "emailverificationtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmailVerificationTokensColumns[5]},
+			},
+			{
+				Name:    "emailverificationtoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailVerificationTokensColumns[2]},
 			},
 		},
 	}
@@ -145,6 +188,98 @@ var (
 			},
 		},
 	}
+	// KnowledgeMentionsColumns holds the columns for the "knowledge_mentions" table.
+	KnowledgeMentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "node_id", Type: field.TypeString},
+		{Name: "label", Type: field.TypeString},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "seen_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "knowledge_topic_mentions", Type: field.TypeUUID},
+		{Name: "session_knowledge_mentions", Type: field.TypeUUID},
+	}
+	// KnowledgeMentionsTable holds the schema information for the "knowledge_mentions" table.
+	KnowledgeMentionsTable = &schema.Table{
+		Name:       "knowledge_mentions",
+		Columns:    KnowledgeMentionsColumns,
+		PrimaryKey: []*schema.Column{KnowledgeMentionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "knowledge_mentions_knowledge_topics_mentions",
+				Columns:    []*schema.Column{KnowledgeMentionsColumns[6]},
+				RefColumns: []*schema.Column{KnowledgeTopicsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "knowledge_mentions_sessions_knowledge_mentions",
+				Columns:    []*schema.Column{KnowledgeMentionsColumns[7]},
+				RefColumns: []*schema.Column{SessionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "knowledgemention_node_id_session_knowledge_mentions",
+				Unique:  true,
+				Columns: []*schema.Column{KnowledgeMentionsColumns[1], KnowledgeMentionsColumns[7]},
+			},
+		},
+	}
+	// KnowledgeTopicsColumns holds the columns for the "knowledge_topics" table.
+	KnowledgeTopicsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "label", Type: field.TypeString},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "first_seen_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "user_knowledge_topics", Type: field.TypeUUID},
+	}
+	// KnowledgeTopicsTable holds the schema information for the "knowledge_topics" table.
+	KnowledgeTopicsTable = &schema.Table{
+		Name:       "knowledge_topics",
+		Columns:    KnowledgeTopicsColumns,
+		PrimaryKey: []*schema.Column{KnowledgeTopicsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "knowledge_topics_users_knowledge_topics",
+				Columns:    []*schema.Column{KnowledgeTopicsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "knowledgetopic_last_seen_at_user_knowledge_topics",
+				Unique:  false,
+				Columns: []*schema.Column{KnowledgeTopicsColumns[6], KnowledgeTopicsColumns[7]},
+			},
+		},
+	}
+	// MindmapEditsColumns holds the columns for the "mindmap_edits" table.
+	MindmapEditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "op", Type: field.TypeEnum, Enums: []string{"rename", "recolor", "pin", "delete", "merge", "split", "move_page", "add_edge", "note"}},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "mindmap_graph_edits", Type: field.TypeUUID},
+	}
+	// MindmapEditsTable holds the schema information for the "mindmap_edits" table.
+	MindmapEditsTable = &schema.Table{
+		Name:       "mindmap_edits",
+		Columns:    MindmapEditsColumns,
+		PrimaryKey: []*schema.Column{MindmapEditsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mindmap_edits_mindmap_graphs_edits",
+				Columns:    []*schema.Column{MindmapEditsColumns[4]},
+				RefColumns: []*schema.Column{MindmapGraphsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// MindmapGraphsColumns holds the columns for the "mindmap_graphs" table.
 	MindmapGraphsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -173,6 +308,67 @@ var (
 			},
 		},
 	}
+	// MindmapRevisionsColumns holds the columns for the "mindmap_revisions" table.
+	MindmapRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "nodes", Type: field.TypeJSON, Nullable: true},
+		{Name: "graph_edges", Type: field.TypeJSON, Nullable: true},
+		{Name: "layout", Type: field.TypeJSON, Nullable: true},
+		{Name: "restored_from", Type: field.TypeInt, Nullable: true},
+		{Name: "generated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "mindmap_graph_revisions", Type: field.TypeUUID},
+	}
+	// MindmapRevisionsTable holds the schema information for the "mindmap_revisions" table.
+	MindmapRevisionsTable = &schema.Table{
+		Name:       "mindmap_revisions",
+		Columns:    MindmapRevisionsColumns,
+		PrimaryKey: []*schema.Column{MindmapRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mindmap_revisions_mindmap_graphs_revisions",
+				Columns:    []*schema.Column{MindmapRevisionsColumns[8]},
+				RefColumns: []*schema.Column{MindmapGraphsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mindmaprevision_version_mindmap_graph_revisions",
+				Unique:  true,
+				Columns: []*schema.Column{MindmapRevisionsColumns[1], MindmapRevisionsColumns[8]},
+			},
+		},
+	}
+	// ModelPricingsColumns holds the columns for the "model_pricings" table.
+	ModelPricingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString},
+		{Name: "input_price", Type: field.TypeFloat64},
+		{Name: "output_price", Type: field.TypeFloat64},
+		{Name: "thinking_price", Type: field.TypeFloat64, Nullable: true},
+		{Name: "cached_input_price", Type: field.TypeFloat64, Nullable: true},
+		{Name: "effective_from", Type: field.TypeTime},
+		{Name: "effective_until", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ModelPricingsTable holds the schema information for the "model_pricings" table.
+	ModelPricingsTable = &schema.Table{
+		Name:       "model_pricings",
+		Columns:    ModelPricingsColumns,
+		PrimaryKey: []*schema.Column{ModelPricingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "modelpricing_provider_model_effective_from",
+				Unique:  true,
+				Columns: []*schema.Column{ModelPricingsColumns[1], ModelPricingsColumns[2], ModelPricingsColumns[7]},
+			},
+		},
+	}
 	// PageVisitsColumns holds the columns for the "page_visits" table.
 	PageVisitsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -182,6 +378,7 @@ var (
 		{Name: "left_at", Type: field.TypeTime, Nullable: true},
 		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
 		{Name: "max_scroll_depth", Type: field.TypeFloat64, Default: 0},
+		{Name: "click_count", Type: field.TypeInt, Default: 0},
 		{Name: "page_visit_url", Type: field.TypeUUID},
 		{Name: "session_page_visits", Type: field.TypeUUID},
 	}
@@ -193,13 +390,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "page_visits_ur_ls_url",
-				Columns:    []*schema.Column{PageVisitsColumns[7]},
+				Columns:    []*schema.Column{PageVisitsColumns[8]},
 				RefColumns: []*schema.Column{UrLsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "page_visits_sessions_page_visits",
-				Columns:    []*schema.Column{PageVisitsColumns[8]},
+				Columns:    []*schema.Column{PageVisitsColumns[9]},
 				RefColumns: []*schema.Column{SessionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -210,6 +407,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{PageVisitsColumns[3]},
 			},
+			{
+				Name:    "pagevisit_session_page_visits_page_visit_url",
+				Unique:  false,
+				Columns: []*schema.Column{PageVisitsColumns[9], PageVisitsColumns[8]},
+			},
 		},
 	}
 	// PasswordResetTokensColumns holds the columns for the "password_reset_tokens" table.
@@ -270,6 +472,31 @@ var (
 		Columns:    PlansColumns,
 		PrimaryKey: []*schema.Column{PlansColumns[0]},
 	}
+	// PromptTemplatesColumns holds the columns for the "prompt_templates" table.
+	PromptTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "locale", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PromptTemplatesTable holds the schema information for the "prompt_templates" table.
+	PromptTemplatesTable = &schema.Table{
+		Name:       "prompt_templates",
+		Columns:    PromptTemplatesColumns,
+		PrimaryKey: []*schema.Column{PromptTemplatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "prompttemplate_name_locale_version",
+				Unique:  true,
+				Columns: []*schema.Column{PromptTemplatesColumns[1], PromptTemplatesColumns[2], PromptTemplatesColumns[3]},
+			},
+		},
+	}
 	// RawEventsColumns holds the columns for the "raw_events" table.
 	RawEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -313,6 +540,47 @@ var (
 			},
 		},
 	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "family_id", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_users_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[1]},
+			},
+			{
+				Name:    "refreshtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[6]},
+			},
+			{
+				Name:    "refreshtoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[2]},
+			},
+		},
+	}
 	// SessionsColumns holds the columns for the "sessions" table.
 	SessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -348,6 +616,25 @@ var (
 			},
 		},
 	}
+	// StripeEventsColumns holds the columns for the "stripe_events" table.
+	StripeEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "processed_at", Type: field.TypeTime},
+	}
+	// StripeEventsTable holds the schema information for the "stripe_events" table.
+	StripeEventsTable = &schema.Table{
+		Name:       "stripe_events",
+		Columns:    StripeEventsColumns,
+		PrimaryKey: []*schema.Column{StripeEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stripeevent_processed_at",
+				Unique:  false,
+				Columns: []*schema.Column{StripeEventsColumns[2]},
+			},
+		},
+	}
 	// SubscriptionsColumns holds the columns for the "subscriptions" table.
 	SubscriptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -359,6 +646,7 @@ var (
 		{Name: "cancel_at_period_end", Type: field.TypeBool, Default: false},
 		{Name: "stripe_subscription_id", Type: field.TypeString, Nullable: true},
 		{Name: "stripe_customer_id", Type: field.TypeString, Nullable: true},
+		{Name: "stripe_event_at", Type: field.TypeTime, Nullable: true},
 		{Name: "plan_subscriptions", Type: field.TypeString},
 		{Name: "user_subscriptions", Type: field.TypeUUID},
 	}
@@ -370,13 +658,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscriptions_plans_subscriptions",
-				Columns:    []*schema.Column{SubscriptionsColumns[9]},
+				Columns:    []*schema.Column{SubscriptionsColumns[10]},
 				RefColumns: []*schema.Column{PlansColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "subscriptions_users_subscriptions",
-				Columns:    []*schema.Column{SubscriptionsColumns[10]},
+				Columns:    []*schema.Column{SubscriptionsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -385,7 +673,17 @@ var (
 			{
 				Name:    "subscription_status_user_subscriptions",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[3], SubscriptionsColumns[10]},
+				Columns: []*schema.Column{SubscriptionsColumns[3], SubscriptionsColumns[11]},
+			},
+			{
+				Name:    "subscription_stripe_subscription_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[7]},
+			},
+			{
+				Name:    "subscription_stripe_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[8]},
 			},
 		},
 	}
@@ -440,10 +738,6 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "url", Type: field.TypeString},
 		{Name: "url_hash", Type: field.TypeString, Unique: true},
-		{Name: "title", Type: field.TypeString, Nullable: true},
-		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
-		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
-		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
 		{Name: "crawled_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UrLsTable holds the schema information for the "ur_ls" table.
@@ -459,6 +753,50 @@ var (
 			},
 		},
 	}
+	// URLContentsColumns holds the columns for the "url_contents" table.
+	URLContentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "abstract", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "entities", Type: field.TypeJSON, Nullable: true},
+		{Name: "summarized_at", Type: field.TypeTime, Nullable: true},
+		{Name: "summary_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "summary_failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "url_contents", Type: field.TypeUUID},
+		{Name: "user_url_contents", Type: field.TypeUUID},
+	}
+	// URLContentsTable holds the schema information for the "url_contents" table.
+	URLContentsTable = &schema.Table{
+		Name:       "url_contents",
+		Columns:    URLContentsColumns,
+		PrimaryKey: []*schema.Column{URLContentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "url_contents_ur_ls_contents",
+				Columns:    []*schema.Column{URLContentsColumns[12]},
+				RefColumns: []*schema.Column{UrLsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "url_contents_users_url_contents",
+				Columns:    []*schema.Column{URLContentsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "urlcontent_user_url_contents_url_contents",
+				Unique:  true,
+				Columns: []*schema.Column{URLContentsColumns[13], URLContentsColumns[12]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -471,6 +809,7 @@ var (
 		{Name: "google_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
 		{Name: "auth_provider", Type: field.TypeEnum, Enums: []string{"email", "google"}, Default: "email"},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -528,39 +867,86 @@ var (
 			},
 		},
 	}
+	// KnowledgeMentionUrlsColumns holds the columns for the "knowledge_mention_urls" table.
+	KnowledgeMentionUrlsColumns = []*schema.Column{
+		{Name: "knowledge_mention_id", Type: field.TypeUUID},
+		{Name: "url_id", Type: field.TypeUUID},
+	}
+	// KnowledgeMentionUrlsTable holds the schema information for the "knowledge_mention_urls" table.
+	KnowledgeMentionUrlsTable = &schema.Table{
+		Name:       "knowledge_mention_urls",
+		Columns:    KnowledgeMentionUrlsColumns,
+		PrimaryKey: []*schema.Column{KnowledgeMentionUrlsColumns[0], KnowledgeMentionUrlsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "knowledge_mention_urls_knowledge_mention_id",
+				Columns:    []*schema.Column{KnowledgeMentionUrlsColumns[0]},
+				RefColumns: []*schema.Column{KnowledgeMentionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "knowledge_mention_urls_url_id",
+				Columns:    []*schema.Column{KnowledgeMentionUrlsColumns[1]},
+				RefColumns: []*schema.Column{UrLsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AiConfigsTable,
 		AiLogsTable,
+		EmailVerificationTokensTable,
 		HighlightsTable,
+		KnowledgeMentionsTable,
+		KnowledgeTopicsTable,
+		MindmapEditsTable,
 		MindmapGraphsTable,
+		MindmapRevisionsTable,
+		ModelPricingsTable,
 		PageVisitsTable,
 		PasswordResetTokensTable,
 		PlansTable,
+		PromptTemplatesTable,
 		RawEventsTable,
+		RefreshTokensTable,
 		SessionsTable,
+		StripeEventsTable,
 		SubscriptionsTable,
 		TokenUsagesTable,
 		UrLsTable,
+		URLContentsTable,
 		UsersTable,
 		UserSettingsTable,
+		KnowledgeMentionUrlsTable,
 	}
 )
 
 func init() {
 	AiLogsTable.ForeignKeys[0].RefTable = SessionsTable
 	AiLogsTable.ForeignKeys[1].RefTable = UsersTable
+	EmailVerificationTokensTable.ForeignKeys[0].RefTable = UsersTable
 	HighlightsTable.ForeignKeys[0].RefTable = PageVisitsTable
 	HighlightsTable.ForeignKeys[1].RefTable = SessionsTable
+	KnowledgeMentionsTable.ForeignKeys[0].RefTable = KnowledgeTopicsTable
+	KnowledgeMentionsTable.ForeignKeys[1].RefTable = SessionsTable
+	KnowledgeTopicsTable.ForeignKeys[0].RefTable = UsersTable
+	MindmapEditsTable.ForeignKeys[0].RefTable = MindmapGraphsTable
 	MindmapGraphsTable.ForeignKeys[0].RefTable = SessionsTable
+	MindmapRevisionsTable.ForeignKeys[0].RefTable = MindmapGraphsTable
 	PageVisitsTable.ForeignKeys[0].RefTable = UrLsTable
 	PageVisitsTable.ForeignKeys[1].RefTable = SessionsTable
 	PasswordResetTokensTable.ForeignKeys[0].RefTable = UsersTable
 	RawEventsTable.ForeignKeys[0].RefTable = SessionsTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
 	SessionsTable.ForeignKeys[0].RefTable = UsersTable
 	SubscriptionsTable.ForeignKeys[0].RefTable = PlansTable
 	SubscriptionsTable.ForeignKeys[1].RefTable = UsersTable
 	TokenUsagesTable.ForeignKeys[0].RefTable = SessionsTable
 	TokenUsagesTable.ForeignKeys[1].RefTable = UsersTable
+	URLContentsTable.ForeignKeys[0].RefTable = UrLsTable
+	URLContentsTable.ForeignKeys[1].RefTable = UsersTable
 	UserSettingsTable.ForeignKeys[0].RefTable = UsersTable
+	KnowledgeMentionUrlsTable.ForeignKeys[0].RefTable = KnowledgeMentionsTable
+	KnowledgeMentionUrlsTable.ForeignKeys[1].RefTable = UrLsTable
 }
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/mindmapedit"
+	"github.com/mindhit/api/ent/mindmapgraph"
+)
+
+// MindmapEdit is the model entity for the MindmapEdit schema.
+type MindmapEdit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Edit operation
+	Op mindmapedit.Op `json:"op,omitempty"`
+	// Operation arguments, and what replaying it needs
+	Payload map[string]interface{} `json:"payload,omitempty"`
+	// Record creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MindmapEditQuery when eager-loading is set.
+	Edges               MindmapEditEdges `json:"edges"`
+	mindmap_graph_edits *uuid.UUID
+	selectValues        sql.SelectValues
+}
+
+// MindmapEditEdges holds the relations/edges for other nodes in the graph.
+type MindmapEditEdges struct {
+	// Mindmap holds the value of the mindmap edge.
+	Mindmap *MindmapGraph `json:"mindmap,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MindmapOrErr returns the Mindmap value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MindmapEditEdges) MindmapOrErr() (*MindmapGraph, error) {
+	if e.Mindmap != nil {
+		return e.Mindmap, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: mindmapgraph.Label}
+	}
+	return nil, &NotLoadedError{edge: "mindmap"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MindmapEdit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mindmapedit.FieldPayload:
+			values[i] = new([]byte)
+		case mindmapedit.FieldID:
+			values[i] = new(sql.NullInt64)
+		case mindmapedit.FieldOp:
+			values[i] = new(sql.NullString)
+		case mindmapedit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case mindmapedit.ForeignKeys[0]: // mindmap_graph_edits
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MindmapEdit fields.
+func (_m *MindmapEdit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mindmapedit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case mindmapedit.FieldOp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field op", values[i])
+			} else if value.Valid {
+				_m.Op = mindmapedit.Op(value.String)
+			}
+		case mindmapedit.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case mindmapedit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case mindmapedit.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field mindmap_graph_edits", values[i])
+			} else if value.Valid {
+				_m.mindmap_graph_edits = new(uuid.UUID)
+				*_m.mindmap_graph_edits = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MindmapEdit.
+// This includes values selected through modifiers, order, etc.
+func (_m *MindmapEdit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMindmap queries the "mindmap" edge of the MindmapEdit entity.
+func (_m *MindmapEdit) QueryMindmap() *MindmapGraphQuery {
+	return NewMindmapEditClient(_m.config).QueryMindmap(_m)
+}
+
+// Update returns a builder for updating this MindmapEdit.
+// Note that you need to call MindmapEdit.Unwrap() before calling this method if this MindmapEdit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MindmapEdit) Update() *MindmapEditUpdateOne {
+	return NewMindmapEditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MindmapEdit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MindmapEdit) Unwrap() *MindmapEdit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MindmapEdit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MindmapEdit) String() string {
+	var builder strings.Builder
+	builder.WriteString("MindmapEdit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("op=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Op))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MindmapEdits is a parsable slice of MindmapEdit.
+type MindmapEdits []*MindmapEdit
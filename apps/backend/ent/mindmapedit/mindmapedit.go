@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mindmapedit
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the mindmapedit type in the database.
+	Label = "mindmap_edit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOp holds the string denoting the op field in the database.
+	FieldOp = "op"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMindmap holds the string denoting the mindmap edge name in mutations.
+	EdgeMindmap = "mindmap"
+	// Table holds the table name of the mindmapedit in the database.
+	Table = "mindmap_edits"
+	// MindmapTable is the table that holds the mindmap relation/edge.
+	MindmapTable = "mindmap_edits"
+	// MindmapInverseTable is the table name for the MindmapGraph entity.
+	// It exists in this package in order to avoid circular dependency with the "mindmapgraph" package.
+	MindmapInverseTable = "mindmap_graphs"
+	// MindmapColumn is the table column denoting the mindmap relation/edge.
+	MindmapColumn = "mindmap_graph_edits"
+)
+
+// Columns holds all SQL columns for mindmapedit fields.
+var Columns = []string{
+	FieldID,
+	FieldOp,
+	FieldPayload,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "mindmap_edits"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"mindmap_graph_edits",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Op defines the type for the "op" enum field.
+type Op string
+
+// Op values.
+const (
+	OpRename   Op = "rename"
+	OpRecolor  Op = "recolor"
+	OpPin      Op = "pin"
+	OpDelete   Op = "delete"
+	OpMerge    Op = "merge"
+	OpSplit    Op = "split"
+	OpMovePage Op = "move_page"
+	OpAddEdge  Op = "add_edge"
+	OpNote     Op = "note"
+)
+
+func (_op Op) String() string {
+	return string(_op)
+}
+
+// OpValidator is a validator for the "op" field enum values. It is called by the builders before save.
+func OpValidator(_op Op) error {
+	switch _op {
+	case OpRename, OpRecolor, OpPin, OpDelete, OpMerge, OpSplit, OpMovePage, OpAddEdge, OpNote:
+		return nil
+	default:
+		return fmt.Errorf("mindmapedit: invalid enum value for op field: %q", _op)
+	}
+}
+
+// OrderOption defines the ordering options for the MindmapEdit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOp orders the results by the op field.
+func ByOp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOp, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMindmapField orders the results by mindmap field.
+func ByMindmapField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMindmapStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMindmapStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MindmapInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MindmapTable, MindmapColumn),
+	)
+}
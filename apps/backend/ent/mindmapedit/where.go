@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mindmapedit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OpEQ applies the EQ predicate on the "op" field.
+func OpEQ(v Op) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldEQ(FieldOp, v))
+}
+
+// OpNEQ applies the NEQ predicate on the "op" field.
+func OpNEQ(v Op) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldNEQ(FieldOp, v))
+}
+
+// OpIn applies the In predicate on the "op" field.
+func OpIn(vs ...Op) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldIn(FieldOp, vs...))
+}
+
+// OpNotIn applies the NotIn predicate on the "op" field.
+func OpNotIn(vs ...Op) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldNotIn(FieldOp, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMindmap applies the HasEdge predicate on the "mindmap" edge.
+func HasMindmap() predicate.MindmapEdit {
+	return predicate.MindmapEdit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MindmapTable, MindmapColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMindmapWith applies the HasEdge predicate on the "mindmap" edge with a given conditions (other predicates).
+func HasMindmapWith(preds ...predicate.MindmapGraph) predicate.MindmapEdit {
+	return predicate.MindmapEdit(func(s *sql.Selector) {
+		step := newMindmapStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MindmapEdit) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MindmapEdit) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MindmapEdit) predicate.MindmapEdit {
+	return predicate.MindmapEdit(sql.NotPredicates(p))
+}
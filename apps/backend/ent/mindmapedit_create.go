@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/mindmapedit"
+	"github.com/mindhit/api/ent/mindmapgraph"
+)
+
+// MindmapEditCreate is the builder for creating a MindmapEdit entity.
+type MindmapEditCreate struct {
+	config
+	mutation *MindmapEditMutation
+	hooks    []Hook
+}
+
+// SetOp sets the "op" field.
+func (_c *MindmapEditCreate) SetOp(v mindmapedit.Op) *MindmapEditCreate {
+	_c.mutation.SetOpField(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *MindmapEditCreate) SetPayload(v map[string]interface{}) *MindmapEditCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MindmapEditCreate) SetCreatedAt(v time.Time) *MindmapEditCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MindmapEditCreate) SetNillableCreatedAt(v *time.Time) *MindmapEditCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetMindmapID sets the "mindmap" edge to the MindmapGraph entity by ID.
+func (_c *MindmapEditCreate) SetMindmapID(id uuid.UUID) *MindmapEditCreate {
+	_c.mutation.SetMindmapID(id)
+	return _c
+}
+
+// SetMindmap sets the "mindmap" edge to the MindmapGraph entity.
+func (_c *MindmapEditCreate) SetMindmap(v *MindmapGraph) *MindmapEditCreate {
+	return _c.SetMindmapID(v.ID)
+}
+
+// Mutation returns the MindmapEditMutation object of the builder.
+func (_c *MindmapEditCreate) Mutation() *MindmapEditMutation {
+	return _c.mutation
+}
+
+// Save creates the MindmapEdit in the database.
+func (_c *MindmapEditCreate) Save(ctx context.Context) (*MindmapEdit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MindmapEditCreate) SaveX(ctx context.Context) *MindmapEdit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MindmapEditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MindmapEditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MindmapEditCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := mindmapedit.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MindmapEditCreate) check() error {
+	if _, ok := _c.mutation.GetOp(); !ok {
+		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "MindmapEdit.op"`)}
+	}
+	if v, ok := _c.mutation.GetOp(); ok {
+		if err := mindmapedit.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "MindmapEdit.op": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "MindmapEdit.payload"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MindmapEdit.created_at"`)}
+	}
+	if len(_c.mutation.MindmapIDs()) == 0 {
+		return &ValidationError{Name: "mindmap", err: errors.New(`ent: missing required edge "MindmapEdit.mindmap"`)}
+	}
+	return nil
+}
+
+func (_c *MindmapEditCreate) sqlSave(ctx context.Context) (*MindmapEdit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MindmapEditCreate) createSpec() (*MindmapEdit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MindmapEdit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mindmapedit.Table, sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.GetOp(); ok {
+		_spec.SetField(mindmapedit.FieldOp, field.TypeEnum, value)
+		_node.Op = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(mindmapedit.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(mindmapedit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.MindmapIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mindmapedit.MindmapTable,
+			Columns: []string{mindmapedit.MindmapColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapgraph.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.mindmap_graph_edits = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MindmapEditCreateBulk is the builder for creating many MindmapEdit entities in bulk.
+type MindmapEditCreateBulk struct {
+	config
+	err      error
+	builders []*MindmapEditCreate
+}
+
+// Save creates the MindmapEdit entities in the database.
+func (_c *MindmapEditCreateBulk) Save(ctx context.Context) ([]*MindmapEdit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MindmapEdit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MindmapEditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MindmapEditCreateBulk) SaveX(ctx context.Context) []*MindmapEdit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MindmapEditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MindmapEditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
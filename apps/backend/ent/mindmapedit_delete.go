@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/mindmapedit"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// MindmapEditDelete is the builder for deleting a MindmapEdit entity.
+type MindmapEditDelete struct {
+	config
+	hooks    []Hook
+	mutation *MindmapEditMutation
+}
+
+// Where appends a list predicates to the MindmapEditDelete builder.
+func (_d *MindmapEditDelete) Where(ps ...predicate.MindmapEdit) *MindmapEditDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MindmapEditDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MindmapEditDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MindmapEditDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mindmapedit.Table, sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MindmapEditDeleteOne is the builder for deleting a single MindmapEdit entity.
+type MindmapEditDeleteOne struct {
+	_d *MindmapEditDelete
+}
+
+// Where appends a list predicates to the MindmapEditDelete builder.
+func (_d *MindmapEditDeleteOne) Where(ps ...predicate.MindmapEdit) *MindmapEditDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MindmapEditDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mindmapedit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MindmapEditDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
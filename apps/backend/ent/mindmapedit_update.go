@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/mindmapedit"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// MindmapEditUpdate is the builder for updating MindmapEdit entities.
+type MindmapEditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MindmapEditMutation
+}
+
+// Where appends a list predicates to the MindmapEditUpdate builder.
+func (_u *MindmapEditUpdate) Where(ps ...predicate.MindmapEdit) *MindmapEditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the MindmapEditMutation object of the builder.
+func (_u *MindmapEditUpdate) Mutation() *MindmapEditMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MindmapEditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MindmapEditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MindmapEditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MindmapEditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MindmapEditUpdate) check() error {
+	if _u.mutation.MindmapCleared() && len(_u.mutation.MindmapIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MindmapEdit.mindmap"`)
+	}
+	return nil
+}
+
+func (_u *MindmapEditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mindmapedit.Table, mindmapedit.Columns, sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mindmapedit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MindmapEditUpdateOne is the builder for updating a single MindmapEdit entity.
+type MindmapEditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MindmapEditMutation
+}
+
+// Mutation returns the MindmapEditMutation object of the builder.
+func (_u *MindmapEditUpdateOne) Mutation() *MindmapEditMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MindmapEditUpdate builder.
+func (_u *MindmapEditUpdateOne) Where(ps ...predicate.MindmapEdit) *MindmapEditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MindmapEditUpdateOne) Select(field string, fields ...string) *MindmapEditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MindmapEdit entity.
+func (_u *MindmapEditUpdateOne) Save(ctx context.Context) (*MindmapEdit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MindmapEditUpdateOne) SaveX(ctx context.Context) *MindmapEdit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MindmapEditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MindmapEditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MindmapEditUpdateOne) check() error {
+	if _u.mutation.MindmapCleared() && len(_u.mutation.MindmapIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MindmapEdit.mindmap"`)
+	}
+	return nil
+}
+
+func (_u *MindmapEditUpdateOne) sqlSave(ctx context.Context) (_node *MindmapEdit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mindmapedit.Table, mindmapedit.Columns, sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MindmapEdit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mindmapedit.FieldID)
+		for _, f := range fields {
+			if !mindmapedit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mindmapedit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &MindmapEdit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mindmapedit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
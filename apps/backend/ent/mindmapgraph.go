@@ -37,7 +37,7 @@ type MindmapGraph struct {
 	ErrorMessage *string `json:"error_message,omitempty"`
 	// AI generation timestamp
 	GeneratedAt time.Time `json:"generated_at,omitempty"`
-	// Mindmap version for regeneration tracking
+	// Version of the current graph, bumped by every regeneration and restore
 	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MindmapGraphQuery when eager-loading is set.
@@ -50,9 +50,13 @@ type MindmapGraph struct {
 type MindmapGraphEdges struct {
 	// Session holds the value of the session edge.
 	Session *Session `json:"session,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*MindmapRevision `json:"revisions,omitempty"`
+	// Edits holds the value of the edits edge.
+	Edits []*MindmapEdit `json:"edits,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // SessionOrErr returns the Session value or an error if the edge
@@ -66,6 +70,24 @@ func (e MindmapGraphEdges) SessionOrErr() (*Session, error) {
 	return nil, &NotLoadedError{edge: "session"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MindmapGraphEdges) RevisionsOrErr() ([]*MindmapRevision, error) {
+	if e.loadedTypes[1] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// EditsOrErr returns the Edits value or an error if the edge
+// was not loaded in eager-loading.
+func (e MindmapGraphEdges) EditsOrErr() ([]*MindmapEdit, error) {
+	if e.loadedTypes[2] {
+		return e.Edits, nil
+	}
+	return nil, &NotLoadedError{edge: "edits"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*MindmapGraph) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -190,6 +212,16 @@ func (_m *MindmapGraph) QuerySession() *SessionQuery {
 	return NewMindmapGraphClient(_m.config).QuerySession(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the MindmapGraph entity.
+func (_m *MindmapGraph) QueryRevisions() *MindmapRevisionQuery {
+	return NewMindmapGraphClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryEdits queries the "edits" edge of the MindmapGraph entity.
+func (_m *MindmapGraph) QueryEdits() *MindmapEditQuery {
+	return NewMindmapGraphClient(_m.config).QueryEdits(_m)
+}
+
 // Update returns a builder for updating this MindmapGraph.
 // Note that you need to call MindmapGraph.Unwrap() before calling this method if this MindmapGraph
 // was returned from a transaction, and the transaction was committed or rolled back.
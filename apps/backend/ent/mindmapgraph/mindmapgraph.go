@@ -36,6 +36,10 @@ const (
 	FieldVersion = "version"
 	// EdgeSession holds the string denoting the session edge name in mutations.
 	EdgeSession = "session"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeEdits holds the string denoting the edits edge name in mutations.
+	EdgeEdits = "edits"
 	// Table holds the table name of the mindmapgraph in the database.
 	Table = "mindmap_graphs"
 	// SessionTable is the table that holds the session relation/edge.
@@ -45,6 +49,20 @@ const (
 	SessionInverseTable = "sessions"
 	// SessionColumn is the table column denoting the session relation/edge.
 	SessionColumn = "session_mindmap"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "mindmap_revisions"
+	// RevisionsInverseTable is the table name for the MindmapRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "mindmaprevision" package.
+	RevisionsInverseTable = "mindmap_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "mindmap_graph_revisions"
+	// EditsTable is the table that holds the edits relation/edge.
+	EditsTable = "mindmap_edits"
+	// EditsInverseTable is the table name for the MindmapEdit entity.
+	// It exists in this package in order to avoid circular dependency with the "mindmapedit" package.
+	EditsInverseTable = "mindmap_edits"
+	// EditsColumn is the table column denoting the edits relation/edge.
+	EditsColumn = "mindmap_graph_edits"
 )
 
 // Columns holds all SQL columns for mindmapgraph fields.
@@ -169,6 +187,34 @@ func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSessionStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEditsCount orders the results by edits count.
+func ByEditsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEditsStep(), opts...)
+	}
+}
+
+// ByEdits orders the results by edits terms.
+func ByEdits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEditsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSessionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -176,3 +222,17 @@ func newSessionStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, SessionTable, SessionColumn),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newEditsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EditsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EditsTable, EditsColumn),
+	)
+}
@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/mindmapedit"
 	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
 	"github.com/mindhit/api/ent/session"
 )
 
@@ -149,6 +151,36 @@ func (_c *MindmapGraphCreate) SetSession(v *Session) *MindmapGraphCreate {
 	return _c.SetSessionID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the MindmapRevision entity by IDs.
+func (_c *MindmapGraphCreate) AddRevisionIDs(ids ...uuid.UUID) *MindmapGraphCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the MindmapRevision entity.
+func (_c *MindmapGraphCreate) AddRevisions(v ...*MindmapRevision) *MindmapGraphCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddEditIDs adds the "edits" edge to the MindmapEdit entity by IDs.
+func (_c *MindmapGraphCreate) AddEditIDs(ids ...int) *MindmapGraphCreate {
+	_c.mutation.AddEditIDs(ids...)
+	return _c
+}
+
+// AddEdits adds the "edits" edges to the MindmapEdit entity.
+func (_c *MindmapGraphCreate) AddEdits(v ...*MindmapEdit) *MindmapGraphCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEditIDs(ids...)
+}
+
 // Mutation returns the MindmapGraphMutation object of the builder.
 func (_c *MindmapGraphCreate) Mutation() *MindmapGraphMutation {
 	return _c.mutation
@@ -323,6 +355,38 @@ func (_c *MindmapGraphCreate) createSpec() (*MindmapGraph, *sqlgraph.CreateSpec)
 		_node.session_mindmap = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.RevisionsTable,
+			Columns: []string{mindmapgraph.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.EditsTable,
+			Columns: []string{mindmapgraph.EditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
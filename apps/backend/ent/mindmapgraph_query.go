@@ -4,15 +4,19 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/mindmapedit"
 	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
 	"github.com/mindhit/api/ent/predicate"
 	"github.com/mindhit/api/ent/session"
 )
@@ -20,12 +24,15 @@ import (
 // MindmapGraphQuery is the builder for querying MindmapGraph entities.
 type MindmapGraphQuery struct {
 	config
-	ctx         *QueryContext
-	order       []mindmapgraph.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.MindmapGraph
-	withSession *SessionQuery
-	withFKs     bool
+	ctx           *QueryContext
+	order         []mindmapgraph.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.MindmapGraph
+	withSession   *SessionQuery
+	withRevisions *MindmapRevisionQuery
+	withEdits     *MindmapEditQuery
+	withFKs       bool
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +91,50 @@ func (_q *MindmapGraphQuery) QuerySession() *SessionQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *MindmapGraphQuery) QueryRevisions() *MindmapRevisionQuery {
+	query := (&MindmapRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mindmapgraph.Table, mindmapgraph.FieldID, selector),
+			sqlgraph.To(mindmaprevision.Table, mindmaprevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, mindmapgraph.RevisionsTable, mindmapgraph.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEdits chains the current query on the "edits" edge.
+func (_q *MindmapGraphQuery) QueryEdits() *MindmapEditQuery {
+	query := (&MindmapEditClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mindmapgraph.Table, mindmapgraph.FieldID, selector),
+			sqlgraph.To(mindmapedit.Table, mindmapedit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, mindmapgraph.EditsTable, mindmapgraph.EditsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first MindmapGraph entity from the query.
 // Returns a *NotFoundError when no MindmapGraph was found.
 func (_q *MindmapGraphQuery) First(ctx context.Context) (*MindmapGraph, error) {
@@ -271,12 +322,14 @@ func (_q *MindmapGraphQuery) Clone() *MindmapGraphQuery {
 		return nil
 	}
 	return &MindmapGraphQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]mindmapgraph.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.MindmapGraph{}, _q.predicates...),
-		withSession: _q.withSession.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]mindmapgraph.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.MindmapGraph{}, _q.predicates...),
+		withSession:   _q.withSession.Clone(),
+		withRevisions: _q.withRevisions.Clone(),
+		withEdits:     _q.withEdits.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,6 +347,28 @@ func (_q *MindmapGraphQuery) WithSession(opts ...func(*SessionQuery)) *MindmapGr
 	return _q
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MindmapGraphQuery) WithRevisions(opts ...func(*MindmapRevisionQuery)) *MindmapGraphQuery {
+	query := (&MindmapRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithEdits tells the query-builder to eager-load the nodes that are connected to
+// the "edits" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MindmapGraphQuery) WithEdits(opts ...func(*MindmapEditQuery)) *MindmapGraphQuery {
+	query := (&MindmapEditClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEdits = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +448,10 @@ func (_q *MindmapGraphQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		nodes       = []*MindmapGraph{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withSession != nil,
+			_q.withRevisions != nil,
+			_q.withEdits != nil,
 		}
 	)
 	if _q.withSession != nil {
@@ -392,6 +469,9 @@ func (_q *MindmapGraphQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -407,6 +487,20 @@ func (_q *MindmapGraphQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *MindmapGraph) { n.Edges.Revisions = []*MindmapRevision{} },
+			func(n *MindmapGraph, e *MindmapRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEdits; query != nil {
+		if err := _q.loadEdits(ctx, query, nodes,
+			func(n *MindmapGraph) { n.Edges.Edits = []*MindmapEdit{} },
+			func(n *MindmapGraph, e *MindmapEdit) { n.Edges.Edits = append(n.Edges.Edits, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -442,9 +536,74 @@ func (_q *MindmapGraphQuery) loadSession(ctx context.Context, query *SessionQuer
 	}
 	return nil
 }
+func (_q *MindmapGraphQuery) loadRevisions(ctx context.Context, query *MindmapRevisionQuery, nodes []*MindmapGraph, init func(*MindmapGraph), assign func(*MindmapGraph, *MindmapRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*MindmapGraph)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MindmapRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(mindmapgraph.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.mindmap_graph_revisions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "mindmap_graph_revisions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "mindmap_graph_revisions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MindmapGraphQuery) loadEdits(ctx context.Context, query *MindmapEditQuery, nodes []*MindmapGraph, init func(*MindmapGraph), assign func(*MindmapGraph, *MindmapEdit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*MindmapGraph)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MindmapEdit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(mindmapgraph.EditsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.mindmap_graph_edits
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "mindmap_graph_edits" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "mindmap_graph_edits" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *MindmapGraphQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -507,6 +666,9 @@ func (_q *MindmapGraphQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -524,6 +686,32 @@ func (_q *MindmapGraphQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *MindmapGraphQuery) ForUpdate(opts ...sql.LockOption) *MindmapGraphQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *MindmapGraphQuery) ForShare(opts ...sql.LockOption) *MindmapGraphQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // MindmapGraphGroupBy is the group-by builder for MindmapGraph entities.
 type MindmapGraphGroupBy struct {
 	selector
@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/mindmapedit"
 	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
 	"github.com/mindhit/api/ent/predicate"
 	"github.com/mindhit/api/ent/session"
 )
@@ -165,6 +167,36 @@ func (_u *MindmapGraphUpdate) SetSession(v *Session) *MindmapGraphUpdate {
 	return _u.SetSessionID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the MindmapRevision entity by IDs.
+func (_u *MindmapGraphUpdate) AddRevisionIDs(ids ...uuid.UUID) *MindmapGraphUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the MindmapRevision entity.
+func (_u *MindmapGraphUpdate) AddRevisions(v ...*MindmapRevision) *MindmapGraphUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddEditIDs adds the "edits" edge to the MindmapEdit entity by IDs.
+func (_u *MindmapGraphUpdate) AddEditIDs(ids ...int) *MindmapGraphUpdate {
+	_u.mutation.AddEditIDs(ids...)
+	return _u
+}
+
+// AddEdits adds the "edits" edges to the MindmapEdit entity.
+func (_u *MindmapGraphUpdate) AddEdits(v ...*MindmapEdit) *MindmapGraphUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEditIDs(ids...)
+}
+
 // Mutation returns the MindmapGraphMutation object of the builder.
 func (_u *MindmapGraphUpdate) Mutation() *MindmapGraphMutation {
 	return _u.mutation
@@ -176,6 +208,48 @@ func (_u *MindmapGraphUpdate) ClearSession() *MindmapGraphUpdate {
 	return _u
 }
 
+// ClearRevisions clears all "revisions" edges to the MindmapRevision entity.
+func (_u *MindmapGraphUpdate) ClearRevisions() *MindmapGraphUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MindmapRevision entities by IDs.
+func (_u *MindmapGraphUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *MindmapGraphUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to MindmapRevision entities.
+func (_u *MindmapGraphUpdate) RemoveRevisions(v ...*MindmapRevision) *MindmapGraphUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearEdits clears all "edits" edges to the MindmapEdit entity.
+func (_u *MindmapGraphUpdate) ClearEdits() *MindmapGraphUpdate {
+	_u.mutation.ClearEdits()
+	return _u
+}
+
+// RemoveEditIDs removes the "edits" edge to MindmapEdit entities by IDs.
+func (_u *MindmapGraphUpdate) RemoveEditIDs(ids ...int) *MindmapGraphUpdate {
+	_u.mutation.RemoveEditIDs(ids...)
+	return _u
+}
+
+// RemoveEdits removes "edits" edges to MindmapEdit entities.
+func (_u *MindmapGraphUpdate) RemoveEdits(v ...*MindmapEdit) *MindmapGraphUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEditIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *MindmapGraphUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -315,6 +389,96 @@ func (_u *MindmapGraphUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.RevisionsTable,
+			Columns: []string{mindmapgraph.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.RevisionsTable,
+			Columns: []string{mindmapgraph.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.RevisionsTable,
+			Columns: []string{mindmapgraph.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.EditsTable,
+			Columns: []string{mindmapgraph.EditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEditsIDs(); len(nodes) > 0 && !_u.mutation.EditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.EditsTable,
+			Columns: []string{mindmapgraph.EditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.EditsTable,
+			Columns: []string{mindmapgraph.EditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{mindmapgraph.Label}
@@ -469,6 +633,36 @@ func (_u *MindmapGraphUpdateOne) SetSession(v *Session) *MindmapGraphUpdateOne {
 	return _u.SetSessionID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the MindmapRevision entity by IDs.
+func (_u *MindmapGraphUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *MindmapGraphUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the MindmapRevision entity.
+func (_u *MindmapGraphUpdateOne) AddRevisions(v ...*MindmapRevision) *MindmapGraphUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddEditIDs adds the "edits" edge to the MindmapEdit entity by IDs.
+func (_u *MindmapGraphUpdateOne) AddEditIDs(ids ...int) *MindmapGraphUpdateOne {
+	_u.mutation.AddEditIDs(ids...)
+	return _u
+}
+
+// AddEdits adds the "edits" edges to the MindmapEdit entity.
+func (_u *MindmapGraphUpdateOne) AddEdits(v ...*MindmapEdit) *MindmapGraphUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEditIDs(ids...)
+}
+
 // Mutation returns the MindmapGraphMutation object of the builder.
 func (_u *MindmapGraphUpdateOne) Mutation() *MindmapGraphMutation {
 	return _u.mutation
@@ -480,6 +674,48 @@ func (_u *MindmapGraphUpdateOne) ClearSession() *MindmapGraphUpdateOne {
 	return _u
 }
 
+// ClearRevisions clears all "revisions" edges to the MindmapRevision entity.
+func (_u *MindmapGraphUpdateOne) ClearRevisions() *MindmapGraphUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MindmapRevision entities by IDs.
+func (_u *MindmapGraphUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *MindmapGraphUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to MindmapRevision entities.
+func (_u *MindmapGraphUpdateOne) RemoveRevisions(v ...*MindmapRevision) *MindmapGraphUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearEdits clears all "edits" edges to the MindmapEdit entity.
+func (_u *MindmapGraphUpdateOne) ClearEdits() *MindmapGraphUpdateOne {
+	_u.mutation.ClearEdits()
+	return _u
+}
+
+// RemoveEditIDs removes the "edits" edge to MindmapEdit entities by IDs.
+func (_u *MindmapGraphUpdateOne) RemoveEditIDs(ids ...int) *MindmapGraphUpdateOne {
+	_u.mutation.RemoveEditIDs(ids...)
+	return _u
+}
+
+// RemoveEdits removes "edits" edges to MindmapEdit entities.
+func (_u *MindmapGraphUpdateOne) RemoveEdits(v ...*MindmapEdit) *MindmapGraphUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEditIDs(ids...)
+}
+
 // Where appends a list predicates to the MindmapGraphUpdate builder.
 func (_u *MindmapGraphUpdateOne) Where(ps ...predicate.MindmapGraph) *MindmapGraphUpdateOne {
 	_u.mutation.Where(ps...)
@@ -649,6 +885,96 @@ func (_u *MindmapGraphUpdateOne) sqlSave(ctx context.Context) (_node *MindmapGra
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.RevisionsTable,
+			Columns: []string{mindmapgraph.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.RevisionsTable,
+			Columns: []string{mindmapgraph.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.RevisionsTable,
+			Columns: []string{mindmapgraph.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.EditsTable,
+			Columns: []string{mindmapgraph.EditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEditsIDs(); len(nodes) > 0 && !_u.mutation.EditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.EditsTable,
+			Columns: []string{mindmapgraph.EditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mindmapgraph.EditsTable,
+			Columns: []string{mindmapgraph.EditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapedit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &MindmapGraph{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
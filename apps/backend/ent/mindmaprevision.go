@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
+)
+
+// MindmapRevision is the model entity for the MindmapRevision schema.
+type MindmapRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// Mindmap version this revision was recorded as
+	Version int `json:"version,omitempty"`
+	// Mindmap node data
+	Nodes []map[string]interface{} `json:"nodes,omitempty"`
+	// Mindmap edge data
+	GraphEdges []map[string]interface{} `json:"graph_edges,omitempty"`
+	// Layout configuration
+	Layout map[string]interface{} `json:"layout,omitempty"`
+	// Version this revision restored, unset for generations
+	RestoredFrom *int `json:"restored_from,omitempty"`
+	// AI generation timestamp of the graph
+	GeneratedAt time.Time `json:"generated_at,omitempty"`
+	// Record creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MindmapRevisionQuery when eager-loading is set.
+	Edges                   MindmapRevisionEdges `json:"edges"`
+	mindmap_graph_revisions *uuid.UUID
+	selectValues            sql.SelectValues
+}
+
+// MindmapRevisionEdges holds the relations/edges for other nodes in the graph.
+type MindmapRevisionEdges struct {
+	// Mindmap holds the value of the mindmap edge.
+	Mindmap *MindmapGraph `json:"mindmap,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MindmapOrErr returns the Mindmap value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MindmapRevisionEdges) MindmapOrErr() (*MindmapGraph, error) {
+	if e.Mindmap != nil {
+		return e.Mindmap, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: mindmapgraph.Label}
+	}
+	return nil, &NotLoadedError{edge: "mindmap"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MindmapRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mindmaprevision.FieldNodes, mindmaprevision.FieldGraphEdges, mindmaprevision.FieldLayout:
+			values[i] = new([]byte)
+		case mindmaprevision.FieldVersion, mindmaprevision.FieldRestoredFrom:
+			values[i] = new(sql.NullInt64)
+		case mindmaprevision.FieldGeneratedAt, mindmaprevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case mindmaprevision.FieldID:
+			values[i] = new(uuid.UUID)
+		case mindmaprevision.ForeignKeys[0]: // mindmap_graph_revisions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MindmapRevision fields.
+func (_m *MindmapRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mindmaprevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case mindmaprevision.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case mindmaprevision.FieldNodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field nodes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Nodes); err != nil {
+					return fmt.Errorf("unmarshal field nodes: %w", err)
+				}
+			}
+		case mindmaprevision.FieldGraphEdges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field graph_edges", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GraphEdges); err != nil {
+					return fmt.Errorf("unmarshal field graph_edges: %w", err)
+				}
+			}
+		case mindmaprevision.FieldLayout:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field layout", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Layout); err != nil {
+					return fmt.Errorf("unmarshal field layout: %w", err)
+				}
+			}
+		case mindmaprevision.FieldRestoredFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_from", values[i])
+			} else if value.Valid {
+				_m.RestoredFrom = new(int)
+				*_m.RestoredFrom = int(value.Int64)
+			}
+		case mindmaprevision.FieldGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field generated_at", values[i])
+			} else if value.Valid {
+				_m.GeneratedAt = value.Time
+			}
+		case mindmaprevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case mindmaprevision.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field mindmap_graph_revisions", values[i])
+			} else if value.Valid {
+				_m.mindmap_graph_revisions = new(uuid.UUID)
+				*_m.mindmap_graph_revisions = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MindmapRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *MindmapRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMindmap queries the "mindmap" edge of the MindmapRevision entity.
+func (_m *MindmapRevision) QueryMindmap() *MindmapGraphQuery {
+	return NewMindmapRevisionClient(_m.config).QueryMindmap(_m)
+}
+
+// Update returns a builder for updating this MindmapRevision.
+// Note that you need to call MindmapRevision.Unwrap() before calling this method if this MindmapRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MindmapRevision) Update() *MindmapRevisionUpdateOne {
+	return NewMindmapRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MindmapRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MindmapRevision) Unwrap() *MindmapRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MindmapRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MindmapRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("MindmapRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("nodes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Nodes))
+	builder.WriteString(", ")
+	builder.WriteString("graph_edges=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GraphEdges))
+	builder.WriteString(", ")
+	builder.WriteString("layout=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Layout))
+	builder.WriteString(", ")
+	if v := _m.RestoredFrom; v != nil {
+		builder.WriteString("restored_from=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("generated_at=")
+	builder.WriteString(_m.GeneratedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MindmapRevisions is a parsable slice of MindmapRevision.
+type MindmapRevisions []*MindmapRevision
@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mindmaprevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the mindmaprevision type in the database.
+	Label = "mindmap_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldNodes holds the string denoting the nodes field in the database.
+	FieldNodes = "nodes"
+	// FieldGraphEdges holds the string denoting the graph_edges field in the database.
+	FieldGraphEdges = "graph_edges"
+	// FieldLayout holds the string denoting the layout field in the database.
+	FieldLayout = "layout"
+	// FieldRestoredFrom holds the string denoting the restored_from field in the database.
+	FieldRestoredFrom = "restored_from"
+	// FieldGeneratedAt holds the string denoting the generated_at field in the database.
+	FieldGeneratedAt = "generated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMindmap holds the string denoting the mindmap edge name in mutations.
+	EdgeMindmap = "mindmap"
+	// Table holds the table name of the mindmaprevision in the database.
+	Table = "mindmap_revisions"
+	// MindmapTable is the table that holds the mindmap relation/edge.
+	MindmapTable = "mindmap_revisions"
+	// MindmapInverseTable is the table name for the MindmapGraph entity.
+	// It exists in this package in order to avoid circular dependency with the "mindmapgraph" package.
+	MindmapInverseTable = "mindmap_graphs"
+	// MindmapColumn is the table column denoting the mindmap relation/edge.
+	MindmapColumn = "mindmap_graph_revisions"
+)
+
+// Columns holds all SQL columns for mindmaprevision fields.
+var Columns = []string{
+	FieldID,
+	FieldVersion,
+	FieldNodes,
+	FieldGraphEdges,
+	FieldLayout,
+	FieldRestoredFrom,
+	FieldGeneratedAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "mindmap_revisions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"mindmap_graph_revisions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the MindmapRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByRestoredFrom orders the results by the restored_from field.
+func ByRestoredFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestoredFrom, opts...).ToFunc()
+}
+
+// ByGeneratedAt orders the results by the generated_at field.
+func ByGeneratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeneratedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMindmapField orders the results by mindmap field.
+func ByMindmapField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMindmapStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMindmapStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MindmapInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MindmapTable, MindmapColumn),
+	)
+}
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mindmaprevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLTE(FieldID, id))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// RestoredFrom applies equality check predicate on the "restored_from" field. It's identical to RestoredFromEQ.
+func RestoredFrom(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// GeneratedAt applies equality check predicate on the "generated_at" field. It's identical to GeneratedAtEQ.
+func GeneratedAt(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLTE(FieldVersion, v))
+}
+
+// NodesIsNil applies the IsNil predicate on the "nodes" field.
+func NodesIsNil() predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIsNull(FieldNodes))
+}
+
+// NodesNotNil applies the NotNil predicate on the "nodes" field.
+func NodesNotNil() predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotNull(FieldNodes))
+}
+
+// GraphEdgesIsNil applies the IsNil predicate on the "graph_edges" field.
+func GraphEdgesIsNil() predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIsNull(FieldGraphEdges))
+}
+
+// GraphEdgesNotNil applies the NotNil predicate on the "graph_edges" field.
+func GraphEdgesNotNil() predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotNull(FieldGraphEdges))
+}
+
+// LayoutIsNil applies the IsNil predicate on the "layout" field.
+func LayoutIsNil() predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIsNull(FieldLayout))
+}
+
+// LayoutNotNil applies the NotNil predicate on the "layout" field.
+func LayoutNotNil() predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotNull(FieldLayout))
+}
+
+// RestoredFromEQ applies the EQ predicate on the "restored_from" field.
+func RestoredFromEQ(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromNEQ applies the NEQ predicate on the "restored_from" field.
+func RestoredFromNEQ(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromIn applies the In predicate on the "restored_from" field.
+func RestoredFromIn(vs ...int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromNotIn applies the NotIn predicate on the "restored_from" field.
+func RestoredFromNotIn(vs ...int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromGT applies the GT predicate on the "restored_from" field.
+func RestoredFromGT(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGT(FieldRestoredFrom, v))
+}
+
+// RestoredFromGTE applies the GTE predicate on the "restored_from" field.
+func RestoredFromGTE(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGTE(FieldRestoredFrom, v))
+}
+
+// RestoredFromLT applies the LT predicate on the "restored_from" field.
+func RestoredFromLT(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLT(FieldRestoredFrom, v))
+}
+
+// RestoredFromLTE applies the LTE predicate on the "restored_from" field.
+func RestoredFromLTE(v int) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLTE(FieldRestoredFrom, v))
+}
+
+// RestoredFromIsNil applies the IsNil predicate on the "restored_from" field.
+func RestoredFromIsNil() predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIsNull(FieldRestoredFrom))
+}
+
+// RestoredFromNotNil applies the NotNil predicate on the "restored_from" field.
+func RestoredFromNotNil() predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotNull(FieldRestoredFrom))
+}
+
+// GeneratedAtEQ applies the EQ predicate on the "generated_at" field.
+func GeneratedAtEQ(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtNEQ applies the NEQ predicate on the "generated_at" field.
+func GeneratedAtNEQ(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtIn applies the In predicate on the "generated_at" field.
+func GeneratedAtIn(vs ...time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtNotIn applies the NotIn predicate on the "generated_at" field.
+func GeneratedAtNotIn(vs ...time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtGT applies the GT predicate on the "generated_at" field.
+func GeneratedAtGT(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtGTE applies the GTE predicate on the "generated_at" field.
+func GeneratedAtGTE(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGTE(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLT applies the LT predicate on the "generated_at" field.
+func GeneratedAtLT(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLTE applies the LTE predicate on the "generated_at" field.
+func GeneratedAtLTE(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLTE(FieldGeneratedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMindmap applies the HasEdge predicate on the "mindmap" edge.
+func HasMindmap() predicate.MindmapRevision {
+	return predicate.MindmapRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MindmapTable, MindmapColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMindmapWith applies the HasEdge predicate on the "mindmap" edge with a given conditions (other predicates).
+func HasMindmapWith(preds ...predicate.MindmapGraph) predicate.MindmapRevision {
+	return predicate.MindmapRevision(func(s *sql.Selector) {
+		step := newMindmapStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MindmapRevision) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MindmapRevision) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MindmapRevision) predicate.MindmapRevision {
+	return predicate.MindmapRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
+)
+
+// MindmapRevisionCreate is the builder for creating a MindmapRevision entity.
+type MindmapRevisionCreate struct {
+	config
+	mutation *MindmapRevisionMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (_c *MindmapRevisionCreate) SetVersion(v int) *MindmapRevisionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNodes sets the "nodes" field.
+func (_c *MindmapRevisionCreate) SetNodes(v []map[string]interface{}) *MindmapRevisionCreate {
+	_c.mutation.SetNodes(v)
+	return _c
+}
+
+// SetGraphEdges sets the "graph_edges" field.
+func (_c *MindmapRevisionCreate) SetGraphEdges(v []map[string]interface{}) *MindmapRevisionCreate {
+	_c.mutation.SetGraphEdges(v)
+	return _c
+}
+
+// SetLayout sets the "layout" field.
+func (_c *MindmapRevisionCreate) SetLayout(v map[string]interface{}) *MindmapRevisionCreate {
+	_c.mutation.SetLayout(v)
+	return _c
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (_c *MindmapRevisionCreate) SetRestoredFrom(v int) *MindmapRevisionCreate {
+	_c.mutation.SetRestoredFrom(v)
+	return _c
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (_c *MindmapRevisionCreate) SetNillableRestoredFrom(v *int) *MindmapRevisionCreate {
+	if v != nil {
+		_c.SetRestoredFrom(*v)
+	}
+	return _c
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (_c *MindmapRevisionCreate) SetGeneratedAt(v time.Time) *MindmapRevisionCreate {
+	_c.mutation.SetGeneratedAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MindmapRevisionCreate) SetCreatedAt(v time.Time) *MindmapRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MindmapRevisionCreate) SetNillableCreatedAt(v *time.Time) *MindmapRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MindmapRevisionCreate) SetID(v uuid.UUID) *MindmapRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MindmapRevisionCreate) SetNillableID(v *uuid.UUID) *MindmapRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMindmapID sets the "mindmap" edge to the MindmapGraph entity by ID.
+func (_c *MindmapRevisionCreate) SetMindmapID(id uuid.UUID) *MindmapRevisionCreate {
+	_c.mutation.SetMindmapID(id)
+	return _c
+}
+
+// SetMindmap sets the "mindmap" edge to the MindmapGraph entity.
+func (_c *MindmapRevisionCreate) SetMindmap(v *MindmapGraph) *MindmapRevisionCreate {
+	return _c.SetMindmapID(v.ID)
+}
+
+// Mutation returns the MindmapRevisionMutation object of the builder.
+func (_c *MindmapRevisionCreate) Mutation() *MindmapRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the MindmapRevision in the database.
+func (_c *MindmapRevisionCreate) Save(ctx context.Context) (*MindmapRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MindmapRevisionCreate) SaveX(ctx context.Context) *MindmapRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MindmapRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MindmapRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MindmapRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := mindmaprevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := mindmaprevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MindmapRevisionCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "MindmapRevision.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := mindmaprevision.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "MindmapRevision.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GeneratedAt(); !ok {
+		return &ValidationError{Name: "generated_at", err: errors.New(`ent: missing required field "MindmapRevision.generated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MindmapRevision.created_at"`)}
+	}
+	if len(_c.mutation.MindmapIDs()) == 0 {
+		return &ValidationError{Name: "mindmap", err: errors.New(`ent: missing required edge "MindmapRevision.mindmap"`)}
+	}
+	return nil
+}
+
+func (_c *MindmapRevisionCreate) sqlSave(ctx context.Context) (*MindmapRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MindmapRevisionCreate) createSpec() (*MindmapRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MindmapRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mindmaprevision.Table, sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(mindmaprevision.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Nodes(); ok {
+		_spec.SetField(mindmaprevision.FieldNodes, field.TypeJSON, value)
+		_node.Nodes = value
+	}
+	if value, ok := _c.mutation.GraphEdges(); ok {
+		_spec.SetField(mindmaprevision.FieldGraphEdges, field.TypeJSON, value)
+		_node.GraphEdges = value
+	}
+	if value, ok := _c.mutation.Layout(); ok {
+		_spec.SetField(mindmaprevision.FieldLayout, field.TypeJSON, value)
+		_node.Layout = value
+	}
+	if value, ok := _c.mutation.RestoredFrom(); ok {
+		_spec.SetField(mindmaprevision.FieldRestoredFrom, field.TypeInt, value)
+		_node.RestoredFrom = &value
+	}
+	if value, ok := _c.mutation.GeneratedAt(); ok {
+		_spec.SetField(mindmaprevision.FieldGeneratedAt, field.TypeTime, value)
+		_node.GeneratedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(mindmaprevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.MindmapIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mindmaprevision.MindmapTable,
+			Columns: []string{mindmaprevision.MindmapColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mindmapgraph.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.mindmap_graph_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MindmapRevisionCreateBulk is the builder for creating many MindmapRevision entities in bulk.
+type MindmapRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*MindmapRevisionCreate
+}
+
+// Save creates the MindmapRevision entities in the database.
+func (_c *MindmapRevisionCreateBulk) Save(ctx context.Context) ([]*MindmapRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MindmapRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MindmapRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MindmapRevisionCreateBulk) SaveX(ctx context.Context) []*MindmapRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MindmapRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MindmapRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
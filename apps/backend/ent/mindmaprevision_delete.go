@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/mindmaprevision"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// MindmapRevisionDelete is the builder for deleting a MindmapRevision entity.
+type MindmapRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *MindmapRevisionMutation
+}
+
+// Where appends a list predicates to the MindmapRevisionDelete builder.
+func (_d *MindmapRevisionDelete) Where(ps ...predicate.MindmapRevision) *MindmapRevisionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MindmapRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MindmapRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MindmapRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mindmaprevision.Table, sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MindmapRevisionDeleteOne is the builder for deleting a single MindmapRevision entity.
+type MindmapRevisionDeleteOne struct {
+	_d *MindmapRevisionDelete
+}
+
+// Where appends a list predicates to the MindmapRevisionDelete builder.
+func (_d *MindmapRevisionDeleteOne) Where(ps ...predicate.MindmapRevision) *MindmapRevisionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MindmapRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mindmaprevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MindmapRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
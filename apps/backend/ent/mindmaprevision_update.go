@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/mindmaprevision"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// MindmapRevisionUpdate is the builder for updating MindmapRevision entities.
+type MindmapRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MindmapRevisionMutation
+}
+
+// Where appends a list predicates to the MindmapRevisionUpdate builder.
+func (_u *MindmapRevisionUpdate) Where(ps ...predicate.MindmapRevision) *MindmapRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the MindmapRevisionMutation object of the builder.
+func (_u *MindmapRevisionUpdate) Mutation() *MindmapRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MindmapRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MindmapRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MindmapRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MindmapRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MindmapRevisionUpdate) check() error {
+	if _u.mutation.MindmapCleared() && len(_u.mutation.MindmapIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MindmapRevision.mindmap"`)
+	}
+	return nil
+}
+
+func (_u *MindmapRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mindmaprevision.Table, mindmaprevision.Columns, sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.NodesCleared() {
+		_spec.ClearField(mindmaprevision.FieldNodes, field.TypeJSON)
+	}
+	if _u.mutation.GraphEdgesCleared() {
+		_spec.ClearField(mindmaprevision.FieldGraphEdges, field.TypeJSON)
+	}
+	if _u.mutation.LayoutCleared() {
+		_spec.ClearField(mindmaprevision.FieldLayout, field.TypeJSON)
+	}
+	if _u.mutation.RestoredFromCleared() {
+		_spec.ClearField(mindmaprevision.FieldRestoredFrom, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mindmaprevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MindmapRevisionUpdateOne is the builder for updating a single MindmapRevision entity.
+type MindmapRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MindmapRevisionMutation
+}
+
+// Mutation returns the MindmapRevisionMutation object of the builder.
+func (_u *MindmapRevisionUpdateOne) Mutation() *MindmapRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MindmapRevisionUpdate builder.
+func (_u *MindmapRevisionUpdateOne) Where(ps ...predicate.MindmapRevision) *MindmapRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MindmapRevisionUpdateOne) Select(field string, fields ...string) *MindmapRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MindmapRevision entity.
+func (_u *MindmapRevisionUpdateOne) Save(ctx context.Context) (*MindmapRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MindmapRevisionUpdateOne) SaveX(ctx context.Context) *MindmapRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MindmapRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MindmapRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MindmapRevisionUpdateOne) check() error {
+	if _u.mutation.MindmapCleared() && len(_u.mutation.MindmapIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MindmapRevision.mindmap"`)
+	}
+	return nil
+}
+
+func (_u *MindmapRevisionUpdateOne) sqlSave(ctx context.Context) (_node *MindmapRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mindmaprevision.Table, mindmaprevision.Columns, sqlgraph.NewFieldSpec(mindmaprevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MindmapRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mindmaprevision.FieldID)
+		for _, f := range fields {
+			if !mindmaprevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mindmaprevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.NodesCleared() {
+		_spec.ClearField(mindmaprevision.FieldNodes, field.TypeJSON)
+	}
+	if _u.mutation.GraphEdgesCleared() {
+		_spec.ClearField(mindmaprevision.FieldGraphEdges, field.TypeJSON)
+	}
+	if _u.mutation.LayoutCleared() {
+		_spec.ClearField(mindmaprevision.FieldLayout, field.TypeJSON)
+	}
+	if _u.mutation.RestoredFromCleared() {
+		_spec.ClearField(mindmaprevision.FieldRestoredFrom, field.TypeInt)
+	}
+	_node = &MindmapRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mindmaprevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mindhit/api/ent/modelpricing"
+)
+
+// ModelPricing is the model entity for the ModelPricing schema.
+type ModelPricing struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// openai, claude, gemini, openai_compatible
+	Provider string `json:"provider,omitempty"`
+	// Model name, also matches the models it is a prefix of
+	Model string `json:"model,omitempty"`
+	// USD per 1M input tokens
+	InputPrice float64 `json:"input_price,omitempty"`
+	// USD per 1M output tokens
+	OutputPrice float64 `json:"output_price,omitempty"`
+	// USD per 1M thinking tokens, the output price when unset
+	ThinkingPrice *float64 `json:"thinking_price,omitempty"`
+	// USD per 1M input tokens read from the prompt cache, the input price when unset
+	CachedInputPrice *float64 `json:"cached_input_price,omitempty"`
+	// When this price starts to apply
+	EffectiveFrom time.Time `json:"effective_from,omitempty"`
+	// When this price stops to apply, open-ended when unset
+	EffectiveUntil *time.Time `json:"effective_until,omitempty"`
+	// Admin who last updated this price
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModelPricing) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case modelpricing.FieldInputPrice, modelpricing.FieldOutputPrice, modelpricing.FieldThinkingPrice, modelpricing.FieldCachedInputPrice:
+			values[i] = new(sql.NullFloat64)
+		case modelpricing.FieldID:
+			values[i] = new(sql.NullInt64)
+		case modelpricing.FieldProvider, modelpricing.FieldModel, modelpricing.FieldUpdatedBy:
+			values[i] = new(sql.NullString)
+		case modelpricing.FieldEffectiveFrom, modelpricing.FieldEffectiveUntil, modelpricing.FieldCreatedAt, modelpricing.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModelPricing fields.
+func (_m *ModelPricing) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case modelpricing.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case modelpricing.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case modelpricing.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case modelpricing.FieldInputPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_price", values[i])
+			} else if value.Valid {
+				_m.InputPrice = value.Float64
+			}
+		case modelpricing.FieldOutputPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_price", values[i])
+			} else if value.Valid {
+				_m.OutputPrice = value.Float64
+			}
+		case modelpricing.FieldThinkingPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field thinking_price", values[i])
+			} else if value.Valid {
+				_m.ThinkingPrice = new(float64)
+				*_m.ThinkingPrice = value.Float64
+			}
+		case modelpricing.FieldCachedInputPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cached_input_price", values[i])
+			} else if value.Valid {
+				_m.CachedInputPrice = new(float64)
+				*_m.CachedInputPrice = value.Float64
+			}
+		case modelpricing.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				_m.EffectiveFrom = value.Time
+			}
+		case modelpricing.FieldEffectiveUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_until", values[i])
+			} else if value.Valid {
+				_m.EffectiveUntil = new(time.Time)
+				*_m.EffectiveUntil = value.Time
+			}
+		case modelpricing.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = value.String
+			}
+		case modelpricing.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case modelpricing.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModelPricing.
+// This includes values selected through modifiers, order, etc.
+func (_m *ModelPricing) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ModelPricing.
+// Note that you need to call ModelPricing.Unwrap() before calling this method if this ModelPricing
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ModelPricing) Update() *ModelPricingUpdateOne {
+	return NewModelPricingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ModelPricing entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ModelPricing) Unwrap() *ModelPricing {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ModelPricing is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ModelPricing) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModelPricing(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("input_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InputPrice))
+	builder.WriteString(", ")
+	builder.WriteString("output_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutputPrice))
+	builder.WriteString(", ")
+	if v := _m.ThinkingPrice; v != nil {
+		builder.WriteString("thinking_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CachedInputPrice; v != nil {
+		builder.WriteString("cached_input_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(_m.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EffectiveUntil; v != nil {
+		builder.WriteString("effective_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(_m.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModelPricings is a parsable slice of ModelPricing.
+type ModelPricings []*ModelPricing
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package modelpricing
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the modelpricing type in the database.
+	Label = "model_pricing"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldInputPrice holds the string denoting the input_price field in the database.
+	FieldInputPrice = "input_price"
+	// FieldOutputPrice holds the string denoting the output_price field in the database.
+	FieldOutputPrice = "output_price"
+	// FieldThinkingPrice holds the string denoting the thinking_price field in the database.
+	FieldThinkingPrice = "thinking_price"
+	// FieldCachedInputPrice holds the string denoting the cached_input_price field in the database.
+	FieldCachedInputPrice = "cached_input_price"
+	// FieldEffectiveFrom holds the string denoting the effective_from field in the database.
+	FieldEffectiveFrom = "effective_from"
+	// FieldEffectiveUntil holds the string denoting the effective_until field in the database.
+	FieldEffectiveUntil = "effective_until"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the modelpricing in the database.
+	Table = "model_pricings"
+)
+
+// Columns holds all SQL columns for modelpricing fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldModel,
+	FieldInputPrice,
+	FieldOutputPrice,
+	FieldThinkingPrice,
+	FieldCachedInputPrice,
+	FieldEffectiveFrom,
+	FieldEffectiveUntil,
+	FieldUpdatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	ModelValidator func(string) error
+	// InputPriceValidator is a validator for the "input_price" field. It is called by the builders before save.
+	InputPriceValidator func(float64) error
+	// OutputPriceValidator is a validator for the "output_price" field. It is called by the builders before save.
+	OutputPriceValidator func(float64) error
+	// ThinkingPriceValidator is a validator for the "thinking_price" field. It is called by the builders before save.
+	ThinkingPriceValidator func(float64) error
+	// CachedInputPriceValidator is a validator for the "cached_input_price" field. It is called by the builders before save.
+	CachedInputPriceValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ModelPricing queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByInputPrice orders the results by the input_price field.
+func ByInputPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputPrice, opts...).ToFunc()
+}
+
+// ByOutputPrice orders the results by the output_price field.
+func ByOutputPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputPrice, opts...).ToFunc()
+}
+
+// ByThinkingPrice orders the results by the thinking_price field.
+func ByThinkingPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThinkingPrice, opts...).ToFunc()
+}
+
+// ByCachedInputPrice orders the results by the cached_input_price field.
+func ByCachedInputPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCachedInputPrice, opts...).ToFunc()
+}
+
+// ByEffectiveFrom orders the results by the effective_from field.
+func ByEffectiveFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveFrom, opts...).ToFunc()
+}
+
+// ByEffectiveUntil orders the results by the effective_until field.
+func ByEffectiveUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveUntil, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
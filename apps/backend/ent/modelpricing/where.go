@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package modelpricing
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldProvider, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldModel, v))
+}
+
+// InputPrice applies equality check predicate on the "input_price" field. It's identical to InputPriceEQ.
+func InputPrice(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldInputPrice, v))
+}
+
+// OutputPrice applies equality check predicate on the "output_price" field. It's identical to OutputPriceEQ.
+func OutputPrice(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldOutputPrice, v))
+}
+
+// ThinkingPrice applies equality check predicate on the "thinking_price" field. It's identical to ThinkingPriceEQ.
+func ThinkingPrice(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldThinkingPrice, v))
+}
+
+// CachedInputPrice applies equality check predicate on the "cached_input_price" field. It's identical to CachedInputPriceEQ.
+func CachedInputPrice(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldCachedInputPrice, v))
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveUntil applies equality check predicate on the "effective_until" field. It's identical to EffectiveUntilEQ.
+func EffectiveUntil(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldEffectiveUntil, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldContainsFold(FieldModel, v))
+}
+
+// InputPriceEQ applies the EQ predicate on the "input_price" field.
+func InputPriceEQ(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldInputPrice, v))
+}
+
+// InputPriceNEQ applies the NEQ predicate on the "input_price" field.
+func InputPriceNEQ(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldInputPrice, v))
+}
+
+// InputPriceIn applies the In predicate on the "input_price" field.
+func InputPriceIn(vs ...float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldInputPrice, vs...))
+}
+
+// InputPriceNotIn applies the NotIn predicate on the "input_price" field.
+func InputPriceNotIn(vs ...float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldInputPrice, vs...))
+}
+
+// InputPriceGT applies the GT predicate on the "input_price" field.
+func InputPriceGT(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldInputPrice, v))
+}
+
+// InputPriceGTE applies the GTE predicate on the "input_price" field.
+func InputPriceGTE(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldInputPrice, v))
+}
+
+// InputPriceLT applies the LT predicate on the "input_price" field.
+func InputPriceLT(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldInputPrice, v))
+}
+
+// InputPriceLTE applies the LTE predicate on the "input_price" field.
+func InputPriceLTE(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldInputPrice, v))
+}
+
+// OutputPriceEQ applies the EQ predicate on the "output_price" field.
+func OutputPriceEQ(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldOutputPrice, v))
+}
+
+// OutputPriceNEQ applies the NEQ predicate on the "output_price" field.
+func OutputPriceNEQ(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldOutputPrice, v))
+}
+
+// OutputPriceIn applies the In predicate on the "output_price" field.
+func OutputPriceIn(vs ...float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldOutputPrice, vs...))
+}
+
+// OutputPriceNotIn applies the NotIn predicate on the "output_price" field.
+func OutputPriceNotIn(vs ...float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldOutputPrice, vs...))
+}
+
+// OutputPriceGT applies the GT predicate on the "output_price" field.
+func OutputPriceGT(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldOutputPrice, v))
+}
+
+// OutputPriceGTE applies the GTE predicate on the "output_price" field.
+func OutputPriceGTE(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldOutputPrice, v))
+}
+
+// OutputPriceLT applies the LT predicate on the "output_price" field.
+func OutputPriceLT(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldOutputPrice, v))
+}
+
+// OutputPriceLTE applies the LTE predicate on the "output_price" field.
+func OutputPriceLTE(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldOutputPrice, v))
+}
+
+// ThinkingPriceEQ applies the EQ predicate on the "thinking_price" field.
+func ThinkingPriceEQ(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldThinkingPrice, v))
+}
+
+// ThinkingPriceNEQ applies the NEQ predicate on the "thinking_price" field.
+func ThinkingPriceNEQ(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldThinkingPrice, v))
+}
+
+// ThinkingPriceIn applies the In predicate on the "thinking_price" field.
+func ThinkingPriceIn(vs ...float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldThinkingPrice, vs...))
+}
+
+// ThinkingPriceNotIn applies the NotIn predicate on the "thinking_price" field.
+func ThinkingPriceNotIn(vs ...float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldThinkingPrice, vs...))
+}
+
+// ThinkingPriceGT applies the GT predicate on the "thinking_price" field.
+func ThinkingPriceGT(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldThinkingPrice, v))
+}
+
+// ThinkingPriceGTE applies the GTE predicate on the "thinking_price" field.
+func ThinkingPriceGTE(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldThinkingPrice, v))
+}
+
+// ThinkingPriceLT applies the LT predicate on the "thinking_price" field.
+func ThinkingPriceLT(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldThinkingPrice, v))
+}
+
+// ThinkingPriceLTE applies the LTE predicate on the "thinking_price" field.
+func ThinkingPriceLTE(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldThinkingPrice, v))
+}
+
+// ThinkingPriceIsNil applies the IsNil predicate on the "thinking_price" field.
+func ThinkingPriceIsNil() predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIsNull(FieldThinkingPrice))
+}
+
+// ThinkingPriceNotNil applies the NotNil predicate on the "thinking_price" field.
+func ThinkingPriceNotNil() predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotNull(FieldThinkingPrice))
+}
+
+// CachedInputPriceEQ applies the EQ predicate on the "cached_input_price" field.
+func CachedInputPriceEQ(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldCachedInputPrice, v))
+}
+
+// CachedInputPriceNEQ applies the NEQ predicate on the "cached_input_price" field.
+func CachedInputPriceNEQ(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldCachedInputPrice, v))
+}
+
+// CachedInputPriceIn applies the In predicate on the "cached_input_price" field.
+func CachedInputPriceIn(vs ...float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldCachedInputPrice, vs...))
+}
+
+// CachedInputPriceNotIn applies the NotIn predicate on the "cached_input_price" field.
+func CachedInputPriceNotIn(vs ...float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldCachedInputPrice, vs...))
+}
+
+// CachedInputPriceGT applies the GT predicate on the "cached_input_price" field.
+func CachedInputPriceGT(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldCachedInputPrice, v))
+}
+
+// CachedInputPriceGTE applies the GTE predicate on the "cached_input_price" field.
+func CachedInputPriceGTE(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldCachedInputPrice, v))
+}
+
+// CachedInputPriceLT applies the LT predicate on the "cached_input_price" field.
+func CachedInputPriceLT(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldCachedInputPrice, v))
+}
+
+// CachedInputPriceLTE applies the LTE predicate on the "cached_input_price" field.
+func CachedInputPriceLTE(v float64) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldCachedInputPrice, v))
+}
+
+// CachedInputPriceIsNil applies the IsNil predicate on the "cached_input_price" field.
+func CachedInputPriceIsNil() predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIsNull(FieldCachedInputPrice))
+}
+
+// CachedInputPriceNotNil applies the NotNil predicate on the "cached_input_price" field.
+func CachedInputPriceNotNil() predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotNull(FieldCachedInputPrice))
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveUntilEQ applies the EQ predicate on the "effective_until" field.
+func EffectiveUntilEQ(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilNEQ applies the NEQ predicate on the "effective_until" field.
+func EffectiveUntilNEQ(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilIn applies the In predicate on the "effective_until" field.
+func EffectiveUntilIn(vs ...time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldEffectiveUntil, vs...))
+}
+
+// EffectiveUntilNotIn applies the NotIn predicate on the "effective_until" field.
+func EffectiveUntilNotIn(vs ...time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldEffectiveUntil, vs...))
+}
+
+// EffectiveUntilGT applies the GT predicate on the "effective_until" field.
+func EffectiveUntilGT(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilGTE applies the GTE predicate on the "effective_until" field.
+func EffectiveUntilGTE(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilLT applies the LT predicate on the "effective_until" field.
+func EffectiveUntilLT(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilLTE applies the LTE predicate on the "effective_until" field.
+func EffectiveUntilLTE(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilIsNil applies the IsNil predicate on the "effective_until" field.
+func EffectiveUntilIsNil() predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIsNull(FieldEffectiveUntil))
+}
+
+// EffectiveUntilNotNil applies the NotNil predicate on the "effective_until" field.
+func EffectiveUntilNotNil() predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotNull(FieldEffectiveUntil))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModelPricing) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModelPricing) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModelPricing) predicate.ModelPricing {
+	return predicate.ModelPricing(sql.NotPredicates(p))
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/modelpricing"
+)
+
+// ModelPricingCreate is the builder for creating a ModelPricing entity.
+type ModelPricingCreate struct {
+	config
+	mutation *ModelPricingMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (_c *ModelPricingCreate) SetProvider(v string) *ModelPricingCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *ModelPricingCreate) SetModel(v string) *ModelPricingCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetInputPrice sets the "input_price" field.
+func (_c *ModelPricingCreate) SetInputPrice(v float64) *ModelPricingCreate {
+	_c.mutation.SetInputPrice(v)
+	return _c
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (_c *ModelPricingCreate) SetOutputPrice(v float64) *ModelPricingCreate {
+	_c.mutation.SetOutputPrice(v)
+	return _c
+}
+
+// SetThinkingPrice sets the "thinking_price" field.
+func (_c *ModelPricingCreate) SetThinkingPrice(v float64) *ModelPricingCreate {
+	_c.mutation.SetThinkingPrice(v)
+	return _c
+}
+
+// SetNillableThinkingPrice sets the "thinking_price" field if the given value is not nil.
+func (_c *ModelPricingCreate) SetNillableThinkingPrice(v *float64) *ModelPricingCreate {
+	if v != nil {
+		_c.SetThinkingPrice(*v)
+	}
+	return _c
+}
+
+// SetCachedInputPrice sets the "cached_input_price" field.
+func (_c *ModelPricingCreate) SetCachedInputPrice(v float64) *ModelPricingCreate {
+	_c.mutation.SetCachedInputPrice(v)
+	return _c
+}
+
+// SetNillableCachedInputPrice sets the "cached_input_price" field if the given value is not nil.
+func (_c *ModelPricingCreate) SetNillableCachedInputPrice(v *float64) *ModelPricingCreate {
+	if v != nil {
+		_c.SetCachedInputPrice(*v)
+	}
+	return _c
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_c *ModelPricingCreate) SetEffectiveFrom(v time.Time) *ModelPricingCreate {
+	_c.mutation.SetEffectiveFrom(v)
+	return _c
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (_c *ModelPricingCreate) SetEffectiveUntil(v time.Time) *ModelPricingCreate {
+	_c.mutation.SetEffectiveUntil(v)
+	return _c
+}
+
+// SetNillableEffectiveUntil sets the "effective_until" field if the given value is not nil.
+func (_c *ModelPricingCreate) SetNillableEffectiveUntil(v *time.Time) *ModelPricingCreate {
+	if v != nil {
+		_c.SetEffectiveUntil(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *ModelPricingCreate) SetUpdatedBy(v string) *ModelPricingCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *ModelPricingCreate) SetNillableUpdatedBy(v *string) *ModelPricingCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModelPricingCreate) SetCreatedAt(v time.Time) *ModelPricingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModelPricingCreate) SetNillableCreatedAt(v *time.Time) *ModelPricingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ModelPricingCreate) SetUpdatedAt(v time.Time) *ModelPricingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ModelPricingCreate) SetNillableUpdatedAt(v *time.Time) *ModelPricingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ModelPricingMutation object of the builder.
+func (_c *ModelPricingCreate) Mutation() *ModelPricingMutation {
+	return _c.mutation
+}
+
+// Save creates the ModelPricing in the database.
+func (_c *ModelPricingCreate) Save(ctx context.Context) (*ModelPricing, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModelPricingCreate) SaveX(ctx context.Context) *ModelPricing {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModelPricingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModelPricingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModelPricingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := modelpricing.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := modelpricing.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModelPricingCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "ModelPricing.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := modelpricing.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "ModelPricing.model"`)}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := modelpricing.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InputPrice(); !ok {
+		return &ValidationError{Name: "input_price", err: errors.New(`ent: missing required field "ModelPricing.input_price"`)}
+	}
+	if v, ok := _c.mutation.InputPrice(); ok {
+		if err := modelpricing.InputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "input_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.input_price": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OutputPrice(); !ok {
+		return &ValidationError{Name: "output_price", err: errors.New(`ent: missing required field "ModelPricing.output_price"`)}
+	}
+	if v, ok := _c.mutation.OutputPrice(); ok {
+		if err := modelpricing.OutputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "output_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.output_price": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ThinkingPrice(); ok {
+		if err := modelpricing.ThinkingPriceValidator(v); err != nil {
+			return &ValidationError{Name: "thinking_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.thinking_price": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CachedInputPrice(); ok {
+		if err := modelpricing.CachedInputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "cached_input_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.cached_input_price": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "ModelPricing.effective_from"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModelPricing.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ModelPricing.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ModelPricingCreate) sqlSave(ctx context.Context) (*ModelPricing, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModelPricingCreate) createSpec() (*ModelPricing, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModelPricing{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(modelpricing.Table, sqlgraph.NewFieldSpec(modelpricing.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(modelpricing.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(modelpricing.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.InputPrice(); ok {
+		_spec.SetField(modelpricing.FieldInputPrice, field.TypeFloat64, value)
+		_node.InputPrice = value
+	}
+	if value, ok := _c.mutation.OutputPrice(); ok {
+		_spec.SetField(modelpricing.FieldOutputPrice, field.TypeFloat64, value)
+		_node.OutputPrice = value
+	}
+	if value, ok := _c.mutation.ThinkingPrice(); ok {
+		_spec.SetField(modelpricing.FieldThinkingPrice, field.TypeFloat64, value)
+		_node.ThinkingPrice = &value
+	}
+	if value, ok := _c.mutation.CachedInputPrice(); ok {
+		_spec.SetField(modelpricing.FieldCachedInputPrice, field.TypeFloat64, value)
+		_node.CachedInputPrice = &value
+	}
+	if value, ok := _c.mutation.EffectiveFrom(); ok {
+		_spec.SetField(modelpricing.FieldEffectiveFrom, field.TypeTime, value)
+		_node.EffectiveFrom = value
+	}
+	if value, ok := _c.mutation.EffectiveUntil(); ok {
+		_spec.SetField(modelpricing.FieldEffectiveUntil, field.TypeTime, value)
+		_node.EffectiveUntil = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(modelpricing.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(modelpricing.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(modelpricing.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ModelPricingCreateBulk is the builder for creating many ModelPricing entities in bulk.
+type ModelPricingCreateBulk struct {
+	config
+	err      error
+	builders []*ModelPricingCreate
+}
+
+// Save creates the ModelPricing entities in the database.
+func (_c *ModelPricingCreateBulk) Save(ctx context.Context) ([]*ModelPricing, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModelPricing, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModelPricingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModelPricingCreateBulk) SaveX(ctx context.Context) []*ModelPricing {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModelPricingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModelPricingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
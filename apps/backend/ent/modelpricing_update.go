@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/modelpricing"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// ModelPricingUpdate is the builder for updating ModelPricing entities.
+type ModelPricingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModelPricingMutation
+}
+
+// Where appends a list predicates to the ModelPricingUpdate builder.
+func (_u *ModelPricingUpdate) Where(ps ...predicate.ModelPricing) *ModelPricingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ModelPricingUpdate) SetProvider(v string) *ModelPricingUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableProvider(v *string) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ModelPricingUpdate) SetModel(v string) *ModelPricingUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableModel(v *string) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetInputPrice sets the "input_price" field.
+func (_u *ModelPricingUpdate) SetInputPrice(v float64) *ModelPricingUpdate {
+	_u.mutation.ResetInputPrice()
+	_u.mutation.SetInputPrice(v)
+	return _u
+}
+
+// SetNillableInputPrice sets the "input_price" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableInputPrice(v *float64) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetInputPrice(*v)
+	}
+	return _u
+}
+
+// AddInputPrice adds value to the "input_price" field.
+func (_u *ModelPricingUpdate) AddInputPrice(v float64) *ModelPricingUpdate {
+	_u.mutation.AddInputPrice(v)
+	return _u
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (_u *ModelPricingUpdate) SetOutputPrice(v float64) *ModelPricingUpdate {
+	_u.mutation.ResetOutputPrice()
+	_u.mutation.SetOutputPrice(v)
+	return _u
+}
+
+// SetNillableOutputPrice sets the "output_price" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableOutputPrice(v *float64) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetOutputPrice(*v)
+	}
+	return _u
+}
+
+// AddOutputPrice adds value to the "output_price" field.
+func (_u *ModelPricingUpdate) AddOutputPrice(v float64) *ModelPricingUpdate {
+	_u.mutation.AddOutputPrice(v)
+	return _u
+}
+
+// SetThinkingPrice sets the "thinking_price" field.
+func (_u *ModelPricingUpdate) SetThinkingPrice(v float64) *ModelPricingUpdate {
+	_u.mutation.ResetThinkingPrice()
+	_u.mutation.SetThinkingPrice(v)
+	return _u
+}
+
+// SetNillableThinkingPrice sets the "thinking_price" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableThinkingPrice(v *float64) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetThinkingPrice(*v)
+	}
+	return _u
+}
+
+// AddThinkingPrice adds value to the "thinking_price" field.
+func (_u *ModelPricingUpdate) AddThinkingPrice(v float64) *ModelPricingUpdate {
+	_u.mutation.AddThinkingPrice(v)
+	return _u
+}
+
+// ClearThinkingPrice clears the value of the "thinking_price" field.
+func (_u *ModelPricingUpdate) ClearThinkingPrice() *ModelPricingUpdate {
+	_u.mutation.ClearThinkingPrice()
+	return _u
+}
+
+// SetCachedInputPrice sets the "cached_input_price" field.
+func (_u *ModelPricingUpdate) SetCachedInputPrice(v float64) *ModelPricingUpdate {
+	_u.mutation.ResetCachedInputPrice()
+	_u.mutation.SetCachedInputPrice(v)
+	return _u
+}
+
+// SetNillableCachedInputPrice sets the "cached_input_price" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableCachedInputPrice(v *float64) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetCachedInputPrice(*v)
+	}
+	return _u
+}
+
+// AddCachedInputPrice adds value to the "cached_input_price" field.
+func (_u *ModelPricingUpdate) AddCachedInputPrice(v float64) *ModelPricingUpdate {
+	_u.mutation.AddCachedInputPrice(v)
+	return _u
+}
+
+// ClearCachedInputPrice clears the value of the "cached_input_price" field.
+func (_u *ModelPricingUpdate) ClearCachedInputPrice() *ModelPricingUpdate {
+	_u.mutation.ClearCachedInputPrice()
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *ModelPricingUpdate) SetEffectiveFrom(v time.Time) *ModelPricingUpdate {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableEffectiveFrom(v *time.Time) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (_u *ModelPricingUpdate) SetEffectiveUntil(v time.Time) *ModelPricingUpdate {
+	_u.mutation.SetEffectiveUntil(v)
+	return _u
+}
+
+// SetNillableEffectiveUntil sets the "effective_until" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableEffectiveUntil(v *time.Time) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetEffectiveUntil(*v)
+	}
+	return _u
+}
+
+// ClearEffectiveUntil clears the value of the "effective_until" field.
+func (_u *ModelPricingUpdate) ClearEffectiveUntil() *ModelPricingUpdate {
+	_u.mutation.ClearEffectiveUntil()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ModelPricingUpdate) SetUpdatedBy(v string) *ModelPricingUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ModelPricingUpdate) SetNillableUpdatedBy(v *string) *ModelPricingUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ModelPricingUpdate) ClearUpdatedBy() *ModelPricingUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModelPricingUpdate) SetUpdatedAt(v time.Time) *ModelPricingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ModelPricingMutation object of the builder.
+func (_u *ModelPricingUpdate) Mutation() *ModelPricingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModelPricingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModelPricingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModelPricingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModelPricingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModelPricingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := modelpricing.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModelPricingUpdate) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := modelpricing.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := modelpricing.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InputPrice(); ok {
+		if err := modelpricing.InputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "input_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.input_price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutputPrice(); ok {
+		if err := modelpricing.OutputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "output_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.output_price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThinkingPrice(); ok {
+		if err := modelpricing.ThinkingPriceValidator(v); err != nil {
+			return &ValidationError{Name: "thinking_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.thinking_price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CachedInputPrice(); ok {
+		if err := modelpricing.CachedInputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "cached_input_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.cached_input_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ModelPricingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelpricing.Table, modelpricing.Columns, sqlgraph.NewFieldSpec(modelpricing.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(modelpricing.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(modelpricing.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputPrice(); ok {
+		_spec.SetField(modelpricing.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInputPrice(); ok {
+		_spec.AddField(modelpricing.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OutputPrice(); ok {
+		_spec.SetField(modelpricing.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutputPrice(); ok {
+		_spec.AddField(modelpricing.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ThinkingPrice(); ok {
+		_spec.SetField(modelpricing.FieldThinkingPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedThinkingPrice(); ok {
+		_spec.AddField(modelpricing.FieldThinkingPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.ThinkingPriceCleared() {
+		_spec.ClearField(modelpricing.FieldThinkingPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CachedInputPrice(); ok {
+		_spec.SetField(modelpricing.FieldCachedInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCachedInputPrice(); ok {
+		_spec.AddField(modelpricing.FieldCachedInputPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.CachedInputPriceCleared() {
+		_spec.ClearField(modelpricing.FieldCachedInputPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(modelpricing.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EffectiveUntil(); ok {
+		_spec.SetField(modelpricing.FieldEffectiveUntil, field.TypeTime, value)
+	}
+	if _u.mutation.EffectiveUntilCleared() {
+		_spec.ClearField(modelpricing.FieldEffectiveUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(modelpricing.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(modelpricing.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(modelpricing.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelpricing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModelPricingUpdateOne is the builder for updating a single ModelPricing entity.
+type ModelPricingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModelPricingMutation
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ModelPricingUpdateOne) SetProvider(v string) *ModelPricingUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableProvider(v *string) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ModelPricingUpdateOne) SetModel(v string) *ModelPricingUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableModel(v *string) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetInputPrice sets the "input_price" field.
+func (_u *ModelPricingUpdateOne) SetInputPrice(v float64) *ModelPricingUpdateOne {
+	_u.mutation.ResetInputPrice()
+	_u.mutation.SetInputPrice(v)
+	return _u
+}
+
+// SetNillableInputPrice sets the "input_price" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableInputPrice(v *float64) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetInputPrice(*v)
+	}
+	return _u
+}
+
+// AddInputPrice adds value to the "input_price" field.
+func (_u *ModelPricingUpdateOne) AddInputPrice(v float64) *ModelPricingUpdateOne {
+	_u.mutation.AddInputPrice(v)
+	return _u
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (_u *ModelPricingUpdateOne) SetOutputPrice(v float64) *ModelPricingUpdateOne {
+	_u.mutation.ResetOutputPrice()
+	_u.mutation.SetOutputPrice(v)
+	return _u
+}
+
+// SetNillableOutputPrice sets the "output_price" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableOutputPrice(v *float64) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetOutputPrice(*v)
+	}
+	return _u
+}
+
+// AddOutputPrice adds value to the "output_price" field.
+func (_u *ModelPricingUpdateOne) AddOutputPrice(v float64) *ModelPricingUpdateOne {
+	_u.mutation.AddOutputPrice(v)
+	return _u
+}
+
+// SetThinkingPrice sets the "thinking_price" field.
+func (_u *ModelPricingUpdateOne) SetThinkingPrice(v float64) *ModelPricingUpdateOne {
+	_u.mutation.ResetThinkingPrice()
+	_u.mutation.SetThinkingPrice(v)
+	return _u
+}
+
+// SetNillableThinkingPrice sets the "thinking_price" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableThinkingPrice(v *float64) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetThinkingPrice(*v)
+	}
+	return _u
+}
+
+// AddThinkingPrice adds value to the "thinking_price" field.
+func (_u *ModelPricingUpdateOne) AddThinkingPrice(v float64) *ModelPricingUpdateOne {
+	_u.mutation.AddThinkingPrice(v)
+	return _u
+}
+
+// ClearThinkingPrice clears the value of the "thinking_price" field.
+func (_u *ModelPricingUpdateOne) ClearThinkingPrice() *ModelPricingUpdateOne {
+	_u.mutation.ClearThinkingPrice()
+	return _u
+}
+
+// SetCachedInputPrice sets the "cached_input_price" field.
+func (_u *ModelPricingUpdateOne) SetCachedInputPrice(v float64) *ModelPricingUpdateOne {
+	_u.mutation.ResetCachedInputPrice()
+	_u.mutation.SetCachedInputPrice(v)
+	return _u
+}
+
+// SetNillableCachedInputPrice sets the "cached_input_price" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableCachedInputPrice(v *float64) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetCachedInputPrice(*v)
+	}
+	return _u
+}
+
+// AddCachedInputPrice adds value to the "cached_input_price" field.
+func (_u *ModelPricingUpdateOne) AddCachedInputPrice(v float64) *ModelPricingUpdateOne {
+	_u.mutation.AddCachedInputPrice(v)
+	return _u
+}
+
+// ClearCachedInputPrice clears the value of the "cached_input_price" field.
+func (_u *ModelPricingUpdateOne) ClearCachedInputPrice() *ModelPricingUpdateOne {
+	_u.mutation.ClearCachedInputPrice()
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *ModelPricingUpdateOne) SetEffectiveFrom(v time.Time) *ModelPricingUpdateOne {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableEffectiveFrom(v *time.Time) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (_u *ModelPricingUpdateOne) SetEffectiveUntil(v time.Time) *ModelPricingUpdateOne {
+	_u.mutation.SetEffectiveUntil(v)
+	return _u
+}
+
+// SetNillableEffectiveUntil sets the "effective_until" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableEffectiveUntil(v *time.Time) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetEffectiveUntil(*v)
+	}
+	return _u
+}
+
+// ClearEffectiveUntil clears the value of the "effective_until" field.
+func (_u *ModelPricingUpdateOne) ClearEffectiveUntil() *ModelPricingUpdateOne {
+	_u.mutation.ClearEffectiveUntil()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ModelPricingUpdateOne) SetUpdatedBy(v string) *ModelPricingUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ModelPricingUpdateOne) SetNillableUpdatedBy(v *string) *ModelPricingUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ModelPricingUpdateOne) ClearUpdatedBy() *ModelPricingUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModelPricingUpdateOne) SetUpdatedAt(v time.Time) *ModelPricingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ModelPricingMutation object of the builder.
+func (_u *ModelPricingUpdateOne) Mutation() *ModelPricingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ModelPricingUpdate builder.
+func (_u *ModelPricingUpdateOne) Where(ps ...predicate.ModelPricing) *ModelPricingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModelPricingUpdateOne) Select(field string, fields ...string) *ModelPricingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModelPricing entity.
+func (_u *ModelPricingUpdateOne) Save(ctx context.Context) (*ModelPricing, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModelPricingUpdateOne) SaveX(ctx context.Context) *ModelPricing {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModelPricingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModelPricingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModelPricingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := modelpricing.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModelPricingUpdateOne) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := modelpricing.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := modelpricing.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InputPrice(); ok {
+		if err := modelpricing.InputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "input_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.input_price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutputPrice(); ok {
+		if err := modelpricing.OutputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "output_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.output_price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThinkingPrice(); ok {
+		if err := modelpricing.ThinkingPriceValidator(v); err != nil {
+			return &ValidationError{Name: "thinking_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.thinking_price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CachedInputPrice(); ok {
+		if err := modelpricing.CachedInputPriceValidator(v); err != nil {
+			return &ValidationError{Name: "cached_input_price", err: fmt.Errorf(`ent: validator failed for field "ModelPricing.cached_input_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ModelPricingUpdateOne) sqlSave(ctx context.Context) (_node *ModelPricing, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelpricing.Table, modelpricing.Columns, sqlgraph.NewFieldSpec(modelpricing.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModelPricing.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelpricing.FieldID)
+		for _, f := range fields {
+			if !modelpricing.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != modelpricing.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(modelpricing.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(modelpricing.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputPrice(); ok {
+		_spec.SetField(modelpricing.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInputPrice(); ok {
+		_spec.AddField(modelpricing.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OutputPrice(); ok {
+		_spec.SetField(modelpricing.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutputPrice(); ok {
+		_spec.AddField(modelpricing.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ThinkingPrice(); ok {
+		_spec.SetField(modelpricing.FieldThinkingPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedThinkingPrice(); ok {
+		_spec.AddField(modelpricing.FieldThinkingPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.ThinkingPriceCleared() {
+		_spec.ClearField(modelpricing.FieldThinkingPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CachedInputPrice(); ok {
+		_spec.SetField(modelpricing.FieldCachedInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCachedInputPrice(); ok {
+		_spec.AddField(modelpricing.FieldCachedInputPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.CachedInputPriceCleared() {
+		_spec.ClearField(modelpricing.FieldCachedInputPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(modelpricing.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EffectiveUntil(); ok {
+		_spec.SetField(modelpricing.FieldEffectiveUntil, field.TypeTime, value)
+	}
+	if _u.mutation.EffectiveUntilCleared() {
+		_spec.ClearField(modelpricing.FieldEffectiveUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(modelpricing.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(modelpricing.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(modelpricing.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ModelPricing{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelpricing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,17 +14,27 @@ import (
 	"github.com/google/uuid"
 	"github.com/mindhit/api/ent/aiconfig"
 	"github.com/mindhit/api/ent/ailog"
+	"github.com/mindhit/api/ent/emailverificationtoken"
 	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/mindmapedit"
 	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
+	"github.com/mindhit/api/ent/modelpricing"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/passwordresettoken"
 	"github.com/mindhit/api/ent/plan"
 	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/prompttemplate"
 	"github.com/mindhit/api/ent/rawevent"
+	"github.com/mindhit/api/ent/refreshtoken"
 	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/ent/stripeevent"
 	"github.com/mindhit/api/ent/subscription"
 	"github.com/mindhit/api/ent/tokenusage"
 	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
 	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/ent/usersettings"
 )
@@ -38,20 +48,30 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAIConfig           = "AIConfig"
-	TypeAILog              = "AILog"
-	TypeHighlight          = "Highlight"
-	TypeMindmapGraph       = "MindmapGraph"
-	TypePageVisit          = "PageVisit"
-	TypePasswordResetToken = "PasswordResetToken"
-	TypePlan               = "Plan"
-	TypeRawEvent           = "RawEvent"
-	TypeSession            = "Session"
-	TypeSubscription       = "Subscription"
-	TypeTokenUsage         = "TokenUsage"
-	TypeURL                = "URL"
-	TypeUser               = "User"
-	TypeUserSettings       = "UserSettings"
+	TypeAIConfig               = "AIConfig"
+	TypeAILog                  = "AILog"
+	TypeEmailVerificationToken = "EmailVerificationToken"
+	TypeHighlight              = "Highlight"
+	TypeKnowledgeMention       = "KnowledgeMention"
+	TypeKnowledgeTopic         = "KnowledgeTopic"
+	TypeMindmapEdit            = "MindmapEdit"
+	TypeMindmapGraph           = "MindmapGraph"
+	TypeMindmapRevision        = "MindmapRevision"
+	TypeModelPricing           = "ModelPricing"
+	TypePageVisit              = "PageVisit"
+	TypePasswordResetToken     = "PasswordResetToken"
+	TypePlan                   = "Plan"
+	TypePromptTemplate         = "PromptTemplate"
+	TypeRawEvent               = "RawEvent"
+	TypeRefreshToken           = "RefreshToken"
+	TypeSession                = "Session"
+	TypeStripeEvent            = "StripeEvent"
+	TypeSubscription           = "Subscription"
+	TypeTokenUsage             = "TokenUsage"
+	TypeURL                    = "URL"
+	TypeURLContent             = "URLContent"
+	TypeUser                   = "User"
+	TypeUserSettings           = "UserSettings"
 )
 
 // AIConfigMutation represents an operation that mutates the AIConfig nodes in the graph.
@@ -72,6 +92,8 @@ type AIConfigMutation struct {
 	thinking_budget          *int
 	addthinking_budget       *int
 	json_mode                *bool
+	cache_ttl_seconds        *int
+	addcache_ttl_seconds     *int
 	enabled                  *bool
 	updated_by               *string
 	created_at               *time.Time
@@ -557,6 +579,62 @@ func (m *AIConfigMutation) ResetJSONMode() {
 	m.json_mode = nil
 }
 
+// SetCacheTTLSeconds sets the "cache_ttl_seconds" field.
+func (m *AIConfigMutation) SetCacheTTLSeconds(i int) {
+	m.cache_ttl_seconds = &i
+	m.addcache_ttl_seconds = nil
+}
+
+// CacheTTLSeconds returns the value of the "cache_ttl_seconds" field in the mutation.
+func (m *AIConfigMutation) CacheTTLSeconds() (r int, exists bool) {
+	v := m.cache_ttl_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheTTLSeconds returns the old "cache_ttl_seconds" field's value of the AIConfig entity.
+// If the AIConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIConfigMutation) OldCacheTTLSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheTTLSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheTTLSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheTTLSeconds: %w", err)
+	}
+	return oldValue.CacheTTLSeconds, nil
+}
+
+// AddCacheTTLSeconds adds i to the "cache_ttl_seconds" field.
+func (m *AIConfigMutation) AddCacheTTLSeconds(i int) {
+	if m.addcache_ttl_seconds != nil {
+		*m.addcache_ttl_seconds += i
+	} else {
+		m.addcache_ttl_seconds = &i
+	}
+}
+
+// AddedCacheTTLSeconds returns the value that was added to the "cache_ttl_seconds" field in this mutation.
+func (m *AIConfigMutation) AddedCacheTTLSeconds() (r int, exists bool) {
+	v := m.addcache_ttl_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheTTLSeconds resets all changes to the "cache_ttl_seconds" field.
+func (m *AIConfigMutation) ResetCacheTTLSeconds() {
+	m.cache_ttl_seconds = nil
+	m.addcache_ttl_seconds = nil
+}
+
 // SetEnabled sets the "enabled" field.
 func (m *AIConfigMutation) SetEnabled(b bool) {
 	m.enabled = &b
@@ -748,7 +826,7 @@ func (m *AIConfigMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AIConfigMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+	fields := make([]string, 0, 13)
 	if m.task_type != nil {
 		fields = append(fields, aiconfig.FieldTaskType)
 	}
@@ -773,6 +851,9 @@ func (m *AIConfigMutation) Fields() []string {
 	if m.json_mode != nil {
 		fields = append(fields, aiconfig.FieldJSONMode)
 	}
+	if m.cache_ttl_seconds != nil {
+		fields = append(fields, aiconfig.FieldCacheTTLSeconds)
+	}
 	if m.enabled != nil {
 		fields = append(fields, aiconfig.FieldEnabled)
 	}
@@ -809,6 +890,8 @@ func (m *AIConfigMutation) Field(name string) (ent.Value, bool) {
 		return m.ThinkingBudget()
 	case aiconfig.FieldJSONMode:
 		return m.JSONMode()
+	case aiconfig.FieldCacheTTLSeconds:
+		return m.CacheTTLSeconds()
 	case aiconfig.FieldEnabled:
 		return m.Enabled()
 	case aiconfig.FieldUpdatedBy:
@@ -842,6 +925,8 @@ func (m *AIConfigMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldThinkingBudget(ctx)
 	case aiconfig.FieldJSONMode:
 		return m.OldJSONMode(ctx)
+	case aiconfig.FieldCacheTTLSeconds:
+		return m.OldCacheTTLSeconds(ctx)
 	case aiconfig.FieldEnabled:
 		return m.OldEnabled(ctx)
 	case aiconfig.FieldUpdatedBy:
@@ -915,6 +1000,13 @@ func (m *AIConfigMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetJSONMode(v)
 		return nil
+	case aiconfig.FieldCacheTTLSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheTTLSeconds(v)
+		return nil
 	case aiconfig.FieldEnabled:
 		v, ok := value.(bool)
 		if !ok {
@@ -960,6 +1052,9 @@ func (m *AIConfigMutation) AddedFields() []string {
 	if m.addthinking_budget != nil {
 		fields = append(fields, aiconfig.FieldThinkingBudget)
 	}
+	if m.addcache_ttl_seconds != nil {
+		fields = append(fields, aiconfig.FieldCacheTTLSeconds)
+	}
 	return fields
 }
 
@@ -974,6 +1069,8 @@ func (m *AIConfigMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedMaxTokens()
 	case aiconfig.FieldThinkingBudget:
 		return m.AddedThinkingBudget()
+	case aiconfig.FieldCacheTTLSeconds:
+		return m.AddedCacheTTLSeconds()
 	}
 	return nil, false
 }
@@ -1004,6 +1101,13 @@ func (m *AIConfigMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddThinkingBudget(v)
 		return nil
+	case aiconfig.FieldCacheTTLSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheTTLSeconds(v)
+		return nil
 	}
 	return fmt.Errorf("unknown AIConfig numeric field %s", name)
 }
@@ -1070,6 +1174,9 @@ func (m *AIConfigMutation) ResetField(name string) error {
 	case aiconfig.FieldJSONMode:
 		m.ResetJSONMode()
 		return nil
+	case aiconfig.FieldCacheTTLSeconds:
+		m.ResetCacheTTLSeconds()
+		return nil
 	case aiconfig.FieldEnabled:
 		m.ResetEnabled()
 		return nil
@@ -1137,41 +1244,44 @@ func (m *AIConfigMutation) ResetEdge(name string) error {
 // AILogMutation represents an operation that mutates the AILog nodes in the graph.
 type AILogMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	task_type               *string
-	provider                *string
-	model                   *string
-	system_prompt           *string
-	user_prompt             *string
-	thinking                *string
-	content                 *string
-	input_tokens            *int
-	addinput_tokens         *int
-	output_tokens           *int
-	addoutput_tokens        *int
-	thinking_tokens         *int
-	addthinking_tokens      *int
-	total_tokens            *int
-	addtotal_tokens         *int
-	latency_ms              *int64
-	addlatency_ms           *int64
-	request_id              *string
-	status                  *ailog.Status
-	error_message           *string
-	estimated_cost_cents    *int
-	addestimated_cost_cents *int
-	metadata                *map[string]interface{}
-	created_at              *time.Time
-	clearedFields           map[string]struct{}
-	user                    *uuid.UUID
-	cleareduser             bool
-	session                 *uuid.UUID
-	clearedsession          bool
-	done                    bool
-	oldValue                func(context.Context) (*AILog, error)
-	predicates              []predicate.AILog
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	task_type              *string
+	provider               *string
+	model                  *string
+	system_prompt          *string
+	user_prompt            *string
+	thinking               *string
+	content                *string
+	input_tokens           *int
+	addinput_tokens        *int
+	cached_input_tokens    *int
+	addcached_input_tokens *int
+	output_tokens          *int
+	addoutput_tokens       *int
+	thinking_tokens        *int
+	addthinking_tokens     *int
+	total_tokens           *int
+	addtotal_tokens        *int
+	latency_ms             *int64
+	addlatency_ms          *int64
+	request_id             *string
+	cached                 *bool
+	status                 *ailog.Status
+	error_message          *string
+	cost_micro_cents       *int64
+	addcost_micro_cents    *int64
+	metadata               *map[string]interface{}
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	session                *uuid.UUID
+	clearedsession         bool
+	done                   bool
+	oldValue               func(context.Context) (*AILog, error)
+	predicates             []predicate.AILog
 }
 
 var _ ent.Mutation = (*AILogMutation)(nil)
@@ -1736,6 +1846,62 @@ func (m *AILogMutation) ResetInputTokens() {
 	m.addinput_tokens = nil
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (m *AILogMutation) SetCachedInputTokens(i int) {
+	m.cached_input_tokens = &i
+	m.addcached_input_tokens = nil
+}
+
+// CachedInputTokens returns the value of the "cached_input_tokens" field in the mutation.
+func (m *AILogMutation) CachedInputTokens() (r int, exists bool) {
+	v := m.cached_input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCachedInputTokens returns the old "cached_input_tokens" field's value of the AILog entity.
+// If the AILog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AILogMutation) OldCachedInputTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCachedInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCachedInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCachedInputTokens: %w", err)
+	}
+	return oldValue.CachedInputTokens, nil
+}
+
+// AddCachedInputTokens adds i to the "cached_input_tokens" field.
+func (m *AILogMutation) AddCachedInputTokens(i int) {
+	if m.addcached_input_tokens != nil {
+		*m.addcached_input_tokens += i
+	} else {
+		m.addcached_input_tokens = &i
+	}
+}
+
+// AddedCachedInputTokens returns the value that was added to the "cached_input_tokens" field in this mutation.
+func (m *AILogMutation) AddedCachedInputTokens() (r int, exists bool) {
+	v := m.addcached_input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCachedInputTokens resets all changes to the "cached_input_tokens" field.
+func (m *AILogMutation) ResetCachedInputTokens() {
+	m.cached_input_tokens = nil
+	m.addcached_input_tokens = nil
+}
+
 // SetOutputTokens sets the "output_tokens" field.
 func (m *AILogMutation) SetOutputTokens(i int) {
 	m.output_tokens = &i
@@ -2009,6 +2175,42 @@ func (m *AILogMutation) ResetRequestID() {
 	delete(m.clearedFields, ailog.FieldRequestID)
 }
 
+// SetCached sets the "cached" field.
+func (m *AILogMutation) SetCached(b bool) {
+	m.cached = &b
+}
+
+// Cached returns the value of the "cached" field in the mutation.
+func (m *AILogMutation) Cached() (r bool, exists bool) {
+	v := m.cached
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCached returns the old "cached" field's value of the AILog entity.
+// If the AILog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AILogMutation) OldCached(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCached is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCached requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCached: %w", err)
+	}
+	return oldValue.Cached, nil
+}
+
+// ResetCached resets all changes to the "cached" field.
+func (m *AILogMutation) ResetCached() {
+	m.cached = nil
+}
+
 // SetStatus sets the "status" field.
 func (m *AILogMutation) SetStatus(a ailog.Status) {
 	m.status = &a
@@ -2094,60 +2296,74 @@ func (m *AILogMutation) ResetErrorMessage() {
 	delete(m.clearedFields, ailog.FieldErrorMessage)
 }
 
-// SetEstimatedCostCents sets the "estimated_cost_cents" field.
-func (m *AILogMutation) SetEstimatedCostCents(i int) {
-	m.estimated_cost_cents = &i
-	m.addestimated_cost_cents = nil
+// SetCostMicroCents sets the "cost_micro_cents" field.
+func (m *AILogMutation) SetCostMicroCents(i int64) {
+	m.cost_micro_cents = &i
+	m.addcost_micro_cents = nil
 }
 
-// EstimatedCostCents returns the value of the "estimated_cost_cents" field in the mutation.
-func (m *AILogMutation) EstimatedCostCents() (r int, exists bool) {
-	v := m.estimated_cost_cents
+// CostMicroCents returns the value of the "cost_micro_cents" field in the mutation.
+func (m *AILogMutation) CostMicroCents() (r int64, exists bool) {
+	v := m.cost_micro_cents
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEstimatedCostCents returns the old "estimated_cost_cents" field's value of the AILog entity.
+// OldCostMicroCents returns the old "cost_micro_cents" field's value of the AILog entity.
 // If the AILog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AILogMutation) OldEstimatedCostCents(ctx context.Context) (v int, err error) {
+func (m *AILogMutation) OldCostMicroCents(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEstimatedCostCents is only allowed on UpdateOne operations")
+		return v, errors.New("OldCostMicroCents is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEstimatedCostCents requires an ID field in the mutation")
+		return v, errors.New("OldCostMicroCents requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEstimatedCostCents: %w", err)
+		return v, fmt.Errorf("querying old value for OldCostMicroCents: %w", err)
 	}
-	return oldValue.EstimatedCostCents, nil
+	return oldValue.CostMicroCents, nil
 }
 
-// AddEstimatedCostCents adds i to the "estimated_cost_cents" field.
-func (m *AILogMutation) AddEstimatedCostCents(i int) {
-	if m.addestimated_cost_cents != nil {
-		*m.addestimated_cost_cents += i
+// AddCostMicroCents adds i to the "cost_micro_cents" field.
+func (m *AILogMutation) AddCostMicroCents(i int64) {
+	if m.addcost_micro_cents != nil {
+		*m.addcost_micro_cents += i
 	} else {
-		m.addestimated_cost_cents = &i
+		m.addcost_micro_cents = &i
 	}
 }
 
-// AddedEstimatedCostCents returns the value that was added to the "estimated_cost_cents" field in this mutation.
-func (m *AILogMutation) AddedEstimatedCostCents() (r int, exists bool) {
-	v := m.addestimated_cost_cents
+// AddedCostMicroCents returns the value that was added to the "cost_micro_cents" field in this mutation.
+func (m *AILogMutation) AddedCostMicroCents() (r int64, exists bool) {
+	v := m.addcost_micro_cents
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetEstimatedCostCents resets all changes to the "estimated_cost_cents" field.
-func (m *AILogMutation) ResetEstimatedCostCents() {
-	m.estimated_cost_cents = nil
-	m.addestimated_cost_cents = nil
+// ClearCostMicroCents clears the value of the "cost_micro_cents" field.
+func (m *AILogMutation) ClearCostMicroCents() {
+	m.cost_micro_cents = nil
+	m.addcost_micro_cents = nil
+	m.clearedFields[ailog.FieldCostMicroCents] = struct{}{}
+}
+
+// CostMicroCentsCleared returns if the "cost_micro_cents" field was cleared in this mutation.
+func (m *AILogMutation) CostMicroCentsCleared() bool {
+	_, ok := m.clearedFields[ailog.FieldCostMicroCents]
+	return ok
+}
+
+// ResetCostMicroCents resets all changes to the "cost_micro_cents" field.
+func (m *AILogMutation) ResetCostMicroCents() {
+	m.cost_micro_cents = nil
+	m.addcost_micro_cents = nil
+	delete(m.clearedFields, ailog.FieldCostMicroCents)
 }
 
 // SetMetadata sets the "metadata" field.
@@ -2323,7 +2539,7 @@ func (m *AILogMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AILogMutation) Fields() []string {
-	fields := make([]string, 0, 20)
+	fields := make([]string, 0, 22)
 	if m.user != nil {
 		fields = append(fields, ailog.FieldUserID)
 	}
@@ -2354,6 +2570,9 @@ func (m *AILogMutation) Fields() []string {
 	if m.input_tokens != nil {
 		fields = append(fields, ailog.FieldInputTokens)
 	}
+	if m.cached_input_tokens != nil {
+		fields = append(fields, ailog.FieldCachedInputTokens)
+	}
 	if m.output_tokens != nil {
 		fields = append(fields, ailog.FieldOutputTokens)
 	}
@@ -2369,14 +2588,17 @@ func (m *AILogMutation) Fields() []string {
 	if m.request_id != nil {
 		fields = append(fields, ailog.FieldRequestID)
 	}
+	if m.cached != nil {
+		fields = append(fields, ailog.FieldCached)
+	}
 	if m.status != nil {
 		fields = append(fields, ailog.FieldStatus)
 	}
 	if m.error_message != nil {
 		fields = append(fields, ailog.FieldErrorMessage)
 	}
-	if m.estimated_cost_cents != nil {
-		fields = append(fields, ailog.FieldEstimatedCostCents)
+	if m.cost_micro_cents != nil {
+		fields = append(fields, ailog.FieldCostMicroCents)
 	}
 	if m.metadata != nil {
 		fields = append(fields, ailog.FieldMetadata)
@@ -2412,6 +2634,8 @@ func (m *AILogMutation) Field(name string) (ent.Value, bool) {
 		return m.Content()
 	case ailog.FieldInputTokens:
 		return m.InputTokens()
+	case ailog.FieldCachedInputTokens:
+		return m.CachedInputTokens()
 	case ailog.FieldOutputTokens:
 		return m.OutputTokens()
 	case ailog.FieldThinkingTokens:
@@ -2422,12 +2646,14 @@ func (m *AILogMutation) Field(name string) (ent.Value, bool) {
 		return m.LatencyMs()
 	case ailog.FieldRequestID:
 		return m.RequestID()
+	case ailog.FieldCached:
+		return m.Cached()
 	case ailog.FieldStatus:
 		return m.Status()
 	case ailog.FieldErrorMessage:
 		return m.ErrorMessage()
-	case ailog.FieldEstimatedCostCents:
-		return m.EstimatedCostCents()
+	case ailog.FieldCostMicroCents:
+		return m.CostMicroCents()
 	case ailog.FieldMetadata:
 		return m.Metadata()
 	case ailog.FieldCreatedAt:
@@ -2461,6 +2687,8 @@ func (m *AILogMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldContent(ctx)
 	case ailog.FieldInputTokens:
 		return m.OldInputTokens(ctx)
+	case ailog.FieldCachedInputTokens:
+		return m.OldCachedInputTokens(ctx)
 	case ailog.FieldOutputTokens:
 		return m.OldOutputTokens(ctx)
 	case ailog.FieldThinkingTokens:
@@ -2471,12 +2699,14 @@ func (m *AILogMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldLatencyMs(ctx)
 	case ailog.FieldRequestID:
 		return m.OldRequestID(ctx)
+	case ailog.FieldCached:
+		return m.OldCached(ctx)
 	case ailog.FieldStatus:
 		return m.OldStatus(ctx)
 	case ailog.FieldErrorMessage:
 		return m.OldErrorMessage(ctx)
-	case ailog.FieldEstimatedCostCents:
-		return m.OldEstimatedCostCents(ctx)
+	case ailog.FieldCostMicroCents:
+		return m.OldCostMicroCents(ctx)
 	case ailog.FieldMetadata:
 		return m.OldMetadata(ctx)
 	case ailog.FieldCreatedAt:
@@ -2560,6 +2790,13 @@ func (m *AILogMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetInputTokens(v)
 		return nil
+	case ailog.FieldCachedInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCachedInputTokens(v)
+		return nil
 	case ailog.FieldOutputTokens:
 		v, ok := value.(int)
 		if !ok {
@@ -2595,6 +2832,13 @@ func (m *AILogMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetRequestID(v)
 		return nil
+	case ailog.FieldCached:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCached(v)
+		return nil
 	case ailog.FieldStatus:
 		v, ok := value.(ailog.Status)
 		if !ok {
@@ -2609,12 +2853,12 @@ func (m *AILogMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetErrorMessage(v)
 		return nil
-	case ailog.FieldEstimatedCostCents:
-		v, ok := value.(int)
+	case ailog.FieldCostMicroCents:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEstimatedCostCents(v)
+		m.SetCostMicroCents(v)
 		return nil
 	case ailog.FieldMetadata:
 		v, ok := value.(map[string]interface{})
@@ -2641,6 +2885,9 @@ func (m *AILogMutation) AddedFields() []string {
 	if m.addinput_tokens != nil {
 		fields = append(fields, ailog.FieldInputTokens)
 	}
+	if m.addcached_input_tokens != nil {
+		fields = append(fields, ailog.FieldCachedInputTokens)
+	}
 	if m.addoutput_tokens != nil {
 		fields = append(fields, ailog.FieldOutputTokens)
 	}
@@ -2653,8 +2900,8 @@ func (m *AILogMutation) AddedFields() []string {
 	if m.addlatency_ms != nil {
 		fields = append(fields, ailog.FieldLatencyMs)
 	}
-	if m.addestimated_cost_cents != nil {
-		fields = append(fields, ailog.FieldEstimatedCostCents)
+	if m.addcost_micro_cents != nil {
+		fields = append(fields, ailog.FieldCostMicroCents)
 	}
 	return fields
 }
@@ -2666,6 +2913,8 @@ func (m *AILogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case ailog.FieldInputTokens:
 		return m.AddedInputTokens()
+	case ailog.FieldCachedInputTokens:
+		return m.AddedCachedInputTokens()
 	case ailog.FieldOutputTokens:
 		return m.AddedOutputTokens()
 	case ailog.FieldThinkingTokens:
@@ -2674,8 +2923,8 @@ func (m *AILogMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedTotalTokens()
 	case ailog.FieldLatencyMs:
 		return m.AddedLatencyMs()
-	case ailog.FieldEstimatedCostCents:
-		return m.AddedEstimatedCostCents()
+	case ailog.FieldCostMicroCents:
+		return m.AddedCostMicroCents()
 	}
 	return nil, false
 }
@@ -2692,6 +2941,13 @@ func (m *AILogMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddInputTokens(v)
 		return nil
+	case ailog.FieldCachedInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCachedInputTokens(v)
+		return nil
 	case ailog.FieldOutputTokens:
 		v, ok := value.(int)
 		if !ok {
@@ -2720,12 +2976,12 @@ func (m *AILogMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddLatencyMs(v)
 		return nil
-	case ailog.FieldEstimatedCostCents:
-		v, ok := value.(int)
+	case ailog.FieldCostMicroCents:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddEstimatedCostCents(v)
+		m.AddCostMicroCents(v)
 		return nil
 	}
 	return fmt.Errorf("unknown AILog numeric field %s", name)
@@ -2759,6 +3015,9 @@ func (m *AILogMutation) ClearedFields() []string {
 	if m.FieldCleared(ailog.FieldErrorMessage) {
 		fields = append(fields, ailog.FieldErrorMessage)
 	}
+	if m.FieldCleared(ailog.FieldCostMicroCents) {
+		fields = append(fields, ailog.FieldCostMicroCents)
+	}
 	if m.FieldCleared(ailog.FieldMetadata) {
 		fields = append(fields, ailog.FieldMetadata)
 	}
@@ -2800,6 +3059,9 @@ func (m *AILogMutation) ClearField(name string) error {
 	case ailog.FieldErrorMessage:
 		m.ClearErrorMessage()
 		return nil
+	case ailog.FieldCostMicroCents:
+		m.ClearCostMicroCents()
+		return nil
 	case ailog.FieldMetadata:
 		m.ClearMetadata()
 		return nil
@@ -2841,6 +3103,9 @@ func (m *AILogMutation) ResetField(name string) error {
 	case ailog.FieldInputTokens:
 		m.ResetInputTokens()
 		return nil
+	case ailog.FieldCachedInputTokens:
+		m.ResetCachedInputTokens()
+		return nil
 	case ailog.FieldOutputTokens:
 		m.ResetOutputTokens()
 		return nil
@@ -2856,14 +3121,17 @@ func (m *AILogMutation) ResetField(name string) error {
 	case ailog.FieldRequestID:
 		m.ResetRequestID()
 		return nil
+	case ailog.FieldCached:
+		m.ResetCached()
+		return nil
 	case ailog.FieldStatus:
 		m.ResetStatus()
 		return nil
 	case ailog.FieldErrorMessage:
 		m.ResetErrorMessage()
 		return nil
-	case ailog.FieldEstimatedCostCents:
-		m.ResetEstimatedCostCents()
+	case ailog.FieldCostMicroCents:
+		m.ResetCostMicroCents()
 		return nil
 	case ailog.FieldMetadata:
 		m.ResetMetadata()
@@ -2967,39 +3235,35 @@ func (m *AILogMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AILog edge %s", name)
 }
 
-// HighlightMutation represents an operation that mutates the Highlight nodes in the graph.
-type HighlightMutation struct {
+// EmailVerificationTokenMutation represents an operation that mutates the EmailVerificationToken nodes in the graph.
+type EmailVerificationTokenMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	created_at        *time.Time
-	updated_at        *time.Time
-	text              *string
-	selector          *string
-	color             *string
-	note              *string
-	clearedFields     map[string]struct{}
-	session           *uuid.UUID
-	clearedsession    bool
-	page_visit        *uuid.UUID
-	clearedpage_visit bool
-	done              bool
-	oldValue          func(context.Context) (*Highlight, error)
-	predicates        []predicate.Highlight
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	token         *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	used          *bool
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*EmailVerificationToken, error)
+	predicates    []predicate.EmailVerificationToken
 }
 
-var _ ent.Mutation = (*HighlightMutation)(nil)
+var _ ent.Mutation = (*EmailVerificationTokenMutation)(nil)
 
-// highlightOption allows management of the mutation configuration using functional options.
-type highlightOption func(*HighlightMutation)
+// emailverificationtokenOption allows management of the mutation configuration using functional options.
+type emailverificationtokenOption func(*EmailVerificationTokenMutation)
 
-// newHighlightMutation creates new mutation for the Highlight entity.
-func newHighlightMutation(c config, op Op, opts ...highlightOption) *HighlightMutation {
-	m := &HighlightMutation{
+// newEmailVerificationTokenMutation creates new mutation for the EmailVerificationToken entity.
+func newEmailVerificationTokenMutation(c config, op Op, opts ...emailverificationtokenOption) *EmailVerificationTokenMutation {
+	m := &EmailVerificationTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHighlight,
+		typ:           TypeEmailVerificationToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3008,20 +3272,20 @@ func newHighlightMutation(c config, op Op, opts ...highlightOption) *HighlightMu
 	return m
 }
 
-// withHighlightID sets the ID field of the mutation.
-func withHighlightID(id uuid.UUID) highlightOption {
-	return func(m *HighlightMutation) {
+// withEmailVerificationTokenID sets the ID field of the mutation.
+func withEmailVerificationTokenID(id uuid.UUID) emailverificationtokenOption {
+	return func(m *EmailVerificationTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Highlight
+			value *EmailVerificationToken
 		)
-		m.oldValue = func(ctx context.Context) (*Highlight, error) {
+		m.oldValue = func(ctx context.Context) (*EmailVerificationToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Highlight.Get(ctx, id)
+					value, err = m.Client().EmailVerificationToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3030,10 +3294,10 @@ func withHighlightID(id uuid.UUID) highlightOption {
 	}
 }
 
-// withHighlight sets the old Highlight of the mutation.
-func withHighlight(node *Highlight) highlightOption {
-	return func(m *HighlightMutation) {
-		m.oldValue = func(context.Context) (*Highlight, error) {
+// withEmailVerificationToken sets the old EmailVerificationToken of the mutation.
+func withEmailVerificationToken(node *EmailVerificationToken) emailverificationtokenOption {
+	return func(m *EmailVerificationTokenMutation) {
+		m.oldValue = func(context.Context) (*EmailVerificationToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3042,7 +3306,7 @@ func withHighlight(node *Highlight) highlightOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HighlightMutation) Client() *Client {
+func (m EmailVerificationTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3050,7 +3314,7 @@ func (m HighlightMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HighlightMutation) Tx() (*Tx, error) {
+func (m EmailVerificationTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3060,14 +3324,14 @@ func (m HighlightMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Highlight entities.
-func (m *HighlightMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of EmailVerificationToken entities.
+func (m *EmailVerificationTokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HighlightMutation) ID() (id uuid.UUID, exists bool) {
+func (m *EmailVerificationTokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3078,7 +3342,7 @@ func (m *HighlightMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HighlightMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *EmailVerificationTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3087,341 +3351,228 @@ func (m *HighlightMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Highlight.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EmailVerificationToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *HighlightMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetToken sets the "token" field.
+func (m *EmailVerificationTokenMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HighlightMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Token returns the value of the "token" field in the mutation.
+func (m *EmailVerificationTokenMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Highlight entity.
-// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HighlightMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EmailVerificationTokenMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Token, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HighlightMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetToken resets all changes to the "token" field.
+func (m *EmailVerificationTokenMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *HighlightMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetUserID sets the "user_id" field.
+func (m *EmailVerificationTokenMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *HighlightMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EmailVerificationTokenMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Highlight entity.
-// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HighlightMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EmailVerificationTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.UserID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *HighlightMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EmailVerificationTokenMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetText sets the "text" field.
-func (m *HighlightMutation) SetText(s string) {
-	m.text = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *EmailVerificationTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *HighlightMutation) Text() (r string, exists bool) {
-	v := m.text
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EmailVerificationTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the Highlight entity.
-// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HighlightMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *EmailVerificationTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
-	}
-	return oldValue.Text, nil
-}
-
-// ResetText resets all changes to the "text" field.
-func (m *HighlightMutation) ResetText() {
-	m.text = nil
-}
-
-// SetSelector sets the "selector" field.
-func (m *HighlightMutation) SetSelector(s string) {
-	m.selector = &s
-}
-
-// Selector returns the value of the "selector" field in the mutation.
-func (m *HighlightMutation) Selector() (r string, exists bool) {
-	v := m.selector
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSelector returns the old "selector" field's value of the Highlight entity.
-// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HighlightMutation) OldSelector(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSelector is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSelector requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSelector: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Selector, nil
-}
-
-// ClearSelector clears the value of the "selector" field.
-func (m *HighlightMutation) ClearSelector() {
-	m.selector = nil
-	m.clearedFields[highlight.FieldSelector] = struct{}{}
-}
-
-// SelectorCleared returns if the "selector" field was cleared in this mutation.
-func (m *HighlightMutation) SelectorCleared() bool {
-	_, ok := m.clearedFields[highlight.FieldSelector]
-	return ok
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetSelector resets all changes to the "selector" field.
-func (m *HighlightMutation) ResetSelector() {
-	m.selector = nil
-	delete(m.clearedFields, highlight.FieldSelector)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EmailVerificationTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetColor sets the "color" field.
-func (m *HighlightMutation) SetColor(s string) {
-	m.color = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailVerificationTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *HighlightMutation) Color() (r string, exists bool) {
-	v := m.color
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailVerificationTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the Highlight entity.
-// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HighlightMutation) OldColor(ctx context.Context) (v string, err error) {
+func (m *EmailVerificationTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Color, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetColor resets all changes to the "color" field.
-func (m *HighlightMutation) ResetColor() {
-	m.color = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailVerificationTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetNote sets the "note" field.
-func (m *HighlightMutation) SetNote(s string) {
-	m.note = &s
+// SetUsed sets the "used" field.
+func (m *EmailVerificationTokenMutation) SetUsed(b bool) {
+	m.used = &b
 }
 
-// Note returns the value of the "note" field in the mutation.
-func (m *HighlightMutation) Note() (r string, exists bool) {
-	v := m.note
+// Used returns the value of the "used" field in the mutation.
+func (m *EmailVerificationTokenMutation) Used() (r bool, exists bool) {
+	v := m.used
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNote returns the old "note" field's value of the Highlight entity.
-// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
+// OldUsed returns the old "used" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HighlightMutation) OldNote(ctx context.Context) (v string, err error) {
+func (m *EmailVerificationTokenMutation) OldUsed(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNote requires an ID field in the mutation")
+		return v, errors.New("OldUsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNote: %w", err)
-	}
-	return oldValue.Note, nil
-}
-
-// ClearNote clears the value of the "note" field.
-func (m *HighlightMutation) ClearNote() {
-	m.note = nil
-	m.clearedFields[highlight.FieldNote] = struct{}{}
-}
-
-// NoteCleared returns if the "note" field was cleared in this mutation.
-func (m *HighlightMutation) NoteCleared() bool {
-	_, ok := m.clearedFields[highlight.FieldNote]
-	return ok
-}
-
-// ResetNote resets all changes to the "note" field.
-func (m *HighlightMutation) ResetNote() {
-	m.note = nil
-	delete(m.clearedFields, highlight.FieldNote)
-}
-
-// SetSessionID sets the "session" edge to the Session entity by id.
-func (m *HighlightMutation) SetSessionID(id uuid.UUID) {
-	m.session = &id
-}
-
-// ClearSession clears the "session" edge to the Session entity.
-func (m *HighlightMutation) ClearSession() {
-	m.clearedsession = true
-}
-
-// SessionCleared reports if the "session" edge to the Session entity was cleared.
-func (m *HighlightMutation) SessionCleared() bool {
-	return m.clearedsession
-}
-
-// SessionID returns the "session" edge ID in the mutation.
-func (m *HighlightMutation) SessionID() (id uuid.UUID, exists bool) {
-	if m.session != nil {
-		return *m.session, true
-	}
-	return
-}
-
-// SessionIDs returns the "session" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SessionID instead. It exists only for internal usage by the builders.
-func (m *HighlightMutation) SessionIDs() (ids []uuid.UUID) {
-	if id := m.session; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldUsed: %w", err)
 	}
-	return
-}
-
-// ResetSession resets all changes to the "session" edge.
-func (m *HighlightMutation) ResetSession() {
-	m.session = nil
-	m.clearedsession = false
-}
-
-// SetPageVisitID sets the "page_visit" edge to the PageVisit entity by id.
-func (m *HighlightMutation) SetPageVisitID(id uuid.UUID) {
-	m.page_visit = &id
+	return oldValue.Used, nil
 }
 
-// ClearPageVisit clears the "page_visit" edge to the PageVisit entity.
-func (m *HighlightMutation) ClearPageVisit() {
-	m.clearedpage_visit = true
+// ResetUsed resets all changes to the "used" field.
+func (m *EmailVerificationTokenMutation) ResetUsed() {
+	m.used = nil
 }
 
-// PageVisitCleared reports if the "page_visit" edge to the PageVisit entity was cleared.
-func (m *HighlightMutation) PageVisitCleared() bool {
-	return m.clearedpage_visit
+// ClearUser clears the "user" edge to the User entity.
+func (m *EmailVerificationTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[emailverificationtoken.FieldUserID] = struct{}{}
 }
 
-// PageVisitID returns the "page_visit" edge ID in the mutation.
-func (m *HighlightMutation) PageVisitID() (id uuid.UUID, exists bool) {
-	if m.page_visit != nil {
-		return *m.page_visit, true
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *EmailVerificationTokenMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// PageVisitIDs returns the "page_visit" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PageVisitID instead. It exists only for internal usage by the builders.
-func (m *HighlightMutation) PageVisitIDs() (ids []uuid.UUID) {
-	if id := m.page_visit; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *EmailVerificationTokenMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPageVisit resets all changes to the "page_visit" edge.
-func (m *HighlightMutation) ResetPageVisit() {
-	m.page_visit = nil
-	m.clearedpage_visit = false
+// ResetUser resets all changes to the "user" edge.
+func (m *EmailVerificationTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the HighlightMutation builder.
-func (m *HighlightMutation) Where(ps ...predicate.Highlight) {
+// Where appends a list predicates to the EmailVerificationTokenMutation builder.
+func (m *EmailVerificationTokenMutation) Where(ps ...predicate.EmailVerificationToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HighlightMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EmailVerificationTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HighlightMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Highlight, len(ps))
+func (m *EmailVerificationTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailVerificationToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3429,42 +3580,39 @@ func (m *HighlightMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HighlightMutation) Op() Op {
+func (m *EmailVerificationTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HighlightMutation) SetOp(op Op) {
+func (m *EmailVerificationTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Highlight).
-func (m *HighlightMutation) Type() string {
+// Type returns the node type of this mutation (EmailVerificationToken).
+func (m *EmailVerificationTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HighlightMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.created_at != nil {
-		fields = append(fields, highlight.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, highlight.FieldUpdatedAt)
+func (m *EmailVerificationTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.token != nil {
+		fields = append(fields, emailverificationtoken.FieldToken)
 	}
-	if m.text != nil {
-		fields = append(fields, highlight.FieldText)
+	if m.user != nil {
+		fields = append(fields, emailverificationtoken.FieldUserID)
 	}
-	if m.selector != nil {
-		fields = append(fields, highlight.FieldSelector)
+	if m.expires_at != nil {
+		fields = append(fields, emailverificationtoken.FieldExpiresAt)
 	}
-	if m.color != nil {
-		fields = append(fields, highlight.FieldColor)
+	if m.created_at != nil {
+		fields = append(fields, emailverificationtoken.FieldCreatedAt)
 	}
-	if m.note != nil {
-		fields = append(fields, highlight.FieldNote)
+	if m.used != nil {
+		fields = append(fields, emailverificationtoken.FieldUsed)
 	}
 	return fields
 }
@@ -3472,20 +3620,18 @@ func (m *HighlightMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HighlightMutation) Field(name string) (ent.Value, bool) {
+func (m *EmailVerificationTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case highlight.FieldCreatedAt:
+	case emailverificationtoken.FieldToken:
+		return m.Token()
+	case emailverificationtoken.FieldUserID:
+		return m.UserID()
+	case emailverificationtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case emailverificationtoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case highlight.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case highlight.FieldText:
-		return m.Text()
-	case highlight.FieldSelector:
-		return m.Selector()
-	case highlight.FieldColor:
-		return m.Color()
-	case highlight.FieldNote:
-		return m.Note()
+	case emailverificationtoken.FieldUsed:
+		return m.Used()
 	}
 	return nil, false
 }
@@ -3493,179 +3639,145 @@ func (m *HighlightMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HighlightMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EmailVerificationTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case highlight.FieldCreatedAt:
+	case emailverificationtoken.FieldToken:
+		return m.OldToken(ctx)
+	case emailverificationtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case emailverificationtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case emailverificationtoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case highlight.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case highlight.FieldText:
-		return m.OldText(ctx)
-	case highlight.FieldSelector:
-		return m.OldSelector(ctx)
-	case highlight.FieldColor:
-		return m.OldColor(ctx)
-	case highlight.FieldNote:
-		return m.OldNote(ctx)
+	case emailverificationtoken.FieldUsed:
+		return m.OldUsed(ctx)
 	}
-	return nil, fmt.Errorf("unknown Highlight field %s", name)
+	return nil, fmt.Errorf("unknown EmailVerificationToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HighlightMutation) SetField(name string, value ent.Value) error {
+func (m *EmailVerificationTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case highlight.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case highlight.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case emailverificationtoken.FieldToken:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetToken(v)
 		return nil
-	case highlight.FieldText:
-		v, ok := value.(string)
+	case emailverificationtoken.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetUserID(v)
 		return nil
-	case highlight.FieldSelector:
-		v, ok := value.(string)
+	case emailverificationtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSelector(v)
+		m.SetExpiresAt(v)
 		return nil
-	case highlight.FieldColor:
-		v, ok := value.(string)
+	case emailverificationtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColor(v)
+		m.SetCreatedAt(v)
 		return nil
-	case highlight.FieldNote:
-		v, ok := value.(string)
+	case emailverificationtoken.FieldUsed:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNote(v)
+		m.SetUsed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Highlight field %s", name)
+	return fmt.Errorf("unknown EmailVerificationToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HighlightMutation) AddedFields() []string {
+func (m *EmailVerificationTokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HighlightMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EmailVerificationTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HighlightMutation) AddField(name string, value ent.Value) error {
+func (m *EmailVerificationTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Highlight numeric field %s", name)
+	return fmt.Errorf("unknown EmailVerificationToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HighlightMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(highlight.FieldSelector) {
-		fields = append(fields, highlight.FieldSelector)
-	}
-	if m.FieldCleared(highlight.FieldNote) {
-		fields = append(fields, highlight.FieldNote)
-	}
-	return fields
+func (m *EmailVerificationTokenMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HighlightMutation) FieldCleared(name string) bool {
+func (m *EmailVerificationTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HighlightMutation) ClearField(name string) error {
-	switch name {
-	case highlight.FieldSelector:
-		m.ClearSelector()
-		return nil
-	case highlight.FieldNote:
-		m.ClearNote()
-		return nil
-	}
-	return fmt.Errorf("unknown Highlight nullable field %s", name)
+func (m *EmailVerificationTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EmailVerificationToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HighlightMutation) ResetField(name string) error {
+func (m *EmailVerificationTokenMutation) ResetField(name string) error {
 	switch name {
-	case highlight.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case highlight.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case emailverificationtoken.FieldToken:
+		m.ResetToken()
 		return nil
-	case highlight.FieldText:
-		m.ResetText()
+	case emailverificationtoken.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case highlight.FieldSelector:
-		m.ResetSelector()
+	case emailverificationtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case highlight.FieldColor:
-		m.ResetColor()
+	case emailverificationtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case highlight.FieldNote:
-		m.ResetNote()
+	case emailverificationtoken.FieldUsed:
+		m.ResetUsed()
 		return nil
 	}
-	return fmt.Errorf("unknown Highlight field %s", name)
+	return fmt.Errorf("unknown EmailVerificationToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HighlightMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.session != nil {
-		edges = append(edges, highlight.EdgeSession)
-	}
-	if m.page_visit != nil {
-		edges = append(edges, highlight.EdgePageVisit)
+func (m *EmailVerificationTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, emailverificationtoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HighlightMutation) AddedIDs(name string) []ent.Value {
+func (m *EmailVerificationTokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case highlight.EdgeSession:
-		if id := m.session; id != nil {
-			return []ent.Value{*id}
-		}
-	case highlight.EdgePageVisit:
-		if id := m.page_visit; id != nil {
+	case emailverificationtoken.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3673,106 +3785,91 @@ func (m *HighlightMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HighlightMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *EmailVerificationTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HighlightMutation) RemovedIDs(name string) []ent.Value {
+func (m *EmailVerificationTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HighlightMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedsession {
-		edges = append(edges, highlight.EdgeSession)
-	}
-	if m.clearedpage_visit {
-		edges = append(edges, highlight.EdgePageVisit)
+func (m *EmailVerificationTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, emailverificationtoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HighlightMutation) EdgeCleared(name string) bool {
+func (m *EmailVerificationTokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case highlight.EdgeSession:
-		return m.clearedsession
-	case highlight.EdgePageVisit:
-		return m.clearedpage_visit
+	case emailverificationtoken.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HighlightMutation) ClearEdge(name string) error {
+func (m *EmailVerificationTokenMutation) ClearEdge(name string) error {
 	switch name {
-	case highlight.EdgeSession:
-		m.ClearSession()
-		return nil
-	case highlight.EdgePageVisit:
-		m.ClearPageVisit()
+	case emailverificationtoken.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Highlight unique edge %s", name)
+	return fmt.Errorf("unknown EmailVerificationToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HighlightMutation) ResetEdge(name string) error {
+func (m *EmailVerificationTokenMutation) ResetEdge(name string) error {
 	switch name {
-	case highlight.EdgeSession:
-		m.ResetSession()
-		return nil
-	case highlight.EdgePageVisit:
-		m.ResetPageVisit()
+	case emailverificationtoken.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Highlight edge %s", name)
+	return fmt.Errorf("unknown EmailVerificationToken edge %s", name)
 }
 
-// MindmapGraphMutation represents an operation that mutates the MindmapGraph nodes in the graph.
-type MindmapGraphMutation struct {
+// HighlightMutation represents an operation that mutates the Highlight nodes in the graph.
+type HighlightMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *uuid.UUID
 	created_at        *time.Time
 	updated_at        *time.Time
-	status            *mindmapgraph.Status
-	nodes             *[]map[string]interface{}
-	appendnodes       []map[string]interface{}
-	graph_edges       *[]map[string]interface{}
-	appendgraph_edges []map[string]interface{}
-	layout            *map[string]interface{}
-	error_message     *string
-	generated_at      *time.Time
-	version           *int
-	addversion        *int
+	text              *string
+	selector          *string
+	color             *string
+	note              *string
 	clearedFields     map[string]struct{}
 	session           *uuid.UUID
 	clearedsession    bool
+	page_visit        *uuid.UUID
+	clearedpage_visit bool
 	done              bool
-	oldValue          func(context.Context) (*MindmapGraph, error)
-	predicates        []predicate.MindmapGraph
+	oldValue          func(context.Context) (*Highlight, error)
+	predicates        []predicate.Highlight
 }
 
-var _ ent.Mutation = (*MindmapGraphMutation)(nil)
+var _ ent.Mutation = (*HighlightMutation)(nil)
 
-// mindmapgraphOption allows management of the mutation configuration using functional options.
-type mindmapgraphOption func(*MindmapGraphMutation)
+// highlightOption allows management of the mutation configuration using functional options.
+type highlightOption func(*HighlightMutation)
 
-// newMindmapGraphMutation creates new mutation for the MindmapGraph entity.
-func newMindmapGraphMutation(c config, op Op, opts ...mindmapgraphOption) *MindmapGraphMutation {
-	m := &MindmapGraphMutation{
+// newHighlightMutation creates new mutation for the Highlight entity.
+func newHighlightMutation(c config, op Op, opts ...highlightOption) *HighlightMutation {
+	m := &HighlightMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMindmapGraph,
+		typ:           TypeHighlight,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3781,20 +3878,20 @@ func newMindmapGraphMutation(c config, op Op, opts ...mindmapgraphOption) *Mindm
 	return m
 }
 
-// withMindmapGraphID sets the ID field of the mutation.
-func withMindmapGraphID(id uuid.UUID) mindmapgraphOption {
-	return func(m *MindmapGraphMutation) {
+// withHighlightID sets the ID field of the mutation.
+func withHighlightID(id uuid.UUID) highlightOption {
+	return func(m *HighlightMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MindmapGraph
+			value *Highlight
 		)
-		m.oldValue = func(ctx context.Context) (*MindmapGraph, error) {
+		m.oldValue = func(ctx context.Context) (*Highlight, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MindmapGraph.Get(ctx, id)
+					value, err = m.Client().Highlight.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3803,10 +3900,10 @@ func withMindmapGraphID(id uuid.UUID) mindmapgraphOption {
 	}
 }
 
-// withMindmapGraph sets the old MindmapGraph of the mutation.
-func withMindmapGraph(node *MindmapGraph) mindmapgraphOption {
-	return func(m *MindmapGraphMutation) {
-		m.oldValue = func(context.Context) (*MindmapGraph, error) {
+// withHighlight sets the old Highlight of the mutation.
+func withHighlight(node *Highlight) highlightOption {
+	return func(m *HighlightMutation) {
+		m.oldValue = func(context.Context) (*Highlight, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3815,7 +3912,7 @@ func withMindmapGraph(node *MindmapGraph) mindmapgraphOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MindmapGraphMutation) Client() *Client {
+func (m HighlightMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3823,7 +3920,7 @@ func (m MindmapGraphMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MindmapGraphMutation) Tx() (*Tx, error) {
+func (m HighlightMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3833,14 +3930,14 @@ func (m MindmapGraphMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MindmapGraph entities.
-func (m *MindmapGraphMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Highlight entities.
+func (m *HighlightMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MindmapGraphMutation) ID() (id uuid.UUID, exists bool) {
+func (m *HighlightMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3851,7 +3948,7 @@ func (m *MindmapGraphMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MindmapGraphMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *HighlightMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3860,19 +3957,19 @@ func (m *MindmapGraphMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MindmapGraph.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Highlight.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MindmapGraphMutation) SetCreatedAt(t time.Time) {
+func (m *HighlightMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MindmapGraphMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *HighlightMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3880,10 +3977,10 @@ func (m *MindmapGraphMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Highlight entity.
+// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *HighlightMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3898,17 +3995,17 @@ func (m *MindmapGraphMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MindmapGraphMutation) ResetCreatedAt() {
+func (m *HighlightMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MindmapGraphMutation) SetUpdatedAt(t time.Time) {
+func (m *HighlightMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MindmapGraphMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *HighlightMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3916,10 +4013,10 @@ func (m *MindmapGraphMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Highlight entity.
+// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *HighlightMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3934,414 +4031,267 @@ func (m *MindmapGraphMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MindmapGraphMutation) ResetUpdatedAt() {
+func (m *HighlightMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *MindmapGraphMutation) SetStatus(value mindmapgraph.Status) {
-	m.status = &value
+// SetText sets the "text" field.
+func (m *HighlightMutation) SetText(s string) {
+	m.text = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *MindmapGraphMutation) Status() (r mindmapgraph.Status, exists bool) {
-	v := m.status
+// Text returns the value of the "text" field in the mutation.
+func (m *HighlightMutation) Text() (r string, exists bool) {
+	v := m.text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// OldText returns the old "text" field's value of the Highlight entity.
+// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldStatus(ctx context.Context) (v mindmapgraph.Status, err error) {
+func (m *HighlightMutation) OldText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Text, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *MindmapGraphMutation) ResetStatus() {
-	m.status = nil
+// ResetText resets all changes to the "text" field.
+func (m *HighlightMutation) ResetText() {
+	m.text = nil
 }
 
-// SetNodes sets the "nodes" field.
-func (m *MindmapGraphMutation) SetNodes(value []map[string]interface{}) {
-	m.nodes = &value
-	m.appendnodes = nil
+// SetSelector sets the "selector" field.
+func (m *HighlightMutation) SetSelector(s string) {
+	m.selector = &s
 }
 
-// Nodes returns the value of the "nodes" field in the mutation.
-func (m *MindmapGraphMutation) Nodes() (r []map[string]interface{}, exists bool) {
-	v := m.nodes
+// Selector returns the value of the "selector" field in the mutation.
+func (m *HighlightMutation) Selector() (r string, exists bool) {
+	v := m.selector
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNodes returns the old "nodes" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// OldSelector returns the old "selector" field's value of the Highlight entity.
+// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldNodes(ctx context.Context) (v []map[string]interface{}, err error) {
+func (m *HighlightMutation) OldSelector(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNodes is only allowed on UpdateOne operations")
+		return v, errors.New("OldSelector is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNodes requires an ID field in the mutation")
+		return v, errors.New("OldSelector requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNodes: %w", err)
-	}
-	return oldValue.Nodes, nil
-}
-
-// AppendNodes adds value to the "nodes" field.
-func (m *MindmapGraphMutation) AppendNodes(value []map[string]interface{}) {
-	m.appendnodes = append(m.appendnodes, value...)
-}
-
-// AppendedNodes returns the list of values that were appended to the "nodes" field in this mutation.
-func (m *MindmapGraphMutation) AppendedNodes() ([]map[string]interface{}, bool) {
-	if len(m.appendnodes) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldSelector: %w", err)
 	}
-	return m.appendnodes, true
+	return oldValue.Selector, nil
 }
 
-// ClearNodes clears the value of the "nodes" field.
-func (m *MindmapGraphMutation) ClearNodes() {
-	m.nodes = nil
-	m.appendnodes = nil
-	m.clearedFields[mindmapgraph.FieldNodes] = struct{}{}
+// ClearSelector clears the value of the "selector" field.
+func (m *HighlightMutation) ClearSelector() {
+	m.selector = nil
+	m.clearedFields[highlight.FieldSelector] = struct{}{}
 }
 
-// NodesCleared returns if the "nodes" field was cleared in this mutation.
-func (m *MindmapGraphMutation) NodesCleared() bool {
-	_, ok := m.clearedFields[mindmapgraph.FieldNodes]
+// SelectorCleared returns if the "selector" field was cleared in this mutation.
+func (m *HighlightMutation) SelectorCleared() bool {
+	_, ok := m.clearedFields[highlight.FieldSelector]
 	return ok
 }
 
-// ResetNodes resets all changes to the "nodes" field.
-func (m *MindmapGraphMutation) ResetNodes() {
-	m.nodes = nil
-	m.appendnodes = nil
-	delete(m.clearedFields, mindmapgraph.FieldNodes)
+// ResetSelector resets all changes to the "selector" field.
+func (m *HighlightMutation) ResetSelector() {
+	m.selector = nil
+	delete(m.clearedFields, highlight.FieldSelector)
 }
 
-// SetGraphEdges sets the "graph_edges" field.
-func (m *MindmapGraphMutation) SetGraphEdges(value []map[string]interface{}) {
-	m.graph_edges = &value
-	m.appendgraph_edges = nil
+// SetColor sets the "color" field.
+func (m *HighlightMutation) SetColor(s string) {
+	m.color = &s
 }
 
-// GraphEdges returns the value of the "graph_edges" field in the mutation.
-func (m *MindmapGraphMutation) GraphEdges() (r []map[string]interface{}, exists bool) {
-	v := m.graph_edges
+// Color returns the value of the "color" field in the mutation.
+func (m *HighlightMutation) Color() (r string, exists bool) {
+	v := m.color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGraphEdges returns the old "graph_edges" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// OldColor returns the old "color" field's value of the Highlight entity.
+// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldGraphEdges(ctx context.Context) (v []map[string]interface{}, err error) {
+func (m *HighlightMutation) OldColor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGraphEdges is only allowed on UpdateOne operations")
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGraphEdges requires an ID field in the mutation")
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGraphEdges: %w", err)
-	}
-	return oldValue.GraphEdges, nil
-}
-
-// AppendGraphEdges adds value to the "graph_edges" field.
-func (m *MindmapGraphMutation) AppendGraphEdges(value []map[string]interface{}) {
-	m.appendgraph_edges = append(m.appendgraph_edges, value...)
-}
-
-// AppendedGraphEdges returns the list of values that were appended to the "graph_edges" field in this mutation.
-func (m *MindmapGraphMutation) AppendedGraphEdges() ([]map[string]interface{}, bool) {
-	if len(m.appendgraph_edges) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
 	}
-	return m.appendgraph_edges, true
-}
-
-// ClearGraphEdges clears the value of the "graph_edges" field.
-func (m *MindmapGraphMutation) ClearGraphEdges() {
-	m.graph_edges = nil
-	m.appendgraph_edges = nil
-	m.clearedFields[mindmapgraph.FieldGraphEdges] = struct{}{}
-}
-
-// GraphEdgesCleared returns if the "graph_edges" field was cleared in this mutation.
-func (m *MindmapGraphMutation) GraphEdgesCleared() bool {
-	_, ok := m.clearedFields[mindmapgraph.FieldGraphEdges]
-	return ok
+	return oldValue.Color, nil
 }
 
-// ResetGraphEdges resets all changes to the "graph_edges" field.
-func (m *MindmapGraphMutation) ResetGraphEdges() {
-	m.graph_edges = nil
-	m.appendgraph_edges = nil
-	delete(m.clearedFields, mindmapgraph.FieldGraphEdges)
+// ResetColor resets all changes to the "color" field.
+func (m *HighlightMutation) ResetColor() {
+	m.color = nil
 }
 
-// SetLayout sets the "layout" field.
-func (m *MindmapGraphMutation) SetLayout(value map[string]interface{}) {
-	m.layout = &value
+// SetNote sets the "note" field.
+func (m *HighlightMutation) SetNote(s string) {
+	m.note = &s
 }
 
-// Layout returns the value of the "layout" field in the mutation.
-func (m *MindmapGraphMutation) Layout() (r map[string]interface{}, exists bool) {
-	v := m.layout
+// Note returns the value of the "note" field in the mutation.
+func (m *HighlightMutation) Note() (r string, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLayout returns the old "layout" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// OldNote returns the old "note" field's value of the Highlight entity.
+// If the Highlight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldLayout(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *HighlightMutation) OldNote(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLayout is only allowed on UpdateOne operations")
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLayout requires an ID field in the mutation")
+		return v, errors.New("OldNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLayout: %w", err)
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
 	}
-	return oldValue.Layout, nil
+	return oldValue.Note, nil
 }
 
-// ClearLayout clears the value of the "layout" field.
-func (m *MindmapGraphMutation) ClearLayout() {
-	m.layout = nil
-	m.clearedFields[mindmapgraph.FieldLayout] = struct{}{}
+// ClearNote clears the value of the "note" field.
+func (m *HighlightMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[highlight.FieldNote] = struct{}{}
 }
 
-// LayoutCleared returns if the "layout" field was cleared in this mutation.
-func (m *MindmapGraphMutation) LayoutCleared() bool {
-	_, ok := m.clearedFields[mindmapgraph.FieldLayout]
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *HighlightMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[highlight.FieldNote]
 	return ok
 }
 
-// ResetLayout resets all changes to the "layout" field.
-func (m *MindmapGraphMutation) ResetLayout() {
-	m.layout = nil
-	delete(m.clearedFields, mindmapgraph.FieldLayout)
+// ResetNote resets all changes to the "note" field.
+func (m *HighlightMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, highlight.FieldNote)
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *MindmapGraphMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetSessionID sets the "session" edge to the Session entity by id.
+func (m *HighlightMutation) SetSessionID(id uuid.UUID) {
+	m.session = &id
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *MindmapGraphMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearSession clears the "session" edge to the Session entity.
+func (m *HighlightMutation) ClearSession() {
+	m.clearedsession = true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
-	}
-	return oldValue.ErrorMessage, nil
+// SessionCleared reports if the "session" edge to the Session entity was cleared.
+func (m *HighlightMutation) SessionCleared() bool {
+	return m.clearedsession
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *MindmapGraphMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[mindmapgraph.FieldErrorMessage] = struct{}{}
+// SessionID returns the "session" edge ID in the mutation.
+func (m *HighlightMutation) SessionID() (id uuid.UUID, exists bool) {
+	if m.session != nil {
+		return *m.session, true
+	}
+	return
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *MindmapGraphMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[mindmapgraph.FieldErrorMessage]
-	return ok
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *HighlightMutation) SessionIDs() (ids []uuid.UUID) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *MindmapGraphMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, mindmapgraph.FieldErrorMessage)
+// ResetSession resets all changes to the "session" edge.
+func (m *HighlightMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
 }
 
-// SetGeneratedAt sets the "generated_at" field.
-func (m *MindmapGraphMutation) SetGeneratedAt(t time.Time) {
-	m.generated_at = &t
+// SetPageVisitID sets the "page_visit" edge to the PageVisit entity by id.
+func (m *HighlightMutation) SetPageVisitID(id uuid.UUID) {
+	m.page_visit = &id
 }
 
-// GeneratedAt returns the value of the "generated_at" field in the mutation.
-func (m *MindmapGraphMutation) GeneratedAt() (r time.Time, exists bool) {
-	v := m.generated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldGeneratedAt returns the old "generated_at" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldGeneratedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGeneratedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGeneratedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGeneratedAt: %w", err)
-	}
-	return oldValue.GeneratedAt, nil
-}
-
-// ResetGeneratedAt resets all changes to the "generated_at" field.
-func (m *MindmapGraphMutation) ResetGeneratedAt() {
-	m.generated_at = nil
-}
-
-// SetVersion sets the "version" field.
-func (m *MindmapGraphMutation) SetVersion(i int) {
-	m.version = &i
-	m.addversion = nil
-}
-
-// Version returns the value of the "version" field in the mutation.
-func (m *MindmapGraphMutation) Version() (r int, exists bool) {
-	v := m.version
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldVersion returns the old "version" field's value of the MindmapGraph entity.
-// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapGraphMutation) OldVersion(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
-	}
-	return oldValue.Version, nil
-}
-
-// AddVersion adds i to the "version" field.
-func (m *MindmapGraphMutation) AddVersion(i int) {
-	if m.addversion != nil {
-		*m.addversion += i
-	} else {
-		m.addversion = &i
-	}
-}
-
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *MindmapGraphMutation) AddedVersion() (r int, exists bool) {
-	v := m.addversion
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetVersion resets all changes to the "version" field.
-func (m *MindmapGraphMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
-}
-
-// SetSessionID sets the "session" edge to the Session entity by id.
-func (m *MindmapGraphMutation) SetSessionID(id uuid.UUID) {
-	m.session = &id
-}
-
-// ClearSession clears the "session" edge to the Session entity.
-func (m *MindmapGraphMutation) ClearSession() {
-	m.clearedsession = true
+// ClearPageVisit clears the "page_visit" edge to the PageVisit entity.
+func (m *HighlightMutation) ClearPageVisit() {
+	m.clearedpage_visit = true
 }
 
-// SessionCleared reports if the "session" edge to the Session entity was cleared.
-func (m *MindmapGraphMutation) SessionCleared() bool {
-	return m.clearedsession
+// PageVisitCleared reports if the "page_visit" edge to the PageVisit entity was cleared.
+func (m *HighlightMutation) PageVisitCleared() bool {
+	return m.clearedpage_visit
 }
 
-// SessionID returns the "session" edge ID in the mutation.
-func (m *MindmapGraphMutation) SessionID() (id uuid.UUID, exists bool) {
-	if m.session != nil {
-		return *m.session, true
+// PageVisitID returns the "page_visit" edge ID in the mutation.
+func (m *HighlightMutation) PageVisitID() (id uuid.UUID, exists bool) {
+	if m.page_visit != nil {
+		return *m.page_visit, true
 	}
 	return
 }
 
-// SessionIDs returns the "session" edge IDs in the mutation.
+// PageVisitIDs returns the "page_visit" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SessionID instead. It exists only for internal usage by the builders.
-func (m *MindmapGraphMutation) SessionIDs() (ids []uuid.UUID) {
-	if id := m.session; id != nil {
+// PageVisitID instead. It exists only for internal usage by the builders.
+func (m *HighlightMutation) PageVisitIDs() (ids []uuid.UUID) {
+	if id := m.page_visit; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSession resets all changes to the "session" edge.
-func (m *MindmapGraphMutation) ResetSession() {
-	m.session = nil
-	m.clearedsession = false
+// ResetPageVisit resets all changes to the "page_visit" edge.
+func (m *HighlightMutation) ResetPageVisit() {
+	m.page_visit = nil
+	m.clearedpage_visit = false
 }
 
-// Where appends a list predicates to the MindmapGraphMutation builder.
-func (m *MindmapGraphMutation) Where(ps ...predicate.MindmapGraph) {
+// Where appends a list predicates to the HighlightMutation builder.
+func (m *HighlightMutation) Where(ps ...predicate.Highlight) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MindmapGraphMutation builder. Using this method,
+// WhereP appends storage-level predicates to the HighlightMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MindmapGraphMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MindmapGraph, len(ps))
+func (m *HighlightMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Highlight, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4349,51 +4299,42 @@ func (m *MindmapGraphMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MindmapGraphMutation) Op() Op {
+func (m *HighlightMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MindmapGraphMutation) SetOp(op Op) {
+func (m *HighlightMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MindmapGraph).
-func (m *MindmapGraphMutation) Type() string {
+// Type returns the node type of this mutation (Highlight).
+func (m *HighlightMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MindmapGraphMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *HighlightMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
-		fields = append(fields, mindmapgraph.FieldCreatedAt)
+		fields = append(fields, highlight.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, mindmapgraph.FieldUpdatedAt)
-	}
-	if m.status != nil {
-		fields = append(fields, mindmapgraph.FieldStatus)
-	}
-	if m.nodes != nil {
-		fields = append(fields, mindmapgraph.FieldNodes)
-	}
-	if m.graph_edges != nil {
-		fields = append(fields, mindmapgraph.FieldGraphEdges)
+		fields = append(fields, highlight.FieldUpdatedAt)
 	}
-	if m.layout != nil {
-		fields = append(fields, mindmapgraph.FieldLayout)
+	if m.text != nil {
+		fields = append(fields, highlight.FieldText)
 	}
-	if m.error_message != nil {
-		fields = append(fields, mindmapgraph.FieldErrorMessage)
+	if m.selector != nil {
+		fields = append(fields, highlight.FieldSelector)
 	}
-	if m.generated_at != nil {
-		fields = append(fields, mindmapgraph.FieldGeneratedAt)
+	if m.color != nil {
+		fields = append(fields, highlight.FieldColor)
 	}
-	if m.version != nil {
-		fields = append(fields, mindmapgraph.FieldVersion)
+	if m.note != nil {
+		fields = append(fields, highlight.FieldNote)
 	}
 	return fields
 }
@@ -4401,26 +4342,20 @@ func (m *MindmapGraphMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MindmapGraphMutation) Field(name string) (ent.Value, bool) {
+func (m *HighlightMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case mindmapgraph.FieldCreatedAt:
+	case highlight.FieldCreatedAt:
 		return m.CreatedAt()
-	case mindmapgraph.FieldUpdatedAt:
+	case highlight.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case mindmapgraph.FieldStatus:
-		return m.Status()
-	case mindmapgraph.FieldNodes:
-		return m.Nodes()
-	case mindmapgraph.FieldGraphEdges:
-		return m.GraphEdges()
-	case mindmapgraph.FieldLayout:
-		return m.Layout()
-	case mindmapgraph.FieldErrorMessage:
-		return m.ErrorMessage()
-	case mindmapgraph.FieldGeneratedAt:
-		return m.GeneratedAt()
-	case mindmapgraph.FieldVersion:
-		return m.Version()
+	case highlight.FieldText:
+		return m.Text()
+	case highlight.FieldSelector:
+		return m.Selector()
+	case highlight.FieldColor:
+		return m.Color()
+	case highlight.FieldNote:
+		return m.Note()
 	}
 	return nil, false
 }
@@ -4428,329 +4363,285 @@ func (m *MindmapGraphMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MindmapGraphMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *HighlightMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case mindmapgraph.FieldCreatedAt:
+	case highlight.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case mindmapgraph.FieldUpdatedAt:
+	case highlight.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case mindmapgraph.FieldStatus:
-		return m.OldStatus(ctx)
-	case mindmapgraph.FieldNodes:
-		return m.OldNodes(ctx)
-	case mindmapgraph.FieldGraphEdges:
-		return m.OldGraphEdges(ctx)
-	case mindmapgraph.FieldLayout:
-		return m.OldLayout(ctx)
-	case mindmapgraph.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case mindmapgraph.FieldGeneratedAt:
-		return m.OldGeneratedAt(ctx)
-	case mindmapgraph.FieldVersion:
-		return m.OldVersion(ctx)
+	case highlight.FieldText:
+		return m.OldText(ctx)
+	case highlight.FieldSelector:
+		return m.OldSelector(ctx)
+	case highlight.FieldColor:
+		return m.OldColor(ctx)
+	case highlight.FieldNote:
+		return m.OldNote(ctx)
 	}
-	return nil, fmt.Errorf("unknown MindmapGraph field %s", name)
+	return nil, fmt.Errorf("unknown Highlight field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MindmapGraphMutation) SetField(name string, value ent.Value) error {
+func (m *HighlightMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case mindmapgraph.FieldCreatedAt:
+	case highlight.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case mindmapgraph.FieldUpdatedAt:
+	case highlight.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case mindmapgraph.FieldStatus:
-		v, ok := value.(mindmapgraph.Status)
+	case highlight.FieldText:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetText(v)
 		return nil
-	case mindmapgraph.FieldNodes:
-		v, ok := value.([]map[string]interface{})
+	case highlight.FieldSelector:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNodes(v)
+		m.SetSelector(v)
 		return nil
-	case mindmapgraph.FieldGraphEdges:
-		v, ok := value.([]map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGraphEdges(v)
-		return nil
-	case mindmapgraph.FieldLayout:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLayout(v)
-		return nil
-	case mindmapgraph.FieldErrorMessage:
+	case highlight.FieldColor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
-		return nil
-	case mindmapgraph.FieldGeneratedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGeneratedAt(v)
+		m.SetColor(v)
 		return nil
-	case mindmapgraph.FieldVersion:
-		v, ok := value.(int)
+	case highlight.FieldNote:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetNote(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MindmapGraph field %s", name)
+	return fmt.Errorf("unknown Highlight field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MindmapGraphMutation) AddedFields() []string {
-	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, mindmapgraph.FieldVersion)
-	}
-	return fields
+func (m *HighlightMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MindmapGraphMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case mindmapgraph.FieldVersion:
-		return m.AddedVersion()
-	}
+func (m *HighlightMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MindmapGraphMutation) AddField(name string, value ent.Value) error {
+func (m *HighlightMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case mindmapgraph.FieldVersion:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVersion(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MindmapGraph numeric field %s", name)
+	return fmt.Errorf("unknown Highlight numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MindmapGraphMutation) ClearedFields() []string {
+func (m *HighlightMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(mindmapgraph.FieldNodes) {
-		fields = append(fields, mindmapgraph.FieldNodes)
-	}
-	if m.FieldCleared(mindmapgraph.FieldGraphEdges) {
-		fields = append(fields, mindmapgraph.FieldGraphEdges)
-	}
-	if m.FieldCleared(mindmapgraph.FieldLayout) {
-		fields = append(fields, mindmapgraph.FieldLayout)
+	if m.FieldCleared(highlight.FieldSelector) {
+		fields = append(fields, highlight.FieldSelector)
 	}
-	if m.FieldCleared(mindmapgraph.FieldErrorMessage) {
-		fields = append(fields, mindmapgraph.FieldErrorMessage)
+	if m.FieldCleared(highlight.FieldNote) {
+		fields = append(fields, highlight.FieldNote)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MindmapGraphMutation) FieldCleared(name string) bool {
+func (m *HighlightMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MindmapGraphMutation) ClearField(name string) error {
+func (m *HighlightMutation) ClearField(name string) error {
 	switch name {
-	case mindmapgraph.FieldNodes:
-		m.ClearNodes()
-		return nil
-	case mindmapgraph.FieldGraphEdges:
-		m.ClearGraphEdges()
-		return nil
-	case mindmapgraph.FieldLayout:
-		m.ClearLayout()
+	case highlight.FieldSelector:
+		m.ClearSelector()
 		return nil
-	case mindmapgraph.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case highlight.FieldNote:
+		m.ClearNote()
 		return nil
 	}
-	return fmt.Errorf("unknown MindmapGraph nullable field %s", name)
+	return fmt.Errorf("unknown Highlight nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MindmapGraphMutation) ResetField(name string) error {
+func (m *HighlightMutation) ResetField(name string) error {
 	switch name {
-	case mindmapgraph.FieldCreatedAt:
+	case highlight.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case mindmapgraph.FieldUpdatedAt:
+	case highlight.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case mindmapgraph.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case mindmapgraph.FieldNodes:
-		m.ResetNodes()
-		return nil
-	case mindmapgraph.FieldGraphEdges:
-		m.ResetGraphEdges()
-		return nil
-	case mindmapgraph.FieldLayout:
-		m.ResetLayout()
+	case highlight.FieldText:
+		m.ResetText()
 		return nil
-	case mindmapgraph.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case highlight.FieldSelector:
+		m.ResetSelector()
 		return nil
-	case mindmapgraph.FieldGeneratedAt:
-		m.ResetGeneratedAt()
+	case highlight.FieldColor:
+		m.ResetColor()
 		return nil
-	case mindmapgraph.FieldVersion:
-		m.ResetVersion()
+	case highlight.FieldNote:
+		m.ResetNote()
 		return nil
 	}
-	return fmt.Errorf("unknown MindmapGraph field %s", name)
+	return fmt.Errorf("unknown Highlight field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MindmapGraphMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *HighlightMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.session != nil {
-		edges = append(edges, mindmapgraph.EdgeSession)
+		edges = append(edges, highlight.EdgeSession)
+	}
+	if m.page_visit != nil {
+		edges = append(edges, highlight.EdgePageVisit)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MindmapGraphMutation) AddedIDs(name string) []ent.Value {
+func (m *HighlightMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case mindmapgraph.EdgeSession:
+	case highlight.EdgeSession:
 		if id := m.session; id != nil {
 			return []ent.Value{*id}
 		}
+	case highlight.EdgePageVisit:
+		if id := m.page_visit; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MindmapGraphMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *HighlightMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MindmapGraphMutation) RemovedIDs(name string) []ent.Value {
+func (m *HighlightMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MindmapGraphMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *HighlightMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedsession {
-		edges = append(edges, mindmapgraph.EdgeSession)
+		edges = append(edges, highlight.EdgeSession)
+	}
+	if m.clearedpage_visit {
+		edges = append(edges, highlight.EdgePageVisit)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MindmapGraphMutation) EdgeCleared(name string) bool {
+func (m *HighlightMutation) EdgeCleared(name string) bool {
 	switch name {
-	case mindmapgraph.EdgeSession:
+	case highlight.EdgeSession:
 		return m.clearedsession
+	case highlight.EdgePageVisit:
+		return m.clearedpage_visit
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MindmapGraphMutation) ClearEdge(name string) error {
+func (m *HighlightMutation) ClearEdge(name string) error {
 	switch name {
-	case mindmapgraph.EdgeSession:
+	case highlight.EdgeSession:
 		m.ClearSession()
 		return nil
+	case highlight.EdgePageVisit:
+		m.ClearPageVisit()
+		return nil
 	}
-	return fmt.Errorf("unknown MindmapGraph unique edge %s", name)
+	return fmt.Errorf("unknown Highlight unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MindmapGraphMutation) ResetEdge(name string) error {
+func (m *HighlightMutation) ResetEdge(name string) error {
 	switch name {
-	case mindmapgraph.EdgeSession:
+	case highlight.EdgeSession:
 		m.ResetSession()
 		return nil
+	case highlight.EdgePageVisit:
+		m.ResetPageVisit()
+		return nil
 	}
-	return fmt.Errorf("unknown MindmapGraph edge %s", name)
+	return fmt.Errorf("unknown Highlight edge %s", name)
 }
 
-// PageVisitMutation represents an operation that mutates the PageVisit nodes in the graph.
-type PageVisitMutation struct {
+// KnowledgeMentionMutation represents an operation that mutates the KnowledgeMention nodes in the graph.
+type KnowledgeMentionMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	created_at          *time.Time
-	updated_at          *time.Time
-	entered_at          *time.Time
-	left_at             *time.Time
-	duration_ms         *int
-	addduration_ms      *int
-	max_scroll_depth    *float64
-	addmax_scroll_depth *float64
-	clearedFields       map[string]struct{}
-	session             *uuid.UUID
-	clearedsession      bool
-	url                 *uuid.UUID
-	clearedurl          bool
-	done                bool
-	oldValue            func(context.Context) (*PageVisit, error)
-	predicates          []predicate.PageVisit
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	node_id        *string
+	label          *string
+	keywords       *[]string
+	appendkeywords []string
+	seen_at        *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	topic          *uuid.UUID
+	clearedtopic   bool
+	session        *uuid.UUID
+	clearedsession bool
+	urls           map[uuid.UUID]struct{}
+	removedurls    map[uuid.UUID]struct{}
+	clearedurls    bool
+	done           bool
+	oldValue       func(context.Context) (*KnowledgeMention, error)
+	predicates     []predicate.KnowledgeMention
 }
 
-var _ ent.Mutation = (*PageVisitMutation)(nil)
+var _ ent.Mutation = (*KnowledgeMentionMutation)(nil)
 
-// pagevisitOption allows management of the mutation configuration using functional options.
-type pagevisitOption func(*PageVisitMutation)
+// knowledgementionOption allows management of the mutation configuration using functional options.
+type knowledgementionOption func(*KnowledgeMentionMutation)
 
-// newPageVisitMutation creates new mutation for the PageVisit entity.
-func newPageVisitMutation(c config, op Op, opts ...pagevisitOption) *PageVisitMutation {
-	m := &PageVisitMutation{
+// newKnowledgeMentionMutation creates new mutation for the KnowledgeMention entity.
+func newKnowledgeMentionMutation(c config, op Op, opts ...knowledgementionOption) *KnowledgeMentionMutation {
+	m := &KnowledgeMentionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePageVisit,
+		typ:           TypeKnowledgeMention,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4759,20 +4650,20 @@ func newPageVisitMutation(c config, op Op, opts ...pagevisitOption) *PageVisitMu
 	return m
 }
 
-// withPageVisitID sets the ID field of the mutation.
-func withPageVisitID(id uuid.UUID) pagevisitOption {
-	return func(m *PageVisitMutation) {
+// withKnowledgeMentionID sets the ID field of the mutation.
+func withKnowledgeMentionID(id uuid.UUID) knowledgementionOption {
+	return func(m *KnowledgeMentionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PageVisit
+			value *KnowledgeMention
 		)
-		m.oldValue = func(ctx context.Context) (*PageVisit, error) {
+		m.oldValue = func(ctx context.Context) (*KnowledgeMention, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PageVisit.Get(ctx, id)
+					value, err = m.Client().KnowledgeMention.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4781,10 +4672,10 @@ func withPageVisitID(id uuid.UUID) pagevisitOption {
 	}
 }
 
-// withPageVisit sets the old PageVisit of the mutation.
-func withPageVisit(node *PageVisit) pagevisitOption {
-	return func(m *PageVisitMutation) {
-		m.oldValue = func(context.Context) (*PageVisit, error) {
+// withKnowledgeMention sets the old KnowledgeMention of the mutation.
+func withKnowledgeMention(node *KnowledgeMention) knowledgementionOption {
+	return func(m *KnowledgeMentionMutation) {
+		m.oldValue = func(context.Context) (*KnowledgeMention, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4793,7 +4684,7 @@ func withPageVisit(node *PageVisit) pagevisitOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PageVisitMutation) Client() *Client {
+func (m KnowledgeMentionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4801,7 +4692,7 @@ func (m PageVisitMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PageVisitMutation) Tx() (*Tx, error) {
+func (m KnowledgeMentionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4811,14 +4702,14 @@ func (m PageVisitMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PageVisit entities.
-func (m *PageVisitMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of KnowledgeMention entities.
+func (m *KnowledgeMentionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PageVisitMutation) ID() (id uuid.UUID, exists bool) {
+func (m *KnowledgeMentionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4829,7 +4720,7 @@ func (m *PageVisitMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PageVisitMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *KnowledgeMentionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4838,312 +4729,277 @@ func (m *PageVisitMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PageVisit.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().KnowledgeMention.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PageVisitMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetNodeID sets the "node_id" field.
+func (m *KnowledgeMentionMutation) SetNodeID(s string) {
+	m.node_id = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PageVisitMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *KnowledgeMentionMutation) NodeID() (r string, exists bool) {
+	v := m.node_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PageVisit entity.
-// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// OldNodeID returns the old "node_id" field's value of the KnowledgeMention entity.
+// If the KnowledgeMention object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageVisitMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KnowledgeMentionMutation) OldNodeID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.NodeID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PageVisitMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *KnowledgeMentionMutation) ResetNodeID() {
+	m.node_id = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PageVisitMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetLabel sets the "label" field.
+func (m *KnowledgeMentionMutation) SetLabel(s string) {
+	m.label = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PageVisitMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Label returns the value of the "label" field in the mutation.
+func (m *KnowledgeMentionMutation) Label() (r string, exists bool) {
+	v := m.label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PageVisit entity.
-// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// OldLabel returns the old "label" field's value of the KnowledgeMention entity.
+// If the KnowledgeMention object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageVisitMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KnowledgeMentionMutation) OldLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Label, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PageVisitMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetLabel resets all changes to the "label" field.
+func (m *KnowledgeMentionMutation) ResetLabel() {
+	m.label = nil
 }
 
-// SetEnteredAt sets the "entered_at" field.
-func (m *PageVisitMutation) SetEnteredAt(t time.Time) {
-	m.entered_at = &t
+// SetKeywords sets the "keywords" field.
+func (m *KnowledgeMentionMutation) SetKeywords(s []string) {
+	m.keywords = &s
+	m.appendkeywords = nil
 }
 
-// EnteredAt returns the value of the "entered_at" field in the mutation.
-func (m *PageVisitMutation) EnteredAt() (r time.Time, exists bool) {
-	v := m.entered_at
+// Keywords returns the value of the "keywords" field in the mutation.
+func (m *KnowledgeMentionMutation) Keywords() (r []string, exists bool) {
+	v := m.keywords
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnteredAt returns the old "entered_at" field's value of the PageVisit entity.
-// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// OldKeywords returns the old "keywords" field's value of the KnowledgeMention entity.
+// If the KnowledgeMention object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageVisitMutation) OldEnteredAt(ctx context.Context) (v time.Time, err error) {
+func (m *KnowledgeMentionMutation) OldKeywords(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnteredAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeywords is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnteredAt requires an ID field in the mutation")
+		return v, errors.New("OldKeywords requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnteredAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
 	}
-	return oldValue.EnteredAt, nil
-}
-
-// ResetEnteredAt resets all changes to the "entered_at" field.
-func (m *PageVisitMutation) ResetEnteredAt() {
-	m.entered_at = nil
-}
-
-// SetLeftAt sets the "left_at" field.
-func (m *PageVisitMutation) SetLeftAt(t time.Time) {
-	m.left_at = &t
+	return oldValue.Keywords, nil
 }
 
-// LeftAt returns the value of the "left_at" field in the mutation.
-func (m *PageVisitMutation) LeftAt() (r time.Time, exists bool) {
-	v := m.left_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AppendKeywords adds s to the "keywords" field.
+func (m *KnowledgeMentionMutation) AppendKeywords(s []string) {
+	m.appendkeywords = append(m.appendkeywords, s...)
 }
 
-// OldLeftAt returns the old "left_at" field's value of the PageVisit entity.
-// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageVisitMutation) OldLeftAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLeftAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLeftAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLeftAt: %w", err)
+// AppendedKeywords returns the list of values that were appended to the "keywords" field in this mutation.
+func (m *KnowledgeMentionMutation) AppendedKeywords() ([]string, bool) {
+	if len(m.appendkeywords) == 0 {
+		return nil, false
 	}
-	return oldValue.LeftAt, nil
+	return m.appendkeywords, true
 }
 
-// ClearLeftAt clears the value of the "left_at" field.
-func (m *PageVisitMutation) ClearLeftAt() {
-	m.left_at = nil
-	m.clearedFields[pagevisit.FieldLeftAt] = struct{}{}
+// ClearKeywords clears the value of the "keywords" field.
+func (m *KnowledgeMentionMutation) ClearKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.clearedFields[knowledgemention.FieldKeywords] = struct{}{}
 }
 
-// LeftAtCleared returns if the "left_at" field was cleared in this mutation.
-func (m *PageVisitMutation) LeftAtCleared() bool {
-	_, ok := m.clearedFields[pagevisit.FieldLeftAt]
+// KeywordsCleared returns if the "keywords" field was cleared in this mutation.
+func (m *KnowledgeMentionMutation) KeywordsCleared() bool {
+	_, ok := m.clearedFields[knowledgemention.FieldKeywords]
 	return ok
 }
 
-// ResetLeftAt resets all changes to the "left_at" field.
-func (m *PageVisitMutation) ResetLeftAt() {
-	m.left_at = nil
-	delete(m.clearedFields, pagevisit.FieldLeftAt)
+// ResetKeywords resets all changes to the "keywords" field.
+func (m *KnowledgeMentionMutation) ResetKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	delete(m.clearedFields, knowledgemention.FieldKeywords)
 }
 
-// SetDurationMs sets the "duration_ms" field.
-func (m *PageVisitMutation) SetDurationMs(i int) {
-	m.duration_ms = &i
-	m.addduration_ms = nil
+// SetSeenAt sets the "seen_at" field.
+func (m *KnowledgeMentionMutation) SetSeenAt(t time.Time) {
+	m.seen_at = &t
 }
 
-// DurationMs returns the value of the "duration_ms" field in the mutation.
-func (m *PageVisitMutation) DurationMs() (r int, exists bool) {
-	v := m.duration_ms
+// SeenAt returns the value of the "seen_at" field in the mutation.
+func (m *KnowledgeMentionMutation) SeenAt() (r time.Time, exists bool) {
+	v := m.seen_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDurationMs returns the old "duration_ms" field's value of the PageVisit entity.
-// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// OldSeenAt returns the old "seen_at" field's value of the KnowledgeMention entity.
+// If the KnowledgeMention object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageVisitMutation) OldDurationMs(ctx context.Context) (v *int, err error) {
+func (m *KnowledgeMentionMutation) OldSeenAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeenAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+		return v, errors.New("OldSeenAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
-	}
-	return oldValue.DurationMs, nil
-}
-
-// AddDurationMs adds i to the "duration_ms" field.
-func (m *PageVisitMutation) AddDurationMs(i int) {
-	if m.addduration_ms != nil {
-		*m.addduration_ms += i
-	} else {
-		m.addduration_ms = &i
-	}
-}
-
-// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
-func (m *PageVisitMutation) AddedDurationMs() (r int, exists bool) {
-	v := m.addduration_ms
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSeenAt: %w", err)
 	}
-	return *v, true
-}
-
-// ClearDurationMs clears the value of the "duration_ms" field.
-func (m *PageVisitMutation) ClearDurationMs() {
-	m.duration_ms = nil
-	m.addduration_ms = nil
-	m.clearedFields[pagevisit.FieldDurationMs] = struct{}{}
-}
-
-// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
-func (m *PageVisitMutation) DurationMsCleared() bool {
-	_, ok := m.clearedFields[pagevisit.FieldDurationMs]
-	return ok
+	return oldValue.SeenAt, nil
 }
 
-// ResetDurationMs resets all changes to the "duration_ms" field.
-func (m *PageVisitMutation) ResetDurationMs() {
-	m.duration_ms = nil
-	m.addduration_ms = nil
-	delete(m.clearedFields, pagevisit.FieldDurationMs)
+// ResetSeenAt resets all changes to the "seen_at" field.
+func (m *KnowledgeMentionMutation) ResetSeenAt() {
+	m.seen_at = nil
 }
 
-// SetMaxScrollDepth sets the "max_scroll_depth" field.
-func (m *PageVisitMutation) SetMaxScrollDepth(f float64) {
-	m.max_scroll_depth = &f
-	m.addmax_scroll_depth = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *KnowledgeMentionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// MaxScrollDepth returns the value of the "max_scroll_depth" field in the mutation.
-func (m *PageVisitMutation) MaxScrollDepth() (r float64, exists bool) {
-	v := m.max_scroll_depth
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KnowledgeMentionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxScrollDepth returns the old "max_scroll_depth" field's value of the PageVisit entity.
-// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the KnowledgeMention entity.
+// If the KnowledgeMention object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageVisitMutation) OldMaxScrollDepth(ctx context.Context) (v float64, err error) {
+func (m *KnowledgeMentionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxScrollDepth is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxScrollDepth requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxScrollDepth: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.MaxScrollDepth, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddMaxScrollDepth adds f to the "max_scroll_depth" field.
-func (m *PageVisitMutation) AddMaxScrollDepth(f float64) {
-	if m.addmax_scroll_depth != nil {
-		*m.addmax_scroll_depth += f
-	} else {
-		m.addmax_scroll_depth = &f
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KnowledgeMentionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTopicID sets the "topic" edge to the KnowledgeTopic entity by id.
+func (m *KnowledgeMentionMutation) SetTopicID(id uuid.UUID) {
+	m.topic = &id
+}
+
+// ClearTopic clears the "topic" edge to the KnowledgeTopic entity.
+func (m *KnowledgeMentionMutation) ClearTopic() {
+	m.clearedtopic = true
+}
+
+// TopicCleared reports if the "topic" edge to the KnowledgeTopic entity was cleared.
+func (m *KnowledgeMentionMutation) TopicCleared() bool {
+	return m.clearedtopic
+}
+
+// TopicID returns the "topic" edge ID in the mutation.
+func (m *KnowledgeMentionMutation) TopicID() (id uuid.UUID, exists bool) {
+	if m.topic != nil {
+		return *m.topic, true
 	}
+	return
 }
 
-// AddedMaxScrollDepth returns the value that was added to the "max_scroll_depth" field in this mutation.
-func (m *PageVisitMutation) AddedMaxScrollDepth() (r float64, exists bool) {
-	v := m.addmax_scroll_depth
-	if v == nil {
-		return
+// TopicIDs returns the "topic" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TopicID instead. It exists only for internal usage by the builders.
+func (m *KnowledgeMentionMutation) TopicIDs() (ids []uuid.UUID) {
+	if id := m.topic; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetMaxScrollDepth resets all changes to the "max_scroll_depth" field.
-func (m *PageVisitMutation) ResetMaxScrollDepth() {
-	m.max_scroll_depth = nil
-	m.addmax_scroll_depth = nil
+// ResetTopic resets all changes to the "topic" edge.
+func (m *KnowledgeMentionMutation) ResetTopic() {
+	m.topic = nil
+	m.clearedtopic = false
 }
 
 // SetSessionID sets the "session" edge to the Session entity by id.
-func (m *PageVisitMutation) SetSessionID(id uuid.UUID) {
+func (m *KnowledgeMentionMutation) SetSessionID(id uuid.UUID) {
 	m.session = &id
 }
 
 // ClearSession clears the "session" edge to the Session entity.
-func (m *PageVisitMutation) ClearSession() {
+func (m *KnowledgeMentionMutation) ClearSession() {
 	m.clearedsession = true
 }
 
 // SessionCleared reports if the "session" edge to the Session entity was cleared.
-func (m *PageVisitMutation) SessionCleared() bool {
+func (m *KnowledgeMentionMutation) SessionCleared() bool {
 	return m.clearedsession
 }
 
 // SessionID returns the "session" edge ID in the mutation.
-func (m *PageVisitMutation) SessionID() (id uuid.UUID, exists bool) {
+func (m *KnowledgeMentionMutation) SessionID() (id uuid.UUID, exists bool) {
 	if m.session != nil {
 		return *m.session, true
 	}
@@ -5153,7 +5009,7 @@ func (m *PageVisitMutation) SessionID() (id uuid.UUID, exists bool) {
 // SessionIDs returns the "session" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // SessionID instead. It exists only for internal usage by the builders.
-func (m *PageVisitMutation) SessionIDs() (ids []uuid.UUID) {
+func (m *KnowledgeMentionMutation) SessionIDs() (ids []uuid.UUID) {
 	if id := m.session; id != nil {
 		ids = append(ids, *id)
 	}
@@ -5161,59 +5017,74 @@ func (m *PageVisitMutation) SessionIDs() (ids []uuid.UUID) {
 }
 
 // ResetSession resets all changes to the "session" edge.
-func (m *PageVisitMutation) ResetSession() {
+func (m *KnowledgeMentionMutation) ResetSession() {
 	m.session = nil
 	m.clearedsession = false
 }
 
-// SetURLID sets the "url" edge to the URL entity by id.
-func (m *PageVisitMutation) SetURLID(id uuid.UUID) {
-	m.url = &id
+// AddURLIDs adds the "urls" edge to the URL entity by ids.
+func (m *KnowledgeMentionMutation) AddURLIDs(ids ...uuid.UUID) {
+	if m.urls == nil {
+		m.urls = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.urls[ids[i]] = struct{}{}
+	}
 }
 
-// ClearURL clears the "url" edge to the URL entity.
-func (m *PageVisitMutation) ClearURL() {
-	m.clearedurl = true
+// ClearUrls clears the "urls" edge to the URL entity.
+func (m *KnowledgeMentionMutation) ClearUrls() {
+	m.clearedurls = true
 }
 
-// URLCleared reports if the "url" edge to the URL entity was cleared.
-func (m *PageVisitMutation) URLCleared() bool {
-	return m.clearedurl
+// UrlsCleared reports if the "urls" edge to the URL entity was cleared.
+func (m *KnowledgeMentionMutation) UrlsCleared() bool {
+	return m.clearedurls
 }
 
-// URLID returns the "url" edge ID in the mutation.
-func (m *PageVisitMutation) URLID() (id uuid.UUID, exists bool) {
-	if m.url != nil {
-		return *m.url, true
+// RemoveURLIDs removes the "urls" edge to the URL entity by IDs.
+func (m *KnowledgeMentionMutation) RemoveURLIDs(ids ...uuid.UUID) {
+	if m.removedurls == nil {
+		m.removedurls = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.urls, ids[i])
+		m.removedurls[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUrls returns the removed IDs of the "urls" edge to the URL entity.
+func (m *KnowledgeMentionMutation) RemovedUrlsIDs() (ids []uuid.UUID) {
+	for id := range m.removedurls {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// URLIDs returns the "url" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// URLID instead. It exists only for internal usage by the builders.
-func (m *PageVisitMutation) URLIDs() (ids []uuid.UUID) {
-	if id := m.url; id != nil {
-		ids = append(ids, *id)
+// UrlsIDs returns the "urls" edge IDs in the mutation.
+func (m *KnowledgeMentionMutation) UrlsIDs() (ids []uuid.UUID) {
+	for id := range m.urls {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetURL resets all changes to the "url" edge.
-func (m *PageVisitMutation) ResetURL() {
-	m.url = nil
-	m.clearedurl = false
+// ResetUrls resets all changes to the "urls" edge.
+func (m *KnowledgeMentionMutation) ResetUrls() {
+	m.urls = nil
+	m.clearedurls = false
+	m.removedurls = nil
 }
 
-// Where appends a list predicates to the PageVisitMutation builder.
-func (m *PageVisitMutation) Where(ps ...predicate.PageVisit) {
+// Where appends a list predicates to the KnowledgeMentionMutation builder.
+func (m *KnowledgeMentionMutation) Where(ps ...predicate.KnowledgeMention) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PageVisitMutation builder. Using this method,
+// WhereP appends storage-level predicates to the KnowledgeMentionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PageVisitMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PageVisit, len(ps))
+func (m *KnowledgeMentionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KnowledgeMention, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5221,42 +5092,39 @@ func (m *PageVisitMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PageVisitMutation) Op() Op {
+func (m *KnowledgeMentionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PageVisitMutation) SetOp(op Op) {
+func (m *KnowledgeMentionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PageVisit).
-func (m *PageVisitMutation) Type() string {
+// Type returns the node type of this mutation (KnowledgeMention).
+func (m *KnowledgeMentionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PageVisitMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.created_at != nil {
-		fields = append(fields, pagevisit.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, pagevisit.FieldUpdatedAt)
+func (m *KnowledgeMentionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.node_id != nil {
+		fields = append(fields, knowledgemention.FieldNodeID)
 	}
-	if m.entered_at != nil {
-		fields = append(fields, pagevisit.FieldEnteredAt)
+	if m.label != nil {
+		fields = append(fields, knowledgemention.FieldLabel)
 	}
-	if m.left_at != nil {
-		fields = append(fields, pagevisit.FieldLeftAt)
+	if m.keywords != nil {
+		fields = append(fields, knowledgemention.FieldKeywords)
 	}
-	if m.duration_ms != nil {
-		fields = append(fields, pagevisit.FieldDurationMs)
+	if m.seen_at != nil {
+		fields = append(fields, knowledgemention.FieldSeenAt)
 	}
-	if m.max_scroll_depth != nil {
-		fields = append(fields, pagevisit.FieldMaxScrollDepth)
+	if m.created_at != nil {
+		fields = append(fields, knowledgemention.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5264,20 +5132,18 @@ func (m *PageVisitMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PageVisitMutation) Field(name string) (ent.Value, bool) {
+func (m *KnowledgeMentionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pagevisit.FieldCreatedAt:
+	case knowledgemention.FieldNodeID:
+		return m.NodeID()
+	case knowledgemention.FieldLabel:
+		return m.Label()
+	case knowledgemention.FieldKeywords:
+		return m.Keywords()
+	case knowledgemention.FieldSeenAt:
+		return m.SeenAt()
+	case knowledgemention.FieldCreatedAt:
 		return m.CreatedAt()
-	case pagevisit.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case pagevisit.FieldEnteredAt:
-		return m.EnteredAt()
-	case pagevisit.FieldLeftAt:
-		return m.LeftAt()
-	case pagevisit.FieldDurationMs:
-		return m.DurationMs()
-	case pagevisit.FieldMaxScrollDepth:
-		return m.MaxScrollDepth()
 	}
 	return nil, false
 }
@@ -5285,305 +5151,294 @@ func (m *PageVisitMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PageVisitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *KnowledgeMentionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pagevisit.FieldCreatedAt:
+	case knowledgemention.FieldNodeID:
+		return m.OldNodeID(ctx)
+	case knowledgemention.FieldLabel:
+		return m.OldLabel(ctx)
+	case knowledgemention.FieldKeywords:
+		return m.OldKeywords(ctx)
+	case knowledgemention.FieldSeenAt:
+		return m.OldSeenAt(ctx)
+	case knowledgemention.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case pagevisit.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case pagevisit.FieldEnteredAt:
-		return m.OldEnteredAt(ctx)
-	case pagevisit.FieldLeftAt:
-		return m.OldLeftAt(ctx)
-	case pagevisit.FieldDurationMs:
-		return m.OldDurationMs(ctx)
-	case pagevisit.FieldMaxScrollDepth:
-		return m.OldMaxScrollDepth(ctx)
 	}
-	return nil, fmt.Errorf("unknown PageVisit field %s", name)
+	return nil, fmt.Errorf("unknown KnowledgeMention field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PageVisitMutation) SetField(name string, value ent.Value) error {
+func (m *KnowledgeMentionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pagevisit.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case knowledgemention.FieldNodeID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetNodeID(v)
 		return nil
-	case pagevisit.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case knowledgemention.FieldLabel:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetLabel(v)
 		return nil
-	case pagevisit.FieldEnteredAt:
-		v, ok := value.(time.Time)
+	case knowledgemention.FieldKeywords:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnteredAt(v)
+		m.SetKeywords(v)
 		return nil
-	case pagevisit.FieldLeftAt:
+	case knowledgemention.FieldSeenAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLeftAt(v)
-		return nil
-	case pagevisit.FieldDurationMs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDurationMs(v)
+		m.SetSeenAt(v)
 		return nil
-	case pagevisit.FieldMaxScrollDepth:
-		v, ok := value.(float64)
+	case knowledgemention.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxScrollDepth(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PageVisit field %s", name)
+	return fmt.Errorf("unknown KnowledgeMention field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PageVisitMutation) AddedFields() []string {
-	var fields []string
-	if m.addduration_ms != nil {
-		fields = append(fields, pagevisit.FieldDurationMs)
-	}
-	if m.addmax_scroll_depth != nil {
-		fields = append(fields, pagevisit.FieldMaxScrollDepth)
-	}
-	return fields
+func (m *KnowledgeMentionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PageVisitMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case pagevisit.FieldDurationMs:
-		return m.AddedDurationMs()
-	case pagevisit.FieldMaxScrollDepth:
-		return m.AddedMaxScrollDepth()
-	}
+func (m *KnowledgeMentionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PageVisitMutation) AddField(name string, value ent.Value) error {
+func (m *KnowledgeMentionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case pagevisit.FieldDurationMs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDurationMs(v)
-		return nil
-	case pagevisit.FieldMaxScrollDepth:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxScrollDepth(v)
-		return nil
 	}
-	return fmt.Errorf("unknown PageVisit numeric field %s", name)
+	return fmt.Errorf("unknown KnowledgeMention numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PageVisitMutation) ClearedFields() []string {
+func (m *KnowledgeMentionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(pagevisit.FieldLeftAt) {
-		fields = append(fields, pagevisit.FieldLeftAt)
-	}
-	if m.FieldCleared(pagevisit.FieldDurationMs) {
-		fields = append(fields, pagevisit.FieldDurationMs)
+	if m.FieldCleared(knowledgemention.FieldKeywords) {
+		fields = append(fields, knowledgemention.FieldKeywords)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PageVisitMutation) FieldCleared(name string) bool {
+func (m *KnowledgeMentionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PageVisitMutation) ClearField(name string) error {
+func (m *KnowledgeMentionMutation) ClearField(name string) error {
 	switch name {
-	case pagevisit.FieldLeftAt:
-		m.ClearLeftAt()
-		return nil
-	case pagevisit.FieldDurationMs:
-		m.ClearDurationMs()
+	case knowledgemention.FieldKeywords:
+		m.ClearKeywords()
 		return nil
 	}
-	return fmt.Errorf("unknown PageVisit nullable field %s", name)
+	return fmt.Errorf("unknown KnowledgeMention nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PageVisitMutation) ResetField(name string) error {
+func (m *KnowledgeMentionMutation) ResetField(name string) error {
 	switch name {
-	case pagevisit.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case pagevisit.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case knowledgemention.FieldNodeID:
+		m.ResetNodeID()
 		return nil
-	case pagevisit.FieldEnteredAt:
-		m.ResetEnteredAt()
+	case knowledgemention.FieldLabel:
+		m.ResetLabel()
 		return nil
-	case pagevisit.FieldLeftAt:
-		m.ResetLeftAt()
+	case knowledgemention.FieldKeywords:
+		m.ResetKeywords()
 		return nil
-	case pagevisit.FieldDurationMs:
-		m.ResetDurationMs()
+	case knowledgemention.FieldSeenAt:
+		m.ResetSeenAt()
 		return nil
-	case pagevisit.FieldMaxScrollDepth:
-		m.ResetMaxScrollDepth()
+	case knowledgemention.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PageVisit field %s", name)
+	return fmt.Errorf("unknown KnowledgeMention field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PageVisitMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *KnowledgeMentionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.topic != nil {
+		edges = append(edges, knowledgemention.EdgeTopic)
+	}
 	if m.session != nil {
-		edges = append(edges, pagevisit.EdgeSession)
+		edges = append(edges, knowledgemention.EdgeSession)
 	}
-	if m.url != nil {
-		edges = append(edges, pagevisit.EdgeURL)
+	if m.urls != nil {
+		edges = append(edges, knowledgemention.EdgeUrls)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PageVisitMutation) AddedIDs(name string) []ent.Value {
+func (m *KnowledgeMentionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case pagevisit.EdgeSession:
-		if id := m.session; id != nil {
+	case knowledgemention.EdgeTopic:
+		if id := m.topic; id != nil {
 			return []ent.Value{*id}
 		}
-	case pagevisit.EdgeURL:
-		if id := m.url; id != nil {
+	case knowledgemention.EdgeSession:
+		if id := m.session; id != nil {
 			return []ent.Value{*id}
 		}
+	case knowledgemention.EdgeUrls:
+		ids := make([]ent.Value, 0, len(m.urls))
+		for id := range m.urls {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PageVisitMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *KnowledgeMentionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedurls != nil {
+		edges = append(edges, knowledgemention.EdgeUrls)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PageVisitMutation) RemovedIDs(name string) []ent.Value {
+func (m *KnowledgeMentionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case knowledgemention.EdgeUrls:
+		ids := make([]ent.Value, 0, len(m.removedurls))
+		for id := range m.removedurls {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PageVisitMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *KnowledgeMentionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtopic {
+		edges = append(edges, knowledgemention.EdgeTopic)
+	}
 	if m.clearedsession {
-		edges = append(edges, pagevisit.EdgeSession)
+		edges = append(edges, knowledgemention.EdgeSession)
 	}
-	if m.clearedurl {
-		edges = append(edges, pagevisit.EdgeURL)
+	if m.clearedurls {
+		edges = append(edges, knowledgemention.EdgeUrls)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PageVisitMutation) EdgeCleared(name string) bool {
+func (m *KnowledgeMentionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case pagevisit.EdgeSession:
+	case knowledgemention.EdgeTopic:
+		return m.clearedtopic
+	case knowledgemention.EdgeSession:
 		return m.clearedsession
-	case pagevisit.EdgeURL:
-		return m.clearedurl
+	case knowledgemention.EdgeUrls:
+		return m.clearedurls
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PageVisitMutation) ClearEdge(name string) error {
+func (m *KnowledgeMentionMutation) ClearEdge(name string) error {
 	switch name {
-	case pagevisit.EdgeSession:
-		m.ClearSession()
+	case knowledgemention.EdgeTopic:
+		m.ClearTopic()
 		return nil
-	case pagevisit.EdgeURL:
-		m.ClearURL()
+	case knowledgemention.EdgeSession:
+		m.ClearSession()
 		return nil
 	}
-	return fmt.Errorf("unknown PageVisit unique edge %s", name)
+	return fmt.Errorf("unknown KnowledgeMention unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PageVisitMutation) ResetEdge(name string) error {
+func (m *KnowledgeMentionMutation) ResetEdge(name string) error {
 	switch name {
-	case pagevisit.EdgeSession:
+	case knowledgemention.EdgeTopic:
+		m.ResetTopic()
+		return nil
+	case knowledgemention.EdgeSession:
 		m.ResetSession()
 		return nil
-	case pagevisit.EdgeURL:
-		m.ResetURL()
+	case knowledgemention.EdgeUrls:
+		m.ResetUrls()
 		return nil
 	}
-	return fmt.Errorf("unknown PageVisit edge %s", name)
+	return fmt.Errorf("unknown KnowledgeMention edge %s", name)
 }
 
-// PasswordResetTokenMutation represents an operation that mutates the PasswordResetToken nodes in the graph.
-type PasswordResetTokenMutation struct {
+// KnowledgeTopicMutation represents an operation that mutates the KnowledgeTopic nodes in the graph.
+type KnowledgeTopicMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	token         *string
-	expires_at    *time.Time
-	created_at    *time.Time
-	used          *bool
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*PasswordResetToken, error)
-	predicates    []predicate.PasswordResetToken
-}
-
-var _ ent.Mutation = (*PasswordResetTokenMutation)(nil)
-
-// passwordresettokenOption allows management of the mutation configuration using functional options.
-type passwordresettokenOption func(*PasswordResetTokenMutation)
-
-// newPasswordResetTokenMutation creates new mutation for the PasswordResetToken entity.
-func newPasswordResetTokenMutation(c config, op Op, opts ...passwordresettokenOption) *PasswordResetTokenMutation {
-	m := &PasswordResetTokenMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	label           *string
+	keywords        *[]string
+	appendkeywords  []string
+	first_seen_at   *time.Time
+	last_seen_at    *time.Time
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	mentions        map[uuid.UUID]struct{}
+	removedmentions map[uuid.UUID]struct{}
+	clearedmentions bool
+	done            bool
+	oldValue        func(context.Context) (*KnowledgeTopic, error)
+	predicates      []predicate.KnowledgeTopic
+}
+
+var _ ent.Mutation = (*KnowledgeTopicMutation)(nil)
+
+// knowledgetopicOption allows management of the mutation configuration using functional options.
+type knowledgetopicOption func(*KnowledgeTopicMutation)
+
+// newKnowledgeTopicMutation creates new mutation for the KnowledgeTopic entity.
+func newKnowledgeTopicMutation(c config, op Op, opts ...knowledgetopicOption) *KnowledgeTopicMutation {
+	m := &KnowledgeTopicMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePasswordResetToken,
+		typ:           TypeKnowledgeTopic,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5592,20 +5447,20 @@ func newPasswordResetTokenMutation(c config, op Op, opts ...passwordresettokenOp
 	return m
 }
 
-// withPasswordResetTokenID sets the ID field of the mutation.
-func withPasswordResetTokenID(id uuid.UUID) passwordresettokenOption {
-	return func(m *PasswordResetTokenMutation) {
+// withKnowledgeTopicID sets the ID field of the mutation.
+func withKnowledgeTopicID(id uuid.UUID) knowledgetopicOption {
+	return func(m *KnowledgeTopicMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PasswordResetToken
+			value *KnowledgeTopic
 		)
-		m.oldValue = func(ctx context.Context) (*PasswordResetToken, error) {
+		m.oldValue = func(ctx context.Context) (*KnowledgeTopic, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PasswordResetToken.Get(ctx, id)
+					value, err = m.Client().KnowledgeTopic.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5614,10 +5469,10 @@ func withPasswordResetTokenID(id uuid.UUID) passwordresettokenOption {
 	}
 }
 
-// withPasswordResetToken sets the old PasswordResetToken of the mutation.
-func withPasswordResetToken(node *PasswordResetToken) passwordresettokenOption {
-	return func(m *PasswordResetTokenMutation) {
-		m.oldValue = func(context.Context) (*PasswordResetToken, error) {
+// withKnowledgeTopic sets the old KnowledgeTopic of the mutation.
+func withKnowledgeTopic(node *KnowledgeTopic) knowledgetopicOption {
+	return func(m *KnowledgeTopicMutation) {
+		m.oldValue = func(context.Context) (*KnowledgeTopic, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5626,7 +5481,7 @@ func withPasswordResetToken(node *PasswordResetToken) passwordresettokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PasswordResetTokenMutation) Client() *Client {
+func (m KnowledgeTopicMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5634,7 +5489,7 @@ func (m PasswordResetTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PasswordResetTokenMutation) Tx() (*Tx, error) {
+func (m KnowledgeTopicMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5644,14 +5499,14 @@ func (m PasswordResetTokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PasswordResetToken entities.
-func (m *PasswordResetTokenMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of KnowledgeTopic entities.
+func (m *KnowledgeTopicMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PasswordResetTokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *KnowledgeTopicMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5662,7 +5517,7 @@ func (m *PasswordResetTokenMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PasswordResetTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *KnowledgeTopicMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5671,207 +5526,284 @@ func (m *PasswordResetTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, erro
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PasswordResetToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().KnowledgeTopic.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetToken sets the "token" field.
-func (m *PasswordResetTokenMutation) SetToken(s string) {
-	m.token = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *KnowledgeTopicMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *PasswordResetTokenMutation) Token() (r string, exists bool) {
-	v := m.token
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KnowledgeTopicMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the PasswordResetToken entity.
-// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the KnowledgeTopic entity.
+// If the KnowledgeTopic object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *KnowledgeTopicMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *PasswordResetTokenMutation) ResetToken() {
-	m.token = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KnowledgeTopicMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PasswordResetTokenMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *KnowledgeTopicMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PasswordResetTokenMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *KnowledgeTopicMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the PasswordResetToken entity.
-// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the KnowledgeTopic entity.
+// If the KnowledgeTopic object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *KnowledgeTopicMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PasswordResetTokenMutation) ResetUserID() {
-	m.user = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *KnowledgeTopicMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *PasswordResetTokenMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetLabel sets the "label" field.
+func (m *KnowledgeTopicMutation) SetLabel(s string) {
+	m.label = &s
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *PasswordResetTokenMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// Label returns the value of the "label" field in the mutation.
+func (m *KnowledgeTopicMutation) Label() (r string, exists bool) {
+	v := m.label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the PasswordResetToken entity.
-// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// OldLabel returns the old "label" field's value of the KnowledgeTopic entity.
+// If the KnowledgeTopic object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *KnowledgeTopicMutation) OldLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.Label, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *PasswordResetTokenMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetLabel resets all changes to the "label" field.
+func (m *KnowledgeTopicMutation) ResetLabel() {
+	m.label = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PasswordResetTokenMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetKeywords sets the "keywords" field.
+func (m *KnowledgeTopicMutation) SetKeywords(s []string) {
+	m.keywords = &s
+	m.appendkeywords = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PasswordResetTokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Keywords returns the value of the "keywords" field in the mutation.
+func (m *KnowledgeTopicMutation) Keywords() (r []string, exists bool) {
+	v := m.keywords
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PasswordResetToken entity.
-// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// OldKeywords returns the old "keywords" field's value of the KnowledgeTopic entity.
+// If the KnowledgeTopic object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KnowledgeTopicMutation) OldKeywords(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeywords is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldKeywords requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Keywords, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PasswordResetTokenMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AppendKeywords adds s to the "keywords" field.
+func (m *KnowledgeTopicMutation) AppendKeywords(s []string) {
+	m.appendkeywords = append(m.appendkeywords, s...)
 }
 
-// SetUsed sets the "used" field.
-func (m *PasswordResetTokenMutation) SetUsed(b bool) {
-	m.used = &b
+// AppendedKeywords returns the list of values that were appended to the "keywords" field in this mutation.
+func (m *KnowledgeTopicMutation) AppendedKeywords() ([]string, bool) {
+	if len(m.appendkeywords) == 0 {
+		return nil, false
+	}
+	return m.appendkeywords, true
 }
 
-// Used returns the value of the "used" field in the mutation.
-func (m *PasswordResetTokenMutation) Used() (r bool, exists bool) {
-	v := m.used
+// ClearKeywords clears the value of the "keywords" field.
+func (m *KnowledgeTopicMutation) ClearKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.clearedFields[knowledgetopic.FieldKeywords] = struct{}{}
+}
+
+// KeywordsCleared returns if the "keywords" field was cleared in this mutation.
+func (m *KnowledgeTopicMutation) KeywordsCleared() bool {
+	_, ok := m.clearedFields[knowledgetopic.FieldKeywords]
+	return ok
+}
+
+// ResetKeywords resets all changes to the "keywords" field.
+func (m *KnowledgeTopicMutation) ResetKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	delete(m.clearedFields, knowledgetopic.FieldKeywords)
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (m *KnowledgeTopicMutation) SetFirstSeenAt(t time.Time) {
+	m.first_seen_at = &t
+}
+
+// FirstSeenAt returns the value of the "first_seen_at" field in the mutation.
+func (m *KnowledgeTopicMutation) FirstSeenAt() (r time.Time, exists bool) {
+	v := m.first_seen_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsed returns the old "used" field's value of the PasswordResetToken entity.
-// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// OldFirstSeenAt returns the old "first_seen_at" field's value of the KnowledgeTopic entity.
+// If the KnowledgeTopic object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetTokenMutation) OldUsed(ctx context.Context) (v bool, err error) {
+func (m *KnowledgeTopicMutation) OldFirstSeenAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldFirstSeenAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsed requires an ID field in the mutation")
+		return v, errors.New("OldFirstSeenAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldFirstSeenAt: %w", err)
 	}
-	return oldValue.Used, nil
+	return oldValue.FirstSeenAt, nil
 }
 
-// ResetUsed resets all changes to the "used" field.
-func (m *PasswordResetTokenMutation) ResetUsed() {
-	m.used = nil
+// ResetFirstSeenAt resets all changes to the "first_seen_at" field.
+func (m *KnowledgeTopicMutation) ResetFirstSeenAt() {
+	m.first_seen_at = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *KnowledgeTopicMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *KnowledgeTopicMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the KnowledgeTopic entity.
+// If the KnowledgeTopic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeTopicMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *KnowledgeTopicMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *KnowledgeTopicMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *PasswordResetTokenMutation) ClearUser() {
+func (m *KnowledgeTopicMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[passwordresettoken.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PasswordResetTokenMutation) UserCleared() bool {
+func (m *KnowledgeTopicMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
+// UserID returns the "user" edge ID in the mutation.
+func (m *KnowledgeTopicMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *PasswordResetTokenMutation) UserIDs() (ids []uuid.UUID) {
+func (m *KnowledgeTopicMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -5879,20 +5811,74 @@ func (m *PasswordResetTokenMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *PasswordResetTokenMutation) ResetUser() {
+func (m *KnowledgeTopicMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the PasswordResetTokenMutation builder.
-func (m *PasswordResetTokenMutation) Where(ps ...predicate.PasswordResetToken) {
+// AddMentionIDs adds the "mentions" edge to the KnowledgeMention entity by ids.
+func (m *KnowledgeTopicMutation) AddMentionIDs(ids ...uuid.UUID) {
+	if m.mentions == nil {
+		m.mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.mentions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentions clears the "mentions" edge to the KnowledgeMention entity.
+func (m *KnowledgeTopicMutation) ClearMentions() {
+	m.clearedmentions = true
+}
+
+// MentionsCleared reports if the "mentions" edge to the KnowledgeMention entity was cleared.
+func (m *KnowledgeTopicMutation) MentionsCleared() bool {
+	return m.clearedmentions
+}
+
+// RemoveMentionIDs removes the "mentions" edge to the KnowledgeMention entity by IDs.
+func (m *KnowledgeTopicMutation) RemoveMentionIDs(ids ...uuid.UUID) {
+	if m.removedmentions == nil {
+		m.removedmentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.mentions, ids[i])
+		m.removedmentions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentions returns the removed IDs of the "mentions" edge to the KnowledgeMention entity.
+func (m *KnowledgeTopicMutation) RemovedMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionsIDs returns the "mentions" edge IDs in the mutation.
+func (m *KnowledgeTopicMutation) MentionsIDs() (ids []uuid.UUID) {
+	for id := range m.mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentions resets all changes to the "mentions" edge.
+func (m *KnowledgeTopicMutation) ResetMentions() {
+	m.mentions = nil
+	m.clearedmentions = false
+	m.removedmentions = nil
+}
+
+// Where appends a list predicates to the KnowledgeTopicMutation builder.
+func (m *KnowledgeTopicMutation) Where(ps ...predicate.KnowledgeTopic) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PasswordResetTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the KnowledgeTopicMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PasswordResetTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PasswordResetToken, len(ps))
+func (m *KnowledgeTopicMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KnowledgeTopic, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5900,39 +5886,42 @@ func (m *PasswordResetTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PasswordResetTokenMutation) Op() Op {
+func (m *KnowledgeTopicMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PasswordResetTokenMutation) SetOp(op Op) {
+func (m *KnowledgeTopicMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PasswordResetToken).
-func (m *PasswordResetTokenMutation) Type() string {
+// Type returns the node type of this mutation (KnowledgeTopic).
+func (m *KnowledgeTopicMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PasswordResetTokenMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.token != nil {
-		fields = append(fields, passwordresettoken.FieldToken)
+func (m *KnowledgeTopicMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, knowledgetopic.FieldCreatedAt)
 	}
-	if m.user != nil {
-		fields = append(fields, passwordresettoken.FieldUserID)
+	if m.updated_at != nil {
+		fields = append(fields, knowledgetopic.FieldUpdatedAt)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, passwordresettoken.FieldExpiresAt)
+	if m.label != nil {
+		fields = append(fields, knowledgetopic.FieldLabel)
 	}
-	if m.created_at != nil {
-		fields = append(fields, passwordresettoken.FieldCreatedAt)
+	if m.keywords != nil {
+		fields = append(fields, knowledgetopic.FieldKeywords)
 	}
-	if m.used != nil {
-		fields = append(fields, passwordresettoken.FieldUsed)
+	if m.first_seen_at != nil {
+		fields = append(fields, knowledgetopic.FieldFirstSeenAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, knowledgetopic.FieldLastSeenAt)
 	}
 	return fields
 }
@@ -5940,18 +5929,20 @@ func (m *PasswordResetTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PasswordResetTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *KnowledgeTopicMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case passwordresettoken.FieldToken:
-		return m.Token()
-	case passwordresettoken.FieldUserID:
-		return m.UserID()
-	case passwordresettoken.FieldExpiresAt:
-		return m.ExpiresAt()
-	case passwordresettoken.FieldCreatedAt:
+	case knowledgetopic.FieldCreatedAt:
 		return m.CreatedAt()
-	case passwordresettoken.FieldUsed:
-		return m.Used()
+	case knowledgetopic.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case knowledgetopic.FieldLabel:
+		return m.Label()
+	case knowledgetopic.FieldKeywords:
+		return m.Keywords()
+	case knowledgetopic.FieldFirstSeenAt:
+		return m.FirstSeenAt()
+	case knowledgetopic.FieldLastSeenAt:
+		return m.LastSeenAt()
 	}
 	return nil, false
 }
@@ -5959,242 +5950,281 @@ func (m *PasswordResetTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PasswordResetTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *KnowledgeTopicMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case passwordresettoken.FieldToken:
-		return m.OldToken(ctx)
-	case passwordresettoken.FieldUserID:
-		return m.OldUserID(ctx)
-	case passwordresettoken.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case passwordresettoken.FieldCreatedAt:
+	case knowledgetopic.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case passwordresettoken.FieldUsed:
-		return m.OldUsed(ctx)
+	case knowledgetopic.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case knowledgetopic.FieldLabel:
+		return m.OldLabel(ctx)
+	case knowledgetopic.FieldKeywords:
+		return m.OldKeywords(ctx)
+	case knowledgetopic.FieldFirstSeenAt:
+		return m.OldFirstSeenAt(ctx)
+	case knowledgetopic.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PasswordResetToken field %s", name)
+	return nil, fmt.Errorf("unknown KnowledgeTopic field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordResetTokenMutation) SetField(name string, value ent.Value) error {
+func (m *KnowledgeTopicMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case passwordresettoken.FieldToken:
-		v, ok := value.(string)
+	case knowledgetopic.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetCreatedAt(v)
 		return nil
-	case passwordresettoken.FieldUserID:
-		v, ok := value.(uuid.UUID)
+	case knowledgetopic.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case passwordresettoken.FieldExpiresAt:
-		v, ok := value.(time.Time)
+	case knowledgetopic.FieldLabel:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetLabel(v)
 		return nil
-	case passwordresettoken.FieldCreatedAt:
+	case knowledgetopic.FieldKeywords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeywords(v)
+		return nil
+	case knowledgetopic.FieldFirstSeenAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetFirstSeenAt(v)
 		return nil
-	case passwordresettoken.FieldUsed:
-		v, ok := value.(bool)
+	case knowledgetopic.FieldLastSeenAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsed(v)
+		m.SetLastSeenAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PasswordResetToken field %s", name)
+	return fmt.Errorf("unknown KnowledgeTopic field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PasswordResetTokenMutation) AddedFields() []string {
+func (m *KnowledgeTopicMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PasswordResetTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *KnowledgeTopicMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordResetTokenMutation) AddField(name string, value ent.Value) error {
+func (m *KnowledgeTopicMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PasswordResetToken numeric field %s", name)
+	return fmt.Errorf("unknown KnowledgeTopic numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PasswordResetTokenMutation) ClearedFields() []string {
-	return nil
+func (m *KnowledgeTopicMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(knowledgetopic.FieldKeywords) {
+		fields = append(fields, knowledgetopic.FieldKeywords)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PasswordResetTokenMutation) FieldCleared(name string) bool {
+func (m *KnowledgeTopicMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PasswordResetTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PasswordResetToken nullable field %s", name)
+func (m *KnowledgeTopicMutation) ClearField(name string) error {
+	switch name {
+	case knowledgetopic.FieldKeywords:
+		m.ClearKeywords()
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeTopic nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PasswordResetTokenMutation) ResetField(name string) error {
+func (m *KnowledgeTopicMutation) ResetField(name string) error {
 	switch name {
-	case passwordresettoken.FieldToken:
-		m.ResetToken()
+	case knowledgetopic.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case passwordresettoken.FieldUserID:
-		m.ResetUserID()
+	case knowledgetopic.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case passwordresettoken.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case knowledgetopic.FieldLabel:
+		m.ResetLabel()
 		return nil
-	case passwordresettoken.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case knowledgetopic.FieldKeywords:
+		m.ResetKeywords()
 		return nil
-	case passwordresettoken.FieldUsed:
-		m.ResetUsed()
+	case knowledgetopic.FieldFirstSeenAt:
+		m.ResetFirstSeenAt()
+		return nil
+	case knowledgetopic.FieldLastSeenAt:
+		m.ResetLastSeenAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PasswordResetToken field %s", name)
+	return fmt.Errorf("unknown KnowledgeTopic field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PasswordResetTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *KnowledgeTopicMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, passwordresettoken.EdgeUser)
+		edges = append(edges, knowledgetopic.EdgeUser)
+	}
+	if m.mentions != nil {
+		edges = append(edges, knowledgetopic.EdgeMentions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PasswordResetTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *KnowledgeTopicMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case passwordresettoken.EdgeUser:
+	case knowledgetopic.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case knowledgetopic.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.mentions))
+		for id := range m.mentions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PasswordResetTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *KnowledgeTopicMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmentions != nil {
+		edges = append(edges, knowledgetopic.EdgeMentions)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PasswordResetTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *KnowledgeTopicMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case knowledgetopic.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.removedmentions))
+		for id := range m.removedmentions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PasswordResetTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *KnowledgeTopicMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, passwordresettoken.EdgeUser)
+		edges = append(edges, knowledgetopic.EdgeUser)
+	}
+	if m.clearedmentions {
+		edges = append(edges, knowledgetopic.EdgeMentions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PasswordResetTokenMutation) EdgeCleared(name string) bool {
+func (m *KnowledgeTopicMutation) EdgeCleared(name string) bool {
 	switch name {
-	case passwordresettoken.EdgeUser:
+	case knowledgetopic.EdgeUser:
 		return m.cleareduser
+	case knowledgetopic.EdgeMentions:
+		return m.clearedmentions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PasswordResetTokenMutation) ClearEdge(name string) error {
+func (m *KnowledgeTopicMutation) ClearEdge(name string) error {
 	switch name {
-	case passwordresettoken.EdgeUser:
+	case knowledgetopic.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown PasswordResetToken unique edge %s", name)
+	return fmt.Errorf("unknown KnowledgeTopic unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PasswordResetTokenMutation) ResetEdge(name string) error {
+func (m *KnowledgeTopicMutation) ResetEdge(name string) error {
 	switch name {
-	case passwordresettoken.EdgeUser:
+	case knowledgetopic.EdgeUser:
 		m.ResetUser()
 		return nil
+	case knowledgetopic.EdgeMentions:
+		m.ResetMentions()
+		return nil
 	}
-	return fmt.Errorf("unknown PasswordResetToken edge %s", name)
+	return fmt.Errorf("unknown KnowledgeTopic edge %s", name)
 }
 
-// PlanMutation represents an operation that mutates the Plan nodes in the graph.
-type PlanMutation struct {
+// MindmapEditMutation represents an operation that mutates the MindmapEdit nodes in the graph.
+type MindmapEditMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *string
-	name                       *string
-	price_cents                *int
-	addprice_cents             *int
-	billing_period             *string
-	token_limit                *int
-	addtoken_limit             *int
-	session_retention_days     *int
-	addsession_retention_days  *int
-	max_concurrent_sessions    *int
-	addmax_concurrent_sessions *int
-	features                   *map[string]bool
-	created_at                 *time.Time
-	clearedFields              map[string]struct{}
-	subscriptions              map[uuid.UUID]struct{}
-	removedsubscriptions       map[uuid.UUID]struct{}
-	clearedsubscriptions       bool
-	done                       bool
-	oldValue                   func(context.Context) (*Plan, error)
-	predicates                 []predicate.Plan
+	op             Op
+	typ            string
+	id             *int
+	_op            *mindmapedit.Op
+	payload        *map[string]interface{}
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	mindmap        *uuid.UUID
+	clearedmindmap bool
+	done           bool
+	oldValue       func(context.Context) (*MindmapEdit, error)
+	predicates     []predicate.MindmapEdit
 }
 
-var _ ent.Mutation = (*PlanMutation)(nil)
+var _ ent.Mutation = (*MindmapEditMutation)(nil)
 
-// planOption allows management of the mutation configuration using functional options.
-type planOption func(*PlanMutation)
+// mindmapeditOption allows management of the mutation configuration using functional options.
+type mindmapeditOption func(*MindmapEditMutation)
 
-// newPlanMutation creates new mutation for the Plan entity.
-func newPlanMutation(c config, op Op, opts ...planOption) *PlanMutation {
-	m := &PlanMutation{
+// newMindmapEditMutation creates new mutation for the MindmapEdit entity.
+func newMindmapEditMutation(c config, op Op, opts ...mindmapeditOption) *MindmapEditMutation {
+	m := &MindmapEditMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlan,
+		typ:           TypeMindmapEdit,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6203,20 +6233,20 @@ func newPlanMutation(c config, op Op, opts ...planOption) *PlanMutation {
 	return m
 }
 
-// withPlanID sets the ID field of the mutation.
-func withPlanID(id string) planOption {
-	return func(m *PlanMutation) {
+// withMindmapEditID sets the ID field of the mutation.
+func withMindmapEditID(id int) mindmapeditOption {
+	return func(m *MindmapEditMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Plan
+			value *MindmapEdit
 		)
-		m.oldValue = func(ctx context.Context) (*Plan, error) {
+		m.oldValue = func(ctx context.Context) (*MindmapEdit, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Plan.Get(ctx, id)
+					value, err = m.Client().MindmapEdit.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6225,10 +6255,10 @@ func withPlanID(id string) planOption {
 	}
 }
 
-// withPlan sets the old Plan of the mutation.
-func withPlan(node *Plan) planOption {
-	return func(m *PlanMutation) {
-		m.oldValue = func(context.Context) (*Plan, error) {
+// withMindmapEdit sets the old MindmapEdit of the mutation.
+func withMindmapEdit(node *MindmapEdit) mindmapeditOption {
+	return func(m *MindmapEditMutation) {
+		m.oldValue = func(context.Context) (*MindmapEdit, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6237,7 +6267,7 @@ func withPlan(node *Plan) planOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlanMutation) Client() *Client {
+func (m MindmapEditMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6245,7 +6275,7 @@ func (m PlanMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PlanMutation) Tx() (*Tx, error) {
+func (m MindmapEditMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6254,15 +6284,9 @@ func (m PlanMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Plan entities.
-func (m *PlanMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PlanMutation) ID() (id string, exists bool) {
+func (m *MindmapEditMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6273,402 +6297,550 @@ func (m *PlanMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PlanMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *MindmapEditMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Plan.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MindmapEdit.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PlanMutation) SetName(s string) {
-	m.name = &s
+// SetOpField sets the "op" field.
+func (m *MindmapEditMutation) SetOpField(value mindmapedit.Op) {
+	m._op = &value
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PlanMutation) Name() (r string, exists bool) {
-	v := m.name
+// GetOp returns the value of the "op" field in the mutation.
+func (m *MindmapEditMutation) GetOp() (r mindmapedit.Op, exists bool) {
+	v := m._op
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Plan entity.
-// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// OldOp returns the old "op" field's value of the MindmapEdit entity.
+// If the MindmapEdit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlanMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *MindmapEditMutation) OldOp(ctx context.Context) (v mindmapedit.Op, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldOp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOp: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Op, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PlanMutation) ResetName() {
-	m.name = nil
+// ResetOp resets all changes to the "op" field.
+func (m *MindmapEditMutation) ResetOp() {
+	m._op = nil
 }
 
-// SetPriceCents sets the "price_cents" field.
-func (m *PlanMutation) SetPriceCents(i int) {
-	m.price_cents = &i
-	m.addprice_cents = nil
+// SetPayload sets the "payload" field.
+func (m *MindmapEditMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
 }
 
-// PriceCents returns the value of the "price_cents" field in the mutation.
-func (m *PlanMutation) PriceCents() (r int, exists bool) {
-	v := m.price_cents
+// Payload returns the value of the "payload" field in the mutation.
+func (m *MindmapEditMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriceCents returns the old "price_cents" field's value of the Plan entity.
-// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the MindmapEdit entity.
+// If the MindmapEdit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlanMutation) OldPriceCents(ctx context.Context) (v int, err error) {
+func (m *MindmapEditMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriceCents is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriceCents requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriceCents: %w", err)
-	}
-	return oldValue.PriceCents, nil
-}
-
-// AddPriceCents adds i to the "price_cents" field.
-func (m *PlanMutation) AddPriceCents(i int) {
-	if m.addprice_cents != nil {
-		*m.addprice_cents += i
-	} else {
-		m.addprice_cents = &i
-	}
-}
-
-// AddedPriceCents returns the value that was added to the "price_cents" field in this mutation.
-func (m *PlanMutation) AddedPriceCents() (r int, exists bool) {
-	v := m.addprice_cents
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return *v, true
+	return oldValue.Payload, nil
 }
 
-// ResetPriceCents resets all changes to the "price_cents" field.
-func (m *PlanMutation) ResetPriceCents() {
-	m.price_cents = nil
-	m.addprice_cents = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *MindmapEditMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetBillingPeriod sets the "billing_period" field.
-func (m *PlanMutation) SetBillingPeriod(s string) {
-	m.billing_period = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *MindmapEditMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// BillingPeriod returns the value of the "billing_period" field in the mutation.
-func (m *PlanMutation) BillingPeriod() (r string, exists bool) {
-	v := m.billing_period
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MindmapEditMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBillingPeriod returns the old "billing_period" field's value of the Plan entity.
-// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the MindmapEdit entity.
+// If the MindmapEdit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlanMutation) OldBillingPeriod(ctx context.Context) (v string, err error) {
+func (m *MindmapEditMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBillingPeriod is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBillingPeriod requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBillingPeriod: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.BillingPeriod, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetBillingPeriod resets all changes to the "billing_period" field.
-func (m *PlanMutation) ResetBillingPeriod() {
-	m.billing_period = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MindmapEditMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTokenLimit sets the "token_limit" field.
-func (m *PlanMutation) SetTokenLimit(i int) {
-	m.token_limit = &i
-	m.addtoken_limit = nil
+// SetMindmapID sets the "mindmap" edge to the MindmapGraph entity by id.
+func (m *MindmapEditMutation) SetMindmapID(id uuid.UUID) {
+	m.mindmap = &id
 }
 
-// TokenLimit returns the value of the "token_limit" field in the mutation.
-func (m *PlanMutation) TokenLimit() (r int, exists bool) {
-	v := m.token_limit
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearMindmap clears the "mindmap" edge to the MindmapGraph entity.
+func (m *MindmapEditMutation) ClearMindmap() {
+	m.clearedmindmap = true
 }
 
-// OldTokenLimit returns the old "token_limit" field's value of the Plan entity.
-// If the Plan object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlanMutation) OldTokenLimit(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenLimit is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenLimit requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenLimit: %w", err)
-	}
-	return oldValue.TokenLimit, nil
+// MindmapCleared reports if the "mindmap" edge to the MindmapGraph entity was cleared.
+func (m *MindmapEditMutation) MindmapCleared() bool {
+	return m.clearedmindmap
 }
 
-// AddTokenLimit adds i to the "token_limit" field.
-func (m *PlanMutation) AddTokenLimit(i int) {
-	if m.addtoken_limit != nil {
-		*m.addtoken_limit += i
-	} else {
-		m.addtoken_limit = &i
+// MindmapID returns the "mindmap" edge ID in the mutation.
+func (m *MindmapEditMutation) MindmapID() (id uuid.UUID, exists bool) {
+	if m.mindmap != nil {
+		return *m.mindmap, true
 	}
+	return
 }
 
-// AddedTokenLimit returns the value that was added to the "token_limit" field in this mutation.
-func (m *PlanMutation) AddedTokenLimit() (r int, exists bool) {
-	v := m.addtoken_limit
-	if v == nil {
-		return
+// MindmapIDs returns the "mindmap" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MindmapID instead. It exists only for internal usage by the builders.
+func (m *MindmapEditMutation) MindmapIDs() (ids []uuid.UUID) {
+	if id := m.mindmap; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
-}
-
-// ClearTokenLimit clears the value of the "token_limit" field.
-func (m *PlanMutation) ClearTokenLimit() {
-	m.token_limit = nil
-	m.addtoken_limit = nil
-	m.clearedFields[plan.FieldTokenLimit] = struct{}{}
+	return
 }
 
-// TokenLimitCleared returns if the "token_limit" field was cleared in this mutation.
-func (m *PlanMutation) TokenLimitCleared() bool {
-	_, ok := m.clearedFields[plan.FieldTokenLimit]
-	return ok
+// ResetMindmap resets all changes to the "mindmap" edge.
+func (m *MindmapEditMutation) ResetMindmap() {
+	m.mindmap = nil
+	m.clearedmindmap = false
 }
 
-// ResetTokenLimit resets all changes to the "token_limit" field.
-func (m *PlanMutation) ResetTokenLimit() {
-	m.token_limit = nil
-	m.addtoken_limit = nil
-	delete(m.clearedFields, plan.FieldTokenLimit)
+// Where appends a list predicates to the MindmapEditMutation builder.
+func (m *MindmapEditMutation) Where(ps ...predicate.MindmapEdit) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// SetSessionRetentionDays sets the "session_retention_days" field.
-func (m *PlanMutation) SetSessionRetentionDays(i int) {
-	m.session_retention_days = &i
-	m.addsession_retention_days = nil
+// WhereP appends storage-level predicates to the MindmapEditMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MindmapEditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MindmapEdit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SessionRetentionDays returns the value of the "session_retention_days" field in the mutation.
-func (m *PlanMutation) SessionRetentionDays() (r int, exists bool) {
-	v := m.session_retention_days
-	if v == nil {
-		return
-	}
-	return *v, true
+// Op returns the operation name.
+func (m *MindmapEditMutation) Op() Op {
+	return m.op
 }
 
-// OldSessionRetentionDays returns the old "session_retention_days" field's value of the Plan entity.
-// If the Plan object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlanMutation) OldSessionRetentionDays(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionRetentionDays is only allowed on UpdateOne operations")
+// SetOp allows setting the mutation operation.
+func (m *MindmapEditMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MindmapEdit).
+func (m *MindmapEditMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MindmapEditMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m._op != nil {
+		fields = append(fields, mindmapedit.FieldOp)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionRetentionDays requires an ID field in the mutation")
+	if m.payload != nil {
+		fields = append(fields, mindmapedit.FieldPayload)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionRetentionDays: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, mindmapedit.FieldCreatedAt)
 	}
-	return oldValue.SessionRetentionDays, nil
+	return fields
 }
 
-// AddSessionRetentionDays adds i to the "session_retention_days" field.
-func (m *PlanMutation) AddSessionRetentionDays(i int) {
-	if m.addsession_retention_days != nil {
-		*m.addsession_retention_days += i
-	} else {
-		m.addsession_retention_days = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MindmapEditMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mindmapedit.FieldOp:
+		return m.GetOp()
+	case mindmapedit.FieldPayload:
+		return m.Payload()
+	case mindmapedit.FieldCreatedAt:
+		return m.CreatedAt()
 	}
+	return nil, false
 }
 
-// AddedSessionRetentionDays returns the value that was added to the "session_retention_days" field in this mutation.
-func (m *PlanMutation) AddedSessionRetentionDays() (r int, exists bool) {
-	v := m.addsession_retention_days
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MindmapEditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mindmapedit.FieldOp:
+		return m.OldOp(ctx)
+	case mindmapedit.FieldPayload:
+		return m.OldPayload(ctx)
+	case mindmapedit.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown MindmapEdit field %s", name)
 }
 
-// ClearSessionRetentionDays clears the value of the "session_retention_days" field.
-func (m *PlanMutation) ClearSessionRetentionDays() {
-	m.session_retention_days = nil
-	m.addsession_retention_days = nil
-	m.clearedFields[plan.FieldSessionRetentionDays] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MindmapEditMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mindmapedit.FieldOp:
+		v, ok := value.(mindmapedit.Op)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpField(v)
+		return nil
+	case mindmapedit.FieldPayload:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case mindmapedit.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapEdit field %s", name)
 }
 
-// SessionRetentionDaysCleared returns if the "session_retention_days" field was cleared in this mutation.
-func (m *PlanMutation) SessionRetentionDaysCleared() bool {
-	_, ok := m.clearedFields[plan.FieldSessionRetentionDays]
-	return ok
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MindmapEditMutation) AddedFields() []string {
+	return nil
 }
 
-// ResetSessionRetentionDays resets all changes to the "session_retention_days" field.
-func (m *PlanMutation) ResetSessionRetentionDays() {
-	m.session_retention_days = nil
-	m.addsession_retention_days = nil
-	delete(m.clearedFields, plan.FieldSessionRetentionDays)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MindmapEditMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// SetMaxConcurrentSessions sets the "max_concurrent_sessions" field.
-func (m *PlanMutation) SetMaxConcurrentSessions(i int) {
-	m.max_concurrent_sessions = &i
-	m.addmax_concurrent_sessions = nil
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MindmapEditMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MindmapEdit numeric field %s", name)
 }
 
-// MaxConcurrentSessions returns the value of the "max_concurrent_sessions" field in the mutation.
-func (m *PlanMutation) MaxConcurrentSessions() (r int, exists bool) {
-	v := m.max_concurrent_sessions
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MindmapEditMutation) ClearedFields() []string {
+	return nil
 }
 
-// OldMaxConcurrentSessions returns the old "max_concurrent_sessions" field's value of the Plan entity.
-// If the Plan object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlanMutation) OldMaxConcurrentSessions(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxConcurrentSessions is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxConcurrentSessions requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxConcurrentSessions: %w", err)
-	}
-	return oldValue.MaxConcurrentSessions, nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MindmapEditMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AddMaxConcurrentSessions adds i to the "max_concurrent_sessions" field.
-func (m *PlanMutation) AddMaxConcurrentSessions(i int) {
-	if m.addmax_concurrent_sessions != nil {
-		*m.addmax_concurrent_sessions += i
-	} else {
-		m.addmax_concurrent_sessions = &i
-	}
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MindmapEditMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MindmapEdit nullable field %s", name)
 }
 
-// AddedMaxConcurrentSessions returns the value that was added to the "max_concurrent_sessions" field in this mutation.
-func (m *PlanMutation) AddedMaxConcurrentSessions() (r int, exists bool) {
-	v := m.addmax_concurrent_sessions
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MindmapEditMutation) ResetField(name string) error {
+	switch name {
+	case mindmapedit.FieldOp:
+		m.ResetOp()
+		return nil
+	case mindmapedit.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case mindmapedit.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown MindmapEdit field %s", name)
 }
 
-// ClearMaxConcurrentSessions clears the value of the "max_concurrent_sessions" field.
-func (m *PlanMutation) ClearMaxConcurrentSessions() {
-	m.max_concurrent_sessions = nil
-	m.addmax_concurrent_sessions = nil
-	m.clearedFields[plan.FieldMaxConcurrentSessions] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MindmapEditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.mindmap != nil {
+		edges = append(edges, mindmapedit.EdgeMindmap)
+	}
+	return edges
 }
 
-// MaxConcurrentSessionsCleared returns if the "max_concurrent_sessions" field was cleared in this mutation.
-func (m *PlanMutation) MaxConcurrentSessionsCleared() bool {
-	_, ok := m.clearedFields[plan.FieldMaxConcurrentSessions]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MindmapEditMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mindmapedit.EdgeMindmap:
+		if id := m.mindmap; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// ResetMaxConcurrentSessions resets all changes to the "max_concurrent_sessions" field.
-func (m *PlanMutation) ResetMaxConcurrentSessions() {
-	m.max_concurrent_sessions = nil
-	m.addmax_concurrent_sessions = nil
-	delete(m.clearedFields, plan.FieldMaxConcurrentSessions)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MindmapEditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetFeatures sets the "features" field.
-func (m *PlanMutation) SetFeatures(value map[string]bool) {
-	m.features = &value
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MindmapEditMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Features returns the value of the "features" field in the mutation.
-func (m *PlanMutation) Features() (r map[string]bool, exists bool) {
-	v := m.features
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MindmapEditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmindmap {
+		edges = append(edges, mindmapedit.EdgeMindmap)
 	}
-	return *v, true
+	return edges
 }
 
-// OldFeatures returns the old "features" field's value of the Plan entity.
-// If the Plan object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlanMutation) OldFeatures(ctx context.Context) (v map[string]bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFeatures is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFeatures requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFeatures: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MindmapEditMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mindmapedit.EdgeMindmap:
+		return m.clearedmindmap
 	}
-	return oldValue.Features, nil
+	return false
 }
 
-// ResetFeatures resets all changes to the "features" field.
-func (m *PlanMutation) ResetFeatures() {
-	m.features = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MindmapEditMutation) ClearEdge(name string) error {
+	switch name {
+	case mindmapedit.EdgeMindmap:
+		m.ClearMindmap()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapEdit unique edge %s", name)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PlanMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MindmapEditMutation) ResetEdge(name string) error {
+	switch name {
+	case mindmapedit.EdgeMindmap:
+		m.ResetMindmap()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapEdit edge %s", name)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PlanMutation) CreatedAt() (r time.Time, exists bool) {
+// MindmapGraphMutation represents an operation that mutates the MindmapGraph nodes in the graph.
+type MindmapGraphMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	status            *mindmapgraph.Status
+	nodes             *[]map[string]interface{}
+	appendnodes       []map[string]interface{}
+	graph_edges       *[]map[string]interface{}
+	appendgraph_edges []map[string]interface{}
+	layout            *map[string]interface{}
+	error_message     *string
+	generated_at      *time.Time
+	version           *int
+	addversion        *int
+	clearedFields     map[string]struct{}
+	session           *uuid.UUID
+	clearedsession    bool
+	revisions         map[uuid.UUID]struct{}
+	removedrevisions  map[uuid.UUID]struct{}
+	clearedrevisions  bool
+	edits             map[int]struct{}
+	removededits      map[int]struct{}
+	clearededits      bool
+	done              bool
+	oldValue          func(context.Context) (*MindmapGraph, error)
+	predicates        []predicate.MindmapGraph
+}
+
+var _ ent.Mutation = (*MindmapGraphMutation)(nil)
+
+// mindmapgraphOption allows management of the mutation configuration using functional options.
+type mindmapgraphOption func(*MindmapGraphMutation)
+
+// newMindmapGraphMutation creates new mutation for the MindmapGraph entity.
+func newMindmapGraphMutation(c config, op Op, opts ...mindmapgraphOption) *MindmapGraphMutation {
+	m := &MindmapGraphMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMindmapGraph,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMindmapGraphID sets the ID field of the mutation.
+func withMindmapGraphID(id uuid.UUID) mindmapgraphOption {
+	return func(m *MindmapGraphMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MindmapGraph
+		)
+		m.oldValue = func(ctx context.Context) (*MindmapGraph, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MindmapGraph.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMindmapGraph sets the old MindmapGraph of the mutation.
+func withMindmapGraph(node *MindmapGraph) mindmapgraphOption {
+	return func(m *MindmapGraphMutation) {
+		m.oldValue = func(context.Context) (*MindmapGraph, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MindmapGraphMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MindmapGraphMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MindmapGraph entities.
+func (m *MindmapGraphMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MindmapGraphMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MindmapGraphMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MindmapGraph.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MindmapGraphMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MindmapGraphMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6676,10 +6848,10 @@ func (m *PlanMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Plan entity.
-// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MindmapGraphMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6694,908 +6866,9375 @@ func (m *PlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PlanMutation) ResetCreatedAt() {
+func (m *MindmapGraphMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by ids.
-func (m *PlanMutation) AddSubscriptionIDs(ids ...uuid.UUID) {
-	if m.subscriptions == nil {
-		m.subscriptions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.subscriptions[ids[i]] = struct{}{}
-	}
-}
-
-// ClearSubscriptions clears the "subscriptions" edge to the Subscription entity.
-func (m *PlanMutation) ClearSubscriptions() {
-	m.clearedsubscriptions = true
-}
-
-// SubscriptionsCleared reports if the "subscriptions" edge to the Subscription entity was cleared.
-func (m *PlanMutation) SubscriptionsCleared() bool {
-	return m.clearedsubscriptions
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MindmapGraphMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveSubscriptionIDs removes the "subscriptions" edge to the Subscription entity by IDs.
-func (m *PlanMutation) RemoveSubscriptionIDs(ids ...uuid.UUID) {
-	if m.removedsubscriptions == nil {
-		m.removedsubscriptions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.subscriptions, ids[i])
-		m.removedsubscriptions[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MindmapGraphMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedSubscriptions returns the removed IDs of the "subscriptions" edge to the Subscription entity.
-func (m *PlanMutation) RemovedSubscriptionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsubscriptions {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapGraphMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// SubscriptionsIDs returns the "subscriptions" edge IDs in the mutation.
-func (m *PlanMutation) SubscriptionsIDs() (ids []uuid.UUID) {
-	for id := range m.subscriptions {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSubscriptions resets all changes to the "subscriptions" edge.
-func (m *PlanMutation) ResetSubscriptions() {
-	m.subscriptions = nil
-	m.clearedsubscriptions = false
-	m.removedsubscriptions = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MindmapGraphMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the PlanMutation builder.
-func (m *PlanMutation) Where(ps ...predicate.Plan) {
-	m.predicates = append(m.predicates, ps...)
+// SetStatus sets the "status" field.
+func (m *MindmapGraphMutation) SetStatus(value mindmapgraph.Status) {
+	m.status = &value
 }
 
-// WhereP appends storage-level predicates to the PlanMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PlanMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Plan, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Status returns the value of the "status" field in the mutation.
+func (m *MindmapGraphMutation) Status() (r mindmapgraph.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *PlanMutation) Op() Op {
-	return m.op
+// OldStatus returns the old "status" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapGraphMutation) OldStatus(ctx context.Context) (v mindmapgraph.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PlanMutation) SetOp(op Op) {
-	m.op = op
+// ResetStatus resets all changes to the "status" field.
+func (m *MindmapGraphMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Type returns the node type of this mutation (Plan).
-func (m *PlanMutation) Type() string {
-	return m.typ
+// SetNodes sets the "nodes" field.
+func (m *MindmapGraphMutation) SetNodes(value []map[string]interface{}) {
+	m.nodes = &value
+	m.appendnodes = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PlanMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, plan.FieldName)
-	}
-	if m.price_cents != nil {
-		fields = append(fields, plan.FieldPriceCents)
-	}
-	if m.billing_period != nil {
-		fields = append(fields, plan.FieldBillingPeriod)
-	}
-	if m.token_limit != nil {
-		fields = append(fields, plan.FieldTokenLimit)
-	}
-	if m.session_retention_days != nil {
-		fields = append(fields, plan.FieldSessionRetentionDays)
+// Nodes returns the value of the "nodes" field in the mutation.
+func (m *MindmapGraphMutation) Nodes() (r []map[string]interface{}, exists bool) {
+	v := m.nodes
+	if v == nil {
+		return
 	}
-	if m.max_concurrent_sessions != nil {
-		fields = append(fields, plan.FieldMaxConcurrentSessions)
+	return *v, true
+}
+
+// OldNodes returns the old "nodes" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapGraphMutation) OldNodes(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodes is only allowed on UpdateOne operations")
 	}
-	if m.features != nil {
-		fields = append(fields, plan.FieldFeatures)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodes requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, plan.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodes: %w", err)
 	}
-	return fields
+	return oldValue.Nodes, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PlanMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case plan.FieldName:
-		return m.Name()
-	case plan.FieldPriceCents:
-		return m.PriceCents()
-	case plan.FieldBillingPeriod:
-		return m.BillingPeriod()
-	case plan.FieldTokenLimit:
-		return m.TokenLimit()
-	case plan.FieldSessionRetentionDays:
-		return m.SessionRetentionDays()
-	case plan.FieldMaxConcurrentSessions:
-		return m.MaxConcurrentSessions()
-	case plan.FieldFeatures:
-		return m.Features()
-	case plan.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// AppendNodes adds value to the "nodes" field.
+func (m *MindmapGraphMutation) AppendNodes(value []map[string]interface{}) {
+	m.appendnodes = append(m.appendnodes, value...)
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case plan.FieldName:
-		return m.OldName(ctx)
-	case plan.FieldPriceCents:
-		return m.OldPriceCents(ctx)
-	case plan.FieldBillingPeriod:
-		return m.OldBillingPeriod(ctx)
-	case plan.FieldTokenLimit:
-		return m.OldTokenLimit(ctx)
-	case plan.FieldSessionRetentionDays:
-		return m.OldSessionRetentionDays(ctx)
-	case plan.FieldMaxConcurrentSessions:
-		return m.OldMaxConcurrentSessions(ctx)
-	case plan.FieldFeatures:
-		return m.OldFeatures(ctx)
-	case plan.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// AppendedNodes returns the list of values that were appended to the "nodes" field in this mutation.
+func (m *MindmapGraphMutation) AppendedNodes() ([]map[string]interface{}, bool) {
+	if len(m.appendnodes) == 0 {
+		return nil, false
 	}
-	return nil, fmt.Errorf("unknown Plan field %s", name)
+	return m.appendnodes, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PlanMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case plan.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case plan.FieldPriceCents:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriceCents(v)
-		return nil
-	case plan.FieldBillingPeriod:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBillingPeriod(v)
-		return nil
-	case plan.FieldTokenLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenLimit(v)
-		return nil
-	case plan.FieldSessionRetentionDays:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionRetentionDays(v)
-		return nil
-	case plan.FieldMaxConcurrentSessions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxConcurrentSessions(v)
-		return nil
-	case plan.FieldFeatures:
-		v, ok := value.(map[string]bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFeatures(v)
-		return nil
-	case plan.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Plan field %s", name)
+// ClearNodes clears the value of the "nodes" field.
+func (m *MindmapGraphMutation) ClearNodes() {
+	m.nodes = nil
+	m.appendnodes = nil
+	m.clearedFields[mindmapgraph.FieldNodes] = struct{}{}
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PlanMutation) AddedFields() []string {
-	var fields []string
-	if m.addprice_cents != nil {
-		fields = append(fields, plan.FieldPriceCents)
+// NodesCleared returns if the "nodes" field was cleared in this mutation.
+func (m *MindmapGraphMutation) NodesCleared() bool {
+	_, ok := m.clearedFields[mindmapgraph.FieldNodes]
+	return ok
+}
+
+// ResetNodes resets all changes to the "nodes" field.
+func (m *MindmapGraphMutation) ResetNodes() {
+	m.nodes = nil
+	m.appendnodes = nil
+	delete(m.clearedFields, mindmapgraph.FieldNodes)
+}
+
+// SetGraphEdges sets the "graph_edges" field.
+func (m *MindmapGraphMutation) SetGraphEdges(value []map[string]interface{}) {
+	m.graph_edges = &value
+	m.appendgraph_edges = nil
+}
+
+// GraphEdges returns the value of the "graph_edges" field in the mutation.
+func (m *MindmapGraphMutation) GraphEdges() (r []map[string]interface{}, exists bool) {
+	v := m.graph_edges
+	if v == nil {
+		return
 	}
-	if m.addtoken_limit != nil {
-		fields = append(fields, plan.FieldTokenLimit)
+	return *v, true
+}
+
+// OldGraphEdges returns the old "graph_edges" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapGraphMutation) OldGraphEdges(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGraphEdges is only allowed on UpdateOne operations")
 	}
-	if m.addsession_retention_days != nil {
-		fields = append(fields, plan.FieldSessionRetentionDays)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGraphEdges requires an ID field in the mutation")
 	}
-	if m.addmax_concurrent_sessions != nil {
-		fields = append(fields, plan.FieldMaxConcurrentSessions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGraphEdges: %w", err)
 	}
-	return fields
+	return oldValue.GraphEdges, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PlanMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case plan.FieldPriceCents:
-		return m.AddedPriceCents()
-	case plan.FieldTokenLimit:
-		return m.AddedTokenLimit()
-	case plan.FieldSessionRetentionDays:
-		return m.AddedSessionRetentionDays()
-	case plan.FieldMaxConcurrentSessions:
-		return m.AddedMaxConcurrentSessions()
+// AppendGraphEdges adds value to the "graph_edges" field.
+func (m *MindmapGraphMutation) AppendGraphEdges(value []map[string]interface{}) {
+	m.appendgraph_edges = append(m.appendgraph_edges, value...)
+}
+
+// AppendedGraphEdges returns the list of values that were appended to the "graph_edges" field in this mutation.
+func (m *MindmapGraphMutation) AppendedGraphEdges() ([]map[string]interface{}, bool) {
+	if len(m.appendgraph_edges) == 0 {
+		return nil, false
 	}
-	return nil, false
+	return m.appendgraph_edges, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PlanMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case plan.FieldPriceCents:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriceCents(v)
-		return nil
-	case plan.FieldTokenLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTokenLimit(v)
-		return nil
-	case plan.FieldSessionRetentionDays:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSessionRetentionDays(v)
-		return nil
-	case plan.FieldMaxConcurrentSessions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxConcurrentSessions(v)
-		return nil
+// ClearGraphEdges clears the value of the "graph_edges" field.
+func (m *MindmapGraphMutation) ClearGraphEdges() {
+	m.graph_edges = nil
+	m.appendgraph_edges = nil
+	m.clearedFields[mindmapgraph.FieldGraphEdges] = struct{}{}
+}
+
+// GraphEdgesCleared returns if the "graph_edges" field was cleared in this mutation.
+func (m *MindmapGraphMutation) GraphEdgesCleared() bool {
+	_, ok := m.clearedFields[mindmapgraph.FieldGraphEdges]
+	return ok
+}
+
+// ResetGraphEdges resets all changes to the "graph_edges" field.
+func (m *MindmapGraphMutation) ResetGraphEdges() {
+	m.graph_edges = nil
+	m.appendgraph_edges = nil
+	delete(m.clearedFields, mindmapgraph.FieldGraphEdges)
+}
+
+// SetLayout sets the "layout" field.
+func (m *MindmapGraphMutation) SetLayout(value map[string]interface{}) {
+	m.layout = &value
+}
+
+// Layout returns the value of the "layout" field in the mutation.
+func (m *MindmapGraphMutation) Layout() (r map[string]interface{}, exists bool) {
+	v := m.layout
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Plan numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PlanMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(plan.FieldTokenLimit) {
-		fields = append(fields, plan.FieldTokenLimit)
+// OldLayout returns the old "layout" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapGraphMutation) OldLayout(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLayout is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(plan.FieldSessionRetentionDays) {
-		fields = append(fields, plan.FieldSessionRetentionDays)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLayout requires an ID field in the mutation")
 	}
-	if m.FieldCleared(plan.FieldMaxConcurrentSessions) {
-		fields = append(fields, plan.FieldMaxConcurrentSessions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLayout: %w", err)
 	}
-	return fields
+	return oldValue.Layout, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PlanMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ClearLayout clears the value of the "layout" field.
+func (m *MindmapGraphMutation) ClearLayout() {
+	m.layout = nil
+	m.clearedFields[mindmapgraph.FieldLayout] = struct{}{}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PlanMutation) ClearField(name string) error {
-	switch name {
-	case plan.FieldTokenLimit:
-		m.ClearTokenLimit()
-		return nil
-	case plan.FieldSessionRetentionDays:
-		m.ClearSessionRetentionDays()
-		return nil
-	case plan.FieldMaxConcurrentSessions:
-		m.ClearMaxConcurrentSessions()
-		return nil
-	}
-	return fmt.Errorf("unknown Plan nullable field %s", name)
+// LayoutCleared returns if the "layout" field was cleared in this mutation.
+func (m *MindmapGraphMutation) LayoutCleared() bool {
+	_, ok := m.clearedFields[mindmapgraph.FieldLayout]
+	return ok
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PlanMutation) ResetField(name string) error {
-	switch name {
-	case plan.FieldName:
-		m.ResetName()
-		return nil
-	case plan.FieldPriceCents:
-		m.ResetPriceCents()
-		return nil
-	case plan.FieldBillingPeriod:
-		m.ResetBillingPeriod()
-		return nil
-	case plan.FieldTokenLimit:
-		m.ResetTokenLimit()
-		return nil
-	case plan.FieldSessionRetentionDays:
-		m.ResetSessionRetentionDays()
-		return nil
-	case plan.FieldMaxConcurrentSessions:
-		m.ResetMaxConcurrentSessions()
-		return nil
-	case plan.FieldFeatures:
-		m.ResetFeatures()
-		return nil
-	case plan.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Plan field %s", name)
+// ResetLayout resets all changes to the "layout" field.
+func (m *MindmapGraphMutation) ResetLayout() {
+	m.layout = nil
+	delete(m.clearedFields, mindmapgraph.FieldLayout)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlanMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.subscriptions != nil {
-		edges = append(edges, plan.EdgeSubscriptions)
-	}
-	return edges
+// SetErrorMessage sets the "error_message" field.
+func (m *MindmapGraphMutation) SetErrorMessage(s string) {
+	m.error_message = &s
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PlanMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case plan.EdgeSubscriptions:
-		ids := make([]ent.Value, 0, len(m.subscriptions))
-		for id := range m.subscriptions {
-			ids = append(ids, id)
-		}
-		return ids
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *MindmapGraphMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlanMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedsubscriptions != nil {
-		edges = append(edges, plan.EdgeSubscriptions)
+// OldErrorMessage returns the old "error_message" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapGraphMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PlanMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case plan.EdgeSubscriptions:
-		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
-		for id := range m.removedsubscriptions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *MindmapGraphMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[mindmapgraph.FieldErrorMessage] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlanMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsubscriptions {
-		edges = append(edges, plan.EdgeSubscriptions)
-	}
-	return edges
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *MindmapGraphMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[mindmapgraph.FieldErrorMessage]
+	return ok
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PlanMutation) EdgeCleared(name string) bool {
-	switch name {
-	case plan.EdgeSubscriptions:
-		return m.clearedsubscriptions
-	}
-	return false
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *MindmapGraphMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, mindmapgraph.FieldErrorMessage)
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PlanMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Plan unique edge %s", name)
+// SetGeneratedAt sets the "generated_at" field.
+func (m *MindmapGraphMutation) SetGeneratedAt(t time.Time) {
+	m.generated_at = &t
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PlanMutation) ResetEdge(name string) error {
-	switch name {
-	case plan.EdgeSubscriptions:
-		m.ResetSubscriptions()
-		return nil
+// GeneratedAt returns the value of the "generated_at" field in the mutation.
+func (m *MindmapGraphMutation) GeneratedAt() (r time.Time, exists bool) {
+	v := m.generated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Plan edge %s", name)
+	return *v, true
 }
 
-// RawEventMutation represents an operation that mutates the RawEvent nodes in the graph.
-type RawEventMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	created_at     *time.Time
-	updated_at     *time.Time
-	event_type     *string
-	timestamp      *time.Time
-	payload        *string
-	processed      *bool
-	processed_at   *time.Time
-	clearedFields  map[string]struct{}
-	session        *uuid.UUID
-	clearedsession bool
-	done           bool
-	oldValue       func(context.Context) (*RawEvent, error)
-	predicates     []predicate.RawEvent
+// OldGeneratedAt returns the old "generated_at" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapGraphMutation) OldGeneratedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeneratedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeneratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeneratedAt: %w", err)
+	}
+	return oldValue.GeneratedAt, nil
 }
 
-var _ ent.Mutation = (*RawEventMutation)(nil)
+// ResetGeneratedAt resets all changes to the "generated_at" field.
+func (m *MindmapGraphMutation) ResetGeneratedAt() {
+	m.generated_at = nil
+}
 
-// raweventOption allows management of the mutation configuration using functional options.
-type raweventOption func(*RawEventMutation)
+// SetVersion sets the "version" field.
+func (m *MindmapGraphMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
 
-// newRawEventMutation creates new mutation for the RawEvent entity.
-func newRawEventMutation(c config, op Op, opts ...raweventOption) *RawEventMutation {
-	m := &RawEventMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeRawEvent,
-		clearedFields: make(map[string]struct{}),
+// Version returns the value of the "version" field in the mutation.
+func (m *MindmapGraphMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the MindmapGraph entity.
+// If the MindmapGraph object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapGraphMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
 }
 
-// withRawEventID sets the ID field of the mutation.
-func withRawEventID(id uuid.UUID) raweventOption {
-	return func(m *RawEventMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *RawEvent
-		)
-		m.oldValue = func(ctx context.Context) (*RawEvent, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().RawEvent.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddVersion adds i to the "version" field.
+func (m *MindmapGraphMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
 	}
 }
 
-// withRawEvent sets the old RawEvent of the mutation.
-func withRawEvent(node *RawEvent) raweventOption {
-	return func(m *RawEventMutation) {
-		m.oldValue = func(context.Context) (*RawEvent, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *MindmapGraphMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RawEventMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetVersion resets all changes to the "version" field.
+func (m *MindmapGraphMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RawEventMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetSessionID sets the "session" edge to the Session entity by id.
+func (m *MindmapGraphMutation) SetSessionID(id uuid.UUID) {
+	m.session = &id
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RawEvent entities.
-func (m *RawEventMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearSession clears the "session" edge to the Session entity.
+func (m *MindmapGraphMutation) ClearSession() {
+	m.clearedsession = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RawEventMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// SessionCleared reports if the "session" edge to the Session entity was cleared.
+func (m *MindmapGraphMutation) SessionCleared() bool {
+	return m.clearedsession
+}
+
+// SessionID returns the "session" edge ID in the mutation.
+func (m *MindmapGraphMutation) SessionID() (id uuid.UUID, exists bool) {
+	if m.session != nil {
+		return *m.session, true
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RawEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RawEvent.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *MindmapGraphMutation) SessionIDs() (ids []uuid.UUID) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RawEventMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetSession resets all changes to the "session" edge.
+func (m *MindmapGraphMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RawEventMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// AddRevisionIDs adds the "revisions" edge to the MindmapRevision entity by ids.
+func (m *MindmapGraphMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RawEvent entity.
-// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RawEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ClearRevisions clears the "revisions" edge to the MindmapRevision entity.
+func (m *MindmapGraphMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the MindmapRevision entity was cleared.
+func (m *MindmapGraphMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the MindmapRevision entity by IDs.
+func (m *MindmapGraphMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the MindmapRevision entity.
+func (m *MindmapGraphMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RawEventMutation) ResetCreatedAt() {
-	m.created_at = nil
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *MindmapGraphMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RawEventMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *MindmapGraphMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RawEventMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// AddEditIDs adds the "edits" edge to the MindmapEdit entity by ids.
+func (m *MindmapGraphMutation) AddEditIDs(ids ...int) {
+	if m.edits == nil {
+		m.edits = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.edits[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the RawEvent entity.
-// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RawEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// ClearEdits clears the "edits" edge to the MindmapEdit entity.
+func (m *MindmapGraphMutation) ClearEdits() {
+	m.clearededits = true
+}
+
+// EditsCleared reports if the "edits" edge to the MindmapEdit entity was cleared.
+func (m *MindmapGraphMutation) EditsCleared() bool {
+	return m.clearededits
+}
+
+// RemoveEditIDs removes the "edits" edge to the MindmapEdit entity by IDs.
+func (m *MindmapGraphMutation) RemoveEditIDs(ids ...int) {
+	if m.removededits == nil {
+		m.removededits = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.edits, ids[i])
+		m.removededits[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+}
+
+// RemovedEdits returns the removed IDs of the "edits" edge to the MindmapEdit entity.
+func (m *MindmapGraphMutation) RemovedEditsIDs() (ids []int) {
+	for id := range m.removededits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EditsIDs returns the "edits" edge IDs in the mutation.
+func (m *MindmapGraphMutation) EditsIDs() (ids []int) {
+	for id := range m.edits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEdits resets all changes to the "edits" edge.
+func (m *MindmapGraphMutation) ResetEdits() {
+	m.edits = nil
+	m.clearededits = false
+	m.removededits = nil
+}
+
+// Where appends a list predicates to the MindmapGraphMutation builder.
+func (m *MindmapGraphMutation) Where(ps ...predicate.MindmapGraph) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MindmapGraphMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MindmapGraphMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MindmapGraph, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MindmapGraphMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MindmapGraphMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MindmapGraph).
+func (m *MindmapGraphMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MindmapGraphMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, mindmapgraph.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, mindmapgraph.FieldUpdatedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, mindmapgraph.FieldStatus)
+	}
+	if m.nodes != nil {
+		fields = append(fields, mindmapgraph.FieldNodes)
+	}
+	if m.graph_edges != nil {
+		fields = append(fields, mindmapgraph.FieldGraphEdges)
+	}
+	if m.layout != nil {
+		fields = append(fields, mindmapgraph.FieldLayout)
+	}
+	if m.error_message != nil {
+		fields = append(fields, mindmapgraph.FieldErrorMessage)
+	}
+	if m.generated_at != nil {
+		fields = append(fields, mindmapgraph.FieldGeneratedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, mindmapgraph.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MindmapGraphMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mindmapgraph.FieldCreatedAt:
+		return m.CreatedAt()
+	case mindmapgraph.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case mindmapgraph.FieldStatus:
+		return m.Status()
+	case mindmapgraph.FieldNodes:
+		return m.Nodes()
+	case mindmapgraph.FieldGraphEdges:
+		return m.GraphEdges()
+	case mindmapgraph.FieldLayout:
+		return m.Layout()
+	case mindmapgraph.FieldErrorMessage:
+		return m.ErrorMessage()
+	case mindmapgraph.FieldGeneratedAt:
+		return m.GeneratedAt()
+	case mindmapgraph.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MindmapGraphMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mindmapgraph.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case mindmapgraph.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case mindmapgraph.FieldStatus:
+		return m.OldStatus(ctx)
+	case mindmapgraph.FieldNodes:
+		return m.OldNodes(ctx)
+	case mindmapgraph.FieldGraphEdges:
+		return m.OldGraphEdges(ctx)
+	case mindmapgraph.FieldLayout:
+		return m.OldLayout(ctx)
+	case mindmapgraph.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case mindmapgraph.FieldGeneratedAt:
+		return m.OldGeneratedAt(ctx)
+	case mindmapgraph.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown MindmapGraph field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MindmapGraphMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mindmapgraph.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case mindmapgraph.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case mindmapgraph.FieldStatus:
+		v, ok := value.(mindmapgraph.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case mindmapgraph.FieldNodes:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodes(v)
+		return nil
+	case mindmapgraph.FieldGraphEdges:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGraphEdges(v)
+		return nil
+	case mindmapgraph.FieldLayout:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLayout(v)
+		return nil
+	case mindmapgraph.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case mindmapgraph.FieldGeneratedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeneratedAt(v)
+		return nil
+	case mindmapgraph.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapGraph field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MindmapGraphMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, mindmapgraph.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MindmapGraphMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mindmapgraph.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MindmapGraphMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mindmapgraph.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapGraph numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MindmapGraphMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(mindmapgraph.FieldNodes) {
+		fields = append(fields, mindmapgraph.FieldNodes)
+	}
+	if m.FieldCleared(mindmapgraph.FieldGraphEdges) {
+		fields = append(fields, mindmapgraph.FieldGraphEdges)
+	}
+	if m.FieldCleared(mindmapgraph.FieldLayout) {
+		fields = append(fields, mindmapgraph.FieldLayout)
+	}
+	if m.FieldCleared(mindmapgraph.FieldErrorMessage) {
+		fields = append(fields, mindmapgraph.FieldErrorMessage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MindmapGraphMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MindmapGraphMutation) ClearField(name string) error {
+	switch name {
+	case mindmapgraph.FieldNodes:
+		m.ClearNodes()
+		return nil
+	case mindmapgraph.FieldGraphEdges:
+		m.ClearGraphEdges()
+		return nil
+	case mindmapgraph.FieldLayout:
+		m.ClearLayout()
+		return nil
+	case mindmapgraph.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapGraph nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MindmapGraphMutation) ResetField(name string) error {
+	switch name {
+	case mindmapgraph.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case mindmapgraph.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case mindmapgraph.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case mindmapgraph.FieldNodes:
+		m.ResetNodes()
+		return nil
+	case mindmapgraph.FieldGraphEdges:
+		m.ResetGraphEdges()
+		return nil
+	case mindmapgraph.FieldLayout:
+		m.ResetLayout()
+		return nil
+	case mindmapgraph.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case mindmapgraph.FieldGeneratedAt:
+		m.ResetGeneratedAt()
+		return nil
+	case mindmapgraph.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapGraph field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MindmapGraphMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.session != nil {
+		edges = append(edges, mindmapgraph.EdgeSession)
+	}
+	if m.revisions != nil {
+		edges = append(edges, mindmapgraph.EdgeRevisions)
+	}
+	if m.edits != nil {
+		edges = append(edges, mindmapgraph.EdgeEdits)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MindmapGraphMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mindmapgraph.EdgeSession:
+		if id := m.session; id != nil {
+			return []ent.Value{*id}
+		}
+	case mindmapgraph.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case mindmapgraph.EdgeEdits:
+		ids := make([]ent.Value, 0, len(m.edits))
+		for id := range m.edits {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MindmapGraphMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedrevisions != nil {
+		edges = append(edges, mindmapgraph.EdgeRevisions)
+	}
+	if m.removededits != nil {
+		edges = append(edges, mindmapgraph.EdgeEdits)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MindmapGraphMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case mindmapgraph.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case mindmapgraph.EdgeEdits:
+		ids := make([]ent.Value, 0, len(m.removededits))
+		for id := range m.removededits {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MindmapGraphMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedsession {
+		edges = append(edges, mindmapgraph.EdgeSession)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, mindmapgraph.EdgeRevisions)
+	}
+	if m.clearededits {
+		edges = append(edges, mindmapgraph.EdgeEdits)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MindmapGraphMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mindmapgraph.EdgeSession:
+		return m.clearedsession
+	case mindmapgraph.EdgeRevisions:
+		return m.clearedrevisions
+	case mindmapgraph.EdgeEdits:
+		return m.clearededits
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MindmapGraphMutation) ClearEdge(name string) error {
+	switch name {
+	case mindmapgraph.EdgeSession:
+		m.ClearSession()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapGraph unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MindmapGraphMutation) ResetEdge(name string) error {
+	switch name {
+	case mindmapgraph.EdgeSession:
+		m.ResetSession()
+		return nil
+	case mindmapgraph.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case mindmapgraph.EdgeEdits:
+		m.ResetEdits()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapGraph edge %s", name)
+}
+
+// MindmapRevisionMutation represents an operation that mutates the MindmapRevision nodes in the graph.
+type MindmapRevisionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	version           *int
+	addversion        *int
+	nodes             *[]map[string]interface{}
+	appendnodes       []map[string]interface{}
+	graph_edges       *[]map[string]interface{}
+	appendgraph_edges []map[string]interface{}
+	layout            *map[string]interface{}
+	restored_from     *int
+	addrestored_from  *int
+	generated_at      *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	mindmap           *uuid.UUID
+	clearedmindmap    bool
+	done              bool
+	oldValue          func(context.Context) (*MindmapRevision, error)
+	predicates        []predicate.MindmapRevision
+}
+
+var _ ent.Mutation = (*MindmapRevisionMutation)(nil)
+
+// mindmaprevisionOption allows management of the mutation configuration using functional options.
+type mindmaprevisionOption func(*MindmapRevisionMutation)
+
+// newMindmapRevisionMutation creates new mutation for the MindmapRevision entity.
+func newMindmapRevisionMutation(c config, op Op, opts ...mindmaprevisionOption) *MindmapRevisionMutation {
+	m := &MindmapRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMindmapRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMindmapRevisionID sets the ID field of the mutation.
+func withMindmapRevisionID(id uuid.UUID) mindmaprevisionOption {
+	return func(m *MindmapRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MindmapRevision
+		)
+		m.oldValue = func(ctx context.Context) (*MindmapRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MindmapRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMindmapRevision sets the old MindmapRevision of the mutation.
+func withMindmapRevision(node *MindmapRevision) mindmaprevisionOption {
+	return func(m *MindmapRevisionMutation) {
+		m.oldValue = func(context.Context) (*MindmapRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MindmapRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MindmapRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MindmapRevision entities.
+func (m *MindmapRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MindmapRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MindmapRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MindmapRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVersion sets the "version" field.
+func (m *MindmapRevisionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *MindmapRevisionMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the MindmapRevision entity.
+// If the MindmapRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapRevisionMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *MindmapRevisionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *MindmapRevisionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *MindmapRevisionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetNodes sets the "nodes" field.
+func (m *MindmapRevisionMutation) SetNodes(value []map[string]interface{}) {
+	m.nodes = &value
+	m.appendnodes = nil
+}
+
+// Nodes returns the value of the "nodes" field in the mutation.
+func (m *MindmapRevisionMutation) Nodes() (r []map[string]interface{}, exists bool) {
+	v := m.nodes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodes returns the old "nodes" field's value of the MindmapRevision entity.
+// If the MindmapRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapRevisionMutation) OldNodes(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodes: %w", err)
+	}
+	return oldValue.Nodes, nil
+}
+
+// AppendNodes adds value to the "nodes" field.
+func (m *MindmapRevisionMutation) AppendNodes(value []map[string]interface{}) {
+	m.appendnodes = append(m.appendnodes, value...)
+}
+
+// AppendedNodes returns the list of values that were appended to the "nodes" field in this mutation.
+func (m *MindmapRevisionMutation) AppendedNodes() ([]map[string]interface{}, bool) {
+	if len(m.appendnodes) == 0 {
+		return nil, false
+	}
+	return m.appendnodes, true
+}
+
+// ClearNodes clears the value of the "nodes" field.
+func (m *MindmapRevisionMutation) ClearNodes() {
+	m.nodes = nil
+	m.appendnodes = nil
+	m.clearedFields[mindmaprevision.FieldNodes] = struct{}{}
+}
+
+// NodesCleared returns if the "nodes" field was cleared in this mutation.
+func (m *MindmapRevisionMutation) NodesCleared() bool {
+	_, ok := m.clearedFields[mindmaprevision.FieldNodes]
+	return ok
+}
+
+// ResetNodes resets all changes to the "nodes" field.
+func (m *MindmapRevisionMutation) ResetNodes() {
+	m.nodes = nil
+	m.appendnodes = nil
+	delete(m.clearedFields, mindmaprevision.FieldNodes)
+}
+
+// SetGraphEdges sets the "graph_edges" field.
+func (m *MindmapRevisionMutation) SetGraphEdges(value []map[string]interface{}) {
+	m.graph_edges = &value
+	m.appendgraph_edges = nil
+}
+
+// GraphEdges returns the value of the "graph_edges" field in the mutation.
+func (m *MindmapRevisionMutation) GraphEdges() (r []map[string]interface{}, exists bool) {
+	v := m.graph_edges
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGraphEdges returns the old "graph_edges" field's value of the MindmapRevision entity.
+// If the MindmapRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapRevisionMutation) OldGraphEdges(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGraphEdges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGraphEdges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGraphEdges: %w", err)
+	}
+	return oldValue.GraphEdges, nil
+}
+
+// AppendGraphEdges adds value to the "graph_edges" field.
+func (m *MindmapRevisionMutation) AppendGraphEdges(value []map[string]interface{}) {
+	m.appendgraph_edges = append(m.appendgraph_edges, value...)
+}
+
+// AppendedGraphEdges returns the list of values that were appended to the "graph_edges" field in this mutation.
+func (m *MindmapRevisionMutation) AppendedGraphEdges() ([]map[string]interface{}, bool) {
+	if len(m.appendgraph_edges) == 0 {
+		return nil, false
+	}
+	return m.appendgraph_edges, true
+}
+
+// ClearGraphEdges clears the value of the "graph_edges" field.
+func (m *MindmapRevisionMutation) ClearGraphEdges() {
+	m.graph_edges = nil
+	m.appendgraph_edges = nil
+	m.clearedFields[mindmaprevision.FieldGraphEdges] = struct{}{}
+}
+
+// GraphEdgesCleared returns if the "graph_edges" field was cleared in this mutation.
+func (m *MindmapRevisionMutation) GraphEdgesCleared() bool {
+	_, ok := m.clearedFields[mindmaprevision.FieldGraphEdges]
+	return ok
+}
+
+// ResetGraphEdges resets all changes to the "graph_edges" field.
+func (m *MindmapRevisionMutation) ResetGraphEdges() {
+	m.graph_edges = nil
+	m.appendgraph_edges = nil
+	delete(m.clearedFields, mindmaprevision.FieldGraphEdges)
+}
+
+// SetLayout sets the "layout" field.
+func (m *MindmapRevisionMutation) SetLayout(value map[string]interface{}) {
+	m.layout = &value
+}
+
+// Layout returns the value of the "layout" field in the mutation.
+func (m *MindmapRevisionMutation) Layout() (r map[string]interface{}, exists bool) {
+	v := m.layout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLayout returns the old "layout" field's value of the MindmapRevision entity.
+// If the MindmapRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapRevisionMutation) OldLayout(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLayout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLayout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLayout: %w", err)
+	}
+	return oldValue.Layout, nil
+}
+
+// ClearLayout clears the value of the "layout" field.
+func (m *MindmapRevisionMutation) ClearLayout() {
+	m.layout = nil
+	m.clearedFields[mindmaprevision.FieldLayout] = struct{}{}
+}
+
+// LayoutCleared returns if the "layout" field was cleared in this mutation.
+func (m *MindmapRevisionMutation) LayoutCleared() bool {
+	_, ok := m.clearedFields[mindmaprevision.FieldLayout]
+	return ok
+}
+
+// ResetLayout resets all changes to the "layout" field.
+func (m *MindmapRevisionMutation) ResetLayout() {
+	m.layout = nil
+	delete(m.clearedFields, mindmaprevision.FieldLayout)
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (m *MindmapRevisionMutation) SetRestoredFrom(i int) {
+	m.restored_from = &i
+	m.addrestored_from = nil
+}
+
+// RestoredFrom returns the value of the "restored_from" field in the mutation.
+func (m *MindmapRevisionMutation) RestoredFrom() (r int, exists bool) {
+	v := m.restored_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestoredFrom returns the old "restored_from" field's value of the MindmapRevision entity.
+// If the MindmapRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapRevisionMutation) OldRestoredFrom(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestoredFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestoredFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestoredFrom: %w", err)
+	}
+	return oldValue.RestoredFrom, nil
+}
+
+// AddRestoredFrom adds i to the "restored_from" field.
+func (m *MindmapRevisionMutation) AddRestoredFrom(i int) {
+	if m.addrestored_from != nil {
+		*m.addrestored_from += i
+	} else {
+		m.addrestored_from = &i
+	}
+}
+
+// AddedRestoredFrom returns the value that was added to the "restored_from" field in this mutation.
+func (m *MindmapRevisionMutation) AddedRestoredFrom() (r int, exists bool) {
+	v := m.addrestored_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRestoredFrom clears the value of the "restored_from" field.
+func (m *MindmapRevisionMutation) ClearRestoredFrom() {
+	m.restored_from = nil
+	m.addrestored_from = nil
+	m.clearedFields[mindmaprevision.FieldRestoredFrom] = struct{}{}
+}
+
+// RestoredFromCleared returns if the "restored_from" field was cleared in this mutation.
+func (m *MindmapRevisionMutation) RestoredFromCleared() bool {
+	_, ok := m.clearedFields[mindmaprevision.FieldRestoredFrom]
+	return ok
+}
+
+// ResetRestoredFrom resets all changes to the "restored_from" field.
+func (m *MindmapRevisionMutation) ResetRestoredFrom() {
+	m.restored_from = nil
+	m.addrestored_from = nil
+	delete(m.clearedFields, mindmaprevision.FieldRestoredFrom)
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (m *MindmapRevisionMutation) SetGeneratedAt(t time.Time) {
+	m.generated_at = &t
+}
+
+// GeneratedAt returns the value of the "generated_at" field in the mutation.
+func (m *MindmapRevisionMutation) GeneratedAt() (r time.Time, exists bool) {
+	v := m.generated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeneratedAt returns the old "generated_at" field's value of the MindmapRevision entity.
+// If the MindmapRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapRevisionMutation) OldGeneratedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeneratedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeneratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeneratedAt: %w", err)
+	}
+	return oldValue.GeneratedAt, nil
+}
+
+// ResetGeneratedAt resets all changes to the "generated_at" field.
+func (m *MindmapRevisionMutation) ResetGeneratedAt() {
+	m.generated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MindmapRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MindmapRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MindmapRevision entity.
+// If the MindmapRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MindmapRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetMindmapID sets the "mindmap" edge to the MindmapGraph entity by id.
+func (m *MindmapRevisionMutation) SetMindmapID(id uuid.UUID) {
+	m.mindmap = &id
+}
+
+// ClearMindmap clears the "mindmap" edge to the MindmapGraph entity.
+func (m *MindmapRevisionMutation) ClearMindmap() {
+	m.clearedmindmap = true
+}
+
+// MindmapCleared reports if the "mindmap" edge to the MindmapGraph entity was cleared.
+func (m *MindmapRevisionMutation) MindmapCleared() bool {
+	return m.clearedmindmap
+}
+
+// MindmapID returns the "mindmap" edge ID in the mutation.
+func (m *MindmapRevisionMutation) MindmapID() (id uuid.UUID, exists bool) {
+	if m.mindmap != nil {
+		return *m.mindmap, true
+	}
+	return
+}
+
+// MindmapIDs returns the "mindmap" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MindmapID instead. It exists only for internal usage by the builders.
+func (m *MindmapRevisionMutation) MindmapIDs() (ids []uuid.UUID) {
+	if id := m.mindmap; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMindmap resets all changes to the "mindmap" edge.
+func (m *MindmapRevisionMutation) ResetMindmap() {
+	m.mindmap = nil
+	m.clearedmindmap = false
+}
+
+// Where appends a list predicates to the MindmapRevisionMutation builder.
+func (m *MindmapRevisionMutation) Where(ps ...predicate.MindmapRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MindmapRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MindmapRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MindmapRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MindmapRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MindmapRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MindmapRevision).
+func (m *MindmapRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MindmapRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.version != nil {
+		fields = append(fields, mindmaprevision.FieldVersion)
+	}
+	if m.nodes != nil {
+		fields = append(fields, mindmaprevision.FieldNodes)
+	}
+	if m.graph_edges != nil {
+		fields = append(fields, mindmaprevision.FieldGraphEdges)
+	}
+	if m.layout != nil {
+		fields = append(fields, mindmaprevision.FieldLayout)
+	}
+	if m.restored_from != nil {
+		fields = append(fields, mindmaprevision.FieldRestoredFrom)
+	}
+	if m.generated_at != nil {
+		fields = append(fields, mindmaprevision.FieldGeneratedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mindmaprevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MindmapRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mindmaprevision.FieldVersion:
+		return m.Version()
+	case mindmaprevision.FieldNodes:
+		return m.Nodes()
+	case mindmaprevision.FieldGraphEdges:
+		return m.GraphEdges()
+	case mindmaprevision.FieldLayout:
+		return m.Layout()
+	case mindmaprevision.FieldRestoredFrom:
+		return m.RestoredFrom()
+	case mindmaprevision.FieldGeneratedAt:
+		return m.GeneratedAt()
+	case mindmaprevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MindmapRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mindmaprevision.FieldVersion:
+		return m.OldVersion(ctx)
+	case mindmaprevision.FieldNodes:
+		return m.OldNodes(ctx)
+	case mindmaprevision.FieldGraphEdges:
+		return m.OldGraphEdges(ctx)
+	case mindmaprevision.FieldLayout:
+		return m.OldLayout(ctx)
+	case mindmaprevision.FieldRestoredFrom:
+		return m.OldRestoredFrom(ctx)
+	case mindmaprevision.FieldGeneratedAt:
+		return m.OldGeneratedAt(ctx)
+	case mindmaprevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MindmapRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MindmapRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mindmaprevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case mindmaprevision.FieldNodes:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodes(v)
+		return nil
+	case mindmaprevision.FieldGraphEdges:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGraphEdges(v)
+		return nil
+	case mindmaprevision.FieldLayout:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLayout(v)
+		return nil
+	case mindmaprevision.FieldRestoredFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestoredFrom(v)
+		return nil
+	case mindmaprevision.FieldGeneratedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeneratedAt(v)
+		return nil
+	case mindmaprevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MindmapRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, mindmaprevision.FieldVersion)
+	}
+	if m.addrestored_from != nil {
+		fields = append(fields, mindmaprevision.FieldRestoredFrom)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MindmapRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mindmaprevision.FieldVersion:
+		return m.AddedVersion()
+	case mindmaprevision.FieldRestoredFrom:
+		return m.AddedRestoredFrom()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MindmapRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mindmaprevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case mindmaprevision.FieldRestoredFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRestoredFrom(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MindmapRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(mindmaprevision.FieldNodes) {
+		fields = append(fields, mindmaprevision.FieldNodes)
+	}
+	if m.FieldCleared(mindmaprevision.FieldGraphEdges) {
+		fields = append(fields, mindmaprevision.FieldGraphEdges)
+	}
+	if m.FieldCleared(mindmaprevision.FieldLayout) {
+		fields = append(fields, mindmaprevision.FieldLayout)
+	}
+	if m.FieldCleared(mindmaprevision.FieldRestoredFrom) {
+		fields = append(fields, mindmaprevision.FieldRestoredFrom)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MindmapRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MindmapRevisionMutation) ClearField(name string) error {
+	switch name {
+	case mindmaprevision.FieldNodes:
+		m.ClearNodes()
+		return nil
+	case mindmaprevision.FieldGraphEdges:
+		m.ClearGraphEdges()
+		return nil
+	case mindmaprevision.FieldLayout:
+		m.ClearLayout()
+		return nil
+	case mindmaprevision.FieldRestoredFrom:
+		m.ClearRestoredFrom()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MindmapRevisionMutation) ResetField(name string) error {
+	switch name {
+	case mindmaprevision.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case mindmaprevision.FieldNodes:
+		m.ResetNodes()
+		return nil
+	case mindmaprevision.FieldGraphEdges:
+		m.ResetGraphEdges()
+		return nil
+	case mindmaprevision.FieldLayout:
+		m.ResetLayout()
+		return nil
+	case mindmaprevision.FieldRestoredFrom:
+		m.ResetRestoredFrom()
+		return nil
+	case mindmaprevision.FieldGeneratedAt:
+		m.ResetGeneratedAt()
+		return nil
+	case mindmaprevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MindmapRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.mindmap != nil {
+		edges = append(edges, mindmaprevision.EdgeMindmap)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MindmapRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mindmaprevision.EdgeMindmap:
+		if id := m.mindmap; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MindmapRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MindmapRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MindmapRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmindmap {
+		edges = append(edges, mindmaprevision.EdgeMindmap)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MindmapRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mindmaprevision.EdgeMindmap:
+		return m.clearedmindmap
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MindmapRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case mindmaprevision.EdgeMindmap:
+		m.ClearMindmap()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MindmapRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case mindmaprevision.EdgeMindmap:
+		m.ResetMindmap()
+		return nil
+	}
+	return fmt.Errorf("unknown MindmapRevision edge %s", name)
+}
+
+// ModelPricingMutation represents an operation that mutates the ModelPricing nodes in the graph.
+type ModelPricingMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	provider              *string
+	model                 *string
+	input_price           *float64
+	addinput_price        *float64
+	output_price          *float64
+	addoutput_price       *float64
+	thinking_price        *float64
+	addthinking_price     *float64
+	cached_input_price    *float64
+	addcached_input_price *float64
+	effective_from        *time.Time
+	effective_until       *time.Time
+	updated_by            *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*ModelPricing, error)
+	predicates            []predicate.ModelPricing
+}
+
+var _ ent.Mutation = (*ModelPricingMutation)(nil)
+
+// modelpricingOption allows management of the mutation configuration using functional options.
+type modelpricingOption func(*ModelPricingMutation)
+
+// newModelPricingMutation creates new mutation for the ModelPricing entity.
+func newModelPricingMutation(c config, op Op, opts ...modelpricingOption) *ModelPricingMutation {
+	m := &ModelPricingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModelPricing,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelPricingID sets the ID field of the mutation.
+func withModelPricingID(id int) modelpricingOption {
+	return func(m *ModelPricingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModelPricing
+		)
+		m.oldValue = func(ctx context.Context) (*ModelPricing, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModelPricing.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModelPricing sets the old ModelPricing of the mutation.
+func withModelPricing(node *ModelPricing) modelpricingOption {
+	return func(m *ModelPricingMutation) {
+		m.oldValue = func(context.Context) (*ModelPricing, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelPricingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelPricingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelPricingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelPricingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModelPricing.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvider sets the "provider" field.
+func (m *ModelPricingMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *ModelPricingMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *ModelPricingMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetModel sets the "model" field.
+func (m *ModelPricingMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *ModelPricingMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *ModelPricingMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetInputPrice sets the "input_price" field.
+func (m *ModelPricingMutation) SetInputPrice(f float64) {
+	m.input_price = &f
+	m.addinput_price = nil
+}
+
+// InputPrice returns the value of the "input_price" field in the mutation.
+func (m *ModelPricingMutation) InputPrice() (r float64, exists bool) {
+	v := m.input_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputPrice returns the old "input_price" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldInputPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputPrice: %w", err)
+	}
+	return oldValue.InputPrice, nil
+}
+
+// AddInputPrice adds f to the "input_price" field.
+func (m *ModelPricingMutation) AddInputPrice(f float64) {
+	if m.addinput_price != nil {
+		*m.addinput_price += f
+	} else {
+		m.addinput_price = &f
+	}
+}
+
+// AddedInputPrice returns the value that was added to the "input_price" field in this mutation.
+func (m *ModelPricingMutation) AddedInputPrice() (r float64, exists bool) {
+	v := m.addinput_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInputPrice resets all changes to the "input_price" field.
+func (m *ModelPricingMutation) ResetInputPrice() {
+	m.input_price = nil
+	m.addinput_price = nil
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (m *ModelPricingMutation) SetOutputPrice(f float64) {
+	m.output_price = &f
+	m.addoutput_price = nil
+}
+
+// OutputPrice returns the value of the "output_price" field in the mutation.
+func (m *ModelPricingMutation) OutputPrice() (r float64, exists bool) {
+	v := m.output_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputPrice returns the old "output_price" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldOutputPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputPrice: %w", err)
+	}
+	return oldValue.OutputPrice, nil
+}
+
+// AddOutputPrice adds f to the "output_price" field.
+func (m *ModelPricingMutation) AddOutputPrice(f float64) {
+	if m.addoutput_price != nil {
+		*m.addoutput_price += f
+	} else {
+		m.addoutput_price = &f
+	}
+}
+
+// AddedOutputPrice returns the value that was added to the "output_price" field in this mutation.
+func (m *ModelPricingMutation) AddedOutputPrice() (r float64, exists bool) {
+	v := m.addoutput_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOutputPrice resets all changes to the "output_price" field.
+func (m *ModelPricingMutation) ResetOutputPrice() {
+	m.output_price = nil
+	m.addoutput_price = nil
+}
+
+// SetThinkingPrice sets the "thinking_price" field.
+func (m *ModelPricingMutation) SetThinkingPrice(f float64) {
+	m.thinking_price = &f
+	m.addthinking_price = nil
+}
+
+// ThinkingPrice returns the value of the "thinking_price" field in the mutation.
+func (m *ModelPricingMutation) ThinkingPrice() (r float64, exists bool) {
+	v := m.thinking_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThinkingPrice returns the old "thinking_price" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldThinkingPrice(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThinkingPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThinkingPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThinkingPrice: %w", err)
+	}
+	return oldValue.ThinkingPrice, nil
+}
+
+// AddThinkingPrice adds f to the "thinking_price" field.
+func (m *ModelPricingMutation) AddThinkingPrice(f float64) {
+	if m.addthinking_price != nil {
+		*m.addthinking_price += f
+	} else {
+		m.addthinking_price = &f
+	}
+}
+
+// AddedThinkingPrice returns the value that was added to the "thinking_price" field in this mutation.
+func (m *ModelPricingMutation) AddedThinkingPrice() (r float64, exists bool) {
+	v := m.addthinking_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearThinkingPrice clears the value of the "thinking_price" field.
+func (m *ModelPricingMutation) ClearThinkingPrice() {
+	m.thinking_price = nil
+	m.addthinking_price = nil
+	m.clearedFields[modelpricing.FieldThinkingPrice] = struct{}{}
+}
+
+// ThinkingPriceCleared returns if the "thinking_price" field was cleared in this mutation.
+func (m *ModelPricingMutation) ThinkingPriceCleared() bool {
+	_, ok := m.clearedFields[modelpricing.FieldThinkingPrice]
+	return ok
+}
+
+// ResetThinkingPrice resets all changes to the "thinking_price" field.
+func (m *ModelPricingMutation) ResetThinkingPrice() {
+	m.thinking_price = nil
+	m.addthinking_price = nil
+	delete(m.clearedFields, modelpricing.FieldThinkingPrice)
+}
+
+// SetCachedInputPrice sets the "cached_input_price" field.
+func (m *ModelPricingMutation) SetCachedInputPrice(f float64) {
+	m.cached_input_price = &f
+	m.addcached_input_price = nil
+}
+
+// CachedInputPrice returns the value of the "cached_input_price" field in the mutation.
+func (m *ModelPricingMutation) CachedInputPrice() (r float64, exists bool) {
+	v := m.cached_input_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCachedInputPrice returns the old "cached_input_price" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldCachedInputPrice(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCachedInputPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCachedInputPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCachedInputPrice: %w", err)
+	}
+	return oldValue.CachedInputPrice, nil
+}
+
+// AddCachedInputPrice adds f to the "cached_input_price" field.
+func (m *ModelPricingMutation) AddCachedInputPrice(f float64) {
+	if m.addcached_input_price != nil {
+		*m.addcached_input_price += f
+	} else {
+		m.addcached_input_price = &f
+	}
+}
+
+// AddedCachedInputPrice returns the value that was added to the "cached_input_price" field in this mutation.
+func (m *ModelPricingMutation) AddedCachedInputPrice() (r float64, exists bool) {
+	v := m.addcached_input_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCachedInputPrice clears the value of the "cached_input_price" field.
+func (m *ModelPricingMutation) ClearCachedInputPrice() {
+	m.cached_input_price = nil
+	m.addcached_input_price = nil
+	m.clearedFields[modelpricing.FieldCachedInputPrice] = struct{}{}
+}
+
+// CachedInputPriceCleared returns if the "cached_input_price" field was cleared in this mutation.
+func (m *ModelPricingMutation) CachedInputPriceCleared() bool {
+	_, ok := m.clearedFields[modelpricing.FieldCachedInputPrice]
+	return ok
+}
+
+// ResetCachedInputPrice resets all changes to the "cached_input_price" field.
+func (m *ModelPricingMutation) ResetCachedInputPrice() {
+	m.cached_input_price = nil
+	m.addcached_input_price = nil
+	delete(m.clearedFields, modelpricing.FieldCachedInputPrice)
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (m *ModelPricingMutation) SetEffectiveFrom(t time.Time) {
+	m.effective_from = &t
+}
+
+// EffectiveFrom returns the value of the "effective_from" field in the mutation.
+func (m *ModelPricingMutation) EffectiveFrom() (r time.Time, exists bool) {
+	v := m.effective_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEffectiveFrom returns the old "effective_from" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldEffectiveFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEffectiveFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEffectiveFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEffectiveFrom: %w", err)
+	}
+	return oldValue.EffectiveFrom, nil
+}
+
+// ResetEffectiveFrom resets all changes to the "effective_from" field.
+func (m *ModelPricingMutation) ResetEffectiveFrom() {
+	m.effective_from = nil
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (m *ModelPricingMutation) SetEffectiveUntil(t time.Time) {
+	m.effective_until = &t
+}
+
+// EffectiveUntil returns the value of the "effective_until" field in the mutation.
+func (m *ModelPricingMutation) EffectiveUntil() (r time.Time, exists bool) {
+	v := m.effective_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEffectiveUntil returns the old "effective_until" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldEffectiveUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEffectiveUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEffectiveUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEffectiveUntil: %w", err)
+	}
+	return oldValue.EffectiveUntil, nil
+}
+
+// ClearEffectiveUntil clears the value of the "effective_until" field.
+func (m *ModelPricingMutation) ClearEffectiveUntil() {
+	m.effective_until = nil
+	m.clearedFields[modelpricing.FieldEffectiveUntil] = struct{}{}
+}
+
+// EffectiveUntilCleared returns if the "effective_until" field was cleared in this mutation.
+func (m *ModelPricingMutation) EffectiveUntilCleared() bool {
+	_, ok := m.clearedFields[modelpricing.FieldEffectiveUntil]
+	return ok
+}
+
+// ResetEffectiveUntil resets all changes to the "effective_until" field.
+func (m *ModelPricingMutation) ResetEffectiveUntil() {
+	m.effective_until = nil
+	delete(m.clearedFields, modelpricing.FieldEffectiveUntil)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *ModelPricingMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *ModelPricingMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *ModelPricingMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[modelpricing.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *ModelPricingMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[modelpricing.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *ModelPricingMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, modelpricing.FieldUpdatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModelPricingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModelPricingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModelPricingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ModelPricingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ModelPricingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ModelPricing entity.
+// If the ModelPricing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelPricingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ModelPricingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ModelPricingMutation builder.
+func (m *ModelPricingMutation) Where(ps ...predicate.ModelPricing) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelPricingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelPricingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModelPricing, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelPricingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelPricingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModelPricing).
+func (m *ModelPricingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelPricingMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.provider != nil {
+		fields = append(fields, modelpricing.FieldProvider)
+	}
+	if m.model != nil {
+		fields = append(fields, modelpricing.FieldModel)
+	}
+	if m.input_price != nil {
+		fields = append(fields, modelpricing.FieldInputPrice)
+	}
+	if m.output_price != nil {
+		fields = append(fields, modelpricing.FieldOutputPrice)
+	}
+	if m.thinking_price != nil {
+		fields = append(fields, modelpricing.FieldThinkingPrice)
+	}
+	if m.cached_input_price != nil {
+		fields = append(fields, modelpricing.FieldCachedInputPrice)
+	}
+	if m.effective_from != nil {
+		fields = append(fields, modelpricing.FieldEffectiveFrom)
+	}
+	if m.effective_until != nil {
+		fields = append(fields, modelpricing.FieldEffectiveUntil)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, modelpricing.FieldUpdatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, modelpricing.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, modelpricing.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelPricingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modelpricing.FieldProvider:
+		return m.Provider()
+	case modelpricing.FieldModel:
+		return m.Model()
+	case modelpricing.FieldInputPrice:
+		return m.InputPrice()
+	case modelpricing.FieldOutputPrice:
+		return m.OutputPrice()
+	case modelpricing.FieldThinkingPrice:
+		return m.ThinkingPrice()
+	case modelpricing.FieldCachedInputPrice:
+		return m.CachedInputPrice()
+	case modelpricing.FieldEffectiveFrom:
+		return m.EffectiveFrom()
+	case modelpricing.FieldEffectiveUntil:
+		return m.EffectiveUntil()
+	case modelpricing.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case modelpricing.FieldCreatedAt:
+		return m.CreatedAt()
+	case modelpricing.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelPricingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modelpricing.FieldProvider:
+		return m.OldProvider(ctx)
+	case modelpricing.FieldModel:
+		return m.OldModel(ctx)
+	case modelpricing.FieldInputPrice:
+		return m.OldInputPrice(ctx)
+	case modelpricing.FieldOutputPrice:
+		return m.OldOutputPrice(ctx)
+	case modelpricing.FieldThinkingPrice:
+		return m.OldThinkingPrice(ctx)
+	case modelpricing.FieldCachedInputPrice:
+		return m.OldCachedInputPrice(ctx)
+	case modelpricing.FieldEffectiveFrom:
+		return m.OldEffectiveFrom(ctx)
+	case modelpricing.FieldEffectiveUntil:
+		return m.OldEffectiveUntil(ctx)
+	case modelpricing.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case modelpricing.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case modelpricing.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModelPricing field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelPricingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modelpricing.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case modelpricing.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case modelpricing.FieldInputPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputPrice(v)
+		return nil
+	case modelpricing.FieldOutputPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputPrice(v)
+		return nil
+	case modelpricing.FieldThinkingPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThinkingPrice(v)
+		return nil
+	case modelpricing.FieldCachedInputPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCachedInputPrice(v)
+		return nil
+	case modelpricing.FieldEffectiveFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEffectiveFrom(v)
+		return nil
+	case modelpricing.FieldEffectiveUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEffectiveUntil(v)
+		return nil
+	case modelpricing.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case modelpricing.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case modelpricing.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelPricing field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelPricingMutation) AddedFields() []string {
+	var fields []string
+	if m.addinput_price != nil {
+		fields = append(fields, modelpricing.FieldInputPrice)
+	}
+	if m.addoutput_price != nil {
+		fields = append(fields, modelpricing.FieldOutputPrice)
+	}
+	if m.addthinking_price != nil {
+		fields = append(fields, modelpricing.FieldThinkingPrice)
+	}
+	if m.addcached_input_price != nil {
+		fields = append(fields, modelpricing.FieldCachedInputPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelPricingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case modelpricing.FieldInputPrice:
+		return m.AddedInputPrice()
+	case modelpricing.FieldOutputPrice:
+		return m.AddedOutputPrice()
+	case modelpricing.FieldThinkingPrice:
+		return m.AddedThinkingPrice()
+	case modelpricing.FieldCachedInputPrice:
+		return m.AddedCachedInputPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelPricingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case modelpricing.FieldInputPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputPrice(v)
+		return nil
+	case modelpricing.FieldOutputPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputPrice(v)
+		return nil
+	case modelpricing.FieldThinkingPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThinkingPrice(v)
+		return nil
+	case modelpricing.FieldCachedInputPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCachedInputPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelPricing numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelPricingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modelpricing.FieldThinkingPrice) {
+		fields = append(fields, modelpricing.FieldThinkingPrice)
+	}
+	if m.FieldCleared(modelpricing.FieldCachedInputPrice) {
+		fields = append(fields, modelpricing.FieldCachedInputPrice)
+	}
+	if m.FieldCleared(modelpricing.FieldEffectiveUntil) {
+		fields = append(fields, modelpricing.FieldEffectiveUntil)
+	}
+	if m.FieldCleared(modelpricing.FieldUpdatedBy) {
+		fields = append(fields, modelpricing.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelPricingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelPricingMutation) ClearField(name string) error {
+	switch name {
+	case modelpricing.FieldThinkingPrice:
+		m.ClearThinkingPrice()
+		return nil
+	case modelpricing.FieldCachedInputPrice:
+		m.ClearCachedInputPrice()
+		return nil
+	case modelpricing.FieldEffectiveUntil:
+		m.ClearEffectiveUntil()
+		return nil
+	case modelpricing.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelPricing nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelPricingMutation) ResetField(name string) error {
+	switch name {
+	case modelpricing.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case modelpricing.FieldModel:
+		m.ResetModel()
+		return nil
+	case modelpricing.FieldInputPrice:
+		m.ResetInputPrice()
+		return nil
+	case modelpricing.FieldOutputPrice:
+		m.ResetOutputPrice()
+		return nil
+	case modelpricing.FieldThinkingPrice:
+		m.ResetThinkingPrice()
+		return nil
+	case modelpricing.FieldCachedInputPrice:
+		m.ResetCachedInputPrice()
+		return nil
+	case modelpricing.FieldEffectiveFrom:
+		m.ResetEffectiveFrom()
+		return nil
+	case modelpricing.FieldEffectiveUntil:
+		m.ResetEffectiveUntil()
+		return nil
+	case modelpricing.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case modelpricing.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case modelpricing.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelPricing field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelPricingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelPricingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelPricingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelPricingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelPricingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelPricingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelPricingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ModelPricing unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelPricingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ModelPricing edge %s", name)
+}
+
+// PageVisitMutation represents an operation that mutates the PageVisit nodes in the graph.
+type PageVisitMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	entered_at          *time.Time
+	left_at             *time.Time
+	duration_ms         *int
+	addduration_ms      *int
+	max_scroll_depth    *float64
+	addmax_scroll_depth *float64
+	click_count         *int
+	addclick_count      *int
+	clearedFields       map[string]struct{}
+	session             *uuid.UUID
+	clearedsession      bool
+	url                 *uuid.UUID
+	clearedurl          bool
+	done                bool
+	oldValue            func(context.Context) (*PageVisit, error)
+	predicates          []predicate.PageVisit
+}
+
+var _ ent.Mutation = (*PageVisitMutation)(nil)
+
+// pagevisitOption allows management of the mutation configuration using functional options.
+type pagevisitOption func(*PageVisitMutation)
+
+// newPageVisitMutation creates new mutation for the PageVisit entity.
+func newPageVisitMutation(c config, op Op, opts ...pagevisitOption) *PageVisitMutation {
+	m := &PageVisitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePageVisit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPageVisitID sets the ID field of the mutation.
+func withPageVisitID(id uuid.UUID) pagevisitOption {
+	return func(m *PageVisitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PageVisit
+		)
+		m.oldValue = func(ctx context.Context) (*PageVisit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PageVisit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPageVisit sets the old PageVisit of the mutation.
+func withPageVisit(node *PageVisit) pagevisitOption {
+	return func(m *PageVisitMutation) {
+		m.oldValue = func(context.Context) (*PageVisit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PageVisitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PageVisitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PageVisit entities.
+func (m *PageVisitMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PageVisitMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PageVisitMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PageVisit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PageVisitMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PageVisitMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PageVisit entity.
+// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageVisitMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PageVisitMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PageVisitMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PageVisitMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PageVisit entity.
+// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageVisitMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PageVisitMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEnteredAt sets the "entered_at" field.
+func (m *PageVisitMutation) SetEnteredAt(t time.Time) {
+	m.entered_at = &t
+}
+
+// EnteredAt returns the value of the "entered_at" field in the mutation.
+func (m *PageVisitMutation) EnteredAt() (r time.Time, exists bool) {
+	v := m.entered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnteredAt returns the old "entered_at" field's value of the PageVisit entity.
+// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageVisitMutation) OldEnteredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnteredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnteredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnteredAt: %w", err)
+	}
+	return oldValue.EnteredAt, nil
+}
+
+// ResetEnteredAt resets all changes to the "entered_at" field.
+func (m *PageVisitMutation) ResetEnteredAt() {
+	m.entered_at = nil
+}
+
+// SetLeftAt sets the "left_at" field.
+func (m *PageVisitMutation) SetLeftAt(t time.Time) {
+	m.left_at = &t
+}
+
+// LeftAt returns the value of the "left_at" field in the mutation.
+func (m *PageVisitMutation) LeftAt() (r time.Time, exists bool) {
+	v := m.left_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeftAt returns the old "left_at" field's value of the PageVisit entity.
+// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageVisitMutation) OldLeftAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeftAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeftAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeftAt: %w", err)
+	}
+	return oldValue.LeftAt, nil
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (m *PageVisitMutation) ClearLeftAt() {
+	m.left_at = nil
+	m.clearedFields[pagevisit.FieldLeftAt] = struct{}{}
+}
+
+// LeftAtCleared returns if the "left_at" field was cleared in this mutation.
+func (m *PageVisitMutation) LeftAtCleared() bool {
+	_, ok := m.clearedFields[pagevisit.FieldLeftAt]
+	return ok
+}
+
+// ResetLeftAt resets all changes to the "left_at" field.
+func (m *PageVisitMutation) ResetLeftAt() {
+	m.left_at = nil
+	delete(m.clearedFields, pagevisit.FieldLeftAt)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *PageVisitMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *PageVisitMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the PageVisit entity.
+// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageVisitMutation) OldDurationMs(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *PageVisitMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *PageVisitMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (m *PageVisitMutation) ClearDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	m.clearedFields[pagevisit.FieldDurationMs] = struct{}{}
+}
+
+// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
+func (m *PageVisitMutation) DurationMsCleared() bool {
+	_, ok := m.clearedFields[pagevisit.FieldDurationMs]
+	return ok
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *PageVisitMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	delete(m.clearedFields, pagevisit.FieldDurationMs)
+}
+
+// SetMaxScrollDepth sets the "max_scroll_depth" field.
+func (m *PageVisitMutation) SetMaxScrollDepth(f float64) {
+	m.max_scroll_depth = &f
+	m.addmax_scroll_depth = nil
+}
+
+// MaxScrollDepth returns the value of the "max_scroll_depth" field in the mutation.
+func (m *PageVisitMutation) MaxScrollDepth() (r float64, exists bool) {
+	v := m.max_scroll_depth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxScrollDepth returns the old "max_scroll_depth" field's value of the PageVisit entity.
+// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageVisitMutation) OldMaxScrollDepth(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxScrollDepth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxScrollDepth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxScrollDepth: %w", err)
+	}
+	return oldValue.MaxScrollDepth, nil
+}
+
+// AddMaxScrollDepth adds f to the "max_scroll_depth" field.
+func (m *PageVisitMutation) AddMaxScrollDepth(f float64) {
+	if m.addmax_scroll_depth != nil {
+		*m.addmax_scroll_depth += f
+	} else {
+		m.addmax_scroll_depth = &f
+	}
+}
+
+// AddedMaxScrollDepth returns the value that was added to the "max_scroll_depth" field in this mutation.
+func (m *PageVisitMutation) AddedMaxScrollDepth() (r float64, exists bool) {
+	v := m.addmax_scroll_depth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxScrollDepth resets all changes to the "max_scroll_depth" field.
+func (m *PageVisitMutation) ResetMaxScrollDepth() {
+	m.max_scroll_depth = nil
+	m.addmax_scroll_depth = nil
+}
+
+// SetClickCount sets the "click_count" field.
+func (m *PageVisitMutation) SetClickCount(i int) {
+	m.click_count = &i
+	m.addclick_count = nil
+}
+
+// ClickCount returns the value of the "click_count" field in the mutation.
+func (m *PageVisitMutation) ClickCount() (r int, exists bool) {
+	v := m.click_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClickCount returns the old "click_count" field's value of the PageVisit entity.
+// If the PageVisit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageVisitMutation) OldClickCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClickCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClickCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClickCount: %w", err)
+	}
+	return oldValue.ClickCount, nil
+}
+
+// AddClickCount adds i to the "click_count" field.
+func (m *PageVisitMutation) AddClickCount(i int) {
+	if m.addclick_count != nil {
+		*m.addclick_count += i
+	} else {
+		m.addclick_count = &i
+	}
+}
+
+// AddedClickCount returns the value that was added to the "click_count" field in this mutation.
+func (m *PageVisitMutation) AddedClickCount() (r int, exists bool) {
+	v := m.addclick_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetClickCount resets all changes to the "click_count" field.
+func (m *PageVisitMutation) ResetClickCount() {
+	m.click_count = nil
+	m.addclick_count = nil
+}
+
+// SetSessionID sets the "session" edge to the Session entity by id.
+func (m *PageVisitMutation) SetSessionID(id uuid.UUID) {
+	m.session = &id
+}
+
+// ClearSession clears the "session" edge to the Session entity.
+func (m *PageVisitMutation) ClearSession() {
+	m.clearedsession = true
+}
+
+// SessionCleared reports if the "session" edge to the Session entity was cleared.
+func (m *PageVisitMutation) SessionCleared() bool {
+	return m.clearedsession
+}
+
+// SessionID returns the "session" edge ID in the mutation.
+func (m *PageVisitMutation) SessionID() (id uuid.UUID, exists bool) {
+	if m.session != nil {
+		return *m.session, true
+	}
+	return
+}
+
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *PageVisitMutation) SessionIDs() (ids []uuid.UUID) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSession resets all changes to the "session" edge.
+func (m *PageVisitMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
+}
+
+// SetURLID sets the "url" edge to the URL entity by id.
+func (m *PageVisitMutation) SetURLID(id uuid.UUID) {
+	m.url = &id
+}
+
+// ClearURL clears the "url" edge to the URL entity.
+func (m *PageVisitMutation) ClearURL() {
+	m.clearedurl = true
+}
+
+// URLCleared reports if the "url" edge to the URL entity was cleared.
+func (m *PageVisitMutation) URLCleared() bool {
+	return m.clearedurl
+}
+
+// URLID returns the "url" edge ID in the mutation.
+func (m *PageVisitMutation) URLID() (id uuid.UUID, exists bool) {
+	if m.url != nil {
+		return *m.url, true
+	}
+	return
+}
+
+// URLIDs returns the "url" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// URLID instead. It exists only for internal usage by the builders.
+func (m *PageVisitMutation) URLIDs() (ids []uuid.UUID) {
+	if id := m.url; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetURL resets all changes to the "url" edge.
+func (m *PageVisitMutation) ResetURL() {
+	m.url = nil
+	m.clearedurl = false
+}
+
+// Where appends a list predicates to the PageVisitMutation builder.
+func (m *PageVisitMutation) Where(ps ...predicate.PageVisit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PageVisitMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PageVisitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PageVisit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PageVisitMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PageVisitMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PageVisit).
+func (m *PageVisitMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PageVisitMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, pagevisit.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pagevisit.FieldUpdatedAt)
+	}
+	if m.entered_at != nil {
+		fields = append(fields, pagevisit.FieldEnteredAt)
+	}
+	if m.left_at != nil {
+		fields = append(fields, pagevisit.FieldLeftAt)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, pagevisit.FieldDurationMs)
+	}
+	if m.max_scroll_depth != nil {
+		fields = append(fields, pagevisit.FieldMaxScrollDepth)
+	}
+	if m.click_count != nil {
+		fields = append(fields, pagevisit.FieldClickCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PageVisitMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pagevisit.FieldCreatedAt:
+		return m.CreatedAt()
+	case pagevisit.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case pagevisit.FieldEnteredAt:
+		return m.EnteredAt()
+	case pagevisit.FieldLeftAt:
+		return m.LeftAt()
+	case pagevisit.FieldDurationMs:
+		return m.DurationMs()
+	case pagevisit.FieldMaxScrollDepth:
+		return m.MaxScrollDepth()
+	case pagevisit.FieldClickCount:
+		return m.ClickCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PageVisitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pagevisit.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pagevisit.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case pagevisit.FieldEnteredAt:
+		return m.OldEnteredAt(ctx)
+	case pagevisit.FieldLeftAt:
+		return m.OldLeftAt(ctx)
+	case pagevisit.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case pagevisit.FieldMaxScrollDepth:
+		return m.OldMaxScrollDepth(ctx)
+	case pagevisit.FieldClickCount:
+		return m.OldClickCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown PageVisit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PageVisitMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pagevisit.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pagevisit.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case pagevisit.FieldEnteredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnteredAt(v)
+		return nil
+	case pagevisit.FieldLeftAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeftAt(v)
+		return nil
+	case pagevisit.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case pagevisit.FieldMaxScrollDepth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxScrollDepth(v)
+		return nil
+	case pagevisit.FieldClickCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClickCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PageVisit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PageVisitMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, pagevisit.FieldDurationMs)
+	}
+	if m.addmax_scroll_depth != nil {
+		fields = append(fields, pagevisit.FieldMaxScrollDepth)
+	}
+	if m.addclick_count != nil {
+		fields = append(fields, pagevisit.FieldClickCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PageVisitMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pagevisit.FieldDurationMs:
+		return m.AddedDurationMs()
+	case pagevisit.FieldMaxScrollDepth:
+		return m.AddedMaxScrollDepth()
+	case pagevisit.FieldClickCount:
+		return m.AddedClickCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PageVisitMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pagevisit.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	case pagevisit.FieldMaxScrollDepth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxScrollDepth(v)
+		return nil
+	case pagevisit.FieldClickCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClickCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PageVisit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PageVisitMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pagevisit.FieldLeftAt) {
+		fields = append(fields, pagevisit.FieldLeftAt)
+	}
+	if m.FieldCleared(pagevisit.FieldDurationMs) {
+		fields = append(fields, pagevisit.FieldDurationMs)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PageVisitMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PageVisitMutation) ClearField(name string) error {
+	switch name {
+	case pagevisit.FieldLeftAt:
+		m.ClearLeftAt()
+		return nil
+	case pagevisit.FieldDurationMs:
+		m.ClearDurationMs()
+		return nil
+	}
+	return fmt.Errorf("unknown PageVisit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PageVisitMutation) ResetField(name string) error {
+	switch name {
+	case pagevisit.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pagevisit.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case pagevisit.FieldEnteredAt:
+		m.ResetEnteredAt()
+		return nil
+	case pagevisit.FieldLeftAt:
+		m.ResetLeftAt()
+		return nil
+	case pagevisit.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case pagevisit.FieldMaxScrollDepth:
+		m.ResetMaxScrollDepth()
+		return nil
+	case pagevisit.FieldClickCount:
+		m.ResetClickCount()
+		return nil
+	}
+	return fmt.Errorf("unknown PageVisit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PageVisitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.session != nil {
+		edges = append(edges, pagevisit.EdgeSession)
+	}
+	if m.url != nil {
+		edges = append(edges, pagevisit.EdgeURL)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PageVisitMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pagevisit.EdgeSession:
+		if id := m.session; id != nil {
+			return []ent.Value{*id}
+		}
+	case pagevisit.EdgeURL:
+		if id := m.url; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PageVisitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PageVisitMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PageVisitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsession {
+		edges = append(edges, pagevisit.EdgeSession)
+	}
+	if m.clearedurl {
+		edges = append(edges, pagevisit.EdgeURL)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PageVisitMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pagevisit.EdgeSession:
+		return m.clearedsession
+	case pagevisit.EdgeURL:
+		return m.clearedurl
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PageVisitMutation) ClearEdge(name string) error {
+	switch name {
+	case pagevisit.EdgeSession:
+		m.ClearSession()
+		return nil
+	case pagevisit.EdgeURL:
+		m.ClearURL()
+		return nil
+	}
+	return fmt.Errorf("unknown PageVisit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PageVisitMutation) ResetEdge(name string) error {
+	switch name {
+	case pagevisit.EdgeSession:
+		m.ResetSession()
+		return nil
+	case pagevisit.EdgeURL:
+		m.ResetURL()
+		return nil
+	}
+	return fmt.Errorf("unknown PageVisit edge %s", name)
+}
+
+// PasswordResetTokenMutation represents an operation that mutates the PasswordResetToken nodes in the graph.
+type PasswordResetTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	token         *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	used          *bool
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PasswordResetToken, error)
+	predicates    []predicate.PasswordResetToken
+}
+
+var _ ent.Mutation = (*PasswordResetTokenMutation)(nil)
+
+// passwordresettokenOption allows management of the mutation configuration using functional options.
+type passwordresettokenOption func(*PasswordResetTokenMutation)
+
+// newPasswordResetTokenMutation creates new mutation for the PasswordResetToken entity.
+func newPasswordResetTokenMutation(c config, op Op, opts ...passwordresettokenOption) *PasswordResetTokenMutation {
+	m := &PasswordResetTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasswordResetToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordResetTokenID sets the ID field of the mutation.
+func withPasswordResetTokenID(id uuid.UUID) passwordresettokenOption {
+	return func(m *PasswordResetTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PasswordResetToken
+		)
+		m.oldValue = func(ctx context.Context) (*PasswordResetToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PasswordResetToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasswordResetToken sets the old PasswordResetToken of the mutation.
+func withPasswordResetToken(node *PasswordResetToken) passwordresettokenOption {
+	return func(m *PasswordResetTokenMutation) {
+		m.oldValue = func(context.Context) (*PasswordResetToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordResetTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordResetTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PasswordResetToken entities.
+func (m *PasswordResetTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordResetTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordResetTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PasswordResetToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *PasswordResetTokenMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *PasswordResetTokenMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *PasswordResetTokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PasswordResetTokenMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordResetTokenMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordResetTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PasswordResetTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PasswordResetTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PasswordResetTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PasswordResetTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasswordResetTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasswordResetTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUsed sets the "used" field.
+func (m *PasswordResetTokenMutation) SetUsed(b bool) {
+	m.used = &b
+}
+
+// Used returns the value of the "used" field in the mutation.
+func (m *PasswordResetTokenMutation) Used() (r bool, exists bool) {
+	v := m.used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsed returns the old "used" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldUsed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsed: %w", err)
+	}
+	return oldValue.Used, nil
+}
+
+// ResetUsed resets all changes to the "used" field.
+func (m *PasswordResetTokenMutation) ResetUsed() {
+	m.used = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PasswordResetTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[passwordresettoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PasswordResetTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PasswordResetTokenMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PasswordResetTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PasswordResetTokenMutation builder.
+func (m *PasswordResetTokenMutation) Where(ps ...predicate.PasswordResetToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasswordResetTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasswordResetTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PasswordResetToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasswordResetTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PasswordResetTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PasswordResetToken).
+func (m *PasswordResetTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordResetTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.token != nil {
+		fields = append(fields, passwordresettoken.FieldToken)
+	}
+	if m.user != nil {
+		fields = append(fields, passwordresettoken.FieldUserID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, passwordresettoken.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, passwordresettoken.FieldCreatedAt)
+	}
+	if m.used != nil {
+		fields = append(fields, passwordresettoken.FieldUsed)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordResetTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordresettoken.FieldToken:
+		return m.Token()
+	case passwordresettoken.FieldUserID:
+		return m.UserID()
+	case passwordresettoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case passwordresettoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case passwordresettoken.FieldUsed:
+		return m.Used()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordResetTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordresettoken.FieldToken:
+		return m.OldToken(ctx)
+	case passwordresettoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case passwordresettoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case passwordresettoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case passwordresettoken.FieldUsed:
+		return m.OldUsed(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordResetToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordresettoken.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case passwordresettoken.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passwordresettoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case passwordresettoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case passwordresettoken.FieldUsed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordResetTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordResetTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PasswordResetToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordResetTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordResetTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordResetTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PasswordResetToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordResetTokenMutation) ResetField(name string) error {
+	switch name {
+	case passwordresettoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case passwordresettoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passwordresettoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case passwordresettoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case passwordresettoken.FieldUsed:
+		m.ResetUsed()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordResetTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, passwordresettoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordResetTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case passwordresettoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordResetTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordResetTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordResetTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, passwordresettoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordResetTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case passwordresettoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordResetTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case passwordresettoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordResetTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case passwordresettoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken edge %s", name)
+}
+
+// PlanMutation represents an operation that mutates the Plan nodes in the graph.
+type PlanMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	name                       *string
+	price_cents                *int
+	addprice_cents             *int
+	billing_period             *string
+	token_limit                *int
+	addtoken_limit             *int
+	session_retention_days     *int
+	addsession_retention_days  *int
+	max_concurrent_sessions    *int
+	addmax_concurrent_sessions *int
+	features                   *map[string]bool
+	created_at                 *time.Time
+	clearedFields              map[string]struct{}
+	subscriptions              map[uuid.UUID]struct{}
+	removedsubscriptions       map[uuid.UUID]struct{}
+	clearedsubscriptions       bool
+	done                       bool
+	oldValue                   func(context.Context) (*Plan, error)
+	predicates                 []predicate.Plan
+}
+
+var _ ent.Mutation = (*PlanMutation)(nil)
+
+// planOption allows management of the mutation configuration using functional options.
+type planOption func(*PlanMutation)
+
+// newPlanMutation creates new mutation for the Plan entity.
+func newPlanMutation(c config, op Op, opts ...planOption) *PlanMutation {
+	m := &PlanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlanID sets the ID field of the mutation.
+func withPlanID(id string) planOption {
+	return func(m *PlanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Plan
+		)
+		m.oldValue = func(ctx context.Context) (*Plan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Plan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlan sets the old Plan of the mutation.
+func withPlan(node *Plan) planOption {
+	return func(m *PlanMutation) {
+		m.oldValue = func(context.Context) (*Plan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Plan entities.
+func (m *PlanMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlanMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlanMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Plan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PlanMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlanMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlanMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPriceCents sets the "price_cents" field.
+func (m *PlanMutation) SetPriceCents(i int) {
+	m.price_cents = &i
+	m.addprice_cents = nil
+}
+
+// PriceCents returns the value of the "price_cents" field in the mutation.
+func (m *PlanMutation) PriceCents() (r int, exists bool) {
+	v := m.price_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceCents returns the old "price_cents" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldPriceCents(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceCents: %w", err)
+	}
+	return oldValue.PriceCents, nil
+}
+
+// AddPriceCents adds i to the "price_cents" field.
+func (m *PlanMutation) AddPriceCents(i int) {
+	if m.addprice_cents != nil {
+		*m.addprice_cents += i
+	} else {
+		m.addprice_cents = &i
+	}
+}
+
+// AddedPriceCents returns the value that was added to the "price_cents" field in this mutation.
+func (m *PlanMutation) AddedPriceCents() (r int, exists bool) {
+	v := m.addprice_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriceCents resets all changes to the "price_cents" field.
+func (m *PlanMutation) ResetPriceCents() {
+	m.price_cents = nil
+	m.addprice_cents = nil
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (m *PlanMutation) SetBillingPeriod(s string) {
+	m.billing_period = &s
+}
+
+// BillingPeriod returns the value of the "billing_period" field in the mutation.
+func (m *PlanMutation) BillingPeriod() (r string, exists bool) {
+	v := m.billing_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBillingPeriod returns the old "billing_period" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldBillingPeriod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBillingPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBillingPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBillingPeriod: %w", err)
+	}
+	return oldValue.BillingPeriod, nil
+}
+
+// ResetBillingPeriod resets all changes to the "billing_period" field.
+func (m *PlanMutation) ResetBillingPeriod() {
+	m.billing_period = nil
+}
+
+// SetTokenLimit sets the "token_limit" field.
+func (m *PlanMutation) SetTokenLimit(i int) {
+	m.token_limit = &i
+	m.addtoken_limit = nil
+}
+
+// TokenLimit returns the value of the "token_limit" field in the mutation.
+func (m *PlanMutation) TokenLimit() (r int, exists bool) {
+	v := m.token_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenLimit returns the old "token_limit" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldTokenLimit(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenLimit: %w", err)
+	}
+	return oldValue.TokenLimit, nil
+}
+
+// AddTokenLimit adds i to the "token_limit" field.
+func (m *PlanMutation) AddTokenLimit(i int) {
+	if m.addtoken_limit != nil {
+		*m.addtoken_limit += i
+	} else {
+		m.addtoken_limit = &i
+	}
+}
+
+// AddedTokenLimit returns the value that was added to the "token_limit" field in this mutation.
+func (m *PlanMutation) AddedTokenLimit() (r int, exists bool) {
+	v := m.addtoken_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTokenLimit clears the value of the "token_limit" field.
+func (m *PlanMutation) ClearTokenLimit() {
+	m.token_limit = nil
+	m.addtoken_limit = nil
+	m.clearedFields[plan.FieldTokenLimit] = struct{}{}
+}
+
+// TokenLimitCleared returns if the "token_limit" field was cleared in this mutation.
+func (m *PlanMutation) TokenLimitCleared() bool {
+	_, ok := m.clearedFields[plan.FieldTokenLimit]
+	return ok
+}
+
+// ResetTokenLimit resets all changes to the "token_limit" field.
+func (m *PlanMutation) ResetTokenLimit() {
+	m.token_limit = nil
+	m.addtoken_limit = nil
+	delete(m.clearedFields, plan.FieldTokenLimit)
+}
+
+// SetSessionRetentionDays sets the "session_retention_days" field.
+func (m *PlanMutation) SetSessionRetentionDays(i int) {
+	m.session_retention_days = &i
+	m.addsession_retention_days = nil
+}
+
+// SessionRetentionDays returns the value of the "session_retention_days" field in the mutation.
+func (m *PlanMutation) SessionRetentionDays() (r int, exists bool) {
+	v := m.session_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionRetentionDays returns the old "session_retention_days" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldSessionRetentionDays(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionRetentionDays: %w", err)
+	}
+	return oldValue.SessionRetentionDays, nil
+}
+
+// AddSessionRetentionDays adds i to the "session_retention_days" field.
+func (m *PlanMutation) AddSessionRetentionDays(i int) {
+	if m.addsession_retention_days != nil {
+		*m.addsession_retention_days += i
+	} else {
+		m.addsession_retention_days = &i
+	}
+}
+
+// AddedSessionRetentionDays returns the value that was added to the "session_retention_days" field in this mutation.
+func (m *PlanMutation) AddedSessionRetentionDays() (r int, exists bool) {
+	v := m.addsession_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSessionRetentionDays clears the value of the "session_retention_days" field.
+func (m *PlanMutation) ClearSessionRetentionDays() {
+	m.session_retention_days = nil
+	m.addsession_retention_days = nil
+	m.clearedFields[plan.FieldSessionRetentionDays] = struct{}{}
+}
+
+// SessionRetentionDaysCleared returns if the "session_retention_days" field was cleared in this mutation.
+func (m *PlanMutation) SessionRetentionDaysCleared() bool {
+	_, ok := m.clearedFields[plan.FieldSessionRetentionDays]
+	return ok
+}
+
+// ResetSessionRetentionDays resets all changes to the "session_retention_days" field.
+func (m *PlanMutation) ResetSessionRetentionDays() {
+	m.session_retention_days = nil
+	m.addsession_retention_days = nil
+	delete(m.clearedFields, plan.FieldSessionRetentionDays)
+}
+
+// SetMaxConcurrentSessions sets the "max_concurrent_sessions" field.
+func (m *PlanMutation) SetMaxConcurrentSessions(i int) {
+	m.max_concurrent_sessions = &i
+	m.addmax_concurrent_sessions = nil
+}
+
+// MaxConcurrentSessions returns the value of the "max_concurrent_sessions" field in the mutation.
+func (m *PlanMutation) MaxConcurrentSessions() (r int, exists bool) {
+	v := m.max_concurrent_sessions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxConcurrentSessions returns the old "max_concurrent_sessions" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMaxConcurrentSessions(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxConcurrentSessions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxConcurrentSessions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxConcurrentSessions: %w", err)
+	}
+	return oldValue.MaxConcurrentSessions, nil
+}
+
+// AddMaxConcurrentSessions adds i to the "max_concurrent_sessions" field.
+func (m *PlanMutation) AddMaxConcurrentSessions(i int) {
+	if m.addmax_concurrent_sessions != nil {
+		*m.addmax_concurrent_sessions += i
+	} else {
+		m.addmax_concurrent_sessions = &i
+	}
+}
+
+// AddedMaxConcurrentSessions returns the value that was added to the "max_concurrent_sessions" field in this mutation.
+func (m *PlanMutation) AddedMaxConcurrentSessions() (r int, exists bool) {
+	v := m.addmax_concurrent_sessions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxConcurrentSessions clears the value of the "max_concurrent_sessions" field.
+func (m *PlanMutation) ClearMaxConcurrentSessions() {
+	m.max_concurrent_sessions = nil
+	m.addmax_concurrent_sessions = nil
+	m.clearedFields[plan.FieldMaxConcurrentSessions] = struct{}{}
+}
+
+// MaxConcurrentSessionsCleared returns if the "max_concurrent_sessions" field was cleared in this mutation.
+func (m *PlanMutation) MaxConcurrentSessionsCleared() bool {
+	_, ok := m.clearedFields[plan.FieldMaxConcurrentSessions]
+	return ok
+}
+
+// ResetMaxConcurrentSessions resets all changes to the "max_concurrent_sessions" field.
+func (m *PlanMutation) ResetMaxConcurrentSessions() {
+	m.max_concurrent_sessions = nil
+	m.addmax_concurrent_sessions = nil
+	delete(m.clearedFields, plan.FieldMaxConcurrentSessions)
+}
+
+// SetFeatures sets the "features" field.
+func (m *PlanMutation) SetFeatures(value map[string]bool) {
+	m.features = &value
+}
+
+// Features returns the value of the "features" field in the mutation.
+func (m *PlanMutation) Features() (r map[string]bool, exists bool) {
+	v := m.features
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatures returns the old "features" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldFeatures(ctx context.Context) (v map[string]bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatures: %w", err)
+	}
+	return oldValue.Features, nil
+}
+
+// ResetFeatures resets all changes to the "features" field.
+func (m *PlanMutation) ResetFeatures() {
+	m.features = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by ids.
+func (m *PlanMutation) AddSubscriptionIDs(ids ...uuid.UUID) {
+	if m.subscriptions == nil {
+		m.subscriptions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubscriptions clears the "subscriptions" edge to the Subscription entity.
+func (m *PlanMutation) ClearSubscriptions() {
+	m.clearedsubscriptions = true
+}
+
+// SubscriptionsCleared reports if the "subscriptions" edge to the Subscription entity was cleared.
+func (m *PlanMutation) SubscriptionsCleared() bool {
+	return m.clearedsubscriptions
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to the Subscription entity by IDs.
+func (m *PlanMutation) RemoveSubscriptionIDs(ids ...uuid.UUID) {
+	if m.removedsubscriptions == nil {
+		m.removedsubscriptions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.subscriptions, ids[i])
+		m.removedsubscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubscriptions returns the removed IDs of the "subscriptions" edge to the Subscription entity.
+func (m *PlanMutation) RemovedSubscriptionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsubscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubscriptionsIDs returns the "subscriptions" edge IDs in the mutation.
+func (m *PlanMutation) SubscriptionsIDs() (ids []uuid.UUID) {
+	for id := range m.subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSubscriptions resets all changes to the "subscriptions" edge.
+func (m *PlanMutation) ResetSubscriptions() {
+	m.subscriptions = nil
+	m.clearedsubscriptions = false
+	m.removedsubscriptions = nil
+}
+
+// Where appends a list predicates to the PlanMutation builder.
+func (m *PlanMutation) Where(ps ...predicate.Plan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Plan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Plan).
+func (m *PlanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlanMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, plan.FieldName)
+	}
+	if m.price_cents != nil {
+		fields = append(fields, plan.FieldPriceCents)
+	}
+	if m.billing_period != nil {
+		fields = append(fields, plan.FieldBillingPeriod)
+	}
+	if m.token_limit != nil {
+		fields = append(fields, plan.FieldTokenLimit)
+	}
+	if m.session_retention_days != nil {
+		fields = append(fields, plan.FieldSessionRetentionDays)
+	}
+	if m.max_concurrent_sessions != nil {
+		fields = append(fields, plan.FieldMaxConcurrentSessions)
+	}
+	if m.features != nil {
+		fields = append(fields, plan.FieldFeatures)
+	}
+	if m.created_at != nil {
+		fields = append(fields, plan.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldName:
+		return m.Name()
+	case plan.FieldPriceCents:
+		return m.PriceCents()
+	case plan.FieldBillingPeriod:
+		return m.BillingPeriod()
+	case plan.FieldTokenLimit:
+		return m.TokenLimit()
+	case plan.FieldSessionRetentionDays:
+		return m.SessionRetentionDays()
+	case plan.FieldMaxConcurrentSessions:
+		return m.MaxConcurrentSessions()
+	case plan.FieldFeatures:
+		return m.Features()
+	case plan.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case plan.FieldName:
+		return m.OldName(ctx)
+	case plan.FieldPriceCents:
+		return m.OldPriceCents(ctx)
+	case plan.FieldBillingPeriod:
+		return m.OldBillingPeriod(ctx)
+	case plan.FieldTokenLimit:
+		return m.OldTokenLimit(ctx)
+	case plan.FieldSessionRetentionDays:
+		return m.OldSessionRetentionDays(ctx)
+	case plan.FieldMaxConcurrentSessions:
+		return m.OldMaxConcurrentSessions(ctx)
+	case plan.FieldFeatures:
+		return m.OldFeatures(ctx)
+	case plan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Plan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case plan.FieldPriceCents:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceCents(v)
+		return nil
+	case plan.FieldBillingPeriod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBillingPeriod(v)
+		return nil
+	case plan.FieldTokenLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenLimit(v)
+		return nil
+	case plan.FieldSessionRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionRetentionDays(v)
+		return nil
+	case plan.FieldMaxConcurrentSessions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxConcurrentSessions(v)
+		return nil
+	case plan.FieldFeatures:
+		v, ok := value.(map[string]bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatures(v)
+		return nil
+	case plan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlanMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice_cents != nil {
+		fields = append(fields, plan.FieldPriceCents)
+	}
+	if m.addtoken_limit != nil {
+		fields = append(fields, plan.FieldTokenLimit)
+	}
+	if m.addsession_retention_days != nil {
+		fields = append(fields, plan.FieldSessionRetentionDays)
+	}
+	if m.addmax_concurrent_sessions != nil {
+		fields = append(fields, plan.FieldMaxConcurrentSessions)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldPriceCents:
+		return m.AddedPriceCents()
+	case plan.FieldTokenLimit:
+		return m.AddedTokenLimit()
+	case plan.FieldSessionRetentionDays:
+		return m.AddedSessionRetentionDays()
+	case plan.FieldMaxConcurrentSessions:
+		return m.AddedMaxConcurrentSessions()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldPriceCents:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriceCents(v)
+		return nil
+	case plan.FieldTokenLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenLimit(v)
+		return nil
+	case plan.FieldSessionRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSessionRetentionDays(v)
+		return nil
+	case plan.FieldMaxConcurrentSessions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxConcurrentSessions(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(plan.FieldTokenLimit) {
+		fields = append(fields, plan.FieldTokenLimit)
+	}
+	if m.FieldCleared(plan.FieldSessionRetentionDays) {
+		fields = append(fields, plan.FieldSessionRetentionDays)
+	}
+	if m.FieldCleared(plan.FieldMaxConcurrentSessions) {
+		fields = append(fields, plan.FieldMaxConcurrentSessions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlanMutation) ClearField(name string) error {
+	switch name {
+	case plan.FieldTokenLimit:
+		m.ClearTokenLimit()
+		return nil
+	case plan.FieldSessionRetentionDays:
+		m.ClearSessionRetentionDays()
+		return nil
+	case plan.FieldMaxConcurrentSessions:
+		m.ClearMaxConcurrentSessions()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlanMutation) ResetField(name string) error {
+	switch name {
+	case plan.FieldName:
+		m.ResetName()
+		return nil
+	case plan.FieldPriceCents:
+		m.ResetPriceCents()
+		return nil
+	case plan.FieldBillingPeriod:
+		m.ResetBillingPeriod()
+		return nil
+	case plan.FieldTokenLimit:
+		m.ResetTokenLimit()
+		return nil
+	case plan.FieldSessionRetentionDays:
+		m.ResetSessionRetentionDays()
+		return nil
+	case plan.FieldMaxConcurrentSessions:
+		m.ResetMaxConcurrentSessions()
+		return nil
+	case plan.FieldFeatures:
+		m.ResetFeatures()
+		return nil
+	case plan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.subscriptions != nil {
+		edges = append(edges, plan.EdgeSubscriptions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.subscriptions))
+		for id := range m.subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedsubscriptions != nil {
+		edges = append(edges, plan.EdgeSubscriptions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
+		for id := range m.removedsubscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsubscriptions {
+		edges = append(edges, plan.EdgeSubscriptions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case plan.EdgeSubscriptions:
+		return m.clearedsubscriptions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlanMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Plan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlanMutation) ResetEdge(name string) error {
+	switch name {
+	case plan.EdgeSubscriptions:
+		m.ResetSubscriptions()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan edge %s", name)
+}
+
+// PromptTemplateMutation represents an operation that mutates the PromptTemplate nodes in the graph.
+type PromptTemplateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	locale        *string
+	version       *int
+	addversion    *int
+	body          *string
+	enabled       *bool
+	updated_by    *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PromptTemplate, error)
+	predicates    []predicate.PromptTemplate
+}
+
+var _ ent.Mutation = (*PromptTemplateMutation)(nil)
+
+// prompttemplateOption allows management of the mutation configuration using functional options.
+type prompttemplateOption func(*PromptTemplateMutation)
+
+// newPromptTemplateMutation creates new mutation for the PromptTemplate entity.
+func newPromptTemplateMutation(c config, op Op, opts ...prompttemplateOption) *PromptTemplateMutation {
+	m := &PromptTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePromptTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPromptTemplateID sets the ID field of the mutation.
+func withPromptTemplateID(id int) prompttemplateOption {
+	return func(m *PromptTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PromptTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*PromptTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PromptTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPromptTemplate sets the old PromptTemplate of the mutation.
+func withPromptTemplate(node *PromptTemplate) prompttemplateOption {
+	return func(m *PromptTemplateMutation) {
+		m.oldValue = func(context.Context) (*PromptTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PromptTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PromptTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PromptTemplateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PromptTemplateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PromptTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PromptTemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PromptTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PromptTemplate entity.
+// If the PromptTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PromptTemplateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLocale sets the "locale" field.
+func (m *PromptTemplateMutation) SetLocale(s string) {
+	m.locale = &s
+}
+
+// Locale returns the value of the "locale" field in the mutation.
+func (m *PromptTemplateMutation) Locale() (r string, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocale returns the old "locale" field's value of the PromptTemplate entity.
+// If the PromptTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptTemplateMutation) OldLocale(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
+}
+
+// ResetLocale resets all changes to the "locale" field.
+func (m *PromptTemplateMutation) ResetLocale() {
+	m.locale = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *PromptTemplateMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PromptTemplateMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the PromptTemplate entity.
+// If the PromptTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptTemplateMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *PromptTemplateMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PromptTemplateMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PromptTemplateMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetBody sets the "body" field.
+func (m *PromptTemplateMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *PromptTemplateMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the PromptTemplate entity.
+// If the PromptTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptTemplateMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *PromptTemplateMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *PromptTemplateMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *PromptTemplateMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the PromptTemplate entity.
+// If the PromptTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptTemplateMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *PromptTemplateMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *PromptTemplateMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *PromptTemplateMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the PromptTemplate entity.
+// If the PromptTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptTemplateMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *PromptTemplateMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[prompttemplate.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *PromptTemplateMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[prompttemplate.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *PromptTemplateMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, prompttemplate.FieldUpdatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PromptTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PromptTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PromptTemplate entity.
+// If the PromptTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PromptTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PromptTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PromptTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PromptTemplate entity.
+// If the PromptTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PromptTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the PromptTemplateMutation builder.
+func (m *PromptTemplateMutation) Where(ps ...predicate.PromptTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PromptTemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PromptTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PromptTemplate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PromptTemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PromptTemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PromptTemplate).
+func (m *PromptTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PromptTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, prompttemplate.FieldName)
+	}
+	if m.locale != nil {
+		fields = append(fields, prompttemplate.FieldLocale)
+	}
+	if m.version != nil {
+		fields = append(fields, prompttemplate.FieldVersion)
+	}
+	if m.body != nil {
+		fields = append(fields, prompttemplate.FieldBody)
+	}
+	if m.enabled != nil {
+		fields = append(fields, prompttemplate.FieldEnabled)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, prompttemplate.FieldUpdatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, prompttemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, prompttemplate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PromptTemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case prompttemplate.FieldName:
+		return m.Name()
+	case prompttemplate.FieldLocale:
+		return m.Locale()
+	case prompttemplate.FieldVersion:
+		return m.Version()
+	case prompttemplate.FieldBody:
+		return m.Body()
+	case prompttemplate.FieldEnabled:
+		return m.Enabled()
+	case prompttemplate.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case prompttemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case prompttemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PromptTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case prompttemplate.FieldName:
+		return m.OldName(ctx)
+	case prompttemplate.FieldLocale:
+		return m.OldLocale(ctx)
+	case prompttemplate.FieldVersion:
+		return m.OldVersion(ctx)
+	case prompttemplate.FieldBody:
+		return m.OldBody(ctx)
+	case prompttemplate.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case prompttemplate.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case prompttemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case prompttemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PromptTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromptTemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case prompttemplate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case prompttemplate.FieldLocale:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case prompttemplate.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case prompttemplate.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case prompttemplate.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case prompttemplate.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case prompttemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case prompttemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PromptTemplate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PromptTemplateMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, prompttemplate.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PromptTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case prompttemplate.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromptTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case prompttemplate.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PromptTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PromptTemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(prompttemplate.FieldUpdatedBy) {
+		fields = append(fields, prompttemplate.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PromptTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PromptTemplateMutation) ClearField(name string) error {
+	switch name {
+	case prompttemplate.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown PromptTemplate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PromptTemplateMutation) ResetField(name string) error {
+	switch name {
+	case prompttemplate.FieldName:
+		m.ResetName()
+		return nil
+	case prompttemplate.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case prompttemplate.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case prompttemplate.FieldBody:
+		m.ResetBody()
+		return nil
+	case prompttemplate.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case prompttemplate.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case prompttemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case prompttemplate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PromptTemplate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PromptTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PromptTemplateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PromptTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PromptTemplateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PromptTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PromptTemplateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PromptTemplateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PromptTemplate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PromptTemplateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PromptTemplate edge %s", name)
+}
+
+// RawEventMutation represents an operation that mutates the RawEvent nodes in the graph.
+type RawEventMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	updated_at     *time.Time
+	event_type     *string
+	timestamp      *time.Time
+	payload        *string
+	processed      *bool
+	processed_at   *time.Time
+	clearedFields  map[string]struct{}
+	session        *uuid.UUID
+	clearedsession bool
+	done           bool
+	oldValue       func(context.Context) (*RawEvent, error)
+	predicates     []predicate.RawEvent
+}
+
+var _ ent.Mutation = (*RawEventMutation)(nil)
+
+// raweventOption allows management of the mutation configuration using functional options.
+type raweventOption func(*RawEventMutation)
+
+// newRawEventMutation creates new mutation for the RawEvent entity.
+func newRawEventMutation(c config, op Op, opts ...raweventOption) *RawEventMutation {
+	m := &RawEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRawEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRawEventID sets the ID field of the mutation.
+func withRawEventID(id uuid.UUID) raweventOption {
+	return func(m *RawEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RawEvent
+		)
+		m.oldValue = func(ctx context.Context) (*RawEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RawEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRawEvent sets the old RawEvent of the mutation.
+func withRawEvent(node *RawEvent) raweventOption {
+	return func(m *RawEventMutation) {
+		m.oldValue = func(context.Context) (*RawEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RawEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RawEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RawEvent entities.
+func (m *RawEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RawEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RawEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RawEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RawEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RawEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RawEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RawEventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RawEventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RawEventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *RawEventMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *RawEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *RawEventMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *RawEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *RawEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *RawEventMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *RawEventMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *RawEventMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *RawEventMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetProcessed sets the "processed" field.
+func (m *RawEventMutation) SetProcessed(b bool) {
+	m.processed = &b
+}
+
+// Processed returns the value of the "processed" field in the mutation.
+func (m *RawEventMutation) Processed() (r bool, exists bool) {
+	v := m.processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessed returns the old "processed" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldProcessed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessed: %w", err)
+	}
+	return oldValue.Processed, nil
+}
+
+// ResetProcessed resets all changes to the "processed" field.
+func (m *RawEventMutation) ResetProcessed() {
+	m.processed = nil
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (m *RawEventMutation) SetProcessedAt(t time.Time) {
+	m.processed_at = &t
+}
+
+// ProcessedAt returns the value of the "processed_at" field in the mutation.
+func (m *RawEventMutation) ProcessedAt() (r time.Time, exists bool) {
+	v := m.processed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessedAt returns the old "processed_at" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldProcessedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+	}
+	return oldValue.ProcessedAt, nil
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (m *RawEventMutation) ClearProcessedAt() {
+	m.processed_at = nil
+	m.clearedFields[rawevent.FieldProcessedAt] = struct{}{}
+}
+
+// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
+func (m *RawEventMutation) ProcessedAtCleared() bool {
+	_, ok := m.clearedFields[rawevent.FieldProcessedAt]
+	return ok
+}
+
+// ResetProcessedAt resets all changes to the "processed_at" field.
+func (m *RawEventMutation) ResetProcessedAt() {
+	m.processed_at = nil
+	delete(m.clearedFields, rawevent.FieldProcessedAt)
+}
+
+// SetSessionID sets the "session" edge to the Session entity by id.
+func (m *RawEventMutation) SetSessionID(id uuid.UUID) {
+	m.session = &id
+}
+
+// ClearSession clears the "session" edge to the Session entity.
+func (m *RawEventMutation) ClearSession() {
+	m.clearedsession = true
+}
+
+// SessionCleared reports if the "session" edge to the Session entity was cleared.
+func (m *RawEventMutation) SessionCleared() bool {
+	return m.clearedsession
+}
+
+// SessionID returns the "session" edge ID in the mutation.
+func (m *RawEventMutation) SessionID() (id uuid.UUID, exists bool) {
+	if m.session != nil {
+		return *m.session, true
+	}
+	return
+}
+
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *RawEventMutation) SessionIDs() (ids []uuid.UUID) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSession resets all changes to the "session" edge.
+func (m *RawEventMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
+}
+
+// Where appends a list predicates to the RawEventMutation builder.
+func (m *RawEventMutation) Where(ps ...predicate.RawEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RawEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RawEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RawEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RawEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RawEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RawEvent).
+func (m *RawEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RawEventMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, rawevent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rawevent.FieldUpdatedAt)
+	}
+	if m.event_type != nil {
+		fields = append(fields, rawevent.FieldEventType)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, rawevent.FieldTimestamp)
+	}
+	if m.payload != nil {
+		fields = append(fields, rawevent.FieldPayload)
+	}
+	if m.processed != nil {
+		fields = append(fields, rawevent.FieldProcessed)
+	}
+	if m.processed_at != nil {
+		fields = append(fields, rawevent.FieldProcessedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RawEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rawevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case rawevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case rawevent.FieldEventType:
+		return m.EventType()
+	case rawevent.FieldTimestamp:
+		return m.Timestamp()
+	case rawevent.FieldPayload:
+		return m.Payload()
+	case rawevent.FieldProcessed:
+		return m.Processed()
+	case rawevent.FieldProcessedAt:
+		return m.ProcessedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RawEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rawevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rawevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case rawevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case rawevent.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case rawevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case rawevent.FieldProcessed:
+		return m.OldProcessed(ctx)
+	case rawevent.FieldProcessedAt:
+		return m.OldProcessedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RawEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RawEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rawevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rawevent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case rawevent.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case rawevent.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case rawevent.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case rawevent.FieldProcessed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessed(v)
+		return nil
+	case rawevent.FieldProcessedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RawEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RawEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RawEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RawEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RawEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RawEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rawevent.FieldProcessedAt) {
+		fields = append(fields, rawevent.FieldProcessedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RawEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RawEventMutation) ClearField(name string) error {
+	switch name {
+	case rawevent.FieldProcessedAt:
+		m.ClearProcessedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RawEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RawEventMutation) ResetField(name string) error {
+	switch name {
+	case rawevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rawevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case rawevent.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case rawevent.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case rawevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case rawevent.FieldProcessed:
+		m.ResetProcessed()
+		return nil
+	case rawevent.FieldProcessedAt:
+		m.ResetProcessedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RawEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RawEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.session != nil {
+		edges = append(edges, rawevent.EdgeSession)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RawEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rawevent.EdgeSession:
+		if id := m.session; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RawEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RawEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RawEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsession {
+		edges = append(edges, rawevent.EdgeSession)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RawEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rawevent.EdgeSession:
+		return m.clearedsession
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RawEventMutation) ClearEdge(name string) error {
+	switch name {
+	case rawevent.EdgeSession:
+		m.ClearSession()
+		return nil
+	}
+	return fmt.Errorf("unknown RawEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RawEventMutation) ResetEdge(name string) error {
+	switch name {
+	case rawevent.EdgeSession:
+		m.ResetSession()
+		return nil
+	}
+	return fmt.Errorf("unknown RawEvent edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	family_id     *uuid.UUID
+	expires_at    *time.Time
+	created_at    *time.Time
+	used_at       *time.Time
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RefreshToken, error)
+	predicates    []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id uuid.UUID) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RefreshToken entities.
+func (m *RefreshTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *RefreshTokenMutation) SetFamilyID(u uuid.UUID) {
+	m.family_id = &u
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *RefreshTokenMutation) FamilyID() (r uuid.UUID, exists bool) {
+	v := m.family_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldFamilyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *RefreshTokenMutation) ResetFamilyID() {
+	m.family_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RefreshTokenMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RefreshTokenMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RefreshTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RefreshTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *RefreshTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RefreshTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RefreshTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[refreshtoken.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RefreshTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, refreshtoken.FieldUsedAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RefreshTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RefreshTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RefreshTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[refreshtoken.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RefreshTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, refreshtoken.FieldRevokedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RefreshTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[refreshtoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RefreshTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RefreshTokenMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RefreshTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.family_id != nil {
+		fields = append(fields, refreshtoken.FieldFamilyID)
+	}
+	if m.user != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldFamilyID:
+		return m.FamilyID()
+	case refreshtoken.FieldUserID:
+		return m.UserID()
+	case refreshtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case refreshtoken.FieldUsedAt:
+		return m.UsedAt()
+	case refreshtoken.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case refreshtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case refreshtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case refreshtoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case refreshtoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldFamilyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case refreshtoken.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case refreshtoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldUsedAt) {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.FieldCleared(refreshtoken.FieldRevokedAt) {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case refreshtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case refreshtoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case refreshtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case refreshtoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	created_at                *time.Time
+	updated_at                *time.Time
+	status                    *session.Status
+	deleted_at                *time.Time
+	title                     *string
+	description               *string
+	session_status            *session.SessionStatus
+	started_at                *time.Time
+	ended_at                  *time.Time
+	clearedFields             map[string]struct{}
+	user                      *uuid.UUID
+	cleareduser               bool
+	page_visits               map[uuid.UUID]struct{}
+	removedpage_visits        map[uuid.UUID]struct{}
+	clearedpage_visits        bool
+	highlights                map[uuid.UUID]struct{}
+	removedhighlights         map[uuid.UUID]struct{}
+	clearedhighlights         bool
+	raw_events                map[uuid.UUID]struct{}
+	removedraw_events         map[uuid.UUID]struct{}
+	clearedraw_events         bool
+	mindmap                   *uuid.UUID
+	clearedmindmap            bool
+	token_usage               map[uuid.UUID]struct{}
+	removedtoken_usage        map[uuid.UUID]struct{}
+	clearedtoken_usage        bool
+	ai_logs                   map[uuid.UUID]struct{}
+	removedai_logs            map[uuid.UUID]struct{}
+	clearedai_logs            bool
+	knowledge_mentions        map[uuid.UUID]struct{}
+	removedknowledge_mentions map[uuid.UUID]struct{}
+	clearedknowledge_mentions bool
+	done                      bool
+	oldValue                  func(context.Context) (*Session, error)
+	predicates                []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id uuid.UUID) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Session entities.
+func (m *SessionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SessionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SessionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SessionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SessionMutation) SetStatus(s session.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SessionMutation) Status() (r session.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldStatus(ctx context.Context) (v session.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SessionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SessionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SessionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SessionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[session.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SessionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SessionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, session.FieldDeletedAt)
+}
+
+// SetTitle sets the "title" field.
+func (m *SessionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SessionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *SessionMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[session.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *SessionMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[session.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SessionMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, session.FieldTitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *SessionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SessionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SessionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[session.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SessionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[session.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SessionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, session.FieldDescription)
+}
+
+// SetSessionStatus sets the "session_status" field.
+func (m *SessionMutation) SetSessionStatus(ss session.SessionStatus) {
+	m.session_status = &ss
+}
+
+// SessionStatus returns the value of the "session_status" field in the mutation.
+func (m *SessionMutation) SessionStatus() (r session.SessionStatus, exists bool) {
+	v := m.session_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionStatus returns the old "session_status" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldSessionStatus(ctx context.Context) (v session.SessionStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionStatus: %w", err)
+	}
+	return oldValue.SessionStatus, nil
+}
+
+// ResetSessionStatus resets all changes to the "session_status" field.
+func (m *SessionMutation) ResetSessionStatus() {
+	m.session_status = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *SessionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SessionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SessionMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *SessionMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *SessionMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *SessionMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[session.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *SessionMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *SessionMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, session.FieldEndedAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SessionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddPageVisitIDs adds the "page_visits" edge to the PageVisit entity by ids.
+func (m *SessionMutation) AddPageVisitIDs(ids ...uuid.UUID) {
+	if m.page_visits == nil {
+		m.page_visits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.page_visits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPageVisits clears the "page_visits" edge to the PageVisit entity.
+func (m *SessionMutation) ClearPageVisits() {
+	m.clearedpage_visits = true
+}
+
+// PageVisitsCleared reports if the "page_visits" edge to the PageVisit entity was cleared.
+func (m *SessionMutation) PageVisitsCleared() bool {
+	return m.clearedpage_visits
+}
+
+// RemovePageVisitIDs removes the "page_visits" edge to the PageVisit entity by IDs.
+func (m *SessionMutation) RemovePageVisitIDs(ids ...uuid.UUID) {
+	if m.removedpage_visits == nil {
+		m.removedpage_visits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.page_visits, ids[i])
+		m.removedpage_visits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPageVisits returns the removed IDs of the "page_visits" edge to the PageVisit entity.
+func (m *SessionMutation) RemovedPageVisitsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpage_visits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PageVisitsIDs returns the "page_visits" edge IDs in the mutation.
+func (m *SessionMutation) PageVisitsIDs() (ids []uuid.UUID) {
+	for id := range m.page_visits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPageVisits resets all changes to the "page_visits" edge.
+func (m *SessionMutation) ResetPageVisits() {
+	m.page_visits = nil
+	m.clearedpage_visits = false
+	m.removedpage_visits = nil
+}
+
+// AddHighlightIDs adds the "highlights" edge to the Highlight entity by ids.
+func (m *SessionMutation) AddHighlightIDs(ids ...uuid.UUID) {
+	if m.highlights == nil {
+		m.highlights = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.highlights[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHighlights clears the "highlights" edge to the Highlight entity.
+func (m *SessionMutation) ClearHighlights() {
+	m.clearedhighlights = true
+}
+
+// HighlightsCleared reports if the "highlights" edge to the Highlight entity was cleared.
+func (m *SessionMutation) HighlightsCleared() bool {
+	return m.clearedhighlights
+}
+
+// RemoveHighlightIDs removes the "highlights" edge to the Highlight entity by IDs.
+func (m *SessionMutation) RemoveHighlightIDs(ids ...uuid.UUID) {
+	if m.removedhighlights == nil {
+		m.removedhighlights = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.highlights, ids[i])
+		m.removedhighlights[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHighlights returns the removed IDs of the "highlights" edge to the Highlight entity.
+func (m *SessionMutation) RemovedHighlightsIDs() (ids []uuid.UUID) {
+	for id := range m.removedhighlights {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HighlightsIDs returns the "highlights" edge IDs in the mutation.
+func (m *SessionMutation) HighlightsIDs() (ids []uuid.UUID) {
+	for id := range m.highlights {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHighlights resets all changes to the "highlights" edge.
+func (m *SessionMutation) ResetHighlights() {
+	m.highlights = nil
+	m.clearedhighlights = false
+	m.removedhighlights = nil
+}
+
+// AddRawEventIDs adds the "raw_events" edge to the RawEvent entity by ids.
+func (m *SessionMutation) AddRawEventIDs(ids ...uuid.UUID) {
+	if m.raw_events == nil {
+		m.raw_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.raw_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRawEvents clears the "raw_events" edge to the RawEvent entity.
+func (m *SessionMutation) ClearRawEvents() {
+	m.clearedraw_events = true
+}
+
+// RawEventsCleared reports if the "raw_events" edge to the RawEvent entity was cleared.
+func (m *SessionMutation) RawEventsCleared() bool {
+	return m.clearedraw_events
+}
+
+// RemoveRawEventIDs removes the "raw_events" edge to the RawEvent entity by IDs.
+func (m *SessionMutation) RemoveRawEventIDs(ids ...uuid.UUID) {
+	if m.removedraw_events == nil {
+		m.removedraw_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.raw_events, ids[i])
+		m.removedraw_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRawEvents returns the removed IDs of the "raw_events" edge to the RawEvent entity.
+func (m *SessionMutation) RemovedRawEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedraw_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RawEventsIDs returns the "raw_events" edge IDs in the mutation.
+func (m *SessionMutation) RawEventsIDs() (ids []uuid.UUID) {
+	for id := range m.raw_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRawEvents resets all changes to the "raw_events" edge.
+func (m *SessionMutation) ResetRawEvents() {
+	m.raw_events = nil
+	m.clearedraw_events = false
+	m.removedraw_events = nil
+}
+
+// SetMindmapID sets the "mindmap" edge to the MindmapGraph entity by id.
+func (m *SessionMutation) SetMindmapID(id uuid.UUID) {
+	m.mindmap = &id
+}
+
+// ClearMindmap clears the "mindmap" edge to the MindmapGraph entity.
+func (m *SessionMutation) ClearMindmap() {
+	m.clearedmindmap = true
+}
+
+// MindmapCleared reports if the "mindmap" edge to the MindmapGraph entity was cleared.
+func (m *SessionMutation) MindmapCleared() bool {
+	return m.clearedmindmap
+}
+
+// MindmapID returns the "mindmap" edge ID in the mutation.
+func (m *SessionMutation) MindmapID() (id uuid.UUID, exists bool) {
+	if m.mindmap != nil {
+		return *m.mindmap, true
+	}
+	return
+}
+
+// MindmapIDs returns the "mindmap" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MindmapID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) MindmapIDs() (ids []uuid.UUID) {
+	if id := m.mindmap; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMindmap resets all changes to the "mindmap" edge.
+func (m *SessionMutation) ResetMindmap() {
+	m.mindmap = nil
+	m.clearedmindmap = false
+}
+
+// AddTokenUsageIDs adds the "token_usage" edge to the TokenUsage entity by ids.
+func (m *SessionMutation) AddTokenUsageIDs(ids ...uuid.UUID) {
+	if m.token_usage == nil {
+		m.token_usage = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.token_usage[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTokenUsage clears the "token_usage" edge to the TokenUsage entity.
+func (m *SessionMutation) ClearTokenUsage() {
+	m.clearedtoken_usage = true
+}
+
+// TokenUsageCleared reports if the "token_usage" edge to the TokenUsage entity was cleared.
+func (m *SessionMutation) TokenUsageCleared() bool {
+	return m.clearedtoken_usage
+}
+
+// RemoveTokenUsageIDs removes the "token_usage" edge to the TokenUsage entity by IDs.
+func (m *SessionMutation) RemoveTokenUsageIDs(ids ...uuid.UUID) {
+	if m.removedtoken_usage == nil {
+		m.removedtoken_usage = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.token_usage, ids[i])
+		m.removedtoken_usage[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTokenUsage returns the removed IDs of the "token_usage" edge to the TokenUsage entity.
+func (m *SessionMutation) RemovedTokenUsageIDs() (ids []uuid.UUID) {
+	for id := range m.removedtoken_usage {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TokenUsageIDs returns the "token_usage" edge IDs in the mutation.
+func (m *SessionMutation) TokenUsageIDs() (ids []uuid.UUID) {
+	for id := range m.token_usage {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTokenUsage resets all changes to the "token_usage" edge.
+func (m *SessionMutation) ResetTokenUsage() {
+	m.token_usage = nil
+	m.clearedtoken_usage = false
+	m.removedtoken_usage = nil
+}
+
+// AddAiLogIDs adds the "ai_logs" edge to the AILog entity by ids.
+func (m *SessionMutation) AddAiLogIDs(ids ...uuid.UUID) {
+	if m.ai_logs == nil {
+		m.ai_logs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.ai_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAiLogs clears the "ai_logs" edge to the AILog entity.
+func (m *SessionMutation) ClearAiLogs() {
+	m.clearedai_logs = true
+}
+
+// AiLogsCleared reports if the "ai_logs" edge to the AILog entity was cleared.
+func (m *SessionMutation) AiLogsCleared() bool {
+	return m.clearedai_logs
+}
+
+// RemoveAiLogIDs removes the "ai_logs" edge to the AILog entity by IDs.
+func (m *SessionMutation) RemoveAiLogIDs(ids ...uuid.UUID) {
+	if m.removedai_logs == nil {
+		m.removedai_logs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.ai_logs, ids[i])
+		m.removedai_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAiLogs returns the removed IDs of the "ai_logs" edge to the AILog entity.
+func (m *SessionMutation) RemovedAiLogsIDs() (ids []uuid.UUID) {
+	for id := range m.removedai_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AiLogsIDs returns the "ai_logs" edge IDs in the mutation.
+func (m *SessionMutation) AiLogsIDs() (ids []uuid.UUID) {
+	for id := range m.ai_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAiLogs resets all changes to the "ai_logs" edge.
+func (m *SessionMutation) ResetAiLogs() {
+	m.ai_logs = nil
+	m.clearedai_logs = false
+	m.removedai_logs = nil
+}
+
+// AddKnowledgeMentionIDs adds the "knowledge_mentions" edge to the KnowledgeMention entity by ids.
+func (m *SessionMutation) AddKnowledgeMentionIDs(ids ...uuid.UUID) {
+	if m.knowledge_mentions == nil {
+		m.knowledge_mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.knowledge_mentions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKnowledgeMentions clears the "knowledge_mentions" edge to the KnowledgeMention entity.
+func (m *SessionMutation) ClearKnowledgeMentions() {
+	m.clearedknowledge_mentions = true
+}
+
+// KnowledgeMentionsCleared reports if the "knowledge_mentions" edge to the KnowledgeMention entity was cleared.
+func (m *SessionMutation) KnowledgeMentionsCleared() bool {
+	return m.clearedknowledge_mentions
+}
+
+// RemoveKnowledgeMentionIDs removes the "knowledge_mentions" edge to the KnowledgeMention entity by IDs.
+func (m *SessionMutation) RemoveKnowledgeMentionIDs(ids ...uuid.UUID) {
+	if m.removedknowledge_mentions == nil {
+		m.removedknowledge_mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.knowledge_mentions, ids[i])
+		m.removedknowledge_mentions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKnowledgeMentions returns the removed IDs of the "knowledge_mentions" edge to the KnowledgeMention entity.
+func (m *SessionMutation) RemovedKnowledgeMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedknowledge_mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KnowledgeMentionsIDs returns the "knowledge_mentions" edge IDs in the mutation.
+func (m *SessionMutation) KnowledgeMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.knowledge_mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKnowledgeMentions resets all changes to the "knowledge_mentions" edge.
+func (m *SessionMutation) ResetKnowledgeMentions() {
+	m.knowledge_mentions = nil
+	m.clearedknowledge_mentions = false
+	m.removedknowledge_mentions = nil
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, session.FieldUpdatedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, session.FieldStatus)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, session.FieldDeletedAt)
+	}
+	if m.title != nil {
+		fields = append(fields, session.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, session.FieldDescription)
+	}
+	if m.session_status != nil {
+		fields = append(fields, session.FieldSessionStatus)
+	}
+	if m.started_at != nil {
+		fields = append(fields, session.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, session.FieldEndedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case session.FieldStatus:
+		return m.Status()
+	case session.FieldDeletedAt:
+		return m.DeletedAt()
+	case session.FieldTitle:
+		return m.Title()
+	case session.FieldDescription:
+		return m.Description()
+	case session.FieldSessionStatus:
+		return m.SessionStatus()
+	case session.FieldStartedAt:
+		return m.StartedAt()
+	case session.FieldEndedAt:
+		return m.EndedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case session.FieldStatus:
+		return m.OldStatus(ctx)
+	case session.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case session.FieldTitle:
+		return m.OldTitle(ctx)
+	case session.FieldDescription:
+		return m.OldDescription(ctx)
+	case session.FieldSessionStatus:
+		return m.OldSessionStatus(ctx)
+	case session.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case session.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case session.FieldStatus:
+		v, ok := value.(session.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case session.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case session.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case session.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case session.FieldSessionStatus:
+		v, ok := value.(session.SessionStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionStatus(v)
+		return nil
+	case session.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case session.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldDeletedAt) {
+		fields = append(fields, session.FieldDeletedAt)
+	}
+	if m.FieldCleared(session.FieldTitle) {
+		fields = append(fields, session.FieldTitle)
+	}
+	if m.FieldCleared(session.FieldDescription) {
+		fields = append(fields, session.FieldDescription)
+	}
+	if m.FieldCleared(session.FieldEndedAt) {
+		fields = append(fields, session.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case session.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case session.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case session.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case session.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case session.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case session.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case session.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case session.FieldSessionStatus:
+		m.ResetSessionStatus()
+		return nil
+	case session.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case session.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	if m.page_visits != nil {
+		edges = append(edges, session.EdgePageVisits)
+	}
+	if m.highlights != nil {
+		edges = append(edges, session.EdgeHighlights)
+	}
+	if m.raw_events != nil {
+		edges = append(edges, session.EdgeRawEvents)
+	}
+	if m.mindmap != nil {
+		edges = append(edges, session.EdgeMindmap)
+	}
+	if m.token_usage != nil {
+		edges = append(edges, session.EdgeTokenUsage)
+	}
+	if m.ai_logs != nil {
+		edges = append(edges, session.EdgeAiLogs)
+	}
+	if m.knowledge_mentions != nil {
+		edges = append(edges, session.EdgeKnowledgeMentions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case session.EdgePageVisits:
+		ids := make([]ent.Value, 0, len(m.page_visits))
+		for id := range m.page_visits {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeHighlights:
+		ids := make([]ent.Value, 0, len(m.highlights))
+		for id := range m.highlights {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeRawEvents:
+		ids := make([]ent.Value, 0, len(m.raw_events))
+		for id := range m.raw_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeMindmap:
+		if id := m.mindmap; id != nil {
+			return []ent.Value{*id}
+		}
+	case session.EdgeTokenUsage:
+		ids := make([]ent.Value, 0, len(m.token_usage))
+		for id := range m.token_usage {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeAiLogs:
+		ids := make([]ent.Value, 0, len(m.ai_logs))
+		for id := range m.ai_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeKnowledgeMentions:
+		ids := make([]ent.Value, 0, len(m.knowledge_mentions))
+		for id := range m.knowledge_mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedpage_visits != nil {
+		edges = append(edges, session.EdgePageVisits)
+	}
+	if m.removedhighlights != nil {
+		edges = append(edges, session.EdgeHighlights)
+	}
+	if m.removedraw_events != nil {
+		edges = append(edges, session.EdgeRawEvents)
+	}
+	if m.removedtoken_usage != nil {
+		edges = append(edges, session.EdgeTokenUsage)
+	}
+	if m.removedai_logs != nil {
+		edges = append(edges, session.EdgeAiLogs)
+	}
+	if m.removedknowledge_mentions != nil {
+		edges = append(edges, session.EdgeKnowledgeMentions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgePageVisits:
+		ids := make([]ent.Value, 0, len(m.removedpage_visits))
+		for id := range m.removedpage_visits {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeHighlights:
+		ids := make([]ent.Value, 0, len(m.removedhighlights))
+		for id := range m.removedhighlights {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeRawEvents:
+		ids := make([]ent.Value, 0, len(m.removedraw_events))
+		for id := range m.removedraw_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeTokenUsage:
+		ids := make([]ent.Value, 0, len(m.removedtoken_usage))
+		for id := range m.removedtoken_usage {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeAiLogs:
+		ids := make([]ent.Value, 0, len(m.removedai_logs))
+		for id := range m.removedai_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case session.EdgeKnowledgeMentions:
+		ids := make([]ent.Value, 0, len(m.removedknowledge_mentions))
+		for id := range m.removedknowledge_mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	if m.clearedpage_visits {
+		edges = append(edges, session.EdgePageVisits)
+	}
+	if m.clearedhighlights {
+		edges = append(edges, session.EdgeHighlights)
+	}
+	if m.clearedraw_events {
+		edges = append(edges, session.EdgeRawEvents)
+	}
+	if m.clearedmindmap {
+		edges = append(edges, session.EdgeMindmap)
+	}
+	if m.clearedtoken_usage {
+		edges = append(edges, session.EdgeTokenUsage)
+	}
+	if m.clearedai_logs {
+		edges = append(edges, session.EdgeAiLogs)
+	}
+	if m.clearedknowledge_mentions {
+		edges = append(edges, session.EdgeKnowledgeMentions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	case session.EdgePageVisits:
+		return m.clearedpage_visits
+	case session.EdgeHighlights:
+		return m.clearedhighlights
+	case session.EdgeRawEvents:
+		return m.clearedraw_events
+	case session.EdgeMindmap:
+		return m.clearedmindmap
+	case session.EdgeTokenUsage:
+		return m.clearedtoken_usage
+	case session.EdgeAiLogs:
+		return m.clearedai_logs
+	case session.EdgeKnowledgeMentions:
+		return m.clearedknowledge_mentions
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RawEventMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetEventType sets the "event_type" field.
-func (m *RawEventMutation) SetEventType(s string) {
-	m.event_type = &s
+	return false
 }
 
-// EventType returns the value of the "event_type" field in the mutation.
-func (m *RawEventMutation) EventType() (r string, exists bool) {
-	v := m.event_type
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	case session.EdgeMindmap:
+		m.ClearMindmap()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
 
-// OldEventType returns the old "event_type" field's value of the RawEvent entity.
-// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RawEventMutation) OldEventType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	case session.EdgePageVisits:
+		m.ResetPageVisits()
+		return nil
+	case session.EdgeHighlights:
+		m.ResetHighlights()
+		return nil
+	case session.EdgeRawEvents:
+		m.ResetRawEvents()
+		return nil
+	case session.EdgeMindmap:
+		m.ResetMindmap()
+		return nil
+	case session.EdgeTokenUsage:
+		m.ResetTokenUsage()
+		return nil
+	case session.EdgeAiLogs:
+		m.ResetAiLogs()
+		return nil
+	case session.EdgeKnowledgeMentions:
+		m.ResetKnowledgeMentions()
+		return nil
 	}
-	return oldValue.EventType, nil
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
-// ResetEventType resets all changes to the "event_type" field.
-func (m *RawEventMutation) ResetEventType() {
-	m.event_type = nil
+// StripeEventMutation represents an operation that mutates the StripeEvent nodes in the graph.
+type StripeEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	_type         *string
+	processed_at  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*StripeEvent, error)
+	predicates    []predicate.StripeEvent
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *RawEventMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
-}
+var _ ent.Mutation = (*StripeEventMutation)(nil)
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *RawEventMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// stripeeventOption allows management of the mutation configuration using functional options.
+type stripeeventOption func(*StripeEventMutation)
 
-// OldTimestamp returns the old "timestamp" field's value of the RawEvent entity.
-// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RawEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+// newStripeEventMutation creates new mutation for the StripeEvent entity.
+func newStripeEventMutation(c config, op Op, opts ...stripeeventOption) *StripeEventMutation {
+	m := &StripeEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStripeEvent,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Timestamp, nil
-}
-
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *RawEventMutation) ResetTimestamp() {
-	m.timestamp = nil
+	return m
 }
 
-// SetPayload sets the "payload" field.
-func (m *RawEventMutation) SetPayload(s string) {
-	m.payload = &s
+// withStripeEventID sets the ID field of the mutation.
+func withStripeEventID(id string) stripeeventOption {
+	return func(m *StripeEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StripeEvent
+		)
+		m.oldValue = func(ctx context.Context) (*StripeEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StripeEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Payload returns the value of the "payload" field in the mutation.
-func (m *RawEventMutation) Payload() (r string, exists bool) {
-	v := m.payload
-	if v == nil {
-		return
+// withStripeEvent sets the old StripeEvent of the mutation.
+func withStripeEvent(node *StripeEvent) stripeeventOption {
+	return func(m *StripeEventMutation) {
+		m.oldValue = func(context.Context) (*StripeEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldPayload returns the old "payload" field's value of the RawEvent entity.
-// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RawEventMutation) OldPayload(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayload requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
-	}
-	return oldValue.Payload, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StripeEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetPayload resets all changes to the "payload" field.
-func (m *RawEventMutation) ResetPayload() {
-	m.payload = nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StripeEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetProcessed sets the "processed" field.
-func (m *RawEventMutation) SetProcessed(b bool) {
-	m.processed = &b
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of StripeEvent entities.
+func (m *StripeEventMutation) SetID(id string) {
+	m.id = &id
 }
 
-// Processed returns the value of the "processed" field in the mutation.
-func (m *RawEventMutation) Processed() (r bool, exists bool) {
-	v := m.processed
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StripeEventMutation) ID() (id string, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldProcessed returns the old "processed" field's value of the RawEvent entity.
-// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RawEventMutation) OldProcessed(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessed is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessed requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessed: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StripeEventMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StripeEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Processed, nil
-}
-
-// ResetProcessed resets all changes to the "processed" field.
-func (m *RawEventMutation) ResetProcessed() {
-	m.processed = nil
 }
 
-// SetProcessedAt sets the "processed_at" field.
-func (m *RawEventMutation) SetProcessedAt(t time.Time) {
-	m.processed_at = &t
+// SetType sets the "type" field.
+func (m *StripeEventMutation) SetType(s string) {
+	m._type = &s
 }
 
-// ProcessedAt returns the value of the "processed_at" field in the mutation.
-func (m *RawEventMutation) ProcessedAt() (r time.Time, exists bool) {
-	v := m.processed_at
+// GetType returns the value of the "type" field in the mutation.
+func (m *StripeEventMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessedAt returns the old "processed_at" field's value of the RawEvent entity.
-// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the StripeEvent entity.
+// If the StripeEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RawEventMutation) OldProcessedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *StripeEventMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.ProcessedAt, nil
-}
-
-// ClearProcessedAt clears the value of the "processed_at" field.
-func (m *RawEventMutation) ClearProcessedAt() {
-	m.processed_at = nil
-	m.clearedFields[rawevent.FieldProcessedAt] = struct{}{}
-}
-
-// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
-func (m *RawEventMutation) ProcessedAtCleared() bool {
-	_, ok := m.clearedFields[rawevent.FieldProcessedAt]
-	return ok
-}
-
-// ResetProcessedAt resets all changes to the "processed_at" field.
-func (m *RawEventMutation) ResetProcessedAt() {
-	m.processed_at = nil
-	delete(m.clearedFields, rawevent.FieldProcessedAt)
-}
-
-// SetSessionID sets the "session" edge to the Session entity by id.
-func (m *RawEventMutation) SetSessionID(id uuid.UUID) {
-	m.session = &id
+	return oldValue.Type, nil
 }
 
-// ClearSession clears the "session" edge to the Session entity.
-func (m *RawEventMutation) ClearSession() {
-	m.clearedsession = true
+// ResetType resets all changes to the "type" field.
+func (m *StripeEventMutation) ResetType() {
+	m._type = nil
 }
 
-// SessionCleared reports if the "session" edge to the Session entity was cleared.
-func (m *RawEventMutation) SessionCleared() bool {
-	return m.clearedsession
+// SetProcessedAt sets the "processed_at" field.
+func (m *StripeEventMutation) SetProcessedAt(t time.Time) {
+	m.processed_at = &t
 }
 
-// SessionID returns the "session" edge ID in the mutation.
-func (m *RawEventMutation) SessionID() (id uuid.UUID, exists bool) {
-	if m.session != nil {
-		return *m.session, true
+// ProcessedAt returns the value of the "processed_at" field in the mutation.
+func (m *StripeEventMutation) ProcessedAt() (r time.Time, exists bool) {
+	v := m.processed_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SessionIDs returns the "session" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SessionID instead. It exists only for internal usage by the builders.
-func (m *RawEventMutation) SessionIDs() (ids []uuid.UUID) {
-	if id := m.session; id != nil {
-		ids = append(ids, *id)
+// OldProcessedAt returns the old "processed_at" field's value of the StripeEvent entity.
+// If the StripeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StripeEventMutation) OldProcessedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+	}
+	return oldValue.ProcessedAt, nil
 }
 
-// ResetSession resets all changes to the "session" edge.
-func (m *RawEventMutation) ResetSession() {
-	m.session = nil
-	m.clearedsession = false
+// ResetProcessedAt resets all changes to the "processed_at" field.
+func (m *StripeEventMutation) ResetProcessedAt() {
+	m.processed_at = nil
 }
 
-// Where appends a list predicates to the RawEventMutation builder.
-func (m *RawEventMutation) Where(ps ...predicate.RawEvent) {
+// Where appends a list predicates to the StripeEventMutation builder.
+func (m *StripeEventMutation) Where(ps ...predicate.StripeEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RawEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StripeEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RawEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RawEvent, len(ps))
+func (m *StripeEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StripeEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7603,45 +16242,30 @@ func (m *RawEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RawEventMutation) Op() Op {
+func (m *StripeEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RawEventMutation) SetOp(op Op) {
+func (m *StripeEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RawEvent).
-func (m *RawEventMutation) Type() string {
+// Type returns the node type of this mutation (StripeEvent).
+func (m *StripeEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RawEventMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.created_at != nil {
-		fields = append(fields, rawevent.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, rawevent.FieldUpdatedAt)
-	}
-	if m.event_type != nil {
-		fields = append(fields, rawevent.FieldEventType)
-	}
-	if m.timestamp != nil {
-		fields = append(fields, rawevent.FieldTimestamp)
-	}
-	if m.payload != nil {
-		fields = append(fields, rawevent.FieldPayload)
-	}
-	if m.processed != nil {
-		fields = append(fields, rawevent.FieldProcessed)
+func (m *StripeEventMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m._type != nil {
+		fields = append(fields, stripeevent.FieldType)
 	}
 	if m.processed_at != nil {
-		fields = append(fields, rawevent.FieldProcessedAt)
+		fields = append(fields, stripeevent.FieldProcessedAt)
 	}
 	return fields
 }
@@ -7649,21 +16273,11 @@ func (m *RawEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RawEventMutation) Field(name string) (ent.Value, bool) {
+func (m *StripeEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case rawevent.FieldCreatedAt:
-		return m.CreatedAt()
-	case rawevent.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case rawevent.FieldEventType:
-		return m.EventType()
-	case rawevent.FieldTimestamp:
-		return m.Timestamp()
-	case rawevent.FieldPayload:
-		return m.Payload()
-	case rawevent.FieldProcessed:
-		return m.Processed()
-	case rawevent.FieldProcessedAt:
+	case stripeevent.FieldType:
+		return m.GetType()
+	case stripeevent.FieldProcessedAt:
 		return m.ProcessedAt()
 	}
 	return nil, false
@@ -7672,74 +16286,29 @@ func (m *RawEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RawEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StripeEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case rawevent.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case rawevent.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case rawevent.FieldEventType:
-		return m.OldEventType(ctx)
-	case rawevent.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case rawevent.FieldPayload:
-		return m.OldPayload(ctx)
-	case rawevent.FieldProcessed:
-		return m.OldProcessed(ctx)
-	case rawevent.FieldProcessedAt:
+	case stripeevent.FieldType:
+		return m.OldType(ctx)
+	case stripeevent.FieldProcessedAt:
 		return m.OldProcessedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RawEvent field %s", name)
+	return nil, fmt.Errorf("unknown StripeEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RawEventMutation) SetField(name string, value ent.Value) error {
+func (m *StripeEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case rawevent.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case rawevent.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case rawevent.FieldEventType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEventType(v)
-		return nil
-	case rawevent.FieldTimestamp:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimestamp(v)
-		return nil
-	case rawevent.FieldPayload:
+	case stripeevent.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPayload(v)
-		return nil
-	case rawevent.FieldProcessed:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessed(v)
+		m.SetType(v)
 		return nil
-	case rawevent.FieldProcessedAt:
+	case stripeevent.FieldProcessedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -7747,213 +16316,148 @@ func (m *RawEventMutation) SetField(name string, value ent.Value) error {
 		m.SetProcessedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RawEvent field %s", name)
+	return fmt.Errorf("unknown StripeEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RawEventMutation) AddedFields() []string {
+func (m *StripeEventMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RawEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StripeEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RawEventMutation) AddField(name string, value ent.Value) error {
+func (m *StripeEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown RawEvent numeric field %s", name)
+	return fmt.Errorf("unknown StripeEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RawEventMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(rawevent.FieldProcessedAt) {
-		fields = append(fields, rawevent.FieldProcessedAt)
-	}
-	return fields
+func (m *StripeEventMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RawEventMutation) FieldCleared(name string) bool {
+func (m *StripeEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RawEventMutation) ClearField(name string) error {
-	switch name {
-	case rawevent.FieldProcessedAt:
-		m.ClearProcessedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown RawEvent nullable field %s", name)
+func (m *StripeEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StripeEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RawEventMutation) ResetField(name string) error {
+func (m *StripeEventMutation) ResetField(name string) error {
 	switch name {
-	case rawevent.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case rawevent.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case rawevent.FieldEventType:
-		m.ResetEventType()
-		return nil
-	case rawevent.FieldTimestamp:
-		m.ResetTimestamp()
-		return nil
-	case rawevent.FieldPayload:
-		m.ResetPayload()
-		return nil
-	case rawevent.FieldProcessed:
-		m.ResetProcessed()
+	case stripeevent.FieldType:
+		m.ResetType()
 		return nil
-	case rawevent.FieldProcessedAt:
+	case stripeevent.FieldProcessedAt:
 		m.ResetProcessedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RawEvent field %s", name)
+	return fmt.Errorf("unknown StripeEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RawEventMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.session != nil {
-		edges = append(edges, rawevent.EdgeSession)
-	}
+func (m *StripeEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RawEventMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case rawevent.EdgeSession:
-		if id := m.session; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *StripeEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RawEventMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *StripeEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RawEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *StripeEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RawEventMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsession {
-		edges = append(edges, rawevent.EdgeSession)
-	}
+func (m *StripeEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RawEventMutation) EdgeCleared(name string) bool {
-	switch name {
-	case rawevent.EdgeSession:
-		return m.clearedsession
-	}
+func (m *StripeEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RawEventMutation) ClearEdge(name string) error {
-	switch name {
-	case rawevent.EdgeSession:
-		m.ClearSession()
-		return nil
-	}
-	return fmt.Errorf("unknown RawEvent unique edge %s", name)
+func (m *StripeEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StripeEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RawEventMutation) ResetEdge(name string) error {
-	switch name {
-	case rawevent.EdgeSession:
-		m.ResetSession()
-		return nil
-	}
-	return fmt.Errorf("unknown RawEvent edge %s", name)
+func (m *StripeEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StripeEvent edge %s", name)
 }
 
-// SessionMutation represents an operation that mutates the Session nodes in the graph.
-type SessionMutation struct {
+// SubscriptionMutation represents an operation that mutates the Subscription nodes in the graph.
+type SubscriptionMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	status             *session.Status
-	deleted_at         *time.Time
-	title              *string
-	description        *string
-	session_status     *session.SessionStatus
-	started_at         *time.Time
-	ended_at           *time.Time
-	clearedFields      map[string]struct{}
-	user               *uuid.UUID
-	cleareduser        bool
-	page_visits        map[uuid.UUID]struct{}
-	removedpage_visits map[uuid.UUID]struct{}
-	clearedpage_visits bool
-	highlights         map[uuid.UUID]struct{}
-	removedhighlights  map[uuid.UUID]struct{}
-	clearedhighlights  bool
-	raw_events         map[uuid.UUID]struct{}
-	removedraw_events  map[uuid.UUID]struct{}
-	clearedraw_events  bool
-	mindmap            *uuid.UUID
-	clearedmindmap     bool
-	token_usage        map[uuid.UUID]struct{}
-	removedtoken_usage map[uuid.UUID]struct{}
-	clearedtoken_usage bool
-	ai_logs            map[uuid.UUID]struct{}
-	removedai_logs     map[uuid.UUID]struct{}
-	clearedai_logs     bool
-	done               bool
-	oldValue           func(context.Context) (*Session, error)
-	predicates         []predicate.Session
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	created_at             *time.Time
+	updated_at             *time.Time
+	status                 *subscription.Status
+	current_period_start   *time.Time
+	current_period_end     *time.Time
+	cancel_at_period_end   *bool
+	stripe_subscription_id *string
+	stripe_customer_id     *string
+	stripe_event_at        *time.Time
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	plan                   *string
+	clearedplan            bool
+	done                   bool
+	oldValue               func(context.Context) (*Subscription, error)
+	predicates             []predicate.Subscription
 }
 
-var _ ent.Mutation = (*SessionMutation)(nil)
+var _ ent.Mutation = (*SubscriptionMutation)(nil)
 
-// sessionOption allows management of the mutation configuration using functional options.
-type sessionOption func(*SessionMutation)
+// subscriptionOption allows management of the mutation configuration using functional options.
+type subscriptionOption func(*SubscriptionMutation)
 
-// newSessionMutation creates new mutation for the Session entity.
-func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
-	m := &SessionMutation{
+// newSubscriptionMutation creates new mutation for the Subscription entity.
+func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
+	m := &SubscriptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSession,
+		typ:           TypeSubscription,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7962,20 +16466,20 @@ func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation
 	return m
 }
 
-// withSessionID sets the ID field of the mutation.
-func withSessionID(id uuid.UUID) sessionOption {
-	return func(m *SessionMutation) {
+// withSubscriptionID sets the ID field of the mutation.
+func withSubscriptionID(id uuid.UUID) subscriptionOption {
+	return func(m *SubscriptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Session
+			value *Subscription
 		)
-		m.oldValue = func(ctx context.Context) (*Session, error) {
+		m.oldValue = func(ctx context.Context) (*Subscription, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Session.Get(ctx, id)
+					value, err = m.Client().Subscription.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7984,10 +16488,10 @@ func withSessionID(id uuid.UUID) sessionOption {
 	}
 }
 
-// withSession sets the old Session of the mutation.
-func withSession(node *Session) sessionOption {
-	return func(m *SessionMutation) {
-		m.oldValue = func(context.Context) (*Session, error) {
+// withSubscription sets the old Subscription of the mutation.
+func withSubscription(node *Subscription) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		m.oldValue = func(context.Context) (*Subscription, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7996,7 +16500,7 @@ func withSession(node *Session) sessionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SessionMutation) Client() *Client {
+func (m SubscriptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8004,7 +16508,7 @@ func (m SessionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SessionMutation) Tx() (*Tx, error) {
+func (m SubscriptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8014,772 +16518,489 @@ func (m SessionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Session entities.
-func (m *SessionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Subscription entities.
+func (m *SubscriptionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *SessionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SessionMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SessionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SessionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SessionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *SessionMutation) SetStatus(s session.Status) {
-	m.status = &s
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *SessionMutation) Status() (r session.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldStatus(ctx context.Context) (v session.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *SessionMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (m *SessionMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
-}
-
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *SessionMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *SessionMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[session.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *SessionMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[session.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *SessionMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, session.FieldDeletedAt)
-}
-
-// SetTitle sets the "title" field.
-func (m *SessionMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *SessionMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldTitle(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ClearTitle clears the value of the "title" field.
-func (m *SessionMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[session.FieldTitle] = struct{}{}
-}
-
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *SessionMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[session.FieldTitle]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SubscriptionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *SessionMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, session.FieldTitle)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Subscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *SessionMutation) SetDescription(s string) {
-	m.description = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *SubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *SessionMutation) Description() (r string, exists bool) {
-	v := m.description
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *SessionMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[session.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *SessionMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[session.FieldDescription]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *SessionMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, session.FieldDescription)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetSessionStatus sets the "session_status" field.
-func (m *SessionMutation) SetSessionStatus(ss session.SessionStatus) {
-	m.session_status = &ss
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SubscriptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// SessionStatus returns the value of the "session_status" field in the mutation.
-func (m *SessionMutation) SessionStatus() (r session.SessionStatus, exists bool) {
-	v := m.session_status
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionStatus returns the old "session_status" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldSessionStatus(ctx context.Context) (v session.SessionStatus, err error) {
+func (m *SubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionStatus requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.SessionStatus, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSessionStatus resets all changes to the "session_status" field.
-func (m *SessionMutation) ResetSessionStatus() {
-	m.session_status = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SubscriptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *SessionMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetStatus sets the "status" field.
+func (m *SubscriptionMutation) SetStatus(s subscription.Status) {
+	m.status = &s
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *SessionMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// Status returns the value of the "status" field in the mutation.
+func (m *SubscriptionMutation) Status() (r subscription.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SubscriptionMutation) OldStatus(ctx context.Context) (v subscription.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.Status, nil
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *SessionMutation) ResetStartedAt() {
-	m.started_at = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *SubscriptionMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetEndedAt sets the "ended_at" field.
-func (m *SessionMutation) SetEndedAt(t time.Time) {
-	m.ended_at = &t
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (m *SubscriptionMutation) SetCurrentPeriodStart(t time.Time) {
+	m.current_period_start = &t
 }
 
-// EndedAt returns the value of the "ended_at" field in the mutation.
-func (m *SessionMutation) EndedAt() (r time.Time, exists bool) {
-	v := m.ended_at
+// CurrentPeriodStart returns the value of the "current_period_start" field in the mutation.
+func (m *SubscriptionMutation) CurrentPeriodStart() (r time.Time, exists bool) {
+	v := m.current_period_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndedAt returns the old "ended_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrentPeriodStart returns the old "current_period_start" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SubscriptionMutation) OldCurrentPeriodStart(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrentPeriodStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+		return v, errors.New("OldCurrentPeriodStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrentPeriodStart: %w", err)
 	}
-	return oldValue.EndedAt, nil
-}
-
-// ClearEndedAt clears the value of the "ended_at" field.
-func (m *SessionMutation) ClearEndedAt() {
-	m.ended_at = nil
-	m.clearedFields[session.FieldEndedAt] = struct{}{}
-}
-
-// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
-func (m *SessionMutation) EndedAtCleared() bool {
-	_, ok := m.clearedFields[session.FieldEndedAt]
-	return ok
-}
-
-// ResetEndedAt resets all changes to the "ended_at" field.
-func (m *SessionMutation) ResetEndedAt() {
-	m.ended_at = nil
-	delete(m.clearedFields, session.FieldEndedAt)
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *SessionMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *SessionMutation) ClearUser() {
-	m.cleareduser = true
+	return oldValue.CurrentPeriodStart, nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SessionMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetCurrentPeriodStart resets all changes to the "current_period_start" field.
+func (m *SubscriptionMutation) ResetCurrentPeriodStart() {
+	m.current_period_start = nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (m *SubscriptionMutation) SetCurrentPeriodEnd(t time.Time) {
+	m.current_period_end = &t
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// CurrentPeriodEnd returns the value of the "current_period_end" field in the mutation.
+func (m *SubscriptionMutation) CurrentPeriodEnd() (r time.Time, exists bool) {
+	v := m.current_period_end
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *SessionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+	return *v, true
 }
 
-// AddPageVisitIDs adds the "page_visits" edge to the PageVisit entity by ids.
-func (m *SessionMutation) AddPageVisitIDs(ids ...uuid.UUID) {
-	if m.page_visits == nil {
-		m.page_visits = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.page_visits[ids[i]] = struct{}{}
+// OldCurrentPeriodEnd returns the old "current_period_end" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCurrentPeriodEnd(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentPeriodEnd is only allowed on UpdateOne operations")
 	}
-}
-
-// ClearPageVisits clears the "page_visits" edge to the PageVisit entity.
-func (m *SessionMutation) ClearPageVisits() {
-	m.clearedpage_visits = true
-}
-
-// PageVisitsCleared reports if the "page_visits" edge to the PageVisit entity was cleared.
-func (m *SessionMutation) PageVisitsCleared() bool {
-	return m.clearedpage_visits
-}
-
-// RemovePageVisitIDs removes the "page_visits" edge to the PageVisit entity by IDs.
-func (m *SessionMutation) RemovePageVisitIDs(ids ...uuid.UUID) {
-	if m.removedpage_visits == nil {
-		m.removedpage_visits = make(map[uuid.UUID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentPeriodEnd requires an ID field in the mutation")
 	}
-	for i := range ids {
-		delete(m.page_visits, ids[i])
-		m.removedpage_visits[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentPeriodEnd: %w", err)
 	}
+	return oldValue.CurrentPeriodEnd, nil
 }
 
-// RemovedPageVisits returns the removed IDs of the "page_visits" edge to the PageVisit entity.
-func (m *SessionMutation) RemovedPageVisitsIDs() (ids []uuid.UUID) {
-	for id := range m.removedpage_visits {
-		ids = append(ids, id)
-	}
-	return
+// ResetCurrentPeriodEnd resets all changes to the "current_period_end" field.
+func (m *SubscriptionMutation) ResetCurrentPeriodEnd() {
+	m.current_period_end = nil
 }
 
-// PageVisitsIDs returns the "page_visits" edge IDs in the mutation.
-func (m *SessionMutation) PageVisitsIDs() (ids []uuid.UUID) {
-	for id := range m.page_visits {
-		ids = append(ids, id)
-	}
-	return
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (m *SubscriptionMutation) SetCancelAtPeriodEnd(b bool) {
+	m.cancel_at_period_end = &b
 }
 
-// ResetPageVisits resets all changes to the "page_visits" edge.
-func (m *SessionMutation) ResetPageVisits() {
-	m.page_visits = nil
-	m.clearedpage_visits = false
-	m.removedpage_visits = nil
+// CancelAtPeriodEnd returns the value of the "cancel_at_period_end" field in the mutation.
+func (m *SubscriptionMutation) CancelAtPeriodEnd() (r bool, exists bool) {
+	v := m.cancel_at_period_end
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddHighlightIDs adds the "highlights" edge to the Highlight entity by ids.
-func (m *SessionMutation) AddHighlightIDs(ids ...uuid.UUID) {
-	if m.highlights == nil {
-		m.highlights = make(map[uuid.UUID]struct{})
+// OldCancelAtPeriodEnd returns the old "cancel_at_period_end" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCancelAtPeriodEnd(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelAtPeriodEnd is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.highlights[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelAtPeriodEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelAtPeriodEnd: %w", err)
 	}
+	return oldValue.CancelAtPeriodEnd, nil
 }
 
-// ClearHighlights clears the "highlights" edge to the Highlight entity.
-func (m *SessionMutation) ClearHighlights() {
-	m.clearedhighlights = true
+// ResetCancelAtPeriodEnd resets all changes to the "cancel_at_period_end" field.
+func (m *SubscriptionMutation) ResetCancelAtPeriodEnd() {
+	m.cancel_at_period_end = nil
 }
 
-// HighlightsCleared reports if the "highlights" edge to the Highlight entity was cleared.
-func (m *SessionMutation) HighlightsCleared() bool {
-	return m.clearedhighlights
+// SetStripeSubscriptionID sets the "stripe_subscription_id" field.
+func (m *SubscriptionMutation) SetStripeSubscriptionID(s string) {
+	m.stripe_subscription_id = &s
 }
 
-// RemoveHighlightIDs removes the "highlights" edge to the Highlight entity by IDs.
-func (m *SessionMutation) RemoveHighlightIDs(ids ...uuid.UUID) {
-	if m.removedhighlights == nil {
-		m.removedhighlights = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.highlights, ids[i])
-		m.removedhighlights[ids[i]] = struct{}{}
+// StripeSubscriptionID returns the value of the "stripe_subscription_id" field in the mutation.
+func (m *SubscriptionMutation) StripeSubscriptionID() (r string, exists bool) {
+	v := m.stripe_subscription_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedHighlights returns the removed IDs of the "highlights" edge to the Highlight entity.
-func (m *SessionMutation) RemovedHighlightsIDs() (ids []uuid.UUID) {
-	for id := range m.removedhighlights {
-		ids = append(ids, id)
+// OldStripeSubscriptionID returns the old "stripe_subscription_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldStripeSubscriptionID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStripeSubscriptionID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// HighlightsIDs returns the "highlights" edge IDs in the mutation.
-func (m *SessionMutation) HighlightsIDs() (ids []uuid.UUID) {
-	for id := range m.highlights {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStripeSubscriptionID requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStripeSubscriptionID: %w", err)
+	}
+	return oldValue.StripeSubscriptionID, nil
 }
 
-// ResetHighlights resets all changes to the "highlights" edge.
-func (m *SessionMutation) ResetHighlights() {
-	m.highlights = nil
-	m.clearedhighlights = false
-	m.removedhighlights = nil
+// ClearStripeSubscriptionID clears the value of the "stripe_subscription_id" field.
+func (m *SubscriptionMutation) ClearStripeSubscriptionID() {
+	m.stripe_subscription_id = nil
+	m.clearedFields[subscription.FieldStripeSubscriptionID] = struct{}{}
 }
 
-// AddRawEventIDs adds the "raw_events" edge to the RawEvent entity by ids.
-func (m *SessionMutation) AddRawEventIDs(ids ...uuid.UUID) {
-	if m.raw_events == nil {
-		m.raw_events = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.raw_events[ids[i]] = struct{}{}
-	}
+// StripeSubscriptionIDCleared returns if the "stripe_subscription_id" field was cleared in this mutation.
+func (m *SubscriptionMutation) StripeSubscriptionIDCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldStripeSubscriptionID]
+	return ok
 }
 
-// ClearRawEvents clears the "raw_events" edge to the RawEvent entity.
-func (m *SessionMutation) ClearRawEvents() {
-	m.clearedraw_events = true
+// ResetStripeSubscriptionID resets all changes to the "stripe_subscription_id" field.
+func (m *SubscriptionMutation) ResetStripeSubscriptionID() {
+	m.stripe_subscription_id = nil
+	delete(m.clearedFields, subscription.FieldStripeSubscriptionID)
 }
 
-// RawEventsCleared reports if the "raw_events" edge to the RawEvent entity was cleared.
-func (m *SessionMutation) RawEventsCleared() bool {
-	return m.clearedraw_events
+// SetStripeCustomerID sets the "stripe_customer_id" field.
+func (m *SubscriptionMutation) SetStripeCustomerID(s string) {
+	m.stripe_customer_id = &s
 }
 
-// RemoveRawEventIDs removes the "raw_events" edge to the RawEvent entity by IDs.
-func (m *SessionMutation) RemoveRawEventIDs(ids ...uuid.UUID) {
-	if m.removedraw_events == nil {
-		m.removedraw_events = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.raw_events, ids[i])
-		m.removedraw_events[ids[i]] = struct{}{}
+// StripeCustomerID returns the value of the "stripe_customer_id" field in the mutation.
+func (m *SubscriptionMutation) StripeCustomerID() (r string, exists bool) {
+	v := m.stripe_customer_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedRawEvents returns the removed IDs of the "raw_events" edge to the RawEvent entity.
-func (m *SessionMutation) RemovedRawEventsIDs() (ids []uuid.UUID) {
-	for id := range m.removedraw_events {
-		ids = append(ids, id)
+// OldStripeCustomerID returns the old "stripe_customer_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldStripeCustomerID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStripeCustomerID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// RawEventsIDs returns the "raw_events" edge IDs in the mutation.
-func (m *SessionMutation) RawEventsIDs() (ids []uuid.UUID) {
-	for id := range m.raw_events {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStripeCustomerID requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStripeCustomerID: %w", err)
+	}
+	return oldValue.StripeCustomerID, nil
 }
 
-// ResetRawEvents resets all changes to the "raw_events" edge.
-func (m *SessionMutation) ResetRawEvents() {
-	m.raw_events = nil
-	m.clearedraw_events = false
-	m.removedraw_events = nil
+// ClearStripeCustomerID clears the value of the "stripe_customer_id" field.
+func (m *SubscriptionMutation) ClearStripeCustomerID() {
+	m.stripe_customer_id = nil
+	m.clearedFields[subscription.FieldStripeCustomerID] = struct{}{}
 }
 
-// SetMindmapID sets the "mindmap" edge to the MindmapGraph entity by id.
-func (m *SessionMutation) SetMindmapID(id uuid.UUID) {
-	m.mindmap = &id
+// StripeCustomerIDCleared returns if the "stripe_customer_id" field was cleared in this mutation.
+func (m *SubscriptionMutation) StripeCustomerIDCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldStripeCustomerID]
+	return ok
 }
 
-// ClearMindmap clears the "mindmap" edge to the MindmapGraph entity.
-func (m *SessionMutation) ClearMindmap() {
-	m.clearedmindmap = true
+// ResetStripeCustomerID resets all changes to the "stripe_customer_id" field.
+func (m *SubscriptionMutation) ResetStripeCustomerID() {
+	m.stripe_customer_id = nil
+	delete(m.clearedFields, subscription.FieldStripeCustomerID)
 }
 
-// MindmapCleared reports if the "mindmap" edge to the MindmapGraph entity was cleared.
-func (m *SessionMutation) MindmapCleared() bool {
-	return m.clearedmindmap
+// SetStripeEventAt sets the "stripe_event_at" field.
+func (m *SubscriptionMutation) SetStripeEventAt(t time.Time) {
+	m.stripe_event_at = &t
 }
 
-// MindmapID returns the "mindmap" edge ID in the mutation.
-func (m *SessionMutation) MindmapID() (id uuid.UUID, exists bool) {
-	if m.mindmap != nil {
-		return *m.mindmap, true
+// StripeEventAt returns the value of the "stripe_event_at" field in the mutation.
+func (m *SubscriptionMutation) StripeEventAt() (r time.Time, exists bool) {
+	v := m.stripe_event_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MindmapIDs returns the "mindmap" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MindmapID instead. It exists only for internal usage by the builders.
-func (m *SessionMutation) MindmapIDs() (ids []uuid.UUID) {
-	if id := m.mindmap; id != nil {
-		ids = append(ids, *id)
+// OldStripeEventAt returns the old "stripe_event_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldStripeEventAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStripeEventAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStripeEventAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStripeEventAt: %w", err)
+	}
+	return oldValue.StripeEventAt, nil
 }
 
-// ResetMindmap resets all changes to the "mindmap" edge.
-func (m *SessionMutation) ResetMindmap() {
-	m.mindmap = nil
-	m.clearedmindmap = false
+// ClearStripeEventAt clears the value of the "stripe_event_at" field.
+func (m *SubscriptionMutation) ClearStripeEventAt() {
+	m.stripe_event_at = nil
+	m.clearedFields[subscription.FieldStripeEventAt] = struct{}{}
 }
 
-// AddTokenUsageIDs adds the "token_usage" edge to the TokenUsage entity by ids.
-func (m *SessionMutation) AddTokenUsageIDs(ids ...uuid.UUID) {
-	if m.token_usage == nil {
-		m.token_usage = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.token_usage[ids[i]] = struct{}{}
-	}
+// StripeEventAtCleared returns if the "stripe_event_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) StripeEventAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldStripeEventAt]
+	return ok
 }
 
-// ClearTokenUsage clears the "token_usage" edge to the TokenUsage entity.
-func (m *SessionMutation) ClearTokenUsage() {
-	m.clearedtoken_usage = true
+// ResetStripeEventAt resets all changes to the "stripe_event_at" field.
+func (m *SubscriptionMutation) ResetStripeEventAt() {
+	m.stripe_event_at = nil
+	delete(m.clearedFields, subscription.FieldStripeEventAt)
 }
 
-// TokenUsageCleared reports if the "token_usage" edge to the TokenUsage entity was cleared.
-func (m *SessionMutation) TokenUsageCleared() bool {
-	return m.clearedtoken_usage
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SubscriptionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SubscriptionMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// RemoveTokenUsageIDs removes the "token_usage" edge to the TokenUsage entity by IDs.
-func (m *SessionMutation) RemoveTokenUsageIDs(ids ...uuid.UUID) {
-	if m.removedtoken_usage == nil {
-		m.removedtoken_usage = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.token_usage, ids[i])
-		m.removedtoken_usage[ids[i]] = struct{}{}
-	}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SubscriptionMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// RemovedTokenUsage returns the removed IDs of the "token_usage" edge to the TokenUsage entity.
-func (m *SessionMutation) RemovedTokenUsageIDs() (ids []uuid.UUID) {
-	for id := range m.removedtoken_usage {
-		ids = append(ids, id)
+// UserID returns the "user" edge ID in the mutation.
+func (m *SubscriptionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// TokenUsageIDs returns the "token_usage" edge IDs in the mutation.
-func (m *SessionMutation) TokenUsageIDs() (ids []uuid.UUID) {
-	for id := range m.token_usage {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SubscriptionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTokenUsage resets all changes to the "token_usage" edge.
-func (m *SessionMutation) ResetTokenUsage() {
-	m.token_usage = nil
-	m.clearedtoken_usage = false
-	m.removedtoken_usage = nil
-}
-
-// AddAiLogIDs adds the "ai_logs" edge to the AILog entity by ids.
-func (m *SessionMutation) AddAiLogIDs(ids ...uuid.UUID) {
-	if m.ai_logs == nil {
-		m.ai_logs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.ai_logs[ids[i]] = struct{}{}
-	}
+// ResetUser resets all changes to the "user" edge.
+func (m *SubscriptionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// ClearAiLogs clears the "ai_logs" edge to the AILog entity.
-func (m *SessionMutation) ClearAiLogs() {
-	m.clearedai_logs = true
+// SetPlanID sets the "plan" edge to the Plan entity by id.
+func (m *SubscriptionMutation) SetPlanID(id string) {
+	m.plan = &id
 }
 
-// AiLogsCleared reports if the "ai_logs" edge to the AILog entity was cleared.
-func (m *SessionMutation) AiLogsCleared() bool {
-	return m.clearedai_logs
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (m *SubscriptionMutation) ClearPlan() {
+	m.clearedplan = true
 }
 
-// RemoveAiLogIDs removes the "ai_logs" edge to the AILog entity by IDs.
-func (m *SessionMutation) RemoveAiLogIDs(ids ...uuid.UUID) {
-	if m.removedai_logs == nil {
-		m.removedai_logs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.ai_logs, ids[i])
-		m.removedai_logs[ids[i]] = struct{}{}
-	}
+// PlanCleared reports if the "plan" edge to the Plan entity was cleared.
+func (m *SubscriptionMutation) PlanCleared() bool {
+	return m.clearedplan
 }
 
-// RemovedAiLogs returns the removed IDs of the "ai_logs" edge to the AILog entity.
-func (m *SessionMutation) RemovedAiLogsIDs() (ids []uuid.UUID) {
-	for id := range m.removedai_logs {
-		ids = append(ids, id)
+// PlanID returns the "plan" edge ID in the mutation.
+func (m *SubscriptionMutation) PlanID() (id string, exists bool) {
+	if m.plan != nil {
+		return *m.plan, true
 	}
 	return
 }
 
-// AiLogsIDs returns the "ai_logs" edge IDs in the mutation.
-func (m *SessionMutation) AiLogsIDs() (ids []uuid.UUID) {
-	for id := range m.ai_logs {
-		ids = append(ids, id)
+// PlanIDs returns the "plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlanID instead. It exists only for internal usage by the builders.
+func (m *SubscriptionMutation) PlanIDs() (ids []string) {
+	if id := m.plan; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAiLogs resets all changes to the "ai_logs" edge.
-func (m *SessionMutation) ResetAiLogs() {
-	m.ai_logs = nil
-	m.clearedai_logs = false
-	m.removedai_logs = nil
+// ResetPlan resets all changes to the "plan" edge.
+func (m *SubscriptionMutation) ResetPlan() {
+	m.plan = nil
+	m.clearedplan = false
 }
 
-// Where appends a list predicates to the SessionMutation builder.
-func (m *SessionMutation) Where(ps ...predicate.Session) {
+// Where appends a list predicates to the SubscriptionMutation builder.
+func (m *SubscriptionMutation) Where(ps ...predicate.Subscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Session, len(ps))
+func (m *SubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Subscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8787,51 +17008,51 @@ func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SessionMutation) Op() Op {
+func (m *SubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SessionMutation) SetOp(op Op) {
+func (m *SubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Session).
-func (m *SessionMutation) Type() string {
+// Type returns the node type of this mutation (Subscription).
+func (m *SubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SessionMutation) Fields() []string {
+func (m *SubscriptionMutation) Fields() []string {
 	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, session.FieldCreatedAt)
+		fields = append(fields, subscription.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, session.FieldUpdatedAt)
+		fields = append(fields, subscription.FieldUpdatedAt)
 	}
 	if m.status != nil {
-		fields = append(fields, session.FieldStatus)
+		fields = append(fields, subscription.FieldStatus)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, session.FieldDeletedAt)
+	if m.current_period_start != nil {
+		fields = append(fields, subscription.FieldCurrentPeriodStart)
 	}
-	if m.title != nil {
-		fields = append(fields, session.FieldTitle)
+	if m.current_period_end != nil {
+		fields = append(fields, subscription.FieldCurrentPeriodEnd)
 	}
-	if m.description != nil {
-		fields = append(fields, session.FieldDescription)
+	if m.cancel_at_period_end != nil {
+		fields = append(fields, subscription.FieldCancelAtPeriodEnd)
 	}
-	if m.session_status != nil {
-		fields = append(fields, session.FieldSessionStatus)
+	if m.stripe_subscription_id != nil {
+		fields = append(fields, subscription.FieldStripeSubscriptionID)
 	}
-	if m.started_at != nil {
-		fields = append(fields, session.FieldStartedAt)
+	if m.stripe_customer_id != nil {
+		fields = append(fields, subscription.FieldStripeCustomerID)
 	}
-	if m.ended_at != nil {
-		fields = append(fields, session.FieldEndedAt)
+	if m.stripe_event_at != nil {
+		fields = append(fields, subscription.FieldStripeEventAt)
 	}
 	return fields
 }
@@ -8839,26 +17060,26 @@ func (m *SessionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case session.FieldCreatedAt:
+	case subscription.FieldCreatedAt:
 		return m.CreatedAt()
-	case session.FieldUpdatedAt:
+	case subscription.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case session.FieldStatus:
+	case subscription.FieldStatus:
 		return m.Status()
-	case session.FieldDeletedAt:
-		return m.DeletedAt()
-	case session.FieldTitle:
-		return m.Title()
-	case session.FieldDescription:
-		return m.Description()
-	case session.FieldSessionStatus:
-		return m.SessionStatus()
-	case session.FieldStartedAt:
-		return m.StartedAt()
-	case session.FieldEndedAt:
-		return m.EndedAt()
+	case subscription.FieldCurrentPeriodStart:
+		return m.CurrentPeriodStart()
+	case subscription.FieldCurrentPeriodEnd:
+		return m.CurrentPeriodEnd()
+	case subscription.FieldCancelAtPeriodEnd:
+		return m.CancelAtPeriodEnd()
+	case subscription.FieldStripeSubscriptionID:
+		return m.StripeSubscriptionID()
+	case subscription.FieldStripeCustomerID:
+		return m.StripeCustomerID()
+	case subscription.FieldStripeEventAt:
+		return m.StripeEventAt()
 	}
 	return nil, false
 }
@@ -8866,464 +17087,325 @@ func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case session.FieldCreatedAt:
+	case subscription.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case session.FieldUpdatedAt:
+	case subscription.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case session.FieldStatus:
+	case subscription.FieldStatus:
 		return m.OldStatus(ctx)
-	case session.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case session.FieldTitle:
-		return m.OldTitle(ctx)
-	case session.FieldDescription:
-		return m.OldDescription(ctx)
-	case session.FieldSessionStatus:
-		return m.OldSessionStatus(ctx)
-	case session.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case session.FieldEndedAt:
-		return m.OldEndedAt(ctx)
+	case subscription.FieldCurrentPeriodStart:
+		return m.OldCurrentPeriodStart(ctx)
+	case subscription.FieldCurrentPeriodEnd:
+		return m.OldCurrentPeriodEnd(ctx)
+	case subscription.FieldCancelAtPeriodEnd:
+		return m.OldCancelAtPeriodEnd(ctx)
+	case subscription.FieldStripeSubscriptionID:
+		return m.OldStripeSubscriptionID(ctx)
+	case subscription.FieldStripeCustomerID:
+		return m.OldStripeCustomerID(ctx)
+	case subscription.FieldStripeEventAt:
+		return m.OldStripeEventAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Session field %s", name)
+	return nil, fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) SetField(name string, value ent.Value) error {
+func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case session.FieldCreatedAt:
+	case subscription.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case session.FieldUpdatedAt:
+	case subscription.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case session.FieldStatus:
-		v, ok := value.(session.Status)
+	case subscription.FieldStatus:
+		v, ok := value.(subscription.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case session.FieldDeletedAt:
+	case subscription.FieldCurrentPeriodStart:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetCurrentPeriodStart(v)
 		return nil
-	case session.FieldTitle:
-		v, ok := value.(string)
+	case subscription.FieldCurrentPeriodEnd:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetCurrentPeriodEnd(v)
 		return nil
-	case session.FieldDescription:
-		v, ok := value.(string)
+	case subscription.FieldCancelAtPeriodEnd:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetCancelAtPeriodEnd(v)
 		return nil
-	case session.FieldSessionStatus:
-		v, ok := value.(session.SessionStatus)
+	case subscription.FieldStripeSubscriptionID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionStatus(v)
+		m.SetStripeSubscriptionID(v)
 		return nil
-	case session.FieldStartedAt:
-		v, ok := value.(time.Time)
+	case subscription.FieldStripeCustomerID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetStripeCustomerID(v)
 		return nil
-	case session.FieldEndedAt:
+	case subscription.FieldStripeEventAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndedAt(v)
+		m.SetStripeEventAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SessionMutation) AddedFields() []string {
+func (m *SubscriptionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) AddField(name string, value ent.Value) error {
+func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Session numeric field %s", name)
+	return fmt.Errorf("unknown Subscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SessionMutation) ClearedFields() []string {
+func (m *SubscriptionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(session.FieldDeletedAt) {
-		fields = append(fields, session.FieldDeletedAt)
-	}
-	if m.FieldCleared(session.FieldTitle) {
-		fields = append(fields, session.FieldTitle)
+	if m.FieldCleared(subscription.FieldStripeSubscriptionID) {
+		fields = append(fields, subscription.FieldStripeSubscriptionID)
 	}
-	if m.FieldCleared(session.FieldDescription) {
-		fields = append(fields, session.FieldDescription)
+	if m.FieldCleared(subscription.FieldStripeCustomerID) {
+		fields = append(fields, subscription.FieldStripeCustomerID)
 	}
-	if m.FieldCleared(session.FieldEndedAt) {
-		fields = append(fields, session.FieldEndedAt)
+	if m.FieldCleared(subscription.FieldStripeEventAt) {
+		fields = append(fields, subscription.FieldStripeEventAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SessionMutation) FieldCleared(name string) bool {
+func (m *SubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SessionMutation) ClearField(name string) error {
+func (m *SubscriptionMutation) ClearField(name string) error {
 	switch name {
-	case session.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case session.FieldTitle:
-		m.ClearTitle()
+	case subscription.FieldStripeSubscriptionID:
+		m.ClearStripeSubscriptionID()
 		return nil
-	case session.FieldDescription:
-		m.ClearDescription()
+	case subscription.FieldStripeCustomerID:
+		m.ClearStripeCustomerID()
 		return nil
-	case session.FieldEndedAt:
-		m.ClearEndedAt()
+	case subscription.FieldStripeEventAt:
+		m.ClearStripeEventAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Session nullable field %s", name)
+	return fmt.Errorf("unknown Subscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SessionMutation) ResetField(name string) error {
+func (m *SubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case session.FieldCreatedAt:
+	case subscription.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case session.FieldUpdatedAt:
+	case subscription.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case session.FieldStatus:
+	case subscription.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case session.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case subscription.FieldCurrentPeriodStart:
+		m.ResetCurrentPeriodStart()
 		return nil
-	case session.FieldTitle:
-		m.ResetTitle()
+	case subscription.FieldCurrentPeriodEnd:
+		m.ResetCurrentPeriodEnd()
 		return nil
-	case session.FieldDescription:
-		m.ResetDescription()
+	case subscription.FieldCancelAtPeriodEnd:
+		m.ResetCancelAtPeriodEnd()
 		return nil
-	case session.FieldSessionStatus:
-		m.ResetSessionStatus()
+	case subscription.FieldStripeSubscriptionID:
+		m.ResetStripeSubscriptionID()
 		return nil
-	case session.FieldStartedAt:
-		m.ResetStartedAt()
+	case subscription.FieldStripeCustomerID:
+		m.ResetStripeCustomerID()
 		return nil
-	case session.FieldEndedAt:
-		m.ResetEndedAt()
+	case subscription.FieldStripeEventAt:
+		m.ResetStripeEventAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SessionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
+func (m *SubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, session.EdgeUser)
-	}
-	if m.page_visits != nil {
-		edges = append(edges, session.EdgePageVisits)
-	}
-	if m.highlights != nil {
-		edges = append(edges, session.EdgeHighlights)
-	}
-	if m.raw_events != nil {
-		edges = append(edges, session.EdgeRawEvents)
-	}
-	if m.mindmap != nil {
-		edges = append(edges, session.EdgeMindmap)
-	}
-	if m.token_usage != nil {
-		edges = append(edges, session.EdgeTokenUsage)
+		edges = append(edges, subscription.EdgeUser)
 	}
-	if m.ai_logs != nil {
-		edges = append(edges, session.EdgeAiLogs)
+	if m.plan != nil {
+		edges = append(edges, subscription.EdgePlan)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case session.EdgeUser:
+	case subscription.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case session.EdgePageVisits:
-		ids := make([]ent.Value, 0, len(m.page_visits))
-		for id := range m.page_visits {
-			ids = append(ids, id)
-		}
-		return ids
-	case session.EdgeHighlights:
-		ids := make([]ent.Value, 0, len(m.highlights))
-		for id := range m.highlights {
-			ids = append(ids, id)
-		}
-		return ids
-	case session.EdgeRawEvents:
-		ids := make([]ent.Value, 0, len(m.raw_events))
-		for id := range m.raw_events {
-			ids = append(ids, id)
-		}
-		return ids
-	case session.EdgeMindmap:
-		if id := m.mindmap; id != nil {
+	case subscription.EdgePlan:
+		if id := m.plan; id != nil {
 			return []ent.Value{*id}
 		}
-	case session.EdgeTokenUsage:
-		ids := make([]ent.Value, 0, len(m.token_usage))
-		for id := range m.token_usage {
-			ids = append(ids, id)
-		}
-		return ids
-	case session.EdgeAiLogs:
-		ids := make([]ent.Value, 0, len(m.ai_logs))
-		for id := range m.ai_logs {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SessionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.removedpage_visits != nil {
-		edges = append(edges, session.EdgePageVisits)
-	}
-	if m.removedhighlights != nil {
-		edges = append(edges, session.EdgeHighlights)
-	}
-	if m.removedraw_events != nil {
-		edges = append(edges, session.EdgeRawEvents)
-	}
-	if m.removedtoken_usage != nil {
-		edges = append(edges, session.EdgeTokenUsage)
-	}
-	if m.removedai_logs != nil {
-		edges = append(edges, session.EdgeAiLogs)
-	}
+func (m *SubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case session.EdgePageVisits:
-		ids := make([]ent.Value, 0, len(m.removedpage_visits))
-		for id := range m.removedpage_visits {
-			ids = append(ids, id)
-		}
-		return ids
-	case session.EdgeHighlights:
-		ids := make([]ent.Value, 0, len(m.removedhighlights))
-		for id := range m.removedhighlights {
-			ids = append(ids, id)
-		}
-		return ids
-	case session.EdgeRawEvents:
-		ids := make([]ent.Value, 0, len(m.removedraw_events))
-		for id := range m.removedraw_events {
-			ids = append(ids, id)
-		}
-		return ids
-	case session.EdgeTokenUsage:
-		ids := make([]ent.Value, 0, len(m.removedtoken_usage))
-		for id := range m.removedtoken_usage {
-			ids = append(ids, id)
-		}
-		return ids
-	case session.EdgeAiLogs:
-		ids := make([]ent.Value, 0, len(m.removedai_logs))
-		for id := range m.removedai_logs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SessionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
+func (m *SubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, session.EdgeUser)
-	}
-	if m.clearedpage_visits {
-		edges = append(edges, session.EdgePageVisits)
-	}
-	if m.clearedhighlights {
-		edges = append(edges, session.EdgeHighlights)
-	}
-	if m.clearedraw_events {
-		edges = append(edges, session.EdgeRawEvents)
-	}
-	if m.clearedmindmap {
-		edges = append(edges, session.EdgeMindmap)
-	}
-	if m.clearedtoken_usage {
-		edges = append(edges, session.EdgeTokenUsage)
+		edges = append(edges, subscription.EdgeUser)
 	}
-	if m.clearedai_logs {
-		edges = append(edges, session.EdgeAiLogs)
+	if m.clearedplan {
+		edges = append(edges, subscription.EdgePlan)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SessionMutation) EdgeCleared(name string) bool {
+func (m *SubscriptionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case session.EdgeUser:
+	case subscription.EdgeUser:
 		return m.cleareduser
-	case session.EdgePageVisits:
-		return m.clearedpage_visits
-	case session.EdgeHighlights:
-		return m.clearedhighlights
-	case session.EdgeRawEvents:
-		return m.clearedraw_events
-	case session.EdgeMindmap:
-		return m.clearedmindmap
-	case session.EdgeTokenUsage:
-		return m.clearedtoken_usage
-	case session.EdgeAiLogs:
-		return m.clearedai_logs
+	case subscription.EdgePlan:
+		return m.clearedplan
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SessionMutation) ClearEdge(name string) error {
+func (m *SubscriptionMutation) ClearEdge(name string) error {
 	switch name {
-	case session.EdgeUser:
+	case subscription.EdgeUser:
 		m.ClearUser()
 		return nil
-	case session.EdgeMindmap:
-		m.ClearMindmap()
+	case subscription.EdgePlan:
+		m.ClearPlan()
 		return nil
 	}
-	return fmt.Errorf("unknown Session unique edge %s", name)
+	return fmt.Errorf("unknown Subscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SessionMutation) ResetEdge(name string) error {
-	switch name {
-	case session.EdgeUser:
-		m.ResetUser()
-		return nil
-	case session.EdgePageVisits:
-		m.ResetPageVisits()
-		return nil
-	case session.EdgeHighlights:
-		m.ResetHighlights()
-		return nil
-	case session.EdgeRawEvents:
-		m.ResetRawEvents()
-		return nil
-	case session.EdgeMindmap:
-		m.ResetMindmap()
-		return nil
-	case session.EdgeTokenUsage:
-		m.ResetTokenUsage()
+// It returns an error if the edge is not defined in the schema.
+func (m *SubscriptionMutation) ResetEdge(name string) error {
+	switch name {
+	case subscription.EdgeUser:
+		m.ResetUser()
 		return nil
-	case session.EdgeAiLogs:
-		m.ResetAiLogs()
+	case subscription.EdgePlan:
+		m.ResetPlan()
 		return nil
 	}
-	return fmt.Errorf("unknown Session edge %s", name)
+	return fmt.Errorf("unknown Subscription edge %s", name)
 }
 
-// SubscriptionMutation represents an operation that mutates the Subscription nodes in the graph.
-type SubscriptionMutation struct {
+// TokenUsageMutation represents an operation that mutates the TokenUsage nodes in the graph.
+type TokenUsageMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	created_at             *time.Time
-	updated_at             *time.Time
-	status                 *subscription.Status
-	current_period_start   *time.Time
-	current_period_end     *time.Time
-	cancel_at_period_end   *bool
-	stripe_subscription_id *string
-	stripe_customer_id     *string
-	clearedFields          map[string]struct{}
-	user                   *uuid.UUID
-	cleareduser            bool
-	plan                   *string
-	clearedplan            bool
-	done                   bool
-	oldValue               func(context.Context) (*Subscription, error)
-	predicates             []predicate.Subscription
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	updated_at     *time.Time
+	operation      *string
+	tokens_used    *int
+	addtokens_used *int
+	ai_model       *string
+	period_start   *time.Time
+	clearedFields  map[string]struct{}
+	user           *uuid.UUID
+	cleareduser    bool
+	session        *uuid.UUID
+	clearedsession bool
+	done           bool
+	oldValue       func(context.Context) (*TokenUsage, error)
+	predicates     []predicate.TokenUsage
 }
 
-var _ ent.Mutation = (*SubscriptionMutation)(nil)
+var _ ent.Mutation = (*TokenUsageMutation)(nil)
 
-// subscriptionOption allows management of the mutation configuration using functional options.
-type subscriptionOption func(*SubscriptionMutation)
+// tokenusageOption allows management of the mutation configuration using functional options.
+type tokenusageOption func(*TokenUsageMutation)
 
-// newSubscriptionMutation creates new mutation for the Subscription entity.
-func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
-	m := &SubscriptionMutation{
+// newTokenUsageMutation creates new mutation for the TokenUsage entity.
+func newTokenUsageMutation(c config, op Op, opts ...tokenusageOption) *TokenUsageMutation {
+	m := &TokenUsageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSubscription,
+		typ:           TypeTokenUsage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9332,20 +17414,20 @@ func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *Subsc
 	return m
 }
 
-// withSubscriptionID sets the ID field of the mutation.
-func withSubscriptionID(id uuid.UUID) subscriptionOption {
-	return func(m *SubscriptionMutation) {
+// withTokenUsageID sets the ID field of the mutation.
+func withTokenUsageID(id uuid.UUID) tokenusageOption {
+	return func(m *TokenUsageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Subscription
+			value *TokenUsage
 		)
-		m.oldValue = func(ctx context.Context) (*Subscription, error) {
+		m.oldValue = func(ctx context.Context) (*TokenUsage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Subscription.Get(ctx, id)
+					value, err = m.Client().TokenUsage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9354,10 +17436,10 @@ func withSubscriptionID(id uuid.UUID) subscriptionOption {
 	}
 }
 
-// withSubscription sets the old Subscription of the mutation.
-func withSubscription(node *Subscription) subscriptionOption {
-	return func(m *SubscriptionMutation) {
-		m.oldValue = func(context.Context) (*Subscription, error) {
+// withTokenUsage sets the old TokenUsage of the mutation.
+func withTokenUsage(node *TokenUsage) tokenusageOption {
+	return func(m *TokenUsageMutation) {
+		m.oldValue = func(context.Context) (*TokenUsage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9366,7 +17448,7 @@ func withSubscription(node *Subscription) subscriptionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubscriptionMutation) Client() *Client {
+func (m TokenUsageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9374,7 +17456,7 @@ func (m SubscriptionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SubscriptionMutation) Tx() (*Tx, error) {
+func (m TokenUsageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9384,14 +17466,14 @@ func (m SubscriptionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Subscription entities.
-func (m *SubscriptionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TokenUsage entities.
+func (m *TokenUsageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SubscriptionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenUsageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9402,7 +17484,7 @@ func (m *SubscriptionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9411,19 +17493,19 @@ func (m *SubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Subscription.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TokenUsage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SubscriptionMutation) SetCreatedAt(t time.Time) {
+func (m *TokenUsageMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *TokenUsageMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -9431,10 +17513,10 @@ func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TokenUsageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -9449,17 +17531,17 @@ func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SubscriptionMutation) ResetCreatedAt() {
+func (m *TokenUsageMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SubscriptionMutation) SetUpdatedAt(t time.Time) {
+func (m *TokenUsageMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *TokenUsageMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -9467,10 +17549,10 @@ func (m *SubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TokenUsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -9485,269 +17567,204 @@ func (m *SubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SubscriptionMutation) ResetUpdatedAt() {
+func (m *TokenUsageMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *SubscriptionMutation) SetStatus(s subscription.Status) {
-	m.status = &s
+// SetOperation sets the "operation" field.
+func (m *TokenUsageMutation) SetOperation(s string) {
+	m.operation = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SubscriptionMutation) Status() (r subscription.Status, exists bool) {
-	v := m.status
+// Operation returns the value of the "operation" field in the mutation.
+func (m *TokenUsageMutation) Operation() (r string, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldStatus(ctx context.Context) (v subscription.Status, err error) {
+func (m *TokenUsageMutation) OldOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Operation, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SubscriptionMutation) ResetStatus() {
-	m.status = nil
+// ResetOperation resets all changes to the "operation" field.
+func (m *TokenUsageMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// SetCurrentPeriodStart sets the "current_period_start" field.
-func (m *SubscriptionMutation) SetCurrentPeriodStart(t time.Time) {
-	m.current_period_start = &t
+// SetTokensUsed sets the "tokens_used" field.
+func (m *TokenUsageMutation) SetTokensUsed(i int) {
+	m.tokens_used = &i
+	m.addtokens_used = nil
 }
 
-// CurrentPeriodStart returns the value of the "current_period_start" field in the mutation.
-func (m *SubscriptionMutation) CurrentPeriodStart() (r time.Time, exists bool) {
-	v := m.current_period_start
+// TokensUsed returns the value of the "tokens_used" field in the mutation.
+func (m *TokenUsageMutation) TokensUsed() (r int, exists bool) {
+	v := m.tokens_used
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrentPeriodStart returns the old "current_period_start" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldTokensUsed returns the old "tokens_used" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCurrentPeriodStart(ctx context.Context) (v time.Time, err error) {
+func (m *TokenUsageMutation) OldTokensUsed(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPeriodStart is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokensUsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPeriodStart requires an ID field in the mutation")
+		return v, errors.New("OldTokensUsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPeriodStart: %w", err)
-	}
-	return oldValue.CurrentPeriodStart, nil
-}
-
-// ResetCurrentPeriodStart resets all changes to the "current_period_start" field.
-func (m *SubscriptionMutation) ResetCurrentPeriodStart() {
-	m.current_period_start = nil
-}
-
-// SetCurrentPeriodEnd sets the "current_period_end" field.
-func (m *SubscriptionMutation) SetCurrentPeriodEnd(t time.Time) {
-	m.current_period_end = &t
-}
-
-// CurrentPeriodEnd returns the value of the "current_period_end" field in the mutation.
-func (m *SubscriptionMutation) CurrentPeriodEnd() (r time.Time, exists bool) {
-	v := m.current_period_end
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTokensUsed: %w", err)
 	}
-	return *v, true
+	return oldValue.TokensUsed, nil
 }
 
-// OldCurrentPeriodEnd returns the old "current_period_end" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCurrentPeriodEnd(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPeriodEnd is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPeriodEnd requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPeriodEnd: %w", err)
+// AddTokensUsed adds i to the "tokens_used" field.
+func (m *TokenUsageMutation) AddTokensUsed(i int) {
+	if m.addtokens_used != nil {
+		*m.addtokens_used += i
+	} else {
+		m.addtokens_used = &i
 	}
-	return oldValue.CurrentPeriodEnd, nil
-}
-
-// ResetCurrentPeriodEnd resets all changes to the "current_period_end" field.
-func (m *SubscriptionMutation) ResetCurrentPeriodEnd() {
-	m.current_period_end = nil
-}
-
-// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
-func (m *SubscriptionMutation) SetCancelAtPeriodEnd(b bool) {
-	m.cancel_at_period_end = &b
 }
 
-// CancelAtPeriodEnd returns the value of the "cancel_at_period_end" field in the mutation.
-func (m *SubscriptionMutation) CancelAtPeriodEnd() (r bool, exists bool) {
-	v := m.cancel_at_period_end
+// AddedTokensUsed returns the value that was added to the "tokens_used" field in this mutation.
+func (m *TokenUsageMutation) AddedTokensUsed() (r int, exists bool) {
+	v := m.addtokens_used
 	if v == nil {
 		return
 	}
-	return *v, true
-}
-
-// OldCancelAtPeriodEnd returns the old "cancel_at_period_end" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCancelAtPeriodEnd(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCancelAtPeriodEnd is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCancelAtPeriodEnd requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCancelAtPeriodEnd: %w", err)
-	}
-	return oldValue.CancelAtPeriodEnd, nil
+	return *v, true
 }
 
-// ResetCancelAtPeriodEnd resets all changes to the "cancel_at_period_end" field.
-func (m *SubscriptionMutation) ResetCancelAtPeriodEnd() {
-	m.cancel_at_period_end = nil
+// ResetTokensUsed resets all changes to the "tokens_used" field.
+func (m *TokenUsageMutation) ResetTokensUsed() {
+	m.tokens_used = nil
+	m.addtokens_used = nil
 }
 
-// SetStripeSubscriptionID sets the "stripe_subscription_id" field.
-func (m *SubscriptionMutation) SetStripeSubscriptionID(s string) {
-	m.stripe_subscription_id = &s
+// SetAiModel sets the "ai_model" field.
+func (m *TokenUsageMutation) SetAiModel(s string) {
+	m.ai_model = &s
 }
 
-// StripeSubscriptionID returns the value of the "stripe_subscription_id" field in the mutation.
-func (m *SubscriptionMutation) StripeSubscriptionID() (r string, exists bool) {
-	v := m.stripe_subscription_id
+// AiModel returns the value of the "ai_model" field in the mutation.
+func (m *TokenUsageMutation) AiModel() (r string, exists bool) {
+	v := m.ai_model
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStripeSubscriptionID returns the old "stripe_subscription_id" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldAiModel returns the old "ai_model" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldStripeSubscriptionID(ctx context.Context) (v *string, err error) {
+func (m *TokenUsageMutation) OldAiModel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStripeSubscriptionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAiModel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStripeSubscriptionID requires an ID field in the mutation")
+		return v, errors.New("OldAiModel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStripeSubscriptionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAiModel: %w", err)
 	}
-	return oldValue.StripeSubscriptionID, nil
+	return oldValue.AiModel, nil
 }
 
-// ClearStripeSubscriptionID clears the value of the "stripe_subscription_id" field.
-func (m *SubscriptionMutation) ClearStripeSubscriptionID() {
-	m.stripe_subscription_id = nil
-	m.clearedFields[subscription.FieldStripeSubscriptionID] = struct{}{}
+// ClearAiModel clears the value of the "ai_model" field.
+func (m *TokenUsageMutation) ClearAiModel() {
+	m.ai_model = nil
+	m.clearedFields[tokenusage.FieldAiModel] = struct{}{}
 }
 
-// StripeSubscriptionIDCleared returns if the "stripe_subscription_id" field was cleared in this mutation.
-func (m *SubscriptionMutation) StripeSubscriptionIDCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldStripeSubscriptionID]
+// AiModelCleared returns if the "ai_model" field was cleared in this mutation.
+func (m *TokenUsageMutation) AiModelCleared() bool {
+	_, ok := m.clearedFields[tokenusage.FieldAiModel]
 	return ok
 }
 
-// ResetStripeSubscriptionID resets all changes to the "stripe_subscription_id" field.
-func (m *SubscriptionMutation) ResetStripeSubscriptionID() {
-	m.stripe_subscription_id = nil
-	delete(m.clearedFields, subscription.FieldStripeSubscriptionID)
+// ResetAiModel resets all changes to the "ai_model" field.
+func (m *TokenUsageMutation) ResetAiModel() {
+	m.ai_model = nil
+	delete(m.clearedFields, tokenusage.FieldAiModel)
 }
 
-// SetStripeCustomerID sets the "stripe_customer_id" field.
-func (m *SubscriptionMutation) SetStripeCustomerID(s string) {
-	m.stripe_customer_id = &s
+// SetPeriodStart sets the "period_start" field.
+func (m *TokenUsageMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
 }
 
-// StripeCustomerID returns the value of the "stripe_customer_id" field in the mutation.
-func (m *SubscriptionMutation) StripeCustomerID() (r string, exists bool) {
-	v := m.stripe_customer_id
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *TokenUsageMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStripeCustomerID returns the old "stripe_customer_id" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodStart returns the old "period_start" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldStripeCustomerID(ctx context.Context) (v *string, err error) {
+func (m *TokenUsageMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStripeCustomerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStripeCustomerID requires an ID field in the mutation")
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStripeCustomerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
 	}
-	return oldValue.StripeCustomerID, nil
-}
-
-// ClearStripeCustomerID clears the value of the "stripe_customer_id" field.
-func (m *SubscriptionMutation) ClearStripeCustomerID() {
-	m.stripe_customer_id = nil
-	m.clearedFields[subscription.FieldStripeCustomerID] = struct{}{}
-}
-
-// StripeCustomerIDCleared returns if the "stripe_customer_id" field was cleared in this mutation.
-func (m *SubscriptionMutation) StripeCustomerIDCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldStripeCustomerID]
-	return ok
+	return oldValue.PeriodStart, nil
 }
 
-// ResetStripeCustomerID resets all changes to the "stripe_customer_id" field.
-func (m *SubscriptionMutation) ResetStripeCustomerID() {
-	m.stripe_customer_id = nil
-	delete(m.clearedFields, subscription.FieldStripeCustomerID)
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *TokenUsageMutation) ResetPeriodStart() {
+	m.period_start = nil
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *SubscriptionMutation) SetUserID(id uuid.UUID) {
+func (m *TokenUsageMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *SubscriptionMutation) ClearUser() {
+func (m *TokenUsageMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SubscriptionMutation) UserCleared() bool {
+func (m *TokenUsageMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *SubscriptionMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *TokenUsageMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -9757,7 +17774,7 @@ func (m *SubscriptionMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *SubscriptionMutation) UserIDs() (ids []uuid.UUID) {
+func (m *TokenUsageMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -9765,59 +17782,59 @@ func (m *SubscriptionMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *SubscriptionMutation) ResetUser() {
+func (m *TokenUsageMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// SetPlanID sets the "plan" edge to the Plan entity by id.
-func (m *SubscriptionMutation) SetPlanID(id string) {
-	m.plan = &id
+// SetSessionID sets the "session" edge to the Session entity by id.
+func (m *TokenUsageMutation) SetSessionID(id uuid.UUID) {
+	m.session = &id
 }
 
-// ClearPlan clears the "plan" edge to the Plan entity.
-func (m *SubscriptionMutation) ClearPlan() {
-	m.clearedplan = true
+// ClearSession clears the "session" edge to the Session entity.
+func (m *TokenUsageMutation) ClearSession() {
+	m.clearedsession = true
 }
 
-// PlanCleared reports if the "plan" edge to the Plan entity was cleared.
-func (m *SubscriptionMutation) PlanCleared() bool {
-	return m.clearedplan
+// SessionCleared reports if the "session" edge to the Session entity was cleared.
+func (m *TokenUsageMutation) SessionCleared() bool {
+	return m.clearedsession
 }
 
-// PlanID returns the "plan" edge ID in the mutation.
-func (m *SubscriptionMutation) PlanID() (id string, exists bool) {
-	if m.plan != nil {
-		return *m.plan, true
+// SessionID returns the "session" edge ID in the mutation.
+func (m *TokenUsageMutation) SessionID() (id uuid.UUID, exists bool) {
+	if m.session != nil {
+		return *m.session, true
 	}
 	return
 }
 
-// PlanIDs returns the "plan" edge IDs in the mutation.
+// SessionIDs returns the "session" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PlanID instead. It exists only for internal usage by the builders.
-func (m *SubscriptionMutation) PlanIDs() (ids []string) {
-	if id := m.plan; id != nil {
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *TokenUsageMutation) SessionIDs() (ids []uuid.UUID) {
+	if id := m.session; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPlan resets all changes to the "plan" edge.
-func (m *SubscriptionMutation) ResetPlan() {
-	m.plan = nil
-	m.clearedplan = false
+// ResetSession resets all changes to the "session" edge.
+func (m *TokenUsageMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
 }
 
-// Where appends a list predicates to the SubscriptionMutation builder.
-func (m *SubscriptionMutation) Where(ps ...predicate.Subscription) {
+// Where appends a list predicates to the TokenUsageMutation builder.
+func (m *TokenUsageMutation) Where(ps ...predicate.TokenUsage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SubscriptionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TokenUsageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Subscription, len(ps))
+func (m *TokenUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenUsage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9825,48 +17842,42 @@ func (m *SubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SubscriptionMutation) Op() Op {
+func (m *TokenUsageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SubscriptionMutation) SetOp(op Op) {
+func (m *TokenUsageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Subscription).
-func (m *SubscriptionMutation) Type() string {
+// Type returns the node type of this mutation (TokenUsage).
+func (m *TokenUsageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubscriptionMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *TokenUsageMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
-		fields = append(fields, subscription.FieldCreatedAt)
+		fields = append(fields, tokenusage.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, subscription.FieldUpdatedAt)
-	}
-	if m.status != nil {
-		fields = append(fields, subscription.FieldStatus)
-	}
-	if m.current_period_start != nil {
-		fields = append(fields, subscription.FieldCurrentPeriodStart)
+		fields = append(fields, tokenusage.FieldUpdatedAt)
 	}
-	if m.current_period_end != nil {
-		fields = append(fields, subscription.FieldCurrentPeriodEnd)
+	if m.operation != nil {
+		fields = append(fields, tokenusage.FieldOperation)
 	}
-	if m.cancel_at_period_end != nil {
-		fields = append(fields, subscription.FieldCancelAtPeriodEnd)
+	if m.tokens_used != nil {
+		fields = append(fields, tokenusage.FieldTokensUsed)
 	}
-	if m.stripe_subscription_id != nil {
-		fields = append(fields, subscription.FieldStripeSubscriptionID)
+	if m.ai_model != nil {
+		fields = append(fields, tokenusage.FieldAiModel)
 	}
-	if m.stripe_customer_id != nil {
-		fields = append(fields, subscription.FieldStripeCustomerID)
+	if m.period_start != nil {
+		fields = append(fields, tokenusage.FieldPeriodStart)
 	}
 	return fields
 }
@@ -9874,24 +17885,20 @@ func (m *SubscriptionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenUsageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case subscription.FieldCreatedAt:
+	case tokenusage.FieldCreatedAt:
 		return m.CreatedAt()
-	case subscription.FieldUpdatedAt:
+	case tokenusage.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case subscription.FieldStatus:
-		return m.Status()
-	case subscription.FieldCurrentPeriodStart:
-		return m.CurrentPeriodStart()
-	case subscription.FieldCurrentPeriodEnd:
-		return m.CurrentPeriodEnd()
-	case subscription.FieldCancelAtPeriodEnd:
-		return m.CancelAtPeriodEnd()
-	case subscription.FieldStripeSubscriptionID:
-		return m.StripeSubscriptionID()
-	case subscription.FieldStripeCustomerID:
-		return m.StripeCustomerID()
+	case tokenusage.FieldOperation:
+		return m.Operation()
+	case tokenusage.FieldTokensUsed:
+		return m.TokensUsed()
+	case tokenusage.FieldAiModel:
+		return m.AiModel()
+	case tokenusage.FieldPeriodStart:
+		return m.PeriodStart()
 	}
 	return nil, false
 }
@@ -9899,203 +17906,188 @@ func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case subscription.FieldCreatedAt:
+	case tokenusage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case subscription.FieldUpdatedAt:
+	case tokenusage.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case subscription.FieldStatus:
-		return m.OldStatus(ctx)
-	case subscription.FieldCurrentPeriodStart:
-		return m.OldCurrentPeriodStart(ctx)
-	case subscription.FieldCurrentPeriodEnd:
-		return m.OldCurrentPeriodEnd(ctx)
-	case subscription.FieldCancelAtPeriodEnd:
-		return m.OldCancelAtPeriodEnd(ctx)
-	case subscription.FieldStripeSubscriptionID:
-		return m.OldStripeSubscriptionID(ctx)
-	case subscription.FieldStripeCustomerID:
-		return m.OldStripeCustomerID(ctx)
+	case tokenusage.FieldOperation:
+		return m.OldOperation(ctx)
+	case tokenusage.FieldTokensUsed:
+		return m.OldTokensUsed(ctx)
+	case tokenusage.FieldAiModel:
+		return m.OldAiModel(ctx)
+	case tokenusage.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
 	}
-	return nil, fmt.Errorf("unknown Subscription field %s", name)
+	return nil, fmt.Errorf("unknown TokenUsage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
+func (m *TokenUsageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case subscription.FieldCreatedAt:
+	case tokenusage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case subscription.FieldUpdatedAt:
+	case tokenusage.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case subscription.FieldStatus:
-		v, ok := value.(subscription.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case subscription.FieldCurrentPeriodStart:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrentPeriodStart(v)
-		return nil
-	case subscription.FieldCurrentPeriodEnd:
-		v, ok := value.(time.Time)
+	case tokenusage.FieldOperation:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrentPeriodEnd(v)
+		m.SetOperation(v)
 		return nil
-	case subscription.FieldCancelAtPeriodEnd:
-		v, ok := value.(bool)
+	case tokenusage.FieldTokensUsed:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCancelAtPeriodEnd(v)
+		m.SetTokensUsed(v)
 		return nil
-	case subscription.FieldStripeSubscriptionID:
+	case tokenusage.FieldAiModel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStripeSubscriptionID(v)
+		m.SetAiModel(v)
 		return nil
-	case subscription.FieldStripeCustomerID:
-		v, ok := value.(string)
+	case tokenusage.FieldPeriodStart:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStripeCustomerID(v)
+		m.SetPeriodStart(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription field %s", name)
+	return fmt.Errorf("unknown TokenUsage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubscriptionMutation) AddedFields() []string {
-	return nil
+func (m *TokenUsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addtokens_used != nil {
+		fields = append(fields, tokenusage.FieldTokensUsed)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TokenUsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tokenusage.FieldTokensUsed:
+		return m.AddedTokensUsed()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
+func (m *TokenUsageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tokenusage.FieldTokensUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokensUsed(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Subscription numeric field %s", name)
+	return fmt.Errorf("unknown TokenUsage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubscriptionMutation) ClearedFields() []string {
+func (m *TokenUsageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(subscription.FieldStripeSubscriptionID) {
-		fields = append(fields, subscription.FieldStripeSubscriptionID)
-	}
-	if m.FieldCleared(subscription.FieldStripeCustomerID) {
-		fields = append(fields, subscription.FieldStripeCustomerID)
+	if m.FieldCleared(tokenusage.FieldAiModel) {
+		fields = append(fields, tokenusage.FieldAiModel)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubscriptionMutation) FieldCleared(name string) bool {
+func (m *TokenUsageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubscriptionMutation) ClearField(name string) error {
+func (m *TokenUsageMutation) ClearField(name string) error {
 	switch name {
-	case subscription.FieldStripeSubscriptionID:
-		m.ClearStripeSubscriptionID()
-		return nil
-	case subscription.FieldStripeCustomerID:
-		m.ClearStripeCustomerID()
+	case tokenusage.FieldAiModel:
+		m.ClearAiModel()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription nullable field %s", name)
+	return fmt.Errorf("unknown TokenUsage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubscriptionMutation) ResetField(name string) error {
+func (m *TokenUsageMutation) ResetField(name string) error {
 	switch name {
-	case subscription.FieldCreatedAt:
+	case tokenusage.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case subscription.FieldUpdatedAt:
+	case tokenusage.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case subscription.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case subscription.FieldCurrentPeriodStart:
-		m.ResetCurrentPeriodStart()
-		return nil
-	case subscription.FieldCurrentPeriodEnd:
-		m.ResetCurrentPeriodEnd()
+	case tokenusage.FieldOperation:
+		m.ResetOperation()
 		return nil
-	case subscription.FieldCancelAtPeriodEnd:
-		m.ResetCancelAtPeriodEnd()
+	case tokenusage.FieldTokensUsed:
+		m.ResetTokensUsed()
 		return nil
-	case subscription.FieldStripeSubscriptionID:
-		m.ResetStripeSubscriptionID()
+	case tokenusage.FieldAiModel:
+		m.ResetAiModel()
 		return nil
-	case subscription.FieldStripeCustomerID:
-		m.ResetStripeCustomerID()
+	case tokenusage.FieldPeriodStart:
+		m.ResetPeriodStart()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription field %s", name)
+	return fmt.Errorf("unknown TokenUsage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubscriptionMutation) AddedEdges() []string {
+func (m *TokenUsageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, subscription.EdgeUser)
+		edges = append(edges, tokenusage.EdgeUser)
 	}
-	if m.plan != nil {
-		edges = append(edges, subscription.EdgePlan)
+	if m.session != nil {
+		edges = append(edges, tokenusage.EdgeSession)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
+func (m *TokenUsageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case subscription.EdgeUser:
+	case tokenusage.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case subscription.EdgePlan:
-		if id := m.plan; id != nil {
+	case tokenusage.EdgeSession:
+		if id := m.session; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -10103,103 +18095,106 @@ func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubscriptionMutation) RemovedEdges() []string {
+func (m *TokenUsageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
+func (m *TokenUsageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubscriptionMutation) ClearedEdges() []string {
+func (m *TokenUsageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, subscription.EdgeUser)
+		edges = append(edges, tokenusage.EdgeUser)
 	}
-	if m.clearedplan {
-		edges = append(edges, subscription.EdgePlan)
+	if m.clearedsession {
+		edges = append(edges, tokenusage.EdgeSession)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubscriptionMutation) EdgeCleared(name string) bool {
+func (m *TokenUsageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case subscription.EdgeUser:
+	case tokenusage.EdgeUser:
 		return m.cleareduser
-	case subscription.EdgePlan:
-		return m.clearedplan
+	case tokenusage.EdgeSession:
+		return m.clearedsession
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubscriptionMutation) ClearEdge(name string) error {
+func (m *TokenUsageMutation) ClearEdge(name string) error {
 	switch name {
-	case subscription.EdgeUser:
+	case tokenusage.EdgeUser:
 		m.ClearUser()
 		return nil
-	case subscription.EdgePlan:
-		m.ClearPlan()
+	case tokenusage.EdgeSession:
+		m.ClearSession()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription unique edge %s", name)
+	return fmt.Errorf("unknown TokenUsage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubscriptionMutation) ResetEdge(name string) error {
+func (m *TokenUsageMutation) ResetEdge(name string) error {
 	switch name {
-	case subscription.EdgeUser:
+	case tokenusage.EdgeUser:
 		m.ResetUser()
 		return nil
-	case subscription.EdgePlan:
-		m.ResetPlan()
+	case tokenusage.EdgeSession:
+		m.ResetSession()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription edge %s", name)
+	return fmt.Errorf("unknown TokenUsage edge %s", name)
 }
 
-// TokenUsageMutation represents an operation that mutates the TokenUsage nodes in the graph.
-type TokenUsageMutation struct {
+// URLMutation represents an operation that mutates the URL nodes in the graph.
+type URLMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	created_at     *time.Time
-	updated_at     *time.Time
-	operation      *string
-	tokens_used    *int
-	addtokens_used *int
-	ai_model       *string
-	period_start   *time.Time
-	clearedFields  map[string]struct{}
-	user           *uuid.UUID
-	cleareduser    bool
-	session        *uuid.UUID
-	clearedsession bool
-	done           bool
-	oldValue       func(context.Context) (*TokenUsage, error)
-	predicates     []predicate.TokenUsage
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	created_at                *time.Time
+	updated_at                *time.Time
+	url                       *string
+	url_hash                  *string
+	crawled_at                *time.Time
+	clearedFields             map[string]struct{}
+	page_visits               map[uuid.UUID]struct{}
+	removedpage_visits        map[uuid.UUID]struct{}
+	clearedpage_visits        bool
+	contents                  map[uuid.UUID]struct{}
+	removedcontents           map[uuid.UUID]struct{}
+	clearedcontents           bool
+	knowledge_mentions        map[uuid.UUID]struct{}
+	removedknowledge_mentions map[uuid.UUID]struct{}
+	clearedknowledge_mentions bool
+	done                      bool
+	oldValue                  func(context.Context) (*URL, error)
+	predicates                []predicate.URL
 }
 
-var _ ent.Mutation = (*TokenUsageMutation)(nil)
+var _ ent.Mutation = (*URLMutation)(nil)
 
-// tokenusageOption allows management of the mutation configuration using functional options.
-type tokenusageOption func(*TokenUsageMutation)
+// urlOption allows management of the mutation configuration using functional options.
+type urlOption func(*URLMutation)
 
-// newTokenUsageMutation creates new mutation for the TokenUsage entity.
-func newTokenUsageMutation(c config, op Op, opts ...tokenusageOption) *TokenUsageMutation {
-	m := &TokenUsageMutation{
+// newURLMutation creates new mutation for the URL entity.
+func newURLMutation(c config, op Op, opts ...urlOption) *URLMutation {
+	m := &URLMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTokenUsage,
+		typ:           TypeURL,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10208,20 +18203,20 @@ func newTokenUsageMutation(c config, op Op, opts ...tokenusageOption) *TokenUsag
 	return m
 }
 
-// withTokenUsageID sets the ID field of the mutation.
-func withTokenUsageID(id uuid.UUID) tokenusageOption {
-	return func(m *TokenUsageMutation) {
+// withURLID sets the ID field of the mutation.
+func withURLID(id uuid.UUID) urlOption {
+	return func(m *URLMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TokenUsage
+			value *URL
 		)
-		m.oldValue = func(ctx context.Context) (*TokenUsage, error) {
+		m.oldValue = func(ctx context.Context) (*URL, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TokenUsage.Get(ctx, id)
+					value, err = m.Client().URL.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10230,10 +18225,10 @@ func withTokenUsageID(id uuid.UUID) tokenusageOption {
 	}
 }
 
-// withTokenUsage sets the old TokenUsage of the mutation.
-func withTokenUsage(node *TokenUsage) tokenusageOption {
-	return func(m *TokenUsageMutation) {
-		m.oldValue = func(context.Context) (*TokenUsage, error) {
+// withURL sets the old URL of the mutation.
+func withURL(node *URL) urlOption {
+	return func(m *URLMutation) {
+		m.oldValue = func(context.Context) (*URL, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10242,7 +18237,7 @@ func withTokenUsage(node *TokenUsage) tokenusageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenUsageMutation) Client() *Client {
+func (m URLMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10250,7 +18245,7 @@ func (m TokenUsageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenUsageMutation) Tx() (*Tx, error) {
+func (m URLMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10260,14 +18255,14 @@ func (m TokenUsageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TokenUsage entities.
-func (m *TokenUsageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of URL entities.
+func (m *URLMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenUsageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *URLMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10278,7 +18273,7 @@ func (m *TokenUsageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *URLMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10287,19 +18282,19 @@ func (m *TokenUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TokenUsage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().URL.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TokenUsageMutation) SetCreatedAt(t time.Time) {
+func (m *URLMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TokenUsageMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *URLMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10307,10 +18302,10 @@ func (m *TokenUsageMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TokenUsage entity.
-// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the URL entity.
+// If the URL object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenUsageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *URLMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10325,17 +18320,17 @@ func (m *TokenUsageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TokenUsageMutation) ResetCreatedAt() {
+func (m *URLMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TokenUsageMutation) SetUpdatedAt(t time.Time) {
+func (m *URLMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TokenUsageMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *URLMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -10343,10 +18338,10 @@ func (m *TokenUsageMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TokenUsage entity.
-// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the URL entity.
+// If the URL object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenUsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *URLMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -10361,274 +18356,302 @@ func (m *TokenUsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TokenUsageMutation) ResetUpdatedAt() {
+func (m *URLMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetOperation sets the "operation" field.
-func (m *TokenUsageMutation) SetOperation(s string) {
-	m.operation = &s
+// SetURL sets the "url" field.
+func (m *URLMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Operation returns the value of the "operation" field in the mutation.
-func (m *TokenUsageMutation) Operation() (r string, exists bool) {
-	v := m.operation
+// URL returns the value of the "url" field in the mutation.
+func (m *URLMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOperation returns the old "operation" field's value of the TokenUsage entity.
-// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the URL entity.
+// If the URL object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenUsageMutation) OldOperation(ctx context.Context) (v string, err error) {
+func (m *URLMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperation requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Operation, nil
+	return oldValue.URL, nil
 }
 
-// ResetOperation resets all changes to the "operation" field.
-func (m *TokenUsageMutation) ResetOperation() {
-	m.operation = nil
+// ResetURL resets all changes to the "url" field.
+func (m *URLMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetTokensUsed sets the "tokens_used" field.
-func (m *TokenUsageMutation) SetTokensUsed(i int) {
-	m.tokens_used = &i
-	m.addtokens_used = nil
+// SetURLHash sets the "url_hash" field.
+func (m *URLMutation) SetURLHash(s string) {
+	m.url_hash = &s
 }
 
-// TokensUsed returns the value of the "tokens_used" field in the mutation.
-func (m *TokenUsageMutation) TokensUsed() (r int, exists bool) {
-	v := m.tokens_used
+// URLHash returns the value of the "url_hash" field in the mutation.
+func (m *URLMutation) URLHash() (r string, exists bool) {
+	v := m.url_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokensUsed returns the old "tokens_used" field's value of the TokenUsage entity.
-// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldURLHash returns the old "url_hash" field's value of the URL entity.
+// If the URL object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenUsageMutation) OldTokensUsed(ctx context.Context) (v int, err error) {
+func (m *URLMutation) OldURLHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokensUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldURLHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokensUsed requires an ID field in the mutation")
+		return v, errors.New("OldURLHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokensUsed: %w", err)
-	}
-	return oldValue.TokensUsed, nil
-}
-
-// AddTokensUsed adds i to the "tokens_used" field.
-func (m *TokenUsageMutation) AddTokensUsed(i int) {
-	if m.addtokens_used != nil {
-		*m.addtokens_used += i
-	} else {
-		m.addtokens_used = &i
-	}
-}
-
-// AddedTokensUsed returns the value that was added to the "tokens_used" field in this mutation.
-func (m *TokenUsageMutation) AddedTokensUsed() (r int, exists bool) {
-	v := m.addtokens_used
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldURLHash: %w", err)
 	}
-	return *v, true
+	return oldValue.URLHash, nil
 }
 
-// ResetTokensUsed resets all changes to the "tokens_used" field.
-func (m *TokenUsageMutation) ResetTokensUsed() {
-	m.tokens_used = nil
-	m.addtokens_used = nil
+// ResetURLHash resets all changes to the "url_hash" field.
+func (m *URLMutation) ResetURLHash() {
+	m.url_hash = nil
 }
 
-// SetAiModel sets the "ai_model" field.
-func (m *TokenUsageMutation) SetAiModel(s string) {
-	m.ai_model = &s
+// SetCrawledAt sets the "crawled_at" field.
+func (m *URLMutation) SetCrawledAt(t time.Time) {
+	m.crawled_at = &t
 }
 
-// AiModel returns the value of the "ai_model" field in the mutation.
-func (m *TokenUsageMutation) AiModel() (r string, exists bool) {
-	v := m.ai_model
+// CrawledAt returns the value of the "crawled_at" field in the mutation.
+func (m *URLMutation) CrawledAt() (r time.Time, exists bool) {
+	v := m.crawled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiModel returns the old "ai_model" field's value of the TokenUsage entity.
-// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldCrawledAt returns the old "crawled_at" field's value of the URL entity.
+// If the URL object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenUsageMutation) OldAiModel(ctx context.Context) (v string, err error) {
+func (m *URLMutation) OldCrawledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldCrawledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiModel requires an ID field in the mutation")
+		return v, errors.New("OldCrawledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldCrawledAt: %w", err)
 	}
-	return oldValue.AiModel, nil
+	return oldValue.CrawledAt, nil
 }
 
-// ClearAiModel clears the value of the "ai_model" field.
-func (m *TokenUsageMutation) ClearAiModel() {
-	m.ai_model = nil
-	m.clearedFields[tokenusage.FieldAiModel] = struct{}{}
+// ClearCrawledAt clears the value of the "crawled_at" field.
+func (m *URLMutation) ClearCrawledAt() {
+	m.crawled_at = nil
+	m.clearedFields[url.FieldCrawledAt] = struct{}{}
 }
 
-// AiModelCleared returns if the "ai_model" field was cleared in this mutation.
-func (m *TokenUsageMutation) AiModelCleared() bool {
-	_, ok := m.clearedFields[tokenusage.FieldAiModel]
+// CrawledAtCleared returns if the "crawled_at" field was cleared in this mutation.
+func (m *URLMutation) CrawledAtCleared() bool {
+	_, ok := m.clearedFields[url.FieldCrawledAt]
 	return ok
 }
 
-// ResetAiModel resets all changes to the "ai_model" field.
-func (m *TokenUsageMutation) ResetAiModel() {
-	m.ai_model = nil
-	delete(m.clearedFields, tokenusage.FieldAiModel)
+// ResetCrawledAt resets all changes to the "crawled_at" field.
+func (m *URLMutation) ResetCrawledAt() {
+	m.crawled_at = nil
+	delete(m.clearedFields, url.FieldCrawledAt)
 }
 
-// SetPeriodStart sets the "period_start" field.
-func (m *TokenUsageMutation) SetPeriodStart(t time.Time) {
-	m.period_start = &t
+// AddPageVisitIDs adds the "page_visits" edge to the PageVisit entity by ids.
+func (m *URLMutation) AddPageVisitIDs(ids ...uuid.UUID) {
+	if m.page_visits == nil {
+		m.page_visits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.page_visits[ids[i]] = struct{}{}
+	}
 }
 
-// PeriodStart returns the value of the "period_start" field in the mutation.
-func (m *TokenUsageMutation) PeriodStart() (r time.Time, exists bool) {
-	v := m.period_start
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearPageVisits clears the "page_visits" edge to the PageVisit entity.
+func (m *URLMutation) ClearPageVisits() {
+	m.clearedpage_visits = true
 }
 
-// OldPeriodStart returns the old "period_start" field's value of the TokenUsage entity.
-// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenUsageMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
+// PageVisitsCleared reports if the "page_visits" edge to the PageVisit entity was cleared.
+func (m *URLMutation) PageVisitsCleared() bool {
+	return m.clearedpage_visits
+}
+
+// RemovePageVisitIDs removes the "page_visits" edge to the PageVisit entity by IDs.
+func (m *URLMutation) RemovePageVisitIDs(ids ...uuid.UUID) {
+	if m.removedpage_visits == nil {
+		m.removedpage_visits = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.page_visits, ids[i])
+		m.removedpage_visits[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
+}
+
+// RemovedPageVisits returns the removed IDs of the "page_visits" edge to the PageVisit entity.
+func (m *URLMutation) RemovedPageVisitsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpage_visits {
+		ids = append(ids, id)
 	}
-	return oldValue.PeriodStart, nil
+	return
 }
 
-// ResetPeriodStart resets all changes to the "period_start" field.
-func (m *TokenUsageMutation) ResetPeriodStart() {
-	m.period_start = nil
+// PageVisitsIDs returns the "page_visits" edge IDs in the mutation.
+func (m *URLMutation) PageVisitsIDs() (ids []uuid.UUID) {
+	for id := range m.page_visits {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *TokenUsageMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// ResetPageVisits resets all changes to the "page_visits" edge.
+func (m *URLMutation) ResetPageVisits() {
+	m.page_visits = nil
+	m.clearedpage_visits = false
+	m.removedpage_visits = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *TokenUsageMutation) ClearUser() {
-	m.cleareduser = true
+// AddContentIDs adds the "contents" edge to the URLContent entity by ids.
+func (m *URLMutation) AddContentIDs(ids ...uuid.UUID) {
+	if m.contents == nil {
+		m.contents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.contents[ids[i]] = struct{}{}
+	}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *TokenUsageMutation) UserCleared() bool {
-	return m.cleareduser
+// ClearContents clears the "contents" edge to the URLContent entity.
+func (m *URLMutation) ClearContents() {
+	m.clearedcontents = true
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *TokenUsageMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// ContentsCleared reports if the "contents" edge to the URLContent entity was cleared.
+func (m *URLMutation) ContentsCleared() bool {
+	return m.clearedcontents
+}
+
+// RemoveContentIDs removes the "contents" edge to the URLContent entity by IDs.
+func (m *URLMutation) RemoveContentIDs(ids ...uuid.UUID) {
+	if m.removedcontents == nil {
+		m.removedcontents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.contents, ids[i])
+		m.removedcontents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContents returns the removed IDs of the "contents" edge to the URLContent entity.
+func (m *URLMutation) RemovedContentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcontents {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *TokenUsageMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// ContentsIDs returns the "contents" edge IDs in the mutation.
+func (m *URLMutation) ContentsIDs() (ids []uuid.UUID) {
+	for id := range m.contents {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *TokenUsageMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetContents resets all changes to the "contents" edge.
+func (m *URLMutation) ResetContents() {
+	m.contents = nil
+	m.clearedcontents = false
+	m.removedcontents = nil
 }
 
-// SetSessionID sets the "session" edge to the Session entity by id.
-func (m *TokenUsageMutation) SetSessionID(id uuid.UUID) {
-	m.session = &id
+// AddKnowledgeMentionIDs adds the "knowledge_mentions" edge to the KnowledgeMention entity by ids.
+func (m *URLMutation) AddKnowledgeMentionIDs(ids ...uuid.UUID) {
+	if m.knowledge_mentions == nil {
+		m.knowledge_mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.knowledge_mentions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKnowledgeMentions clears the "knowledge_mentions" edge to the KnowledgeMention entity.
+func (m *URLMutation) ClearKnowledgeMentions() {
+	m.clearedknowledge_mentions = true
 }
 
-// ClearSession clears the "session" edge to the Session entity.
-func (m *TokenUsageMutation) ClearSession() {
-	m.clearedsession = true
+// KnowledgeMentionsCleared reports if the "knowledge_mentions" edge to the KnowledgeMention entity was cleared.
+func (m *URLMutation) KnowledgeMentionsCleared() bool {
+	return m.clearedknowledge_mentions
 }
 
-// SessionCleared reports if the "session" edge to the Session entity was cleared.
-func (m *TokenUsageMutation) SessionCleared() bool {
-	return m.clearedsession
+// RemoveKnowledgeMentionIDs removes the "knowledge_mentions" edge to the KnowledgeMention entity by IDs.
+func (m *URLMutation) RemoveKnowledgeMentionIDs(ids ...uuid.UUID) {
+	if m.removedknowledge_mentions == nil {
+		m.removedknowledge_mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.knowledge_mentions, ids[i])
+		m.removedknowledge_mentions[ids[i]] = struct{}{}
+	}
 }
 
-// SessionID returns the "session" edge ID in the mutation.
-func (m *TokenUsageMutation) SessionID() (id uuid.UUID, exists bool) {
-	if m.session != nil {
-		return *m.session, true
+// RemovedKnowledgeMentions returns the removed IDs of the "knowledge_mentions" edge to the KnowledgeMention entity.
+func (m *URLMutation) RemovedKnowledgeMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedknowledge_mentions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// SessionIDs returns the "session" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SessionID instead. It exists only for internal usage by the builders.
-func (m *TokenUsageMutation) SessionIDs() (ids []uuid.UUID) {
-	if id := m.session; id != nil {
-		ids = append(ids, *id)
+// KnowledgeMentionsIDs returns the "knowledge_mentions" edge IDs in the mutation.
+func (m *URLMutation) KnowledgeMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.knowledge_mentions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetSession resets all changes to the "session" edge.
-func (m *TokenUsageMutation) ResetSession() {
-	m.session = nil
-	m.clearedsession = false
+// ResetKnowledgeMentions resets all changes to the "knowledge_mentions" edge.
+func (m *URLMutation) ResetKnowledgeMentions() {
+	m.knowledge_mentions = nil
+	m.clearedknowledge_mentions = false
+	m.removedknowledge_mentions = nil
 }
 
-// Where appends a list predicates to the TokenUsageMutation builder.
-func (m *TokenUsageMutation) Where(ps ...predicate.TokenUsage) {
+// Where appends a list predicates to the URLMutation builder.
+func (m *URLMutation) Where(ps ...predicate.URL) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TokenUsageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the URLMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenUsageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TokenUsage, len(ps))
+func (m *URLMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.URL, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10636,42 +18659,39 @@ func (m *TokenUsageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TokenUsageMutation) Op() Op {
+func (m *URLMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TokenUsageMutation) SetOp(op Op) {
+func (m *URLMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TokenUsage).
-func (m *TokenUsageMutation) Type() string {
+// Type returns the node type of this mutation (URL).
+func (m *URLMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenUsageMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *URLMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, tokenusage.FieldCreatedAt)
+		fields = append(fields, url.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, tokenusage.FieldUpdatedAt)
-	}
-	if m.operation != nil {
-		fields = append(fields, tokenusage.FieldOperation)
+		fields = append(fields, url.FieldUpdatedAt)
 	}
-	if m.tokens_used != nil {
-		fields = append(fields, tokenusage.FieldTokensUsed)
+	if m.url != nil {
+		fields = append(fields, url.FieldURL)
 	}
-	if m.ai_model != nil {
-		fields = append(fields, tokenusage.FieldAiModel)
+	if m.url_hash != nil {
+		fields = append(fields, url.FieldURLHash)
 	}
-	if m.period_start != nil {
-		fields = append(fields, tokenusage.FieldPeriodStart)
+	if m.crawled_at != nil {
+		fields = append(fields, url.FieldCrawledAt)
 	}
 	return fields
 }
@@ -10679,20 +18699,18 @@ func (m *TokenUsageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenUsageMutation) Field(name string) (ent.Value, bool) {
+func (m *URLMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tokenusage.FieldCreatedAt:
+	case url.FieldCreatedAt:
 		return m.CreatedAt()
-	case tokenusage.FieldUpdatedAt:
+	case url.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case tokenusage.FieldOperation:
-		return m.Operation()
-	case tokenusage.FieldTokensUsed:
-		return m.TokensUsed()
-	case tokenusage.FieldAiModel:
-		return m.AiModel()
-	case tokenusage.FieldPeriodStart:
-		return m.PeriodStart()
+	case url.FieldURL:
+		return m.URL()
+	case url.FieldURLHash:
+		return m.URLHash()
+	case url.FieldCrawledAt:
+		return m.CrawledAt()
 	}
 	return nil, false
 }
@@ -10700,294 +18718,315 @@ func (m *TokenUsageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *URLMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tokenusage.FieldCreatedAt:
+	case url.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case tokenusage.FieldUpdatedAt:
+	case url.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case tokenusage.FieldOperation:
-		return m.OldOperation(ctx)
-	case tokenusage.FieldTokensUsed:
-		return m.OldTokensUsed(ctx)
-	case tokenusage.FieldAiModel:
-		return m.OldAiModel(ctx)
-	case tokenusage.FieldPeriodStart:
-		return m.OldPeriodStart(ctx)
+	case url.FieldURL:
+		return m.OldURL(ctx)
+	case url.FieldURLHash:
+		return m.OldURLHash(ctx)
+	case url.FieldCrawledAt:
+		return m.OldCrawledAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TokenUsage field %s", name)
+	return nil, fmt.Errorf("unknown URL field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenUsageMutation) SetField(name string, value ent.Value) error {
+func (m *URLMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tokenusage.FieldCreatedAt:
+	case url.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case tokenusage.FieldUpdatedAt:
+	case url.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case tokenusage.FieldOperation:
+	case url.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOperation(v)
-		return nil
-	case tokenusage.FieldTokensUsed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokensUsed(v)
+		m.SetURL(v)
 		return nil
-	case tokenusage.FieldAiModel:
+	case url.FieldURLHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiModel(v)
+		m.SetURLHash(v)
 		return nil
-	case tokenusage.FieldPeriodStart:
+	case url.FieldCrawledAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPeriodStart(v)
+		m.SetCrawledAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenUsage field %s", name)
+	return fmt.Errorf("unknown URL field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenUsageMutation) AddedFields() []string {
-	var fields []string
-	if m.addtokens_used != nil {
-		fields = append(fields, tokenusage.FieldTokensUsed)
-	}
-	return fields
+func (m *URLMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenUsageMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case tokenusage.FieldTokensUsed:
-		return m.AddedTokensUsed()
-	}
+func (m *URLMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenUsageMutation) AddField(name string, value ent.Value) error {
+func (m *URLMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tokenusage.FieldTokensUsed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTokensUsed(v)
-		return nil
 	}
-	return fmt.Errorf("unknown TokenUsage numeric field %s", name)
+	return fmt.Errorf("unknown URL numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenUsageMutation) ClearedFields() []string {
+func (m *URLMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(tokenusage.FieldAiModel) {
-		fields = append(fields, tokenusage.FieldAiModel)
+	if m.FieldCleared(url.FieldCrawledAt) {
+		fields = append(fields, url.FieldCrawledAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenUsageMutation) FieldCleared(name string) bool {
+func (m *URLMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenUsageMutation) ClearField(name string) error {
+func (m *URLMutation) ClearField(name string) error {
 	switch name {
-	case tokenusage.FieldAiModel:
-		m.ClearAiModel()
+	case url.FieldCrawledAt:
+		m.ClearCrawledAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenUsage nullable field %s", name)
+	return fmt.Errorf("unknown URL nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenUsageMutation) ResetField(name string) error {
+func (m *URLMutation) ResetField(name string) error {
 	switch name {
-	case tokenusage.FieldCreatedAt:
+	case url.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case tokenusage.FieldUpdatedAt:
+	case url.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case tokenusage.FieldOperation:
-		m.ResetOperation()
-		return nil
-	case tokenusage.FieldTokensUsed:
-		m.ResetTokensUsed()
+	case url.FieldURL:
+		m.ResetURL()
 		return nil
-	case tokenusage.FieldAiModel:
-		m.ResetAiModel()
+	case url.FieldURLHash:
+		m.ResetURLHash()
 		return nil
-	case tokenusage.FieldPeriodStart:
-		m.ResetPeriodStart()
+	case url.FieldCrawledAt:
+		m.ResetCrawledAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenUsage field %s", name)
+	return fmt.Errorf("unknown URL field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenUsageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, tokenusage.EdgeUser)
+func (m *URLMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.page_visits != nil {
+		edges = append(edges, url.EdgePageVisits)
 	}
-	if m.session != nil {
-		edges = append(edges, tokenusage.EdgeSession)
+	if m.contents != nil {
+		edges = append(edges, url.EdgeContents)
+	}
+	if m.knowledge_mentions != nil {
+		edges = append(edges, url.EdgeKnowledgeMentions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenUsageMutation) AddedIDs(name string) []ent.Value {
+func (m *URLMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tokenusage.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+	case url.EdgePageVisits:
+		ids := make([]ent.Value, 0, len(m.page_visits))
+		for id := range m.page_visits {
+			ids = append(ids, id)
 		}
-	case tokenusage.EdgeSession:
-		if id := m.session; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case url.EdgeContents:
+		ids := make([]ent.Value, 0, len(m.contents))
+		for id := range m.contents {
+			ids = append(ids, id)
 		}
+		return ids
+	case url.EdgeKnowledgeMentions:
+		ids := make([]ent.Value, 0, len(m.knowledge_mentions))
+		for id := range m.knowledge_mentions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenUsageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *URLMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedpage_visits != nil {
+		edges = append(edges, url.EdgePageVisits)
+	}
+	if m.removedcontents != nil {
+		edges = append(edges, url.EdgeContents)
+	}
+	if m.removedknowledge_mentions != nil {
+		edges = append(edges, url.EdgeKnowledgeMentions)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenUsageMutation) RemovedIDs(name string) []ent.Value {
+func (m *URLMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case url.EdgePageVisits:
+		ids := make([]ent.Value, 0, len(m.removedpage_visits))
+		for id := range m.removedpage_visits {
+			ids = append(ids, id)
+		}
+		return ids
+	case url.EdgeContents:
+		ids := make([]ent.Value, 0, len(m.removedcontents))
+		for id := range m.removedcontents {
+			ids = append(ids, id)
+		}
+		return ids
+	case url.EdgeKnowledgeMentions:
+		ids := make([]ent.Value, 0, len(m.removedknowledge_mentions))
+		for id := range m.removedknowledge_mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenUsageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, tokenusage.EdgeUser)
+func (m *URLMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpage_visits {
+		edges = append(edges, url.EdgePageVisits)
 	}
-	if m.clearedsession {
-		edges = append(edges, tokenusage.EdgeSession)
+	if m.clearedcontents {
+		edges = append(edges, url.EdgeContents)
+	}
+	if m.clearedknowledge_mentions {
+		edges = append(edges, url.EdgeKnowledgeMentions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenUsageMutation) EdgeCleared(name string) bool {
+func (m *URLMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tokenusage.EdgeUser:
-		return m.cleareduser
-	case tokenusage.EdgeSession:
-		return m.clearedsession
+	case url.EdgePageVisits:
+		return m.clearedpage_visits
+	case url.EdgeContents:
+		return m.clearedcontents
+	case url.EdgeKnowledgeMentions:
+		return m.clearedknowledge_mentions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenUsageMutation) ClearEdge(name string) error {
+func (m *URLMutation) ClearEdge(name string) error {
 	switch name {
-	case tokenusage.EdgeUser:
-		m.ClearUser()
-		return nil
-	case tokenusage.EdgeSession:
-		m.ClearSession()
-		return nil
 	}
-	return fmt.Errorf("unknown TokenUsage unique edge %s", name)
+	return fmt.Errorf("unknown URL unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenUsageMutation) ResetEdge(name string) error {
+func (m *URLMutation) ResetEdge(name string) error {
 	switch name {
-	case tokenusage.EdgeUser:
-		m.ResetUser()
+	case url.EdgePageVisits:
+		m.ResetPageVisits()
 		return nil
-	case tokenusage.EdgeSession:
-		m.ResetSession()
+	case url.EdgeContents:
+		m.ResetContents()
+		return nil
+	case url.EdgeKnowledgeMentions:
+		m.ResetKnowledgeMentions()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenUsage edge %s", name)
+	return fmt.Errorf("unknown URL edge %s", name)
 }
 
-// URLMutation represents an operation that mutates the URL nodes in the graph.
-type URLMutation struct {
+// URLContentMutation represents an operation that mutates the URLContent nodes in the graph.
+type URLContentMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	url                *string
-	url_hash           *string
-	title              *string
-	content            *string
-	summary            *string
-	keywords           *[]string
-	appendkeywords     []string
-	crawled_at         *time.Time
-	clearedFields      map[string]struct{}
-	page_visits        map[uuid.UUID]struct{}
-	removedpage_visits map[uuid.UUID]struct{}
-	clearedpage_visits bool
-	done               bool
-	oldValue           func(context.Context) (*URL, error)
-	predicates         []predicate.URL
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	title             *string
+	content           *string
+	summary           *string
+	abstract          *string
+	keywords          *[]string
+	appendkeywords    []string
+	entities          *[]string
+	appendentities    []string
+	summarized_at     *time.Time
+	summary_error     *string
+	summary_failed_at *time.Time
+	clearedFields     map[string]struct{}
+	user              *uuid.UUID
+	cleareduser       bool
+	url               *uuid.UUID
+	clearedurl        bool
+	done              bool
+	oldValue          func(context.Context) (*URLContent, error)
+	predicates        []predicate.URLContent
 }
 
-var _ ent.Mutation = (*URLMutation)(nil)
+var _ ent.Mutation = (*URLContentMutation)(nil)
 
-// urlOption allows management of the mutation configuration using functional options.
-type urlOption func(*URLMutation)
+// urlcontentOption allows management of the mutation configuration using functional options.
+type urlcontentOption func(*URLContentMutation)
 
-// newURLMutation creates new mutation for the URL entity.
-func newURLMutation(c config, op Op, opts ...urlOption) *URLMutation {
-	m := &URLMutation{
+// newURLContentMutation creates new mutation for the URLContent entity.
+func newURLContentMutation(c config, op Op, opts ...urlcontentOption) *URLContentMutation {
+	m := &URLContentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeURL,
+		typ:           TypeURLContent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10996,20 +19035,20 @@ func newURLMutation(c config, op Op, opts ...urlOption) *URLMutation {
 	return m
 }
 
-// withURLID sets the ID field of the mutation.
-func withURLID(id uuid.UUID) urlOption {
-	return func(m *URLMutation) {
+// withURLContentID sets the ID field of the mutation.
+func withURLContentID(id uuid.UUID) urlcontentOption {
+	return func(m *URLContentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *URL
+			value *URLContent
 		)
-		m.oldValue = func(ctx context.Context) (*URL, error) {
+		m.oldValue = func(ctx context.Context) (*URLContent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().URL.Get(ctx, id)
+					value, err = m.Client().URLContent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -11018,10 +19057,10 @@ func withURLID(id uuid.UUID) urlOption {
 	}
 }
 
-// withURL sets the old URL of the mutation.
-func withURL(node *URL) urlOption {
-	return func(m *URLMutation) {
-		m.oldValue = func(context.Context) (*URL, error) {
+// withURLContent sets the old URLContent of the mutation.
+func withURLContent(node *URLContent) urlcontentOption {
+	return func(m *URLContentMutation) {
+		m.oldValue = func(context.Context) (*URLContent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -11030,7 +19069,7 @@ func withURL(node *URL) urlOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m URLMutation) Client() *Client {
+func (m URLContentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -11038,7 +19077,7 @@ func (m URLMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m URLMutation) Tx() (*Tx, error) {
+func (m URLContentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -11048,14 +19087,14 @@ func (m URLMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of URL entities.
-func (m *URLMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of URLContent entities.
+func (m *URLContentMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *URLMutation) ID() (id uuid.UUID, exists bool) {
+func (m *URLContentMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -11066,7 +19105,7 @@ func (m *URLMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *URLMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *URLContentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -11075,19 +19114,19 @@ func (m *URLMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().URL.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().URLContent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *URLMutation) SetCreatedAt(t time.Time) {
+func (m *URLContentMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *URLMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *URLContentMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -11095,10 +19134,10 @@ func (m *URLMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *URLContentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -11113,17 +19152,17 @@ func (m *URLMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *URLMutation) ResetCreatedAt() {
+func (m *URLContentMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *URLMutation) SetUpdatedAt(t time.Time) {
+func (m *URLContentMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *URLMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *URLContentMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -11131,10 +19170,10 @@ func (m *URLMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *URLContentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -11149,406 +19188,570 @@ func (m *URLMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *URLMutation) ResetUpdatedAt() {
+func (m *URLContentMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetURL sets the "url" field.
-func (m *URLMutation) SetURL(s string) {
-	m.url = &s
+// SetTitle sets the "title" field.
+func (m *URLContentMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *URLMutation) URL() (r string, exists bool) {
-	v := m.url
+// Title returns the value of the "title" field in the mutation.
+func (m *URLContentMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *URLContentMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.Title, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *URLMutation) ResetURL() {
-	m.url = nil
+// ClearTitle clears the value of the "title" field.
+func (m *URLContentMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[urlcontent.FieldTitle] = struct{}{}
 }
 
-// SetURLHash sets the "url_hash" field.
-func (m *URLMutation) SetURLHash(s string) {
-	m.url_hash = &s
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *URLContentMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldTitle]
+	return ok
 }
 
-// URLHash returns the value of the "url_hash" field in the mutation.
-func (m *URLMutation) URLHash() (r string, exists bool) {
-	v := m.url_hash
+// ResetTitle resets all changes to the "title" field.
+func (m *URLContentMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, urlcontent.FieldTitle)
+}
+
+// SetContent sets the "content" field.
+func (m *URLContentMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *URLContentMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURLHash returns the old "url_hash" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldURLHash(ctx context.Context) (v string, err error) {
+func (m *URLContentMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURLHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURLHash requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURLHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.URLHash, nil
+	return oldValue.Content, nil
 }
 
-// ResetURLHash resets all changes to the "url_hash" field.
-func (m *URLMutation) ResetURLHash() {
-	m.url_hash = nil
+// ClearContent clears the value of the "content" field.
+func (m *URLContentMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[urlcontent.FieldContent] = struct{}{}
 }
 
-// SetTitle sets the "title" field.
-func (m *URLMutation) SetTitle(s string) {
-	m.title = &s
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *URLContentMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldContent]
+	return ok
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *URLMutation) Title() (r string, exists bool) {
-	v := m.title
+// ResetContent resets all changes to the "content" field.
+func (m *URLContentMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, urlcontent.FieldContent)
+}
+
+// SetSummary sets the "summary" field.
+func (m *URLContentMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *URLContentMutation) Summary() (r string, exists bool) {
+	v := m.summary
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldSummary returns the old "summary" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *URLContentMutation) OldSummary(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldSummary requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Summary, nil
 }
 
-// ClearTitle clears the value of the "title" field.
-func (m *URLMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[url.FieldTitle] = struct{}{}
+// ClearSummary clears the value of the "summary" field.
+func (m *URLContentMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[urlcontent.FieldSummary] = struct{}{}
 }
 
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *URLMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[url.FieldTitle]
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *URLContentMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldSummary]
 	return ok
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *URLMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, url.FieldTitle)
+// ResetSummary resets all changes to the "summary" field.
+func (m *URLContentMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, urlcontent.FieldSummary)
 }
 
-// SetContent sets the "content" field.
-func (m *URLMutation) SetContent(s string) {
-	m.content = &s
+// SetAbstract sets the "abstract" field.
+func (m *URLContentMutation) SetAbstract(s string) {
+	m.abstract = &s
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *URLMutation) Content() (r string, exists bool) {
-	v := m.content
+// Abstract returns the value of the "abstract" field in the mutation.
+func (m *URLContentMutation) Abstract() (r string, exists bool) {
+	v := m.abstract
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbstract returns the old "abstract" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *URLContentMutation) OldAbstract(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbstract is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbstract requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbstract: %w", err)
+	}
+	return oldValue.Abstract, nil
+}
+
+// ClearAbstract clears the value of the "abstract" field.
+func (m *URLContentMutation) ClearAbstract() {
+	m.abstract = nil
+	m.clearedFields[urlcontent.FieldAbstract] = struct{}{}
+}
+
+// AbstractCleared returns if the "abstract" field was cleared in this mutation.
+func (m *URLContentMutation) AbstractCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldAbstract]
+	return ok
+}
+
+// ResetAbstract resets all changes to the "abstract" field.
+func (m *URLContentMutation) ResetAbstract() {
+	m.abstract = nil
+	delete(m.clearedFields, urlcontent.FieldAbstract)
+}
+
+// SetKeywords sets the "keywords" field.
+func (m *URLContentMutation) SetKeywords(s []string) {
+	m.keywords = &s
+	m.appendkeywords = nil
+}
+
+// Keywords returns the value of the "keywords" field in the mutation.
+func (m *URLContentMutation) Keywords() (r []string, exists bool) {
+	v := m.keywords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeywords returns the old "keywords" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *URLContentMutation) OldKeywords(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeywords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeywords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
+	}
+	return oldValue.Keywords, nil
+}
+
+// AppendKeywords adds s to the "keywords" field.
+func (m *URLContentMutation) AppendKeywords(s []string) {
+	m.appendkeywords = append(m.appendkeywords, s...)
+}
+
+// AppendedKeywords returns the list of values that were appended to the "keywords" field in this mutation.
+func (m *URLContentMutation) AppendedKeywords() ([]string, bool) {
+	if len(m.appendkeywords) == 0 {
+		return nil, false
+	}
+	return m.appendkeywords, true
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (m *URLContentMutation) ClearKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.clearedFields[urlcontent.FieldKeywords] = struct{}{}
+}
+
+// KeywordsCleared returns if the "keywords" field was cleared in this mutation.
+func (m *URLContentMutation) KeywordsCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldKeywords]
+	return ok
+}
+
+// ResetKeywords resets all changes to the "keywords" field.
+func (m *URLContentMutation) ResetKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	delete(m.clearedFields, urlcontent.FieldKeywords)
+}
+
+// SetEntities sets the "entities" field.
+func (m *URLContentMutation) SetEntities(s []string) {
+	m.entities = &s
+	m.appendentities = nil
+}
+
+// Entities returns the value of the "entities" field in the mutation.
+func (m *URLContentMutation) Entities() (r []string, exists bool) {
+	v := m.entities
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldEntities returns the old "entities" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *URLContentMutation) OldEntities(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntities is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldEntities requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntities: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Entities, nil
 }
 
-// ClearContent clears the value of the "content" field.
-func (m *URLMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[url.FieldContent] = struct{}{}
+// AppendEntities adds s to the "entities" field.
+func (m *URLContentMutation) AppendEntities(s []string) {
+	m.appendentities = append(m.appendentities, s...)
 }
 
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *URLMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[url.FieldContent]
+// AppendedEntities returns the list of values that were appended to the "entities" field in this mutation.
+func (m *URLContentMutation) AppendedEntities() ([]string, bool) {
+	if len(m.appendentities) == 0 {
+		return nil, false
+	}
+	return m.appendentities, true
+}
+
+// ClearEntities clears the value of the "entities" field.
+func (m *URLContentMutation) ClearEntities() {
+	m.entities = nil
+	m.appendentities = nil
+	m.clearedFields[urlcontent.FieldEntities] = struct{}{}
+}
+
+// EntitiesCleared returns if the "entities" field was cleared in this mutation.
+func (m *URLContentMutation) EntitiesCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldEntities]
 	return ok
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *URLMutation) ResetContent() {
-	m.content = nil
-	delete(m.clearedFields, url.FieldContent)
+// ResetEntities resets all changes to the "entities" field.
+func (m *URLContentMutation) ResetEntities() {
+	m.entities = nil
+	m.appendentities = nil
+	delete(m.clearedFields, urlcontent.FieldEntities)
 }
 
-// SetSummary sets the "summary" field.
-func (m *URLMutation) SetSummary(s string) {
-	m.summary = &s
+// SetSummarizedAt sets the "summarized_at" field.
+func (m *URLContentMutation) SetSummarizedAt(t time.Time) {
+	m.summarized_at = &t
 }
 
-// Summary returns the value of the "summary" field in the mutation.
-func (m *URLMutation) Summary() (r string, exists bool) {
-	v := m.summary
+// SummarizedAt returns the value of the "summarized_at" field in the mutation.
+func (m *URLContentMutation) SummarizedAt() (r time.Time, exists bool) {
+	v := m.summarized_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummary returns the old "summary" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldSummarizedAt returns the old "summarized_at" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldSummary(ctx context.Context) (v string, err error) {
+func (m *URLContentMutation) OldSummarizedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+		return v, errors.New("OldSummarizedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummary requires an ID field in the mutation")
+		return v, errors.New("OldSummarizedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+		return v, fmt.Errorf("querying old value for OldSummarizedAt: %w", err)
 	}
-	return oldValue.Summary, nil
+	return oldValue.SummarizedAt, nil
 }
 
-// ClearSummary clears the value of the "summary" field.
-func (m *URLMutation) ClearSummary() {
-	m.summary = nil
-	m.clearedFields[url.FieldSummary] = struct{}{}
+// ClearSummarizedAt clears the value of the "summarized_at" field.
+func (m *URLContentMutation) ClearSummarizedAt() {
+	m.summarized_at = nil
+	m.clearedFields[urlcontent.FieldSummarizedAt] = struct{}{}
 }
 
-// SummaryCleared returns if the "summary" field was cleared in this mutation.
-func (m *URLMutation) SummaryCleared() bool {
-	_, ok := m.clearedFields[url.FieldSummary]
+// SummarizedAtCleared returns if the "summarized_at" field was cleared in this mutation.
+func (m *URLContentMutation) SummarizedAtCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldSummarizedAt]
 	return ok
 }
 
-// ResetSummary resets all changes to the "summary" field.
-func (m *URLMutation) ResetSummary() {
-	m.summary = nil
-	delete(m.clearedFields, url.FieldSummary)
+// ResetSummarizedAt resets all changes to the "summarized_at" field.
+func (m *URLContentMutation) ResetSummarizedAt() {
+	m.summarized_at = nil
+	delete(m.clearedFields, urlcontent.FieldSummarizedAt)
 }
 
-// SetKeywords sets the "keywords" field.
-func (m *URLMutation) SetKeywords(s []string) {
-	m.keywords = &s
-	m.appendkeywords = nil
+// SetSummaryError sets the "summary_error" field.
+func (m *URLContentMutation) SetSummaryError(s string) {
+	m.summary_error = &s
 }
 
-// Keywords returns the value of the "keywords" field in the mutation.
-func (m *URLMutation) Keywords() (r []string, exists bool) {
-	v := m.keywords
+// SummaryError returns the value of the "summary_error" field in the mutation.
+func (m *URLContentMutation) SummaryError() (r string, exists bool) {
+	v := m.summary_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeywords returns the old "keywords" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldSummaryError returns the old "summary_error" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldKeywords(ctx context.Context) (v []string, err error) {
+func (m *URLContentMutation) OldSummaryError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeywords is only allowed on UpdateOne operations")
+		return v, errors.New("OldSummaryError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeywords requires an ID field in the mutation")
+		return v, errors.New("OldSummaryError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
-	}
-	return oldValue.Keywords, nil
-}
-
-// AppendKeywords adds s to the "keywords" field.
-func (m *URLMutation) AppendKeywords(s []string) {
-	m.appendkeywords = append(m.appendkeywords, s...)
-}
-
-// AppendedKeywords returns the list of values that were appended to the "keywords" field in this mutation.
-func (m *URLMutation) AppendedKeywords() ([]string, bool) {
-	if len(m.appendkeywords) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldSummaryError: %w", err)
 	}
-	return m.appendkeywords, true
+	return oldValue.SummaryError, nil
 }
 
-// ClearKeywords clears the value of the "keywords" field.
-func (m *URLMutation) ClearKeywords() {
-	m.keywords = nil
-	m.appendkeywords = nil
-	m.clearedFields[url.FieldKeywords] = struct{}{}
+// ClearSummaryError clears the value of the "summary_error" field.
+func (m *URLContentMutation) ClearSummaryError() {
+	m.summary_error = nil
+	m.clearedFields[urlcontent.FieldSummaryError] = struct{}{}
 }
 
-// KeywordsCleared returns if the "keywords" field was cleared in this mutation.
-func (m *URLMutation) KeywordsCleared() bool {
-	_, ok := m.clearedFields[url.FieldKeywords]
+// SummaryErrorCleared returns if the "summary_error" field was cleared in this mutation.
+func (m *URLContentMutation) SummaryErrorCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldSummaryError]
 	return ok
 }
 
-// ResetKeywords resets all changes to the "keywords" field.
-func (m *URLMutation) ResetKeywords() {
-	m.keywords = nil
-	m.appendkeywords = nil
-	delete(m.clearedFields, url.FieldKeywords)
+// ResetSummaryError resets all changes to the "summary_error" field.
+func (m *URLContentMutation) ResetSummaryError() {
+	m.summary_error = nil
+	delete(m.clearedFields, urlcontent.FieldSummaryError)
 }
 
-// SetCrawledAt sets the "crawled_at" field.
-func (m *URLMutation) SetCrawledAt(t time.Time) {
-	m.crawled_at = &t
+// SetSummaryFailedAt sets the "summary_failed_at" field.
+func (m *URLContentMutation) SetSummaryFailedAt(t time.Time) {
+	m.summary_failed_at = &t
 }
 
-// CrawledAt returns the value of the "crawled_at" field in the mutation.
-func (m *URLMutation) CrawledAt() (r time.Time, exists bool) {
-	v := m.crawled_at
+// SummaryFailedAt returns the value of the "summary_failed_at" field in the mutation.
+func (m *URLContentMutation) SummaryFailedAt() (r time.Time, exists bool) {
+	v := m.summary_failed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCrawledAt returns the old "crawled_at" field's value of the URL entity.
-// If the URL object wasn't provided to the builder, the object is fetched from the database.
+// OldSummaryFailedAt returns the old "summary_failed_at" field's value of the URLContent entity.
+// If the URLContent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *URLMutation) OldCrawledAt(ctx context.Context) (v *time.Time, err error) {
+func (m *URLContentMutation) OldSummaryFailedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCrawledAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSummaryFailedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCrawledAt requires an ID field in the mutation")
+		return v, errors.New("OldSummaryFailedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCrawledAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSummaryFailedAt: %w", err)
 	}
-	return oldValue.CrawledAt, nil
+	return oldValue.SummaryFailedAt, nil
 }
 
-// ClearCrawledAt clears the value of the "crawled_at" field.
-func (m *URLMutation) ClearCrawledAt() {
-	m.crawled_at = nil
-	m.clearedFields[url.FieldCrawledAt] = struct{}{}
+// ClearSummaryFailedAt clears the value of the "summary_failed_at" field.
+func (m *URLContentMutation) ClearSummaryFailedAt() {
+	m.summary_failed_at = nil
+	m.clearedFields[urlcontent.FieldSummaryFailedAt] = struct{}{}
 }
 
-// CrawledAtCleared returns if the "crawled_at" field was cleared in this mutation.
-func (m *URLMutation) CrawledAtCleared() bool {
-	_, ok := m.clearedFields[url.FieldCrawledAt]
+// SummaryFailedAtCleared returns if the "summary_failed_at" field was cleared in this mutation.
+func (m *URLContentMutation) SummaryFailedAtCleared() bool {
+	_, ok := m.clearedFields[urlcontent.FieldSummaryFailedAt]
 	return ok
 }
 
-// ResetCrawledAt resets all changes to the "crawled_at" field.
-func (m *URLMutation) ResetCrawledAt() {
-	m.crawled_at = nil
-	delete(m.clearedFields, url.FieldCrawledAt)
+// ResetSummaryFailedAt resets all changes to the "summary_failed_at" field.
+func (m *URLContentMutation) ResetSummaryFailedAt() {
+	m.summary_failed_at = nil
+	delete(m.clearedFields, urlcontent.FieldSummaryFailedAt)
 }
 
-// AddPageVisitIDs adds the "page_visits" edge to the PageVisit entity by ids.
-func (m *URLMutation) AddPageVisitIDs(ids ...uuid.UUID) {
-	if m.page_visits == nil {
-		m.page_visits = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.page_visits[ids[i]] = struct{}{}
-	}
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *URLContentMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
 }
 
-// ClearPageVisits clears the "page_visits" edge to the PageVisit entity.
-func (m *URLMutation) ClearPageVisits() {
-	m.clearedpage_visits = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *URLContentMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// PageVisitsCleared reports if the "page_visits" edge to the PageVisit entity was cleared.
-func (m *URLMutation) PageVisitsCleared() bool {
-	return m.clearedpage_visits
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *URLContentMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// RemovePageVisitIDs removes the "page_visits" edge to the PageVisit entity by IDs.
-func (m *URLMutation) RemovePageVisitIDs(ids ...uuid.UUID) {
-	if m.removedpage_visits == nil {
-		m.removedpage_visits = make(map[uuid.UUID]struct{})
+// UserID returns the "user" edge ID in the mutation.
+func (m *URLContentMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	for i := range ids {
-		delete(m.page_visits, ids[i])
-		m.removedpage_visits[ids[i]] = struct{}{}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *URLContentMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// RemovedPageVisits returns the removed IDs of the "page_visits" edge to the PageVisit entity.
-func (m *URLMutation) RemovedPageVisitsIDs() (ids []uuid.UUID) {
-	for id := range m.removedpage_visits {
-		ids = append(ids, id)
+// ResetUser resets all changes to the "user" edge.
+func (m *URLContentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetURLID sets the "url" edge to the URL entity by id.
+func (m *URLContentMutation) SetURLID(id uuid.UUID) {
+	m.url = &id
+}
+
+// ClearURL clears the "url" edge to the URL entity.
+func (m *URLContentMutation) ClearURL() {
+	m.clearedurl = true
+}
+
+// URLCleared reports if the "url" edge to the URL entity was cleared.
+func (m *URLContentMutation) URLCleared() bool {
+	return m.clearedurl
+}
+
+// URLID returns the "url" edge ID in the mutation.
+func (m *URLContentMutation) URLID() (id uuid.UUID, exists bool) {
+	if m.url != nil {
+		return *m.url, true
 	}
 	return
 }
 
-// PageVisitsIDs returns the "page_visits" edge IDs in the mutation.
-func (m *URLMutation) PageVisitsIDs() (ids []uuid.UUID) {
-	for id := range m.page_visits {
-		ids = append(ids, id)
+// URLIDs returns the "url" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// URLID instead. It exists only for internal usage by the builders.
+func (m *URLContentMutation) URLIDs() (ids []uuid.UUID) {
+	if id := m.url; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPageVisits resets all changes to the "page_visits" edge.
-func (m *URLMutation) ResetPageVisits() {
-	m.page_visits = nil
-	m.clearedpage_visits = false
-	m.removedpage_visits = nil
+// ResetURL resets all changes to the "url" edge.
+func (m *URLContentMutation) ResetURL() {
+	m.url = nil
+	m.clearedurl = false
 }
 
-// Where appends a list predicates to the URLMutation builder.
-func (m *URLMutation) Where(ps ...predicate.URL) {
+// Where appends a list predicates to the URLContentMutation builder.
+func (m *URLContentMutation) Where(ps ...predicate.URLContent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the URLMutation builder. Using this method,
+// WhereP appends storage-level predicates to the URLContentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *URLMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.URL, len(ps))
+func (m *URLContentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.URLContent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11556,51 +19759,57 @@ func (m *URLMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *URLMutation) Op() Op {
+func (m *URLContentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *URLMutation) SetOp(op Op) {
+func (m *URLContentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (URL).
-func (m *URLMutation) Type() string {
+// Type returns the node type of this mutation (URLContent).
+func (m *URLContentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *URLMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *URLContentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, url.FieldCreatedAt)
+		fields = append(fields, urlcontent.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, url.FieldUpdatedAt)
-	}
-	if m.url != nil {
-		fields = append(fields, url.FieldURL)
-	}
-	if m.url_hash != nil {
-		fields = append(fields, url.FieldURLHash)
+		fields = append(fields, urlcontent.FieldUpdatedAt)
 	}
 	if m.title != nil {
-		fields = append(fields, url.FieldTitle)
+		fields = append(fields, urlcontent.FieldTitle)
 	}
 	if m.content != nil {
-		fields = append(fields, url.FieldContent)
+		fields = append(fields, urlcontent.FieldContent)
 	}
 	if m.summary != nil {
-		fields = append(fields, url.FieldSummary)
+		fields = append(fields, urlcontent.FieldSummary)
+	}
+	if m.abstract != nil {
+		fields = append(fields, urlcontent.FieldAbstract)
 	}
 	if m.keywords != nil {
-		fields = append(fields, url.FieldKeywords)
+		fields = append(fields, urlcontent.FieldKeywords)
 	}
-	if m.crawled_at != nil {
-		fields = append(fields, url.FieldCrawledAt)
+	if m.entities != nil {
+		fields = append(fields, urlcontent.FieldEntities)
+	}
+	if m.summarized_at != nil {
+		fields = append(fields, urlcontent.FieldSummarizedAt)
+	}
+	if m.summary_error != nil {
+		fields = append(fields, urlcontent.FieldSummaryError)
+	}
+	if m.summary_failed_at != nil {
+		fields = append(fields, urlcontent.FieldSummaryFailedAt)
 	}
 	return fields
 }
@@ -11608,26 +19817,30 @@ func (m *URLMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *URLMutation) Field(name string) (ent.Value, bool) {
+func (m *URLContentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case url.FieldCreatedAt:
+	case urlcontent.FieldCreatedAt:
 		return m.CreatedAt()
-	case url.FieldUpdatedAt:
+	case urlcontent.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case url.FieldURL:
-		return m.URL()
-	case url.FieldURLHash:
-		return m.URLHash()
-	case url.FieldTitle:
+	case urlcontent.FieldTitle:
 		return m.Title()
-	case url.FieldContent:
+	case urlcontent.FieldContent:
 		return m.Content()
-	case url.FieldSummary:
+	case urlcontent.FieldSummary:
 		return m.Summary()
-	case url.FieldKeywords:
+	case urlcontent.FieldAbstract:
+		return m.Abstract()
+	case urlcontent.FieldKeywords:
 		return m.Keywords()
-	case url.FieldCrawledAt:
-		return m.CrawledAt()
+	case urlcontent.FieldEntities:
+		return m.Entities()
+	case urlcontent.FieldSummarizedAt:
+		return m.SummarizedAt()
+	case urlcontent.FieldSummaryError:
+		return m.SummaryError()
+	case urlcontent.FieldSummaryFailedAt:
+		return m.SummaryFailedAt()
 	}
 	return nil, false
 }
@@ -11635,331 +19848,400 @@ func (m *URLMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *URLMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *URLContentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case url.FieldCreatedAt:
+	case urlcontent.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case url.FieldUpdatedAt:
+	case urlcontent.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case url.FieldURL:
-		return m.OldURL(ctx)
-	case url.FieldURLHash:
-		return m.OldURLHash(ctx)
-	case url.FieldTitle:
+	case urlcontent.FieldTitle:
 		return m.OldTitle(ctx)
-	case url.FieldContent:
+	case urlcontent.FieldContent:
 		return m.OldContent(ctx)
-	case url.FieldSummary:
+	case urlcontent.FieldSummary:
 		return m.OldSummary(ctx)
-	case url.FieldKeywords:
+	case urlcontent.FieldAbstract:
+		return m.OldAbstract(ctx)
+	case urlcontent.FieldKeywords:
 		return m.OldKeywords(ctx)
-	case url.FieldCrawledAt:
-		return m.OldCrawledAt(ctx)
+	case urlcontent.FieldEntities:
+		return m.OldEntities(ctx)
+	case urlcontent.FieldSummarizedAt:
+		return m.OldSummarizedAt(ctx)
+	case urlcontent.FieldSummaryError:
+		return m.OldSummaryError(ctx)
+	case urlcontent.FieldSummaryFailedAt:
+		return m.OldSummaryFailedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown URL field %s", name)
+	return nil, fmt.Errorf("unknown URLContent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *URLMutation) SetField(name string, value ent.Value) error {
+func (m *URLContentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case url.FieldCreatedAt:
+	case urlcontent.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case url.FieldUpdatedAt:
+	case urlcontent.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case url.FieldURL:
+	case urlcontent.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetTitle(v)
 		return nil
-	case url.FieldURLHash:
+	case urlcontent.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURLHash(v)
+		m.SetContent(v)
 		return nil
-	case url.FieldTitle:
+	case urlcontent.FieldSummary:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetSummary(v)
 		return nil
-	case url.FieldContent:
+	case urlcontent.FieldAbstract:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetAbstract(v)
 		return nil
-	case url.FieldSummary:
-		v, ok := value.(string)
+	case urlcontent.FieldKeywords:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSummary(v)
+		m.SetKeywords(v)
 		return nil
-	case url.FieldKeywords:
+	case urlcontent.FieldEntities:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeywords(v)
+		m.SetEntities(v)
 		return nil
-	case url.FieldCrawledAt:
+	case urlcontent.FieldSummarizedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCrawledAt(v)
+		m.SetSummarizedAt(v)
+		return nil
+	case urlcontent.FieldSummaryError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummaryError(v)
+		return nil
+	case urlcontent.FieldSummaryFailedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummaryFailedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown URL field %s", name)
+	return fmt.Errorf("unknown URLContent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *URLMutation) AddedFields() []string {
+func (m *URLContentMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *URLMutation) AddedField(name string) (ent.Value, bool) {
+func (m *URLContentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *URLMutation) AddField(name string, value ent.Value) error {
+func (m *URLContentMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown URL numeric field %s", name)
+	return fmt.Errorf("unknown URLContent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *URLMutation) ClearedFields() []string {
+func (m *URLContentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(url.FieldTitle) {
-		fields = append(fields, url.FieldTitle)
+	if m.FieldCleared(urlcontent.FieldTitle) {
+		fields = append(fields, urlcontent.FieldTitle)
 	}
-	if m.FieldCleared(url.FieldContent) {
-		fields = append(fields, url.FieldContent)
+	if m.FieldCleared(urlcontent.FieldContent) {
+		fields = append(fields, urlcontent.FieldContent)
 	}
-	if m.FieldCleared(url.FieldSummary) {
-		fields = append(fields, url.FieldSummary)
+	if m.FieldCleared(urlcontent.FieldSummary) {
+		fields = append(fields, urlcontent.FieldSummary)
 	}
-	if m.FieldCleared(url.FieldKeywords) {
-		fields = append(fields, url.FieldKeywords)
+	if m.FieldCleared(urlcontent.FieldAbstract) {
+		fields = append(fields, urlcontent.FieldAbstract)
 	}
-	if m.FieldCleared(url.FieldCrawledAt) {
-		fields = append(fields, url.FieldCrawledAt)
+	if m.FieldCleared(urlcontent.FieldKeywords) {
+		fields = append(fields, urlcontent.FieldKeywords)
+	}
+	if m.FieldCleared(urlcontent.FieldEntities) {
+		fields = append(fields, urlcontent.FieldEntities)
+	}
+	if m.FieldCleared(urlcontent.FieldSummarizedAt) {
+		fields = append(fields, urlcontent.FieldSummarizedAt)
+	}
+	if m.FieldCleared(urlcontent.FieldSummaryError) {
+		fields = append(fields, urlcontent.FieldSummaryError)
+	}
+	if m.FieldCleared(urlcontent.FieldSummaryFailedAt) {
+		fields = append(fields, urlcontent.FieldSummaryFailedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *URLMutation) FieldCleared(name string) bool {
+func (m *URLContentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *URLMutation) ClearField(name string) error {
+func (m *URLContentMutation) ClearField(name string) error {
 	switch name {
-	case url.FieldTitle:
+	case urlcontent.FieldTitle:
 		m.ClearTitle()
 		return nil
-	case url.FieldContent:
+	case urlcontent.FieldContent:
 		m.ClearContent()
 		return nil
-	case url.FieldSummary:
+	case urlcontent.FieldSummary:
 		m.ClearSummary()
 		return nil
-	case url.FieldKeywords:
+	case urlcontent.FieldAbstract:
+		m.ClearAbstract()
+		return nil
+	case urlcontent.FieldKeywords:
 		m.ClearKeywords()
 		return nil
-	case url.FieldCrawledAt:
-		m.ClearCrawledAt()
+	case urlcontent.FieldEntities:
+		m.ClearEntities()
+		return nil
+	case urlcontent.FieldSummarizedAt:
+		m.ClearSummarizedAt()
+		return nil
+	case urlcontent.FieldSummaryError:
+		m.ClearSummaryError()
+		return nil
+	case urlcontent.FieldSummaryFailedAt:
+		m.ClearSummaryFailedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown URL nullable field %s", name)
+	return fmt.Errorf("unknown URLContent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *URLMutation) ResetField(name string) error {
+func (m *URLContentMutation) ResetField(name string) error {
 	switch name {
-	case url.FieldCreatedAt:
+	case urlcontent.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case url.FieldUpdatedAt:
+	case urlcontent.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case url.FieldURL:
-		m.ResetURL()
-		return nil
-	case url.FieldURLHash:
-		m.ResetURLHash()
-		return nil
-	case url.FieldTitle:
+	case urlcontent.FieldTitle:
 		m.ResetTitle()
 		return nil
-	case url.FieldContent:
+	case urlcontent.FieldContent:
 		m.ResetContent()
 		return nil
-	case url.FieldSummary:
+	case urlcontent.FieldSummary:
 		m.ResetSummary()
 		return nil
-	case url.FieldKeywords:
+	case urlcontent.FieldAbstract:
+		m.ResetAbstract()
+		return nil
+	case urlcontent.FieldKeywords:
 		m.ResetKeywords()
 		return nil
-	case url.FieldCrawledAt:
-		m.ResetCrawledAt()
+	case urlcontent.FieldEntities:
+		m.ResetEntities()
+		return nil
+	case urlcontent.FieldSummarizedAt:
+		m.ResetSummarizedAt()
+		return nil
+	case urlcontent.FieldSummaryError:
+		m.ResetSummaryError()
+		return nil
+	case urlcontent.FieldSummaryFailedAt:
+		m.ResetSummaryFailedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown URL field %s", name)
+	return fmt.Errorf("unknown URLContent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *URLMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.page_visits != nil {
-		edges = append(edges, url.EdgePageVisits)
+func (m *URLContentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, urlcontent.EdgeUser)
+	}
+	if m.url != nil {
+		edges = append(edges, urlcontent.EdgeURL)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *URLMutation) AddedIDs(name string) []ent.Value {
+func (m *URLContentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case url.EdgePageVisits:
-		ids := make([]ent.Value, 0, len(m.page_visits))
-		for id := range m.page_visits {
-			ids = append(ids, id)
+	case urlcontent.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case urlcontent.EdgeURL:
+		if id := m.url; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *URLMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedpage_visits != nil {
-		edges = append(edges, url.EdgePageVisits)
-	}
+func (m *URLContentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *URLMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case url.EdgePageVisits:
-		ids := make([]ent.Value, 0, len(m.removedpage_visits))
-		for id := range m.removedpage_visits {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *URLContentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *URLMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedpage_visits {
-		edges = append(edges, url.EdgePageVisits)
+func (m *URLContentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, urlcontent.EdgeUser)
+	}
+	if m.clearedurl {
+		edges = append(edges, urlcontent.EdgeURL)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *URLMutation) EdgeCleared(name string) bool {
+func (m *URLContentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case url.EdgePageVisits:
-		return m.clearedpage_visits
+	case urlcontent.EdgeUser:
+		return m.cleareduser
+	case urlcontent.EdgeURL:
+		return m.clearedurl
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *URLMutation) ClearEdge(name string) error {
+func (m *URLContentMutation) ClearEdge(name string) error {
 	switch name {
+	case urlcontent.EdgeUser:
+		m.ClearUser()
+		return nil
+	case urlcontent.EdgeURL:
+		m.ClearURL()
+		return nil
 	}
-	return fmt.Errorf("unknown URL unique edge %s", name)
+	return fmt.Errorf("unknown URLContent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *URLMutation) ResetEdge(name string) error {
+func (m *URLContentMutation) ResetEdge(name string) error {
 	switch name {
-	case url.EdgePageVisits:
-		m.ResetPageVisits()
+	case urlcontent.EdgeUser:
+		m.ResetUser()
+		return nil
+	case urlcontent.EdgeURL:
+		m.ResetURL()
 		return nil
 	}
-	return fmt.Errorf("unknown URL edge %s", name)
+	return fmt.Errorf("unknown URLContent edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uuid.UUID
-	created_at                   *time.Time
-	updated_at                   *time.Time
-	status                       *user.Status
-	deleted_at                   *time.Time
-	email                        *string
-	password_hash                *string
-	google_id                    *string
-	avatar_url                   *string
-	auth_provider                *user.AuthProvider
-	clearedFields                map[string]struct{}
-	settings                     *uuid.UUID
-	clearedsettings              bool
-	sessions                     map[uuid.UUID]struct{}
-	removedsessions              map[uuid.UUID]struct{}
-	clearedsessions              bool
-	password_reset_tokens        map[uuid.UUID]struct{}
-	removedpassword_reset_tokens map[uuid.UUID]struct{}
-	clearedpassword_reset_tokens bool
-	subscriptions                map[uuid.UUID]struct{}
-	removedsubscriptions         map[uuid.UUID]struct{}
-	clearedsubscriptions         bool
-	token_usage                  map[uuid.UUID]struct{}
-	removedtoken_usage           map[uuid.UUID]struct{}
-	clearedtoken_usage           bool
-	ai_logs                      map[uuid.UUID]struct{}
-	removedai_logs               map[uuid.UUID]struct{}
-	clearedai_logs               bool
-	done                         bool
-	oldValue                     func(context.Context) (*User, error)
-	predicates                   []predicate.User
+	op                               Op
+	typ                              string
+	id                               *uuid.UUID
+	created_at                       *time.Time
+	updated_at                       *time.Time
+	status                           *user.Status
+	deleted_at                       *time.Time
+	email                            *string
+	password_hash                    *string
+	google_id                        *string
+	avatar_url                       *string
+	auth_provider                    *user.AuthProvider
+	email_verified_at                *time.Time
+	clearedFields                    map[string]struct{}
+	settings                         *uuid.UUID
+	clearedsettings                  bool
+	sessions                         map[uuid.UUID]struct{}
+	removedsessions                  map[uuid.UUID]struct{}
+	clearedsessions                  bool
+	password_reset_tokens            map[uuid.UUID]struct{}
+	removedpassword_reset_tokens     map[uuid.UUID]struct{}
+	clearedpassword_reset_tokens     bool
+	email_verification_tokens        map[uuid.UUID]struct{}
+	removedemail_verification_tokens map[uuid.UUID]struct{}
+	clearedemail_verification_tokens bool
+	refresh_tokens                   map[uuid.UUID]struct{}
+	removedrefresh_tokens            map[uuid.UUID]struct{}
+	clearedrefresh_tokens            bool
+	subscriptions                    map[uuid.UUID]struct{}
+	removedsubscriptions             map[uuid.UUID]struct{}
+	clearedsubscriptions             bool
+	token_usage                      map[uuid.UUID]struct{}
+	removedtoken_usage               map[uuid.UUID]struct{}
+	clearedtoken_usage               bool
+	ai_logs                          map[uuid.UUID]struct{}
+	removedai_logs                   map[uuid.UUID]struct{}
+	clearedai_logs                   bool
+	url_contents                     map[uuid.UUID]struct{}
+	removedurl_contents              map[uuid.UUID]struct{}
+	clearedurl_contents              bool
+	knowledge_topics                 map[uuid.UUID]struct{}
+	removedknowledge_topics          map[uuid.UUID]struct{}
+	clearedknowledge_topics          bool
+	done                             bool
+	oldValue                         func(context.Context) (*User, error)
+	predicates                       []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -12442,6 +20724,55 @@ func (m *UserMutation) ResetAuthProvider() {
 	m.auth_provider = nil
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (m *UserMutation) SetEmailVerifiedAt(t time.Time) {
+	m.email_verified_at = &t
+}
+
+// EmailVerifiedAt returns the value of the "email_verified_at" field in the mutation.
+func (m *UserMutation) EmailVerifiedAt() (r time.Time, exists bool) {
+	v := m.email_verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailVerifiedAt returns the old "email_verified_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmailVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailVerifiedAt: %w", err)
+	}
+	return oldValue.EmailVerifiedAt, nil
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (m *UserMutation) ClearEmailVerifiedAt() {
+	m.email_verified_at = nil
+	m.clearedFields[user.FieldEmailVerifiedAt] = struct{}{}
+}
+
+// EmailVerifiedAtCleared returns if the "email_verified_at" field was cleared in this mutation.
+func (m *UserMutation) EmailVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmailVerifiedAt]
+	return ok
+}
+
+// ResetEmailVerifiedAt resets all changes to the "email_verified_at" field.
+func (m *UserMutation) ResetEmailVerifiedAt() {
+	m.email_verified_at = nil
+	delete(m.clearedFields, user.FieldEmailVerifiedAt)
+}
+
 // SetSettingsID sets the "settings" edge to the UserSettings entity by id.
 func (m *UserMutation) SetSettingsID(id uuid.UUID) {
 	m.settings = &id
@@ -12589,6 +20920,114 @@ func (m *UserMutation) ResetPasswordResetTokens() {
 	m.removedpassword_reset_tokens = nil
 }
 
+// AddEmailVerificationTokenIDs adds the "email_verification_tokens" edge to the EmailVerificationToken entity by ids.
+func (m *UserMutation) AddEmailVerificationTokenIDs(ids ...uuid.UUID) {
+	if m.email_verification_tokens == nil {
+		m.email_verification_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.email_verification_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEmailVerificationTokens clears the "email_verification_tokens" edge to the EmailVerificationToken entity.
+func (m *UserMutation) ClearEmailVerificationTokens() {
+	m.clearedemail_verification_tokens = true
+}
+
+// EmailVerificationTokensCleared reports if the "email_verification_tokens" edge to the EmailVerificationToken entity was cleared.
+func (m *UserMutation) EmailVerificationTokensCleared() bool {
+	return m.clearedemail_verification_tokens
+}
+
+// RemoveEmailVerificationTokenIDs removes the "email_verification_tokens" edge to the EmailVerificationToken entity by IDs.
+func (m *UserMutation) RemoveEmailVerificationTokenIDs(ids ...uuid.UUID) {
+	if m.removedemail_verification_tokens == nil {
+		m.removedemail_verification_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.email_verification_tokens, ids[i])
+		m.removedemail_verification_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEmailVerificationTokens returns the removed IDs of the "email_verification_tokens" edge to the EmailVerificationToken entity.
+func (m *UserMutation) RemovedEmailVerificationTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedemail_verification_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EmailVerificationTokensIDs returns the "email_verification_tokens" edge IDs in the mutation.
+func (m *UserMutation) EmailVerificationTokensIDs() (ids []uuid.UUID) {
+	for id := range m.email_verification_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEmailVerificationTokens resets all changes to the "email_verification_tokens" edge.
+func (m *UserMutation) ResetEmailVerificationTokens() {
+	m.email_verification_tokens = nil
+	m.clearedemail_verification_tokens = false
+	m.removedemail_verification_tokens = nil
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
+func (m *UserMutation) AddRefreshTokenIDs(ids ...uuid.UUID) {
+	if m.refresh_tokens == nil {
+		m.refresh_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.refresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) ClearRefreshTokens() {
+	m.clearedrefresh_tokens = true
+}
+
+// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
+func (m *UserMutation) RefreshTokensCleared() bool {
+	return m.clearedrefresh_tokens
+}
+
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (m *UserMutation) RemoveRefreshTokenIDs(ids ...uuid.UUID) {
+	if m.removedrefresh_tokens == nil {
+		m.removedrefresh_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.refresh_tokens, ids[i])
+		m.removedrefresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) RemovedRefreshTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedrefresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
+func (m *UserMutation) RefreshTokensIDs() (ids []uuid.UUID) {
+	for id := range m.refresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
+func (m *UserMutation) ResetRefreshTokens() {
+	m.refresh_tokens = nil
+	m.clearedrefresh_tokens = false
+	m.removedrefresh_tokens = nil
+}
+
 // AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by ids.
 func (m *UserMutation) AddSubscriptionIDs(ids ...uuid.UUID) {
 	if m.subscriptions == nil {
@@ -12751,6 +21190,114 @@ func (m *UserMutation) ResetAiLogs() {
 	m.removedai_logs = nil
 }
 
+// AddURLContentIDs adds the "url_contents" edge to the URLContent entity by ids.
+func (m *UserMutation) AddURLContentIDs(ids ...uuid.UUID) {
+	if m.url_contents == nil {
+		m.url_contents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.url_contents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearURLContents clears the "url_contents" edge to the URLContent entity.
+func (m *UserMutation) ClearURLContents() {
+	m.clearedurl_contents = true
+}
+
+// URLContentsCleared reports if the "url_contents" edge to the URLContent entity was cleared.
+func (m *UserMutation) URLContentsCleared() bool {
+	return m.clearedurl_contents
+}
+
+// RemoveURLContentIDs removes the "url_contents" edge to the URLContent entity by IDs.
+func (m *UserMutation) RemoveURLContentIDs(ids ...uuid.UUID) {
+	if m.removedurl_contents == nil {
+		m.removedurl_contents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.url_contents, ids[i])
+		m.removedurl_contents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedURLContents returns the removed IDs of the "url_contents" edge to the URLContent entity.
+func (m *UserMutation) RemovedURLContentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedurl_contents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// URLContentsIDs returns the "url_contents" edge IDs in the mutation.
+func (m *UserMutation) URLContentsIDs() (ids []uuid.UUID) {
+	for id := range m.url_contents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetURLContents resets all changes to the "url_contents" edge.
+func (m *UserMutation) ResetURLContents() {
+	m.url_contents = nil
+	m.clearedurl_contents = false
+	m.removedurl_contents = nil
+}
+
+// AddKnowledgeTopicIDs adds the "knowledge_topics" edge to the KnowledgeTopic entity by ids.
+func (m *UserMutation) AddKnowledgeTopicIDs(ids ...uuid.UUID) {
+	if m.knowledge_topics == nil {
+		m.knowledge_topics = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.knowledge_topics[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKnowledgeTopics clears the "knowledge_topics" edge to the KnowledgeTopic entity.
+func (m *UserMutation) ClearKnowledgeTopics() {
+	m.clearedknowledge_topics = true
+}
+
+// KnowledgeTopicsCleared reports if the "knowledge_topics" edge to the KnowledgeTopic entity was cleared.
+func (m *UserMutation) KnowledgeTopicsCleared() bool {
+	return m.clearedknowledge_topics
+}
+
+// RemoveKnowledgeTopicIDs removes the "knowledge_topics" edge to the KnowledgeTopic entity by IDs.
+func (m *UserMutation) RemoveKnowledgeTopicIDs(ids ...uuid.UUID) {
+	if m.removedknowledge_topics == nil {
+		m.removedknowledge_topics = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.knowledge_topics, ids[i])
+		m.removedknowledge_topics[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKnowledgeTopics returns the removed IDs of the "knowledge_topics" edge to the KnowledgeTopic entity.
+func (m *UserMutation) RemovedKnowledgeTopicsIDs() (ids []uuid.UUID) {
+	for id := range m.removedknowledge_topics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KnowledgeTopicsIDs returns the "knowledge_topics" edge IDs in the mutation.
+func (m *UserMutation) KnowledgeTopicsIDs() (ids []uuid.UUID) {
+	for id := range m.knowledge_topics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKnowledgeTopics resets all changes to the "knowledge_topics" edge.
+func (m *UserMutation) ResetKnowledgeTopics() {
+	m.knowledge_topics = nil
+	m.clearedknowledge_topics = false
+	m.removedknowledge_topics = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -12785,7 +21332,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -12813,6 +21360,9 @@ func (m *UserMutation) Fields() []string {
 	if m.auth_provider != nil {
 		fields = append(fields, user.FieldAuthProvider)
 	}
+	if m.email_verified_at != nil {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
 	return fields
 }
 
@@ -12839,6 +21389,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.AvatarURL()
 	case user.FieldAuthProvider:
 		return m.AuthProvider()
+	case user.FieldEmailVerifiedAt:
+		return m.EmailVerifiedAt()
 	}
 	return nil, false
 }
@@ -12866,6 +21418,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldAvatarURL(ctx)
 	case user.FieldAuthProvider:
 		return m.OldAuthProvider(ctx)
+	case user.FieldEmailVerifiedAt:
+		return m.OldEmailVerifiedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -12938,6 +21492,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAuthProvider(v)
 		return nil
+	case user.FieldEmailVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailVerifiedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -12980,6 +21541,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldAvatarURL) {
 		fields = append(fields, user.FieldAvatarURL)
 	}
+	if m.FieldCleared(user.FieldEmailVerifiedAt) {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
 	return fields
 }
 
@@ -13006,6 +21570,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldAvatarURL:
 		m.ClearAvatarURL()
 		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ClearEmailVerifiedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -13041,13 +21608,16 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldAuthProvider:
 		m.ResetAuthProvider()
 		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ResetEmailVerifiedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 10)
 	if m.settings != nil {
 		edges = append(edges, user.EdgeSettings)
 	}
@@ -13057,6 +21627,12 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.password_reset_tokens != nil {
 		edges = append(edges, user.EdgePasswordResetTokens)
 	}
+	if m.email_verification_tokens != nil {
+		edges = append(edges, user.EdgeEmailVerificationTokens)
+	}
+	if m.refresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
 	if m.subscriptions != nil {
 		edges = append(edges, user.EdgeSubscriptions)
 	}
@@ -13066,6 +21642,12 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.ai_logs != nil {
 		edges = append(edges, user.EdgeAiLogs)
 	}
+	if m.url_contents != nil {
+		edges = append(edges, user.EdgeURLContents)
+	}
+	if m.knowledge_topics != nil {
+		edges = append(edges, user.EdgeKnowledgeTopics)
+	}
 	return edges
 }
 
@@ -13089,6 +21671,18 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeEmailVerificationTokens:
+		ids := make([]ent.Value, 0, len(m.email_verification_tokens))
+		for id := range m.email_verification_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.refresh_tokens))
+		for id := range m.refresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeSubscriptions:
 		ids := make([]ent.Value, 0, len(m.subscriptions))
 		for id := range m.subscriptions {
@@ -13107,19 +21701,37 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeURLContents:
+		ids := make([]ent.Value, 0, len(m.url_contents))
+		for id := range m.url_contents {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeKnowledgeTopics:
+		ids := make([]ent.Value, 0, len(m.knowledge_topics))
+		for id := range m.knowledge_topics {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 10)
 	if m.removedsessions != nil {
 		edges = append(edges, user.EdgeSessions)
 	}
 	if m.removedpassword_reset_tokens != nil {
 		edges = append(edges, user.EdgePasswordResetTokens)
 	}
+	if m.removedemail_verification_tokens != nil {
+		edges = append(edges, user.EdgeEmailVerificationTokens)
+	}
+	if m.removedrefresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
 	if m.removedsubscriptions != nil {
 		edges = append(edges, user.EdgeSubscriptions)
 	}
@@ -13129,6 +21741,12 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedai_logs != nil {
 		edges = append(edges, user.EdgeAiLogs)
 	}
+	if m.removedurl_contents != nil {
+		edges = append(edges, user.EdgeURLContents)
+	}
+	if m.removedknowledge_topics != nil {
+		edges = append(edges, user.EdgeKnowledgeTopics)
+	}
 	return edges
 }
 
@@ -13148,6 +21766,18 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeEmailVerificationTokens:
+		ids := make([]ent.Value, 0, len(m.removedemail_verification_tokens))
+		for id := range m.removedemail_verification_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.removedrefresh_tokens))
+		for id := range m.removedrefresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeSubscriptions:
 		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
 		for id := range m.removedsubscriptions {
@@ -13166,13 +21796,25 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeURLContents:
+		ids := make([]ent.Value, 0, len(m.removedurl_contents))
+		for id := range m.removedurl_contents {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeKnowledgeTopics:
+		ids := make([]ent.Value, 0, len(m.removedknowledge_topics))
+		for id := range m.removedknowledge_topics {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 10)
 	if m.clearedsettings {
 		edges = append(edges, user.EdgeSettings)
 	}
@@ -13182,6 +21824,12 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedpassword_reset_tokens {
 		edges = append(edges, user.EdgePasswordResetTokens)
 	}
+	if m.clearedemail_verification_tokens {
+		edges = append(edges, user.EdgeEmailVerificationTokens)
+	}
+	if m.clearedrefresh_tokens {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
 	if m.clearedsubscriptions {
 		edges = append(edges, user.EdgeSubscriptions)
 	}
@@ -13191,6 +21839,12 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedai_logs {
 		edges = append(edges, user.EdgeAiLogs)
 	}
+	if m.clearedurl_contents {
+		edges = append(edges, user.EdgeURLContents)
+	}
+	if m.clearedknowledge_topics {
+		edges = append(edges, user.EdgeKnowledgeTopics)
+	}
 	return edges
 }
 
@@ -13204,12 +21858,20 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedsessions
 	case user.EdgePasswordResetTokens:
 		return m.clearedpassword_reset_tokens
+	case user.EdgeEmailVerificationTokens:
+		return m.clearedemail_verification_tokens
+	case user.EdgeRefreshTokens:
+		return m.clearedrefresh_tokens
 	case user.EdgeSubscriptions:
 		return m.clearedsubscriptions
 	case user.EdgeTokenUsage:
 		return m.clearedtoken_usage
 	case user.EdgeAiLogs:
 		return m.clearedai_logs
+	case user.EdgeURLContents:
+		return m.clearedurl_contents
+	case user.EdgeKnowledgeTopics:
+		return m.clearedknowledge_topics
 	}
 	return false
 }
@@ -13238,6 +21900,12 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgePasswordResetTokens:
 		m.ResetPasswordResetTokens()
 		return nil
+	case user.EdgeEmailVerificationTokens:
+		m.ResetEmailVerificationTokens()
+		return nil
+	case user.EdgeRefreshTokens:
+		m.ResetRefreshTokens()
+		return nil
 	case user.EdgeSubscriptions:
 		m.ResetSubscriptions()
 		return nil
@@ -13247,6 +21915,12 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeAiLogs:
 		m.ResetAiLogs()
 		return nil
+	case user.EdgeURLContents:
+		m.ResetURLContents()
+		return nil
+	case user.EdgeKnowledgeTopics:
+		m.ResetKnowledgeTopics()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
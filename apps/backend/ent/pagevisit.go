@@ -33,6 +33,8 @@ type PageVisit struct {
 	DurationMs *int `json:"duration_ms,omitempty"`
 	// Maximum scroll depth (0-1)
 	MaxScrollDepth float64 `json:"max_scroll_depth,omitempty"`
+	// Number of clicks recorded on the page
+	ClickCount int `json:"click_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PageVisitQuery when eager-loading is set.
 	Edges               PageVisitEdges `json:"edges"`
@@ -81,7 +83,7 @@ func (*PageVisit) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case pagevisit.FieldMaxScrollDepth:
 			values[i] = new(sql.NullFloat64)
-		case pagevisit.FieldDurationMs:
+		case pagevisit.FieldDurationMs, pagevisit.FieldClickCount:
 			values[i] = new(sql.NullInt64)
 		case pagevisit.FieldCreatedAt, pagevisit.FieldUpdatedAt, pagevisit.FieldEnteredAt, pagevisit.FieldLeftAt:
 			values[i] = new(sql.NullTime)
@@ -150,6 +152,12 @@ func (_m *PageVisit) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MaxScrollDepth = value.Float64
 			}
+		case pagevisit.FieldClickCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field click_count", values[i])
+			} else if value.Valid {
+				_m.ClickCount = int(value.Int64)
+			}
 		case pagevisit.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field page_visit_url", values[i])
@@ -231,6 +239,9 @@ func (_m *PageVisit) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("max_scroll_depth=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MaxScrollDepth))
+	builder.WriteString(", ")
+	builder.WriteString("click_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClickCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
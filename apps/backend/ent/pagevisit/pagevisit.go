@@ -27,6 +27,8 @@ const (
 	FieldDurationMs = "duration_ms"
 	// FieldMaxScrollDepth holds the string denoting the max_scroll_depth field in the database.
 	FieldMaxScrollDepth = "max_scroll_depth"
+	// FieldClickCount holds the string denoting the click_count field in the database.
+	FieldClickCount = "click_count"
 	// EdgeSession holds the string denoting the session edge name in mutations.
 	EdgeSession = "session"
 	// EdgeURL holds the string denoting the url edge name in mutations.
@@ -58,6 +60,7 @@ var Columns = []string{
 	FieldLeftAt,
 	FieldDurationMs,
 	FieldMaxScrollDepth,
+	FieldClickCount,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "page_visits"
@@ -93,6 +96,10 @@ var (
 	DefaultEnteredAt func() time.Time
 	// DefaultMaxScrollDepth holds the default value on creation for the "max_scroll_depth" field.
 	DefaultMaxScrollDepth float64
+	// DefaultClickCount holds the default value on creation for the "click_count" field.
+	DefaultClickCount int
+	// ClickCountValidator is a validator for the "click_count" field. It is called by the builders before save.
+	ClickCountValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -135,6 +142,11 @@ func ByMaxScrollDepth(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxScrollDepth, opts...).ToFunc()
 }
 
+// ByClickCount orders the results by the click_count field.
+func ByClickCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClickCount, opts...).ToFunc()
+}
+
 // BySessionField orders the results by session field.
 func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
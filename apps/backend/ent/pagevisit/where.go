@@ -86,6 +86,11 @@ func MaxScrollDepth(v float64) predicate.PageVisit {
 	return predicate.PageVisit(sql.FieldEQ(FieldMaxScrollDepth, v))
 }
 
+// ClickCount applies equality check predicate on the "click_count" field. It's identical to ClickCountEQ.
+func ClickCount(v int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldEQ(FieldClickCount, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PageVisit {
 	return predicate.PageVisit(sql.FieldEQ(FieldCreatedAt, v))
@@ -346,6 +351,46 @@ func MaxScrollDepthLTE(v float64) predicate.PageVisit {
 	return predicate.PageVisit(sql.FieldLTE(FieldMaxScrollDepth, v))
 }
 
+// ClickCountEQ applies the EQ predicate on the "click_count" field.
+func ClickCountEQ(v int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldEQ(FieldClickCount, v))
+}
+
+// ClickCountNEQ applies the NEQ predicate on the "click_count" field.
+func ClickCountNEQ(v int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldNEQ(FieldClickCount, v))
+}
+
+// ClickCountIn applies the In predicate on the "click_count" field.
+func ClickCountIn(vs ...int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldIn(FieldClickCount, vs...))
+}
+
+// ClickCountNotIn applies the NotIn predicate on the "click_count" field.
+func ClickCountNotIn(vs ...int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldNotIn(FieldClickCount, vs...))
+}
+
+// ClickCountGT applies the GT predicate on the "click_count" field.
+func ClickCountGT(v int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldGT(FieldClickCount, v))
+}
+
+// ClickCountGTE applies the GTE predicate on the "click_count" field.
+func ClickCountGTE(v int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldGTE(FieldClickCount, v))
+}
+
+// ClickCountLT applies the LT predicate on the "click_count" field.
+func ClickCountLT(v int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldLT(FieldClickCount, v))
+}
+
+// ClickCountLTE applies the LTE predicate on the "click_count" field.
+func ClickCountLTE(v int) predicate.PageVisit {
+	return predicate.PageVisit(sql.FieldLTE(FieldClickCount, v))
+}
+
 // HasSession applies the HasEdge predicate on the "session" edge.
 func HasSession() predicate.PageVisit {
 	return predicate.PageVisit(func(s *sql.Selector) {
@@ -107,6 +107,20 @@ func (_c *PageVisitCreate) SetNillableMaxScrollDepth(v *float64) *PageVisitCreat
 	return _c
 }
 
+// SetClickCount sets the "click_count" field.
+func (_c *PageVisitCreate) SetClickCount(v int) *PageVisitCreate {
+	_c.mutation.SetClickCount(v)
+	return _c
+}
+
+// SetNillableClickCount sets the "click_count" field if the given value is not nil.
+func (_c *PageVisitCreate) SetNillableClickCount(v *int) *PageVisitCreate {
+	if v != nil {
+		_c.SetClickCount(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PageVisitCreate) SetID(v uuid.UUID) *PageVisitCreate {
 	_c.mutation.SetID(v)
@@ -194,6 +208,10 @@ func (_c *PageVisitCreate) defaults() {
 		v := pagevisit.DefaultMaxScrollDepth
 		_c.mutation.SetMaxScrollDepth(v)
 	}
+	if _, ok := _c.mutation.ClickCount(); !ok {
+		v := pagevisit.DefaultClickCount
+		_c.mutation.SetClickCount(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := pagevisit.DefaultID()
 		_c.mutation.SetID(v)
@@ -214,6 +232,14 @@ func (_c *PageVisitCreate) check() error {
 	if _, ok := _c.mutation.MaxScrollDepth(); !ok {
 		return &ValidationError{Name: "max_scroll_depth", err: errors.New(`ent: missing required field "PageVisit.max_scroll_depth"`)}
 	}
+	if _, ok := _c.mutation.ClickCount(); !ok {
+		return &ValidationError{Name: "click_count", err: errors.New(`ent: missing required field "PageVisit.click_count"`)}
+	}
+	if v, ok := _c.mutation.ClickCount(); ok {
+		if err := pagevisit.ClickCountValidator(v); err != nil {
+			return &ValidationError{Name: "click_count", err: fmt.Errorf(`ent: validator failed for field "PageVisit.click_count": %w`, err)}
+		}
+	}
 	if len(_c.mutation.SessionIDs()) == 0 {
 		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "PageVisit.session"`)}
 	}
@@ -279,6 +305,10 @@ func (_c *PageVisitCreate) createSpec() (*PageVisit, *sqlgraph.CreateSpec) {
 		_spec.SetField(pagevisit.FieldMaxScrollDepth, field.TypeFloat64, value)
 		_node.MaxScrollDepth = value
 	}
+	if value, ok := _c.mutation.ClickCount(); ok {
+		_spec.SetField(pagevisit.FieldClickCount, field.TypeInt, value)
+		_node.ClickCount = value
+	}
 	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
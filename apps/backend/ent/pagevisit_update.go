@@ -119,6 +119,27 @@ func (_u *PageVisitUpdate) AddMaxScrollDepth(v float64) *PageVisitUpdate {
 	return _u
 }
 
+// SetClickCount sets the "click_count" field.
+func (_u *PageVisitUpdate) SetClickCount(v int) *PageVisitUpdate {
+	_u.mutation.ResetClickCount()
+	_u.mutation.SetClickCount(v)
+	return _u
+}
+
+// SetNillableClickCount sets the "click_count" field if the given value is not nil.
+func (_u *PageVisitUpdate) SetNillableClickCount(v *int) *PageVisitUpdate {
+	if v != nil {
+		_u.SetClickCount(*v)
+	}
+	return _u
+}
+
+// AddClickCount adds value to the "click_count" field.
+func (_u *PageVisitUpdate) AddClickCount(v int) *PageVisitUpdate {
+	_u.mutation.AddClickCount(v)
+	return _u
+}
+
 // SetSessionID sets the "session" edge to the Session entity by ID.
 func (_u *PageVisitUpdate) SetSessionID(id uuid.UUID) *PageVisitUpdate {
 	_u.mutation.SetSessionID(id)
@@ -196,6 +217,11 @@ func (_u *PageVisitUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *PageVisitUpdate) check() error {
+	if v, ok := _u.mutation.ClickCount(); ok {
+		if err := pagevisit.ClickCountValidator(v); err != nil {
+			return &ValidationError{Name: "click_count", err: fmt.Errorf(`ent: validator failed for field "PageVisit.click_count": %w`, err)}
+		}
+	}
 	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PageVisit.session"`)
 	}
@@ -244,6 +270,12 @@ func (_u *PageVisitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedMaxScrollDepth(); ok {
 		_spec.AddField(pagevisit.FieldMaxScrollDepth, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.ClickCount(); ok {
+		_spec.SetField(pagevisit.FieldClickCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClickCount(); ok {
+		_spec.AddField(pagevisit.FieldClickCount, field.TypeInt, value)
+	}
 	if _u.mutation.SessionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -410,6 +442,27 @@ func (_u *PageVisitUpdateOne) AddMaxScrollDepth(v float64) *PageVisitUpdateOne {
 	return _u
 }
 
+// SetClickCount sets the "click_count" field.
+func (_u *PageVisitUpdateOne) SetClickCount(v int) *PageVisitUpdateOne {
+	_u.mutation.ResetClickCount()
+	_u.mutation.SetClickCount(v)
+	return _u
+}
+
+// SetNillableClickCount sets the "click_count" field if the given value is not nil.
+func (_u *PageVisitUpdateOne) SetNillableClickCount(v *int) *PageVisitUpdateOne {
+	if v != nil {
+		_u.SetClickCount(*v)
+	}
+	return _u
+}
+
+// AddClickCount adds value to the "click_count" field.
+func (_u *PageVisitUpdateOne) AddClickCount(v int) *PageVisitUpdateOne {
+	_u.mutation.AddClickCount(v)
+	return _u
+}
+
 // SetSessionID sets the "session" edge to the Session entity by ID.
 func (_u *PageVisitUpdateOne) SetSessionID(id uuid.UUID) *PageVisitUpdateOne {
 	_u.mutation.SetSessionID(id)
@@ -500,6 +553,11 @@ func (_u *PageVisitUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *PageVisitUpdateOne) check() error {
+	if v, ok := _u.mutation.ClickCount(); ok {
+		if err := pagevisit.ClickCountValidator(v); err != nil {
+			return &ValidationError{Name: "click_count", err: fmt.Errorf(`ent: validator failed for field "PageVisit.click_count": %w`, err)}
+		}
+	}
 	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PageVisit.session"`)
 	}
@@ -565,6 +623,12 @@ func (_u *PageVisitUpdateOne) sqlSave(ctx context.Context) (_node *PageVisit, er
 	if value, ok := _u.mutation.AddedMaxScrollDepth(); ok {
 		_spec.AddField(pagevisit.FieldMaxScrollDepth, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.ClickCount(); ok {
+		_spec.SetField(pagevisit.FieldClickCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClickCount(); ok {
+		_spec.AddField(pagevisit.FieldClickCount, field.TypeInt, value)
+	}
 	if _u.mutation.SessionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
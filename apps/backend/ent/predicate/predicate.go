@@ -12,12 +12,30 @@ type AIConfig func(*sql.Selector)
 // AILog is the predicate function for ailog builders.
 type AILog func(*sql.Selector)
 
+// EmailVerificationToken is the predicate function for emailverificationtoken builders.
+type EmailVerificationToken func(*sql.Selector)
+
 // Highlight is the predicate function for highlight builders.
 type Highlight func(*sql.Selector)
 
+// KnowledgeMention is the predicate function for knowledgemention builders.
+type KnowledgeMention func(*sql.Selector)
+
+// KnowledgeTopic is the predicate function for knowledgetopic builders.
+type KnowledgeTopic func(*sql.Selector)
+
+// MindmapEdit is the predicate function for mindmapedit builders.
+type MindmapEdit func(*sql.Selector)
+
 // MindmapGraph is the predicate function for mindmapgraph builders.
 type MindmapGraph func(*sql.Selector)
 
+// MindmapRevision is the predicate function for mindmaprevision builders.
+type MindmapRevision func(*sql.Selector)
+
+// ModelPricing is the predicate function for modelpricing builders.
+type ModelPricing func(*sql.Selector)
+
 // PageVisit is the predicate function for pagevisit builders.
 type PageVisit func(*sql.Selector)
 
@@ -27,12 +45,21 @@ type PasswordResetToken func(*sql.Selector)
 // Plan is the predicate function for plan builders.
 type Plan func(*sql.Selector)
 
+// PromptTemplate is the predicate function for prompttemplate builders.
+type PromptTemplate func(*sql.Selector)
+
 // RawEvent is the predicate function for rawevent builders.
 type RawEvent func(*sql.Selector)
 
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
+// StripeEvent is the predicate function for stripeevent builders.
+type StripeEvent func(*sql.Selector)
+
 // Subscription is the predicate function for subscription builders.
 type Subscription func(*sql.Selector)
 
@@ -42,6 +69,9 @@ type TokenUsage func(*sql.Selector)
 // URL is the predicate function for url builders.
 type URL func(*sql.Selector)
 
+// URLContent is the predicate function for urlcontent builders.
+type URLContent func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
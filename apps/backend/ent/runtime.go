@@ -8,17 +8,27 @@ import (
 	"github.com/google/uuid"
 	"github.com/mindhit/api/ent/aiconfig"
 	"github.com/mindhit/api/ent/ailog"
+	"github.com/mindhit/api/ent/emailverificationtoken"
 	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/mindmapedit"
 	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
+	"github.com/mindhit/api/ent/modelpricing"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/passwordresettoken"
 	"github.com/mindhit/api/ent/plan"
+	"github.com/mindhit/api/ent/prompttemplate"
 	"github.com/mindhit/api/ent/rawevent"
+	"github.com/mindhit/api/ent/refreshtoken"
 	"github.com/mindhit/api/ent/schema"
 	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/ent/stripeevent"
 	"github.com/mindhit/api/ent/subscription"
 	"github.com/mindhit/api/ent/tokenusage"
 	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
 	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/ent/usersettings"
 )
@@ -57,16 +67,22 @@ func init() {
 	aiconfigDescJSONMode := aiconfigFields[7].Descriptor()
 	// aiconfig.DefaultJSONMode holds the default value on creation for the json_mode field.
 	aiconfig.DefaultJSONMode = aiconfigDescJSONMode.Default.(bool)
+	// aiconfigDescCacheTTLSeconds is the schema descriptor for cache_ttl_seconds field.
+	aiconfigDescCacheTTLSeconds := aiconfigFields[8].Descriptor()
+	// aiconfig.DefaultCacheTTLSeconds holds the default value on creation for the cache_ttl_seconds field.
+	aiconfig.DefaultCacheTTLSeconds = aiconfigDescCacheTTLSeconds.Default.(int)
+	// aiconfig.CacheTTLSecondsValidator is a validator for the "cache_ttl_seconds" field. It is called by the builders before save.
+	aiconfig.CacheTTLSecondsValidator = aiconfigDescCacheTTLSeconds.Validators[0].(func(int) error)
 	// aiconfigDescEnabled is the schema descriptor for enabled field.
-	aiconfigDescEnabled := aiconfigFields[8].Descriptor()
+	aiconfigDescEnabled := aiconfigFields[9].Descriptor()
 	// aiconfig.DefaultEnabled holds the default value on creation for the enabled field.
 	aiconfig.DefaultEnabled = aiconfigDescEnabled.Default.(bool)
 	// aiconfigDescCreatedAt is the schema descriptor for created_at field.
-	aiconfigDescCreatedAt := aiconfigFields[10].Descriptor()
+	aiconfigDescCreatedAt := aiconfigFields[11].Descriptor()
 	// aiconfig.DefaultCreatedAt holds the default value on creation for the created_at field.
 	aiconfig.DefaultCreatedAt = aiconfigDescCreatedAt.Default.(func() time.Time)
 	// aiconfigDescUpdatedAt is the schema descriptor for updated_at field.
-	aiconfigDescUpdatedAt := aiconfigFields[11].Descriptor()
+	aiconfigDescUpdatedAt := aiconfigFields[12].Descriptor()
 	// aiconfig.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	aiconfig.DefaultUpdatedAt = aiconfigDescUpdatedAt.Default.(func() time.Time)
 	// aiconfig.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -89,34 +105,56 @@ func init() {
 	ailogDescInputTokens := ailogFields[10].Descriptor()
 	// ailog.DefaultInputTokens holds the default value on creation for the input_tokens field.
 	ailog.DefaultInputTokens = ailogDescInputTokens.Default.(int)
+	// ailogDescCachedInputTokens is the schema descriptor for cached_input_tokens field.
+	ailogDescCachedInputTokens := ailogFields[11].Descriptor()
+	// ailog.DefaultCachedInputTokens holds the default value on creation for the cached_input_tokens field.
+	ailog.DefaultCachedInputTokens = ailogDescCachedInputTokens.Default.(int)
 	// ailogDescOutputTokens is the schema descriptor for output_tokens field.
-	ailogDescOutputTokens := ailogFields[11].Descriptor()
+	ailogDescOutputTokens := ailogFields[12].Descriptor()
 	// ailog.DefaultOutputTokens holds the default value on creation for the output_tokens field.
 	ailog.DefaultOutputTokens = ailogDescOutputTokens.Default.(int)
 	// ailogDescThinkingTokens is the schema descriptor for thinking_tokens field.
-	ailogDescThinkingTokens := ailogFields[12].Descriptor()
+	ailogDescThinkingTokens := ailogFields[13].Descriptor()
 	// ailog.DefaultThinkingTokens holds the default value on creation for the thinking_tokens field.
 	ailog.DefaultThinkingTokens = ailogDescThinkingTokens.Default.(int)
 	// ailogDescTotalTokens is the schema descriptor for total_tokens field.
-	ailogDescTotalTokens := ailogFields[13].Descriptor()
+	ailogDescTotalTokens := ailogFields[14].Descriptor()
 	// ailog.DefaultTotalTokens holds the default value on creation for the total_tokens field.
 	ailog.DefaultTotalTokens = ailogDescTotalTokens.Default.(int)
 	// ailogDescLatencyMs is the schema descriptor for latency_ms field.
-	ailogDescLatencyMs := ailogFields[14].Descriptor()
+	ailogDescLatencyMs := ailogFields[15].Descriptor()
 	// ailog.DefaultLatencyMs holds the default value on creation for the latency_ms field.
 	ailog.DefaultLatencyMs = ailogDescLatencyMs.Default.(int64)
-	// ailogDescEstimatedCostCents is the schema descriptor for estimated_cost_cents field.
-	ailogDescEstimatedCostCents := ailogFields[18].Descriptor()
-	// ailog.DefaultEstimatedCostCents holds the default value on creation for the estimated_cost_cents field.
-	ailog.DefaultEstimatedCostCents = ailogDescEstimatedCostCents.Default.(int)
+	// ailogDescCached is the schema descriptor for cached field.
+	ailogDescCached := ailogFields[17].Descriptor()
+	// ailog.DefaultCached holds the default value on creation for the cached field.
+	ailog.DefaultCached = ailogDescCached.Default.(bool)
 	// ailogDescCreatedAt is the schema descriptor for created_at field.
-	ailogDescCreatedAt := ailogFields[20].Descriptor()
+	ailogDescCreatedAt := ailogFields[22].Descriptor()
 	// ailog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	ailog.DefaultCreatedAt = ailogDescCreatedAt.Default.(func() time.Time)
 	// ailogDescID is the schema descriptor for id field.
 	ailogDescID := ailogFields[0].Descriptor()
 	// ailog.DefaultID holds the default value on creation for the id field.
 	ailog.DefaultID = ailogDescID.Default.(func() uuid.UUID)
+	emailverificationtokenFields := schema.EmailVerificationToken{}.Fields()
+	_ = emailverificationtokenFields
+	// emailverificationtokenDescToken is the schema descriptor for token field.
+	emailverificationtokenDescToken := emailverificationtokenFields[1].Descriptor()
+	// emailverificationtoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	emailverificationtoken.TokenValidator = emailverificationtokenDescToken.Validators[0].(func(string) error)
+	// emailverificationtokenDescCreatedAt is the schema descriptor for created_at field.
+	emailverificationtokenDescCreatedAt := emailverificationtokenFields[4].Descriptor()
+	// emailverificationtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailverificationtoken.DefaultCreatedAt = emailverificationtokenDescCreatedAt.Default.(func() time.Time)
+	// emailverificationtokenDescUsed is the schema descriptor for used field.
+	emailverificationtokenDescUsed := emailverificationtokenFields[5].Descriptor()
+	// emailverificationtoken.DefaultUsed holds the default value on creation for the used field.
+	emailverificationtoken.DefaultUsed = emailverificationtokenDescUsed.Default.(bool)
+	// emailverificationtokenDescID is the schema descriptor for id field.
+	emailverificationtokenDescID := emailverificationtokenFields[0].Descriptor()
+	// emailverificationtoken.DefaultID holds the default value on creation for the id field.
+	emailverificationtoken.DefaultID = emailverificationtokenDescID.Default.(func() uuid.UUID)
 	highlightMixin := schema.Highlight{}.Mixin()
 	highlightMixinFields0 := highlightMixin[0].Fields()
 	_ = highlightMixinFields0
@@ -144,6 +182,53 @@ func init() {
 	highlightDescID := highlightMixinFields0[0].Descriptor()
 	// highlight.DefaultID holds the default value on creation for the id field.
 	highlight.DefaultID = highlightDescID.Default.(func() uuid.UUID)
+	knowledgementionFields := schema.KnowledgeMention{}.Fields()
+	_ = knowledgementionFields
+	// knowledgementionDescNodeID is the schema descriptor for node_id field.
+	knowledgementionDescNodeID := knowledgementionFields[1].Descriptor()
+	// knowledgemention.NodeIDValidator is a validator for the "node_id" field. It is called by the builders before save.
+	knowledgemention.NodeIDValidator = knowledgementionDescNodeID.Validators[0].(func(string) error)
+	// knowledgementionDescLabel is the schema descriptor for label field.
+	knowledgementionDescLabel := knowledgementionFields[2].Descriptor()
+	// knowledgemention.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	knowledgemention.LabelValidator = knowledgementionDescLabel.Validators[0].(func(string) error)
+	// knowledgementionDescCreatedAt is the schema descriptor for created_at field.
+	knowledgementionDescCreatedAt := knowledgementionFields[5].Descriptor()
+	// knowledgemention.DefaultCreatedAt holds the default value on creation for the created_at field.
+	knowledgemention.DefaultCreatedAt = knowledgementionDescCreatedAt.Default.(func() time.Time)
+	// knowledgementionDescID is the schema descriptor for id field.
+	knowledgementionDescID := knowledgementionFields[0].Descriptor()
+	// knowledgemention.DefaultID holds the default value on creation for the id field.
+	knowledgemention.DefaultID = knowledgementionDescID.Default.(func() uuid.UUID)
+	knowledgetopicMixin := schema.KnowledgeTopic{}.Mixin()
+	knowledgetopicMixinFields0 := knowledgetopicMixin[0].Fields()
+	_ = knowledgetopicMixinFields0
+	knowledgetopicFields := schema.KnowledgeTopic{}.Fields()
+	_ = knowledgetopicFields
+	// knowledgetopicDescCreatedAt is the schema descriptor for created_at field.
+	knowledgetopicDescCreatedAt := knowledgetopicMixinFields0[1].Descriptor()
+	// knowledgetopic.DefaultCreatedAt holds the default value on creation for the created_at field.
+	knowledgetopic.DefaultCreatedAt = knowledgetopicDescCreatedAt.Default.(func() time.Time)
+	// knowledgetopicDescUpdatedAt is the schema descriptor for updated_at field.
+	knowledgetopicDescUpdatedAt := knowledgetopicMixinFields0[2].Descriptor()
+	// knowledgetopic.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	knowledgetopic.DefaultUpdatedAt = knowledgetopicDescUpdatedAt.Default.(func() time.Time)
+	// knowledgetopic.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	knowledgetopic.UpdateDefaultUpdatedAt = knowledgetopicDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// knowledgetopicDescLabel is the schema descriptor for label field.
+	knowledgetopicDescLabel := knowledgetopicFields[0].Descriptor()
+	// knowledgetopic.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	knowledgetopic.LabelValidator = knowledgetopicDescLabel.Validators[0].(func(string) error)
+	// knowledgetopicDescID is the schema descriptor for id field.
+	knowledgetopicDescID := knowledgetopicMixinFields0[0].Descriptor()
+	// knowledgetopic.DefaultID holds the default value on creation for the id field.
+	knowledgetopic.DefaultID = knowledgetopicDescID.Default.(func() uuid.UUID)
+	mindmapeditFields := schema.MindmapEdit{}.Fields()
+	_ = mindmapeditFields
+	// mindmapeditDescCreatedAt is the schema descriptor for created_at field.
+	mindmapeditDescCreatedAt := mindmapeditFields[2].Descriptor()
+	// mindmapedit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mindmapedit.DefaultCreatedAt = mindmapeditDescCreatedAt.Default.(func() time.Time)
 	mindmapgraphMixin := schema.MindmapGraph{}.Mixin()
 	mindmapgraphMixinFields0 := mindmapgraphMixin[0].Fields()
 	_ = mindmapgraphMixinFields0
@@ -171,6 +256,56 @@ func init() {
 	mindmapgraphDescID := mindmapgraphMixinFields0[0].Descriptor()
 	// mindmapgraph.DefaultID holds the default value on creation for the id field.
 	mindmapgraph.DefaultID = mindmapgraphDescID.Default.(func() uuid.UUID)
+	mindmaprevisionFields := schema.MindmapRevision{}.Fields()
+	_ = mindmaprevisionFields
+	// mindmaprevisionDescVersion is the schema descriptor for version field.
+	mindmaprevisionDescVersion := mindmaprevisionFields[1].Descriptor()
+	// mindmaprevision.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	mindmaprevision.VersionValidator = mindmaprevisionDescVersion.Validators[0].(func(int) error)
+	// mindmaprevisionDescCreatedAt is the schema descriptor for created_at field.
+	mindmaprevisionDescCreatedAt := mindmaprevisionFields[7].Descriptor()
+	// mindmaprevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mindmaprevision.DefaultCreatedAt = mindmaprevisionDescCreatedAt.Default.(func() time.Time)
+	// mindmaprevisionDescID is the schema descriptor for id field.
+	mindmaprevisionDescID := mindmaprevisionFields[0].Descriptor()
+	// mindmaprevision.DefaultID holds the default value on creation for the id field.
+	mindmaprevision.DefaultID = mindmaprevisionDescID.Default.(func() uuid.UUID)
+	modelpricingFields := schema.ModelPricing{}.Fields()
+	_ = modelpricingFields
+	// modelpricingDescProvider is the schema descriptor for provider field.
+	modelpricingDescProvider := modelpricingFields[0].Descriptor()
+	// modelpricing.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	modelpricing.ProviderValidator = modelpricingDescProvider.Validators[0].(func(string) error)
+	// modelpricingDescModel is the schema descriptor for model field.
+	modelpricingDescModel := modelpricingFields[1].Descriptor()
+	// modelpricing.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	modelpricing.ModelValidator = modelpricingDescModel.Validators[0].(func(string) error)
+	// modelpricingDescInputPrice is the schema descriptor for input_price field.
+	modelpricingDescInputPrice := modelpricingFields[2].Descriptor()
+	// modelpricing.InputPriceValidator is a validator for the "input_price" field. It is called by the builders before save.
+	modelpricing.InputPriceValidator = modelpricingDescInputPrice.Validators[0].(func(float64) error)
+	// modelpricingDescOutputPrice is the schema descriptor for output_price field.
+	modelpricingDescOutputPrice := modelpricingFields[3].Descriptor()
+	// modelpricing.OutputPriceValidator is a validator for the "output_price" field. It is called by the builders before save.
+	modelpricing.OutputPriceValidator = modelpricingDescOutputPrice.Validators[0].(func(float64) error)
+	// modelpricingDescThinkingPrice is the schema descriptor for thinking_price field.
+	modelpricingDescThinkingPrice := modelpricingFields[4].Descriptor()
+	// modelpricing.ThinkingPriceValidator is a validator for the "thinking_price" field. It is called by the builders before save.
+	modelpricing.ThinkingPriceValidator = modelpricingDescThinkingPrice.Validators[0].(func(float64) error)
+	// modelpricingDescCachedInputPrice is the schema descriptor for cached_input_price field.
+	modelpricingDescCachedInputPrice := modelpricingFields[5].Descriptor()
+	// modelpricing.CachedInputPriceValidator is a validator for the "cached_input_price" field. It is called by the builders before save.
+	modelpricing.CachedInputPriceValidator = modelpricingDescCachedInputPrice.Validators[0].(func(float64) error)
+	// modelpricingDescCreatedAt is the schema descriptor for created_at field.
+	modelpricingDescCreatedAt := modelpricingFields[9].Descriptor()
+	// modelpricing.DefaultCreatedAt holds the default value on creation for the created_at field.
+	modelpricing.DefaultCreatedAt = modelpricingDescCreatedAt.Default.(func() time.Time)
+	// modelpricingDescUpdatedAt is the schema descriptor for updated_at field.
+	modelpricingDescUpdatedAt := modelpricingFields[10].Descriptor()
+	// modelpricing.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	modelpricing.DefaultUpdatedAt = modelpricingDescUpdatedAt.Default.(func() time.Time)
+	// modelpricing.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	modelpricing.UpdateDefaultUpdatedAt = modelpricingDescUpdatedAt.UpdateDefault.(func() time.Time)
 	pagevisitMixin := schema.PageVisit{}.Mixin()
 	pagevisitMixinFields0 := pagevisitMixin[0].Fields()
 	_ = pagevisitMixinFields0
@@ -194,6 +329,12 @@ func init() {
 	pagevisitDescMaxScrollDepth := pagevisitFields[3].Descriptor()
 	// pagevisit.DefaultMaxScrollDepth holds the default value on creation for the max_scroll_depth field.
 	pagevisit.DefaultMaxScrollDepth = pagevisitDescMaxScrollDepth.Default.(float64)
+	// pagevisitDescClickCount is the schema descriptor for click_count field.
+	pagevisitDescClickCount := pagevisitFields[4].Descriptor()
+	// pagevisit.DefaultClickCount holds the default value on creation for the click_count field.
+	pagevisit.DefaultClickCount = pagevisitDescClickCount.Default.(int)
+	// pagevisit.ClickCountValidator is a validator for the "click_count" field. It is called by the builders before save.
+	pagevisit.ClickCountValidator = pagevisitDescClickCount.Validators[0].(func(int) error)
 	// pagevisitDescID is the schema descriptor for id field.
 	pagevisitDescID := pagevisitMixinFields0[0].Descriptor()
 	// pagevisit.DefaultID holds the default value on creation for the id field.
@@ -238,6 +379,38 @@ func init() {
 	planDescCreatedAt := planFields[8].Descriptor()
 	// plan.DefaultCreatedAt holds the default value on creation for the created_at field.
 	plan.DefaultCreatedAt = planDescCreatedAt.Default.(func() time.Time)
+	prompttemplateFields := schema.PromptTemplate{}.Fields()
+	_ = prompttemplateFields
+	// prompttemplateDescName is the schema descriptor for name field.
+	prompttemplateDescName := prompttemplateFields[0].Descriptor()
+	// prompttemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	prompttemplate.NameValidator = prompttemplateDescName.Validators[0].(func(string) error)
+	// prompttemplateDescLocale is the schema descriptor for locale field.
+	prompttemplateDescLocale := prompttemplateFields[1].Descriptor()
+	// prompttemplate.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	prompttemplate.LocaleValidator = prompttemplateDescLocale.Validators[0].(func(string) error)
+	// prompttemplateDescVersion is the schema descriptor for version field.
+	prompttemplateDescVersion := prompttemplateFields[2].Descriptor()
+	// prompttemplate.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	prompttemplate.VersionValidator = prompttemplateDescVersion.Validators[0].(func(int) error)
+	// prompttemplateDescBody is the schema descriptor for body field.
+	prompttemplateDescBody := prompttemplateFields[3].Descriptor()
+	// prompttemplate.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	prompttemplate.BodyValidator = prompttemplateDescBody.Validators[0].(func(string) error)
+	// prompttemplateDescEnabled is the schema descriptor for enabled field.
+	prompttemplateDescEnabled := prompttemplateFields[4].Descriptor()
+	// prompttemplate.DefaultEnabled holds the default value on creation for the enabled field.
+	prompttemplate.DefaultEnabled = prompttemplateDescEnabled.Default.(bool)
+	// prompttemplateDescCreatedAt is the schema descriptor for created_at field.
+	prompttemplateDescCreatedAt := prompttemplateFields[6].Descriptor()
+	// prompttemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	prompttemplate.DefaultCreatedAt = prompttemplateDescCreatedAt.Default.(func() time.Time)
+	// prompttemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	prompttemplateDescUpdatedAt := prompttemplateFields[7].Descriptor()
+	// prompttemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	prompttemplate.DefaultUpdatedAt = prompttemplateDescUpdatedAt.Default.(func() time.Time)
+	// prompttemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	prompttemplate.UpdateDefaultUpdatedAt = prompttemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
 	raweventMixin := schema.RawEvent{}.Mixin()
 	raweventMixinFields0 := raweventMixin[0].Fields()
 	_ = raweventMixinFields0
@@ -265,6 +438,12 @@ func init() {
 	raweventDescID := raweventMixinFields0[0].Descriptor()
 	// rawevent.DefaultID holds the default value on creation for the id field.
 	rawevent.DefaultID = raweventDescID.Default.(func() uuid.UUID)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
 	sessionMixin := schema.Session{}.Mixin()
 	sessionMixinFields0 := sessionMixin[0].Fields()
 	_ = sessionMixinFields0
@@ -290,6 +469,20 @@ func init() {
 	sessionDescID := sessionMixinFields0[0].Descriptor()
 	// session.DefaultID holds the default value on creation for the id field.
 	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	stripeeventFields := schema.StripeEvent{}.Fields()
+	_ = stripeeventFields
+	// stripeeventDescType is the schema descriptor for type field.
+	stripeeventDescType := stripeeventFields[1].Descriptor()
+	// stripeevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	stripeevent.TypeValidator = stripeeventDescType.Validators[0].(func(string) error)
+	// stripeeventDescProcessedAt is the schema descriptor for processed_at field.
+	stripeeventDescProcessedAt := stripeeventFields[2].Descriptor()
+	// stripeevent.DefaultProcessedAt holds the default value on creation for the processed_at field.
+	stripeevent.DefaultProcessedAt = stripeeventDescProcessedAt.Default.(func() time.Time)
+	// stripeeventDescID is the schema descriptor for id field.
+	stripeeventDescID := stripeeventFields[0].Descriptor()
+	// stripeevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	stripeevent.IDValidator = stripeeventDescID.Validators[0].(func(string) error)
 	subscriptionMixin := schema.Subscription{}.Mixin()
 	subscriptionMixinFields0 := subscriptionMixin[0].Fields()
 	_ = subscriptionMixinFields0
@@ -367,6 +560,25 @@ func init() {
 	urlDescID := urlMixinFields0[0].Descriptor()
 	// url.DefaultID holds the default value on creation for the id field.
 	url.DefaultID = urlDescID.Default.(func() uuid.UUID)
+	urlcontentMixin := schema.URLContent{}.Mixin()
+	urlcontentMixinFields0 := urlcontentMixin[0].Fields()
+	_ = urlcontentMixinFields0
+	urlcontentFields := schema.URLContent{}.Fields()
+	_ = urlcontentFields
+	// urlcontentDescCreatedAt is the schema descriptor for created_at field.
+	urlcontentDescCreatedAt := urlcontentMixinFields0[1].Descriptor()
+	// urlcontent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	urlcontent.DefaultCreatedAt = urlcontentDescCreatedAt.Default.(func() time.Time)
+	// urlcontentDescUpdatedAt is the schema descriptor for updated_at field.
+	urlcontentDescUpdatedAt := urlcontentMixinFields0[2].Descriptor()
+	// urlcontent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	urlcontent.DefaultUpdatedAt = urlcontentDescUpdatedAt.Default.(func() time.Time)
+	// urlcontent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	urlcontent.UpdateDefaultUpdatedAt = urlcontentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// urlcontentDescID is the schema descriptor for id field.
+	urlcontentDescID := urlcontentMixinFields0[0].Descriptor()
+	// urlcontent.DefaultID holds the default value on creation for the id field.
+	urlcontent.DefaultID = urlcontentDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
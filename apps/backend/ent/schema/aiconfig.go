@@ -22,15 +22,15 @@ func (AIConfig) Fields() []ent.Field {
 		field.String("task_type").
 			NotEmpty().
 			Unique().
-			Comment("Task type: 'default', 'tag_extraction', 'mindmap'"),
+			Comment("Task type: 'default', 'tag_extraction', 'summarize', 'mindmap'"),
 
 		// Provider settings
 		field.String("provider").
 			NotEmpty().
-			Comment("AI provider: 'openai', 'claude', 'gemini'"),
+			Comment("AI provider: 'openai', 'claude', 'gemini', 'openai_compatible', 'fake'"),
 		field.String("model").
 			NotEmpty().
-			Comment("Model name: 'gpt-4o', 'claude-sonnet-4', 'gemini-2.0-flash'"),
+			Comment("Model name: 'gpt-4o', 'claude-sonnet-4', 'gemini-2.0-flash', 'llama3.1:8b'"),
 
 		// Fallback providers
 		field.JSON("fallback_providers", []string{}).
@@ -50,6 +50,10 @@ func (AIConfig) Fields() []ent.Field {
 		field.Bool("json_mode").
 			Default(false).
 			Comment("Force JSON output"),
+		field.Int("cache_ttl_seconds").
+			Default(0).
+			NonNegative().
+			Comment("How long identical requests are answered from the response cache, 0 disables it"),
 
 		// Enable status
 		field.Bool("enabled").
@@ -34,7 +34,7 @@ func (AILog) Fields() []ent.Field {
 		// Request info
 		field.String("task_type").
 			NotEmpty().
-			Comment("tag_extraction, mindmap, general"),
+			Comment("tag_extraction, summarize, mindmap, general"),
 		field.String("provider").
 			NotEmpty().
 			Comment("openai, claude, gemini"),
@@ -56,6 +56,9 @@ func (AILog) Fields() []ent.Field {
 		// Token usage (accurate values from API response)
 		field.Int("input_tokens").
 			Default(0),
+		field.Int("cached_input_tokens").
+			Default(0).
+			Comment("Input tokens read from the provider's prompt cache"),
 		field.Int("output_tokens").
 			Default(0),
 		field.Int("thinking_tokens").
@@ -70,6 +73,9 @@ func (AILog) Fields() []ent.Field {
 		field.String("request_id").
 			Optional().
 			Comment("Provider request ID for debugging"),
+		field.Bool("cached").
+			Default(false).
+			Comment("Answered from the response cache, without calling the provider"),
 
 		// Status
 		field.Enum("status").
@@ -78,10 +84,11 @@ func (AILog) Fields() []ent.Field {
 		field.Text("error_message").
 			Optional(),
 
-		// Cost tracking (cents)
-		field.Int("estimated_cost_cents").
-			Default(0).
-			Comment("Estimated cost in cents"),
+		// Cost tracking (micro-cents, a millionth of a cent)
+		field.Int64("cost_micro_cents").
+			Optional().
+			Nillable().
+			Comment("Cost in micro-cents, unset when the model has no price"),
 
 		// Additional metadata
 		field.JSON("metadata", map[string]interface{}{}).
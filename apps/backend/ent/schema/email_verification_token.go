@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EmailVerificationToken holds the schema definition for email verification tokens.
+type EmailVerificationToken struct {
+	ent.Schema
+}
+
+func (EmailVerificationToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("token").
+			Unique().
+			NotEmpty().
+			Comment("Secure verification token"),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("Owner user ID"),
+		field.Time("expires_at").
+			Comment("Token expiration time"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Token creation time"),
+		field.Bool("used").
+			Default(false).
+			Comment("Whether the token has been used"),
+	}
+}
+
+func (EmailVerificationToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("email_verification_tokens").
+			Field("user_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (EmailVerificationToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token"),
+		index.Fields("user_id"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// KnowledgeMention is a topic node of a session's mindmap, merged into a
+// topic of the user's knowledge graph. A session's mentions are replaced
+// whenever its mindmap completes again.
+type KnowledgeMention struct {
+	ent.Schema
+}
+
+// Fields of the KnowledgeMention.
+func (KnowledgeMention) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Primary key"),
+		field.String("node_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the topic node in the mindmap"),
+		field.String("label").
+			NotEmpty().
+			Immutable().
+			Comment("Label of the topic node"),
+		field.JSON("keywords", []string{}).
+			Optional().
+			Immutable().
+			Comment("Keywords of the topic node"),
+		field.Time("seen_at").
+			Immutable().
+			Comment("Start of the session"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Record creation timestamp"),
+	}
+}
+
+// Edges of the KnowledgeMention.
+func (KnowledgeMention) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("topic", KnowledgeTopic.Type).
+			Ref("mentions").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("session", Session.Type).
+			Ref("knowledge_mentions").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Pages of the topic node, which link topics that share them
+		edge.To("urls", URL.Type),
+	}
+}
+
+// Indexes of the KnowledgeMention.
+func (KnowledgeMention) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("node_id").
+			Edges("session").
+			Unique(),
+	}
+}
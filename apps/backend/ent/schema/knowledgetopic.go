@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// KnowledgeTopic is a topic of a user's knowledge graph: the topics of the
+// user's mindmaps that are about the same thing, merged across sessions.
+// Its label, keywords and dates summarize its mentions.
+type KnowledgeTopic struct {
+	ent.Schema
+}
+
+// Mixin of the KnowledgeTopic.
+func (KnowledgeTopic) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the KnowledgeTopic.
+func (KnowledgeTopic) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("label").
+			NotEmpty().
+			Comment("Label the topic goes by most often"),
+		field.JSON("keywords", []string{}).
+			Optional().
+			Comment("Most frequent keywords of the mentions"),
+		field.Time("first_seen_at").
+			Comment("Start of the earliest session that mentions the topic"),
+		field.Time("last_seen_at").
+			Comment("Start of the latest session that mentions the topic"),
+	}
+}
+
+// Edges of the KnowledgeTopic.
+func (KnowledgeTopic) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("knowledge_topics").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("mentions", KnowledgeMention.Type),
+	}
+}
+
+// Indexes of the KnowledgeTopic.
+func (KnowledgeTopic) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_seen_at").
+			Edges("user"),
+	}
+}
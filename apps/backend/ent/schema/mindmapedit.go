@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// MindmapEdit is an entry of a mindmap's edit log, a manual change the user
+// made to the generated graph. The log is replayed onto every regenerated
+// graph, in ID order.
+type MindmapEdit struct {
+	ent.Schema
+}
+
+// Fields returns the fields for MindmapEdit.
+func (MindmapEdit) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("op").
+			Values("rename", "recolor", "pin", "delete", "merge", "split", "move_page", "add_edge", "note").
+			Immutable().
+			Comment("Edit operation"),
+		field.JSON("payload", map[string]interface{}{}).
+			Immutable().
+			Comment("Operation arguments, and what replaying it needs"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Record creation timestamp"),
+	}
+}
+
+// Edges returns the edges for MindmapEdit.
+func (MindmapEdit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("mindmap", MindmapGraph.Type).
+			Ref("edits").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
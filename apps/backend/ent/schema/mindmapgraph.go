@@ -46,7 +46,7 @@ func (MindmapGraph) Fields() []ent.Field {
 			Comment("AI generation timestamp"),
 		field.Int("version").
 			Default(1).
-			Comment("Mindmap version for regeneration tracking"),
+			Comment("Version of the current graph, bumped by every regeneration and restore"),
 	}
 }
 
@@ -58,5 +58,7 @@ func (MindmapGraph) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", MindmapRevision.Type),
+		edge.To("edits", MindmapEdit.Type),
 	}
 }
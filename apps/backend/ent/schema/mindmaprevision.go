@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MindmapRevision is an immutable snapshot of a mindmap's generated graph,
+// before manual edits, recorded for every generation and restore so earlier
+// graphs are never lost.
+type MindmapRevision struct {
+	ent.Schema
+}
+
+// Fields returns the fields for MindmapRevision.
+func (MindmapRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Primary key"),
+		field.Int("version").
+			Positive().
+			Immutable().
+			Comment("Mindmap version this revision was recorded as"),
+		field.JSON("nodes", []map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Mindmap node data"),
+		field.JSON("graph_edges", []map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Mindmap edge data"),
+		field.JSON("layout", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Layout configuration"),
+		field.Int("restored_from").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Version this revision restored, unset for generations"),
+		field.Time("generated_at").
+			Immutable().
+			Comment("AI generation timestamp of the graph"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Record creation timestamp"),
+	}
+}
+
+// Edges returns the edges for MindmapRevision.
+func (MindmapRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("mindmap", MindmapGraph.Type).
+			Ref("revisions").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes returns the indexes for MindmapRevision.
+func (MindmapRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("version").
+			Edges("mindmap").
+			Unique(),
+	}
+}
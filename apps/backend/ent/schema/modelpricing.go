@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ModelPricing holds the price of an AI model, used to compute the cost of
+// AI requests. Prices change over time, so each row is effective from a date
+// until the next row of the model, or until effective_until.
+type ModelPricing struct {
+	ent.Schema
+}
+
+// Fields of the ModelPricing.
+func (ModelPricing) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			NotEmpty().
+			Comment("openai, claude, gemini, openai_compatible"),
+		field.String("model").
+			NotEmpty().
+			Comment("Model name, also matches the models it is a prefix of"),
+
+		// Prices in USD per 1M tokens
+		field.Float("input_price").
+			Min(0).
+			Comment("USD per 1M input tokens"),
+		field.Float("output_price").
+			Min(0).
+			Comment("USD per 1M output tokens"),
+		field.Float("thinking_price").
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("USD per 1M thinking tokens, the output price when unset"),
+		field.Float("cached_input_price").
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("USD per 1M input tokens read from the prompt cache, the input price when unset"),
+
+		// Effective dates
+		field.Time("effective_from").
+			Comment("When this price starts to apply"),
+		field.Time("effective_until").
+			Optional().
+			Nillable().
+			Comment("When this price stops to apply, open-ended when unset"),
+
+		// Audit fields
+		field.String("updated_by").
+			Optional().
+			Comment("Admin who last updated this price"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ModelPricing.
+func (ModelPricing) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "model", "effective_from").Unique(),
+	}
+}
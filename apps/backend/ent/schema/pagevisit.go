@@ -36,6 +36,10 @@ func (PageVisit) Fields() []ent.Field {
 		field.Float("max_scroll_depth").
 			Default(0).
 			Comment("Maximum scroll depth (0-1)"),
+		field.Int("click_count").
+			Default(0).
+			NonNegative().
+			Comment("Number of clicks recorded on the page"),
 	}
 }
 
@@ -55,5 +59,6 @@ func (PageVisit) Edges() []ent.Edge {
 func (PageVisit) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("entered_at"),
+		index.Edges("session", "url"),
 	}
 }
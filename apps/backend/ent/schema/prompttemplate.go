@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PromptTemplate overrides an embedded AI prompt template without a deploy.
+// The enabled row with the highest version wins over the embedded templates.
+type PromptTemplate struct {
+	ent.Schema
+}
+
+// Fields of the PromptTemplate.
+func (PromptTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Template name: 'tag_extraction', 'summarize', 'mindmap'"),
+		field.String("locale").
+			NotEmpty().
+			Comment("Output language: 'ko', 'en'"),
+		field.Int("version").
+			Positive().
+			Comment("Template version, recorded with every AI request"),
+		field.Text("body").
+			NotEmpty().
+			Comment(`Go text/template defining "user" and optionally "system"`),
+
+		// Enable status
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether this template is active"),
+
+		// Audit fields
+		field.String("updated_by").
+			Optional().
+			Comment("Admin who last updated this template"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the PromptTemplate.
+func (PromptTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "locale", "version").Unique(),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RefreshToken holds the schema definition for issued refresh tokens. Tokens
+// rotated from the same login share a family, which is revoked as a whole on
+// logout or when a used token is presented again.
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Comment("Token ID (jti claim)"),
+		field.UUID("family_id", uuid.UUID{}).
+			Immutable().
+			Comment("Login the token was rotated from"),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Owner user ID"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("Token expiration time"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Token creation time"),
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("When the token was exchanged for a new one"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("When the token's family was revoked"),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the RefreshToken.
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+		index.Fields("user_id"),
+		index.Fields("expires_at"),
+	}
+}
@@ -59,6 +59,7 @@ func (Session) Edges() []ent.Edge {
 			Unique(),
 		edge.To("token_usage", TokenUsage.Type),
 		edge.To("ai_logs", AILog.Type),
+		edge.To("knowledge_mentions", KnowledgeMention.Type),
 	}
 }
 
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StripeEvent records Stripe webhook events that have been applied, so
+// redelivered events are processed only once.
+type StripeEvent struct {
+	ent.Schema
+}
+
+// Fields of the StripeEvent.
+func (StripeEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable().
+			NotEmpty().
+			Comment("Stripe event ID (evt_...)"),
+		field.String("type").
+			NotEmpty().
+			Immutable().
+			Comment("Stripe event type"),
+		field.Time("processed_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the event was applied"),
+	}
+}
+
+// Indexes of the StripeEvent.
+func (StripeEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("processed_at"),
+	}
+}
@@ -44,6 +44,10 @@ func (Subscription) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Stripe customer ID"),
+		field.Time("stripe_event_at").
+			Optional().
+			Nillable().
+			Comment("Creation time of the last Stripe event applied, to drop out-of-order updates"),
 	}
 }
 
@@ -67,5 +71,7 @@ func (Subscription) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("status").
 			Edges("user"),
+		index.Fields("stripe_subscription_id"),
+		index.Fields("stripe_customer_id"),
 	}
 }
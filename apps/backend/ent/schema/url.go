@@ -7,6 +7,8 @@ import (
 	"entgo.io/ent/schema/index"
 )
 
+// URL is the canonical record for a normalized URL, shared by all users.
+// Anything captured from the page lives in the per-user URLContent.
 type URL struct {
 	ent.Schema
 }
@@ -26,18 +28,6 @@ func (URL) Fields() []ent.Field {
 			Unique().
 			NotEmpty().
 			Comment("SHA256 hash of normalized URL"),
-		field.String("title").
-			Optional().
-			Comment("Page title"),
-		field.Text("content").
-			Optional().
-			Comment("Extracted page content"),
-		field.Text("summary").
-			Optional().
-			Comment("AI-generated summary"),
-		field.JSON("keywords", []string{}).
-			Optional().
-			Comment("AI-extracted keywords"),
 		field.Time("crawled_at").
 			Optional().
 			Nillable().
@@ -49,6 +39,9 @@ func (URL) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("page_visits", PageVisit.Type).
 			Ref("url"),
+		edge.To("contents", URLContent.Type),
+		edge.From("knowledge_mentions", KnowledgeMention.Type).
+			Ref("urls"),
 	}
 }
 
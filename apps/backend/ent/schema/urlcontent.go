@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// URLContent holds what a single user captured for a URL, together with the
+// AI output derived from it. Pages can render differently per user (logged-in
+// pages, intranets, mail), so captured content is never shared across users.
+type URLContent struct {
+	ent.Schema
+}
+
+func (URLContent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (URLContent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			Optional().
+			Comment("Page title as seen by the user"),
+		field.Text("content").
+			Optional().
+			Comment("Extracted page content"),
+		field.Text("summary").
+			Optional().
+			Comment("AI-generated summary"),
+		field.Text("abstract").
+			Optional().
+			Comment("AI-generated short abstract (1-2 sentences)"),
+		field.JSON("keywords", []string{}).
+			Optional().
+			Comment("AI-extracted keywords"),
+		field.JSON("entities", []string{}).
+			Optional().
+			Comment("AI-extracted key entities (people, organizations, products, concepts)"),
+		field.Time("summarized_at").
+			Optional().
+			Nillable().
+			Comment("Last time the full-page summary was generated"),
+		field.Text("summary_error").
+			Optional().
+			Nillable().
+			Comment("Why the last full-page summary attempt failed"),
+		field.Time("summary_failed_at").
+			Optional().
+			Nillable().
+			Comment("When the full-page summary last failed all its attempts"),
+	}
+}
+
+func (URLContent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("url_contents").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("url", URL.Type).
+			Ref("contents").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (URLContent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user", "url").
+			Unique(),
+	}
+}
@@ -47,6 +47,10 @@ func (User) Fields() []ent.Field {
 			Values("email", "google").
 			Default("email").
 			Comment("Authentication provider used for signup"),
+		field.Time("email_verified_at").
+			Optional().
+			Nillable().
+			Comment("When the email address was verified - nil until verified"),
 	}
 }
 
@@ -56,9 +60,13 @@ func (User) Edges() []ent.Edge {
 			Unique(),
 		edge.To("sessions", Session.Type),
 		edge.To("password_reset_tokens", PasswordResetToken.Type),
+		edge.To("email_verification_tokens", EmailVerificationToken.Type),
+		edge.To("refresh_tokens", RefreshToken.Type),
 		edge.To("subscriptions", Subscription.Type),
 		edge.To("token_usage", TokenUsage.Type),
 		edge.To("ai_logs", AILog.Type),
+		edge.To("url_contents", URLContent.Type),
+		edge.To("knowledge_topics", KnowledgeTopic.Type),
 	}
 }
 
@@ -62,9 +62,11 @@ type SessionEdges struct {
 	TokenUsage []*TokenUsage `json:"token_usage,omitempty"`
 	// AiLogs holds the value of the ai_logs edge.
 	AiLogs []*AILog `json:"ai_logs,omitempty"`
+	// KnowledgeMentions holds the value of the knowledge_mentions edge.
+	KnowledgeMentions []*KnowledgeMention `json:"knowledge_mentions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [8]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -134,6 +136,15 @@ func (e SessionEdges) AiLogsOrErr() ([]*AILog, error) {
 	return nil, &NotLoadedError{edge: "ai_logs"}
 }
 
+// KnowledgeMentionsOrErr returns the KnowledgeMentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e SessionEdges) KnowledgeMentionsOrErr() ([]*KnowledgeMention, error) {
+	if e.loadedTypes[7] {
+		return e.KnowledgeMentions, nil
+	}
+	return nil, &NotLoadedError{edge: "knowledge_mentions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Session) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -281,6 +292,11 @@ func (_m *Session) QueryAiLogs() *AILogQuery {
 	return NewSessionClient(_m.config).QueryAiLogs(_m)
 }
 
+// QueryKnowledgeMentions queries the "knowledge_mentions" edge of the Session entity.
+func (_m *Session) QueryKnowledgeMentions() *KnowledgeMentionQuery {
+	return NewSessionClient(_m.config).QueryKnowledgeMentions(_m)
+}
+
 // Update returns a builder for updating this Session.
 // Note that you need to call Session.Unwrap() before calling this method if this Session
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -48,6 +48,8 @@ const (
 	EdgeTokenUsage = "token_usage"
 	// EdgeAiLogs holds the string denoting the ai_logs edge name in mutations.
 	EdgeAiLogs = "ai_logs"
+	// EdgeKnowledgeMentions holds the string denoting the knowledge_mentions edge name in mutations.
+	EdgeKnowledgeMentions = "knowledge_mentions"
 	// Table holds the table name of the session in the database.
 	Table = "sessions"
 	// UserTable is the table that holds the user relation/edge.
@@ -99,6 +101,13 @@ const (
 	AiLogsInverseTable = "ai_logs"
 	// AiLogsColumn is the table column denoting the ai_logs relation/edge.
 	AiLogsColumn = "session_id"
+	// KnowledgeMentionsTable is the table that holds the knowledge_mentions relation/edge.
+	KnowledgeMentionsTable = "knowledge_mentions"
+	// KnowledgeMentionsInverseTable is the table name for the KnowledgeMention entity.
+	// It exists in this package in order to avoid circular dependency with the "knowledgemention" package.
+	KnowledgeMentionsInverseTable = "knowledge_mentions"
+	// KnowledgeMentionsColumn is the table column denoting the knowledge_mentions relation/edge.
+	KnowledgeMentionsColumn = "session_knowledge_mentions"
 )
 
 // Columns holds all SQL columns for session fields.
@@ -340,6 +349,20 @@ func ByAiLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAiLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByKnowledgeMentionsCount orders the results by knowledge_mentions count.
+func ByKnowledgeMentionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKnowledgeMentionsStep(), opts...)
+	}
+}
+
+// ByKnowledgeMentions orders the results by knowledge_mentions terms.
+func ByKnowledgeMentions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKnowledgeMentionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -389,3 +412,10 @@ func newAiLogsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AiLogsTable, AiLogsColumn),
 	)
 }
+func newKnowledgeMentionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KnowledgeMentionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, KnowledgeMentionsTable, KnowledgeMentionsColumn),
+	)
+}
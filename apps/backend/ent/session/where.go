@@ -662,6 +662,29 @@ func HasAiLogsWith(preds ...predicate.AILog) predicate.Session {
 	})
 }
 
+// HasKnowledgeMentions applies the HasEdge predicate on the "knowledge_mentions" edge.
+func HasKnowledgeMentions() predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, KnowledgeMentionsTable, KnowledgeMentionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKnowledgeMentionsWith applies the HasEdge predicate on the "knowledge_mentions" edge with a given conditions (other predicates).
+func HasKnowledgeMentionsWith(preds ...predicate.KnowledgeMention) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := newKnowledgeMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Session) predicate.Session {
 	return predicate.Session(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/mindhit/api/ent/ailog"
 	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/knowledgemention"
 	"github.com/mindhit/api/ent/mindmapgraph"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/rawevent"
@@ -273,6 +274,21 @@ func (_c *SessionCreate) AddAiLogs(v ...*AILog) *SessionCreate {
 	return _c.AddAiLogIDs(ids...)
 }
 
+// AddKnowledgeMentionIDs adds the "knowledge_mentions" edge to the KnowledgeMention entity by IDs.
+func (_c *SessionCreate) AddKnowledgeMentionIDs(ids ...uuid.UUID) *SessionCreate {
+	_c.mutation.AddKnowledgeMentionIDs(ids...)
+	return _c
+}
+
+// AddKnowledgeMentions adds the "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_c *SessionCreate) AddKnowledgeMentions(v ...*KnowledgeMention) *SessionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddKnowledgeMentionIDs(ids...)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (_c *SessionCreate) Mutation() *SessionMutation {
 	return _c.mutation
@@ -548,6 +564,22 @@ func (_c *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.KnowledgeMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   session.KnowledgeMentionsTable,
+			Columns: []string{session.KnowledgeMentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
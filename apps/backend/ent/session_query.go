@@ -9,12 +9,14 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mindhit/api/ent/ailog"
 	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/knowledgemention"
 	"github.com/mindhit/api/ent/mindmapgraph"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/predicate"
@@ -27,18 +29,20 @@ import (
 // SessionQuery is the builder for querying Session entities.
 type SessionQuery struct {
 	config
-	ctx            *QueryContext
-	order          []session.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Session
-	withUser       *UserQuery
-	withPageVisits *PageVisitQuery
-	withHighlights *HighlightQuery
-	withRawEvents  *RawEventQuery
-	withMindmap    *MindmapGraphQuery
-	withTokenUsage *TokenUsageQuery
-	withAiLogs     *AILogQuery
-	withFKs        bool
+	ctx                   *QueryContext
+	order                 []session.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Session
+	withUser              *UserQuery
+	withPageVisits        *PageVisitQuery
+	withHighlights        *HighlightQuery
+	withRawEvents         *RawEventQuery
+	withMindmap           *MindmapGraphQuery
+	withTokenUsage        *TokenUsageQuery
+	withAiLogs            *AILogQuery
+	withKnowledgeMentions *KnowledgeMentionQuery
+	withFKs               bool
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -229,6 +233,28 @@ func (_q *SessionQuery) QueryAiLogs() *AILogQuery {
 	return query
 }
 
+// QueryKnowledgeMentions chains the current query on the "knowledge_mentions" edge.
+func (_q *SessionQuery) QueryKnowledgeMentions() *KnowledgeMentionQuery {
+	query := (&KnowledgeMentionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, selector),
+			sqlgraph.To(knowledgemention.Table, knowledgemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, session.KnowledgeMentionsTable, session.KnowledgeMentionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Session entity from the query.
 // Returns a *NotFoundError when no Session was found.
 func (_q *SessionQuery) First(ctx context.Context) (*Session, error) {
@@ -416,18 +442,19 @@ func (_q *SessionQuery) Clone() *SessionQuery {
 		return nil
 	}
 	return &SessionQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]session.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Session{}, _q.predicates...),
-		withUser:       _q.withUser.Clone(),
-		withPageVisits: _q.withPageVisits.Clone(),
-		withHighlights: _q.withHighlights.Clone(),
-		withRawEvents:  _q.withRawEvents.Clone(),
-		withMindmap:    _q.withMindmap.Clone(),
-		withTokenUsage: _q.withTokenUsage.Clone(),
-		withAiLogs:     _q.withAiLogs.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]session.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.Session{}, _q.predicates...),
+		withUser:              _q.withUser.Clone(),
+		withPageVisits:        _q.withPageVisits.Clone(),
+		withHighlights:        _q.withHighlights.Clone(),
+		withRawEvents:         _q.withRawEvents.Clone(),
+		withMindmap:           _q.withMindmap.Clone(),
+		withTokenUsage:        _q.withTokenUsage.Clone(),
+		withAiLogs:            _q.withAiLogs.Clone(),
+		withKnowledgeMentions: _q.withKnowledgeMentions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -511,6 +538,17 @@ func (_q *SessionQuery) WithAiLogs(opts ...func(*AILogQuery)) *SessionQuery {
 	return _q
 }
 
+// WithKnowledgeMentions tells the query-builder to eager-load the nodes that are connected to
+// the "knowledge_mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SessionQuery) WithKnowledgeMentions(opts ...func(*KnowledgeMentionQuery)) *SessionQuery {
+	query := (&KnowledgeMentionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withKnowledgeMentions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -590,7 +628,7 @@ func (_q *SessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sess
 		nodes       = []*Session{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [8]bool{
 			_q.withUser != nil,
 			_q.withPageVisits != nil,
 			_q.withHighlights != nil,
@@ -598,6 +636,7 @@ func (_q *SessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sess
 			_q.withMindmap != nil,
 			_q.withTokenUsage != nil,
 			_q.withAiLogs != nil,
+			_q.withKnowledgeMentions != nil,
 		}
 	)
 	if _q.withUser != nil {
@@ -615,6 +654,9 @@ func (_q *SessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sess
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -671,6 +713,15 @@ func (_q *SessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sess
 			return nil, err
 		}
 	}
+	if query := _q.withKnowledgeMentions; query != nil {
+		if err := _q.loadKnowledgeMentions(ctx, query, nodes,
+			func(n *Session) { n.Edges.KnowledgeMentions = []*KnowledgeMention{} },
+			func(n *Session, e *KnowledgeMention) {
+				n.Edges.KnowledgeMentions = append(n.Edges.KnowledgeMentions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -891,9 +942,43 @@ func (_q *SessionQuery) loadAiLogs(ctx context.Context, query *AILogQuery, nodes
 	}
 	return nil
 }
+func (_q *SessionQuery) loadKnowledgeMentions(ctx context.Context, query *KnowledgeMentionQuery, nodes []*Session, init func(*Session), assign func(*Session, *KnowledgeMention)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Session)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.KnowledgeMention(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(session.KnowledgeMentionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.session_knowledge_mentions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "session_knowledge_mentions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_knowledge_mentions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *SessionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -956,6 +1041,9 @@ func (_q *SessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -973,6 +1061,32 @@ func (_q *SessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *SessionQuery) ForUpdate(opts ...sql.LockOption) *SessionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *SessionQuery) ForShare(opts ...sql.LockOption) *SessionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // SessionGroupBy is the group-by builder for Session entities.
 type SessionGroupBy struct {
 	selector
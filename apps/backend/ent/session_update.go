@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/mindhit/api/ent/ailog"
 	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/knowledgemention"
 	"github.com/mindhit/api/ent/mindmapgraph"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/predicate"
@@ -269,6 +270,21 @@ func (_u *SessionUpdate) AddAiLogs(v ...*AILog) *SessionUpdate {
 	return _u.AddAiLogIDs(ids...)
 }
 
+// AddKnowledgeMentionIDs adds the "knowledge_mentions" edge to the KnowledgeMention entity by IDs.
+func (_u *SessionUpdate) AddKnowledgeMentionIDs(ids ...uuid.UUID) *SessionUpdate {
+	_u.mutation.AddKnowledgeMentionIDs(ids...)
+	return _u
+}
+
+// AddKnowledgeMentions adds the "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_u *SessionUpdate) AddKnowledgeMentions(v ...*KnowledgeMention) *SessionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKnowledgeMentionIDs(ids...)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (_u *SessionUpdate) Mutation() *SessionMutation {
 	return _u.mutation
@@ -391,6 +407,27 @@ func (_u *SessionUpdate) RemoveAiLogs(v ...*AILog) *SessionUpdate {
 	return _u.RemoveAiLogIDs(ids...)
 }
 
+// ClearKnowledgeMentions clears all "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_u *SessionUpdate) ClearKnowledgeMentions() *SessionUpdate {
+	_u.mutation.ClearKnowledgeMentions()
+	return _u
+}
+
+// RemoveKnowledgeMentionIDs removes the "knowledge_mentions" edge to KnowledgeMention entities by IDs.
+func (_u *SessionUpdate) RemoveKnowledgeMentionIDs(ids ...uuid.UUID) *SessionUpdate {
+	_u.mutation.RemoveKnowledgeMentionIDs(ids...)
+	return _u
+}
+
+// RemoveKnowledgeMentions removes "knowledge_mentions" edges to KnowledgeMention entities.
+func (_u *SessionUpdate) RemoveKnowledgeMentions(v ...*KnowledgeMention) *SessionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKnowledgeMentionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SessionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -776,6 +813,51 @@ func (_u *SessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KnowledgeMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   session.KnowledgeMentionsTable,
+			Columns: []string{session.KnowledgeMentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKnowledgeMentionsIDs(); len(nodes) > 0 && !_u.mutation.KnowledgeMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   session.KnowledgeMentionsTable,
+			Columns: []string{session.KnowledgeMentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KnowledgeMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   session.KnowledgeMentionsTable,
+			Columns: []string{session.KnowledgeMentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{session.Label}
@@ -1029,6 +1111,21 @@ func (_u *SessionUpdateOne) AddAiLogs(v ...*AILog) *SessionUpdateOne {
 	return _u.AddAiLogIDs(ids...)
 }
 
+// AddKnowledgeMentionIDs adds the "knowledge_mentions" edge to the KnowledgeMention entity by IDs.
+func (_u *SessionUpdateOne) AddKnowledgeMentionIDs(ids ...uuid.UUID) *SessionUpdateOne {
+	_u.mutation.AddKnowledgeMentionIDs(ids...)
+	return _u
+}
+
+// AddKnowledgeMentions adds the "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_u *SessionUpdateOne) AddKnowledgeMentions(v ...*KnowledgeMention) *SessionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKnowledgeMentionIDs(ids...)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (_u *SessionUpdateOne) Mutation() *SessionMutation {
 	return _u.mutation
@@ -1151,6 +1248,27 @@ func (_u *SessionUpdateOne) RemoveAiLogs(v ...*AILog) *SessionUpdateOne {
 	return _u.RemoveAiLogIDs(ids...)
 }
 
+// ClearKnowledgeMentions clears all "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_u *SessionUpdateOne) ClearKnowledgeMentions() *SessionUpdateOne {
+	_u.mutation.ClearKnowledgeMentions()
+	return _u
+}
+
+// RemoveKnowledgeMentionIDs removes the "knowledge_mentions" edge to KnowledgeMention entities by IDs.
+func (_u *SessionUpdateOne) RemoveKnowledgeMentionIDs(ids ...uuid.UUID) *SessionUpdateOne {
+	_u.mutation.RemoveKnowledgeMentionIDs(ids...)
+	return _u
+}
+
+// RemoveKnowledgeMentions removes "knowledge_mentions" edges to KnowledgeMention entities.
+func (_u *SessionUpdateOne) RemoveKnowledgeMentions(v ...*KnowledgeMention) *SessionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKnowledgeMentionIDs(ids...)
+}
+
 // Where appends a list predicates to the SessionUpdate builder.
 func (_u *SessionUpdateOne) Where(ps ...predicate.Session) *SessionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1566,6 +1684,51 @@ func (_u *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KnowledgeMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   session.KnowledgeMentionsTable,
+			Columns: []string{session.KnowledgeMentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKnowledgeMentionsIDs(); len(nodes) > 0 && !_u.mutation.KnowledgeMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   session.KnowledgeMentionsTable,
+			Columns: []string{session.KnowledgeMentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KnowledgeMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   session.KnowledgeMentionsTable,
+			Columns: []string{session.KnowledgeMentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Session{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mindhit/api/ent/stripeevent"
+)
+
+// StripeEvent is the model entity for the StripeEvent schema.
+type StripeEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Stripe event ID (evt_...)
+	ID string `json:"id,omitempty"`
+	// Stripe event type
+	Type string `json:"type,omitempty"`
+	// When the event was applied
+	ProcessedAt  time.Time `json:"processed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StripeEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stripeevent.FieldID, stripeevent.FieldType:
+			values[i] = new(sql.NullString)
+		case stripeevent.FieldProcessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StripeEvent fields.
+func (_m *StripeEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stripeevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case stripeevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case stripeevent.FieldProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
+			} else if value.Valid {
+				_m.ProcessedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StripeEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *StripeEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StripeEvent.
+// Note that you need to call StripeEvent.Unwrap() before calling this method if this StripeEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StripeEvent) Update() *StripeEventUpdateOne {
+	return NewStripeEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StripeEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StripeEvent) Unwrap() *StripeEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StripeEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StripeEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("StripeEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("processed_at=")
+	builder.WriteString(_m.ProcessedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StripeEvents is a parsable slice of StripeEvent.
+type StripeEvents []*StripeEvent
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mindhit/api/ent/stripeevent"
+)
+
+// StripeEventCreate is the builder for creating a StripeEvent entity.
+type StripeEventCreate struct {
+	config
+	mutation *StripeEventMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (_c *StripeEventCreate) SetType(v string) *StripeEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_c *StripeEventCreate) SetProcessedAt(v time.Time) *StripeEventCreate {
+	_c.mutation.SetProcessedAt(v)
+	return _c
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_c *StripeEventCreate) SetNillableProcessedAt(v *time.Time) *StripeEventCreate {
+	if v != nil {
+		_c.SetProcessedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StripeEventCreate) SetID(v string) *StripeEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the StripeEventMutation object of the builder.
+func (_c *StripeEventCreate) Mutation() *StripeEventMutation {
+	return _c.mutation
+}
+
+// Save creates the StripeEvent in the database.
+func (_c *StripeEventCreate) Save(ctx context.Context) (*StripeEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StripeEventCreate) SaveX(ctx context.Context) *StripeEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StripeEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StripeEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StripeEventCreate) defaults() {
+	if _, ok := _c.mutation.ProcessedAt(); !ok {
+		v := stripeevent.DefaultProcessedAt()
+		_c.mutation.SetProcessedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StripeEventCreate) check() error {
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "StripeEvent.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := stripeevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "StripeEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProcessedAt(); !ok {
+		return &ValidationError{Name: "processed_at", err: errors.New(`ent: missing required field "StripeEvent.processed_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := stripeevent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "StripeEvent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *StripeEventCreate) sqlSave(ctx context.Context) (*StripeEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected StripeEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StripeEventCreate) createSpec() (*StripeEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StripeEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stripeevent.Table, sqlgraph.NewFieldSpec(stripeevent.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(stripeevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.ProcessedAt(); ok {
+		_spec.SetField(stripeevent.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = value
+	}
+	return _node, _spec
+}
+
+// StripeEventCreateBulk is the builder for creating many StripeEvent entities in bulk.
+type StripeEventCreateBulk struct {
+	config
+	err      error
+	builders []*StripeEventCreate
+}
+
+// Save creates the StripeEvent entities in the database.
+func (_c *StripeEventCreateBulk) Save(ctx context.Context) ([]*StripeEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StripeEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StripeEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StripeEventCreateBulk) SaveX(ctx context.Context) []*StripeEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StripeEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StripeEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
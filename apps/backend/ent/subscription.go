@@ -37,6 +37,8 @@ type Subscription struct {
 	StripeSubscriptionID *string `json:"stripe_subscription_id,omitempty"`
 	// Stripe customer ID
 	StripeCustomerID *string `json:"stripe_customer_id,omitempty"`
+	// Creation time of the last Stripe event applied, to drop out-of-order updates
+	StripeEventAt *time.Time `json:"stripe_event_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SubscriptionQuery when eager-loading is set.
 	Edges              SubscriptionEdges `json:"edges"`
@@ -87,7 +89,7 @@ func (*Subscription) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case subscription.FieldStatus, subscription.FieldStripeSubscriptionID, subscription.FieldStripeCustomerID:
 			values[i] = new(sql.NullString)
-		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd:
+		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldStripeEventAt:
 			values[i] = new(sql.NullTime)
 		case subscription.FieldID:
 			values[i] = new(uuid.UUID)
@@ -166,6 +168,13 @@ func (_m *Subscription) assignValues(columns []string, values []any) error {
 				_m.StripeCustomerID = new(string)
 				*_m.StripeCustomerID = value.String
 			}
+		case subscription.FieldStripeEventAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field stripe_event_at", values[i])
+			} else if value.Valid {
+				_m.StripeEventAt = new(time.Time)
+				*_m.StripeEventAt = value.Time
+			}
 		case subscription.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field plan_subscriptions", values[i])
@@ -253,6 +262,11 @@ func (_m *Subscription) String() string {
 		builder.WriteString("stripe_customer_id=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.StripeEventAt; v != nil {
+		builder.WriteString("stripe_event_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
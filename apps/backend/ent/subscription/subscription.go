@@ -32,6 +32,8 @@ const (
 	FieldStripeSubscriptionID = "stripe_subscription_id"
 	// FieldStripeCustomerID holds the string denoting the stripe_customer_id field in the database.
 	FieldStripeCustomerID = "stripe_customer_id"
+	// FieldStripeEventAt holds the string denoting the stripe_event_at field in the database.
+	FieldStripeEventAt = "stripe_event_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgePlan holds the string denoting the plan edge name in mutations.
@@ -65,6 +67,7 @@ var Columns = []string{
 	FieldCancelAtPeriodEnd,
 	FieldStripeSubscriptionID,
 	FieldStripeCustomerID,
+	FieldStripeEventAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "subscriptions"
@@ -178,6 +181,11 @@ func ByStripeCustomerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStripeCustomerID, opts...).ToFunc()
 }
 
+// ByStripeEventAt orders the results by the stripe_event_at field.
+func ByStripeEventAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStripeEventAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -91,6 +91,11 @@ func StripeCustomerID(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldStripeCustomerID, v))
 }
 
+// StripeEventAt applies equality check predicate on the "stripe_event_at" field. It's identical to StripeEventAtEQ.
+func StripeEventAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStripeEventAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
@@ -431,6 +436,56 @@ func StripeCustomerIDContainsFold(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldContainsFold(FieldStripeCustomerID, v))
 }
 
+// StripeEventAtEQ applies the EQ predicate on the "stripe_event_at" field.
+func StripeEventAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStripeEventAt, v))
+}
+
+// StripeEventAtNEQ applies the NEQ predicate on the "stripe_event_at" field.
+func StripeEventAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldStripeEventAt, v))
+}
+
+// StripeEventAtIn applies the In predicate on the "stripe_event_at" field.
+func StripeEventAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldStripeEventAt, vs...))
+}
+
+// StripeEventAtNotIn applies the NotIn predicate on the "stripe_event_at" field.
+func StripeEventAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldStripeEventAt, vs...))
+}
+
+// StripeEventAtGT applies the GT predicate on the "stripe_event_at" field.
+func StripeEventAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldStripeEventAt, v))
+}
+
+// StripeEventAtGTE applies the GTE predicate on the "stripe_event_at" field.
+func StripeEventAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldStripeEventAt, v))
+}
+
+// StripeEventAtLT applies the LT predicate on the "stripe_event_at" field.
+func StripeEventAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldStripeEventAt, v))
+}
+
+// StripeEventAtLTE applies the LTE predicate on the "stripe_event_at" field.
+func StripeEventAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldStripeEventAt, v))
+}
+
+// StripeEventAtIsNil applies the IsNil predicate on the "stripe_event_at" field.
+func StripeEventAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldStripeEventAt))
+}
+
+// StripeEventAtNotNil applies the NotNil predicate on the "stripe_event_at" field.
+func StripeEventAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldStripeEventAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Subscription {
 	return predicate.Subscription(func(s *sql.Selector) {
@@ -119,6 +119,20 @@ func (_c *SubscriptionCreate) SetNillableStripeCustomerID(v *string) *Subscripti
 	return _c
 }
 
+// SetStripeEventAt sets the "stripe_event_at" field.
+func (_c *SubscriptionCreate) SetStripeEventAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetStripeEventAt(v)
+	return _c
+}
+
+// SetNillableStripeEventAt sets the "stripe_event_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableStripeEventAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetStripeEventAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SubscriptionCreate) SetID(v uuid.UUID) *SubscriptionCreate {
 	_c.mutation.SetID(v)
@@ -310,6 +324,10 @@ func (_c *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldStripeCustomerID, field.TypeString, value)
 		_node.StripeCustomerID = &value
 	}
+	if value, ok := _c.mutation.StripeEventAt(); ok {
+		_spec.SetField(subscription.FieldStripeEventAt, field.TypeTime, value)
+		_node.StripeEventAt = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
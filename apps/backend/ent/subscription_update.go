@@ -133,6 +133,26 @@ func (_u *SubscriptionUpdate) ClearStripeCustomerID() *SubscriptionUpdate {
 	return _u
 }
 
+// SetStripeEventAt sets the "stripe_event_at" field.
+func (_u *SubscriptionUpdate) SetStripeEventAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetStripeEventAt(v)
+	return _u
+}
+
+// SetNillableStripeEventAt sets the "stripe_event_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableStripeEventAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetStripeEventAt(*v)
+	}
+	return _u
+}
+
+// ClearStripeEventAt clears the value of the "stripe_event_at" field.
+func (_u *SubscriptionUpdate) ClearStripeEventAt() *SubscriptionUpdate {
+	_u.mutation.ClearStripeEventAt()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *SubscriptionUpdate) SetUserID(id uuid.UUID) *SubscriptionUpdate {
 	_u.mutation.SetUserID(id)
@@ -263,6 +283,12 @@ func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.StripeCustomerIDCleared() {
 		_spec.ClearField(subscription.FieldStripeCustomerID, field.TypeString)
 	}
+	if value, ok := _u.mutation.StripeEventAt(); ok {
+		_spec.SetField(subscription.FieldStripeEventAt, field.TypeTime, value)
+	}
+	if _u.mutation.StripeEventAtCleared() {
+		_spec.ClearField(subscription.FieldStripeEventAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -443,6 +469,26 @@ func (_u *SubscriptionUpdateOne) ClearStripeCustomerID() *SubscriptionUpdateOne
 	return _u
 }
 
+// SetStripeEventAt sets the "stripe_event_at" field.
+func (_u *SubscriptionUpdateOne) SetStripeEventAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetStripeEventAt(v)
+	return _u
+}
+
+// SetNillableStripeEventAt sets the "stripe_event_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableStripeEventAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetStripeEventAt(*v)
+	}
+	return _u
+}
+
+// ClearStripeEventAt clears the value of the "stripe_event_at" field.
+func (_u *SubscriptionUpdateOne) ClearStripeEventAt() *SubscriptionUpdateOne {
+	_u.mutation.ClearStripeEventAt()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *SubscriptionUpdateOne) SetUserID(id uuid.UUID) *SubscriptionUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -603,6 +649,12 @@ func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscripti
 	if _u.mutation.StripeCustomerIDCleared() {
 		_spec.ClearField(subscription.FieldStripeCustomerID, field.TypeString)
 	}
+	if value, ok := _u.mutation.StripeEventAt(); ok {
+		_spec.SetField(subscription.FieldStripeEventAt, field.TypeTime, value)
+	}
+	if _u.mutation.StripeEventAtCleared() {
+		_spec.ClearField(subscription.FieldStripeEventAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -16,26 +16,46 @@ type Tx struct {
 	AIConfig *AIConfigClient
 	// AILog is the client for interacting with the AILog builders.
 	AILog *AILogClient
+	// EmailVerificationToken is the client for interacting with the EmailVerificationToken builders.
+	EmailVerificationToken *EmailVerificationTokenClient
 	// Highlight is the client for interacting with the Highlight builders.
 	Highlight *HighlightClient
+	// KnowledgeMention is the client for interacting with the KnowledgeMention builders.
+	KnowledgeMention *KnowledgeMentionClient
+	// KnowledgeTopic is the client for interacting with the KnowledgeTopic builders.
+	KnowledgeTopic *KnowledgeTopicClient
+	// MindmapEdit is the client for interacting with the MindmapEdit builders.
+	MindmapEdit *MindmapEditClient
 	// MindmapGraph is the client for interacting with the MindmapGraph builders.
 	MindmapGraph *MindmapGraphClient
+	// MindmapRevision is the client for interacting with the MindmapRevision builders.
+	MindmapRevision *MindmapRevisionClient
+	// ModelPricing is the client for interacting with the ModelPricing builders.
+	ModelPricing *ModelPricingClient
 	// PageVisit is the client for interacting with the PageVisit builders.
 	PageVisit *PageVisitClient
 	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
 	PasswordResetToken *PasswordResetTokenClient
 	// Plan is the client for interacting with the Plan builders.
 	Plan *PlanClient
+	// PromptTemplate is the client for interacting with the PromptTemplate builders.
+	PromptTemplate *PromptTemplateClient
 	// RawEvent is the client for interacting with the RawEvent builders.
 	RawEvent *RawEventClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
+	// StripeEvent is the client for interacting with the StripeEvent builders.
+	StripeEvent *StripeEventClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
 	// TokenUsage is the client for interacting with the TokenUsage builders.
 	TokenUsage *TokenUsageClient
 	// URL is the client for interacting with the URL builders.
 	URL *URLClient
+	// URLContent is the client for interacting with the URLContent builders.
+	URLContent *URLContentClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserSettings is the client for interacting with the UserSettings builders.
@@ -173,16 +193,26 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.AIConfig = NewAIConfigClient(tx.config)
 	tx.AILog = NewAILogClient(tx.config)
+	tx.EmailVerificationToken = NewEmailVerificationTokenClient(tx.config)
 	tx.Highlight = NewHighlightClient(tx.config)
+	tx.KnowledgeMention = NewKnowledgeMentionClient(tx.config)
+	tx.KnowledgeTopic = NewKnowledgeTopicClient(tx.config)
+	tx.MindmapEdit = NewMindmapEditClient(tx.config)
 	tx.MindmapGraph = NewMindmapGraphClient(tx.config)
+	tx.MindmapRevision = NewMindmapRevisionClient(tx.config)
+	tx.ModelPricing = NewModelPricingClient(tx.config)
 	tx.PageVisit = NewPageVisitClient(tx.config)
 	tx.PasswordResetToken = NewPasswordResetTokenClient(tx.config)
 	tx.Plan = NewPlanClient(tx.config)
+	tx.PromptTemplate = NewPromptTemplateClient(tx.config)
 	tx.RawEvent = NewRawEventClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
+	tx.StripeEvent = NewStripeEventClient(tx.config)
 	tx.Subscription = NewSubscriptionClient(tx.config)
 	tx.TokenUsage = NewTokenUsageClient(tx.config)
 	tx.URL = NewURLClient(tx.config)
+	tx.URLContent = NewURLContentClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserSettings = NewUserSettingsClient(tx.config)
 }
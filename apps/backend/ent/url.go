@@ -3,7 +3,6 @@
 package ent
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -28,14 +27,6 @@ type URL struct {
 	URL string `json:"url,omitempty"`
 	// SHA256 hash of normalized URL
 	URLHash string `json:"url_hash,omitempty"`
-	// Page title
-	Title string `json:"title,omitempty"`
-	// Extracted page content
-	Content string `json:"content,omitempty"`
-	// AI-generated summary
-	Summary string `json:"summary,omitempty"`
-	// AI-extracted keywords
-	Keywords []string `json:"keywords,omitempty"`
 	// Last time the URL content was crawled
 	CrawledAt *time.Time `json:"crawled_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -48,9 +39,13 @@ type URL struct {
 type URLEdges struct {
 	// PageVisits holds the value of the page_visits edge.
 	PageVisits []*PageVisit `json:"page_visits,omitempty"`
+	// Contents holds the value of the contents edge.
+	Contents []*URLContent `json:"contents,omitempty"`
+	// KnowledgeMentions holds the value of the knowledge_mentions edge.
+	KnowledgeMentions []*KnowledgeMention `json:"knowledge_mentions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // PageVisitsOrErr returns the PageVisits value or an error if the edge
@@ -62,14 +57,30 @@ func (e URLEdges) PageVisitsOrErr() ([]*PageVisit, error) {
 	return nil, &NotLoadedError{edge: "page_visits"}
 }
 
+// ContentsOrErr returns the Contents value or an error if the edge
+// was not loaded in eager-loading.
+func (e URLEdges) ContentsOrErr() ([]*URLContent, error) {
+	if e.loadedTypes[1] {
+		return e.Contents, nil
+	}
+	return nil, &NotLoadedError{edge: "contents"}
+}
+
+// KnowledgeMentionsOrErr returns the KnowledgeMentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e URLEdges) KnowledgeMentionsOrErr() ([]*KnowledgeMention, error) {
+	if e.loadedTypes[2] {
+		return e.KnowledgeMentions, nil
+	}
+	return nil, &NotLoadedError{edge: "knowledge_mentions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*URL) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case url.FieldKeywords:
-			values[i] = new([]byte)
-		case url.FieldURL, url.FieldURLHash, url.FieldTitle, url.FieldContent, url.FieldSummary:
+		case url.FieldURL, url.FieldURLHash:
 			values[i] = new(sql.NullString)
 		case url.FieldCreatedAt, url.FieldUpdatedAt, url.FieldCrawledAt:
 			values[i] = new(sql.NullTime)
@@ -120,32 +131,6 @@ func (_m *URL) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.URLHash = value.String
 			}
-		case url.FieldTitle:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field title", values[i])
-			} else if value.Valid {
-				_m.Title = value.String
-			}
-		case url.FieldContent:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field content", values[i])
-			} else if value.Valid {
-				_m.Content = value.String
-			}
-		case url.FieldSummary:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field summary", values[i])
-			} else if value.Valid {
-				_m.Summary = value.String
-			}
-		case url.FieldKeywords:
-			if value, ok := values[i].(*[]byte); !ok {
-				return fmt.Errorf("unexpected type %T for field keywords", values[i])
-			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &_m.Keywords); err != nil {
-					return fmt.Errorf("unmarshal field keywords: %w", err)
-				}
-			}
 		case url.FieldCrawledAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field crawled_at", values[i])
@@ -171,6 +156,16 @@ func (_m *URL) QueryPageVisits() *PageVisitQuery {
 	return NewURLClient(_m.config).QueryPageVisits(_m)
 }
 
+// QueryContents queries the "contents" edge of the URL entity.
+func (_m *URL) QueryContents() *URLContentQuery {
+	return NewURLClient(_m.config).QueryContents(_m)
+}
+
+// QueryKnowledgeMentions queries the "knowledge_mentions" edge of the URL entity.
+func (_m *URL) QueryKnowledgeMentions() *KnowledgeMentionQuery {
+	return NewURLClient(_m.config).QueryKnowledgeMentions(_m)
+}
+
 // Update returns a builder for updating this URL.
 // Note that you need to call URL.Unwrap() before calling this method if this URL
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -206,18 +201,6 @@ func (_m *URL) String() string {
 	builder.WriteString("url_hash=")
 	builder.WriteString(_m.URLHash)
 	builder.WriteString(", ")
-	builder.WriteString("title=")
-	builder.WriteString(_m.Title)
-	builder.WriteString(", ")
-	builder.WriteString("content=")
-	builder.WriteString(_m.Content)
-	builder.WriteString(", ")
-	builder.WriteString("summary=")
-	builder.WriteString(_m.Summary)
-	builder.WriteString(", ")
-	builder.WriteString("keywords=")
-	builder.WriteString(fmt.Sprintf("%v", _m.Keywords))
-	builder.WriteString(", ")
 	if v := _m.CrawledAt; v != nil {
 		builder.WriteString("crawled_at=")
 		builder.WriteString(v.Format(time.ANSIC))
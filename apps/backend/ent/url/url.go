@@ -23,18 +23,14 @@ const (
 	FieldURL = "url"
 	// FieldURLHash holds the string denoting the url_hash field in the database.
 	FieldURLHash = "url_hash"
-	// FieldTitle holds the string denoting the title field in the database.
-	FieldTitle = "title"
-	// FieldContent holds the string denoting the content field in the database.
-	FieldContent = "content"
-	// FieldSummary holds the string denoting the summary field in the database.
-	FieldSummary = "summary"
-	// FieldKeywords holds the string denoting the keywords field in the database.
-	FieldKeywords = "keywords"
 	// FieldCrawledAt holds the string denoting the crawled_at field in the database.
 	FieldCrawledAt = "crawled_at"
 	// EdgePageVisits holds the string denoting the page_visits edge name in mutations.
 	EdgePageVisits = "page_visits"
+	// EdgeContents holds the string denoting the contents edge name in mutations.
+	EdgeContents = "contents"
+	// EdgeKnowledgeMentions holds the string denoting the knowledge_mentions edge name in mutations.
+	EdgeKnowledgeMentions = "knowledge_mentions"
 	// Table holds the table name of the url in the database.
 	Table = "ur_ls"
 	// PageVisitsTable is the table that holds the page_visits relation/edge.
@@ -44,6 +40,18 @@ const (
 	PageVisitsInverseTable = "page_visits"
 	// PageVisitsColumn is the table column denoting the page_visits relation/edge.
 	PageVisitsColumn = "page_visit_url"
+	// ContentsTable is the table that holds the contents relation/edge.
+	ContentsTable = "url_contents"
+	// ContentsInverseTable is the table name for the URLContent entity.
+	// It exists in this package in order to avoid circular dependency with the "urlcontent" package.
+	ContentsInverseTable = "url_contents"
+	// ContentsColumn is the table column denoting the contents relation/edge.
+	ContentsColumn = "url_contents"
+	// KnowledgeMentionsTable is the table that holds the knowledge_mentions relation/edge. The primary key declared below.
+	KnowledgeMentionsTable = "knowledge_mention_urls"
+	// KnowledgeMentionsInverseTable is the table name for the KnowledgeMention entity.
+	// It exists in this package in order to avoid circular dependency with the "knowledgemention" package.
+	KnowledgeMentionsInverseTable = "knowledge_mentions"
 )
 
 // Columns holds all SQL columns for url fields.
@@ -53,13 +61,15 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldURL,
 	FieldURLHash,
-	FieldTitle,
-	FieldContent,
-	FieldSummary,
-	FieldKeywords,
 	FieldCrawledAt,
 }
 
+var (
+	// KnowledgeMentionsPrimaryKey and KnowledgeMentionsColumn2 are the table columns denoting the
+	// primary key for the knowledge_mentions relation (M2M).
+	KnowledgeMentionsPrimaryKey = []string{"knowledge_mention_id", "url_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -113,21 +123,6 @@ func ByURLHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldURLHash, opts...).ToFunc()
 }
 
-// ByTitle orders the results by the title field.
-func ByTitle(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldTitle, opts...).ToFunc()
-}
-
-// ByContent orders the results by the content field.
-func ByContent(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldContent, opts...).ToFunc()
-}
-
-// BySummary orders the results by the summary field.
-func BySummary(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldSummary, opts...).ToFunc()
-}
-
 // ByCrawledAt orders the results by the crawled_at field.
 func ByCrawledAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCrawledAt, opts...).ToFunc()
@@ -146,6 +141,34 @@ func ByPageVisits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPageVisitsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByContentsCount orders the results by contents count.
+func ByContentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContentsStep(), opts...)
+	}
+}
+
+// ByContents orders the results by contents terms.
+func ByContents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByKnowledgeMentionsCount orders the results by knowledge_mentions count.
+func ByKnowledgeMentionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKnowledgeMentionsStep(), opts...)
+	}
+}
+
+// ByKnowledgeMentions orders the results by knowledge_mentions terms.
+func ByKnowledgeMentions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKnowledgeMentionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPageVisitsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -153,3 +176,17 @@ func newPageVisitsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, PageVisitsTable, PageVisitsColumn),
 	)
 }
+func newContentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContentsTable, ContentsColumn),
+	)
+}
+func newKnowledgeMentionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KnowledgeMentionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, KnowledgeMentionsTable, KnowledgeMentionsPrimaryKey...),
+	)
+}
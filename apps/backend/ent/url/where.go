@@ -76,21 +76,6 @@ func URLHash(v string) predicate.URL {
 	return predicate.URL(sql.FieldEQ(FieldURLHash, v))
 }
 
-// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
-func Title(v string) predicate.URL {
-	return predicate.URL(sql.FieldEQ(FieldTitle, v))
-}
-
-// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
-func Content(v string) predicate.URL {
-	return predicate.URL(sql.FieldEQ(FieldContent, v))
-}
-
-// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
-func Summary(v string) predicate.URL {
-	return predicate.URL(sql.FieldEQ(FieldSummary, v))
-}
-
 // CrawledAt applies equality check predicate on the "crawled_at" field. It's identical to CrawledAtEQ.
 func CrawledAt(v time.Time) predicate.URL {
 	return predicate.URL(sql.FieldEQ(FieldCrawledAt, v))
@@ -306,241 +291,6 @@ func URLHashContainsFold(v string) predicate.URL {
 	return predicate.URL(sql.FieldContainsFold(FieldURLHash, v))
 }
 
-// TitleEQ applies the EQ predicate on the "title" field.
-func TitleEQ(v string) predicate.URL {
-	return predicate.URL(sql.FieldEQ(FieldTitle, v))
-}
-
-// TitleNEQ applies the NEQ predicate on the "title" field.
-func TitleNEQ(v string) predicate.URL {
-	return predicate.URL(sql.FieldNEQ(FieldTitle, v))
-}
-
-// TitleIn applies the In predicate on the "title" field.
-func TitleIn(vs ...string) predicate.URL {
-	return predicate.URL(sql.FieldIn(FieldTitle, vs...))
-}
-
-// TitleNotIn applies the NotIn predicate on the "title" field.
-func TitleNotIn(vs ...string) predicate.URL {
-	return predicate.URL(sql.FieldNotIn(FieldTitle, vs...))
-}
-
-// TitleGT applies the GT predicate on the "title" field.
-func TitleGT(v string) predicate.URL {
-	return predicate.URL(sql.FieldGT(FieldTitle, v))
-}
-
-// TitleGTE applies the GTE predicate on the "title" field.
-func TitleGTE(v string) predicate.URL {
-	return predicate.URL(sql.FieldGTE(FieldTitle, v))
-}
-
-// TitleLT applies the LT predicate on the "title" field.
-func TitleLT(v string) predicate.URL {
-	return predicate.URL(sql.FieldLT(FieldTitle, v))
-}
-
-// TitleLTE applies the LTE predicate on the "title" field.
-func TitleLTE(v string) predicate.URL {
-	return predicate.URL(sql.FieldLTE(FieldTitle, v))
-}
-
-// TitleContains applies the Contains predicate on the "title" field.
-func TitleContains(v string) predicate.URL {
-	return predicate.URL(sql.FieldContains(FieldTitle, v))
-}
-
-// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
-func TitleHasPrefix(v string) predicate.URL {
-	return predicate.URL(sql.FieldHasPrefix(FieldTitle, v))
-}
-
-// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
-func TitleHasSuffix(v string) predicate.URL {
-	return predicate.URL(sql.FieldHasSuffix(FieldTitle, v))
-}
-
-// TitleIsNil applies the IsNil predicate on the "title" field.
-func TitleIsNil() predicate.URL {
-	return predicate.URL(sql.FieldIsNull(FieldTitle))
-}
-
-// TitleNotNil applies the NotNil predicate on the "title" field.
-func TitleNotNil() predicate.URL {
-	return predicate.URL(sql.FieldNotNull(FieldTitle))
-}
-
-// TitleEqualFold applies the EqualFold predicate on the "title" field.
-func TitleEqualFold(v string) predicate.URL {
-	return predicate.URL(sql.FieldEqualFold(FieldTitle, v))
-}
-
-// TitleContainsFold applies the ContainsFold predicate on the "title" field.
-func TitleContainsFold(v string) predicate.URL {
-	return predicate.URL(sql.FieldContainsFold(FieldTitle, v))
-}
-
-// ContentEQ applies the EQ predicate on the "content" field.
-func ContentEQ(v string) predicate.URL {
-	return predicate.URL(sql.FieldEQ(FieldContent, v))
-}
-
-// ContentNEQ applies the NEQ predicate on the "content" field.
-func ContentNEQ(v string) predicate.URL {
-	return predicate.URL(sql.FieldNEQ(FieldContent, v))
-}
-
-// ContentIn applies the In predicate on the "content" field.
-func ContentIn(vs ...string) predicate.URL {
-	return predicate.URL(sql.FieldIn(FieldContent, vs...))
-}
-
-// ContentNotIn applies the NotIn predicate on the "content" field.
-func ContentNotIn(vs ...string) predicate.URL {
-	return predicate.URL(sql.FieldNotIn(FieldContent, vs...))
-}
-
-// ContentGT applies the GT predicate on the "content" field.
-func ContentGT(v string) predicate.URL {
-	return predicate.URL(sql.FieldGT(FieldContent, v))
-}
-
-// ContentGTE applies the GTE predicate on the "content" field.
-func ContentGTE(v string) predicate.URL {
-	return predicate.URL(sql.FieldGTE(FieldContent, v))
-}
-
-// ContentLT applies the LT predicate on the "content" field.
-func ContentLT(v string) predicate.URL {
-	return predicate.URL(sql.FieldLT(FieldContent, v))
-}
-
-// ContentLTE applies the LTE predicate on the "content" field.
-func ContentLTE(v string) predicate.URL {
-	return predicate.URL(sql.FieldLTE(FieldContent, v))
-}
-
-// ContentContains applies the Contains predicate on the "content" field.
-func ContentContains(v string) predicate.URL {
-	return predicate.URL(sql.FieldContains(FieldContent, v))
-}
-
-// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
-func ContentHasPrefix(v string) predicate.URL {
-	return predicate.URL(sql.FieldHasPrefix(FieldContent, v))
-}
-
-// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
-func ContentHasSuffix(v string) predicate.URL {
-	return predicate.URL(sql.FieldHasSuffix(FieldContent, v))
-}
-
-// ContentIsNil applies the IsNil predicate on the "content" field.
-func ContentIsNil() predicate.URL {
-	return predicate.URL(sql.FieldIsNull(FieldContent))
-}
-
-// ContentNotNil applies the NotNil predicate on the "content" field.
-func ContentNotNil() predicate.URL {
-	return predicate.URL(sql.FieldNotNull(FieldContent))
-}
-
-// ContentEqualFold applies the EqualFold predicate on the "content" field.
-func ContentEqualFold(v string) predicate.URL {
-	return predicate.URL(sql.FieldEqualFold(FieldContent, v))
-}
-
-// ContentContainsFold applies the ContainsFold predicate on the "content" field.
-func ContentContainsFold(v string) predicate.URL {
-	return predicate.URL(sql.FieldContainsFold(FieldContent, v))
-}
-
-// SummaryEQ applies the EQ predicate on the "summary" field.
-func SummaryEQ(v string) predicate.URL {
-	return predicate.URL(sql.FieldEQ(FieldSummary, v))
-}
-
-// SummaryNEQ applies the NEQ predicate on the "summary" field.
-func SummaryNEQ(v string) predicate.URL {
-	return predicate.URL(sql.FieldNEQ(FieldSummary, v))
-}
-
-// SummaryIn applies the In predicate on the "summary" field.
-func SummaryIn(vs ...string) predicate.URL {
-	return predicate.URL(sql.FieldIn(FieldSummary, vs...))
-}
-
-// SummaryNotIn applies the NotIn predicate on the "summary" field.
-func SummaryNotIn(vs ...string) predicate.URL {
-	return predicate.URL(sql.FieldNotIn(FieldSummary, vs...))
-}
-
-// SummaryGT applies the GT predicate on the "summary" field.
-func SummaryGT(v string) predicate.URL {
-	return predicate.URL(sql.FieldGT(FieldSummary, v))
-}
-
-// SummaryGTE applies the GTE predicate on the "summary" field.
-func SummaryGTE(v string) predicate.URL {
-	return predicate.URL(sql.FieldGTE(FieldSummary, v))
-}
-
-// SummaryLT applies the LT predicate on the "summary" field.
-func SummaryLT(v string) predicate.URL {
-	return predicate.URL(sql.FieldLT(FieldSummary, v))
-}
-
-// SummaryLTE applies the LTE predicate on the "summary" field.
-func SummaryLTE(v string) predicate.URL {
-	return predicate.URL(sql.FieldLTE(FieldSummary, v))
-}
-
-// SummaryContains applies the Contains predicate on the "summary" field.
-func SummaryContains(v string) predicate.URL {
-	return predicate.URL(sql.FieldContains(FieldSummary, v))
-}
-
-// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
-func SummaryHasPrefix(v string) predicate.URL {
-	return predicate.URL(sql.FieldHasPrefix(FieldSummary, v))
-}
-
-// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
-func SummaryHasSuffix(v string) predicate.URL {
-	return predicate.URL(sql.FieldHasSuffix(FieldSummary, v))
-}
-
-// SummaryIsNil applies the IsNil predicate on the "summary" field.
-func SummaryIsNil() predicate.URL {
-	return predicate.URL(sql.FieldIsNull(FieldSummary))
-}
-
-// SummaryNotNil applies the NotNil predicate on the "summary" field.
-func SummaryNotNil() predicate.URL {
-	return predicate.URL(sql.FieldNotNull(FieldSummary))
-}
-
-// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
-func SummaryEqualFold(v string) predicate.URL {
-	return predicate.URL(sql.FieldEqualFold(FieldSummary, v))
-}
-
-// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
-func SummaryContainsFold(v string) predicate.URL {
-	return predicate.URL(sql.FieldContainsFold(FieldSummary, v))
-}
-
-// KeywordsIsNil applies the IsNil predicate on the "keywords" field.
-func KeywordsIsNil() predicate.URL {
-	return predicate.URL(sql.FieldIsNull(FieldKeywords))
-}
-
-// KeywordsNotNil applies the NotNil predicate on the "keywords" field.
-func KeywordsNotNil() predicate.URL {
-	return predicate.URL(sql.FieldNotNull(FieldKeywords))
-}
-
 // CrawledAtEQ applies the EQ predicate on the "crawled_at" field.
 func CrawledAtEQ(v time.Time) predicate.URL {
 	return predicate.URL(sql.FieldEQ(FieldCrawledAt, v))
@@ -614,6 +364,52 @@ func HasPageVisitsWith(preds ...predicate.PageVisit) predicate.URL {
 	})
 }
 
+// HasContents applies the HasEdge predicate on the "contents" edge.
+func HasContents() predicate.URL {
+	return predicate.URL(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContentsTable, ContentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentsWith applies the HasEdge predicate on the "contents" edge with a given conditions (other predicates).
+func HasContentsWith(preds ...predicate.URLContent) predicate.URL {
+	return predicate.URL(func(s *sql.Selector) {
+		step := newContentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasKnowledgeMentions applies the HasEdge predicate on the "knowledge_mentions" edge.
+func HasKnowledgeMentions() predicate.URL {
+	return predicate.URL(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, KnowledgeMentionsTable, KnowledgeMentionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKnowledgeMentionsWith applies the HasEdge predicate on the "knowledge_mentions" edge with a given conditions (other predicates).
+func HasKnowledgeMentionsWith(preds ...predicate.KnowledgeMention) predicate.URL {
+	return predicate.URL(func(s *sql.Selector) {
+		step := newKnowledgeMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.URL) predicate.URL {
 	return predicate.URL(sql.AndPredicates(predicates...))
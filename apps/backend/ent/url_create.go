@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
 )
 
 // URLCreate is the builder for creating a URL entity.
@@ -62,54 +64,6 @@ func (_c *URLCreate) SetURLHash(v string) *URLCreate {
 	return _c
 }
 
-// SetTitle sets the "title" field.
-func (_c *URLCreate) SetTitle(v string) *URLCreate {
-	_c.mutation.SetTitle(v)
-	return _c
-}
-
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_c *URLCreate) SetNillableTitle(v *string) *URLCreate {
-	if v != nil {
-		_c.SetTitle(*v)
-	}
-	return _c
-}
-
-// SetContent sets the "content" field.
-func (_c *URLCreate) SetContent(v string) *URLCreate {
-	_c.mutation.SetContent(v)
-	return _c
-}
-
-// SetNillableContent sets the "content" field if the given value is not nil.
-func (_c *URLCreate) SetNillableContent(v *string) *URLCreate {
-	if v != nil {
-		_c.SetContent(*v)
-	}
-	return _c
-}
-
-// SetSummary sets the "summary" field.
-func (_c *URLCreate) SetSummary(v string) *URLCreate {
-	_c.mutation.SetSummary(v)
-	return _c
-}
-
-// SetNillableSummary sets the "summary" field if the given value is not nil.
-func (_c *URLCreate) SetNillableSummary(v *string) *URLCreate {
-	if v != nil {
-		_c.SetSummary(*v)
-	}
-	return _c
-}
-
-// SetKeywords sets the "keywords" field.
-func (_c *URLCreate) SetKeywords(v []string) *URLCreate {
-	_c.mutation.SetKeywords(v)
-	return _c
-}
-
 // SetCrawledAt sets the "crawled_at" field.
 func (_c *URLCreate) SetCrawledAt(v time.Time) *URLCreate {
 	_c.mutation.SetCrawledAt(v)
@@ -153,6 +107,36 @@ func (_c *URLCreate) AddPageVisits(v ...*PageVisit) *URLCreate {
 	return _c.AddPageVisitIDs(ids...)
 }
 
+// AddContentIDs adds the "contents" edge to the URLContent entity by IDs.
+func (_c *URLCreate) AddContentIDs(ids ...uuid.UUID) *URLCreate {
+	_c.mutation.AddContentIDs(ids...)
+	return _c
+}
+
+// AddContents adds the "contents" edges to the URLContent entity.
+func (_c *URLCreate) AddContents(v ...*URLContent) *URLCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContentIDs(ids...)
+}
+
+// AddKnowledgeMentionIDs adds the "knowledge_mentions" edge to the KnowledgeMention entity by IDs.
+func (_c *URLCreate) AddKnowledgeMentionIDs(ids ...uuid.UUID) *URLCreate {
+	_c.mutation.AddKnowledgeMentionIDs(ids...)
+	return _c
+}
+
+// AddKnowledgeMentions adds the "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_c *URLCreate) AddKnowledgeMentions(v ...*KnowledgeMention) *URLCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddKnowledgeMentionIDs(ids...)
+}
+
 // Mutation returns the URLMutation object of the builder.
 func (_c *URLCreate) Mutation() *URLMutation {
 	return _c.mutation
@@ -277,22 +261,6 @@ func (_c *URLCreate) createSpec() (*URL, *sqlgraph.CreateSpec) {
 		_spec.SetField(url.FieldURLHash, field.TypeString, value)
 		_node.URLHash = value
 	}
-	if value, ok := _c.mutation.Title(); ok {
-		_spec.SetField(url.FieldTitle, field.TypeString, value)
-		_node.Title = value
-	}
-	if value, ok := _c.mutation.Content(); ok {
-		_spec.SetField(url.FieldContent, field.TypeString, value)
-		_node.Content = value
-	}
-	if value, ok := _c.mutation.Summary(); ok {
-		_spec.SetField(url.FieldSummary, field.TypeString, value)
-		_node.Summary = value
-	}
-	if value, ok := _c.mutation.Keywords(); ok {
-		_spec.SetField(url.FieldKeywords, field.TypeJSON, value)
-		_node.Keywords = value
-	}
 	if value, ok := _c.mutation.CrawledAt(); ok {
 		_spec.SetField(url.FieldCrawledAt, field.TypeTime, value)
 		_node.CrawledAt = &value
@@ -313,6 +281,38 @@ func (_c *URLCreate) createSpec() (*URL, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ContentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   url.ContentsTable,
+			Columns: []string{url.ContentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.KnowledgeMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   url.KnowledgeMentionsTable,
+			Columns: url.KnowledgeMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
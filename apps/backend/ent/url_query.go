@@ -9,23 +9,29 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/predicate"
 	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
 )
 
 // URLQuery is the builder for querying URL entities.
 type URLQuery struct {
 	config
-	ctx            *QueryContext
-	order          []url.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.URL
-	withPageVisits *PageVisitQuery
+	ctx                   *QueryContext
+	order                 []url.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.URL
+	withPageVisits        *PageVisitQuery
+	withContents          *URLContentQuery
+	withKnowledgeMentions *KnowledgeMentionQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +90,50 @@ func (_q *URLQuery) QueryPageVisits() *PageVisitQuery {
 	return query
 }
 
+// QueryContents chains the current query on the "contents" edge.
+func (_q *URLQuery) QueryContents() *URLContentQuery {
+	query := (&URLContentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(url.Table, url.FieldID, selector),
+			sqlgraph.To(urlcontent.Table, urlcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, url.ContentsTable, url.ContentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryKnowledgeMentions chains the current query on the "knowledge_mentions" edge.
+func (_q *URLQuery) QueryKnowledgeMentions() *KnowledgeMentionQuery {
+	query := (&KnowledgeMentionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(url.Table, url.FieldID, selector),
+			sqlgraph.To(knowledgemention.Table, knowledgemention.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, url.KnowledgeMentionsTable, url.KnowledgeMentionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first URL entity from the query.
 // Returns a *NotFoundError when no URL was found.
 func (_q *URLQuery) First(ctx context.Context) (*URL, error) {
@@ -271,12 +321,14 @@ func (_q *URLQuery) Clone() *URLQuery {
 		return nil
 	}
 	return &URLQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]url.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.URL{}, _q.predicates...),
-		withPageVisits: _q.withPageVisits.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]url.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.URL{}, _q.predicates...),
+		withPageVisits:        _q.withPageVisits.Clone(),
+		withContents:          _q.withContents.Clone(),
+		withKnowledgeMentions: _q.withKnowledgeMentions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,6 +346,28 @@ func (_q *URLQuery) WithPageVisits(opts ...func(*PageVisitQuery)) *URLQuery {
 	return _q
 }
 
+// WithContents tells the query-builder to eager-load the nodes that are connected to
+// the "contents" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *URLQuery) WithContents(opts ...func(*URLContentQuery)) *URLQuery {
+	query := (&URLContentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContents = query
+	return _q
+}
+
+// WithKnowledgeMentions tells the query-builder to eager-load the nodes that are connected to
+// the "knowledge_mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *URLQuery) WithKnowledgeMentions(opts ...func(*KnowledgeMentionQuery)) *URLQuery {
+	query := (&KnowledgeMentionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withKnowledgeMentions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +446,10 @@ func (_q *URLQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*URL, err
 	var (
 		nodes       = []*URL{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withPageVisits != nil,
+			_q.withContents != nil,
+			_q.withKnowledgeMentions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -385,6 +461,9 @@ func (_q *URLQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*URL, err
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -401,6 +480,20 @@ func (_q *URLQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*URL, err
 			return nil, err
 		}
 	}
+	if query := _q.withContents; query != nil {
+		if err := _q.loadContents(ctx, query, nodes,
+			func(n *URL) { n.Edges.Contents = []*URLContent{} },
+			func(n *URL, e *URLContent) { n.Edges.Contents = append(n.Edges.Contents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withKnowledgeMentions; query != nil {
+		if err := _q.loadKnowledgeMentions(ctx, query, nodes,
+			func(n *URL) { n.Edges.KnowledgeMentions = []*KnowledgeMention{} },
+			func(n *URL, e *KnowledgeMention) { n.Edges.KnowledgeMentions = append(n.Edges.KnowledgeMentions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,9 +528,104 @@ func (_q *URLQuery) loadPageVisits(ctx context.Context, query *PageVisitQuery, n
 	}
 	return nil
 }
+func (_q *URLQuery) loadContents(ctx context.Context, query *URLContentQuery, nodes []*URL, init func(*URL), assign func(*URL, *URLContent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*URL)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.URLContent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(url.ContentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.url_contents
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "url_contents" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "url_contents" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *URLQuery) loadKnowledgeMentions(ctx context.Context, query *KnowledgeMentionQuery, nodes []*URL, init func(*URL), assign func(*URL, *KnowledgeMention)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*URL)
+	nids := make(map[uuid.UUID]map[*URL]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(url.KnowledgeMentionsTable)
+		s.Join(joinT).On(s.C(knowledgemention.FieldID), joinT.C(url.KnowledgeMentionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(url.KnowledgeMentionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(url.KnowledgeMentionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*URL]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*KnowledgeMention](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "knowledge_mentions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *URLQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -500,6 +688,9 @@ func (_q *URLQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -517,6 +708,32 @@ func (_q *URLQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *URLQuery) ForUpdate(opts ...sql.LockOption) *URLQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *URLQuery) ForShare(opts ...sql.LockOption) *URLQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // URLGroupBy is the group-by builder for URL entities.
 type URLGroupBy struct {
 	selector
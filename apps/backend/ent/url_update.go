@@ -10,12 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/knowledgemention"
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/predicate"
 	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
 )
 
 // URLUpdate is the builder for updating URL entities.
@@ -65,143 +66,137 @@ func (_u *URLUpdate) SetNillableURLHash(v *string) *URLUpdate {
 	return _u
 }
 
-// SetTitle sets the "title" field.
-func (_u *URLUpdate) SetTitle(v string) *URLUpdate {
-	_u.mutation.SetTitle(v)
+// SetCrawledAt sets the "crawled_at" field.
+func (_u *URLUpdate) SetCrawledAt(v time.Time) *URLUpdate {
+	_u.mutation.SetCrawledAt(v)
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *URLUpdate) SetNillableTitle(v *string) *URLUpdate {
+// SetNillableCrawledAt sets the "crawled_at" field if the given value is not nil.
+func (_u *URLUpdate) SetNillableCrawledAt(v *time.Time) *URLUpdate {
 	if v != nil {
-		_u.SetTitle(*v)
+		_u.SetCrawledAt(*v)
 	}
 	return _u
 }
 
-// ClearTitle clears the value of the "title" field.
-func (_u *URLUpdate) ClearTitle() *URLUpdate {
-	_u.mutation.ClearTitle()
+// ClearCrawledAt clears the value of the "crawled_at" field.
+func (_u *URLUpdate) ClearCrawledAt() *URLUpdate {
+	_u.mutation.ClearCrawledAt()
 	return _u
 }
 
-// SetContent sets the "content" field.
-func (_u *URLUpdate) SetContent(v string) *URLUpdate {
-	_u.mutation.SetContent(v)
+// AddPageVisitIDs adds the "page_visits" edge to the PageVisit entity by IDs.
+func (_u *URLUpdate) AddPageVisitIDs(ids ...uuid.UUID) *URLUpdate {
+	_u.mutation.AddPageVisitIDs(ids...)
 	return _u
 }
 
-// SetNillableContent sets the "content" field if the given value is not nil.
-func (_u *URLUpdate) SetNillableContent(v *string) *URLUpdate {
-	if v != nil {
-		_u.SetContent(*v)
+// AddPageVisits adds the "page_visits" edges to the PageVisit entity.
+func (_u *URLUpdate) AddPageVisits(v ...*PageVisit) *URLUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
-}
-
-// ClearContent clears the value of the "content" field.
-func (_u *URLUpdate) ClearContent() *URLUpdate {
-	_u.mutation.ClearContent()
-	return _u
+	return _u.AddPageVisitIDs(ids...)
 }
 
-// SetSummary sets the "summary" field.
-func (_u *URLUpdate) SetSummary(v string) *URLUpdate {
-	_u.mutation.SetSummary(v)
+// AddContentIDs adds the "contents" edge to the URLContent entity by IDs.
+func (_u *URLUpdate) AddContentIDs(ids ...uuid.UUID) *URLUpdate {
+	_u.mutation.AddContentIDs(ids...)
 	return _u
 }
 
-// SetNillableSummary sets the "summary" field if the given value is not nil.
-func (_u *URLUpdate) SetNillableSummary(v *string) *URLUpdate {
-	if v != nil {
-		_u.SetSummary(*v)
+// AddContents adds the "contents" edges to the URLContent entity.
+func (_u *URLUpdate) AddContents(v ...*URLContent) *URLUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.AddContentIDs(ids...)
 }
 
-// ClearSummary clears the value of the "summary" field.
-func (_u *URLUpdate) ClearSummary() *URLUpdate {
-	_u.mutation.ClearSummary()
+// AddKnowledgeMentionIDs adds the "knowledge_mentions" edge to the KnowledgeMention entity by IDs.
+func (_u *URLUpdate) AddKnowledgeMentionIDs(ids ...uuid.UUID) *URLUpdate {
+	_u.mutation.AddKnowledgeMentionIDs(ids...)
 	return _u
 }
 
-// SetKeywords sets the "keywords" field.
-func (_u *URLUpdate) SetKeywords(v []string) *URLUpdate {
-	_u.mutation.SetKeywords(v)
-	return _u
+// AddKnowledgeMentions adds the "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_u *URLUpdate) AddKnowledgeMentions(v ...*KnowledgeMention) *URLUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKnowledgeMentionIDs(ids...)
 }
 
-// AppendKeywords appends value to the "keywords" field.
-func (_u *URLUpdate) AppendKeywords(v []string) *URLUpdate {
-	_u.mutation.AppendKeywords(v)
-	return _u
+// Mutation returns the URLMutation object of the builder.
+func (_u *URLUpdate) Mutation() *URLMutation {
+	return _u.mutation
 }
 
-// ClearKeywords clears the value of the "keywords" field.
-func (_u *URLUpdate) ClearKeywords() *URLUpdate {
-	_u.mutation.ClearKeywords()
+// ClearPageVisits clears all "page_visits" edges to the PageVisit entity.
+func (_u *URLUpdate) ClearPageVisits() *URLUpdate {
+	_u.mutation.ClearPageVisits()
 	return _u
 }
 
-// SetCrawledAt sets the "crawled_at" field.
-func (_u *URLUpdate) SetCrawledAt(v time.Time) *URLUpdate {
-	_u.mutation.SetCrawledAt(v)
+// RemovePageVisitIDs removes the "page_visits" edge to PageVisit entities by IDs.
+func (_u *URLUpdate) RemovePageVisitIDs(ids ...uuid.UUID) *URLUpdate {
+	_u.mutation.RemovePageVisitIDs(ids...)
 	return _u
 }
 
-// SetNillableCrawledAt sets the "crawled_at" field if the given value is not nil.
-func (_u *URLUpdate) SetNillableCrawledAt(v *time.Time) *URLUpdate {
-	if v != nil {
-		_u.SetCrawledAt(*v)
+// RemovePageVisits removes "page_visits" edges to PageVisit entities.
+func (_u *URLUpdate) RemovePageVisits(v ...*PageVisit) *URLUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.RemovePageVisitIDs(ids...)
 }
 
-// ClearCrawledAt clears the value of the "crawled_at" field.
-func (_u *URLUpdate) ClearCrawledAt() *URLUpdate {
-	_u.mutation.ClearCrawledAt()
+// ClearContents clears all "contents" edges to the URLContent entity.
+func (_u *URLUpdate) ClearContents() *URLUpdate {
+	_u.mutation.ClearContents()
 	return _u
 }
 
-// AddPageVisitIDs adds the "page_visits" edge to the PageVisit entity by IDs.
-func (_u *URLUpdate) AddPageVisitIDs(ids ...uuid.UUID) *URLUpdate {
-	_u.mutation.AddPageVisitIDs(ids...)
+// RemoveContentIDs removes the "contents" edge to URLContent entities by IDs.
+func (_u *URLUpdate) RemoveContentIDs(ids ...uuid.UUID) *URLUpdate {
+	_u.mutation.RemoveContentIDs(ids...)
 	return _u
 }
 
-// AddPageVisits adds the "page_visits" edges to the PageVisit entity.
-func (_u *URLUpdate) AddPageVisits(v ...*PageVisit) *URLUpdate {
+// RemoveContents removes "contents" edges to URLContent entities.
+func (_u *URLUpdate) RemoveContents(v ...*URLContent) *URLUpdate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddPageVisitIDs(ids...)
+	return _u.RemoveContentIDs(ids...)
 }
 
-// Mutation returns the URLMutation object of the builder.
-func (_u *URLUpdate) Mutation() *URLMutation {
-	return _u.mutation
-}
-
-// ClearPageVisits clears all "page_visits" edges to the PageVisit entity.
-func (_u *URLUpdate) ClearPageVisits() *URLUpdate {
-	_u.mutation.ClearPageVisits()
+// ClearKnowledgeMentions clears all "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_u *URLUpdate) ClearKnowledgeMentions() *URLUpdate {
+	_u.mutation.ClearKnowledgeMentions()
 	return _u
 }
 
-// RemovePageVisitIDs removes the "page_visits" edge to PageVisit entities by IDs.
-func (_u *URLUpdate) RemovePageVisitIDs(ids ...uuid.UUID) *URLUpdate {
-	_u.mutation.RemovePageVisitIDs(ids...)
+// RemoveKnowledgeMentionIDs removes the "knowledge_mentions" edge to KnowledgeMention entities by IDs.
+func (_u *URLUpdate) RemoveKnowledgeMentionIDs(ids ...uuid.UUID) *URLUpdate {
+	_u.mutation.RemoveKnowledgeMentionIDs(ids...)
 	return _u
 }
 
-// RemovePageVisits removes "page_visits" edges to PageVisit entities.
-func (_u *URLUpdate) RemovePageVisits(v ...*PageVisit) *URLUpdate {
+// RemoveKnowledgeMentions removes "knowledge_mentions" edges to KnowledgeMention entities.
+func (_u *URLUpdate) RemoveKnowledgeMentions(v ...*KnowledgeMention) *URLUpdate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePageVisitIDs(ids...)
+	return _u.RemoveKnowledgeMentionIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -276,35 +271,6 @@ func (_u *URLUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.URLHash(); ok {
 		_spec.SetField(url.FieldURLHash, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Title(); ok {
-		_spec.SetField(url.FieldTitle, field.TypeString, value)
-	}
-	if _u.mutation.TitleCleared() {
-		_spec.ClearField(url.FieldTitle, field.TypeString)
-	}
-	if value, ok := _u.mutation.Content(); ok {
-		_spec.SetField(url.FieldContent, field.TypeString, value)
-	}
-	if _u.mutation.ContentCleared() {
-		_spec.ClearField(url.FieldContent, field.TypeString)
-	}
-	if value, ok := _u.mutation.Summary(); ok {
-		_spec.SetField(url.FieldSummary, field.TypeString, value)
-	}
-	if _u.mutation.SummaryCleared() {
-		_spec.ClearField(url.FieldSummary, field.TypeString)
-	}
-	if value, ok := _u.mutation.Keywords(); ok {
-		_spec.SetField(url.FieldKeywords, field.TypeJSON, value)
-	}
-	if value, ok := _u.mutation.AppendedKeywords(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, url.FieldKeywords, value)
-		})
-	}
-	if _u.mutation.KeywordsCleared() {
-		_spec.ClearField(url.FieldKeywords, field.TypeJSON)
-	}
 	if value, ok := _u.mutation.CrawledAt(); ok {
 		_spec.SetField(url.FieldCrawledAt, field.TypeTime, value)
 	}
@@ -356,6 +322,96 @@ func (_u *URLUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   url.ContentsTable,
+			Columns: []string{url.ContentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContentsIDs(); len(nodes) > 0 && !_u.mutation.ContentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   url.ContentsTable,
+			Columns: []string{url.ContentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   url.ContentsTable,
+			Columns: []string{url.ContentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.KnowledgeMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   url.KnowledgeMentionsTable,
+			Columns: url.KnowledgeMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKnowledgeMentionsIDs(); len(nodes) > 0 && !_u.mutation.KnowledgeMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   url.KnowledgeMentionsTable,
+			Columns: url.KnowledgeMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KnowledgeMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   url.KnowledgeMentionsTable,
+			Columns: url.KnowledgeMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{url.Label}
@@ -410,143 +466,137 @@ func (_u *URLUpdateOne) SetNillableURLHash(v *string) *URLUpdateOne {
 	return _u
 }
 
-// SetTitle sets the "title" field.
-func (_u *URLUpdateOne) SetTitle(v string) *URLUpdateOne {
-	_u.mutation.SetTitle(v)
+// SetCrawledAt sets the "crawled_at" field.
+func (_u *URLUpdateOne) SetCrawledAt(v time.Time) *URLUpdateOne {
+	_u.mutation.SetCrawledAt(v)
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *URLUpdateOne) SetNillableTitle(v *string) *URLUpdateOne {
+// SetNillableCrawledAt sets the "crawled_at" field if the given value is not nil.
+func (_u *URLUpdateOne) SetNillableCrawledAt(v *time.Time) *URLUpdateOne {
 	if v != nil {
-		_u.SetTitle(*v)
+		_u.SetCrawledAt(*v)
 	}
 	return _u
 }
 
-// ClearTitle clears the value of the "title" field.
-func (_u *URLUpdateOne) ClearTitle() *URLUpdateOne {
-	_u.mutation.ClearTitle()
+// ClearCrawledAt clears the value of the "crawled_at" field.
+func (_u *URLUpdateOne) ClearCrawledAt() *URLUpdateOne {
+	_u.mutation.ClearCrawledAt()
 	return _u
 }
 
-// SetContent sets the "content" field.
-func (_u *URLUpdateOne) SetContent(v string) *URLUpdateOne {
-	_u.mutation.SetContent(v)
+// AddPageVisitIDs adds the "page_visits" edge to the PageVisit entity by IDs.
+func (_u *URLUpdateOne) AddPageVisitIDs(ids ...uuid.UUID) *URLUpdateOne {
+	_u.mutation.AddPageVisitIDs(ids...)
 	return _u
 }
 
-// SetNillableContent sets the "content" field if the given value is not nil.
-func (_u *URLUpdateOne) SetNillableContent(v *string) *URLUpdateOne {
-	if v != nil {
-		_u.SetContent(*v)
+// AddPageVisits adds the "page_visits" edges to the PageVisit entity.
+func (_u *URLUpdateOne) AddPageVisits(v ...*PageVisit) *URLUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
-}
-
-// ClearContent clears the value of the "content" field.
-func (_u *URLUpdateOne) ClearContent() *URLUpdateOne {
-	_u.mutation.ClearContent()
-	return _u
+	return _u.AddPageVisitIDs(ids...)
 }
 
-// SetSummary sets the "summary" field.
-func (_u *URLUpdateOne) SetSummary(v string) *URLUpdateOne {
-	_u.mutation.SetSummary(v)
+// AddContentIDs adds the "contents" edge to the URLContent entity by IDs.
+func (_u *URLUpdateOne) AddContentIDs(ids ...uuid.UUID) *URLUpdateOne {
+	_u.mutation.AddContentIDs(ids...)
 	return _u
 }
 
-// SetNillableSummary sets the "summary" field if the given value is not nil.
-func (_u *URLUpdateOne) SetNillableSummary(v *string) *URLUpdateOne {
-	if v != nil {
-		_u.SetSummary(*v)
+// AddContents adds the "contents" edges to the URLContent entity.
+func (_u *URLUpdateOne) AddContents(v ...*URLContent) *URLUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.AddContentIDs(ids...)
 }
 
-// ClearSummary clears the value of the "summary" field.
-func (_u *URLUpdateOne) ClearSummary() *URLUpdateOne {
-	_u.mutation.ClearSummary()
+// AddKnowledgeMentionIDs adds the "knowledge_mentions" edge to the KnowledgeMention entity by IDs.
+func (_u *URLUpdateOne) AddKnowledgeMentionIDs(ids ...uuid.UUID) *URLUpdateOne {
+	_u.mutation.AddKnowledgeMentionIDs(ids...)
 	return _u
 }
 
-// SetKeywords sets the "keywords" field.
-func (_u *URLUpdateOne) SetKeywords(v []string) *URLUpdateOne {
-	_u.mutation.SetKeywords(v)
-	return _u
+// AddKnowledgeMentions adds the "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_u *URLUpdateOne) AddKnowledgeMentions(v ...*KnowledgeMention) *URLUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKnowledgeMentionIDs(ids...)
 }
 
-// AppendKeywords appends value to the "keywords" field.
-func (_u *URLUpdateOne) AppendKeywords(v []string) *URLUpdateOne {
-	_u.mutation.AppendKeywords(v)
-	return _u
+// Mutation returns the URLMutation object of the builder.
+func (_u *URLUpdateOne) Mutation() *URLMutation {
+	return _u.mutation
 }
 
-// ClearKeywords clears the value of the "keywords" field.
-func (_u *URLUpdateOne) ClearKeywords() *URLUpdateOne {
-	_u.mutation.ClearKeywords()
+// ClearPageVisits clears all "page_visits" edges to the PageVisit entity.
+func (_u *URLUpdateOne) ClearPageVisits() *URLUpdateOne {
+	_u.mutation.ClearPageVisits()
 	return _u
 }
 
-// SetCrawledAt sets the "crawled_at" field.
-func (_u *URLUpdateOne) SetCrawledAt(v time.Time) *URLUpdateOne {
-	_u.mutation.SetCrawledAt(v)
+// RemovePageVisitIDs removes the "page_visits" edge to PageVisit entities by IDs.
+func (_u *URLUpdateOne) RemovePageVisitIDs(ids ...uuid.UUID) *URLUpdateOne {
+	_u.mutation.RemovePageVisitIDs(ids...)
 	return _u
 }
 
-// SetNillableCrawledAt sets the "crawled_at" field if the given value is not nil.
-func (_u *URLUpdateOne) SetNillableCrawledAt(v *time.Time) *URLUpdateOne {
-	if v != nil {
-		_u.SetCrawledAt(*v)
+// RemovePageVisits removes "page_visits" edges to PageVisit entities.
+func (_u *URLUpdateOne) RemovePageVisits(v ...*PageVisit) *URLUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.RemovePageVisitIDs(ids...)
 }
 
-// ClearCrawledAt clears the value of the "crawled_at" field.
-func (_u *URLUpdateOne) ClearCrawledAt() *URLUpdateOne {
-	_u.mutation.ClearCrawledAt()
+// ClearContents clears all "contents" edges to the URLContent entity.
+func (_u *URLUpdateOne) ClearContents() *URLUpdateOne {
+	_u.mutation.ClearContents()
 	return _u
 }
 
-// AddPageVisitIDs adds the "page_visits" edge to the PageVisit entity by IDs.
-func (_u *URLUpdateOne) AddPageVisitIDs(ids ...uuid.UUID) *URLUpdateOne {
-	_u.mutation.AddPageVisitIDs(ids...)
+// RemoveContentIDs removes the "contents" edge to URLContent entities by IDs.
+func (_u *URLUpdateOne) RemoveContentIDs(ids ...uuid.UUID) *URLUpdateOne {
+	_u.mutation.RemoveContentIDs(ids...)
 	return _u
 }
 
-// AddPageVisits adds the "page_visits" edges to the PageVisit entity.
-func (_u *URLUpdateOne) AddPageVisits(v ...*PageVisit) *URLUpdateOne {
+// RemoveContents removes "contents" edges to URLContent entities.
+func (_u *URLUpdateOne) RemoveContents(v ...*URLContent) *URLUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddPageVisitIDs(ids...)
+	return _u.RemoveContentIDs(ids...)
 }
 
-// Mutation returns the URLMutation object of the builder.
-func (_u *URLUpdateOne) Mutation() *URLMutation {
-	return _u.mutation
-}
-
-// ClearPageVisits clears all "page_visits" edges to the PageVisit entity.
-func (_u *URLUpdateOne) ClearPageVisits() *URLUpdateOne {
-	_u.mutation.ClearPageVisits()
+// ClearKnowledgeMentions clears all "knowledge_mentions" edges to the KnowledgeMention entity.
+func (_u *URLUpdateOne) ClearKnowledgeMentions() *URLUpdateOne {
+	_u.mutation.ClearKnowledgeMentions()
 	return _u
 }
 
-// RemovePageVisitIDs removes the "page_visits" edge to PageVisit entities by IDs.
-func (_u *URLUpdateOne) RemovePageVisitIDs(ids ...uuid.UUID) *URLUpdateOne {
-	_u.mutation.RemovePageVisitIDs(ids...)
+// RemoveKnowledgeMentionIDs removes the "knowledge_mentions" edge to KnowledgeMention entities by IDs.
+func (_u *URLUpdateOne) RemoveKnowledgeMentionIDs(ids ...uuid.UUID) *URLUpdateOne {
+	_u.mutation.RemoveKnowledgeMentionIDs(ids...)
 	return _u
 }
 
-// RemovePageVisits removes "page_visits" edges to PageVisit entities.
-func (_u *URLUpdateOne) RemovePageVisits(v ...*PageVisit) *URLUpdateOne {
+// RemoveKnowledgeMentions removes "knowledge_mentions" edges to KnowledgeMention entities.
+func (_u *URLUpdateOne) RemoveKnowledgeMentions(v ...*KnowledgeMention) *URLUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePageVisitIDs(ids...)
+	return _u.RemoveKnowledgeMentionIDs(ids...)
 }
 
 // Where appends a list predicates to the URLUpdate builder.
@@ -651,35 +701,6 @@ func (_u *URLUpdateOne) sqlSave(ctx context.Context) (_node *URL, err error) {
 	if value, ok := _u.mutation.URLHash(); ok {
 		_spec.SetField(url.FieldURLHash, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Title(); ok {
-		_spec.SetField(url.FieldTitle, field.TypeString, value)
-	}
-	if _u.mutation.TitleCleared() {
-		_spec.ClearField(url.FieldTitle, field.TypeString)
-	}
-	if value, ok := _u.mutation.Content(); ok {
-		_spec.SetField(url.FieldContent, field.TypeString, value)
-	}
-	if _u.mutation.ContentCleared() {
-		_spec.ClearField(url.FieldContent, field.TypeString)
-	}
-	if value, ok := _u.mutation.Summary(); ok {
-		_spec.SetField(url.FieldSummary, field.TypeString, value)
-	}
-	if _u.mutation.SummaryCleared() {
-		_spec.ClearField(url.FieldSummary, field.TypeString)
-	}
-	if value, ok := _u.mutation.Keywords(); ok {
-		_spec.SetField(url.FieldKeywords, field.TypeJSON, value)
-	}
-	if value, ok := _u.mutation.AppendedKeywords(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, url.FieldKeywords, value)
-		})
-	}
-	if _u.mutation.KeywordsCleared() {
-		_spec.ClearField(url.FieldKeywords, field.TypeJSON)
-	}
 	if value, ok := _u.mutation.CrawledAt(); ok {
 		_spec.SetField(url.FieldCrawledAt, field.TypeTime, value)
 	}
@@ -731,6 +752,96 @@ func (_u *URLUpdateOne) sqlSave(ctx context.Context) (_node *URL, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   url.ContentsTable,
+			Columns: []string{url.ContentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContentsIDs(); len(nodes) > 0 && !_u.mutation.ContentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   url.ContentsTable,
+			Columns: []string{url.ContentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   url.ContentsTable,
+			Columns: []string{url.ContentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.KnowledgeMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   url.KnowledgeMentionsTable,
+			Columns: url.KnowledgeMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKnowledgeMentionsIDs(); len(nodes) > 0 && !_u.mutation.KnowledgeMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   url.KnowledgeMentionsTable,
+			Columns: url.KnowledgeMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KnowledgeMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   url.KnowledgeMentionsTable,
+			Columns: url.KnowledgeMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knowledgemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &URL{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
+)
+
+// URLContent is the model entity for the URLContent schema.
+type URLContent struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary key
+	ID uuid.UUID `json:"id,omitempty"`
+	// Record creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Record last update timestamp
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Page title as seen by the user
+	Title string `json:"title,omitempty"`
+	// Extracted page content
+	Content string `json:"content,omitempty"`
+	// AI-generated summary
+	Summary string `json:"summary,omitempty"`
+	// AI-generated short abstract (1-2 sentences)
+	Abstract string `json:"abstract,omitempty"`
+	// AI-extracted keywords
+	Keywords []string `json:"keywords,omitempty"`
+	// AI-extracted key entities (people, organizations, products, concepts)
+	Entities []string `json:"entities,omitempty"`
+	// Last time the full-page summary was generated
+	SummarizedAt *time.Time `json:"summarized_at,omitempty"`
+	// Why the last full-page summary attempt failed
+	SummaryError *string `json:"summary_error,omitempty"`
+	// When the full-page summary last failed all its attempts
+	SummaryFailedAt *time.Time `json:"summary_failed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the URLContentQuery when eager-loading is set.
+	Edges             URLContentEdges `json:"edges"`
+	url_contents      *uuid.UUID
+	user_url_contents *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// URLContentEdges holds the relations/edges for other nodes in the graph.
+type URLContentEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// URL holds the value of the url edge.
+	URL *URL `json:"url,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e URLContentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// URLOrErr returns the URL value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e URLContentEdges) URLOrErr() (*URL, error) {
+	if e.URL != nil {
+		return e.URL, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: url.Label}
+	}
+	return nil, &NotLoadedError{edge: "url"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*URLContent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case urlcontent.FieldKeywords, urlcontent.FieldEntities:
+			values[i] = new([]byte)
+		case urlcontent.FieldTitle, urlcontent.FieldContent, urlcontent.FieldSummary, urlcontent.FieldAbstract, urlcontent.FieldSummaryError:
+			values[i] = new(sql.NullString)
+		case urlcontent.FieldCreatedAt, urlcontent.FieldUpdatedAt, urlcontent.FieldSummarizedAt, urlcontent.FieldSummaryFailedAt:
+			values[i] = new(sql.NullTime)
+		case urlcontent.FieldID:
+			values[i] = new(uuid.UUID)
+		case urlcontent.ForeignKeys[0]: // url_contents
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case urlcontent.ForeignKeys[1]: // user_url_contents
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the URLContent fields.
+func (_m *URLContent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case urlcontent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case urlcontent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case urlcontent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case urlcontent.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case urlcontent.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case urlcontent.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				_m.Summary = value.String
+			}
+		case urlcontent.FieldAbstract:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field abstract", values[i])
+			} else if value.Valid {
+				_m.Abstract = value.String
+			}
+		case urlcontent.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case urlcontent.FieldEntities:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field entities", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Entities); err != nil {
+					return fmt.Errorf("unmarshal field entities: %w", err)
+				}
+			}
+		case urlcontent.FieldSummarizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field summarized_at", values[i])
+			} else if value.Valid {
+				_m.SummarizedAt = new(time.Time)
+				*_m.SummarizedAt = value.Time
+			}
+		case urlcontent.FieldSummaryError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_error", values[i])
+			} else if value.Valid {
+				_m.SummaryError = new(string)
+				*_m.SummaryError = value.String
+			}
+		case urlcontent.FieldSummaryFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_failed_at", values[i])
+			} else if value.Valid {
+				_m.SummaryFailedAt = new(time.Time)
+				*_m.SummaryFailedAt = value.Time
+			}
+		case urlcontent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field url_contents", values[i])
+			} else if value.Valid {
+				_m.url_contents = new(uuid.UUID)
+				*_m.url_contents = *value.S.(*uuid.UUID)
+			}
+		case urlcontent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_url_contents", values[i])
+			} else if value.Valid {
+				_m.user_url_contents = new(uuid.UUID)
+				*_m.user_url_contents = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the URLContent.
+// This includes values selected through modifiers, order, etc.
+func (_m *URLContent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the URLContent entity.
+func (_m *URLContent) QueryUser() *UserQuery {
+	return NewURLContentClient(_m.config).QueryUser(_m)
+}
+
+// QueryURL queries the "url" edge of the URLContent entity.
+func (_m *URLContent) QueryURL() *URLQuery {
+	return NewURLContentClient(_m.config).QueryURL(_m)
+}
+
+// Update returns a builder for updating this URLContent.
+// Note that you need to call URLContent.Unwrap() before calling this method if this URLContent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *URLContent) Update() *URLContentUpdateOne {
+	return NewURLContentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the URLContent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *URLContent) Unwrap() *URLContent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: URLContent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *URLContent) String() string {
+	var builder strings.Builder
+	builder.WriteString("URLContent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(_m.Summary)
+	builder.WriteString(", ")
+	builder.WriteString("abstract=")
+	builder.WriteString(_m.Abstract)
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Keywords))
+	builder.WriteString(", ")
+	builder.WriteString("entities=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Entities))
+	builder.WriteString(", ")
+	if v := _m.SummarizedAt; v != nil {
+		builder.WriteString("summarized_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SummaryError; v != nil {
+		builder.WriteString("summary_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SummaryFailedAt; v != nil {
+		builder.WriteString("summary_failed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// URLContents is a parsable slice of URLContent.
+type URLContents []*URLContent
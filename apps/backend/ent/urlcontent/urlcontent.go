@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package urlcontent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the urlcontent type in the database.
+	Label = "url_content"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldSummary holds the string denoting the summary field in the database.
+	FieldSummary = "summary"
+	// FieldAbstract holds the string denoting the abstract field in the database.
+	FieldAbstract = "abstract"
+	// FieldKeywords holds the string denoting the keywords field in the database.
+	FieldKeywords = "keywords"
+	// FieldEntities holds the string denoting the entities field in the database.
+	FieldEntities = "entities"
+	// FieldSummarizedAt holds the string denoting the summarized_at field in the database.
+	FieldSummarizedAt = "summarized_at"
+	// FieldSummaryError holds the string denoting the summary_error field in the database.
+	FieldSummaryError = "summary_error"
+	// FieldSummaryFailedAt holds the string denoting the summary_failed_at field in the database.
+	FieldSummaryFailedAt = "summary_failed_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeURL holds the string denoting the url edge name in mutations.
+	EdgeURL = "url"
+	// Table holds the table name of the urlcontent in the database.
+	Table = "url_contents"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "url_contents"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_url_contents"
+	// URLTable is the table that holds the url relation/edge.
+	URLTable = "url_contents"
+	// URLInverseTable is the table name for the URL entity.
+	// It exists in this package in order to avoid circular dependency with the "url" package.
+	URLInverseTable = "ur_ls"
+	// URLColumn is the table column denoting the url relation/edge.
+	URLColumn = "url_contents"
+)
+
+// Columns holds all SQL columns for urlcontent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldTitle,
+	FieldContent,
+	FieldSummary,
+	FieldAbstract,
+	FieldKeywords,
+	FieldEntities,
+	FieldSummarizedAt,
+	FieldSummaryError,
+	FieldSummaryFailedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "url_contents"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"url_contents",
+	"user_url_contents",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the URLContent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// BySummary orders the results by the summary field.
+func BySummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummary, opts...).ToFunc()
+}
+
+// ByAbstract orders the results by the abstract field.
+func ByAbstract(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbstract, opts...).ToFunc()
+}
+
+// BySummarizedAt orders the results by the summarized_at field.
+func BySummarizedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummarizedAt, opts...).ToFunc()
+}
+
+// BySummaryError orders the results by the summary_error field.
+func BySummaryError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummaryError, opts...).ToFunc()
+}
+
+// BySummaryFailedAt orders the results by the summary_failed_at field.
+func BySummaryFailedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummaryFailedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByURLField orders the results by url field.
+func ByURLField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newURLStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newURLStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(URLInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, URLTable, URLColumn),
+	)
+}
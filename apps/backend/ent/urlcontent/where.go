@@ -0,0 +1,738 @@
+// Code generated by ent, DO NOT EDIT.
+
+package urlcontent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldTitle, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldContent, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldSummary, v))
+}
+
+// Abstract applies equality check predicate on the "abstract" field. It's identical to AbstractEQ.
+func Abstract(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldAbstract, v))
+}
+
+// SummarizedAt applies equality check predicate on the "summarized_at" field. It's identical to SummarizedAtEQ.
+func SummarizedAt(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldSummarizedAt, v))
+}
+
+// SummaryError applies equality check predicate on the "summary_error" field. It's identical to SummaryErrorEQ.
+func SummaryError(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldSummaryError, v))
+}
+
+// SummaryFailedAt applies equality check predicate on the "summary_failed_at" field. It's identical to SummaryFailedAtEQ.
+func SummaryFailedAt(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldSummaryFailedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContainsFold(FieldContent, v))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// AbstractEQ applies the EQ predicate on the "abstract" field.
+func AbstractEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldAbstract, v))
+}
+
+// AbstractNEQ applies the NEQ predicate on the "abstract" field.
+func AbstractNEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldAbstract, v))
+}
+
+// AbstractIn applies the In predicate on the "abstract" field.
+func AbstractIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldAbstract, vs...))
+}
+
+// AbstractNotIn applies the NotIn predicate on the "abstract" field.
+func AbstractNotIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldAbstract, vs...))
+}
+
+// AbstractGT applies the GT predicate on the "abstract" field.
+func AbstractGT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldAbstract, v))
+}
+
+// AbstractGTE applies the GTE predicate on the "abstract" field.
+func AbstractGTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldAbstract, v))
+}
+
+// AbstractLT applies the LT predicate on the "abstract" field.
+func AbstractLT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldAbstract, v))
+}
+
+// AbstractLTE applies the LTE predicate on the "abstract" field.
+func AbstractLTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldAbstract, v))
+}
+
+// AbstractContains applies the Contains predicate on the "abstract" field.
+func AbstractContains(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContains(FieldAbstract, v))
+}
+
+// AbstractHasPrefix applies the HasPrefix predicate on the "abstract" field.
+func AbstractHasPrefix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasPrefix(FieldAbstract, v))
+}
+
+// AbstractHasSuffix applies the HasSuffix predicate on the "abstract" field.
+func AbstractHasSuffix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasSuffix(FieldAbstract, v))
+}
+
+// AbstractIsNil applies the IsNil predicate on the "abstract" field.
+func AbstractIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldAbstract))
+}
+
+// AbstractNotNil applies the NotNil predicate on the "abstract" field.
+func AbstractNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldAbstract))
+}
+
+// AbstractEqualFold applies the EqualFold predicate on the "abstract" field.
+func AbstractEqualFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEqualFold(FieldAbstract, v))
+}
+
+// AbstractContainsFold applies the ContainsFold predicate on the "abstract" field.
+func AbstractContainsFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContainsFold(FieldAbstract, v))
+}
+
+// KeywordsIsNil applies the IsNil predicate on the "keywords" field.
+func KeywordsIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldKeywords))
+}
+
+// KeywordsNotNil applies the NotNil predicate on the "keywords" field.
+func KeywordsNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldKeywords))
+}
+
+// EntitiesIsNil applies the IsNil predicate on the "entities" field.
+func EntitiesIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldEntities))
+}
+
+// EntitiesNotNil applies the NotNil predicate on the "entities" field.
+func EntitiesNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldEntities))
+}
+
+// SummarizedAtEQ applies the EQ predicate on the "summarized_at" field.
+func SummarizedAtEQ(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldSummarizedAt, v))
+}
+
+// SummarizedAtNEQ applies the NEQ predicate on the "summarized_at" field.
+func SummarizedAtNEQ(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldSummarizedAt, v))
+}
+
+// SummarizedAtIn applies the In predicate on the "summarized_at" field.
+func SummarizedAtIn(vs ...time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldSummarizedAt, vs...))
+}
+
+// SummarizedAtNotIn applies the NotIn predicate on the "summarized_at" field.
+func SummarizedAtNotIn(vs ...time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldSummarizedAt, vs...))
+}
+
+// SummarizedAtGT applies the GT predicate on the "summarized_at" field.
+func SummarizedAtGT(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldSummarizedAt, v))
+}
+
+// SummarizedAtGTE applies the GTE predicate on the "summarized_at" field.
+func SummarizedAtGTE(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldSummarizedAt, v))
+}
+
+// SummarizedAtLT applies the LT predicate on the "summarized_at" field.
+func SummarizedAtLT(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldSummarizedAt, v))
+}
+
+// SummarizedAtLTE applies the LTE predicate on the "summarized_at" field.
+func SummarizedAtLTE(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldSummarizedAt, v))
+}
+
+// SummarizedAtIsNil applies the IsNil predicate on the "summarized_at" field.
+func SummarizedAtIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldSummarizedAt))
+}
+
+// SummarizedAtNotNil applies the NotNil predicate on the "summarized_at" field.
+func SummarizedAtNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldSummarizedAt))
+}
+
+// SummaryErrorEQ applies the EQ predicate on the "summary_error" field.
+func SummaryErrorEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldSummaryError, v))
+}
+
+// SummaryErrorNEQ applies the NEQ predicate on the "summary_error" field.
+func SummaryErrorNEQ(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldSummaryError, v))
+}
+
+// SummaryErrorIn applies the In predicate on the "summary_error" field.
+func SummaryErrorIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldSummaryError, vs...))
+}
+
+// SummaryErrorNotIn applies the NotIn predicate on the "summary_error" field.
+func SummaryErrorNotIn(vs ...string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldSummaryError, vs...))
+}
+
+// SummaryErrorGT applies the GT predicate on the "summary_error" field.
+func SummaryErrorGT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldSummaryError, v))
+}
+
+// SummaryErrorGTE applies the GTE predicate on the "summary_error" field.
+func SummaryErrorGTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldSummaryError, v))
+}
+
+// SummaryErrorLT applies the LT predicate on the "summary_error" field.
+func SummaryErrorLT(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldSummaryError, v))
+}
+
+// SummaryErrorLTE applies the LTE predicate on the "summary_error" field.
+func SummaryErrorLTE(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldSummaryError, v))
+}
+
+// SummaryErrorContains applies the Contains predicate on the "summary_error" field.
+func SummaryErrorContains(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContains(FieldSummaryError, v))
+}
+
+// SummaryErrorHasPrefix applies the HasPrefix predicate on the "summary_error" field.
+func SummaryErrorHasPrefix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasPrefix(FieldSummaryError, v))
+}
+
+// SummaryErrorHasSuffix applies the HasSuffix predicate on the "summary_error" field.
+func SummaryErrorHasSuffix(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldHasSuffix(FieldSummaryError, v))
+}
+
+// SummaryErrorIsNil applies the IsNil predicate on the "summary_error" field.
+func SummaryErrorIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldSummaryError))
+}
+
+// SummaryErrorNotNil applies the NotNil predicate on the "summary_error" field.
+func SummaryErrorNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldSummaryError))
+}
+
+// SummaryErrorEqualFold applies the EqualFold predicate on the "summary_error" field.
+func SummaryErrorEqualFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEqualFold(FieldSummaryError, v))
+}
+
+// SummaryErrorContainsFold applies the ContainsFold predicate on the "summary_error" field.
+func SummaryErrorContainsFold(v string) predicate.URLContent {
+	return predicate.URLContent(sql.FieldContainsFold(FieldSummaryError, v))
+}
+
+// SummaryFailedAtEQ applies the EQ predicate on the "summary_failed_at" field.
+func SummaryFailedAtEQ(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldEQ(FieldSummaryFailedAt, v))
+}
+
+// SummaryFailedAtNEQ applies the NEQ predicate on the "summary_failed_at" field.
+func SummaryFailedAtNEQ(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNEQ(FieldSummaryFailedAt, v))
+}
+
+// SummaryFailedAtIn applies the In predicate on the "summary_failed_at" field.
+func SummaryFailedAtIn(vs ...time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldIn(FieldSummaryFailedAt, vs...))
+}
+
+// SummaryFailedAtNotIn applies the NotIn predicate on the "summary_failed_at" field.
+func SummaryFailedAtNotIn(vs ...time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotIn(FieldSummaryFailedAt, vs...))
+}
+
+// SummaryFailedAtGT applies the GT predicate on the "summary_failed_at" field.
+func SummaryFailedAtGT(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGT(FieldSummaryFailedAt, v))
+}
+
+// SummaryFailedAtGTE applies the GTE predicate on the "summary_failed_at" field.
+func SummaryFailedAtGTE(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldGTE(FieldSummaryFailedAt, v))
+}
+
+// SummaryFailedAtLT applies the LT predicate on the "summary_failed_at" field.
+func SummaryFailedAtLT(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLT(FieldSummaryFailedAt, v))
+}
+
+// SummaryFailedAtLTE applies the LTE predicate on the "summary_failed_at" field.
+func SummaryFailedAtLTE(v time.Time) predicate.URLContent {
+	return predicate.URLContent(sql.FieldLTE(FieldSummaryFailedAt, v))
+}
+
+// SummaryFailedAtIsNil applies the IsNil predicate on the "summary_failed_at" field.
+func SummaryFailedAtIsNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldIsNull(FieldSummaryFailedAt))
+}
+
+// SummaryFailedAtNotNil applies the NotNil predicate on the "summary_failed_at" field.
+func SummaryFailedAtNotNil() predicate.URLContent {
+	return predicate.URLContent(sql.FieldNotNull(FieldSummaryFailedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.URLContent {
+	return predicate.URLContent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.URLContent {
+	return predicate.URLContent(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasURL applies the HasEdge predicate on the "url" edge.
+func HasURL() predicate.URLContent {
+	return predicate.URLContent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, URLTable, URLColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasURLWith applies the HasEdge predicate on the "url" edge with a given conditions (other predicates).
+func HasURLWith(preds ...predicate.URL) predicate.URLContent {
+	return predicate.URLContent(func(s *sql.Selector) {
+		step := newURLStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.URLContent) predicate.URLContent {
+	return predicate.URLContent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.URLContent) predicate.URLContent {
+	return predicate.URLContent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.URLContent) predicate.URLContent {
+	return predicate.URLContent(sql.NotPredicates(p))
+}
@@ -0,0 +1,461 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
+)
+
+// URLContentCreate is the builder for creating a URLContent entity.
+type URLContentCreate struct {
+	config
+	mutation *URLContentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *URLContentCreate) SetCreatedAt(v time.Time) *URLContentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableCreatedAt(v *time.Time) *URLContentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *URLContentCreate) SetUpdatedAt(v time.Time) *URLContentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableUpdatedAt(v *time.Time) *URLContentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *URLContentCreate) SetTitle(v string) *URLContentCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableTitle(v *string) *URLContentCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *URLContentCreate) SetContent(v string) *URLContentCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableContent(v *string) *URLContentCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *URLContentCreate) SetSummary(v string) *URLContentCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableSummary(v *string) *URLContentCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetAbstract sets the "abstract" field.
+func (_c *URLContentCreate) SetAbstract(v string) *URLContentCreate {
+	_c.mutation.SetAbstract(v)
+	return _c
+}
+
+// SetNillableAbstract sets the "abstract" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableAbstract(v *string) *URLContentCreate {
+	if v != nil {
+		_c.SetAbstract(*v)
+	}
+	return _c
+}
+
+// SetKeywords sets the "keywords" field.
+func (_c *URLContentCreate) SetKeywords(v []string) *URLContentCreate {
+	_c.mutation.SetKeywords(v)
+	return _c
+}
+
+// SetEntities sets the "entities" field.
+func (_c *URLContentCreate) SetEntities(v []string) *URLContentCreate {
+	_c.mutation.SetEntities(v)
+	return _c
+}
+
+// SetSummarizedAt sets the "summarized_at" field.
+func (_c *URLContentCreate) SetSummarizedAt(v time.Time) *URLContentCreate {
+	_c.mutation.SetSummarizedAt(v)
+	return _c
+}
+
+// SetNillableSummarizedAt sets the "summarized_at" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableSummarizedAt(v *time.Time) *URLContentCreate {
+	if v != nil {
+		_c.SetSummarizedAt(*v)
+	}
+	return _c
+}
+
+// SetSummaryError sets the "summary_error" field.
+func (_c *URLContentCreate) SetSummaryError(v string) *URLContentCreate {
+	_c.mutation.SetSummaryError(v)
+	return _c
+}
+
+// SetNillableSummaryError sets the "summary_error" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableSummaryError(v *string) *URLContentCreate {
+	if v != nil {
+		_c.SetSummaryError(*v)
+	}
+	return _c
+}
+
+// SetSummaryFailedAt sets the "summary_failed_at" field.
+func (_c *URLContentCreate) SetSummaryFailedAt(v time.Time) *URLContentCreate {
+	_c.mutation.SetSummaryFailedAt(v)
+	return _c
+}
+
+// SetNillableSummaryFailedAt sets the "summary_failed_at" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableSummaryFailedAt(v *time.Time) *URLContentCreate {
+	if v != nil {
+		_c.SetSummaryFailedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *URLContentCreate) SetID(v uuid.UUID) *URLContentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *URLContentCreate) SetNillableID(v *uuid.UUID) *URLContentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *URLContentCreate) SetUserID(id uuid.UUID) *URLContentCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *URLContentCreate) SetUser(v *User) *URLContentCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetURLID sets the "url" edge to the URL entity by ID.
+func (_c *URLContentCreate) SetURLID(id uuid.UUID) *URLContentCreate {
+	_c.mutation.SetURLID(id)
+	return _c
+}
+
+// SetURL sets the "url" edge to the URL entity.
+func (_c *URLContentCreate) SetURL(v *URL) *URLContentCreate {
+	return _c.SetURLID(v.ID)
+}
+
+// Mutation returns the URLContentMutation object of the builder.
+func (_c *URLContentCreate) Mutation() *URLContentMutation {
+	return _c.mutation
+}
+
+// Save creates the URLContent in the database.
+func (_c *URLContentCreate) Save(ctx context.Context) (*URLContent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *URLContentCreate) SaveX(ctx context.Context) *URLContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *URLContentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *URLContentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *URLContentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := urlcontent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := urlcontent.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := urlcontent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *URLContentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "URLContent.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "URLContent.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "URLContent.user"`)}
+	}
+	if len(_c.mutation.URLIDs()) == 0 {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required edge "URLContent.url"`)}
+	}
+	return nil
+}
+
+func (_c *URLContentCreate) sqlSave(ctx context.Context) (*URLContent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *URLContentCreate) createSpec() (*URLContent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &URLContent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(urlcontent.Table, sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(urlcontent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(urlcontent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(urlcontent.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(urlcontent.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(urlcontent.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := _c.mutation.Abstract(); ok {
+		_spec.SetField(urlcontent.FieldAbstract, field.TypeString, value)
+		_node.Abstract = value
+	}
+	if value, ok := _c.mutation.Keywords(); ok {
+		_spec.SetField(urlcontent.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := _c.mutation.Entities(); ok {
+		_spec.SetField(urlcontent.FieldEntities, field.TypeJSON, value)
+		_node.Entities = value
+	}
+	if value, ok := _c.mutation.SummarizedAt(); ok {
+		_spec.SetField(urlcontent.FieldSummarizedAt, field.TypeTime, value)
+		_node.SummarizedAt = &value
+	}
+	if value, ok := _c.mutation.SummaryError(); ok {
+		_spec.SetField(urlcontent.FieldSummaryError, field.TypeString, value)
+		_node.SummaryError = &value
+	}
+	if value, ok := _c.mutation.SummaryFailedAt(); ok {
+		_spec.SetField(urlcontent.FieldSummaryFailedAt, field.TypeTime, value)
+		_node.SummaryFailedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.UserTable,
+			Columns: []string{urlcontent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_url_contents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.URLIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.URLTable,
+			Columns: []string{urlcontent.URLColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.url_contents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// URLContentCreateBulk is the builder for creating many URLContent entities in bulk.
+type URLContentCreateBulk struct {
+	config
+	err      error
+	builders []*URLContentCreate
+}
+
+// Save creates the URLContent entities in the database.
+func (_c *URLContentCreateBulk) Save(ctx context.Context) ([]*URLContent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*URLContent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*URLContentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *URLContentCreateBulk) SaveX(ctx context.Context) []*URLContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *URLContentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *URLContentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
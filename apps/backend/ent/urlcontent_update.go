@@ -0,0 +1,908 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
+)
+
+// URLContentUpdate is the builder for updating URLContent entities.
+type URLContentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *URLContentMutation
+}
+
+// Where appends a list predicates to the URLContentUpdate builder.
+func (_u *URLContentUpdate) Where(ps ...predicate.URLContent) *URLContentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *URLContentUpdate) SetUpdatedAt(v time.Time) *URLContentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *URLContentUpdate) SetTitle(v string) *URLContentUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *URLContentUpdate) SetNillableTitle(v *string) *URLContentUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *URLContentUpdate) ClearTitle() *URLContentUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *URLContentUpdate) SetContent(v string) *URLContentUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *URLContentUpdate) SetNillableContent(v *string) *URLContentUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *URLContentUpdate) ClearContent() *URLContentUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *URLContentUpdate) SetSummary(v string) *URLContentUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *URLContentUpdate) SetNillableSummary(v *string) *URLContentUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *URLContentUpdate) ClearSummary() *URLContentUpdate {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetAbstract sets the "abstract" field.
+func (_u *URLContentUpdate) SetAbstract(v string) *URLContentUpdate {
+	_u.mutation.SetAbstract(v)
+	return _u
+}
+
+// SetNillableAbstract sets the "abstract" field if the given value is not nil.
+func (_u *URLContentUpdate) SetNillableAbstract(v *string) *URLContentUpdate {
+	if v != nil {
+		_u.SetAbstract(*v)
+	}
+	return _u
+}
+
+// ClearAbstract clears the value of the "abstract" field.
+func (_u *URLContentUpdate) ClearAbstract() *URLContentUpdate {
+	_u.mutation.ClearAbstract()
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *URLContentUpdate) SetKeywords(v []string) *URLContentUpdate {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *URLContentUpdate) AppendKeywords(v []string) *URLContentUpdate {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (_u *URLContentUpdate) ClearKeywords() *URLContentUpdate {
+	_u.mutation.ClearKeywords()
+	return _u
+}
+
+// SetEntities sets the "entities" field.
+func (_u *URLContentUpdate) SetEntities(v []string) *URLContentUpdate {
+	_u.mutation.SetEntities(v)
+	return _u
+}
+
+// AppendEntities appends value to the "entities" field.
+func (_u *URLContentUpdate) AppendEntities(v []string) *URLContentUpdate {
+	_u.mutation.AppendEntities(v)
+	return _u
+}
+
+// ClearEntities clears the value of the "entities" field.
+func (_u *URLContentUpdate) ClearEntities() *URLContentUpdate {
+	_u.mutation.ClearEntities()
+	return _u
+}
+
+// SetSummarizedAt sets the "summarized_at" field.
+func (_u *URLContentUpdate) SetSummarizedAt(v time.Time) *URLContentUpdate {
+	_u.mutation.SetSummarizedAt(v)
+	return _u
+}
+
+// SetNillableSummarizedAt sets the "summarized_at" field if the given value is not nil.
+func (_u *URLContentUpdate) SetNillableSummarizedAt(v *time.Time) *URLContentUpdate {
+	if v != nil {
+		_u.SetSummarizedAt(*v)
+	}
+	return _u
+}
+
+// ClearSummarizedAt clears the value of the "summarized_at" field.
+func (_u *URLContentUpdate) ClearSummarizedAt() *URLContentUpdate {
+	_u.mutation.ClearSummarizedAt()
+	return _u
+}
+
+// SetSummaryError sets the "summary_error" field.
+func (_u *URLContentUpdate) SetSummaryError(v string) *URLContentUpdate {
+	_u.mutation.SetSummaryError(v)
+	return _u
+}
+
+// SetNillableSummaryError sets the "summary_error" field if the given value is not nil.
+func (_u *URLContentUpdate) SetNillableSummaryError(v *string) *URLContentUpdate {
+	if v != nil {
+		_u.SetSummaryError(*v)
+	}
+	return _u
+}
+
+// ClearSummaryError clears the value of the "summary_error" field.
+func (_u *URLContentUpdate) ClearSummaryError() *URLContentUpdate {
+	_u.mutation.ClearSummaryError()
+	return _u
+}
+
+// SetSummaryFailedAt sets the "summary_failed_at" field.
+func (_u *URLContentUpdate) SetSummaryFailedAt(v time.Time) *URLContentUpdate {
+	_u.mutation.SetSummaryFailedAt(v)
+	return _u
+}
+
+// SetNillableSummaryFailedAt sets the "summary_failed_at" field if the given value is not nil.
+func (_u *URLContentUpdate) SetNillableSummaryFailedAt(v *time.Time) *URLContentUpdate {
+	if v != nil {
+		_u.SetSummaryFailedAt(*v)
+	}
+	return _u
+}
+
+// ClearSummaryFailedAt clears the value of the "summary_failed_at" field.
+func (_u *URLContentUpdate) ClearSummaryFailedAt() *URLContentUpdate {
+	_u.mutation.ClearSummaryFailedAt()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *URLContentUpdate) SetUserID(id uuid.UUID) *URLContentUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *URLContentUpdate) SetUser(v *User) *URLContentUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetURLID sets the "url" edge to the URL entity by ID.
+func (_u *URLContentUpdate) SetURLID(id uuid.UUID) *URLContentUpdate {
+	_u.mutation.SetURLID(id)
+	return _u
+}
+
+// SetURL sets the "url" edge to the URL entity.
+func (_u *URLContentUpdate) SetURL(v *URL) *URLContentUpdate {
+	return _u.SetURLID(v.ID)
+}
+
+// Mutation returns the URLContentMutation object of the builder.
+func (_u *URLContentUpdate) Mutation() *URLContentMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *URLContentUpdate) ClearUser() *URLContentUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearURL clears the "url" edge to the URL entity.
+func (_u *URLContentUpdate) ClearURL() *URLContentUpdate {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *URLContentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *URLContentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *URLContentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *URLContentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *URLContentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := urlcontent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *URLContentUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "URLContent.user"`)
+	}
+	if _u.mutation.URLCleared() && len(_u.mutation.URLIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "URLContent.url"`)
+	}
+	return nil
+}
+
+func (_u *URLContentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(urlcontent.Table, urlcontent.Columns, sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(urlcontent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(urlcontent.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(urlcontent.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(urlcontent.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(urlcontent.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(urlcontent.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(urlcontent.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.Abstract(); ok {
+		_spec.SetField(urlcontent.FieldAbstract, field.TypeString, value)
+	}
+	if _u.mutation.AbstractCleared() {
+		_spec.ClearField(urlcontent.FieldAbstract, field.TypeString)
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(urlcontent.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, urlcontent.FieldKeywords, value)
+		})
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(urlcontent.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Entities(); ok {
+		_spec.SetField(urlcontent.FieldEntities, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEntities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, urlcontent.FieldEntities, value)
+		})
+	}
+	if _u.mutation.EntitiesCleared() {
+		_spec.ClearField(urlcontent.FieldEntities, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SummarizedAt(); ok {
+		_spec.SetField(urlcontent.FieldSummarizedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SummarizedAtCleared() {
+		_spec.ClearField(urlcontent.FieldSummarizedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SummaryError(); ok {
+		_spec.SetField(urlcontent.FieldSummaryError, field.TypeString, value)
+	}
+	if _u.mutation.SummaryErrorCleared() {
+		_spec.ClearField(urlcontent.FieldSummaryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SummaryFailedAt(); ok {
+		_spec.SetField(urlcontent.FieldSummaryFailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SummaryFailedAtCleared() {
+		_spec.ClearField(urlcontent.FieldSummaryFailedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.UserTable,
+			Columns: []string{urlcontent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.UserTable,
+			Columns: []string{urlcontent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.URLCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.URLTable,
+			Columns: []string{urlcontent.URLColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.URLIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.URLTable,
+			Columns: []string{urlcontent.URLColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{urlcontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// URLContentUpdateOne is the builder for updating a single URLContent entity.
+type URLContentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *URLContentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *URLContentUpdateOne) SetUpdatedAt(v time.Time) *URLContentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *URLContentUpdateOne) SetTitle(v string) *URLContentUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *URLContentUpdateOne) SetNillableTitle(v *string) *URLContentUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *URLContentUpdateOne) ClearTitle() *URLContentUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *URLContentUpdateOne) SetContent(v string) *URLContentUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *URLContentUpdateOne) SetNillableContent(v *string) *URLContentUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *URLContentUpdateOne) ClearContent() *URLContentUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *URLContentUpdateOne) SetSummary(v string) *URLContentUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *URLContentUpdateOne) SetNillableSummary(v *string) *URLContentUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *URLContentUpdateOne) ClearSummary() *URLContentUpdateOne {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetAbstract sets the "abstract" field.
+func (_u *URLContentUpdateOne) SetAbstract(v string) *URLContentUpdateOne {
+	_u.mutation.SetAbstract(v)
+	return _u
+}
+
+// SetNillableAbstract sets the "abstract" field if the given value is not nil.
+func (_u *URLContentUpdateOne) SetNillableAbstract(v *string) *URLContentUpdateOne {
+	if v != nil {
+		_u.SetAbstract(*v)
+	}
+	return _u
+}
+
+// ClearAbstract clears the value of the "abstract" field.
+func (_u *URLContentUpdateOne) ClearAbstract() *URLContentUpdateOne {
+	_u.mutation.ClearAbstract()
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *URLContentUpdateOne) SetKeywords(v []string) *URLContentUpdateOne {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *URLContentUpdateOne) AppendKeywords(v []string) *URLContentUpdateOne {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (_u *URLContentUpdateOne) ClearKeywords() *URLContentUpdateOne {
+	_u.mutation.ClearKeywords()
+	return _u
+}
+
+// SetEntities sets the "entities" field.
+func (_u *URLContentUpdateOne) SetEntities(v []string) *URLContentUpdateOne {
+	_u.mutation.SetEntities(v)
+	return _u
+}
+
+// AppendEntities appends value to the "entities" field.
+func (_u *URLContentUpdateOne) AppendEntities(v []string) *URLContentUpdateOne {
+	_u.mutation.AppendEntities(v)
+	return _u
+}
+
+// ClearEntities clears the value of the "entities" field.
+func (_u *URLContentUpdateOne) ClearEntities() *URLContentUpdateOne {
+	_u.mutation.ClearEntities()
+	return _u
+}
+
+// SetSummarizedAt sets the "summarized_at" field.
+func (_u *URLContentUpdateOne) SetSummarizedAt(v time.Time) *URLContentUpdateOne {
+	_u.mutation.SetSummarizedAt(v)
+	return _u
+}
+
+// SetNillableSummarizedAt sets the "summarized_at" field if the given value is not nil.
+func (_u *URLContentUpdateOne) SetNillableSummarizedAt(v *time.Time) *URLContentUpdateOne {
+	if v != nil {
+		_u.SetSummarizedAt(*v)
+	}
+	return _u
+}
+
+// ClearSummarizedAt clears the value of the "summarized_at" field.
+func (_u *URLContentUpdateOne) ClearSummarizedAt() *URLContentUpdateOne {
+	_u.mutation.ClearSummarizedAt()
+	return _u
+}
+
+// SetSummaryError sets the "summary_error" field.
+func (_u *URLContentUpdateOne) SetSummaryError(v string) *URLContentUpdateOne {
+	_u.mutation.SetSummaryError(v)
+	return _u
+}
+
+// SetNillableSummaryError sets the "summary_error" field if the given value is not nil.
+func (_u *URLContentUpdateOne) SetNillableSummaryError(v *string) *URLContentUpdateOne {
+	if v != nil {
+		_u.SetSummaryError(*v)
+	}
+	return _u
+}
+
+// ClearSummaryError clears the value of the "summary_error" field.
+func (_u *URLContentUpdateOne) ClearSummaryError() *URLContentUpdateOne {
+	_u.mutation.ClearSummaryError()
+	return _u
+}
+
+// SetSummaryFailedAt sets the "summary_failed_at" field.
+func (_u *URLContentUpdateOne) SetSummaryFailedAt(v time.Time) *URLContentUpdateOne {
+	_u.mutation.SetSummaryFailedAt(v)
+	return _u
+}
+
+// SetNillableSummaryFailedAt sets the "summary_failed_at" field if the given value is not nil.
+func (_u *URLContentUpdateOne) SetNillableSummaryFailedAt(v *time.Time) *URLContentUpdateOne {
+	if v != nil {
+		_u.SetSummaryFailedAt(*v)
+	}
+	return _u
+}
+
+// ClearSummaryFailedAt clears the value of the "summary_failed_at" field.
+func (_u *URLContentUpdateOne) ClearSummaryFailedAt() *URLContentUpdateOne {
+	_u.mutation.ClearSummaryFailedAt()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *URLContentUpdateOne) SetUserID(id uuid.UUID) *URLContentUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *URLContentUpdateOne) SetUser(v *User) *URLContentUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetURLID sets the "url" edge to the URL entity by ID.
+func (_u *URLContentUpdateOne) SetURLID(id uuid.UUID) *URLContentUpdateOne {
+	_u.mutation.SetURLID(id)
+	return _u
+}
+
+// SetURL sets the "url" edge to the URL entity.
+func (_u *URLContentUpdateOne) SetURL(v *URL) *URLContentUpdateOne {
+	return _u.SetURLID(v.ID)
+}
+
+// Mutation returns the URLContentMutation object of the builder.
+func (_u *URLContentUpdateOne) Mutation() *URLContentMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *URLContentUpdateOne) ClearUser() *URLContentUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearURL clears the "url" edge to the URL entity.
+func (_u *URLContentUpdateOne) ClearURL() *URLContentUpdateOne {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// Where appends a list predicates to the URLContentUpdate builder.
+func (_u *URLContentUpdateOne) Where(ps ...predicate.URLContent) *URLContentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *URLContentUpdateOne) Select(field string, fields ...string) *URLContentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated URLContent entity.
+func (_u *URLContentUpdateOne) Save(ctx context.Context) (*URLContent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *URLContentUpdateOne) SaveX(ctx context.Context) *URLContent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *URLContentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *URLContentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *URLContentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := urlcontent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *URLContentUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "URLContent.user"`)
+	}
+	if _u.mutation.URLCleared() && len(_u.mutation.URLIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "URLContent.url"`)
+	}
+	return nil
+}
+
+func (_u *URLContentUpdateOne) sqlSave(ctx context.Context) (_node *URLContent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(urlcontent.Table, urlcontent.Columns, sqlgraph.NewFieldSpec(urlcontent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "URLContent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, urlcontent.FieldID)
+		for _, f := range fields {
+			if !urlcontent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != urlcontent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(urlcontent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(urlcontent.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(urlcontent.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(urlcontent.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(urlcontent.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(urlcontent.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(urlcontent.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.Abstract(); ok {
+		_spec.SetField(urlcontent.FieldAbstract, field.TypeString, value)
+	}
+	if _u.mutation.AbstractCleared() {
+		_spec.ClearField(urlcontent.FieldAbstract, field.TypeString)
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(urlcontent.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, urlcontent.FieldKeywords, value)
+		})
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(urlcontent.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Entities(); ok {
+		_spec.SetField(urlcontent.FieldEntities, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEntities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, urlcontent.FieldEntities, value)
+		})
+	}
+	if _u.mutation.EntitiesCleared() {
+		_spec.ClearField(urlcontent.FieldEntities, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SummarizedAt(); ok {
+		_spec.SetField(urlcontent.FieldSummarizedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SummarizedAtCleared() {
+		_spec.ClearField(urlcontent.FieldSummarizedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SummaryError(); ok {
+		_spec.SetField(urlcontent.FieldSummaryError, field.TypeString, value)
+	}
+	if _u.mutation.SummaryErrorCleared() {
+		_spec.ClearField(urlcontent.FieldSummaryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SummaryFailedAt(); ok {
+		_spec.SetField(urlcontent.FieldSummaryFailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SummaryFailedAtCleared() {
+		_spec.ClearField(urlcontent.FieldSummaryFailedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.UserTable,
+			Columns: []string{urlcontent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.UserTable,
+			Columns: []string{urlcontent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.URLCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.URLTable,
+			Columns: []string{urlcontent.URLColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.URLIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   urlcontent.URLTable,
+			Columns: []string{urlcontent.URLColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(url.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &URLContent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{urlcontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
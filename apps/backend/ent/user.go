@@ -38,6 +38,8 @@ type User struct {
 	AvatarURL *string `json:"avatar_url,omitempty"`
 	// Authentication provider used for signup
 	AuthProvider user.AuthProvider `json:"auth_provider,omitempty"`
+	// When the email address was verified - nil until verified
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -52,15 +54,23 @@ type UserEdges struct {
 	Sessions []*Session `json:"sessions,omitempty"`
 	// PasswordResetTokens holds the value of the password_reset_tokens edge.
 	PasswordResetTokens []*PasswordResetToken `json:"password_reset_tokens,omitempty"`
+	// EmailVerificationTokens holds the value of the email_verification_tokens edge.
+	EmailVerificationTokens []*EmailVerificationToken `json:"email_verification_tokens,omitempty"`
+	// RefreshTokens holds the value of the refresh_tokens edge.
+	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
 	// Subscriptions holds the value of the subscriptions edge.
 	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
 	// TokenUsage holds the value of the token_usage edge.
 	TokenUsage []*TokenUsage `json:"token_usage,omitempty"`
 	// AiLogs holds the value of the ai_logs edge.
 	AiLogs []*AILog `json:"ai_logs,omitempty"`
+	// URLContents holds the value of the url_contents edge.
+	URLContents []*URLContent `json:"url_contents,omitempty"`
+	// KnowledgeTopics holds the value of the knowledge_topics edge.
+	KnowledgeTopics []*KnowledgeTopic `json:"knowledge_topics,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [10]bool
 }
 
 // SettingsOrErr returns the Settings value or an error if the edge
@@ -92,10 +102,28 @@ func (e UserEdges) PasswordResetTokensOrErr() ([]*PasswordResetToken, error) {
 	return nil, &NotLoadedError{edge: "password_reset_tokens"}
 }
 
+// EmailVerificationTokensOrErr returns the EmailVerificationTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailVerificationTokensOrErr() ([]*EmailVerificationToken, error) {
+	if e.loadedTypes[3] {
+		return e.EmailVerificationTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "email_verification_tokens"}
+}
+
+// RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[4] {
+		return e.RefreshTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refresh_tokens"}
+}
+
 // SubscriptionsOrErr returns the Subscriptions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) SubscriptionsOrErr() ([]*Subscription, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[5] {
 		return e.Subscriptions, nil
 	}
 	return nil, &NotLoadedError{edge: "subscriptions"}
@@ -104,7 +132,7 @@ func (e UserEdges) SubscriptionsOrErr() ([]*Subscription, error) {
 // TokenUsageOrErr returns the TokenUsage value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) TokenUsageOrErr() ([]*TokenUsage, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[6] {
 		return e.TokenUsage, nil
 	}
 	return nil, &NotLoadedError{edge: "token_usage"}
@@ -113,12 +141,30 @@ func (e UserEdges) TokenUsageOrErr() ([]*TokenUsage, error) {
 // AiLogsOrErr returns the AiLogs value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) AiLogsOrErr() ([]*AILog, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[7] {
 		return e.AiLogs, nil
 	}
 	return nil, &NotLoadedError{edge: "ai_logs"}
 }
 
+// URLContentsOrErr returns the URLContents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) URLContentsOrErr() ([]*URLContent, error) {
+	if e.loadedTypes[8] {
+		return e.URLContents, nil
+	}
+	return nil, &NotLoadedError{edge: "url_contents"}
+}
+
+// KnowledgeTopicsOrErr returns the KnowledgeTopics value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) KnowledgeTopicsOrErr() ([]*KnowledgeTopic, error) {
+	if e.loadedTypes[9] {
+		return e.KnowledgeTopics, nil
+	}
+	return nil, &NotLoadedError{edge: "knowledge_topics"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -126,7 +172,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldStatus, user.FieldEmail, user.FieldPasswordHash, user.FieldGoogleID, user.FieldAvatarURL, user.FieldAuthProvider:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt, user.FieldEmailVerifiedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -209,6 +255,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AuthProvider = user.AuthProvider(value.String)
 			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerifiedAt = new(time.Time)
+				*_m.EmailVerifiedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -237,6 +290,16 @@ func (_m *User) QueryPasswordResetTokens() *PasswordResetTokenQuery {
 	return NewUserClient(_m.config).QueryPasswordResetTokens(_m)
 }
 
+// QueryEmailVerificationTokens queries the "email_verification_tokens" edge of the User entity.
+func (_m *User) QueryEmailVerificationTokens() *EmailVerificationTokenQuery {
+	return NewUserClient(_m.config).QueryEmailVerificationTokens(_m)
+}
+
+// QueryRefreshTokens queries the "refresh_tokens" edge of the User entity.
+func (_m *User) QueryRefreshTokens() *RefreshTokenQuery {
+	return NewUserClient(_m.config).QueryRefreshTokens(_m)
+}
+
 // QuerySubscriptions queries the "subscriptions" edge of the User entity.
 func (_m *User) QuerySubscriptions() *SubscriptionQuery {
 	return NewUserClient(_m.config).QuerySubscriptions(_m)
@@ -252,6 +315,16 @@ func (_m *User) QueryAiLogs() *AILogQuery {
 	return NewUserClient(_m.config).QueryAiLogs(_m)
 }
 
+// QueryURLContents queries the "url_contents" edge of the User entity.
+func (_m *User) QueryURLContents() *URLContentQuery {
+	return NewUserClient(_m.config).QueryURLContents(_m)
+}
+
+// QueryKnowledgeTopics queries the "knowledge_topics" edge of the User entity.
+func (_m *User) QueryKnowledgeTopics() *KnowledgeTopicQuery {
+	return NewUserClient(_m.config).QueryKnowledgeTopics(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -306,6 +379,11 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("auth_provider=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AuthProvider))
+	builder.WriteString(", ")
+	if v := _m.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
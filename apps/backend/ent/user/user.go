@@ -34,18 +34,28 @@ const (
 	FieldAvatarURL = "avatar_url"
 	// FieldAuthProvider holds the string denoting the auth_provider field in the database.
 	FieldAuthProvider = "auth_provider"
+	// FieldEmailVerifiedAt holds the string denoting the email_verified_at field in the database.
+	FieldEmailVerifiedAt = "email_verified_at"
 	// EdgeSettings holds the string denoting the settings edge name in mutations.
 	EdgeSettings = "settings"
 	// EdgeSessions holds the string denoting the sessions edge name in mutations.
 	EdgeSessions = "sessions"
 	// EdgePasswordResetTokens holds the string denoting the password_reset_tokens edge name in mutations.
 	EdgePasswordResetTokens = "password_reset_tokens"
+	// EdgeEmailVerificationTokens holds the string denoting the email_verification_tokens edge name in mutations.
+	EdgeEmailVerificationTokens = "email_verification_tokens"
+	// EdgeRefreshTokens holds the string denoting the refresh_tokens edge name in mutations.
+	EdgeRefreshTokens = "refresh_tokens"
 	// EdgeSubscriptions holds the string denoting the subscriptions edge name in mutations.
 	EdgeSubscriptions = "subscriptions"
 	// EdgeTokenUsage holds the string denoting the token_usage edge name in mutations.
 	EdgeTokenUsage = "token_usage"
 	// EdgeAiLogs holds the string denoting the ai_logs edge name in mutations.
 	EdgeAiLogs = "ai_logs"
+	// EdgeURLContents holds the string denoting the url_contents edge name in mutations.
+	EdgeURLContents = "url_contents"
+	// EdgeKnowledgeTopics holds the string denoting the knowledge_topics edge name in mutations.
+	EdgeKnowledgeTopics = "knowledge_topics"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// SettingsTable is the table that holds the settings relation/edge.
@@ -69,6 +79,20 @@ const (
 	PasswordResetTokensInverseTable = "password_reset_tokens"
 	// PasswordResetTokensColumn is the table column denoting the password_reset_tokens relation/edge.
 	PasswordResetTokensColumn = "user_id"
+	// EmailVerificationTokensTable is the table that holds the email_verification_tokens relation/edge.
+	EmailVerificationTokensTable = "email_verification_tokens"
+	// EmailVerificationTokensInverseTable is the table name for the EmailVerificationToken entity.
+	// It exists in this package in order to avoid circular dependency with the "emailverificationtoken" package.
+	EmailVerificationTokensInverseTable = "email_verification_tokens"
+	// EmailVerificationTokensColumn is the table column denoting the email_verification_tokens relation/edge.
+	EmailVerificationTokensColumn = "user_id"
+	// RefreshTokensTable is the table that holds the refresh_tokens relation/edge.
+	RefreshTokensTable = "refresh_tokens"
+	// RefreshTokensInverseTable is the table name for the RefreshToken entity.
+	// It exists in this package in order to avoid circular dependency with the "refreshtoken" package.
+	RefreshTokensInverseTable = "refresh_tokens"
+	// RefreshTokensColumn is the table column denoting the refresh_tokens relation/edge.
+	RefreshTokensColumn = "user_id"
 	// SubscriptionsTable is the table that holds the subscriptions relation/edge.
 	SubscriptionsTable = "subscriptions"
 	// SubscriptionsInverseTable is the table name for the Subscription entity.
@@ -90,6 +114,20 @@ const (
 	AiLogsInverseTable = "ai_logs"
 	// AiLogsColumn is the table column denoting the ai_logs relation/edge.
 	AiLogsColumn = "user_id"
+	// URLContentsTable is the table that holds the url_contents relation/edge.
+	URLContentsTable = "url_contents"
+	// URLContentsInverseTable is the table name for the URLContent entity.
+	// It exists in this package in order to avoid circular dependency with the "urlcontent" package.
+	URLContentsInverseTable = "url_contents"
+	// URLContentsColumn is the table column denoting the url_contents relation/edge.
+	URLContentsColumn = "user_url_contents"
+	// KnowledgeTopicsTable is the table that holds the knowledge_topics relation/edge.
+	KnowledgeTopicsTable = "knowledge_topics"
+	// KnowledgeTopicsInverseTable is the table name for the KnowledgeTopic entity.
+	// It exists in this package in order to avoid circular dependency with the "knowledgetopic" package.
+	KnowledgeTopicsInverseTable = "knowledge_topics"
+	// KnowledgeTopicsColumn is the table column denoting the knowledge_topics relation/edge.
+	KnowledgeTopicsColumn = "user_knowledge_topics"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -104,6 +142,7 @@ var Columns = []string{
 	FieldGoogleID,
 	FieldAvatarURL,
 	FieldAuthProvider,
+	FieldEmailVerifiedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -234,6 +273,11 @@ func ByAuthProvider(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAuthProvider, opts...).ToFunc()
 }
 
+// ByEmailVerifiedAt orders the results by the email_verified_at field.
+func ByEmailVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifiedAt, opts...).ToFunc()
+}
+
 // BySettingsField orders the results by settings field.
 func BySettingsField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -269,6 +313,34 @@ func ByPasswordResetTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpti
 	}
 }
 
+// ByEmailVerificationTokensCount orders the results by email_verification_tokens count.
+func ByEmailVerificationTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailVerificationTokensStep(), opts...)
+	}
+}
+
+// ByEmailVerificationTokens orders the results by email_verification_tokens terms.
+func ByEmailVerificationTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailVerificationTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRefreshTokensCount orders the results by refresh_tokens count.
+func ByRefreshTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefreshTokensStep(), opts...)
+	}
+}
+
+// ByRefreshTokens orders the results by refresh_tokens terms.
+func ByRefreshTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefreshTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // BySubscriptionsCount orders the results by subscriptions count.
 func BySubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -310,6 +382,34 @@ func ByAiLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAiLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByURLContentsCount orders the results by url_contents count.
+func ByURLContentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newURLContentsStep(), opts...)
+	}
+}
+
+// ByURLContents orders the results by url_contents terms.
+func ByURLContents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newURLContentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByKnowledgeTopicsCount orders the results by knowledge_topics count.
+func ByKnowledgeTopicsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKnowledgeTopicsStep(), opts...)
+	}
+}
+
+// ByKnowledgeTopics orders the results by knowledge_topics terms.
+func ByKnowledgeTopics(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKnowledgeTopicsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSettingsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -331,6 +431,20 @@ func newPasswordResetTokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PasswordResetTokensTable, PasswordResetTokensColumn),
 	)
 }
+func newEmailVerificationTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailVerificationTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailVerificationTokensTable, EmailVerificationTokensColumn),
+	)
+}
+func newRefreshTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefreshTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
+	)
+}
 func newSubscriptionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -352,3 +466,17 @@ func newAiLogsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AiLogsTable, AiLogsColumn),
 	)
 }
+func newURLContentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(URLContentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, URLContentsTable, URLContentsColumn),
+	)
+}
+func newKnowledgeTopicsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KnowledgeTopicsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, KnowledgeTopicsTable, KnowledgeTopicsColumn),
+	)
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mindhit/api/internal/controller/response"
+	"github.com/mindhit/api/internal/infrastructure/cache"
+	"github.com/mindhit/api/internal/infrastructure/middleware"
+	"github.com/mindhit/api/internal/service"
+)
+
+// BreakerStateReader reads the AI circuit breaker states published by the
+// workers.
+type BreakerStateReader interface {
+	All(ctx context.Context) ([]cache.InstanceBreakers, error)
+}
+
+// AdminAIController serves the admin API of AI processing. Its routes are
+// plain gin handlers behind the Auth and Admin middlewares.
+type AdminAIController struct {
+	breakerStates BreakerStateReader
+	pricing       *service.ModelPricingService
+}
+
+// NewAdminAIController creates a new AdminAIController.
+func NewAdminAIController(breakerStates BreakerStateReader, pricing *service.ModelPricingService) *AdminAIController {
+	return &AdminAIController{
+		breakerStates: breakerStates,
+		pricing:       pricing,
+	}
+}
+
+// GetBreakers handles GET /v1/admin/ai/breakers. It returns the circuit
+// breaker state of every provider and model, per worker instance.
+func (c *AdminAIController) GetBreakers(ctx *gin.Context) {
+	instances, err := c.breakerStates.All(ctx.Request.Context())
+	if err != nil {
+		slog.Error("failed to get ai circuit breaker states", "error", err)
+		response.InternalError(ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"instances": instances})
+}
+
+// ListPricing handles GET /v1/admin/ai/pricing. It returns the prices of all
+// models, the latest first for each model.
+func (c *AdminAIController) ListPricing(ctx *gin.Context) {
+	pricings, err := c.pricing.List(ctx.Request.Context())
+	if err != nil {
+		slog.Error("failed to list model pricing", "error", err)
+		response.InternalError(ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"pricing": pricings})
+}
+
+// CreatePricing handles POST /v1/admin/ai/pricing. A new price of a model
+// takes over from its effective_from, older prices keep pricing the requests
+// made before.
+func (c *AdminAIController) CreatePricing(ctx *gin.Context) {
+	var req service.ModelPricingRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(ctx, "invalid request body", nil)
+		return
+	}
+	req.UpdatedBy = middleware.GetUserEmail(ctx)
+
+	pricing, err := c.pricing.Create(ctx.Request.Context(), req)
+	if err != nil {
+		pricingError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, gin.H{"pricing": pricing})
+}
+
+// UpdatePricing handles PUT /v1/admin/ai/pricing/:id.
+func (c *AdminAIController) UpdatePricing(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		response.NotFound(ctx, service.ErrModelPricingNotFound.Error())
+		return
+	}
+	var req service.ModelPricingRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(ctx, "invalid request body", nil)
+		return
+	}
+	req.UpdatedBy = middleware.GetUserEmail(ctx)
+
+	pricing, err := c.pricing.Update(ctx.Request.Context(), id, req)
+	if err != nil {
+		pricingError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"pricing": pricing})
+}
+
+// DeletePricing handles DELETE /v1/admin/ai/pricing/:id.
+func (c *AdminAIController) DeletePricing(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		response.NotFound(ctx, service.ErrModelPricingNotFound.Error())
+		return
+	}
+	if err := c.pricing.Delete(ctx.Request.Context(), id); err != nil {
+		pricingError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// pricingError sends the response for an error of the model pricing service.
+func pricingError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrUnknownAIProvider), errors.Is(err, service.ErrInvalidModelPricing):
+		response.BadRequest(ctx, err.Error(), nil)
+	case errors.Is(err, service.ErrModelPricingExists):
+		response.Conflict(ctx, err.Error())
+	case errors.Is(err, service.ErrModelPricingNotFound):
+		response.NotFound(ctx, err.Error())
+	default:
+		slog.Error("failed to save model pricing", "error", err)
+		response.InternalError(ctx)
+	}
+}
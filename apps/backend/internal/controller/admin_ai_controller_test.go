@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/cache"
+	"github.com/mindhit/api/internal/infrastructure/middleware"
+	"github.com/mindhit/api/internal/service"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+func TestAdminAIController_GetBreakers(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	jwtService := service.NewJWTService("test-secret")
+	authService := service.NewAuthService(client)
+
+	admin, err := authService.Signup(ctx, "admin@mindhit.test", "password123")
+	require.NoError(t, err)
+	member, err := authService.Signup(ctx, "member@mindhit.test", "password123")
+	require.NoError(t, err)
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	states := cache.NewBreakerStates(rdb)
+	require.NoError(t, states.Publish(ctx, "worker-1", []ai.BreakerStatus{
+		{Provider: ai.ProviderGemini, Model: "gemini-2.5-flash", State: ai.BreakerOpen},
+	}, time.Minute))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	group := r.Group("/v1/admin", middleware.Auth(jwtService, authService), middleware.Admin(authService, []string{"admin@mindhit.test"}))
+	group.GET("/ai/breakers", NewAdminAIController(states, service.NewModelPricingService(client)).GetBreakers)
+
+	get := func(userToken string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/admin/ai/breakers", nil)
+		if userToken != "" {
+			req.Header.Set("Authorization", "Bearer "+userToken)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, get("").Code)
+
+	memberTokens, err := jwtService.GenerateTokenPair(member.ID)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, get(memberTokens.AccessToken).Code)
+
+	adminTokens, err := jwtService.GenerateTokenPair(admin.ID)
+	require.NoError(t, err)
+	w := get(adminTokens.AccessToken)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var body struct {
+		Instances []cache.InstanceBreakers `json:"instances"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Instances, 1)
+	assert.Equal(t, "worker-1", body.Instances[0].Instance)
+	require.Len(t, body.Instances[0].Breakers, 1)
+	assert.Equal(t, ai.BreakerOpen, body.Instances[0].Breakers[0].State)
+}
+
+func TestAdminAIController_Pricing(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	jwtService := service.NewJWTService("test-secret")
+	authService := service.NewAuthService(client)
+
+	admin, err := authService.Signup(ctx, "admin@mindhit.test", "password123")
+	require.NoError(t, err)
+	tokens, err := jwtService.GenerateTokenPair(admin.ID)
+	require.NoError(t, err)
+
+	controller := NewAdminAIController(nil, service.NewModelPricingService(client))
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	group := r.Group("/v1/admin", middleware.Auth(jwtService, authService), middleware.Admin(authService, []string{"admin@mindhit.test"}))
+	group.GET("/ai/pricing", controller.ListPricing)
+	group.POST("/ai/pricing", controller.CreatePricing)
+	group.PUT("/ai/pricing/:id", controller.UpdatePricing)
+	group.DELETE("/ai/pricing/:id", controller.DeletePricing)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	type pricingBody struct {
+		Pricing ent.ModelPricing `json:"pricing"`
+	}
+
+	w := do(http.MethodPost, "/v1/admin/ai/pricing", `{"provider": "openai", "model": "gpt-4o", "input_price": 2.5, "output_price": 10, "effective_from": "2026-01-01T00:00:00Z"}`)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created pricingBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, "admin@mindhit.test", created.Pricing.UpdatedBy)
+	assert.Nil(t, created.Pricing.CachedInputPrice)
+
+	w = do(http.MethodPost, "/v1/admin/ai/pricing", `{"provider": "openai", "model": "gpt-4o", "input_price": 2.5, "output_price": 10, "effective_from": "2026-01-01T00:00:00Z"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	w = do(http.MethodPost, "/v1/admin/ai/pricing", `{"provider": "acme", "model": "m", "input_price": 1, "output_price": 1}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	id := strconv.Itoa(created.Pricing.ID)
+	w = do(http.MethodPut, "/v1/admin/ai/pricing/"+id, `{"provider": "openai", "model": "gpt-4o", "input_price": 2.5, "output_price": 10, "cached_input_price": 1.25, "effective_from": "2026-01-01T00:00:00Z"}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated pricingBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	require.NotNil(t, updated.Pricing.CachedInputPrice)
+	assert.Equal(t, 1.25, *updated.Pricing.CachedInputPrice)
+
+	w = do(http.MethodGet, "/v1/admin/ai/pricing", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var list struct {
+		Pricing []ent.ModelPricing `json:"pricing"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	assert.Len(t, list.Pricing, 1)
+
+	assert.Equal(t, http.StatusNoContent, do(http.MethodDelete, "/v1/admin/ai/pricing/"+id, "").Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodDelete, "/v1/admin/ai/pricing/"+id, "").Code)
+}
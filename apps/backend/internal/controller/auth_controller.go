@@ -7,21 +7,26 @@ import (
 	"log/slog"
 	"strings"
 
+	"github.com/mindhit/api/ent"
 	"github.com/mindhit/api/internal/generated"
 	"github.com/mindhit/api/internal/service"
 )
 
 // AuthController implements auth-related handlers from StrictServerInterface
 type AuthController struct {
-	authService *service.AuthService
-	jwtService  *service.JWTService
+	authService  *service.AuthService
+	jwtService   *service.JWTService
+	tokenService *service.TokenService
+	emailService *service.EmailService
 }
 
 // NewAuthController creates a new AuthController
-func NewAuthController(authService *service.AuthService, jwtService *service.JWTService) *AuthController {
+func NewAuthController(authService *service.AuthService, jwtService *service.JWTService, tokenService *service.TokenService, emailService *service.EmailService) *AuthController {
 	return &AuthController{
-		authService: authService,
-		jwtService:  jwtService,
+		authService:  authService,
+		jwtService:   jwtService,
+		tokenService: tokenService,
+		emailService: emailService,
 	}
 }
 
@@ -54,19 +59,24 @@ func (c *AuthController) RoutesSignup(ctx context.Context, request generated.Rou
 		return nil, err
 	}
 
-	tokenPair, err := c.jwtService.GenerateTokenPair(user.ID)
+	// The account is usable right away; a failed email can be resent
+	c.sendEmailVerification(ctx, user)
+
+	tokenPair, err := c.tokenService.Issue(ctx, user.ID)
 	if err != nil {
 		slog.Error("failed to generate token pair", "error", err, "user_id", user.ID)
 		return nil, err
 	}
 
 	return generated.RoutesSignup201JSONResponse{
-		Token: tokenPair.AccessToken,
+		Token:        tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
 		User: generated.AuthUser{
-			Id:        user.ID.String(),
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
+			Id:            user.ID.String(),
+			Email:         user.Email,
+			EmailVerified: user.EmailVerifiedAt != nil,
+			CreatedAt:     user.CreatedAt,
+			UpdatedAt:     user.UpdatedAt,
 		},
 	}, nil
 }
@@ -100,19 +110,21 @@ func (c *AuthController) RoutesLogin(ctx context.Context, request generated.Rout
 		return nil, err
 	}
 
-	tokenPair, err := c.jwtService.GenerateTokenPair(user.ID)
+	tokenPair, err := c.tokenService.Issue(ctx, user.ID)
 	if err != nil {
 		slog.Error("failed to generate token pair", "error", err, "user_id", user.ID)
 		return nil, err
 	}
 
 	return generated.RoutesLogin200JSONResponse{
-		Token: tokenPair.AccessToken,
+		Token:        tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
 		User: generated.AuthUser{
-			Id:        user.ID.String(),
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
+			Id:            user.ID.String(),
+			Email:         user.Email,
+			EmailVerified: user.EmailVerifiedAt != nil,
+			CreatedAt:     user.CreatedAt,
+			UpdatedAt:     user.UpdatedAt,
 		},
 	}, nil
 }
@@ -147,7 +159,8 @@ func (c *AuthController) RoutesRefresh(ctx context.Context, request generated.Ro
 
 	tokenString := parts[1]
 
-	// Validate refresh token
+	// Check the user before rotating, so a deleted user's token is not
+	// exchanged
 	claims, err := c.jwtService.ValidateRefreshToken(tokenString)
 	if err != nil {
 		return generated.RoutesRefresh401JSONResponse{
@@ -173,15 +186,26 @@ func (c *AuthController) RoutesRefresh(ctx context.Context, request generated.Ro
 		}, nil
 	}
 
-	// Generate new access token
-	accessToken, _, err := c.jwtService.GenerateAccessToken(claims.UserID)
+	// Rotate the refresh token
+	tokenPair, err := c.tokenService.Refresh(ctx, tokenString)
+	if errors.Is(err, service.ErrInvalidRefreshToken) || errors.Is(err, service.ErrRefreshTokenReused) {
+		return generated.RoutesRefresh401JSONResponse{
+			Error: struct {
+				Code    *string `json:"code,omitempty"`
+				Message string  `json:"message"`
+			}{
+				Message: "invalid or expired refresh token",
+			},
+		}, nil
+	}
 	if err != nil {
-		slog.Error("failed to generate access token", "error", err, "user_id", claims.UserID)
+		slog.Error("failed to refresh tokens", "error", err, "user_id", claims.UserID)
 		return nil, err
 	}
 
 	return generated.RoutesRefresh200JSONResponse{
-		Token: accessToken,
+		Token:        tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
 	}, nil
 }
 
@@ -247,10 +271,11 @@ func (c *AuthController) RoutesMe(ctx context.Context, request generated.RoutesM
 
 	return generated.RoutesMe200JSONResponse{
 		User: generated.AuthUser{
-			Id:        user.ID.String(),
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
+			Id:            user.ID.String(),
+			Email:         user.Email,
+			EmailVerified: user.EmailVerifiedAt != nil,
+			CreatedAt:     user.CreatedAt,
+			UpdatedAt:     user.UpdatedAt,
 		},
 	}, nil
 }
@@ -298,8 +323,12 @@ func (c *AuthController) RoutesLogout(ctx context.Context, request generated.Rou
 		}, nil
 	}
 
-	// For stateless JWT, we just log the logout event
-	// In production, you might want to add the token to a blacklist
+	// Revoke this login; other devices stay signed in
+	if err := c.tokenService.RevokeFamily(ctx, claims.FamilyID); err != nil {
+		slog.Error("failed to revoke tokens", "error", err, "user_id", claims.UserID)
+		return nil, err
+	}
+
 	slog.InfoContext(ctx, "user logged out", "user_id", claims.UserID)
 
 	return generated.RoutesLogout200JSONResponse{
@@ -307,6 +336,58 @@ func (c *AuthController) RoutesLogout(ctx context.Context, request generated.Rou
 	}, nil
 }
 
+// RoutesLogoutAll handles POST /v1/auth/logout-all
+func (c *AuthController) RoutesLogoutAll(ctx context.Context, request generated.RoutesLogoutAllRequestObject) (generated.RoutesLogoutAllResponseObject, error) {
+	// Extract token from Authorization header
+	authHeader := request.Params.Authorization
+	if authHeader == "" {
+		return generated.RoutesLogoutAll401JSONResponse{
+			Error: struct {
+				Code    *string `json:"code,omitempty"`
+				Message string  `json:"message"`
+			}{
+				Message: "authorization header is required",
+			},
+		}, nil
+	}
+
+	// Parse Bearer token
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return generated.RoutesLogoutAll401JSONResponse{
+			Error: struct {
+				Code    *string `json:"code,omitempty"`
+				Message string  `json:"message"`
+			}{
+				Message: "invalid authorization header format",
+			},
+		}, nil
+	}
+
+	claims, err := c.jwtService.ValidateAccessToken(parts[1])
+	if err != nil {
+		return generated.RoutesLogoutAll401JSONResponse{
+			Error: struct {
+				Code    *string `json:"code,omitempty"`
+				Message string  `json:"message"`
+			}{
+				Message: "invalid or expired access token",
+			},
+		}, nil
+	}
+
+	if err := c.tokenService.RevokeAll(ctx, claims.UserID); err != nil {
+		slog.Error("failed to revoke tokens", "error", err, "user_id", claims.UserID)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "user logged out everywhere", "user_id", claims.UserID)
+
+	return generated.RoutesLogoutAll200JSONResponse{
+		Message: "successfully logged out of all devices",
+	}, nil
+}
+
 // RoutesForgotPassword handles POST /v1/auth/forgot-password
 func (c *AuthController) RoutesForgotPassword(ctx context.Context, request generated.RoutesForgotPasswordRequestObject) (generated.RoutesForgotPasswordResponseObject, error) {
 	if request.Body == nil {
@@ -326,9 +407,11 @@ func (c *AuthController) RoutesForgotPassword(ctx context.Context, request gener
 		slog.Error("failed to create reset token", "error", err)
 	}
 
-	// If token was generated, email should be sent here
+	// Only a known email gets a token
 	if token != "" {
-		// TODO: Send email with reset link (implement in Phase 5 or later)
+		if err := c.emailService.SendPasswordReset(ctx, request.Body.Email, token); err != nil {
+			slog.Error("failed to queue password reset email", "error", err)
+		}
 		slog.Info("password reset requested", "email", request.Body.Email)
 	}
 
@@ -401,6 +484,156 @@ func (c *AuthController) RoutesResetPassword(ctx context.Context, request genera
 	}, nil
 }
 
+// RoutesVerifyEmail handles POST /v1/auth/verify-email
+func (c *AuthController) RoutesVerifyEmail(ctx context.Context, request generated.RoutesVerifyEmailRequestObject) (generated.RoutesVerifyEmailResponseObject, error) {
+	if request.Body == nil {
+		return generated.RoutesVerifyEmail400JSONResponse{
+			Error: struct {
+				Code    *string `json:"code,omitempty"`
+				Message string  `json:"message"`
+			}{
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	err := c.authService.VerifyEmail(ctx, request.Body.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTokenInvalid):
+			return generated.RoutesVerifyEmail400JSONResponse{
+				Error: struct {
+					Code    *string `json:"code,omitempty"`
+					Message string  `json:"message"`
+				}{
+					Message: "invalid or expired token",
+				},
+			}, nil
+		case errors.Is(err, service.ErrTokenExpired):
+			return generated.RoutesVerifyEmail400JSONResponse{
+				Error: struct {
+					Code    *string `json:"code,omitempty"`
+					Message string  `json:"message"`
+				}{
+					Message: "token has expired",
+				},
+			}, nil
+		case errors.Is(err, service.ErrUserInactive):
+			return generated.RoutesVerifyEmail400JSONResponse{
+				Error: struct {
+					Code    *string `json:"code,omitempty"`
+					Message string  `json:"message"`
+				}{
+					Message: "user account is inactive",
+				},
+			}, nil
+		default:
+			slog.Error("email verification failed", "error", err)
+			return nil, err
+		}
+	}
+
+	return generated.RoutesVerifyEmail200JSONResponse{
+		Message: "Email has been verified successfully.",
+	}, nil
+}
+
+// RoutesResendVerification handles POST /v1/auth/resend-verification
+func (c *AuthController) RoutesResendVerification(ctx context.Context, request generated.RoutesResendVerificationRequestObject) (generated.RoutesResendVerificationResponseObject, error) {
+	// Extract token from Authorization header
+	authHeader := request.Params.Authorization
+	if authHeader == "" {
+		return generated.RoutesResendVerification401JSONResponse{
+			Error: struct {
+				Code    *string `json:"code,omitempty"`
+				Message string  `json:"message"`
+			}{
+				Message: "authorization header is required",
+			},
+		}, nil
+	}
+
+	// Parse Bearer token
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return generated.RoutesResendVerification401JSONResponse{
+			Error: struct {
+				Code    *string `json:"code,omitempty"`
+				Message string  `json:"message"`
+			}{
+				Message: "invalid authorization header format",
+			},
+		}, nil
+	}
+
+	// Validate access token
+	claims, err := c.jwtService.ValidateAccessToken(parts[1])
+	if err != nil {
+		return generated.RoutesResendVerification401JSONResponse{
+			Error: struct {
+				Code    *string `json:"code,omitempty"`
+				Message string  `json:"message"`
+			}{
+				Message: "invalid or expired access token",
+			},
+		}, nil
+	}
+
+	user, err := c.authService.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return generated.RoutesResendVerification401JSONResponse{
+				Error: struct {
+					Code    *string `json:"code,omitempty"`
+					Message string  `json:"message"`
+				}{
+					Message: "user not found",
+				},
+			}, nil
+		}
+		slog.Error("failed to get user", "error", err, "user_id", claims.UserID)
+		return nil, err
+	}
+
+	token, err := c.authService.RequestEmailVerification(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, service.ErrEmailAlreadyVerified) {
+			return generated.RoutesResendVerification409JSONResponse{
+				Error: struct {
+					Code    *string `json:"code,omitempty"`
+					Message string  `json:"message"`
+				}{
+					Message: "email is already verified",
+				},
+			}, nil
+		}
+		slog.Error("failed to create verification token", "error", err, "user_id", user.ID)
+		return nil, err
+	}
+
+	if err := c.emailService.SendEmailVerification(ctx, user, token); err != nil {
+		slog.Error("failed to queue verification email", "error", err, "user_id", user.ID)
+		return nil, err
+	}
+
+	return generated.RoutesResendVerification200JSONResponse{
+		Message: "A verification email has been sent.",
+	}, nil
+}
+
+// sendEmailVerification starts email verification for a new email signup.
+// Errors are logged only.
+func (c *AuthController) sendEmailVerification(ctx context.Context, user *ent.User) {
+	token, err := c.authService.RequestEmailVerification(ctx, user.ID)
+	if err != nil {
+		slog.Error("failed to create verification token", "error", err, "user_id", user.ID)
+		return
+	}
+	if err := c.emailService.SendEmailVerification(ctx, user, token); err != nil {
+		slog.Error("failed to queue verification email", "error", err, "user_id", user.ID)
+	}
+}
+
 // RoutesDeleteMe handles DELETE /v1/auth/me
 func (c *AuthController) RoutesDeleteMe(ctx context.Context, request generated.RoutesDeleteMeRequestObject) (generated.RoutesDeleteMeResponseObject, error) {
 	// Extract token from Authorization header
@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mindhit/api/ent/emailverificationtoken"
 	"github.com/mindhit/api/internal/generated"
 	"github.com/mindhit/api/internal/service"
 	"github.com/mindhit/api/internal/testutil"
@@ -20,7 +22,9 @@ func TestAuthController_RoutesSignup(t *testing.T) {
 
 	authService := service.NewAuthService(client)
 	jwtService := service.NewJWTService("test-secret")
-	controller := NewAuthController(authService, jwtService)
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
 
 	ctx := context.Background()
 
@@ -82,7 +86,9 @@ func TestAuthController_RoutesLogin(t *testing.T) {
 
 	authService := service.NewAuthService(client)
 	jwtService := service.NewJWTService("test-secret")
-	controller := NewAuthController(authService, jwtService)
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
 
 	ctx := context.Background()
 
@@ -157,7 +163,9 @@ func TestAuthController_RoutesRefresh(t *testing.T) {
 
 	authService := service.NewAuthService(client)
 	jwtService := service.NewJWTService("test-secret")
-	controller := NewAuthController(authService, jwtService)
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
 
 	ctx := context.Background()
 
@@ -166,7 +174,7 @@ func TestAuthController_RoutesRefresh(t *testing.T) {
 	user, err := authService.Signup(ctx, email, "password123")
 	require.NoError(t, err)
 
-	tokenPair, err := jwtService.GenerateTokenPair(user.ID)
+	tokenPair, err := tokenService.Issue(ctx, user.ID)
 	require.NoError(t, err)
 
 	t.Run("successful refresh", func(t *testing.T) {
@@ -186,6 +194,37 @@ func TestAuthController_RoutesRefresh(t *testing.T) {
 		claims, err := jwtService.ValidateAccessToken(successResp.Token)
 		require.NoError(t, err)
 		assert.Equal(t, user.ID, claims.UserID)
+		assert.Equal(t, tokenPair.FamilyID, claims.FamilyID)
+		// The refresh token is rotated
+		assert.NotEmpty(t, successResp.RefreshToken)
+		assert.NotEqual(t, tokenPair.RefreshToken, successResp.RefreshToken)
+	})
+
+	t.Run("reused refresh token returns 401 and revokes the login", func(t *testing.T) {
+		pair, err := tokenService.Issue(ctx, user.ID)
+		require.NoError(t, err)
+
+		resp, err := controller.RoutesRefresh(ctx, generated.RoutesRefreshRequestObject{
+			Params: generated.RoutesRefreshParams{Authorization: "Bearer " + pair.RefreshToken},
+		})
+		require.NoError(t, err)
+		rotated, ok := resp.(generated.RoutesRefresh200JSONResponse)
+		require.True(t, ok, "expected 200 response")
+
+		// Replaying the old token revokes the whole family
+		resp, err = controller.RoutesRefresh(ctx, generated.RoutesRefreshRequestObject{
+			Params: generated.RoutesRefreshParams{Authorization: "Bearer " + pair.RefreshToken},
+		})
+		require.NoError(t, err)
+		_, ok = resp.(generated.RoutesRefresh401JSONResponse)
+		assert.True(t, ok, "expected 401 response for reused token")
+
+		resp, err = controller.RoutesRefresh(ctx, generated.RoutesRefreshRequestObject{
+			Params: generated.RoutesRefreshParams{Authorization: "Bearer " + rotated.RefreshToken},
+		})
+		require.NoError(t, err)
+		_, ok = resp.(generated.RoutesRefresh401JSONResponse)
+		assert.True(t, ok, "expected 401 response for the rotated token of a revoked login")
 	})
 
 	t.Run("missing authorization header returns 401", func(t *testing.T) {
@@ -237,7 +276,9 @@ func TestAuthController_RoutesMe(t *testing.T) {
 
 	authService := service.NewAuthService(client)
 	jwtService := service.NewJWTService("test-secret")
-	controller := NewAuthController(authService, jwtService)
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
 
 	ctx := context.Background()
 
@@ -314,7 +355,9 @@ func TestAuthController_RoutesLogout(t *testing.T) {
 
 	authService := service.NewAuthService(client)
 	jwtService := service.NewJWTService("test-secret")
-	controller := NewAuthController(authService, jwtService)
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
 
 	ctx := context.Background()
 
@@ -323,7 +366,7 @@ func TestAuthController_RoutesLogout(t *testing.T) {
 	user, err := authService.Signup(ctx, email, "password123")
 	require.NoError(t, err)
 
-	tokenPair, err := jwtService.GenerateTokenPair(user.ID)
+	tokenPair, err := tokenService.Issue(ctx, user.ID)
 	require.NoError(t, err)
 
 	t.Run("successful logout", func(t *testing.T) {
@@ -339,6 +382,10 @@ func TestAuthController_RoutesLogout(t *testing.T) {
 		successResp, ok := resp.(generated.RoutesLogout200JSONResponse)
 		require.True(t, ok, "expected 200 response")
 		assert.Equal(t, "successfully logged out", successResp.Message)
+
+		// The refresh token of the login no longer works
+		_, err = tokenService.Refresh(ctx, tokenPair.RefreshToken)
+		assert.ErrorIs(t, err, service.ErrInvalidRefreshToken)
 	})
 
 	t.Run("missing authorization header returns 401", func(t *testing.T) {
@@ -376,7 +423,9 @@ func TestAuthController_RoutesForgotPassword(t *testing.T) {
 
 	authService := service.NewAuthService(client)
 	jwtService := service.NewJWTService("test-secret")
-	controller := NewAuthController(authService, jwtService)
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
 
 	ctx := context.Background()
 
@@ -434,7 +483,9 @@ func TestAuthController_RoutesResetPassword(t *testing.T) {
 
 	authService := service.NewAuthService(client)
 	jwtService := service.NewJWTService("test-secret")
-	controller := NewAuthController(authService, jwtService)
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
 
 	ctx := context.Background()
 
@@ -523,3 +574,126 @@ func TestAuthController_RoutesResetPassword(t *testing.T) {
 		assert.True(t, ok, "expected 400 response")
 	})
 }
+
+func TestAuthController_RoutesLogoutAll(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	authService := service.NewAuthService(client)
+	jwtService := service.NewJWTService("test-secret")
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
+
+	ctx := context.Background()
+
+	email := uniqueEmail("logout-all")
+	user, err := authService.Signup(ctx, email, "password123")
+	require.NoError(t, err)
+
+	laptop, err := tokenService.Issue(ctx, user.ID)
+	require.NoError(t, err)
+	phone, err := tokenService.Issue(ctx, user.ID)
+	require.NoError(t, err)
+
+	resp, err := controller.RoutesLogoutAll(ctx, generated.RoutesLogoutAllRequestObject{
+		Params: generated.RoutesLogoutAllParams{Authorization: "Bearer " + laptop.AccessToken},
+	})
+	require.NoError(t, err)
+
+	_, ok := resp.(generated.RoutesLogoutAll200JSONResponse)
+	require.True(t, ok, "expected 200 response")
+	_, err = tokenService.Refresh(ctx, laptop.RefreshToken)
+	assert.ErrorIs(t, err, service.ErrInvalidRefreshToken)
+	_, err = tokenService.Refresh(ctx, phone.RefreshToken)
+	assert.ErrorIs(t, err, service.ErrInvalidRefreshToken)
+}
+
+func TestAuthController_EmailVerification(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	authService := service.NewAuthService(client)
+	jwtService := service.NewJWTService("test-secret")
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	emailService := service.NewEmailService(client, nil, "http://localhost:3000")
+	controller := NewAuthController(authService, jwtService, tokenService, emailService)
+
+	ctx := context.Background()
+
+	signupResp, err := controller.RoutesSignup(ctx, generated.RoutesSignupRequestObject{
+		Body: &generated.RoutesSignupJSONRequestBody{
+			Email:    uniqueEmail("verify"),
+			Password: "password123",
+		},
+	})
+	require.NoError(t, err)
+	signup, ok := signupResp.(generated.RoutesSignup201JSONResponse)
+	require.True(t, ok, "expected 201 response")
+	assert.False(t, signup.User.EmailVerified)
+	userID, err := uuid.Parse(signup.User.Id)
+	require.NoError(t, err)
+
+	auth := generated.RoutesResendVerificationParams{Authorization: "Bearer " + signup.Token}
+
+	t.Run("signup creates a verification token", func(t *testing.T) {
+		count, err := client.EmailVerificationToken.Query().
+			Where(emailverificationtoken.UserIDEQ(userID)).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("resend requires authentication", func(t *testing.T) {
+		resp, err := controller.RoutesResendVerification(ctx, generated.RoutesResendVerificationRequestObject{})
+		require.NoError(t, err)
+		_, ok := resp.(generated.RoutesResendVerification401JSONResponse)
+		assert.True(t, ok, "expected 401 response")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		resp, err := controller.RoutesVerifyEmail(ctx, generated.RoutesVerifyEmailRequestObject{
+			Body: &generated.RoutesVerifyEmailJSONRequestBody{Token: "not-a-token"},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(generated.RoutesVerifyEmail400JSONResponse)
+		assert.True(t, ok, "expected 400 response")
+	})
+
+	t.Run("resend and verify", func(t *testing.T) {
+		resp, err := controller.RoutesResendVerification(ctx, generated.RoutesResendVerificationRequestObject{Params: auth})
+		require.NoError(t, err)
+		_, ok := resp.(generated.RoutesResendVerification200JSONResponse)
+		require.True(t, ok, "expected 200 response")
+
+		token, err := client.EmailVerificationToken.Query().
+			Where(
+				emailverificationtoken.UserIDEQ(userID),
+				emailverificationtoken.UsedEQ(false),
+			).
+			Only(ctx)
+		require.NoError(t, err, "resend should leave exactly one usable token")
+
+		verifyResp, err := controller.RoutesVerifyEmail(ctx, generated.RoutesVerifyEmailRequestObject{
+			Body: &generated.RoutesVerifyEmailJSONRequestBody{Token: token.Token},
+		})
+		require.NoError(t, err)
+		_, ok = verifyResp.(generated.RoutesVerifyEmail200JSONResponse)
+		require.True(t, ok, "expected 200 response")
+
+		meResp, err := controller.RoutesMe(ctx, generated.RoutesMeRequestObject{
+			Params: generated.RoutesMeParams{Authorization: auth.Authorization},
+		})
+		require.NoError(t, err)
+		me, ok := meResp.(generated.RoutesMe200JSONResponse)
+		require.True(t, ok, "expected 200 response")
+		assert.True(t, me.User.EmailVerified)
+	})
+
+	t.Run("resend after verification", func(t *testing.T) {
+		resp, err := controller.RoutesResendVerification(ctx, generated.RoutesResendVerificationRequestObject{Params: auth})
+		require.NoError(t, err)
+		_, ok := resp.(generated.RoutesResendVerification409JSONResponse)
+		assert.True(t, ok, "expected 409 response")
+	})
+}
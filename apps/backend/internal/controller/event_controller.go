@@ -115,6 +115,7 @@ func (c *EventController) RoutesBatchEvents(ctx context.Context, request generat
 			URL:       ptrToString(e.Url),
 			Title:     ptrToString(e.Title),
 			Content:   ptrToString(e.Text),
+			Payload:   buildEventPayload(e),
 		}
 	}
 
@@ -301,6 +302,36 @@ func (c *EventController) RoutesGetEventStats(ctx context.Context, request gener
 	}, nil
 }
 
+// buildEventPayload collects the type-specific event fields into a payload map
+func buildEventPayload(e generated.EventsEventData) map[string]interface{} {
+	payload := make(map[string]interface{})
+	if e.Text != nil {
+		payload["text"] = *e.Text
+	}
+	if e.Selector != nil {
+		payload["selector"] = *e.Selector
+	}
+	if e.Color != nil {
+		payload["color"] = *e.Color
+	}
+	if e.Note != nil {
+		payload["note"] = *e.Note
+	}
+	if e.DurationMs != nil {
+		payload["duration_ms"] = *e.DurationMs
+	}
+	if e.ScrollDepth != nil {
+		payload["scroll_depth"] = *e.ScrollDepth
+	}
+	if e.MaxScrollDepth != nil {
+		payload["max_scroll_depth"] = *e.MaxScrollDepth
+	}
+	if len(payload) == 0 {
+		return nil
+	}
+	return payload
+}
+
 // ptrToString safely converts a string pointer to string
 func ptrToString(s *string) string {
 	if s == nil {
@@ -15,6 +15,7 @@ type Handler struct {
 	*UsageController
 	*OAuthController
 	*MindmapController
+	*KnowledgeController
 }
 
 // NewHandler creates a new Handler with all controllers.
@@ -26,6 +27,7 @@ func NewHandler(
 	usage *UsageController,
 	oauth *OAuthController,
 	mindmap *MindmapController,
+	knowledge *KnowledgeController,
 ) *Handler {
 	return &Handler{
 		AuthController:         auth,
@@ -35,6 +37,7 @@ func NewHandler(
 		UsageController:        usage,
 		OAuthController:        oauth,
 		MindmapController:      mindmap,
+		KnowledgeController:    knowledge,
 	}
 }
 
@@ -60,6 +63,11 @@ func (h *Handler) RoutesLogout(ctx context.Context, request generated.RoutesLogo
 	return h.AuthController.RoutesLogout(ctx, request)
 }
 
+// RoutesLogoutAll delegates to AuthController
+func (h *Handler) RoutesLogoutAll(ctx context.Context, request generated.RoutesLogoutAllRequestObject) (generated.RoutesLogoutAllResponseObject, error) {
+	return h.AuthController.RoutesLogoutAll(ctx, request)
+}
+
 // RoutesMe delegates to AuthController
 func (h *Handler) RoutesMe(ctx context.Context, request generated.RoutesMeRequestObject) (generated.RoutesMeResponseObject, error) {
 	return h.AuthController.RoutesMe(ctx, request)
@@ -80,6 +88,16 @@ func (h *Handler) RoutesSignup(ctx context.Context, request generated.RoutesSign
 	return h.AuthController.RoutesSignup(ctx, request)
 }
 
+// RoutesVerifyEmail delegates to AuthController
+func (h *Handler) RoutesVerifyEmail(ctx context.Context, request generated.RoutesVerifyEmailRequestObject) (generated.RoutesVerifyEmailResponseObject, error) {
+	return h.AuthController.RoutesVerifyEmail(ctx, request)
+}
+
+// RoutesResendVerification delegates to AuthController
+func (h *Handler) RoutesResendVerification(ctx context.Context, request generated.RoutesResendVerificationRequestObject) (generated.RoutesResendVerificationResponseObject, error) {
+	return h.AuthController.RoutesResendVerification(ctx, request)
+}
+
 // RoutesList delegates to SessionController
 func (h *Handler) RoutesList(ctx context.Context, request generated.RoutesListRequestObject) (generated.RoutesListResponseObject, error) {
 	return h.SessionController.RoutesList(ctx, request)
@@ -145,6 +163,16 @@ func (h *Handler) SubscriptionRoutesListPlans(ctx context.Context, request gener
 	return h.SubscriptionController.SubscriptionRoutesListPlans(ctx, request)
 }
 
+// SubscriptionRoutesCreateCheckout delegates to SubscriptionController
+func (h *Handler) SubscriptionRoutesCreateCheckout(ctx context.Context, request generated.SubscriptionRoutesCreateCheckoutRequestObject) (generated.SubscriptionRoutesCreateCheckoutResponseObject, error) {
+	return h.SubscriptionController.SubscriptionRoutesCreateCheckout(ctx, request)
+}
+
+// SubscriptionRoutesCreatePortal delegates to SubscriptionController
+func (h *Handler) SubscriptionRoutesCreatePortal(ctx context.Context, request generated.SubscriptionRoutesCreatePortalRequestObject) (generated.SubscriptionRoutesCreatePortalResponseObject, error) {
+	return h.SubscriptionController.SubscriptionRoutesCreatePortal(ctx, request)
+}
+
 // UsageRoutesGetUsage delegates to UsageController
 func (h *Handler) UsageRoutesGetUsage(ctx context.Context, request generated.UsageRoutesGetUsageRequestObject) (generated.UsageRoutesGetUsageResponseObject, error) {
 	return h.UsageController.UsageRoutesGetUsage(ctx, request)
@@ -169,3 +197,63 @@ func (h *Handler) MindmapRoutesGetMindmap(ctx context.Context, request generated
 func (h *Handler) MindmapRoutesGenerateMindmap(ctx context.Context, request generated.MindmapRoutesGenerateMindmapRequestObject) (generated.MindmapRoutesGenerateMindmapResponseObject, error) {
 	return h.MindmapController.MindmapRoutesGenerateMindmap(ctx, request)
 }
+
+// MindmapRoutesEditMindmap delegates to MindmapController
+func (h *Handler) MindmapRoutesEditMindmap(ctx context.Context, request generated.MindmapRoutesEditMindmapRequestObject) (generated.MindmapRoutesEditMindmapResponseObject, error) {
+	return h.MindmapController.MindmapRoutesEditMindmap(ctx, request)
+}
+
+// MindmapRoutesListEdits delegates to MindmapController
+func (h *Handler) MindmapRoutesListEdits(ctx context.Context, request generated.MindmapRoutesListEditsRequestObject) (generated.MindmapRoutesListEditsResponseObject, error) {
+	return h.MindmapController.MindmapRoutesListEdits(ctx, request)
+}
+
+// MindmapRoutesExportMindmap delegates to MindmapController
+func (h *Handler) MindmapRoutesExportMindmap(ctx context.Context, request generated.MindmapRoutesExportMindmapRequestObject) (generated.MindmapRoutesExportMindmapResponseObject, error) {
+	return h.MindmapController.MindmapRoutesExportMindmap(ctx, request)
+}
+
+// MindmapRoutesRelayout delegates to MindmapController
+func (h *Handler) MindmapRoutesRelayout(ctx context.Context, request generated.MindmapRoutesRelayoutRequestObject) (generated.MindmapRoutesRelayoutResponseObject, error) {
+	return h.MindmapController.MindmapRoutesRelayout(ctx, request)
+}
+
+// MindmapRoutesListRevisions delegates to MindmapController
+func (h *Handler) MindmapRoutesListRevisions(ctx context.Context, request generated.MindmapRoutesListRevisionsRequestObject) (generated.MindmapRoutesListRevisionsResponseObject, error) {
+	return h.MindmapController.MindmapRoutesListRevisions(ctx, request)
+}
+
+// MindmapRoutesGetRevision delegates to MindmapController
+func (h *Handler) MindmapRoutesGetRevision(ctx context.Context, request generated.MindmapRoutesGetRevisionRequestObject) (generated.MindmapRoutesGetRevisionResponseObject, error) {
+	return h.MindmapController.MindmapRoutesGetRevision(ctx, request)
+}
+
+// MindmapRoutesRestoreRevision delegates to MindmapController
+func (h *Handler) MindmapRoutesRestoreRevision(ctx context.Context, request generated.MindmapRoutesRestoreRevisionRequestObject) (generated.MindmapRoutesRestoreRevisionResponseObject, error) {
+	return h.MindmapController.MindmapRoutesRestoreRevision(ctx, request)
+}
+
+// MindmapRoutesDiffRevisions delegates to MindmapController
+func (h *Handler) MindmapRoutesDiffRevisions(ctx context.Context, request generated.MindmapRoutesDiffRevisionsRequestObject) (generated.MindmapRoutesDiffRevisionsResponseObject, error) {
+	return h.MindmapController.MindmapRoutesDiffRevisions(ctx, request)
+}
+
+// KnowledgeRoutesListTopics delegates to KnowledgeController
+func (h *Handler) KnowledgeRoutesListTopics(ctx context.Context, request generated.KnowledgeRoutesListTopicsRequestObject) (generated.KnowledgeRoutesListTopicsResponseObject, error) {
+	return h.KnowledgeController.KnowledgeRoutesListTopics(ctx, request)
+}
+
+// KnowledgeRoutesGetTopic delegates to KnowledgeController
+func (h *Handler) KnowledgeRoutesGetTopic(ctx context.Context, request generated.KnowledgeRoutesGetTopicRequestObject) (generated.KnowledgeRoutesGetTopicResponseObject, error) {
+	return h.KnowledgeController.KnowledgeRoutesGetTopic(ctx, request)
+}
+
+// KnowledgeRoutesGetTimeline delegates to KnowledgeController
+func (h *Handler) KnowledgeRoutesGetTimeline(ctx context.Context, request generated.KnowledgeRoutesGetTimelineRequestObject) (generated.KnowledgeRoutesGetTimelineResponseObject, error) {
+	return h.KnowledgeController.KnowledgeRoutesGetTimeline(ctx, request)
+}
+
+// KnowledgeRoutesGetNeighborhood delegates to KnowledgeController
+func (h *Handler) KnowledgeRoutesGetNeighborhood(ctx context.Context, request generated.KnowledgeRoutesGetNeighborhoodRequestObject) (generated.KnowledgeRoutesGetNeighborhoodResponseObject, error) {
+	return h.KnowledgeController.KnowledgeRoutesGetNeighborhood(ctx, request)
+}
@@ -0,0 +1,245 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/internal/generated"
+	"github.com/mindhit/api/internal/service"
+)
+
+// KnowledgeController implements knowledge graph handlers from StrictServerInterface.
+type KnowledgeController struct {
+	knowledgeService *service.KnowledgeService
+	jwtService       *service.JWTService
+}
+
+// NewKnowledgeController creates a new KnowledgeController.
+func NewKnowledgeController(knowledgeService *service.KnowledgeService, jwtService *service.JWTService) *KnowledgeController {
+	return &KnowledgeController{
+		knowledgeService: knowledgeService,
+		jwtService:       jwtService,
+	}
+}
+
+// extractUserID extracts and validates user ID from authorization header.
+func (c *KnowledgeController) extractUserID(authHeader string) (uuid.UUID, error) {
+	if authHeader == "" {
+		return uuid.Nil, errors.New("authorization header is required")
+	}
+
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return uuid.Nil, errors.New("invalid authorization header format")
+	}
+
+	claims, err := c.jwtService.ValidateAccessToken(parts[1])
+	if err != nil {
+		return uuid.Nil, errors.New("invalid or expired access token")
+	}
+
+	return claims.UserID, nil
+}
+
+// KnowledgeRoutesListTopics handles GET /v1/knowledge/topics.
+func (c *KnowledgeController) KnowledgeRoutesListTopics(ctx context.Context, request generated.KnowledgeRoutesListTopicsRequestObject) (generated.KnowledgeRoutesListTopicsResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.KnowledgeRoutesListTopics401JSONResponse(knowledgeError(err.Error())), nil
+	}
+
+	limit := 0
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+	}
+	topics, err := c.knowledgeService.ListTopics(ctx, userID, strings.TrimSpace(ptrToString(request.Params.Q)), limit)
+	if err != nil {
+		slog.Error("knowledge topic list failed", "error", err)
+		return nil, err
+	}
+
+	result := make([]generated.KnowledgeKnowledgeTopic, len(topics))
+	for i, t := range topics {
+		result[i] = mapKnowledgeTopic(t)
+	}
+	return generated.KnowledgeRoutesListTopics200JSONResponse{Topics: result}, nil
+}
+
+// KnowledgeRoutesGetTopic handles GET /v1/knowledge/topics/{id}.
+func (c *KnowledgeController) KnowledgeRoutesGetTopic(ctx context.Context, request generated.KnowledgeRoutesGetTopicRequestObject) (generated.KnowledgeRoutesGetTopicResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.KnowledgeRoutesGetTopic401JSONResponse(knowledgeError(err.Error())), nil
+	}
+	topicID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.KnowledgeRoutesGetTopic404JSONResponse(knowledgeError("invalid topic id")), nil
+	}
+
+	topic, sessions, err := c.knowledgeService.GetTopic(ctx, userID, topicID)
+	if errors.Is(err, service.ErrKnowledgeTopicNotFound) {
+		return generated.KnowledgeRoutesGetTopic404JSONResponse(knowledgeError("knowledge topic not found")), nil
+	}
+	if err != nil {
+		slog.Error("knowledge topic get failed", "error", err)
+		return nil, err
+	}
+
+	return generated.KnowledgeRoutesGetTopic200JSONResponse{
+		Topic:    mapKnowledgeTopic(*topic),
+		Sessions: mapKnowledgeContributions(sessions),
+	}, nil
+}
+
+// KnowledgeRoutesGetTimeline handles GET /v1/knowledge/topics/{id}/timeline.
+func (c *KnowledgeController) KnowledgeRoutesGetTimeline(ctx context.Context, request generated.KnowledgeRoutesGetTimelineRequestObject) (generated.KnowledgeRoutesGetTimelineResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.KnowledgeRoutesGetTimeline401JSONResponse(knowledgeError(err.Error())), nil
+	}
+	topicID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.KnowledgeRoutesGetTimeline404JSONResponse(knowledgeError("invalid topic id")), nil
+	}
+
+	topic, entries, err := c.knowledgeService.Timeline(ctx, userID, topicID)
+	if errors.Is(err, service.ErrKnowledgeTopicNotFound) {
+		return generated.KnowledgeRoutesGetTimeline404JSONResponse(knowledgeError("knowledge topic not found")), nil
+	}
+	if err != nil {
+		slog.Error("knowledge topic timeline failed", "error", err)
+		return nil, err
+	}
+
+	return generated.KnowledgeRoutesGetTimeline200JSONResponse{
+		Topic:   mapKnowledgeTopic(*topic),
+		Entries: mapKnowledgeContributions(entries),
+	}, nil
+}
+
+// KnowledgeRoutesGetNeighborhood handles GET /v1/knowledge/topics/{id}/neighborhood.
+func (c *KnowledgeController) KnowledgeRoutesGetNeighborhood(ctx context.Context, request generated.KnowledgeRoutesGetNeighborhoodRequestObject) (generated.KnowledgeRoutesGetNeighborhoodResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.KnowledgeRoutesGetNeighborhood401JSONResponse(knowledgeError(err.Error())), nil
+	}
+	topicID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.KnowledgeRoutesGetNeighborhood404JSONResponse(knowledgeError("invalid topic id")), nil
+	}
+
+	depth, limit := 1, 0
+	if request.Params.Depth != nil {
+		depth = int(*request.Params.Depth)
+	}
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+	}
+	hood, err := c.knowledgeService.Neighborhood(ctx, userID, topicID, depth, limit)
+	if errors.Is(err, service.ErrKnowledgeTopicNotFound) {
+		return generated.KnowledgeRoutesGetNeighborhood404JSONResponse(knowledgeError("knowledge topic not found")), nil
+	}
+	if err != nil {
+		slog.Error("knowledge neighborhood failed", "error", err)
+		return nil, err
+	}
+
+	resp := generated.KnowledgeRoutesGetNeighborhood200JSONResponse{
+		Center:    mapKnowledgeTopic(hood.Center),
+		Neighbors: make([]generated.KnowledgeKnowledgeNeighbor, len(hood.Neighbors)),
+		Links:     make([]generated.KnowledgeKnowledgeLink, len(hood.Links)),
+		Urls:      make([]generated.KnowledgeKnowledgeUrl, len(hood.URLs)),
+	}
+	for i, n := range hood.Neighbors {
+		resp.Neighbors[i] = generated.KnowledgeKnowledgeNeighbor{
+			Topic: mapKnowledgeTopic(n.KnowledgeTopicInfo),
+			Depth: int32(n.Depth),
+		}
+	}
+	for i, l := range hood.Links {
+		resp.Links[i] = generated.KnowledgeKnowledgeLink{
+			Source:         l.Source.String(),
+			Target:         l.Target.String(),
+			SharedSessions: int32(l.Sessions),
+			SharedUrls:     int32(l.URLs),
+			SharedKeywords: nonNilStrings(l.Keywords),
+			Weight:         l.Weight,
+		}
+	}
+	for i, u := range hood.URLs {
+		topicIDs := make([]string, len(u.TopicIDs))
+		for j, id := range u.TopicIDs {
+			topicIDs[j] = id.String()
+		}
+		sessions := int32(u.Sessions)
+		resp.Urls[i] = mapKnowledgeURL(u)
+		resp.Urls[i].TopicIds = &topicIDs
+		resp.Urls[i].SessionCount = &sessions
+	}
+	return resp, nil
+}
+
+// mapKnowledgeTopic converts a service.KnowledgeTopicInfo to generated.KnowledgeKnowledgeTopic.
+func mapKnowledgeTopic(t service.KnowledgeTopicInfo) generated.KnowledgeKnowledgeTopic {
+	return generated.KnowledgeKnowledgeTopic{
+		Id:           t.Topic.ID.String(),
+		Label:        t.Topic.Label,
+		Keywords:     nonNilStrings(t.Topic.Keywords),
+		SessionCount: int32(t.Sessions),
+		UrlCount:     int32(t.URLs),
+		FirstSeenAt:  t.Topic.FirstSeenAt,
+		LastSeenAt:   t.Topic.LastSeenAt,
+	}
+}
+
+// mapKnowledgeContributions converts service.KnowledgeContribution values to generated.KnowledgeKnowledgeContribution.
+func mapKnowledgeContributions(contributions []service.KnowledgeContribution) []generated.KnowledgeKnowledgeContribution {
+	result := make([]generated.KnowledgeKnowledgeContribution, len(contributions))
+	for i, c := range contributions {
+		urls := make([]generated.KnowledgeKnowledgeUrl, len(c.URLs))
+		for j, u := range c.URLs {
+			urls[j] = mapKnowledgeURL(u)
+		}
+		result[i] = generated.KnowledgeKnowledgeContribution{
+			SessionId:    c.Session.ID.String(),
+			SessionTitle: c.Session.Title,
+			StartedAt:    c.Session.StartedAt,
+			Labels:       nonNilStrings(c.Labels),
+			Keywords:     nonNilStrings(c.Keywords),
+			Urls:         urls,
+			NewUrlCount:  int32(c.NewURLs),
+		}
+	}
+	return result
+}
+
+// mapKnowledgeURL converts a service.KnowledgeURL to generated.KnowledgeKnowledgeUrl.
+func mapKnowledgeURL(u service.KnowledgeURL) generated.KnowledgeKnowledgeUrl {
+	result := generated.KnowledgeKnowledgeUrl{
+		Id:  u.ID.String(),
+		Url: u.URL,
+	}
+	if u.Title != "" {
+		result.Title = &u.Title
+	}
+	return result
+}
+
+// nonNilStrings returns s, or an empty list for nil so it encodes as [].
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// knowledgeError builds an error body with a message.
+func knowledgeError(message string) generated.CommonErrorResponse {
+	body := generated.CommonErrorResponse{}
+	body.Error.Message = message
+	return body
+}
@@ -2,9 +2,11 @@
 package controller
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"log/slog"
+	"mime"
 	"strings"
 
 	"github.com/google/uuid"
@@ -110,24 +112,14 @@ func (c *MindmapController) MindmapRoutesGenerateMindmap(ctx context.Context, re
 		}, nil
 	}
 
-	// Get or create mindmap
-	mindmap, created, err := c.mindmapService.GetOrCreateForSession(ctx, sessionID, userID)
+	force := request.Body != nil && request.Body.Force != nil && *request.Body.Force
+
+	// Create or reset the mindmap and enqueue generation
+	mindmap, err := c.mindmapService.RequestGeneration(ctx, sessionID, userID, force)
 	if err != nil {
 		return c.handleGenerateError(err)
 	}
 
-	// If force is true and mindmap already exists, update to pending status
-	if !created && request.Body.Force != nil && *request.Body.Force {
-		mindmap, err = c.mindmapService.UpdateStatus(ctx, mindmap.ID, mindmapgraph.StatusPending)
-		if err != nil {
-			slog.Error("failed to reset mindmap status", "error", err)
-			return nil, err
-		}
-	}
-
-	// TODO: Enqueue mindmap generation job if status is pending
-	// This will be handled by the worker
-
 	return generated.MindmapRoutesGenerateMindmap202JSONResponse{
 		Mindmap: mapMindmap(mindmap, sessionID),
 	}, nil
@@ -203,12 +195,410 @@ func (c *MindmapController) handleGenerateError(err error) (generated.MindmapRou
 	}
 }
 
+// MindmapRoutesListRevisions handles GET /v1/sessions/{sessionId}/mindmap/revisions.
+func (c *MindmapController) MindmapRoutesListRevisions(ctx context.Context, request generated.MindmapRoutesListRevisionsRequestObject) (generated.MindmapRoutesListRevisionsResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.MindmapRoutesListRevisions401JSONResponse(mindmapError(err.Error())), nil
+	}
+	sessionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.MindmapRoutesListRevisions404JSONResponse(mindmapError("invalid session id")), nil
+	}
+
+	mindmap, revisions, err := c.mindmapService.ListRevisions(ctx, sessionID, userID)
+	if msg, ok := mindmapNotFound(err); ok {
+		return generated.MindmapRoutesListRevisions404JSONResponse(mindmapError(msg)), nil
+	}
+	if err != nil {
+		slog.Error("mindmap revision list failed", "error", err)
+		return nil, err
+	}
+
+	result := make([]generated.MindmapMindmapRevisionSummary, len(revisions))
+	for i, rev := range revisions {
+		result[i] = mapRevisionSummary(rev, mindmap)
+	}
+	return generated.MindmapRoutesListRevisions200JSONResponse{Revisions: result}, nil
+}
+
+// MindmapRoutesGetRevision handles GET /v1/sessions/{sessionId}/mindmap/revisions/{version}.
+func (c *MindmapController) MindmapRoutesGetRevision(ctx context.Context, request generated.MindmapRoutesGetRevisionRequestObject) (generated.MindmapRoutesGetRevisionResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.MindmapRoutesGetRevision401JSONResponse(mindmapError(err.Error())), nil
+	}
+	sessionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.MindmapRoutesGetRevision404JSONResponse(mindmapError("invalid session id")), nil
+	}
+
+	mindmap, rev, err := c.mindmapService.GetRevision(ctx, sessionID, userID, int(request.Version))
+	if msg, ok := mindmapNotFound(err); ok {
+		return generated.MindmapRoutesGetRevision404JSONResponse(mindmapError(msg)), nil
+	}
+	if err != nil {
+		slog.Error("mindmap revision get failed", "error", err)
+		return nil, err
+	}
+
+	summary := mapRevisionSummary(rev, mindmap)
+	return generated.MindmapRoutesGetRevision200JSONResponse{
+		Revision: generated.MindmapMindmapRevision{
+			Version:      summary.Version,
+			RestoredFrom: summary.RestoredFrom,
+			Current:      summary.Current,
+			GeneratedAt:  summary.GeneratedAt,
+			CreatedAt:    summary.CreatedAt,
+			Data: generated.MindmapMindmapData{
+				Nodes:  mapNodes(rev.Nodes),
+				Edges:  mapEdges(rev.GraphEdges),
+				Layout: mapLayout(rev.Layout),
+			},
+		},
+	}, nil
+}
+
+// MindmapRoutesRestoreRevision handles POST /v1/sessions/{sessionId}/mindmap/revisions/{version}/restore.
+func (c *MindmapController) MindmapRoutesRestoreRevision(ctx context.Context, request generated.MindmapRoutesRestoreRevisionRequestObject) (generated.MindmapRoutesRestoreRevisionResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.MindmapRoutesRestoreRevision401JSONResponse(mindmapError(err.Error())), nil
+	}
+	sessionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.MindmapRoutesRestoreRevision404JSONResponse(mindmapError("invalid session id")), nil
+	}
+
+	mindmap, err := c.mindmapService.RestoreRevision(ctx, sessionID, userID, int(request.Version))
+	if msg, ok := mindmapNotFound(err); ok {
+		return generated.MindmapRoutesRestoreRevision404JSONResponse(mindmapError(msg)), nil
+	}
+	switch {
+	case errors.Is(err, service.ErrMindmapGenerating):
+		return generated.MindmapRoutesRestoreRevision409JSONResponse(mindmapError("mindmap is being generated, try again when it finishes")), nil
+	case err != nil:
+		slog.Error("mindmap revision restore failed", "error", err)
+		return nil, err
+	}
+
+	return generated.MindmapRoutesRestoreRevision200JSONResponse{
+		Mindmap: mapMindmap(mindmap, sessionID),
+	}, nil
+}
+
+// MindmapRoutesDiffRevisions handles GET /v1/sessions/{sessionId}/mindmap/diff.
+func (c *MindmapController) MindmapRoutesDiffRevisions(ctx context.Context, request generated.MindmapRoutesDiffRevisionsRequestObject) (generated.MindmapRoutesDiffRevisionsResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.MindmapRoutesDiffRevisions401JSONResponse(mindmapError(err.Error())), nil
+	}
+	sessionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.MindmapRoutesDiffRevisions404JSONResponse(mindmapError("invalid session id")), nil
+	}
+
+	diff, err := c.mindmapService.DiffRevisions(ctx, sessionID, userID, int(request.Params.From), int(request.Params.To))
+	if msg, ok := mindmapNotFound(err); ok {
+		return generated.MindmapRoutesDiffRevisions404JSONResponse(mindmapError(msg)), nil
+	}
+	if err != nil {
+		slog.Error("mindmap revision diff failed", "error", err)
+		return nil, err
+	}
+
+	return generated.MindmapRoutesDiffRevisions200JSONResponse{Diff: mapDiff(diff)}, nil
+}
+
+// MindmapRoutesEditMindmap handles PATCH /v1/sessions/{sessionId}/mindmap.
+func (c *MindmapController) MindmapRoutesEditMindmap(ctx context.Context, request generated.MindmapRoutesEditMindmapRequestObject) (generated.MindmapRoutesEditMindmapResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.MindmapRoutesEditMindmap401JSONResponse(mindmapError(err.Error())), nil
+	}
+	sessionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.MindmapRoutesEditMindmap404JSONResponse(mindmapError("invalid session id")), nil
+	}
+	if request.Body == nil {
+		return generated.MindmapRoutesEditMindmap400JSONResponse(mindmapError("edits are required")), nil
+	}
+
+	edits := make([]service.MindmapEdit, len(request.Body.Edits))
+	for i, e := range request.Body.Edits {
+		edits[i] = mapEditFromAPI(e)
+	}
+
+	mindmap, err := c.mindmapService.Edit(ctx, sessionID, userID, edits)
+	if msg, ok := mindmapNotFound(err); ok {
+		return generated.MindmapRoutesEditMindmap404JSONResponse(mindmapError(msg)), nil
+	}
+	switch {
+	case errors.Is(err, service.ErrInvalidMindmapEdit):
+		return generated.MindmapRoutesEditMindmap400JSONResponse(mindmapError(err.Error())), nil
+	case errors.Is(err, service.ErrMindmapNotCompleted), errors.Is(err, service.ErrMindmapChanged):
+		return generated.MindmapRoutesEditMindmap409JSONResponse(mindmapError(err.Error())), nil
+	case err != nil:
+		slog.Error("mindmap edit failed", "error", err)
+		return nil, err
+	}
+
+	return generated.MindmapRoutesEditMindmap200JSONResponse{
+		Mindmap: mapMindmap(mindmap, sessionID),
+	}, nil
+}
+
+// MindmapRoutesListEdits handles GET /v1/sessions/{sessionId}/mindmap/edits.
+func (c *MindmapController) MindmapRoutesListEdits(ctx context.Context, request generated.MindmapRoutesListEditsRequestObject) (generated.MindmapRoutesListEditsResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.MindmapRoutesListEdits401JSONResponse(mindmapError(err.Error())), nil
+	}
+	sessionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.MindmapRoutesListEdits404JSONResponse(mindmapError("invalid session id")), nil
+	}
+
+	records, err := c.mindmapService.ListEdits(ctx, sessionID, userID)
+	if msg, ok := mindmapNotFound(err); ok {
+		return generated.MindmapRoutesListEdits404JSONResponse(mindmapError(msg)), nil
+	}
+	if err != nil {
+		slog.Error("mindmap edit list failed", "error", err)
+		return nil, err
+	}
+
+	result := make([]generated.MindmapMindmapEditRecord, 0, len(records))
+	for _, r := range records {
+		edit, err := service.EditFromRecord(r)
+		if err != nil {
+			slog.Error("failed to read mindmap edit", "edit_id", r.ID, "error", err)
+			continue
+		}
+		result = append(result, generated.MindmapMindmapEditRecord{
+			Id:        int32(r.ID),
+			Edit:      mapEditToAPI(edit),
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return generated.MindmapRoutesListEdits200JSONResponse{Edits: result}, nil
+}
+
+// MindmapRoutesExportMindmap handles GET /v1/sessions/{sessionId}/mindmap/export.
+func (c *MindmapController) MindmapRoutesExportMindmap(ctx context.Context, request generated.MindmapRoutesExportMindmapRequestObject) (generated.MindmapRoutesExportMindmapResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.MindmapRoutesExportMindmap401JSONResponse(mindmapError(err.Error())), nil
+	}
+	sessionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.MindmapRoutesExportMindmap404JSONResponse(mindmapError("invalid session id")), nil
+	}
+
+	export, err := c.mindmapService.Export(ctx, sessionID, userID, service.ExportFormat(request.Params.Format))
+	if msg, ok := mindmapNotFound(err); ok {
+		return generated.MindmapRoutesExportMindmap404JSONResponse(mindmapError(msg)), nil
+	}
+	switch {
+	case errors.Is(err, service.ErrUnknownExportFormat):
+		return generated.MindmapRoutesExportMindmap400JSONResponse(mindmapError(err.Error())), nil
+	case errors.Is(err, service.ErrExportNotInPlan):
+		body := mindmapError("your plan doesn't include " + string(request.Params.Format) + " export; upgrade your plan to use it")
+		code := "EXPORT_NOT_IN_PLAN"
+		body.Error.Code = &code
+		return generated.MindmapRoutesExportMindmap402JSONResponse(body), nil
+	case errors.Is(err, service.ErrMindmapNotCompleted):
+		return generated.MindmapRoutesExportMindmap409JSONResponse(mindmapError(err.Error())), nil
+	case err != nil:
+		slog.Error("mindmap export failed", "error", err)
+		return nil, err
+	}
+
+	return generated.MindmapRoutesExportMindmap200AsteriskResponse{
+		Body:          bytes.NewReader(export.Content),
+		ContentType:   export.ContentType,
+		ContentLength: int64(len(export.Content)),
+		Headers: generated.MindmapRoutesExportMindmap200ResponseHeaders{
+			ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": export.Filename}),
+		},
+	}, nil
+}
+
+// MindmapRoutesRelayout handles POST /v1/sessions/{sessionId}/mindmap/layout.
+func (c *MindmapController) MindmapRoutesRelayout(ctx context.Context, request generated.MindmapRoutesRelayoutRequestObject) (generated.MindmapRoutesRelayoutResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.MindmapRoutesRelayout401JSONResponse(mindmapError(err.Error())), nil
+	}
+	sessionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return generated.MindmapRoutesRelayout404JSONResponse(mindmapError("invalid session id")), nil
+	}
+	if request.Body == nil {
+		return generated.MindmapRoutesRelayout400JSONResponse(mindmapError("layout type is required")), nil
+	}
+
+	dimensions := 0
+	if request.Body.Dimensions != nil {
+		dimensions = int(*request.Body.Dimensions)
+	}
+
+	mindmap, err := c.mindmapService.Relayout(ctx, sessionID, userID, string(request.Body.Type), dimensions)
+	if msg, ok := mindmapNotFound(err); ok {
+		return generated.MindmapRoutesRelayout404JSONResponse(mindmapError(msg)), nil
+	}
+	switch {
+	case errors.Is(err, service.ErrInvalidLayout):
+		return generated.MindmapRoutesRelayout400JSONResponse(mindmapError(err.Error())), nil
+	case errors.Is(err, service.ErrMindmapNotCompleted), errors.Is(err, service.ErrMindmapChanged):
+		return generated.MindmapRoutesRelayout409JSONResponse(mindmapError(err.Error())), nil
+	case err != nil:
+		slog.Error("mindmap relayout failed", "error", err)
+		return nil, err
+	}
+
+	return generated.MindmapRoutesRelayout200JSONResponse{
+		Mindmap: mapMindmap(mindmap, sessionID),
+	}, nil
+}
+
+// mindmapNotFound returns the message of the errors a mindmap or revision
+// lookup answers with 404.
+func mindmapNotFound(err error) (string, bool) {
+	switch {
+	case errors.Is(err, service.ErrSessionNotFound):
+		return "session not found", true
+	case errors.Is(err, service.ErrMindmapNotFound):
+		return "mindmap not found for this session", true
+	case errors.Is(err, service.ErrMindmapRevisionNotFound):
+		return "mindmap revision not found", true
+	default:
+		return "", false
+	}
+}
+
+// mindmapError builds an error body with a message.
+func mindmapError(message string) generated.CommonErrorResponse {
+	body := generated.CommonErrorResponse{}
+	body.Error.Message = message
+	return body
+}
+
+// mapEditFromAPI converts a generated.MindmapMindmapEdit to service.MindmapEdit.
+func mapEditFromAPI(e generated.MindmapMindmapEdit) service.MindmapEdit {
+	edit := service.MindmapEdit{
+		Op:       service.MindmapEditOp(e.Op),
+		NodeID:   ptrToString(e.NodeId),
+		TargetID: ptrToString(e.TargetId),
+		Label:    ptrToString(e.Label),
+		Color:    ptrToString(e.Color),
+		Pinned:   e.Pinned,
+		Note:     ptrToString(e.Note),
+	}
+	if e.NodeIds != nil {
+		edit.NodeIDs = *e.NodeIds
+	}
+	if e.PageIds != nil {
+		edit.PageIDs = *e.PageIds
+	}
+	if e.Position != nil {
+		edit.Position = &service.Position{X: e.Position.X, Y: e.Position.Y, Z: e.Position.Z}
+	}
+	return edit
+}
+
+// mapEditToAPI converts a service.MindmapEdit to generated.MindmapMindmapEdit.
+func mapEditToAPI(e service.MindmapEdit) generated.MindmapMindmapEdit {
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+	edit := generated.MindmapMindmapEdit{
+		Op:       generated.MindmapMindmapEditOp(e.Op),
+		NodeId:   optional(e.NodeID),
+		TargetId: optional(e.TargetID),
+		Label:    optional(e.Label),
+		Color:    optional(e.Color),
+		Pinned:   e.Pinned,
+		Note:     optional(e.Note),
+	}
+	if len(e.NodeIDs) > 0 {
+		edit.NodeIds = &e.NodeIDs
+	}
+	if len(e.PageIDs) > 0 {
+		edit.PageIds = &e.PageIDs
+	}
+	if e.Position != nil {
+		edit.Position = &generated.MindmapPosition{X: e.Position.X, Y: e.Position.Y, Z: e.Position.Z}
+	}
+	return edit
+}
+
+// mapRevisionSummary converts an ent.MindmapRevision to
+// generated.MindmapMindmapRevisionSummary.
+func mapRevisionSummary(rev *ent.MindmapRevision, mindmap *ent.MindmapGraph) generated.MindmapMindmapRevisionSummary {
+	result := generated.MindmapMindmapRevisionSummary{
+		Version:     int32(rev.Version),
+		Current:     rev.Version == mindmap.Version && mindmap.Status == mindmapgraph.StatusCompleted,
+		GeneratedAt: rev.GeneratedAt,
+		CreatedAt:   rev.CreatedAt,
+	}
+	if rev.RestoredFrom != nil {
+		from := int32(*rev.RestoredFrom)
+		result.RestoredFrom = &from
+	}
+	return result
+}
+
+// mapDiff converts a service.MindmapDiff to generated.MindmapMindmapDiff.
+func mapDiff(diff *service.MindmapDiff) generated.MindmapMindmapDiff {
+	result := generated.MindmapMindmapDiff{
+		From:          int32(diff.From),
+		To:            int32(diff.To),
+		AddedTopics:   mapDiffTopics(diff.AddedTopics),
+		RemovedTopics: mapDiffTopics(diff.RemovedTopics),
+		RenamedTopics: make([]generated.MindmapDiffTopicRename, len(diff.RenamedTopics)),
+		MovedPages:    make([]generated.MindmapDiffPageMove, len(diff.MovedPages)),
+	}
+	for i, r := range diff.RenamedTopics {
+		result.RenamedTopics[i] = generated.MindmapDiffTopicRename{
+			From:       mapDiffTopic(r.From),
+			To:         mapDiffTopic(r.To),
+			Similarity: r.Similarity,
+		}
+	}
+	for i, m := range diff.MovedPages {
+		result.MovedPages[i] = generated.MindmapDiffPageMove{
+			Id:    m.ID,
+			Label: m.Label,
+			From:  mapDiffTopic(m.From),
+			To:    mapDiffTopic(m.To),
+		}
+	}
+	return result
+}
+
+func mapDiffTopic(t service.DiffTopic) generated.MindmapDiffTopic {
+	return generated.MindmapDiffTopic{Id: t.ID, Label: t.Label}
+}
+
+func mapDiffTopics(topics []service.DiffTopic) []generated.MindmapDiffTopic {
+	result := make([]generated.MindmapDiffTopic, len(topics))
+	for i, t := range topics {
+		result[i] = mapDiffTopic(t)
+	}
+	return result
+}
+
 // mapMindmap converts an ent.MindmapGraph to generated.MindmapMindmap.
 func mapMindmap(m *ent.MindmapGraph, sessionID uuid.UUID) generated.MindmapMindmap {
 	result := generated.MindmapMindmap{
 		Id:        m.ID.String(),
 		SessionId: sessionID.String(),
 		Status:    generated.MindmapMindmapStatus(m.Status.String()),
+		Version:   int32(m.Version),
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
 	}
@@ -265,6 +655,9 @@ func mapEdges(edges []map[string]interface{}) []generated.MindmapMindmapEdge {
 		if label := getString(edge, "label"); label != "" {
 			result[i].Label = &label
 		}
+		if manual, ok := edge["manual"].(bool); ok && manual {
+			result[i].Manual = &manual
+		}
 	}
 	return result
 }
@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/internal/controller/response"
+	"github.com/mindhit/api/internal/infrastructure/progress"
+	"github.com/mindhit/api/internal/service"
+)
+
+const (
+	// streamKeepAliveInterval keeps proxies from closing an idle stream.
+	streamKeepAliveInterval = 15 * time.Second
+	// streamMaxDuration bounds a stream; clients reconnect if generation
+	// takes longer.
+	streamMaxDuration = 10 * time.Minute
+)
+
+// MindmapStreamController streams mindmap generation progress as server-sent
+// events. It is a plain gin handler because the response is a stream.
+type MindmapStreamController struct {
+	mindmapService *service.MindmapService
+	jwtService     *service.JWTService
+	broker         *progress.Broker
+}
+
+// NewMindmapStreamController creates a new MindmapStreamController. Without a
+// broker the endpoint answers 503.
+func NewMindmapStreamController(mindmapService *service.MindmapService, jwtService *service.JWTService, broker *progress.Broker) *MindmapStreamController {
+	return &MindmapStreamController{
+		mindmapService: mindmapService,
+		jwtService:     jwtService,
+		broker:         broker,
+	}
+}
+
+// accessClaims validates the access token in the Authorization header.
+func (c *MindmapStreamController) accessClaims(ctx *gin.Context) (*service.Claims, error) {
+	authHeader := ctx.GetHeader("Authorization")
+	if authHeader == "" {
+		return nil, errors.New("authorization header is required")
+	}
+
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return nil, errors.New("invalid authorization header format")
+	}
+
+	claims, err := c.jwtService.ValidateAccessToken(parts[1])
+	if err != nil {
+		return nil, errors.New("invalid or expired access token")
+	}
+
+	return claims, nil
+}
+
+// extractUserID extracts and validates the user ID of a stream request.
+// EventSource can't set headers, so a stream ticket for the session may be
+// passed as the ticket query parameter instead of the access token.
+func (c *MindmapStreamController) extractUserID(ctx *gin.Context, sessionID uuid.UUID) (uuid.UUID, error) {
+	if ticket := ctx.Query("ticket"); ticket != "" {
+		claims, err := c.jwtService.ValidateStreamTicket(ticket, sessionID)
+		if err != nil {
+			return uuid.Nil, errors.New("invalid or expired stream ticket")
+		}
+		return claims.UserID, nil
+	}
+
+	claims, err := c.accessClaims(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return claims.UserID, nil
+}
+
+// Ticket handles POST /v1/sessions/{id}/mindmap/stream/ticket. It exchanges
+// the access token for a short-lived ticket that only opens the session's
+// progress stream, so the access token never appears in a URL.
+func (c *MindmapStreamController) Ticket(ctx *gin.Context) {
+	claims, err := c.accessClaims(ctx)
+	if err != nil {
+		response.Unauthorized(ctx, err.Error())
+		return
+	}
+
+	sessionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.NotFound(ctx, "invalid session id")
+		return
+	}
+
+	ticket, expiresIn, err := c.jwtService.GenerateStreamTicket(claims, sessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to generate stream ticket", "session_id", sessionID, "error", err)
+		response.InternalError(ctx)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"ticket": ticket, "expires_in": expiresIn})
+}
+
+// Stream handles GET /v1/sessions/{id}/mindmap/stream. It sends a status
+// event first, then the progress events until generation is done or failed.
+func (c *MindmapStreamController) Stream(ctx *gin.Context) {
+	sessionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.NotFound(ctx, "invalid session id")
+		return
+	}
+
+	userID, err := c.extractUserID(ctx, sessionID)
+	if err != nil {
+		response.Unauthorized(ctx, err.Error())
+		return
+	}
+
+	if c.broker == nil {
+		response.ServiceUnavailable(ctx, "mindmap progress streaming is not configured")
+		return
+	}
+
+	streamCtx, cancel := context.WithTimeout(ctx.Request.Context(), streamMaxDuration)
+	defer cancel()
+
+	// Subscribe before reading the status, so an event published in between
+	// is not missed
+	sub, err := c.broker.Subscribe(streamCtx, sessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to subscribe to mindmap progress", "session_id", sessionID, "error", err)
+		response.InternalError(ctx)
+		return
+	}
+	defer func() { _ = sub.Close() }()
+
+	mindmap, err := c.mindmapService.GetBySessionID(streamCtx, sessionID, userID)
+	switch {
+	case errors.Is(err, service.ErrSessionNotFound):
+		response.NotFound(ctx, "session not found")
+		return
+	case errors.Is(err, service.ErrMindmapNotFound):
+		response.NotFound(ctx, "mindmap not found")
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to get mindmap", "session_id", sessionID, "error", err)
+		response.InternalError(ctx)
+		return
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	c.send(ctx, "status", gin.H{"type": "status", "status": mindmap.Status, "mindmap_id": mindmap.ID.String()})
+
+	// Generation already ended, nothing will be published anymore
+	switch mindmap.Status {
+	case mindmapgraph.StatusCompleted:
+		c.send(ctx, string(progress.EventDone), progress.Event{Type: progress.EventDone, MindmapID: mindmap.ID.String()})
+		return
+	case mindmapgraph.StatusFailed:
+		event := progress.Event{Type: progress.EventError}
+		if mindmap.ErrorMessage != nil {
+			event.Error = *mindmap.ErrorMessage
+		}
+		c.send(ctx, string(progress.EventError), event)
+		return
+	}
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-streamCtx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := ctx.Writer.WriteString(": keepalive\n\n"); err != nil {
+				return
+			}
+			ctx.Writer.Flush()
+		case event, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			c.send(ctx, string(event.Type), event)
+			if event.Final() {
+				return
+			}
+		}
+	}
+}
+
+// send writes one server-sent event and flushes it to the client.
+func (c *MindmapStreamController) send(ctx *gin.Context, name string, data any) {
+	ctx.SSEvent(name, data)
+	ctx.Writer.Flush()
+}
@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/internal/infrastructure/progress"
+	"github.com/mindhit/api/internal/service"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+func newStreamRouter(c *MindmapStreamController) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/v1/sessions/:id/mindmap/stream", c.Stream)
+	r.POST("/v1/sessions/:id/mindmap/stream/ticket", c.Ticket)
+	return r
+}
+
+func newProgressBroker(t *testing.T) *progress.Broker {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return progress.NewBroker(rdb)
+}
+
+func TestMindmapStreamController_Rejects(t *testing.T) {
+	jwtService := service.NewJWTService("test-secret")
+	tokens, err := jwtService.GenerateTokenPair(uuid.New())
+	require.NoError(t, err)
+	access, err := jwtService.ValidateAccessToken(tokens.AccessToken)
+	require.NoError(t, err)
+	otherTicket, _, err := jwtService.GenerateStreamTicket(access, uuid.New())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		broker *progress.Broker
+		path   string
+		header string
+		status int
+	}{
+		{"missing token", newProgressBroker(t), "/v1/sessions/" + uuid.NewString() + "/mindmap/stream", "", http.StatusUnauthorized},
+		{"invalid ticket", newProgressBroker(t), "/v1/sessions/" + uuid.NewString() + "/mindmap/stream?ticket=bogus", "", http.StatusUnauthorized},
+		{"access token as ticket", newProgressBroker(t), "/v1/sessions/" + uuid.NewString() + "/mindmap/stream?ticket=" + tokens.AccessToken, "", http.StatusUnauthorized},
+		{"ticket of another session", newProgressBroker(t), "/v1/sessions/" + uuid.NewString() + "/mindmap/stream?ticket=" + otherTicket, "", http.StatusUnauthorized},
+		{"access token in query", newProgressBroker(t), "/v1/sessions/" + uuid.NewString() + "/mindmap/stream?access_token=" + tokens.AccessToken, "", http.StatusUnauthorized},
+		{"invalid session id", newProgressBroker(t), "/v1/sessions/not-a-uuid/mindmap/stream", "Bearer " + tokens.AccessToken, http.StatusNotFound},
+		{"streaming not configured", nil, "/v1/sessions/" + uuid.NewString() + "/mindmap/stream", "Bearer " + tokens.AccessToken, http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newStreamRouter(NewMindmapStreamController(nil, jwtService, tt.broker))
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestMindmapStreamController_Stream(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	jwtService := service.NewJWTService("test-secret")
+	authService := service.NewAuthService(client)
+	mindmapService := service.NewMindmapService(client, nil)
+	broker := newProgressBroker(t)
+
+	user, err := authService.Signup(ctx, uniqueEmail("stream"), "password123")
+	require.NoError(t, err)
+	tokens, err := jwtService.GenerateTokenPair(user.ID)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(newStreamRouter(NewMindmapStreamController(mindmapService, jwtService, broker)))
+	defer server.Close()
+
+	createMindmap := func(t *testing.T, status mindmapgraph.Status) uuid.UUID {
+		sess, err := client.Session.Create().SetUserID(user.ID).SetStartedAt(time.Now()).Save(ctx)
+		require.NoError(t, err)
+		_, err = client.MindmapGraph.Create().SetSessionID(sess.ID).SetStatus(status).Save(ctx)
+		require.NoError(t, err)
+		return sess.ID
+	}
+
+	open := func(t *testing.T, sessionID uuid.UUID) *bufio.Reader {
+		ticket := streamTicket(t, server.URL, sessionID, tokens.AccessToken)
+		resp, err := http.Get(server.URL + "/v1/sessions/" + sessionID.String() + "/mindmap/stream?ticket=" + ticket)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		return bufio.NewReader(resp.Body)
+	}
+
+	t.Run("completed mindmap ends the stream", func(t *testing.T) {
+		events := readEvents(t, open(t, createMindmap(t, mindmapgraph.StatusCompleted)))
+		assert.Equal(t, []string{"status", "done"}, events)
+	})
+
+	t.Run("relays progress until done", func(t *testing.T) {
+		sessionID := createMindmap(t, mindmapgraph.StatusGenerating)
+		body := open(t, sessionID)
+
+		// The status event is sent after the subscription is active
+		line, err := body.ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, "event:status\n", line)
+
+		require.NoError(t, broker.Publish(ctx, sessionID, progress.Event{Type: progress.EventStage, Stage: progress.StageGenerating}))
+		require.NoError(t, broker.Publish(ctx, sessionID, progress.Event{Type: progress.EventContent, Delta: "{"}))
+		require.NoError(t, broker.Publish(ctx, sessionID, progress.Event{Type: progress.EventDone, MindmapID: "m-1"}))
+
+		assert.Equal(t, []string{"stage", "content", "done"}, readEvents(t, body))
+	})
+
+	t.Run("other user's session is not found", func(t *testing.T) {
+		other, err := jwtService.GenerateTokenPair(uuid.New())
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/sessions/"+createMindmap(t, mindmapgraph.StatusGenerating).String()+"/mindmap/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+other.AccessToken)
+		w := httptest.NewRecorder()
+		newStreamRouter(NewMindmapStreamController(mindmapService, jwtService, broker)).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+// streamTicket exchanges an access token for a stream ticket of a session.
+func streamTicket(t *testing.T, serverURL string, sessionID uuid.UUID, accessToken string) string {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, serverURL+"/v1/sessions/"+sessionID.String()+"/mindmap/stream/ticket", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Ticket    string `json:"ticket"`
+		ExpiresIn int64  `json:"expires_in"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, int64(60), body.ExpiresIn)
+	return body.Ticket
+}
+
+func TestMindmapStreamController_Ticket(t *testing.T) {
+	jwtService := service.NewJWTService("test-secret")
+	userID := uuid.New()
+	tokens, err := jwtService.GenerateTokenPair(userID)
+	require.NoError(t, err)
+	sessionID := uuid.New()
+
+	server := httptest.NewServer(newStreamRouter(NewMindmapStreamController(nil, jwtService, nil)))
+	defer server.Close()
+
+	ticket := streamTicket(t, server.URL, sessionID, tokens.AccessToken)
+
+	claims, err := jwtService.ValidateStreamTicket(ticket, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, userID, claims.UserID)
+	assert.Equal(t, tokens.FamilyID, claims.FamilyID)
+
+	resp, err := http.Post(server.URL+"/v1/sessions/"+sessionID.String()+"/mindmap/stream/ticket", "application/json", nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "tickets need the access token")
+}
+
+// readEvents reads event names until the stream ends.
+func readEvents(t *testing.T, body *bufio.Reader) []string {
+	t.Helper()
+	done := make(chan []string, 1)
+	go func() {
+		var events []string
+		for {
+			line, err := body.ReadString('\n')
+			if err != nil {
+				done <- events
+				return
+			}
+			if name, ok := strings.CutPrefix(strings.TrimSpace(line), "event:"); ok {
+				events = append(events, name)
+			}
+		}
+	}()
+
+	select {
+	case events := <-done:
+		return events
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end")
+		return nil
+	}
+}
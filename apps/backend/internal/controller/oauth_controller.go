@@ -12,19 +12,19 @@ import (
 // OAuthController implements OAuth-related handlers.
 type OAuthController struct {
 	oauthService        *service.OAuthService
-	jwtService          *service.JWTService
+	tokenService        *service.TokenService
 	subscriptionService *service.SubscriptionService
 }
 
 // NewOAuthController creates a new OAuthController.
 func NewOAuthController(
 	oauthService *service.OAuthService,
-	jwtService *service.JWTService,
+	tokenService *service.TokenService,
 	subscriptionService *service.SubscriptionService,
 ) *OAuthController {
 	return &OAuthController{
 		oauthService:        oauthService,
-		jwtService:          jwtService,
+		tokenService:        tokenService,
 		subscriptionService: subscriptionService,
 	}
 }
@@ -64,19 +64,21 @@ func (c *OAuthController) RoutesGoogleAuth(
 	}
 
 	// 4. Generate JWT token pair
-	tokenPair, err := c.jwtService.GenerateTokenPair(user.ID)
+	tokenPair, err := c.tokenService.Issue(ctx, user.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to generate token pair", "error", err)
 		return nil, err
 	}
 
 	return generated.RoutesGoogleAuth200JSONResponse{
-		Token: tokenPair.AccessToken,
+		Token:        tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
 		User: generated.AuthUser{
-			Id:        user.ID.String(),
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
+			Id:            user.ID.String(),
+			Email:         user.Email,
+			EmailVerified: user.EmailVerifiedAt != nil,
+			CreatedAt:     user.CreatedAt,
+			UpdatedAt:     user.UpdatedAt,
 		},
 	}, nil
 }
@@ -117,19 +119,21 @@ func (c *OAuthController) RoutesGoogleAuthCode(
 	}
 
 	// 4. Generate JWT token pair
-	tokenPair, err := c.jwtService.GenerateTokenPair(user.ID)
+	tokenPair, err := c.tokenService.Issue(ctx, user.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to generate token pair", "error", err)
 		return nil, err
 	}
 
 	return generated.RoutesGoogleAuthCode200JSONResponse{
-		Token: tokenPair.AccessToken,
+		Token:        tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
 		User: generated.AuthUser{
-			Id:        user.ID.String(),
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
+			Id:            user.ID.String(),
+			Email:         user.Email,
+			EmailVerified: user.EmailVerifiedAt != nil,
+			CreatedAt:     user.CreatedAt,
+			UpdatedAt:     user.UpdatedAt,
 		},
 	}, nil
 }
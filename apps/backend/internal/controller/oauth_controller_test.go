@@ -32,7 +32,8 @@ func TestOAuthController_RoutesGoogleAuth_InvalidToken(t *testing.T) {
 	oauthService := service.NewOAuthService(client)
 	jwtService := service.NewJWTService("test-secret")
 	subscriptionService := service.NewSubscriptionService(client)
-	controller := NewOAuthController(oauthService, jwtService, subscriptionService)
+	tokenService := service.NewTokenService(client, jwtService, nil)
+	controller := NewOAuthController(oauthService, tokenService, subscriptionService)
 
 	ctx := context.Background()
 
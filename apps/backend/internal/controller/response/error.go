@@ -15,6 +15,7 @@ const (
 	CodeNotFound            = "NOT_FOUND"
 	CodeConflict            = "CONFLICT"
 	CodeInternalServerError = "INTERNAL_SERVER_ERROR"
+	CodeServiceUnavailable  = "SERVICE_UNAVAILABLE"
 	CodeValidationError     = "VALIDATION_ERROR"
 )
 
@@ -107,6 +108,16 @@ func InternalError(c *gin.Context) {
 	})
 }
 
+// ServiceUnavailable sends a 503 Service Unavailable response
+func ServiceUnavailable(c *gin.Context, message string) {
+	c.JSON(http.StatusServiceUnavailable, ErrorResponse{
+		Error: ErrorBody{
+			Code:    CodeServiceUnavailable,
+			Message: message,
+		},
+	})
+}
+
 // ValidationError sends a 400 Bad Request with validation details
 func ValidationError(c *gin.Context, details []ValidationDetail) {
 	c.JSON(http.StatusBadRequest, ValidationErrorResponse{
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"strings"
 
@@ -62,6 +63,10 @@ func (c *SessionController) RoutesStart(ctx context.Context, request generated.R
 
 	sess, err := c.sessionService.Start(ctx, userID)
 	if err != nil {
+		var limitErr *service.SessionLimitError
+		if errors.As(err, &limitErr) {
+			return sessionLimitResponse(limitErr), nil
+		}
 		slog.Error("failed to create session", "error", err, "user_id", userID)
 		return nil, err
 	}
@@ -71,6 +76,31 @@ func (c *SessionController) RoutesStart(ctx context.Context, request generated.R
 	}, nil
 }
 
+// sessionLimitResponse maps a concurrent session limit to 402 when a plan
+// upgrade would lift it, and to 409 when an active session has to be stopped.
+func sessionLimitResponse(limitErr *service.SessionLimitError) generated.RoutesStartResponseObject {
+	hint := "stop an active session to start a new one"
+	if limitErr.UpgradeAvailable {
+		hint = "stop an active session or upgrade your plan"
+	}
+
+	code := "SESSION_LIMIT_REACHED"
+	body := generated.CommonErrorResponse{
+		Error: struct {
+			Code    *string `json:"code,omitempty"`
+			Message string  `json:"message"`
+		}{
+			Code:    &code,
+			Message: fmt.Sprintf("your plan allows %d concurrent session(s); %s", limitErr.Limit, hint),
+		},
+	}
+
+	if limitErr.UpgradeAvailable {
+		return generated.RoutesStart402JSONResponse(body)
+	}
+	return generated.RoutesStart409JSONResponse(body)
+}
+
 // RoutesList handles GET /v1/sessions
 func (c *SessionController) RoutesList(ctx context.Context, request generated.RoutesListRequestObject) (generated.RoutesListResponseObject, error) {
 	userID, err := c.extractUserID(request.Params.Authorization)
@@ -98,6 +98,27 @@ func TestSessionController_RoutesStart(t *testing.T) {
 	})
 }
 
+func TestSessionLimitResponse(t *testing.T) {
+	t.Run("upgradable plan returns 402", func(t *testing.T) {
+		resp := sessionLimitResponse(&service.SessionLimitError{PlanID: "free", Limit: 1, Active: 1, UpgradeAvailable: true})
+
+		body, ok := resp.(generated.RoutesStart402JSONResponse)
+		require.True(t, ok, "expected 402 response")
+		require.NotNil(t, body.Error.Code)
+		assert.Equal(t, "SESSION_LIMIT_REACHED", *body.Error.Code)
+		assert.Contains(t, body.Error.Message, "upgrade")
+	})
+
+	t.Run("top plan returns 409", func(t *testing.T) {
+		resp := sessionLimitResponse(&service.SessionLimitError{PlanID: "pro", Limit: 5, Active: 5})
+
+		body, ok := resp.(generated.RoutesStart409JSONResponse)
+		require.True(t, ok, "expected 409 response")
+		require.NotNil(t, body.Error.Code)
+		assert.Equal(t, "SESSION_LIMIT_REACHED", *body.Error.Code)
+	})
+}
+
 // ==================== List Tests ====================
 
 func TestSessionController_RoutesList(t *testing.T) {
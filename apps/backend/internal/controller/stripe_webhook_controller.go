@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mindhit/api/internal/controller/response"
+	"github.com/mindhit/api/internal/service"
+)
+
+// maxWebhookBodyBytes caps the webhook payload size, as Stripe recommends.
+const maxWebhookBodyBytes = 65536
+
+// StripeWebhookController receives Stripe webhooks. It is a plain gin handler
+// because the signature has to be checked against the raw request body.
+type StripeWebhookController struct {
+	stripeService *service.StripeService
+}
+
+// NewStripeWebhookController creates a new StripeWebhookController.
+func NewStripeWebhookController(stripeService *service.StripeService) *StripeWebhookController {
+	return &StripeWebhookController{stripeService: stripeService}
+}
+
+// Handle handles POST /v1/webhooks/stripe. Failures other than a bad
+// signature return 500 so that Stripe redelivers the event.
+func (c *StripeWebhookController) Handle(ctx *gin.Context) {
+	payload, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxWebhookBodyBytes))
+	if err != nil {
+		response.BadRequest(ctx, "failed to read request body", nil)
+		return
+	}
+
+	err = c.stripeService.HandleWebhook(ctx.Request.Context(), payload, ctx.GetHeader("Stripe-Signature"))
+	switch {
+	case errors.Is(err, service.ErrInvalidWebhookSignature):
+		slog.WarnContext(ctx, "rejected stripe webhook", "error", err)
+		response.BadRequest(ctx, "invalid signature", nil)
+	case errors.Is(err, service.ErrBillingNotConfigured):
+		response.ServiceUnavailable(ctx, err.Error())
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to handle stripe webhook", "error", err)
+		response.InternalError(ctx)
+	default:
+		ctx.JSON(http.StatusOK, gin.H{"received": true})
+	}
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mindhit/api/internal/service"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+func serveStripeWebhook(stripeService *service.StripeService, payload []byte, signature string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/v1/webhooks/stripe", NewStripeWebhookController(stripeService).Handle)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/webhooks/stripe", bytes.NewReader(payload))
+	req.Header.Set("Stripe-Signature", signature)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestStripeWebhookController_Handle(t *testing.T) {
+	payload, signature := testutil.SignedStripeEvent(t, "whsec_other", "evt_test", "invoice.payment_failed", time.Now(),
+		map[string]any{"id": "in_test", "object": "invoice", "subscription": "sub_test"})
+
+	t.Run("invalid signature returns 400", func(t *testing.T) {
+		stripeService := service.NewStripeService(nil, nil, service.StripeConfig{
+			SecretKey:     "sk_test_fake",
+			WebhookSecret: "whsec_test",
+		})
+
+		w := serveStripeWebhook(stripeService, payload, signature)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("billing not configured returns 503", func(t *testing.T) {
+		stripeService := service.NewStripeService(nil, nil, service.StripeConfig{})
+
+		w := serveStripeWebhook(stripeService, payload, signature)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+}
@@ -17,13 +17,15 @@ import (
 // SubscriptionController implements subscription-related handlers from StrictServerInterface.
 type SubscriptionController struct {
 	subscriptionService *service.SubscriptionService
+	stripeService       *service.StripeService
 	jwtService          *service.JWTService
 }
 
 // NewSubscriptionController creates a new SubscriptionController.
-func NewSubscriptionController(subscriptionService *service.SubscriptionService, jwtService *service.JWTService) *SubscriptionController {
+func NewSubscriptionController(subscriptionService *service.SubscriptionService, stripeService *service.StripeService, jwtService *service.JWTService) *SubscriptionController {
 	return &SubscriptionController{
 		subscriptionService: subscriptionService,
+		stripeService:       stripeService,
 		jwtService:          jwtService,
 	}
 }
@@ -102,6 +104,60 @@ func (c *SubscriptionController) SubscriptionRoutesListPlans(ctx context.Context
 	}, nil
 }
 
+// SubscriptionRoutesCreateCheckout handles POST /v1/subscription/checkout.
+func (c *SubscriptionController) SubscriptionRoutesCreateCheckout(ctx context.Context, request generated.SubscriptionRoutesCreateCheckoutRequestObject) (generated.SubscriptionRoutesCreateCheckoutResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.SubscriptionRoutesCreateCheckout401JSONResponse(billingError("", err.Error())), nil
+	}
+
+	url, err := c.stripeService.CreateCheckoutSession(ctx, userID, request.Body.PlanId)
+	switch {
+	case errors.Is(err, service.ErrPlanNotPurchasable):
+		return generated.SubscriptionRoutesCreateCheckout400JSONResponse(billingError("PLAN_NOT_PURCHASABLE", err.Error())), nil
+	case errors.Is(err, service.ErrAlreadySubscribed):
+		return generated.SubscriptionRoutesCreateCheckout409JSONResponse(billingError("ALREADY_SUBSCRIBED", "manage your current subscription from the billing portal")), nil
+	case errors.Is(err, service.ErrBillingNotConfigured):
+		return generated.SubscriptionRoutesCreateCheckout503JSONResponse(billingError("BILLING_UNAVAILABLE", err.Error())), nil
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to create checkout session", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return generated.SubscriptionRoutesCreateCheckout200JSONResponse{Url: url}, nil
+}
+
+// SubscriptionRoutesCreatePortal handles POST /v1/subscription/portal.
+func (c *SubscriptionController) SubscriptionRoutesCreatePortal(ctx context.Context, request generated.SubscriptionRoutesCreatePortalRequestObject) (generated.SubscriptionRoutesCreatePortalResponseObject, error) {
+	userID, err := c.extractUserID(request.Params.Authorization)
+	if err != nil {
+		return generated.SubscriptionRoutesCreatePortal401JSONResponse(billingError("", err.Error())), nil
+	}
+
+	url, err := c.stripeService.CreatePortalSession(ctx, userID)
+	switch {
+	case errors.Is(err, service.ErrNoBillingAccount):
+		return generated.SubscriptionRoutesCreatePortal404JSONResponse(billingError("NO_BILLING_ACCOUNT", err.Error())), nil
+	case errors.Is(err, service.ErrBillingNotConfigured):
+		return generated.SubscriptionRoutesCreatePortal503JSONResponse(billingError("BILLING_UNAVAILABLE", err.Error())), nil
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to create portal session", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return generated.SubscriptionRoutesCreatePortal200JSONResponse{Url: url}, nil
+}
+
+// billingError builds an error body, leaving the code out when it is empty.
+func billingError(code, message string) generated.CommonErrorResponse {
+	body := generated.CommonErrorResponse{}
+	body.Error.Message = message
+	if code != "" {
+		body.Error.Code = &code
+	}
+	return body
+}
+
 // mapSubscriptionInfo maps service.SubscriptionInfo to generated.SubscriptionSubscriptionInfo.
 func mapSubscriptionInfo(info *service.SubscriptionInfo) generated.SubscriptionSubscriptionInfo {
 	result := generated.SubscriptionSubscriptionInfo{
@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -22,6 +23,38 @@ import (
 	strictgin "github.com/oapi-codegen/runtime/strictmiddleware/gin"
 )
 
+// Defines values for MindmapLayoutType.
+const (
+	Force  MindmapLayoutType = "force"
+	Galaxy MindmapLayoutType = "galaxy"
+	Radial MindmapLayoutType = "radial"
+	Tree   MindmapLayoutType = "tree"
+)
+
+// Defines values for MindmapMindmapEditOp.
+const (
+	AddEdge  MindmapMindmapEditOp = "add_edge"
+	Delete   MindmapMindmapEditOp = "delete"
+	Merge    MindmapMindmapEditOp = "merge"
+	MovePage MindmapMindmapEditOp = "move_page"
+	Note     MindmapMindmapEditOp = "note"
+	Pin      MindmapMindmapEditOp = "pin"
+	Recolor  MindmapMindmapEditOp = "recolor"
+	Rename   MindmapMindmapEditOp = "rename"
+	Split    MindmapMindmapEditOp = "split"
+)
+
+// Defines values for MindmapMindmapExportFormat.
+const (
+	Canvas   MindmapMindmapExportFormat = "canvas"
+	Dot      MindmapMindmapExportFormat = "dot"
+	Freemind MindmapMindmapExportFormat = "freemind"
+	Graphml  MindmapMindmapExportFormat = "graphml"
+	Markdown MindmapMindmapExportFormat = "markdown"
+	Opml     MindmapMindmapExportFormat = "opml"
+	Svg      MindmapMindmapExportFormat = "svg"
+)
+
 // Defines values for MindmapMindmapStatus.
 const (
 	MindmapMindmapStatusCompleted  MindmapMindmapStatus = "completed"
@@ -41,7 +74,8 @@ const (
 
 // AuthAuthResponse 인증 응답
 type AuthAuthResponse struct {
-	Token string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	Token        string `json:"token"`
 
 	// User 사용자 정보
 	User AuthUser `json:"user"`
@@ -96,8 +130,17 @@ type AuthSignupRequest struct {
 type AuthUser struct {
 	CreatedAt time.Time `json:"created_at"`
 	Email     string    `json:"email"`
-	Id        string    `json:"id"`
-	UpdatedAt time.Time `json:"updated_at"`
+
+	// EmailVerified 이메일 인증 여부
+	EmailVerified bool      `json:"email_verified"`
+	Id            string    `json:"id"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// AuthVerifyEmailRequest 이메일 인증 완료 요청
+type AuthVerifyEmailRequest struct {
+	// Token 인증 토큰
+	Token string `json:"token"`
 }
 
 // CommonErrorResponse 기본 에러 응답
@@ -142,12 +185,21 @@ type EventsEventData struct {
 	// Color 하이라이트 색상
 	Color *string `json:"color,omitempty"`
 
+	// DurationMs 페이지 체류 시간 (ms, page_leave)
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+
+	// MaxScrollDepth 최대 스크롤 깊이 (0-1, page_leave)
+	MaxScrollDepth *float64 `json:"max_scroll_depth,omitempty"`
+
 	// Metadata 추가 메타데이터 (JSON)
 	Metadata *string `json:"metadata,omitempty"`
 
 	// Note 사용자 노트
 	Note *string `json:"note,omitempty"`
 
+	// ScrollDepth 현재 스크롤 깊이 (0-1, scroll)
+	ScrollDepth *float64 `json:"scroll_depth,omitempty"`
+
 	// Selector CSS 선택자
 	Selector *string `json:"selector,omitempty"`
 
@@ -201,12 +253,147 @@ type EventsPageVisit struct {
 	VisitedAt  time.Time `json:"visited_at"`
 }
 
+// KnowledgeKnowledgeContribution 세션이 토픽에 기여한 내용
+type KnowledgeKnowledgeContribution struct {
+	Keywords []string `json:"keywords"`
+
+	// Labels 세션의 마인드맵에서 토픽의 이름
+	Labels []string `json:"labels"`
+
+	// NewUrlCount 세션이 토픽에 처음 가져온 페이지 수
+	NewUrlCount  int32                   `json:"new_url_count"`
+	SessionId    string                  `json:"session_id"`
+	SessionTitle *string                 `json:"session_title,omitempty"`
+	StartedAt    time.Time               `json:"started_at"`
+	Urls         []KnowledgeKnowledgeUrl `json:"urls"`
+}
+
+// KnowledgeKnowledgeLink 지식 그래프 토픽 간 연결
+type KnowledgeKnowledgeLink struct {
+	SharedKeywords []string `json:"shared_keywords"`
+	SharedSessions int32    `json:"shared_sessions"`
+	SharedUrls     int32    `json:"shared_urls"`
+	Source         string   `json:"source"`
+	Target         string   `json:"target"`
+
+	// Weight 공유 세션 수 + 공유 페이지 수 + 공유 키워드 수 / 2
+	Weight float64 `json:"weight"`
+}
+
+// KnowledgeKnowledgeNeighbor 지식 그래프 이웃 토픽
+type KnowledgeKnowledgeNeighbor struct {
+	// Depth 중심 토픽에서 떨어진 링크 수
+	Depth int32 `json:"depth"`
+
+	// Topic 지식 그래프 토픽 (세션들의 마인드맵 토픽을 합친 것)
+	Topic KnowledgeKnowledgeTopic `json:"topic"`
+}
+
+// KnowledgeKnowledgeNeighborhoodResponse 지식 그래프 이웃 응답
+type KnowledgeKnowledgeNeighborhoodResponse struct {
+	// Center 지식 그래프 토픽 (세션들의 마인드맵 토픽을 합친 것)
+	Center    KnowledgeKnowledgeTopic      `json:"center"`
+	Links     []KnowledgeKnowledgeLink     `json:"links"`
+	Neighbors []KnowledgeKnowledgeNeighbor `json:"neighbors"`
+
+	// Urls 여러 토픽이나 세션에 반복해서 나온 페이지
+	Urls []KnowledgeKnowledgeUrl `json:"urls"`
+}
+
+// KnowledgeKnowledgeTimelineResponse 지식 그래프 토픽 타임라인 응답
+type KnowledgeKnowledgeTimelineResponse struct {
+	// Entries 토픽을 다룬 세션 (오래된 순)
+	Entries []KnowledgeKnowledgeContribution `json:"entries"`
+
+	// Topic 지식 그래프 토픽 (세션들의 마인드맵 토픽을 합친 것)
+	Topic KnowledgeKnowledgeTopic `json:"topic"`
+}
+
+// KnowledgeKnowledgeTopic 지식 그래프 토픽 (세션들의 마인드맵 토픽을 합친 것)
+type KnowledgeKnowledgeTopic struct {
+	FirstSeenAt time.Time `json:"first_seen_at"`
+	Id          string    `json:"id"`
+	Keywords    []string  `json:"keywords"`
+	Label       string    `json:"label"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+
+	// SessionCount 토픽을 다룬 세션 수
+	SessionCount int32 `json:"session_count"`
+
+	// UrlCount 토픽에 속한 페이지 수
+	UrlCount int32 `json:"url_count"`
+}
+
+// KnowledgeKnowledgeTopicListResponse 지식 그래프 토픽 목록 응답
+type KnowledgeKnowledgeTopicListResponse struct {
+	Topics []KnowledgeKnowledgeTopic `json:"topics"`
+}
+
+// KnowledgeKnowledgeTopicResponse 지식 그래프 토픽 응답
+type KnowledgeKnowledgeTopicResponse struct {
+	// Sessions 토픽에 기여한 세션 (최신순)
+	Sessions []KnowledgeKnowledgeContribution `json:"sessions"`
+
+	// Topic 지식 그래프 토픽 (세션들의 마인드맵 토픽을 합친 것)
+	Topic KnowledgeKnowledgeTopic `json:"topic"`
+}
+
+// KnowledgeKnowledgeUrl 지식 그래프 페이지
+type KnowledgeKnowledgeUrl struct {
+	Id string `json:"id"`
+
+	// SessionCount 페이지가 나온 세션 수 (이웃 조회)
+	SessionCount *int32  `json:"session_count,omitempty"`
+	Title        *string `json:"title,omitempty"`
+
+	// TopicIds 페이지가 속한 토픽 (이웃 조회)
+	TopicIds *[]string `json:"topic_ids,omitempty"`
+	Url      string    `json:"url"`
+}
+
+// MindmapDiffPageMove 다른 토픽으로 옮겨진 페이지
+type MindmapDiffPageMove struct {
+	// From 리비전 비교의 토픽
+	From  MindmapDiffTopic `json:"from"`
+	Id    string           `json:"id"`
+	Label string           `json:"label"`
+
+	// To 리비전 비교의 토픽
+	To MindmapDiffTopic `json:"to"`
+}
+
+// MindmapDiffTopic 리비전 비교의 토픽
+type MindmapDiffTopic struct {
+	Id    string `json:"id"`
+	Label string `json:"label"`
+}
+
+// MindmapDiffTopicRename 이름이 바뀐 토픽
+type MindmapDiffTopicRename struct {
+	// From 리비전 비교의 토픽
+	From MindmapDiffTopic `json:"from"`
+
+	// Similarity 라벨 유사도 (0~1)
+	Similarity float64 `json:"similarity"`
+
+	// To 리비전 비교의 토픽
+	To MindmapDiffTopic `json:"to"`
+}
+
+// MindmapEditMindmapRequest 마인드맵 편집 요청 (모두 적용되거나 하나도 적용되지 않음)
+type MindmapEditMindmapRequest struct {
+	Edits []MindmapMindmapEdit `json:"edits"`
+}
+
 // MindmapGenerateMindmapRequest 마인드맵 생성 요청
 type MindmapGenerateMindmapRequest struct {
 	// Force 강제 재생성 여부
 	Force *bool `json:"force,omitempty"`
 }
 
+// MindmapLayoutType 마인드맵 레이아웃 타입
+type MindmapLayoutType string
+
 // MindmapMindmap 마인드맵 정보
 type MindmapMindmap struct {
 	CreatedAt time.Time `json:"created_at"`
@@ -220,6 +407,9 @@ type MindmapMindmap struct {
 	// Status 마인드맵 상태
 	Status    MindmapMindmapStatus `json:"status"`
 	UpdatedAt time.Time            `json:"updated_at"`
+
+	// Version 현재 그래프의 버전
+	Version int32 `json:"version"`
 }
 
 // MindmapMindmapData 마인드맵 데이터
@@ -231,14 +421,86 @@ type MindmapMindmapData struct {
 	Nodes  []MindmapMindmapNode `json:"nodes"`
 }
 
+// MindmapMindmapDiff 두 리비전의 구조 비교
+type MindmapMindmapDiff struct {
+	AddedTopics   []MindmapDiffTopic       `json:"added_topics"`
+	From          int32                    `json:"from"`
+	MovedPages    []MindmapDiffPageMove    `json:"moved_pages"`
+	RemovedTopics []MindmapDiffTopic       `json:"removed_topics"`
+	RenamedTopics []MindmapDiffTopicRename `json:"renamed_topics"`
+	To            int32                    `json:"to"`
+}
+
+// MindmapMindmapDiffResponse 리비전 비교 응답
+type MindmapMindmapDiffResponse struct {
+	// Diff 두 리비전의 구조 비교
+	Diff MindmapMindmapDiff `json:"diff"`
+}
+
 // MindmapMindmapEdge 마인드맵 엣지
 type MindmapMindmapEdge struct {
-	Label  *string `json:"label,omitempty"`
+	Label *string `json:"label,omitempty"`
+
+	// Manual 사용자가 추가한 엣지인지 여부
+	Manual *bool   `json:"manual,omitempty"`
 	Source string  `json:"source"`
 	Target string  `json:"target"`
 	Weight float64 `json:"weight"`
 }
 
+// MindmapMindmapEdit 마인드맵 편집 (연산마다 쓰는 필드가 다름)
+type MindmapMindmapEdit struct {
+	// Color recolor: #RRGGBB 색상
+	Color *string `json:"color,omitempty"`
+
+	// Label rename, split: 토픽 이름, merge: 합쳐진 토픽의 새 이름, add_edge: 엣지 라벨
+	Label *string `json:"label,omitempty"`
+
+	// NodeId 대상 노드 (merge 제외)
+	NodeId *string `json:"node_id,omitempty"`
+
+	// NodeIds merge: 합칠 토픽들
+	NodeIds *[]string `json:"node_ids,omitempty"`
+
+	// Note note: 메모, 빈 값이면 삭제
+	Note *string `json:"note,omitempty"`
+
+	// Op 마인드맵 편집 연산
+	Op MindmapMindmapEditOp `json:"op"`
+
+	// PageIds split: 새 토픽으로 옮길 페이지들
+	PageIds *[]string `json:"page_ids,omitempty"`
+
+	// Pinned pin: 고정 여부. 고정된 토픽은 재생성 후에도 유지
+	Pinned *bool `json:"pinned,omitempty"`
+
+	// Position pin: 고정 위치
+	Position *MindmapPosition `json:"position,omitempty"`
+
+	// TargetId merge: 합쳐질 토픽, move_page: 옮길 토픽, add_edge: 연결할 노드
+	TargetId *string `json:"target_id,omitempty"`
+}
+
+// MindmapMindmapEditListResponse 편집 기록 목록 응답
+type MindmapMindmapEditListResponse struct {
+	Edits []MindmapMindmapEditRecord `json:"edits"`
+}
+
+// MindmapMindmapEditOp 마인드맵 편집 연산
+type MindmapMindmapEditOp string
+
+// MindmapMindmapEditRecord 편집 기록
+type MindmapMindmapEditRecord struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Edit 마인드맵 편집 (연산마다 쓰는 필드가 다름)
+	Edit MindmapMindmapEdit `json:"edit"`
+	Id   int32              `json:"id"`
+}
+
+// MindmapMindmapExportFormat 마인드맵 내보내기 형식
+type MindmapMindmapExportFormat string
+
 // MindmapMindmapLayout 마인드맵 레이아웃 설정
 type MindmapMindmapLayout struct {
 	Params *map[string]interface{} `json:"params,omitempty"`
@@ -264,6 +526,46 @@ type MindmapMindmapResponse struct {
 	Mindmap MindmapMindmap `json:"mindmap"`
 }
 
+// MindmapMindmapRevision 마인드맵 리비전
+type MindmapMindmapRevision struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current 현재 그래프인지 여부
+	Current bool `json:"current"`
+
+	// Data 마인드맵 데이터
+	Data        MindmapMindmapData `json:"data"`
+	GeneratedAt time.Time          `json:"generated_at"`
+
+	// RestoredFrom 복원으로 만들어진 리비전이면 복원한 버전
+	RestoredFrom *int32 `json:"restored_from,omitempty"`
+	Version      int32  `json:"version"`
+}
+
+// MindmapMindmapRevisionListResponse 마인드맵 리비전 목록 응답
+type MindmapMindmapRevisionListResponse struct {
+	Revisions []MindmapMindmapRevisionSummary `json:"revisions"`
+}
+
+// MindmapMindmapRevisionResponse 마인드맵 리비전 응답
+type MindmapMindmapRevisionResponse struct {
+	// Revision 마인드맵 리비전
+	Revision MindmapMindmapRevision `json:"revision"`
+}
+
+// MindmapMindmapRevisionSummary 마인드맵 리비전 요약
+type MindmapMindmapRevisionSummary struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current 현재 그래프인지 여부
+	Current     bool      `json:"current"`
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// RestoredFrom 복원으로 만들어진 리비전이면 복원한 버전
+	RestoredFrom *int32 `json:"restored_from,omitempty"`
+	Version      int32  `json:"version"`
+}
+
 // MindmapMindmapStatus 마인드맵 상태
 type MindmapMindmapStatus string
 
@@ -274,6 +576,15 @@ type MindmapPosition struct {
 	Z float64 `json:"z"`
 }
 
+// MindmapRelayoutMindmapRequest 마인드맵 레이아웃 변경 요청
+type MindmapRelayoutMindmapRequest struct {
+	// Dimensions 2 또는 3, 생략하면 현재 차원 유지
+	Dimensions *int32 `json:"dimensions,omitempty"`
+
+	// Type 마인드맵 레이아웃 타입
+	Type MindmapLayoutType `json:"type"`
+}
+
 // SessionSession 세션 정보
 type SessionSession struct {
 	CreatedAt   time.Time  `json:"created_at"`
@@ -308,6 +619,16 @@ type SessionUpdateSessionRequest struct {
 	Title       *string `json:"title,omitempty"`
 }
 
+// SubscriptionBillingRedirectResponse Stripe 페이지 이동 URL 응답
+type SubscriptionBillingRedirectResponse struct {
+	Url string `json:"url"`
+}
+
+// SubscriptionCheckoutRequest 결제(Checkout) 세션 생성 요청
+type SubscriptionCheckoutRequest struct {
+	PlanId string `json:"plan_id"`
+}
+
 // SubscriptionPlan 플랜 정보
 type SubscriptionPlan struct {
 	BillingPeriod         string          `json:"billing_period"`
@@ -371,6 +692,11 @@ type RoutesLogoutParams struct {
 	Authorization string `json:"authorization"`
 }
 
+// RoutesLogoutAllParams defines parameters for RoutesLogoutAll.
+type RoutesLogoutAllParams struct {
+	Authorization string `json:"authorization"`
+}
+
 // RoutesDeleteMeParams defines parameters for RoutesDeleteMe.
 type RoutesDeleteMeParams struct {
 	Hard          *bool  `form:"hard,omitempty" json:"hard,omitempty"`
@@ -387,6 +713,35 @@ type RoutesRefreshParams struct {
 	Authorization string `json:"authorization"`
 }
 
+// RoutesResendVerificationParams defines parameters for RoutesResendVerification.
+type RoutesResendVerificationParams struct {
+	Authorization string `json:"authorization"`
+}
+
+// KnowledgeRoutesListTopicsParams defines parameters for KnowledgeRoutesListTopics.
+type KnowledgeRoutesListTopicsParams struct {
+	Q             *string `form:"q,omitempty" json:"q,omitempty"`
+	Limit         *int32  `form:"limit,omitempty" json:"limit,omitempty"`
+	Authorization string  `json:"authorization"`
+}
+
+// KnowledgeRoutesGetTopicParams defines parameters for KnowledgeRoutesGetTopic.
+type KnowledgeRoutesGetTopicParams struct {
+	Authorization string `json:"authorization"`
+}
+
+// KnowledgeRoutesGetNeighborhoodParams defines parameters for KnowledgeRoutesGetNeighborhood.
+type KnowledgeRoutesGetNeighborhoodParams struct {
+	Depth         *int32 `form:"depth,omitempty" json:"depth,omitempty"`
+	Limit         *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+	Authorization string `json:"authorization"`
+}
+
+// KnowledgeRoutesGetTimelineParams defines parameters for KnowledgeRoutesGetTimeline.
+type KnowledgeRoutesGetTimelineParams struct {
+	Authorization string `json:"authorization"`
+}
+
 // RoutesListParams defines parameters for RoutesList.
 type RoutesListParams struct {
 	Limit         *int32 `form:"limit,omitempty" json:"limit,omitempty"`
@@ -437,11 +792,54 @@ type MindmapRoutesGetMindmapParams struct {
 	Authorization string `json:"authorization"`
 }
 
+// MindmapRoutesEditMindmapParams defines parameters for MindmapRoutesEditMindmap.
+type MindmapRoutesEditMindmapParams struct {
+	Authorization string `json:"authorization"`
+}
+
+// MindmapRoutesDiffRevisionsParams defines parameters for MindmapRoutesDiffRevisions.
+type MindmapRoutesDiffRevisionsParams struct {
+	From          int32  `form:"from" json:"from"`
+	To            int32  `form:"to" json:"to"`
+	Authorization string `json:"authorization"`
+}
+
+// MindmapRoutesListEditsParams defines parameters for MindmapRoutesListEdits.
+type MindmapRoutesListEditsParams struct {
+	Authorization string `json:"authorization"`
+}
+
+// MindmapRoutesExportMindmapParams defines parameters for MindmapRoutesExportMindmap.
+type MindmapRoutesExportMindmapParams struct {
+	Format        MindmapMindmapExportFormat `form:"format" json:"format"`
+	Authorization string                     `json:"authorization"`
+}
+
 // MindmapRoutesGenerateMindmapParams defines parameters for MindmapRoutesGenerateMindmap.
 type MindmapRoutesGenerateMindmapParams struct {
 	Authorization string `json:"authorization"`
 }
 
+// MindmapRoutesRelayoutParams defines parameters for MindmapRoutesRelayout.
+type MindmapRoutesRelayoutParams struct {
+	Authorization string `json:"authorization"`
+}
+
+// MindmapRoutesListRevisionsParams defines parameters for MindmapRoutesListRevisions.
+type MindmapRoutesListRevisionsParams struct {
+	Authorization string `json:"authorization"`
+}
+
+// MindmapRoutesGetRevisionParams defines parameters for MindmapRoutesGetRevision.
+type MindmapRoutesGetRevisionParams struct {
+	Authorization string `json:"authorization"`
+}
+
+// MindmapRoutesRestoreRevisionParams defines parameters for MindmapRoutesRestoreRevision.
+type MindmapRoutesRestoreRevisionParams struct {
+	Authorization string `json:"authorization"`
+}
+
 // RoutesPauseParams defines parameters for RoutesPause.
 type RoutesPauseParams struct {
 	Authorization string `json:"authorization"`
@@ -462,11 +860,21 @@ type SubscriptionRoutesGetSubscriptionParams struct {
 	Authorization string `json:"authorization"`
 }
 
+// SubscriptionRoutesCreateCheckoutParams defines parameters for SubscriptionRoutesCreateCheckout.
+type SubscriptionRoutesCreateCheckoutParams struct {
+	Authorization string `json:"authorization"`
+}
+
 // SubscriptionRoutesListPlansParams defines parameters for SubscriptionRoutesListPlans.
 type SubscriptionRoutesListPlansParams struct {
 	Authorization string `json:"authorization"`
 }
 
+// SubscriptionRoutesCreatePortalParams defines parameters for SubscriptionRoutesCreatePortal.
+type SubscriptionRoutesCreatePortalParams struct {
+	Authorization string `json:"authorization"`
+}
+
 // UsageRoutesGetUsageParams defines parameters for UsageRoutesGetUsage.
 type UsageRoutesGetUsageParams struct {
 	Authorization string `json:"authorization"`
@@ -496,15 +904,27 @@ type RoutesResetPasswordJSONRequestBody = AuthResetPasswordRequest
 // RoutesSignupJSONRequestBody defines body for RoutesSignup for application/json ContentType.
 type RoutesSignupJSONRequestBody = AuthSignupRequest
 
+// RoutesVerifyEmailJSONRequestBody defines body for RoutesVerifyEmail for application/json ContentType.
+type RoutesVerifyEmailJSONRequestBody = AuthVerifyEmailRequest
+
 // RoutesUpdateJSONRequestBody defines body for RoutesUpdate for application/json ContentType.
 type RoutesUpdateJSONRequestBody = SessionUpdateSessionRequest
 
 // RoutesBatchEventsJSONRequestBody defines body for RoutesBatchEvents for application/json ContentType.
 type RoutesBatchEventsJSONRequestBody = EventsBatchEventsRequest
 
+// MindmapRoutesEditMindmapJSONRequestBody defines body for MindmapRoutesEditMindmap for application/json ContentType.
+type MindmapRoutesEditMindmapJSONRequestBody = MindmapEditMindmapRequest
+
 // MindmapRoutesGenerateMindmapJSONRequestBody defines body for MindmapRoutesGenerateMindmap for application/json ContentType.
 type MindmapRoutesGenerateMindmapJSONRequestBody = MindmapGenerateMindmapRequest
 
+// MindmapRoutesRelayoutJSONRequestBody defines body for MindmapRoutesRelayout for application/json ContentType.
+type MindmapRoutesRelayoutJSONRequestBody = MindmapRelayoutMindmapRequest
+
+// SubscriptionRoutesCreateCheckoutJSONRequestBody defines body for SubscriptionRoutesCreateCheckout for application/json ContentType.
+type SubscriptionRoutesCreateCheckoutJSONRequestBody = SubscriptionCheckoutRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -523,6 +943,9 @@ type ServerInterface interface {
 	// (POST /v1/auth/logout)
 	RoutesLogout(c *gin.Context, params RoutesLogoutParams)
 
+	// (POST /v1/auth/logout-all)
+	RoutesLogoutAll(c *gin.Context, params RoutesLogoutAllParams)
+
 	// (DELETE /v1/auth/me)
 	RoutesDeleteMe(c *gin.Context, params RoutesDeleteMeParams)
 
@@ -532,12 +955,30 @@ type ServerInterface interface {
 	// (POST /v1/auth/refresh)
 	RoutesRefresh(c *gin.Context, params RoutesRefreshParams)
 
+	// (POST /v1/auth/resend-verification)
+	RoutesResendVerification(c *gin.Context, params RoutesResendVerificationParams)
+
 	// (POST /v1/auth/reset-password)
 	RoutesResetPassword(c *gin.Context)
 
 	// (POST /v1/auth/signup)
 	RoutesSignup(c *gin.Context)
 
+	// (POST /v1/auth/verify-email)
+	RoutesVerifyEmail(c *gin.Context)
+
+	// (GET /v1/knowledge/topics)
+	KnowledgeRoutesListTopics(c *gin.Context, params KnowledgeRoutesListTopicsParams)
+
+	// (GET /v1/knowledge/topics/{id})
+	KnowledgeRoutesGetTopic(c *gin.Context, id string, params KnowledgeRoutesGetTopicParams)
+
+	// (GET /v1/knowledge/topics/{id}/neighborhood)
+	KnowledgeRoutesGetNeighborhood(c *gin.Context, id string, params KnowledgeRoutesGetNeighborhoodParams)
+
+	// (GET /v1/knowledge/topics/{id}/timeline)
+	KnowledgeRoutesGetTimeline(c *gin.Context, id string, params KnowledgeRoutesGetTimelineParams)
+
 	// (GET /v1/sessions)
 	RoutesList(c *gin.Context, params RoutesListParams)
 
@@ -565,9 +1006,33 @@ type ServerInterface interface {
 	// (GET /v1/sessions/{id}/mindmap)
 	MindmapRoutesGetMindmap(c *gin.Context, id string, params MindmapRoutesGetMindmapParams)
 
+	// (PATCH /v1/sessions/{id}/mindmap)
+	MindmapRoutesEditMindmap(c *gin.Context, id string, params MindmapRoutesEditMindmapParams)
+
+	// (GET /v1/sessions/{id}/mindmap/diff)
+	MindmapRoutesDiffRevisions(c *gin.Context, id string, params MindmapRoutesDiffRevisionsParams)
+
+	// (GET /v1/sessions/{id}/mindmap/edits)
+	MindmapRoutesListEdits(c *gin.Context, id string, params MindmapRoutesListEditsParams)
+
+	// (GET /v1/sessions/{id}/mindmap/export)
+	MindmapRoutesExportMindmap(c *gin.Context, id string, params MindmapRoutesExportMindmapParams)
+
 	// (POST /v1/sessions/{id}/mindmap/generate)
 	MindmapRoutesGenerateMindmap(c *gin.Context, id string, params MindmapRoutesGenerateMindmapParams)
 
+	// (POST /v1/sessions/{id}/mindmap/layout)
+	MindmapRoutesRelayout(c *gin.Context, id string, params MindmapRoutesRelayoutParams)
+
+	// (GET /v1/sessions/{id}/mindmap/revisions)
+	MindmapRoutesListRevisions(c *gin.Context, id string, params MindmapRoutesListRevisionsParams)
+
+	// (GET /v1/sessions/{id}/mindmap/revisions/{version})
+	MindmapRoutesGetRevision(c *gin.Context, id string, version int32, params MindmapRoutesGetRevisionParams)
+
+	// (POST /v1/sessions/{id}/mindmap/revisions/{version}/restore)
+	MindmapRoutesRestoreRevision(c *gin.Context, id string, version int32, params MindmapRoutesRestoreRevisionParams)
+
 	// (PATCH /v1/sessions/{id}/pause)
 	RoutesPause(c *gin.Context, id string, params RoutesPauseParams)
 
@@ -580,9 +1045,15 @@ type ServerInterface interface {
 	// (GET /v1/subscription)
 	SubscriptionRoutesGetSubscription(c *gin.Context, params SubscriptionRoutesGetSubscriptionParams)
 
+	// (POST /v1/subscription/checkout)
+	SubscriptionRoutesCreateCheckout(c *gin.Context, params SubscriptionRoutesCreateCheckoutParams)
+
 	// (GET /v1/subscription/plans)
 	SubscriptionRoutesListPlans(c *gin.Context, params SubscriptionRoutesListPlansParams)
 
+	// (POST /v1/subscription/portal)
+	SubscriptionRoutesCreatePortal(c *gin.Context, params SubscriptionRoutesCreatePortalParams)
+
 	// (GET /v1/usage)
 	UsageRoutesGetUsage(c *gin.Context, params UsageRoutesGetUsageParams)
 
@@ -693,6 +1164,48 @@ func (siw *ServerInterfaceWrapper) RoutesLogout(c *gin.Context) {
 	siw.Handler.RoutesLogout(c, params)
 }
 
+// RoutesLogoutAll operation middleware
+func (siw *ServerInterfaceWrapper) RoutesLogoutAll(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoutesLogoutAllParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RoutesLogoutAll(c, params)
+}
+
 // RoutesDeleteMe operation middleware
 func (siw *ServerInterfaceWrapper) RoutesDeleteMe(c *gin.Context) {
 
@@ -827,8 +1340,37 @@ func (siw *ServerInterfaceWrapper) RoutesRefresh(c *gin.Context) {
 	siw.Handler.RoutesRefresh(c, params)
 }
 
-// RoutesResetPassword operation middleware
-func (siw *ServerInterfaceWrapper) RoutesResetPassword(c *gin.Context) {
+// RoutesResendVerification operation middleware
+func (siw *ServerInterfaceWrapper) RoutesResendVerification(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoutesResendVerificationParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -837,11 +1379,11 @@ func (siw *ServerInterfaceWrapper) RoutesResetPassword(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesResetPassword(c)
+	siw.Handler.RoutesResendVerification(c, params)
 }
 
-// RoutesSignup operation middleware
-func (siw *ServerInterfaceWrapper) RoutesSignup(c *gin.Context) {
+// RoutesResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) RoutesResetPassword(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -850,56 +1392,24 @@ func (siw *ServerInterfaceWrapper) RoutesSignup(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesSignup(c)
+	siw.Handler.RoutesResetPassword(c)
 }
 
-// RoutesList operation middleware
-func (siw *ServerInterfaceWrapper) RoutesList(c *gin.Context) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesListParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "offset", c.Request.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	headers := c.Request.Header
-
-	// ------------- Required header parameter "authorization" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
-		var Authorization string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
-			return
-		}
+// RoutesSignup operation middleware
+func (siw *ServerInterfaceWrapper) RoutesSignup(c *gin.Context) {
 
-		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
 			return
 		}
+	}
 
-		params.Authorization = Authorization
+	siw.Handler.RoutesSignup(c)
+}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
-		return
-	}
+// RoutesVerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) RoutesVerifyEmail(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -908,16 +1418,32 @@ func (siw *ServerInterfaceWrapper) RoutesList(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesList(c, params)
+	siw.Handler.RoutesVerifyEmail(c)
 }
 
-// RoutesStart operation middleware
-func (siw *ServerInterfaceWrapper) RoutesStart(c *gin.Context) {
+// KnowledgeRoutesListTopics operation middleware
+func (siw *ServerInterfaceWrapper) KnowledgeRoutesListTopics(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesStartParams
+	var params KnowledgeRoutesListTopicsParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	headers := c.Request.Header
 
@@ -950,11 +1476,11 @@ func (siw *ServerInterfaceWrapper) RoutesStart(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesStart(c, params)
+	siw.Handler.KnowledgeRoutesListTopics(c, params)
 }
 
-// RoutesDelete operation middleware
-func (siw *ServerInterfaceWrapper) RoutesDelete(c *gin.Context) {
+// KnowledgeRoutesGetTopic operation middleware
+func (siw *ServerInterfaceWrapper) KnowledgeRoutesGetTopic(c *gin.Context) {
 
 	var err error
 
@@ -968,7 +1494,7 @@ func (siw *ServerInterfaceWrapper) RoutesDelete(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesDeleteParams
+	var params KnowledgeRoutesGetTopicParams
 
 	headers := c.Request.Header
 
@@ -1001,11 +1527,11 @@ func (siw *ServerInterfaceWrapper) RoutesDelete(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesDelete(c, id, params)
+	siw.Handler.KnowledgeRoutesGetTopic(c, id, params)
 }
 
-// RoutesGet operation middleware
-func (siw *ServerInterfaceWrapper) RoutesGet(c *gin.Context) {
+// KnowledgeRoutesGetNeighborhood operation middleware
+func (siw *ServerInterfaceWrapper) KnowledgeRoutesGetNeighborhood(c *gin.Context) {
 
 	var err error
 
@@ -1019,7 +1545,23 @@ func (siw *ServerInterfaceWrapper) RoutesGet(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesGetParams
+	var params KnowledgeRoutesGetNeighborhoodParams
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "depth", c.Request.URL.Query(), &params.Depth)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter depth: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	headers := c.Request.Header
 
@@ -1052,11 +1594,11 @@ func (siw *ServerInterfaceWrapper) RoutesGet(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesGet(c, id, params)
+	siw.Handler.KnowledgeRoutesGetNeighborhood(c, id, params)
 }
 
-// RoutesUpdate operation middleware
-func (siw *ServerInterfaceWrapper) RoutesUpdate(c *gin.Context) {
+// KnowledgeRoutesGetTimeline operation middleware
+func (siw *ServerInterfaceWrapper) KnowledgeRoutesGetTimeline(c *gin.Context) {
 
 	var err error
 
@@ -1070,7 +1612,7 @@ func (siw *ServerInterfaceWrapper) RoutesUpdate(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesUpdateParams
+	var params KnowledgeRoutesGetTimelineParams
 
 	headers := c.Request.Header
 
@@ -1103,33 +1645,16 @@ func (siw *ServerInterfaceWrapper) RoutesUpdate(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesUpdate(c, id, params)
+	siw.Handler.KnowledgeRoutesGetTimeline(c, id, params)
 }
 
-// RoutesListEvents operation middleware
-func (siw *ServerInterfaceWrapper) RoutesListEvents(c *gin.Context) {
+// RoutesList operation middleware
+func (siw *ServerInterfaceWrapper) RoutesList(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesListEventsParams
-
-	// ------------- Optional query parameter "type" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "type", c.Request.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
-		return
-	}
+	var params RoutesListParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -1178,25 +1703,16 @@ func (siw *ServerInterfaceWrapper) RoutesListEvents(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesListEvents(c, id, params)
+	siw.Handler.RoutesList(c, params)
 }
 
-// RoutesBatchEvents operation middleware
-func (siw *ServerInterfaceWrapper) RoutesBatchEvents(c *gin.Context) {
+// RoutesStart operation middleware
+func (siw *ServerInterfaceWrapper) RoutesStart(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesBatchEventsParams
+	var params RoutesStartParams
 
 	headers := c.Request.Header
 
@@ -1229,11 +1745,11 @@ func (siw *ServerInterfaceWrapper) RoutesBatchEvents(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesBatchEvents(c, id, params)
+	siw.Handler.RoutesStart(c, params)
 }
 
-// RoutesGetEventStats operation middleware
-func (siw *ServerInterfaceWrapper) RoutesGetEventStats(c *gin.Context) {
+// RoutesDelete operation middleware
+func (siw *ServerInterfaceWrapper) RoutesDelete(c *gin.Context) {
 
 	var err error
 
@@ -1247,7 +1763,7 @@ func (siw *ServerInterfaceWrapper) RoutesGetEventStats(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesGetEventStatsParams
+	var params RoutesDeleteParams
 
 	headers := c.Request.Header
 
@@ -1280,11 +1796,11 @@ func (siw *ServerInterfaceWrapper) RoutesGetEventStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesGetEventStats(c, id, params)
+	siw.Handler.RoutesDelete(c, id, params)
 }
 
-// MindmapRoutesGetMindmap operation middleware
-func (siw *ServerInterfaceWrapper) MindmapRoutesGetMindmap(c *gin.Context) {
+// RoutesGet operation middleware
+func (siw *ServerInterfaceWrapper) RoutesGet(c *gin.Context) {
 
 	var err error
 
@@ -1298,7 +1814,7 @@ func (siw *ServerInterfaceWrapper) MindmapRoutesGetMindmap(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params MindmapRoutesGetMindmapParams
+	var params RoutesGetParams
 
 	headers := c.Request.Header
 
@@ -1331,11 +1847,11 @@ func (siw *ServerInterfaceWrapper) MindmapRoutesGetMindmap(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MindmapRoutesGetMindmap(c, id, params)
+	siw.Handler.RoutesGet(c, id, params)
 }
 
-// MindmapRoutesGenerateMindmap operation middleware
-func (siw *ServerInterfaceWrapper) MindmapRoutesGenerateMindmap(c *gin.Context) {
+// RoutesUpdate operation middleware
+func (siw *ServerInterfaceWrapper) RoutesUpdate(c *gin.Context) {
 
 	var err error
 
@@ -1349,7 +1865,7 @@ func (siw *ServerInterfaceWrapper) MindmapRoutesGenerateMindmap(c *gin.Context)
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params MindmapRoutesGenerateMindmapParams
+	var params RoutesUpdateParams
 
 	headers := c.Request.Header
 
@@ -1382,11 +1898,11 @@ func (siw *ServerInterfaceWrapper) MindmapRoutesGenerateMindmap(c *gin.Context)
 		}
 	}
 
-	siw.Handler.MindmapRoutesGenerateMindmap(c, id, params)
+	siw.Handler.RoutesUpdate(c, id, params)
 }
 
-// RoutesPause operation middleware
-func (siw *ServerInterfaceWrapper) RoutesPause(c *gin.Context) {
+// RoutesListEvents operation middleware
+func (siw *ServerInterfaceWrapper) RoutesListEvents(c *gin.Context) {
 
 	var err error
 
@@ -1400,7 +1916,31 @@ func (siw *ServerInterfaceWrapper) RoutesPause(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesPauseParams
+	var params RoutesListEventsParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	headers := c.Request.Header
 
@@ -1433,11 +1973,11 @@ func (siw *ServerInterfaceWrapper) RoutesPause(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesPause(c, id, params)
+	siw.Handler.RoutesListEvents(c, id, params)
 }
 
-// RoutesResume operation middleware
-func (siw *ServerInterfaceWrapper) RoutesResume(c *gin.Context) {
+// RoutesBatchEvents operation middleware
+func (siw *ServerInterfaceWrapper) RoutesBatchEvents(c *gin.Context) {
 
 	var err error
 
@@ -1451,7 +1991,7 @@ func (siw *ServerInterfaceWrapper) RoutesResume(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesResumeParams
+	var params RoutesBatchEventsParams
 
 	headers := c.Request.Header
 
@@ -1484,11 +2024,11 @@ func (siw *ServerInterfaceWrapper) RoutesResume(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesResume(c, id, params)
+	siw.Handler.RoutesBatchEvents(c, id, params)
 }
 
-// RoutesStop operation middleware
-func (siw *ServerInterfaceWrapper) RoutesStop(c *gin.Context) {
+// RoutesGetEventStats operation middleware
+func (siw *ServerInterfaceWrapper) RoutesGetEventStats(c *gin.Context) {
 
 	var err error
 
@@ -1502,7 +2042,7 @@ func (siw *ServerInterfaceWrapper) RoutesStop(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoutesStopParams
+	var params RoutesGetEventStatsParams
 
 	headers := c.Request.Header
 
@@ -1535,16 +2075,25 @@ func (siw *ServerInterfaceWrapper) RoutesStop(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoutesStop(c, id, params)
+	siw.Handler.RoutesGetEventStats(c, id, params)
 }
 
-// SubscriptionRoutesGetSubscription operation middleware
-func (siw *ServerInterfaceWrapper) SubscriptionRoutesGetSubscription(c *gin.Context) {
+// MindmapRoutesGetMindmap operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesGetMindmap(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SubscriptionRoutesGetSubscriptionParams
+	var params MindmapRoutesGetMindmapParams
 
 	headers := c.Request.Header
 
@@ -1577,16 +2126,25 @@ func (siw *ServerInterfaceWrapper) SubscriptionRoutesGetSubscription(c *gin.Cont
 		}
 	}
 
-	siw.Handler.SubscriptionRoutesGetSubscription(c, params)
+	siw.Handler.MindmapRoutesGetMindmap(c, id, params)
 }
 
-// SubscriptionRoutesListPlans operation middleware
-func (siw *ServerInterfaceWrapper) SubscriptionRoutesListPlans(c *gin.Context) {
+// MindmapRoutesEditMindmap operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesEditMindmap(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SubscriptionRoutesListPlansParams
+	var params MindmapRoutesEditMindmapParams
 
 	headers := c.Request.Header
 
@@ -1619,25 +2177,64 @@ func (siw *ServerInterfaceWrapper) SubscriptionRoutesListPlans(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubscriptionRoutesListPlans(c, params)
+	siw.Handler.MindmapRoutesEditMindmap(c, id, params)
 }
 
-// UsageRoutesGetUsage operation middleware
-func (siw *ServerInterfaceWrapper) UsageRoutesGetUsage(c *gin.Context) {
+// MindmapRoutesDiffRevisions operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesDiffRevisions(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UsageRoutesGetUsageParams
+	var params MindmapRoutesDiffRevisionsParams
 
-	headers := c.Request.Header
+	// ------------- Required query parameter "from" -------------
 
-	// ------------- Required header parameter "authorization" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
-		var Authorization string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
 			return
 		}
 
@@ -1661,22 +2258,89 @@ func (siw *ServerInterfaceWrapper) UsageRoutesGetUsage(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UsageRoutesGetUsage(c, params)
+	siw.Handler.MindmapRoutesDiffRevisions(c, id, params)
 }
 
-// UsageRoutesGetUsageHistory operation middleware
-func (siw *ServerInterfaceWrapper) UsageRoutesGetUsageHistory(c *gin.Context) {
+// MindmapRoutesListEdits operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesListEdits(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UsageRoutesGetUsageHistoryParams
+	var params MindmapRoutesListEditsParams
 
-	// ------------- Optional query parameter "months" -------------
+	headers := c.Request.Header
 
-	err = runtime.BindQueryParameter("form", false, false, "months", c.Request.URL.Query(), &params.Months)
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MindmapRoutesListEdits(c, id, params)
+}
+
+// MindmapRoutesExportMindmap operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesExportMindmap(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter months: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MindmapRoutesExportMindmapParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := c.Query("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument format is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1711,803 +2375,2205 @@ func (siw *ServerInterfaceWrapper) UsageRoutesGetUsageHistory(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UsageRoutesGetUsageHistory(c, params)
+	siw.Handler.MindmapRoutesExportMindmap(c, id, params)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// MindmapRoutesGenerateMindmap operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesGenerateMindmap(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MindmapRoutesGenerateMindmapParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
 		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	router.POST(options.BaseURL+"/v1/auth/forgot-password", wrapper.RoutesForgotPassword)
-	router.POST(options.BaseURL+"/v1/auth/google", wrapper.RoutesGoogleAuth)
-	router.POST(options.BaseURL+"/v1/auth/google/code", wrapper.RoutesGoogleAuthCode)
-	router.POST(options.BaseURL+"/v1/auth/login", wrapper.RoutesLogin)
-	router.POST(options.BaseURL+"/v1/auth/logout", wrapper.RoutesLogout)
-	router.DELETE(options.BaseURL+"/v1/auth/me", wrapper.RoutesDeleteMe)
-	router.GET(options.BaseURL+"/v1/auth/me", wrapper.RoutesMe)
-	router.POST(options.BaseURL+"/v1/auth/refresh", wrapper.RoutesRefresh)
-	router.POST(options.BaseURL+"/v1/auth/reset-password", wrapper.RoutesResetPassword)
-	router.POST(options.BaseURL+"/v1/auth/signup", wrapper.RoutesSignup)
-	router.GET(options.BaseURL+"/v1/sessions", wrapper.RoutesList)
-	router.POST(options.BaseURL+"/v1/sessions/start", wrapper.RoutesStart)
-	router.DELETE(options.BaseURL+"/v1/sessions/:id", wrapper.RoutesDelete)
-	router.GET(options.BaseURL+"/v1/sessions/:id", wrapper.RoutesGet)
-	router.PUT(options.BaseURL+"/v1/sessions/:id", wrapper.RoutesUpdate)
-	router.GET(options.BaseURL+"/v1/sessions/:id/events", wrapper.RoutesListEvents)
-	router.POST(options.BaseURL+"/v1/sessions/:id/events", wrapper.RoutesBatchEvents)
-	router.GET(options.BaseURL+"/v1/sessions/:id/events/stats", wrapper.RoutesGetEventStats)
-	router.GET(options.BaseURL+"/v1/sessions/:id/mindmap", wrapper.MindmapRoutesGetMindmap)
-	router.POST(options.BaseURL+"/v1/sessions/:id/mindmap/generate", wrapper.MindmapRoutesGenerateMindmap)
-	router.PATCH(options.BaseURL+"/v1/sessions/:id/pause", wrapper.RoutesPause)
-	router.PATCH(options.BaseURL+"/v1/sessions/:id/resume", wrapper.RoutesResume)
-	router.POST(options.BaseURL+"/v1/sessions/:id/stop", wrapper.RoutesStop)
-	router.GET(options.BaseURL+"/v1/subscription", wrapper.SubscriptionRoutesGetSubscription)
-	router.GET(options.BaseURL+"/v1/subscription/plans", wrapper.SubscriptionRoutesListPlans)
-	router.GET(options.BaseURL+"/v1/usage", wrapper.UsageRoutesGetUsage)
-	router.GET(options.BaseURL+"/v1/usage/history", wrapper.UsageRoutesGetUsageHistory)
+	siw.Handler.MindmapRoutesGenerateMindmap(c, id, params)
 }
 
-type RoutesForgotPasswordRequestObject struct {
-	Body *RoutesForgotPasswordJSONRequestBody
+// MindmapRoutesRelayout operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesRelayout(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MindmapRoutesRelayoutParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MindmapRoutesRelayout(c, id, params)
 }
 
-type RoutesForgotPasswordResponseObject interface {
-	VisitRoutesForgotPasswordResponse(w http.ResponseWriter) error
+// MindmapRoutesListRevisions operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesListRevisions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MindmapRoutesListRevisionsParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MindmapRoutesListRevisions(c, id, params)
+}
+
+// MindmapRoutesGetRevision operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesGetRevision(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", c.Param("version"), &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MindmapRoutesGetRevisionParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MindmapRoutesGetRevision(c, id, version, params)
+}
+
+// MindmapRoutesRestoreRevision operation middleware
+func (siw *ServerInterfaceWrapper) MindmapRoutesRestoreRevision(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", c.Param("version"), &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MindmapRoutesRestoreRevisionParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MindmapRoutesRestoreRevision(c, id, version, params)
+}
+
+// RoutesPause operation middleware
+func (siw *ServerInterfaceWrapper) RoutesPause(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoutesPauseParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RoutesPause(c, id, params)
+}
+
+// RoutesResume operation middleware
+func (siw *ServerInterfaceWrapper) RoutesResume(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoutesResumeParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RoutesResume(c, id, params)
+}
+
+// RoutesStop operation middleware
+func (siw *ServerInterfaceWrapper) RoutesStop(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoutesStopParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RoutesStop(c, id, params)
+}
+
+// SubscriptionRoutesGetSubscription operation middleware
+func (siw *ServerInterfaceWrapper) SubscriptionRoutesGetSubscription(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubscriptionRoutesGetSubscriptionParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubscriptionRoutesGetSubscription(c, params)
+}
+
+// SubscriptionRoutesCreateCheckout operation middleware
+func (siw *ServerInterfaceWrapper) SubscriptionRoutesCreateCheckout(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubscriptionRoutesCreateCheckoutParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubscriptionRoutesCreateCheckout(c, params)
+}
+
+// SubscriptionRoutesListPlans operation middleware
+func (siw *ServerInterfaceWrapper) SubscriptionRoutesListPlans(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubscriptionRoutesListPlansParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubscriptionRoutesListPlans(c, params)
+}
+
+// SubscriptionRoutesCreatePortal operation middleware
+func (siw *ServerInterfaceWrapper) SubscriptionRoutesCreatePortal(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubscriptionRoutesCreatePortalParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubscriptionRoutesCreatePortal(c, params)
+}
+
+// UsageRoutesGetUsage operation middleware
+func (siw *ServerInterfaceWrapper) UsageRoutesGetUsage(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsageRoutesGetUsageParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UsageRoutesGetUsage(c, params)
+}
+
+// UsageRoutesGetUsageHistory operation middleware
+func (siw *ServerInterfaceWrapper) UsageRoutesGetUsageHistory(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsageRoutesGetUsageHistoryParams
+
+	// ------------- Optional query parameter "months" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "months", c.Request.URL.Query(), &params.Months)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter months: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter authorization is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UsageRoutesGetUsageHistory(c, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.POST(options.BaseURL+"/v1/auth/forgot-password", wrapper.RoutesForgotPassword)
+	router.POST(options.BaseURL+"/v1/auth/google", wrapper.RoutesGoogleAuth)
+	router.POST(options.BaseURL+"/v1/auth/google/code", wrapper.RoutesGoogleAuthCode)
+	router.POST(options.BaseURL+"/v1/auth/login", wrapper.RoutesLogin)
+	router.POST(options.BaseURL+"/v1/auth/logout", wrapper.RoutesLogout)
+	router.POST(options.BaseURL+"/v1/auth/logout-all", wrapper.RoutesLogoutAll)
+	router.DELETE(options.BaseURL+"/v1/auth/me", wrapper.RoutesDeleteMe)
+	router.GET(options.BaseURL+"/v1/auth/me", wrapper.RoutesMe)
+	router.POST(options.BaseURL+"/v1/auth/refresh", wrapper.RoutesRefresh)
+	router.POST(options.BaseURL+"/v1/auth/resend-verification", wrapper.RoutesResendVerification)
+	router.POST(options.BaseURL+"/v1/auth/reset-password", wrapper.RoutesResetPassword)
+	router.POST(options.BaseURL+"/v1/auth/signup", wrapper.RoutesSignup)
+	router.POST(options.BaseURL+"/v1/auth/verify-email", wrapper.RoutesVerifyEmail)
+	router.GET(options.BaseURL+"/v1/knowledge/topics", wrapper.KnowledgeRoutesListTopics)
+	router.GET(options.BaseURL+"/v1/knowledge/topics/:id", wrapper.KnowledgeRoutesGetTopic)
+	router.GET(options.BaseURL+"/v1/knowledge/topics/:id/neighborhood", wrapper.KnowledgeRoutesGetNeighborhood)
+	router.GET(options.BaseURL+"/v1/knowledge/topics/:id/timeline", wrapper.KnowledgeRoutesGetTimeline)
+	router.GET(options.BaseURL+"/v1/sessions", wrapper.RoutesList)
+	router.POST(options.BaseURL+"/v1/sessions/start", wrapper.RoutesStart)
+	router.DELETE(options.BaseURL+"/v1/sessions/:id", wrapper.RoutesDelete)
+	router.GET(options.BaseURL+"/v1/sessions/:id", wrapper.RoutesGet)
+	router.PUT(options.BaseURL+"/v1/sessions/:id", wrapper.RoutesUpdate)
+	router.GET(options.BaseURL+"/v1/sessions/:id/events", wrapper.RoutesListEvents)
+	router.POST(options.BaseURL+"/v1/sessions/:id/events", wrapper.RoutesBatchEvents)
+	router.GET(options.BaseURL+"/v1/sessions/:id/events/stats", wrapper.RoutesGetEventStats)
+	router.GET(options.BaseURL+"/v1/sessions/:id/mindmap", wrapper.MindmapRoutesGetMindmap)
+	router.PATCH(options.BaseURL+"/v1/sessions/:id/mindmap", wrapper.MindmapRoutesEditMindmap)
+	router.GET(options.BaseURL+"/v1/sessions/:id/mindmap/diff", wrapper.MindmapRoutesDiffRevisions)
+	router.GET(options.BaseURL+"/v1/sessions/:id/mindmap/edits", wrapper.MindmapRoutesListEdits)
+	router.GET(options.BaseURL+"/v1/sessions/:id/mindmap/export", wrapper.MindmapRoutesExportMindmap)
+	router.POST(options.BaseURL+"/v1/sessions/:id/mindmap/generate", wrapper.MindmapRoutesGenerateMindmap)
+	router.POST(options.BaseURL+"/v1/sessions/:id/mindmap/layout", wrapper.MindmapRoutesRelayout)
+	router.GET(options.BaseURL+"/v1/sessions/:id/mindmap/revisions", wrapper.MindmapRoutesListRevisions)
+	router.GET(options.BaseURL+"/v1/sessions/:id/mindmap/revisions/:version", wrapper.MindmapRoutesGetRevision)
+	router.POST(options.BaseURL+"/v1/sessions/:id/mindmap/revisions/:version/restore", wrapper.MindmapRoutesRestoreRevision)
+	router.PATCH(options.BaseURL+"/v1/sessions/:id/pause", wrapper.RoutesPause)
+	router.PATCH(options.BaseURL+"/v1/sessions/:id/resume", wrapper.RoutesResume)
+	router.POST(options.BaseURL+"/v1/sessions/:id/stop", wrapper.RoutesStop)
+	router.GET(options.BaseURL+"/v1/subscription", wrapper.SubscriptionRoutesGetSubscription)
+	router.POST(options.BaseURL+"/v1/subscription/checkout", wrapper.SubscriptionRoutesCreateCheckout)
+	router.GET(options.BaseURL+"/v1/subscription/plans", wrapper.SubscriptionRoutesListPlans)
+	router.POST(options.BaseURL+"/v1/subscription/portal", wrapper.SubscriptionRoutesCreatePortal)
+	router.GET(options.BaseURL+"/v1/usage", wrapper.UsageRoutesGetUsage)
+	router.GET(options.BaseURL+"/v1/usage/history", wrapper.UsageRoutesGetUsageHistory)
+}
+
+type RoutesForgotPasswordRequestObject struct {
+	Body *RoutesForgotPasswordJSONRequestBody
+}
+
+type RoutesForgotPasswordResponseObject interface {
+	VisitRoutesForgotPasswordResponse(w http.ResponseWriter) error
+}
+
+type RoutesForgotPassword200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response RoutesForgotPassword200JSONResponse) VisitRoutesForgotPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesForgotPassword400JSONResponse CommonValidationError
+
+func (response RoutesForgotPassword400JSONResponse) VisitRoutesForgotPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesGoogleAuthRequestObject struct {
+	Body *RoutesGoogleAuthJSONRequestBody
+}
+
+type RoutesGoogleAuthResponseObject interface {
+	VisitRoutesGoogleAuthResponse(w http.ResponseWriter) error
+}
+
+type RoutesGoogleAuth200JSONResponse AuthAuthResponse
+
+func (response RoutesGoogleAuth200JSONResponse) VisitRoutesGoogleAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesGoogleAuth400JSONResponse CommonValidationError
+
+func (response RoutesGoogleAuth400JSONResponse) VisitRoutesGoogleAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesGoogleAuth401JSONResponse CommonErrorResponse
+
+func (response RoutesGoogleAuth401JSONResponse) VisitRoutesGoogleAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesGoogleAuthCodeRequestObject struct {
+	Body *RoutesGoogleAuthCodeJSONRequestBody
+}
+
+type RoutesGoogleAuthCodeResponseObject interface {
+	VisitRoutesGoogleAuthCodeResponse(w http.ResponseWriter) error
+}
+
+type RoutesGoogleAuthCode200JSONResponse AuthAuthResponse
+
+func (response RoutesGoogleAuthCode200JSONResponse) VisitRoutesGoogleAuthCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesGoogleAuthCode400JSONResponse CommonValidationError
+
+func (response RoutesGoogleAuthCode400JSONResponse) VisitRoutesGoogleAuthCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesGoogleAuthCode401JSONResponse CommonErrorResponse
+
+func (response RoutesGoogleAuthCode401JSONResponse) VisitRoutesGoogleAuthCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesLoginRequestObject struct {
+	Body *RoutesLoginJSONRequestBody
+}
+
+type RoutesLoginResponseObject interface {
+	VisitRoutesLoginResponse(w http.ResponseWriter) error
+}
+
+type RoutesLogin200JSONResponse AuthAuthResponse
+
+func (response RoutesLogin200JSONResponse) VisitRoutesLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesLogin401JSONResponse CommonErrorResponse
+
+func (response RoutesLogin401JSONResponse) VisitRoutesLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesLogoutRequestObject struct {
+	Params RoutesLogoutParams
+}
+
+type RoutesLogoutResponseObject interface {
+	VisitRoutesLogoutResponse(w http.ResponseWriter) error
+}
+
+type RoutesLogout200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response RoutesLogout200JSONResponse) VisitRoutesLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesLogout401JSONResponse CommonErrorResponse
+
+func (response RoutesLogout401JSONResponse) VisitRoutesLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesLogoutAllRequestObject struct {
+	Params RoutesLogoutAllParams
+}
+
+type RoutesLogoutAllResponseObject interface {
+	VisitRoutesLogoutAllResponse(w http.ResponseWriter) error
+}
+
+type RoutesLogoutAll200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response RoutesLogoutAll200JSONResponse) VisitRoutesLogoutAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesLogoutAll401JSONResponse CommonErrorResponse
+
+func (response RoutesLogoutAll401JSONResponse) VisitRoutesLogoutAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesDeleteMeRequestObject struct {
+	Params RoutesDeleteMeParams
+}
+
+type RoutesDeleteMeResponseObject interface {
+	VisitRoutesDeleteMeResponse(w http.ResponseWriter) error
+}
+
+type RoutesDeleteMe204Response struct {
+}
+
+func (response RoutesDeleteMe204Response) VisitRoutesDeleteMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RoutesDeleteMe401JSONResponse CommonErrorResponse
+
+func (response RoutesDeleteMe401JSONResponse) VisitRoutesDeleteMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesDeleteMe403JSONResponse CommonErrorResponse
+
+func (response RoutesDeleteMe403JSONResponse) VisitRoutesDeleteMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesMeRequestObject struct {
+	Params RoutesMeParams
+}
+
+type RoutesMeResponseObject interface {
+	VisitRoutesMeResponse(w http.ResponseWriter) error
+}
+
+type RoutesMe200JSONResponse struct {
+	// User 사용자 정보
+	User AuthUser `json:"user"`
+}
+
+func (response RoutesMe200JSONResponse) VisitRoutesMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesMe401JSONResponse CommonErrorResponse
+
+func (response RoutesMe401JSONResponse) VisitRoutesMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesRefreshRequestObject struct {
+	Params RoutesRefreshParams
+}
+
+type RoutesRefreshResponseObject interface {
+	VisitRoutesRefreshResponse(w http.ResponseWriter) error
+}
+
+type RoutesRefresh200JSONResponse struct {
+	RefreshToken string `json:"refresh_token"`
+	Token        string `json:"token"`
+}
+
+func (response RoutesRefresh200JSONResponse) VisitRoutesRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesRefresh401JSONResponse CommonErrorResponse
+
+func (response RoutesRefresh401JSONResponse) VisitRoutesRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesResendVerificationRequestObject struct {
+	Params RoutesResendVerificationParams
+}
+
+type RoutesResendVerificationResponseObject interface {
+	VisitRoutesResendVerificationResponse(w http.ResponseWriter) error
+}
+
+type RoutesResendVerification200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response RoutesResendVerification200JSONResponse) VisitRoutesResendVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesResendVerification401JSONResponse CommonErrorResponse
+
+func (response RoutesResendVerification401JSONResponse) VisitRoutesResendVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesResendVerification409JSONResponse CommonErrorResponse
+
+func (response RoutesResendVerification409JSONResponse) VisitRoutesResendVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesResetPasswordRequestObject struct {
+	Body *RoutesResetPasswordJSONRequestBody
+}
+
+type RoutesResetPasswordResponseObject interface {
+	VisitRoutesResetPasswordResponse(w http.ResponseWriter) error
+}
+
+type RoutesResetPassword200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response RoutesResetPassword200JSONResponse) VisitRoutesResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesResetPassword400JSONResponse CommonErrorResponse
+
+func (response RoutesResetPassword400JSONResponse) VisitRoutesResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesSignupRequestObject struct {
+	Body *RoutesSignupJSONRequestBody
+}
+
+type RoutesSignupResponseObject interface {
+	VisitRoutesSignupResponse(w http.ResponseWriter) error
+}
+
+type RoutesSignup201JSONResponse AuthAuthResponse
+
+func (response RoutesSignup201JSONResponse) VisitRoutesSignupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesSignup400JSONResponse CommonValidationError
+
+func (response RoutesSignup400JSONResponse) VisitRoutesSignupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesSignup409JSONResponse CommonErrorResponse
+
+func (response RoutesSignup409JSONResponse) VisitRoutesSignupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesVerifyEmailRequestObject struct {
+	Body *RoutesVerifyEmailJSONRequestBody
+}
+
+type RoutesVerifyEmailResponseObject interface {
+	VisitRoutesVerifyEmailResponse(w http.ResponseWriter) error
+}
+
+type RoutesVerifyEmail200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response RoutesVerifyEmail200JSONResponse) VisitRoutesVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesVerifyEmail400JSONResponse CommonErrorResponse
+
+func (response RoutesVerifyEmail400JSONResponse) VisitRoutesVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesListTopicsRequestObject struct {
+	Params KnowledgeRoutesListTopicsParams
+}
+
+type KnowledgeRoutesListTopicsResponseObject interface {
+	VisitKnowledgeRoutesListTopicsResponse(w http.ResponseWriter) error
+}
+
+type KnowledgeRoutesListTopics200JSONResponse KnowledgeKnowledgeTopicListResponse
+
+func (response KnowledgeRoutesListTopics200JSONResponse) VisitKnowledgeRoutesListTopicsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesListTopics401JSONResponse CommonErrorResponse
+
+func (response KnowledgeRoutesListTopics401JSONResponse) VisitKnowledgeRoutesListTopicsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetTopicRequestObject struct {
+	Id     string `json:"id"`
+	Params KnowledgeRoutesGetTopicParams
+}
+
+type KnowledgeRoutesGetTopicResponseObject interface {
+	VisitKnowledgeRoutesGetTopicResponse(w http.ResponseWriter) error
+}
+
+type KnowledgeRoutesGetTopic200JSONResponse KnowledgeKnowledgeTopicResponse
+
+func (response KnowledgeRoutesGetTopic200JSONResponse) VisitKnowledgeRoutesGetTopicResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetTopic401JSONResponse CommonErrorResponse
+
+func (response KnowledgeRoutesGetTopic401JSONResponse) VisitKnowledgeRoutesGetTopicResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetTopic404JSONResponse CommonErrorResponse
+
+func (response KnowledgeRoutesGetTopic404JSONResponse) VisitKnowledgeRoutesGetTopicResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetNeighborhoodRequestObject struct {
+	Id     string `json:"id"`
+	Params KnowledgeRoutesGetNeighborhoodParams
+}
+
+type KnowledgeRoutesGetNeighborhoodResponseObject interface {
+	VisitKnowledgeRoutesGetNeighborhoodResponse(w http.ResponseWriter) error
+}
+
+type KnowledgeRoutesGetNeighborhood200JSONResponse KnowledgeKnowledgeNeighborhoodResponse
+
+func (response KnowledgeRoutesGetNeighborhood200JSONResponse) VisitKnowledgeRoutesGetNeighborhoodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetNeighborhood401JSONResponse CommonErrorResponse
+
+func (response KnowledgeRoutesGetNeighborhood401JSONResponse) VisitKnowledgeRoutesGetNeighborhoodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetNeighborhood404JSONResponse CommonErrorResponse
+
+func (response KnowledgeRoutesGetNeighborhood404JSONResponse) VisitKnowledgeRoutesGetNeighborhoodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetTimelineRequestObject struct {
+	Id     string `json:"id"`
+	Params KnowledgeRoutesGetTimelineParams
+}
+
+type KnowledgeRoutesGetTimelineResponseObject interface {
+	VisitKnowledgeRoutesGetTimelineResponse(w http.ResponseWriter) error
+}
+
+type KnowledgeRoutesGetTimeline200JSONResponse KnowledgeKnowledgeTimelineResponse
+
+func (response KnowledgeRoutesGetTimeline200JSONResponse) VisitKnowledgeRoutesGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetTimeline401JSONResponse CommonErrorResponse
+
+func (response KnowledgeRoutesGetTimeline401JSONResponse) VisitKnowledgeRoutesGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KnowledgeRoutesGetTimeline404JSONResponse CommonErrorResponse
+
+func (response KnowledgeRoutesGetTimeline404JSONResponse) VisitKnowledgeRoutesGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesListRequestObject struct {
+	Params RoutesListParams
+}
+
+type RoutesListResponseObject interface {
+	VisitRoutesListResponse(w http.ResponseWriter) error
+}
+
+type RoutesList200JSONResponse SessionSessionListResponse
+
+func (response RoutesList200JSONResponse) VisitRoutesListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesList401JSONResponse CommonErrorResponse
+
+func (response RoutesList401JSONResponse) VisitRoutesListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesStartRequestObject struct {
+	Params RoutesStartParams
+}
+
+type RoutesStartResponseObject interface {
+	VisitRoutesStartResponse(w http.ResponseWriter) error
+}
+
+type RoutesStart201JSONResponse SessionSessionResponse
+
+func (response RoutesStart201JSONResponse) VisitRoutesStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesForgotPassword200JSONResponse struct {
-	Message string `json:"message"`
+type RoutesStart401JSONResponse CommonErrorResponse
+
+func (response RoutesStart401JSONResponse) VisitRoutesStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response RoutesForgotPassword200JSONResponse) VisitRoutesForgotPasswordResponse(w http.ResponseWriter) error {
+type RoutesStart402JSONResponse CommonErrorResponse
+
+func (response RoutesStart402JSONResponse) VisitRoutesStartResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(402)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesForgotPassword400JSONResponse CommonValidationError
+type RoutesStart409JSONResponse CommonErrorResponse
 
-func (response RoutesForgotPassword400JSONResponse) VisitRoutesForgotPasswordResponse(w http.ResponseWriter) error {
+func (response RoutesStart409JSONResponse) VisitRoutesStartResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGoogleAuthRequestObject struct {
-	Body *RoutesGoogleAuthJSONRequestBody
+type RoutesDeleteRequestObject struct {
+	Id     string `json:"id"`
+	Params RoutesDeleteParams
 }
 
-type RoutesGoogleAuthResponseObject interface {
-	VisitRoutesGoogleAuthResponse(w http.ResponseWriter) error
+type RoutesDeleteResponseObject interface {
+	VisitRoutesDeleteResponse(w http.ResponseWriter) error
 }
 
-type RoutesGoogleAuth200JSONResponse AuthAuthResponse
+type RoutesDelete204Response struct {
+}
 
-func (response RoutesGoogleAuth200JSONResponse) VisitRoutesGoogleAuthResponse(w http.ResponseWriter) error {
+func (response RoutesDelete204Response) VisitRoutesDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RoutesDelete401JSONResponse CommonErrorResponse
+
+func (response RoutesDelete401JSONResponse) VisitRoutesDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGoogleAuth400JSONResponse CommonValidationError
+type RoutesDelete403JSONResponse CommonErrorResponse
 
-func (response RoutesGoogleAuth400JSONResponse) VisitRoutesGoogleAuthResponse(w http.ResponseWriter) error {
+func (response RoutesDelete403JSONResponse) VisitRoutesDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGoogleAuth401JSONResponse CommonErrorResponse
+type RoutesDelete404JSONResponse CommonErrorResponse
 
-func (response RoutesGoogleAuth401JSONResponse) VisitRoutesGoogleAuthResponse(w http.ResponseWriter) error {
+func (response RoutesDelete404JSONResponse) VisitRoutesDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGoogleAuthCodeRequestObject struct {
-	Body *RoutesGoogleAuthCodeJSONRequestBody
+type RoutesGetRequestObject struct {
+	Id     string `json:"id"`
+	Params RoutesGetParams
 }
 
-type RoutesGoogleAuthCodeResponseObject interface {
-	VisitRoutesGoogleAuthCodeResponse(w http.ResponseWriter) error
+type RoutesGetResponseObject interface {
+	VisitRoutesGetResponse(w http.ResponseWriter) error
 }
 
-type RoutesGoogleAuthCode200JSONResponse AuthAuthResponse
+type RoutesGet200JSONResponse SessionSessionResponse
 
-func (response RoutesGoogleAuthCode200JSONResponse) VisitRoutesGoogleAuthCodeResponse(w http.ResponseWriter) error {
+func (response RoutesGet200JSONResponse) VisitRoutesGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGoogleAuthCode400JSONResponse CommonValidationError
+type RoutesGet401JSONResponse CommonErrorResponse
 
-func (response RoutesGoogleAuthCode400JSONResponse) VisitRoutesGoogleAuthCodeResponse(w http.ResponseWriter) error {
+func (response RoutesGet401JSONResponse) VisitRoutesGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGoogleAuthCode401JSONResponse CommonErrorResponse
+type RoutesGet403JSONResponse CommonErrorResponse
 
-func (response RoutesGoogleAuthCode401JSONResponse) VisitRoutesGoogleAuthCodeResponse(w http.ResponseWriter) error {
+func (response RoutesGet403JSONResponse) VisitRoutesGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesLoginRequestObject struct {
-	Body *RoutesLoginJSONRequestBody
+type RoutesGet404JSONResponse CommonErrorResponse
+
+func (response RoutesGet404JSONResponse) VisitRoutesGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesLoginResponseObject interface {
-	VisitRoutesLoginResponse(w http.ResponseWriter) error
+type RoutesUpdateRequestObject struct {
+	Id     string `json:"id"`
+	Params RoutesUpdateParams
+	Body   *RoutesUpdateJSONRequestBody
 }
 
-type RoutesLogin200JSONResponse AuthAuthResponse
+type RoutesUpdateResponseObject interface {
+	VisitRoutesUpdateResponse(w http.ResponseWriter) error
+}
 
-func (response RoutesLogin200JSONResponse) VisitRoutesLoginResponse(w http.ResponseWriter) error {
+type RoutesUpdate200JSONResponse SessionSessionResponse
+
+func (response RoutesUpdate200JSONResponse) VisitRoutesUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesLogin401JSONResponse CommonErrorResponse
+type RoutesUpdate401JSONResponse CommonErrorResponse
 
-func (response RoutesLogin401JSONResponse) VisitRoutesLoginResponse(w http.ResponseWriter) error {
+func (response RoutesUpdate401JSONResponse) VisitRoutesUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesLogoutRequestObject struct {
-	Params RoutesLogoutParams
+type RoutesUpdate403JSONResponse CommonErrorResponse
+
+func (response RoutesUpdate403JSONResponse) VisitRoutesUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesLogoutResponseObject interface {
-	VisitRoutesLogoutResponse(w http.ResponseWriter) error
+type RoutesUpdate404JSONResponse CommonErrorResponse
+
+func (response RoutesUpdate404JSONResponse) VisitRoutesUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesLogout200JSONResponse struct {
-	Message string `json:"message"`
+type RoutesListEventsRequestObject struct {
+	Id     string `json:"id"`
+	Params RoutesListEventsParams
 }
 
-func (response RoutesLogout200JSONResponse) VisitRoutesLogoutResponse(w http.ResponseWriter) error {
+type RoutesListEventsResponseObject interface {
+	VisitRoutesListEventsResponse(w http.ResponseWriter) error
+}
+
+type RoutesListEvents200JSONResponse EventsEventListResponse
+
+func (response RoutesListEvents200JSONResponse) VisitRoutesListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesLogout401JSONResponse CommonErrorResponse
+type RoutesListEvents401JSONResponse CommonErrorResponse
 
-func (response RoutesLogout401JSONResponse) VisitRoutesLogoutResponse(w http.ResponseWriter) error {
+func (response RoutesListEvents401JSONResponse) VisitRoutesListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesDeleteMeRequestObject struct {
-	Params RoutesDeleteMeParams
+type RoutesListEvents403JSONResponse CommonErrorResponse
+
+func (response RoutesListEvents403JSONResponse) VisitRoutesListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesDeleteMeResponseObject interface {
-	VisitRoutesDeleteMeResponse(w http.ResponseWriter) error
+type RoutesListEvents404JSONResponse CommonErrorResponse
+
+func (response RoutesListEvents404JSONResponse) VisitRoutesListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesDeleteMe204Response struct {
+type RoutesBatchEventsRequestObject struct {
+	Id     string `json:"id"`
+	Params RoutesBatchEventsParams
+	Body   *RoutesBatchEventsJSONRequestBody
 }
 
-func (response RoutesDeleteMe204Response) VisitRoutesDeleteMeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type RoutesBatchEventsResponseObject interface {
+	VisitRoutesBatchEventsResponse(w http.ResponseWriter) error
 }
 
-type RoutesDeleteMe401JSONResponse CommonErrorResponse
+type RoutesBatchEvents200JSONResponse EventsBatchEventsResponse
 
-func (response RoutesDeleteMe401JSONResponse) VisitRoutesDeleteMeResponse(w http.ResponseWriter) error {
+func (response RoutesBatchEvents200JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesBatchEvents400JSONResponse CommonErrorResponse
+
+func (response RoutesBatchEvents400JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesBatchEvents401JSONResponse CommonErrorResponse
+
+func (response RoutesBatchEvents401JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesDeleteMe403JSONResponse CommonErrorResponse
+type RoutesBatchEvents403JSONResponse CommonErrorResponse
 
-func (response RoutesDeleteMe403JSONResponse) VisitRoutesDeleteMeResponse(w http.ResponseWriter) error {
+func (response RoutesBatchEvents403JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesMeRequestObject struct {
-	Params RoutesMeParams
+type RoutesBatchEvents404JSONResponse CommonErrorResponse
+
+func (response RoutesBatchEvents404JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesMeResponseObject interface {
-	VisitRoutesMeResponse(w http.ResponseWriter) error
+type RoutesGetEventStatsRequestObject struct {
+	Id     string `json:"id"`
+	Params RoutesGetEventStatsParams
 }
 
-type RoutesMe200JSONResponse struct {
-	// User 사용자 정보
-	User AuthUser `json:"user"`
+type RoutesGetEventStatsResponseObject interface {
+	VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error
 }
 
-func (response RoutesMe200JSONResponse) VisitRoutesMeResponse(w http.ResponseWriter) error {
+type RoutesGetEventStats200JSONResponse EventsEventStatsResponse
+
+func (response RoutesGetEventStats200JSONResponse) VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesMe401JSONResponse CommonErrorResponse
+type RoutesGetEventStats401JSONResponse CommonErrorResponse
 
-func (response RoutesMe401JSONResponse) VisitRoutesMeResponse(w http.ResponseWriter) error {
+func (response RoutesGetEventStats401JSONResponse) VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesRefreshRequestObject struct {
-	Params RoutesRefreshParams
-}
+type RoutesGetEventStats403JSONResponse CommonErrorResponse
+
+func (response RoutesGetEventStats403JSONResponse) VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutesGetEventStats404JSONResponse CommonErrorResponse
+
+func (response RoutesGetEventStats404JSONResponse) VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MindmapRoutesGetMindmapRequestObject struct {
+	Id     string `json:"id"`
+	Params MindmapRoutesGetMindmapParams
+}
+
+type MindmapRoutesGetMindmapResponseObject interface {
+	VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error
+}
+
+type MindmapRoutesGetMindmap200JSONResponse MindmapMindmapResponse
+
+func (response MindmapRoutesGetMindmap200JSONResponse) VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MindmapRoutesGetMindmap401JSONResponse CommonErrorResponse
 
-type RoutesRefreshResponseObject interface {
-	VisitRoutesRefreshResponse(w http.ResponseWriter) error
-}
+func (response MindmapRoutesGetMindmap401JSONResponse) VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-type RoutesRefresh200JSONResponse struct {
-	Token string `json:"token"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response RoutesRefresh200JSONResponse) VisitRoutesRefreshResponse(w http.ResponseWriter) error {
+type MindmapRoutesGetMindmap403JSONResponse CommonErrorResponse
+
+func (response MindmapRoutesGetMindmap403JSONResponse) VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesRefresh401JSONResponse CommonErrorResponse
+type MindmapRoutesGetMindmap404JSONResponse CommonErrorResponse
 
-func (response RoutesRefresh401JSONResponse) VisitRoutesRefreshResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGetMindmap404JSONResponse) VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesResetPasswordRequestObject struct {
-	Body *RoutesResetPasswordJSONRequestBody
+type MindmapRoutesEditMindmapRequestObject struct {
+	Id     string `json:"id"`
+	Params MindmapRoutesEditMindmapParams
+	Body   *MindmapRoutesEditMindmapJSONRequestBody
 }
 
-type RoutesResetPasswordResponseObject interface {
-	VisitRoutesResetPasswordResponse(w http.ResponseWriter) error
+type MindmapRoutesEditMindmapResponseObject interface {
+	VisitMindmapRoutesEditMindmapResponse(w http.ResponseWriter) error
 }
 
-type RoutesResetPassword200JSONResponse struct {
-	Message string `json:"message"`
-}
+type MindmapRoutesEditMindmap200JSONResponse MindmapMindmapResponse
 
-func (response RoutesResetPassword200JSONResponse) VisitRoutesResetPasswordResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesEditMindmap200JSONResponse) VisitMindmapRoutesEditMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesResetPassword400JSONResponse CommonErrorResponse
+type MindmapRoutesEditMindmap400JSONResponse CommonErrorResponse
 
-func (response RoutesResetPassword400JSONResponse) VisitRoutesResetPasswordResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesEditMindmap400JSONResponse) VisitMindmapRoutesEditMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesSignupRequestObject struct {
-	Body *RoutesSignupJSONRequestBody
-}
+type MindmapRoutesEditMindmap401JSONResponse CommonErrorResponse
 
-type RoutesSignupResponseObject interface {
-	VisitRoutesSignupResponse(w http.ResponseWriter) error
+func (response MindmapRoutesEditMindmap401JSONResponse) VisitMindmapRoutesEditMindmapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesSignup201JSONResponse AuthAuthResponse
+type MindmapRoutesEditMindmap403JSONResponse CommonErrorResponse
 
-func (response RoutesSignup201JSONResponse) VisitRoutesSignupResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesEditMindmap403JSONResponse) VisitMindmapRoutesEditMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesSignup400JSONResponse CommonValidationError
+type MindmapRoutesEditMindmap404JSONResponse CommonErrorResponse
 
-func (response RoutesSignup400JSONResponse) VisitRoutesSignupResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesEditMindmap404JSONResponse) VisitMindmapRoutesEditMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesSignup409JSONResponse CommonErrorResponse
+type MindmapRoutesEditMindmap409JSONResponse CommonErrorResponse
 
-func (response RoutesSignup409JSONResponse) VisitRoutesSignupResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesEditMindmap409JSONResponse) VisitMindmapRoutesEditMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesListRequestObject struct {
-	Params RoutesListParams
+type MindmapRoutesDiffRevisionsRequestObject struct {
+	Id     string `json:"id"`
+	Params MindmapRoutesDiffRevisionsParams
 }
 
-type RoutesListResponseObject interface {
-	VisitRoutesListResponse(w http.ResponseWriter) error
+type MindmapRoutesDiffRevisionsResponseObject interface {
+	VisitMindmapRoutesDiffRevisionsResponse(w http.ResponseWriter) error
 }
 
-type RoutesList200JSONResponse SessionSessionListResponse
+type MindmapRoutesDiffRevisions200JSONResponse MindmapMindmapDiffResponse
 
-func (response RoutesList200JSONResponse) VisitRoutesListResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesDiffRevisions200JSONResponse) VisitMindmapRoutesDiffRevisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesList401JSONResponse CommonErrorResponse
+type MindmapRoutesDiffRevisions401JSONResponse CommonErrorResponse
 
-func (response RoutesList401JSONResponse) VisitRoutesListResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesDiffRevisions401JSONResponse) VisitMindmapRoutesDiffRevisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesStartRequestObject struct {
-	Params RoutesStartParams
-}
-
-type RoutesStartResponseObject interface {
-	VisitRoutesStartResponse(w http.ResponseWriter) error
-}
-
-type RoutesStart201JSONResponse SessionSessionResponse
+type MindmapRoutesDiffRevisions403JSONResponse CommonErrorResponse
 
-func (response RoutesStart201JSONResponse) VisitRoutesStartResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesDiffRevisions403JSONResponse) VisitMindmapRoutesDiffRevisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesStart401JSONResponse CommonErrorResponse
+type MindmapRoutesDiffRevisions404JSONResponse CommonErrorResponse
 
-func (response RoutesStart401JSONResponse) VisitRoutesStartResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesDiffRevisions404JSONResponse) VisitMindmapRoutesDiffRevisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesDeleteRequestObject struct {
+type MindmapRoutesListEditsRequestObject struct {
 	Id     string `json:"id"`
-	Params RoutesDeleteParams
+	Params MindmapRoutesListEditsParams
 }
 
-type RoutesDeleteResponseObject interface {
-	VisitRoutesDeleteResponse(w http.ResponseWriter) error
+type MindmapRoutesListEditsResponseObject interface {
+	VisitMindmapRoutesListEditsResponse(w http.ResponseWriter) error
 }
 
-type RoutesDelete204Response struct {
-}
+type MindmapRoutesListEdits200JSONResponse MindmapMindmapEditListResponse
 
-func (response RoutesDelete204Response) VisitRoutesDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response MindmapRoutesListEdits200JSONResponse) VisitMindmapRoutesListEditsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesDelete401JSONResponse CommonErrorResponse
+type MindmapRoutesListEdits401JSONResponse CommonErrorResponse
 
-func (response RoutesDelete401JSONResponse) VisitRoutesDeleteResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesListEdits401JSONResponse) VisitMindmapRoutesListEditsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesDelete403JSONResponse CommonErrorResponse
+type MindmapRoutesListEdits403JSONResponse CommonErrorResponse
 
-func (response RoutesDelete403JSONResponse) VisitRoutesDeleteResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesListEdits403JSONResponse) VisitMindmapRoutesListEditsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesDelete404JSONResponse CommonErrorResponse
+type MindmapRoutesListEdits404JSONResponse CommonErrorResponse
 
-func (response RoutesDelete404JSONResponse) VisitRoutesDeleteResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesListEdits404JSONResponse) VisitMindmapRoutesListEditsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGetRequestObject struct {
+type MindmapRoutesExportMindmapRequestObject struct {
 	Id     string `json:"id"`
-	Params RoutesGetParams
+	Params MindmapRoutesExportMindmapParams
 }
 
-type RoutesGetResponseObject interface {
-	VisitRoutesGetResponse(w http.ResponseWriter) error
+type MindmapRoutesExportMindmapResponseObject interface {
+	VisitMindmapRoutesExportMindmapResponse(w http.ResponseWriter) error
 }
 
-type RoutesGet200JSONResponse SessionSessionResponse
-
-func (response RoutesGet200JSONResponse) VisitRoutesGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type MindmapRoutesExportMindmap200ResponseHeaders struct {
+	ContentDisposition string
 }
 
-type RoutesGet401JSONResponse CommonErrorResponse
+type MindmapRoutesExportMindmap200AsteriskResponse struct {
+	Body          io.Reader
+	Headers       MindmapRoutesExportMindmap200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
 
-func (response RoutesGet401JSONResponse) VisitRoutesGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+func (response MindmapRoutesExportMindmap200AsteriskResponse) VisitMindmapRoutesExportMindmapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("content-disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type RoutesGet403JSONResponse CommonErrorResponse
+type MindmapRoutesExportMindmap400JSONResponse CommonErrorResponse
 
-func (response RoutesGet403JSONResponse) VisitRoutesGetResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesExportMindmap400JSONResponse) VisitMindmapRoutesExportMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGet404JSONResponse CommonErrorResponse
+type MindmapRoutesExportMindmap401JSONResponse CommonErrorResponse
 
-func (response RoutesGet404JSONResponse) VisitRoutesGetResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesExportMindmap401JSONResponse) VisitMindmapRoutesExportMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesUpdateRequestObject struct {
-	Id     string `json:"id"`
-	Params RoutesUpdateParams
-	Body   *RoutesUpdateJSONRequestBody
-}
-
-type RoutesUpdateResponseObject interface {
-	VisitRoutesUpdateResponse(w http.ResponseWriter) error
-}
-
-type RoutesUpdate200JSONResponse SessionSessionResponse
+type MindmapRoutesExportMindmap402JSONResponse CommonErrorResponse
 
-func (response RoutesUpdate200JSONResponse) VisitRoutesUpdateResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesExportMindmap402JSONResponse) VisitMindmapRoutesExportMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(402)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesUpdate401JSONResponse CommonErrorResponse
+type MindmapRoutesExportMindmap403JSONResponse CommonErrorResponse
 
-func (response RoutesUpdate401JSONResponse) VisitRoutesUpdateResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesExportMindmap403JSONResponse) VisitMindmapRoutesExportMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesUpdate403JSONResponse CommonErrorResponse
+type MindmapRoutesExportMindmap404JSONResponse CommonErrorResponse
 
-func (response RoutesUpdate403JSONResponse) VisitRoutesUpdateResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesExportMindmap404JSONResponse) VisitMindmapRoutesExportMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesUpdate404JSONResponse CommonErrorResponse
+type MindmapRoutesExportMindmap409JSONResponse CommonErrorResponse
 
-func (response RoutesUpdate404JSONResponse) VisitRoutesUpdateResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesExportMindmap409JSONResponse) VisitMindmapRoutesExportMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesListEventsRequestObject struct {
+type MindmapRoutesGenerateMindmapRequestObject struct {
 	Id     string `json:"id"`
-	Params RoutesListEventsParams
+	Params MindmapRoutesGenerateMindmapParams
+	Body   *MindmapRoutesGenerateMindmapJSONRequestBody
 }
 
-type RoutesListEventsResponseObject interface {
-	VisitRoutesListEventsResponse(w http.ResponseWriter) error
+type MindmapRoutesGenerateMindmapResponseObject interface {
+	VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error
 }
 
-type RoutesListEvents200JSONResponse EventsEventListResponse
+type MindmapRoutesGenerateMindmap202JSONResponse MindmapMindmapResponse
 
-func (response RoutesListEvents200JSONResponse) VisitRoutesListEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGenerateMindmap202JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(202)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesListEvents401JSONResponse CommonErrorResponse
+type MindmapRoutesGenerateMindmap400JSONResponse CommonErrorResponse
 
-func (response RoutesListEvents401JSONResponse) VisitRoutesListEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGenerateMindmap400JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MindmapRoutesGenerateMindmap401JSONResponse CommonErrorResponse
+
+func (response MindmapRoutesGenerateMindmap401JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesListEvents403JSONResponse CommonErrorResponse
+type MindmapRoutesGenerateMindmap403JSONResponse CommonErrorResponse
 
-func (response RoutesListEvents403JSONResponse) VisitRoutesListEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGenerateMindmap403JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesListEvents404JSONResponse CommonErrorResponse
+type MindmapRoutesGenerateMindmap404JSONResponse CommonErrorResponse
 
-func (response RoutesListEvents404JSONResponse) VisitRoutesListEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGenerateMindmap404JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesBatchEventsRequestObject struct {
+type MindmapRoutesRelayoutRequestObject struct {
 	Id     string `json:"id"`
-	Params RoutesBatchEventsParams
-	Body   *RoutesBatchEventsJSONRequestBody
+	Params MindmapRoutesRelayoutParams
+	Body   *MindmapRoutesRelayoutJSONRequestBody
 }
 
-type RoutesBatchEventsResponseObject interface {
-	VisitRoutesBatchEventsResponse(w http.ResponseWriter) error
+type MindmapRoutesRelayoutResponseObject interface {
+	VisitMindmapRoutesRelayoutResponse(w http.ResponseWriter) error
 }
 
-type RoutesBatchEvents200JSONResponse EventsBatchEventsResponse
+type MindmapRoutesRelayout200JSONResponse MindmapMindmapResponse
 
-func (response RoutesBatchEvents200JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRelayout200JSONResponse) VisitMindmapRoutesRelayoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesBatchEvents400JSONResponse CommonErrorResponse
+type MindmapRoutesRelayout400JSONResponse CommonErrorResponse
 
-func (response RoutesBatchEvents400JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRelayout400JSONResponse) VisitMindmapRoutesRelayoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesBatchEvents401JSONResponse CommonErrorResponse
+type MindmapRoutesRelayout401JSONResponse CommonErrorResponse
 
-func (response RoutesBatchEvents401JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRelayout401JSONResponse) VisitMindmapRoutesRelayoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesBatchEvents403JSONResponse CommonErrorResponse
+type MindmapRoutesRelayout403JSONResponse CommonErrorResponse
 
-func (response RoutesBatchEvents403JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRelayout403JSONResponse) VisitMindmapRoutesRelayoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesBatchEvents404JSONResponse CommonErrorResponse
+type MindmapRoutesRelayout404JSONResponse CommonErrorResponse
 
-func (response RoutesBatchEvents404JSONResponse) VisitRoutesBatchEventsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRelayout404JSONResponse) VisitMindmapRoutesRelayoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGetEventStatsRequestObject struct {
+type MindmapRoutesRelayout409JSONResponse CommonErrorResponse
+
+func (response MindmapRoutesRelayout409JSONResponse) VisitMindmapRoutesRelayoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MindmapRoutesListRevisionsRequestObject struct {
 	Id     string `json:"id"`
-	Params RoutesGetEventStatsParams
+	Params MindmapRoutesListRevisionsParams
 }
 
-type RoutesGetEventStatsResponseObject interface {
-	VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error
+type MindmapRoutesListRevisionsResponseObject interface {
+	VisitMindmapRoutesListRevisionsResponse(w http.ResponseWriter) error
 }
 
-type RoutesGetEventStats200JSONResponse EventsEventStatsResponse
+type MindmapRoutesListRevisions200JSONResponse MindmapMindmapRevisionListResponse
 
-func (response RoutesGetEventStats200JSONResponse) VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesListRevisions200JSONResponse) VisitMindmapRoutesListRevisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGetEventStats401JSONResponse CommonErrorResponse
+type MindmapRoutesListRevisions401JSONResponse CommonErrorResponse
 
-func (response RoutesGetEventStats401JSONResponse) VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesListRevisions401JSONResponse) VisitMindmapRoutesListRevisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGetEventStats403JSONResponse CommonErrorResponse
+type MindmapRoutesListRevisions403JSONResponse CommonErrorResponse
 
-func (response RoutesGetEventStats403JSONResponse) VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesListRevisions403JSONResponse) VisitMindmapRoutesListRevisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoutesGetEventStats404JSONResponse CommonErrorResponse
+type MindmapRoutesListRevisions404JSONResponse CommonErrorResponse
 
-func (response RoutesGetEventStats404JSONResponse) VisitRoutesGetEventStatsResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesListRevisions404JSONResponse) VisitMindmapRoutesListRevisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MindmapRoutesGetMindmapRequestObject struct {
-	Id     string `json:"id"`
-	Params MindmapRoutesGetMindmapParams
+type MindmapRoutesGetRevisionRequestObject struct {
+	Id      string `json:"id"`
+	Version int32  `json:"version"`
+	Params  MindmapRoutesGetRevisionParams
 }
 
-type MindmapRoutesGetMindmapResponseObject interface {
-	VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error
+type MindmapRoutesGetRevisionResponseObject interface {
+	VisitMindmapRoutesGetRevisionResponse(w http.ResponseWriter) error
 }
 
-type MindmapRoutesGetMindmap200JSONResponse MindmapMindmapResponse
+type MindmapRoutesGetRevision200JSONResponse MindmapMindmapRevisionResponse
 
-func (response MindmapRoutesGetMindmap200JSONResponse) VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGetRevision200JSONResponse) VisitMindmapRoutesGetRevisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MindmapRoutesGetMindmap401JSONResponse CommonErrorResponse
+type MindmapRoutesGetRevision401JSONResponse CommonErrorResponse
 
-func (response MindmapRoutesGetMindmap401JSONResponse) VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGetRevision401JSONResponse) VisitMindmapRoutesGetRevisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MindmapRoutesGetMindmap403JSONResponse CommonErrorResponse
+type MindmapRoutesGetRevision403JSONResponse CommonErrorResponse
 
-func (response MindmapRoutesGetMindmap403JSONResponse) VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGetRevision403JSONResponse) VisitMindmapRoutesGetRevisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MindmapRoutesGetMindmap404JSONResponse CommonErrorResponse
+type MindmapRoutesGetRevision404JSONResponse CommonErrorResponse
 
-func (response MindmapRoutesGetMindmap404JSONResponse) VisitMindmapRoutesGetMindmapResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesGetRevision404JSONResponse) VisitMindmapRoutesGetRevisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MindmapRoutesGenerateMindmapRequestObject struct {
-	Id     string `json:"id"`
-	Params MindmapRoutesGenerateMindmapParams
-	Body   *MindmapRoutesGenerateMindmapJSONRequestBody
-}
-
-type MindmapRoutesGenerateMindmapResponseObject interface {
-	VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error
+type MindmapRoutesRestoreRevisionRequestObject struct {
+	Id      string `json:"id"`
+	Version int32  `json:"version"`
+	Params  MindmapRoutesRestoreRevisionParams
 }
 
-type MindmapRoutesGenerateMindmap202JSONResponse MindmapMindmapResponse
-
-func (response MindmapRoutesGenerateMindmap202JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
-
-	return json.NewEncoder(w).Encode(response)
+type MindmapRoutesRestoreRevisionResponseObject interface {
+	VisitMindmapRoutesRestoreRevisionResponse(w http.ResponseWriter) error
 }
 
-type MindmapRoutesGenerateMindmap400JSONResponse CommonErrorResponse
+type MindmapRoutesRestoreRevision200JSONResponse MindmapMindmapResponse
 
-func (response MindmapRoutesGenerateMindmap400JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRestoreRevision200JSONResponse) VisitMindmapRoutesRestoreRevisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MindmapRoutesGenerateMindmap401JSONResponse CommonErrorResponse
+type MindmapRoutesRestoreRevision401JSONResponse CommonErrorResponse
 
-func (response MindmapRoutesGenerateMindmap401JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRestoreRevision401JSONResponse) VisitMindmapRoutesRestoreRevisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MindmapRoutesGenerateMindmap403JSONResponse CommonErrorResponse
+type MindmapRoutesRestoreRevision403JSONResponse CommonErrorResponse
 
-func (response MindmapRoutesGenerateMindmap403JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRestoreRevision403JSONResponse) VisitMindmapRoutesRestoreRevisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MindmapRoutesGenerateMindmap404JSONResponse CommonErrorResponse
+type MindmapRoutesRestoreRevision404JSONResponse CommonErrorResponse
 
-func (response MindmapRoutesGenerateMindmap404JSONResponse) VisitMindmapRoutesGenerateMindmapResponse(w http.ResponseWriter) error {
+func (response MindmapRoutesRestoreRevision404JSONResponse) VisitMindmapRoutesRestoreRevisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
+type MindmapRoutesRestoreRevision409JSONResponse CommonErrorResponse
+
+func (response MindmapRoutesRestoreRevision409JSONResponse) VisitMindmapRoutesRestoreRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type RoutesPauseRequestObject struct {
 	Id     string `json:"id"`
 	Params RoutesPauseParams
@@ -2696,6 +4762,60 @@ func (response SubscriptionRoutesGetSubscription401JSONResponse) VisitSubscripti
 	return json.NewEncoder(w).Encode(response)
 }
 
+type SubscriptionRoutesCreateCheckoutRequestObject struct {
+	Params SubscriptionRoutesCreateCheckoutParams
+	Body   *SubscriptionRoutesCreateCheckoutJSONRequestBody
+}
+
+type SubscriptionRoutesCreateCheckoutResponseObject interface {
+	VisitSubscriptionRoutesCreateCheckoutResponse(w http.ResponseWriter) error
+}
+
+type SubscriptionRoutesCreateCheckout200JSONResponse SubscriptionBillingRedirectResponse
+
+func (response SubscriptionRoutesCreateCheckout200JSONResponse) VisitSubscriptionRoutesCreateCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscriptionRoutesCreateCheckout400JSONResponse CommonErrorResponse
+
+func (response SubscriptionRoutesCreateCheckout400JSONResponse) VisitSubscriptionRoutesCreateCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscriptionRoutesCreateCheckout401JSONResponse CommonErrorResponse
+
+func (response SubscriptionRoutesCreateCheckout401JSONResponse) VisitSubscriptionRoutesCreateCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscriptionRoutesCreateCheckout409JSONResponse CommonErrorResponse
+
+func (response SubscriptionRoutesCreateCheckout409JSONResponse) VisitSubscriptionRoutesCreateCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscriptionRoutesCreateCheckout503JSONResponse CommonErrorResponse
+
+func (response SubscriptionRoutesCreateCheckout503JSONResponse) VisitSubscriptionRoutesCreateCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type SubscriptionRoutesListPlansRequestObject struct {
 	Params SubscriptionRoutesListPlansParams
 }
@@ -2722,6 +4842,50 @@ func (response SubscriptionRoutesListPlans401JSONResponse) VisitSubscriptionRout
 	return json.NewEncoder(w).Encode(response)
 }
 
+type SubscriptionRoutesCreatePortalRequestObject struct {
+	Params SubscriptionRoutesCreatePortalParams
+}
+
+type SubscriptionRoutesCreatePortalResponseObject interface {
+	VisitSubscriptionRoutesCreatePortalResponse(w http.ResponseWriter) error
+}
+
+type SubscriptionRoutesCreatePortal200JSONResponse SubscriptionBillingRedirectResponse
+
+func (response SubscriptionRoutesCreatePortal200JSONResponse) VisitSubscriptionRoutesCreatePortalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscriptionRoutesCreatePortal401JSONResponse CommonErrorResponse
+
+func (response SubscriptionRoutesCreatePortal401JSONResponse) VisitSubscriptionRoutesCreatePortalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscriptionRoutesCreatePortal404JSONResponse CommonErrorResponse
+
+func (response SubscriptionRoutesCreatePortal404JSONResponse) VisitSubscriptionRoutesCreatePortalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscriptionRoutesCreatePortal503JSONResponse CommonErrorResponse
+
+func (response SubscriptionRoutesCreatePortal503JSONResponse) VisitSubscriptionRoutesCreatePortalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type UsageRoutesGetUsageRequestObject struct {
 	Params UsageRoutesGetUsageParams
 }
@@ -2792,6 +4956,9 @@ type StrictServerInterface interface {
 	// (POST /v1/auth/logout)
 	RoutesLogout(ctx context.Context, request RoutesLogoutRequestObject) (RoutesLogoutResponseObject, error)
 
+	// (POST /v1/auth/logout-all)
+	RoutesLogoutAll(ctx context.Context, request RoutesLogoutAllRequestObject) (RoutesLogoutAllResponseObject, error)
+
 	// (DELETE /v1/auth/me)
 	RoutesDeleteMe(ctx context.Context, request RoutesDeleteMeRequestObject) (RoutesDeleteMeResponseObject, error)
 
@@ -2801,12 +4968,30 @@ type StrictServerInterface interface {
 	// (POST /v1/auth/refresh)
 	RoutesRefresh(ctx context.Context, request RoutesRefreshRequestObject) (RoutesRefreshResponseObject, error)
 
+	// (POST /v1/auth/resend-verification)
+	RoutesResendVerification(ctx context.Context, request RoutesResendVerificationRequestObject) (RoutesResendVerificationResponseObject, error)
+
 	// (POST /v1/auth/reset-password)
 	RoutesResetPassword(ctx context.Context, request RoutesResetPasswordRequestObject) (RoutesResetPasswordResponseObject, error)
 
 	// (POST /v1/auth/signup)
 	RoutesSignup(ctx context.Context, request RoutesSignupRequestObject) (RoutesSignupResponseObject, error)
 
+	// (POST /v1/auth/verify-email)
+	RoutesVerifyEmail(ctx context.Context, request RoutesVerifyEmailRequestObject) (RoutesVerifyEmailResponseObject, error)
+
+	// (GET /v1/knowledge/topics)
+	KnowledgeRoutesListTopics(ctx context.Context, request KnowledgeRoutesListTopicsRequestObject) (KnowledgeRoutesListTopicsResponseObject, error)
+
+	// (GET /v1/knowledge/topics/{id})
+	KnowledgeRoutesGetTopic(ctx context.Context, request KnowledgeRoutesGetTopicRequestObject) (KnowledgeRoutesGetTopicResponseObject, error)
+
+	// (GET /v1/knowledge/topics/{id}/neighborhood)
+	KnowledgeRoutesGetNeighborhood(ctx context.Context, request KnowledgeRoutesGetNeighborhoodRequestObject) (KnowledgeRoutesGetNeighborhoodResponseObject, error)
+
+	// (GET /v1/knowledge/topics/{id}/timeline)
+	KnowledgeRoutesGetTimeline(ctx context.Context, request KnowledgeRoutesGetTimelineRequestObject) (KnowledgeRoutesGetTimelineResponseObject, error)
+
 	// (GET /v1/sessions)
 	RoutesList(ctx context.Context, request RoutesListRequestObject) (RoutesListResponseObject, error)
 
@@ -2834,9 +5019,33 @@ type StrictServerInterface interface {
 	// (GET /v1/sessions/{id}/mindmap)
 	MindmapRoutesGetMindmap(ctx context.Context, request MindmapRoutesGetMindmapRequestObject) (MindmapRoutesGetMindmapResponseObject, error)
 
+	// (PATCH /v1/sessions/{id}/mindmap)
+	MindmapRoutesEditMindmap(ctx context.Context, request MindmapRoutesEditMindmapRequestObject) (MindmapRoutesEditMindmapResponseObject, error)
+
+	// (GET /v1/sessions/{id}/mindmap/diff)
+	MindmapRoutesDiffRevisions(ctx context.Context, request MindmapRoutesDiffRevisionsRequestObject) (MindmapRoutesDiffRevisionsResponseObject, error)
+
+	// (GET /v1/sessions/{id}/mindmap/edits)
+	MindmapRoutesListEdits(ctx context.Context, request MindmapRoutesListEditsRequestObject) (MindmapRoutesListEditsResponseObject, error)
+
+	// (GET /v1/sessions/{id}/mindmap/export)
+	MindmapRoutesExportMindmap(ctx context.Context, request MindmapRoutesExportMindmapRequestObject) (MindmapRoutesExportMindmapResponseObject, error)
+
 	// (POST /v1/sessions/{id}/mindmap/generate)
 	MindmapRoutesGenerateMindmap(ctx context.Context, request MindmapRoutesGenerateMindmapRequestObject) (MindmapRoutesGenerateMindmapResponseObject, error)
 
+	// (POST /v1/sessions/{id}/mindmap/layout)
+	MindmapRoutesRelayout(ctx context.Context, request MindmapRoutesRelayoutRequestObject) (MindmapRoutesRelayoutResponseObject, error)
+
+	// (GET /v1/sessions/{id}/mindmap/revisions)
+	MindmapRoutesListRevisions(ctx context.Context, request MindmapRoutesListRevisionsRequestObject) (MindmapRoutesListRevisionsResponseObject, error)
+
+	// (GET /v1/sessions/{id}/mindmap/revisions/{version})
+	MindmapRoutesGetRevision(ctx context.Context, request MindmapRoutesGetRevisionRequestObject) (MindmapRoutesGetRevisionResponseObject, error)
+
+	// (POST /v1/sessions/{id}/mindmap/revisions/{version}/restore)
+	MindmapRoutesRestoreRevision(ctx context.Context, request MindmapRoutesRestoreRevisionRequestObject) (MindmapRoutesRestoreRevisionResponseObject, error)
+
 	// (PATCH /v1/sessions/{id}/pause)
 	RoutesPause(ctx context.Context, request RoutesPauseRequestObject) (RoutesPauseResponseObject, error)
 
@@ -2849,9 +5058,15 @@ type StrictServerInterface interface {
 	// (GET /v1/subscription)
 	SubscriptionRoutesGetSubscription(ctx context.Context, request SubscriptionRoutesGetSubscriptionRequestObject) (SubscriptionRoutesGetSubscriptionResponseObject, error)
 
+	// (POST /v1/subscription/checkout)
+	SubscriptionRoutesCreateCheckout(ctx context.Context, request SubscriptionRoutesCreateCheckoutRequestObject) (SubscriptionRoutesCreateCheckoutResponseObject, error)
+
 	// (GET /v1/subscription/plans)
 	SubscriptionRoutesListPlans(ctx context.Context, request SubscriptionRoutesListPlansRequestObject) (SubscriptionRoutesListPlansResponseObject, error)
 
+	// (POST /v1/subscription/portal)
+	SubscriptionRoutesCreatePortal(ctx context.Context, request SubscriptionRoutesCreatePortalRequestObject) (SubscriptionRoutesCreatePortalResponseObject, error)
+
 	// (GET /v1/usage)
 	UsageRoutesGetUsage(ctx context.Context, request UsageRoutesGetUsageRequestObject) (UsageRoutesGetUsageResponseObject, error)
 
@@ -3030,6 +5245,33 @@ func (sh *strictHandler) RoutesLogout(ctx *gin.Context, params RoutesLogoutParam
 	}
 }
 
+// RoutesLogoutAll operation middleware
+func (sh *strictHandler) RoutesLogoutAll(ctx *gin.Context, params RoutesLogoutAllParams) {
+	var request RoutesLogoutAllRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RoutesLogoutAll(ctx, request.(RoutesLogoutAllRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RoutesLogoutAll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RoutesLogoutAllResponseObject); ok {
+		if err := validResponse.VisitRoutesLogoutAllResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // RoutesDeleteMe operation middleware
 func (sh *strictHandler) RoutesDeleteMe(ctx *gin.Context, params RoutesDeleteMeParams) {
 	var request RoutesDeleteMeRequestObject
@@ -3111,6 +5353,33 @@ func (sh *strictHandler) RoutesRefresh(ctx *gin.Context, params RoutesRefreshPar
 	}
 }
 
+// RoutesResendVerification operation middleware
+func (sh *strictHandler) RoutesResendVerification(ctx *gin.Context, params RoutesResendVerificationParams) {
+	var request RoutesResendVerificationRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RoutesResendVerification(ctx, request.(RoutesResendVerificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RoutesResendVerification")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RoutesResendVerificationResponseObject); ok {
+		if err := validResponse.VisitRoutesResendVerificationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // RoutesResetPassword operation middleware
 func (sh *strictHandler) RoutesResetPassword(ctx *gin.Context) {
 	var request RoutesResetPasswordRequestObject
@@ -3121,13 +5390,162 @@ func (sh *strictHandler) RoutesResetPassword(ctx *gin.Context) {
 		ctx.Error(err)
 		return
 	}
-	request.Body = &body
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RoutesResetPassword(ctx, request.(RoutesResetPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RoutesResetPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RoutesResetPasswordResponseObject); ok {
+		if err := validResponse.VisitRoutesResetPasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RoutesSignup operation middleware
+func (sh *strictHandler) RoutesSignup(ctx *gin.Context) {
+	var request RoutesSignupRequestObject
+
+	var body RoutesSignupJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RoutesSignup(ctx, request.(RoutesSignupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RoutesSignup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RoutesSignupResponseObject); ok {
+		if err := validResponse.VisitRoutesSignupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RoutesVerifyEmail operation middleware
+func (sh *strictHandler) RoutesVerifyEmail(ctx *gin.Context) {
+	var request RoutesVerifyEmailRequestObject
+
+	var body RoutesVerifyEmailJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RoutesVerifyEmail(ctx, request.(RoutesVerifyEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RoutesVerifyEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RoutesVerifyEmailResponseObject); ok {
+		if err := validResponse.VisitRoutesVerifyEmailResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// KnowledgeRoutesListTopics operation middleware
+func (sh *strictHandler) KnowledgeRoutesListTopics(ctx *gin.Context, params KnowledgeRoutesListTopicsParams) {
+	var request KnowledgeRoutesListTopicsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.KnowledgeRoutesListTopics(ctx, request.(KnowledgeRoutesListTopicsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "KnowledgeRoutesListTopics")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(KnowledgeRoutesListTopicsResponseObject); ok {
+		if err := validResponse.VisitKnowledgeRoutesListTopicsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// KnowledgeRoutesGetTopic operation middleware
+func (sh *strictHandler) KnowledgeRoutesGetTopic(ctx *gin.Context, id string, params KnowledgeRoutesGetTopicParams) {
+	var request KnowledgeRoutesGetTopicRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.KnowledgeRoutesGetTopic(ctx, request.(KnowledgeRoutesGetTopicRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "KnowledgeRoutesGetTopic")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(KnowledgeRoutesGetTopicResponseObject); ok {
+		if err := validResponse.VisitKnowledgeRoutesGetTopicResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// KnowledgeRoutesGetNeighborhood operation middleware
+func (sh *strictHandler) KnowledgeRoutesGetNeighborhood(ctx *gin.Context, id string, params KnowledgeRoutesGetNeighborhoodParams) {
+	var request KnowledgeRoutesGetNeighborhoodRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RoutesResetPassword(ctx, request.(RoutesResetPasswordRequestObject))
+		return sh.ssi.KnowledgeRoutesGetNeighborhood(ctx, request.(KnowledgeRoutesGetNeighborhoodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RoutesResetPassword")
+		handler = middleware(handler, "KnowledgeRoutesGetNeighborhood")
 	}
 
 	response, err := handler(ctx, request)
@@ -3135,8 +5553,8 @@ func (sh *strictHandler) RoutesResetPassword(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RoutesResetPasswordResponseObject); ok {
-		if err := validResponse.VisitRoutesResetPasswordResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(KnowledgeRoutesGetNeighborhoodResponseObject); ok {
+		if err := validResponse.VisitKnowledgeRoutesGetNeighborhoodResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3144,23 +5562,18 @@ func (sh *strictHandler) RoutesResetPassword(ctx *gin.Context) {
 	}
 }
 
-// RoutesSignup operation middleware
-func (sh *strictHandler) RoutesSignup(ctx *gin.Context) {
-	var request RoutesSignupRequestObject
+// KnowledgeRoutesGetTimeline operation middleware
+func (sh *strictHandler) KnowledgeRoutesGetTimeline(ctx *gin.Context, id string, params KnowledgeRoutesGetTimelineParams) {
+	var request KnowledgeRoutesGetTimelineRequestObject
 
-	var body RoutesSignupJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RoutesSignup(ctx, request.(RoutesSignupRequestObject))
+		return sh.ssi.KnowledgeRoutesGetTimeline(ctx, request.(KnowledgeRoutesGetTimelineRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RoutesSignup")
+		handler = middleware(handler, "KnowledgeRoutesGetTimeline")
 	}
 
 	response, err := handler(ctx, request)
@@ -3168,8 +5581,8 @@ func (sh *strictHandler) RoutesSignup(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RoutesSignupResponseObject); ok {
-		if err := validResponse.VisitRoutesSignupResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(KnowledgeRoutesGetTimelineResponseObject); ok {
+		if err := validResponse.VisitKnowledgeRoutesGetTimelineResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3443,6 +5856,126 @@ func (sh *strictHandler) MindmapRoutesGetMindmap(ctx *gin.Context, id string, pa
 	}
 }
 
+// MindmapRoutesEditMindmap operation middleware
+func (sh *strictHandler) MindmapRoutesEditMindmap(ctx *gin.Context, id string, params MindmapRoutesEditMindmapParams) {
+	var request MindmapRoutesEditMindmapRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body MindmapRoutesEditMindmapJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MindmapRoutesEditMindmap(ctx, request.(MindmapRoutesEditMindmapRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MindmapRoutesEditMindmap")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MindmapRoutesEditMindmapResponseObject); ok {
+		if err := validResponse.VisitMindmapRoutesEditMindmapResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MindmapRoutesDiffRevisions operation middleware
+func (sh *strictHandler) MindmapRoutesDiffRevisions(ctx *gin.Context, id string, params MindmapRoutesDiffRevisionsParams) {
+	var request MindmapRoutesDiffRevisionsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MindmapRoutesDiffRevisions(ctx, request.(MindmapRoutesDiffRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MindmapRoutesDiffRevisions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MindmapRoutesDiffRevisionsResponseObject); ok {
+		if err := validResponse.VisitMindmapRoutesDiffRevisionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MindmapRoutesListEdits operation middleware
+func (sh *strictHandler) MindmapRoutesListEdits(ctx *gin.Context, id string, params MindmapRoutesListEditsParams) {
+	var request MindmapRoutesListEditsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MindmapRoutesListEdits(ctx, request.(MindmapRoutesListEditsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MindmapRoutesListEdits")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MindmapRoutesListEditsResponseObject); ok {
+		if err := validResponse.VisitMindmapRoutesListEditsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MindmapRoutesExportMindmap operation middleware
+func (sh *strictHandler) MindmapRoutesExportMindmap(ctx *gin.Context, id string, params MindmapRoutesExportMindmapParams) {
+	var request MindmapRoutesExportMindmapRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MindmapRoutesExportMindmap(ctx, request.(MindmapRoutesExportMindmapRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MindmapRoutesExportMindmap")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MindmapRoutesExportMindmapResponseObject); ok {
+		if err := validResponse.VisitMindmapRoutesExportMindmapResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // MindmapRoutesGenerateMindmap operation middleware
 func (sh *strictHandler) MindmapRoutesGenerateMindmap(ctx *gin.Context, id string, params MindmapRoutesGenerateMindmapParams) {
 	var request MindmapRoutesGenerateMindmapRequestObject
@@ -3479,6 +6012,128 @@ func (sh *strictHandler) MindmapRoutesGenerateMindmap(ctx *gin.Context, id strin
 	}
 }
 
+// MindmapRoutesRelayout operation middleware
+func (sh *strictHandler) MindmapRoutesRelayout(ctx *gin.Context, id string, params MindmapRoutesRelayoutParams) {
+	var request MindmapRoutesRelayoutRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body MindmapRoutesRelayoutJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MindmapRoutesRelayout(ctx, request.(MindmapRoutesRelayoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MindmapRoutesRelayout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MindmapRoutesRelayoutResponseObject); ok {
+		if err := validResponse.VisitMindmapRoutesRelayoutResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MindmapRoutesListRevisions operation middleware
+func (sh *strictHandler) MindmapRoutesListRevisions(ctx *gin.Context, id string, params MindmapRoutesListRevisionsParams) {
+	var request MindmapRoutesListRevisionsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MindmapRoutesListRevisions(ctx, request.(MindmapRoutesListRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MindmapRoutesListRevisions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MindmapRoutesListRevisionsResponseObject); ok {
+		if err := validResponse.VisitMindmapRoutesListRevisionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MindmapRoutesGetRevision operation middleware
+func (sh *strictHandler) MindmapRoutesGetRevision(ctx *gin.Context, id string, version int32, params MindmapRoutesGetRevisionParams) {
+	var request MindmapRoutesGetRevisionRequestObject
+
+	request.Id = id
+	request.Version = version
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MindmapRoutesGetRevision(ctx, request.(MindmapRoutesGetRevisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MindmapRoutesGetRevision")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MindmapRoutesGetRevisionResponseObject); ok {
+		if err := validResponse.VisitMindmapRoutesGetRevisionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MindmapRoutesRestoreRevision operation middleware
+func (sh *strictHandler) MindmapRoutesRestoreRevision(ctx *gin.Context, id string, version int32, params MindmapRoutesRestoreRevisionParams) {
+	var request MindmapRoutesRestoreRevisionRequestObject
+
+	request.Id = id
+	request.Version = version
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MindmapRoutesRestoreRevision(ctx, request.(MindmapRoutesRestoreRevisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MindmapRoutesRestoreRevision")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MindmapRoutesRestoreRevisionResponseObject); ok {
+		if err := validResponse.VisitMindmapRoutesRestoreRevisionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // RoutesPause operation middleware
 func (sh *strictHandler) RoutesPause(ctx *gin.Context, id string, params RoutesPauseParams) {
 	var request RoutesPauseRequestObject
@@ -3590,6 +6245,41 @@ func (sh *strictHandler) SubscriptionRoutesGetSubscription(ctx *gin.Context, par
 	}
 }
 
+// SubscriptionRoutesCreateCheckout operation middleware
+func (sh *strictHandler) SubscriptionRoutesCreateCheckout(ctx *gin.Context, params SubscriptionRoutesCreateCheckoutParams) {
+	var request SubscriptionRoutesCreateCheckoutRequestObject
+
+	request.Params = params
+
+	var body SubscriptionRoutesCreateCheckoutJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SubscriptionRoutesCreateCheckout(ctx, request.(SubscriptionRoutesCreateCheckoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SubscriptionRoutesCreateCheckout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SubscriptionRoutesCreateCheckoutResponseObject); ok {
+		if err := validResponse.VisitSubscriptionRoutesCreateCheckoutResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // SubscriptionRoutesListPlans operation middleware
 func (sh *strictHandler) SubscriptionRoutesListPlans(ctx *gin.Context, params SubscriptionRoutesListPlansParams) {
 	var request SubscriptionRoutesListPlansRequestObject
@@ -3617,6 +6307,33 @@ func (sh *strictHandler) SubscriptionRoutesListPlans(ctx *gin.Context, params Su
 	}
 }
 
+// SubscriptionRoutesCreatePortal operation middleware
+func (sh *strictHandler) SubscriptionRoutesCreatePortal(ctx *gin.Context, params SubscriptionRoutesCreatePortalParams) {
+	var request SubscriptionRoutesCreatePortalRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SubscriptionRoutesCreatePortal(ctx, request.(SubscriptionRoutesCreatePortalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SubscriptionRoutesCreatePortal")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SubscriptionRoutesCreatePortalResponseObject); ok {
+		if err := validResponse.VisitSubscriptionRoutesCreatePortalResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // UsageRoutesGetUsage operation middleware
 func (sh *strictHandler) UsageRoutesGetUsage(ctx *gin.Context, params UsageRoutesGetUsageParams) {
 	var request UsageRoutesGetUsageRequestObject
@@ -3674,70 +6391,127 @@ func (sh *strictHandler) UsageRoutesGetUsageHistory(ctx *gin.Context, params Usa
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdb2/bRpP/KgvevXAA1bLTXJEzcC/aJNfm4LZG3PRNEQhrciSx5b9wl47dwIBby4Gb",
-	"uHcpLkGcVHadNk/zuHABNX/6uECeL2StvsODXZISSe1SlGMnjqM3sURxZ2dmZ34z+29yXdNd23MdcCjR",
-	"pq5rRK+DjcXH9wNaH+f/XALiuQ4B/tAAovumR03X0aY0trnHft1EbPOH9q3nWknzfNcDn5ogCFD3K3DE",
-	"h0UPtCmNUN90atpSSQsI+PyHf/ehqk1p/1buMVGOOCiL7i/zF5eWSpoPVwPTB0Ob+iJsXYrIXynF5N25",
-	"L0GnnLxo+t+uX3PpDCbkmusbl+BqAIT2i9D+q9FuLbefrHU29hDb2mWNR2z7LmIP7rAnv/eJBDY2LZke",
-	"nrV37rDNF4j98oLdWNdKmm060+DUaF2bmixlNZARKKSqlORD161ZwD+ecw1QShK+hj7lLyL+j+ubX2P+",
-	"I+Lt0IUFvY6dGkSyobFzdd+1+XMKDjFdhz3YOdUnse4aMHRfWql/zH0wTB90Wgl8s59gSCn5DhqzA0KR",
-	"jaleR7QOyHUABQQMZDoIp7r0Q5Wc0gYpWg95S7FSQO3FVN5+2Nz/xx7b3FMZj+6DAQ41saWkdFG8QBfR",
-	"LPjzpg6E3bvNGk3Ubv3INpfRxfPoM271aKxHq4DUvX6Vwk67NdNRO8kg0bp+0TfsXuSAkh+lXpBooWT2",
-	"EhA4uGffb7R/WVcJ4sC1SpLljJ+vrKEU2TH2vMlurKOzbOv2qbTXn5X4QBcRM2S7zHVubHe+aQ0JHyHV",
-	"Upp3pfJmzZoTeEqtdR6ssx9v77eW2dbqEaNg2jhyBm8oLR/Yqi5HQSkj1Le77MEO27qN2Pbd9tNnMq/G",
-	"FIwKFsqsur7NP2kGpvAONW0pFqrdxTTkAdMzhuwkowbT0EpdXSRYTpGWqeaca9uuM37B911fnQjs77Xa",
-	"T/cQu3e7/dOuKh8AToN/kEeYPqFtIATXYDByxC/28581BsFBjpifY8s0RFA5DzQaozS3VRMs4+XYDUmU",
-	"ctiW8HMhVl7GPJvbnQd/Z40/0P6TZZGLiSEoqntDSCk+mhRsMigpU6lpqSsC9n28+KpH78I853L8A54r",
-	"hJ+V+MZB6smjzs091G612F8bSoybj1PivPb3nqGxyXcmJyb2W02OTIWUGHEr/pzHFAtt4YWLYdvJiQmB",
-	"cPHXrGazSgn5LKoVdSafVYvchz3f1YEQkAXHJxvtX3fbt5uoR4ytbWilHl6ZDn33dA+rTIdCjSf4PDJS",
-	"WVrEnm0OTy2joR7LcTc5yuoNSp6Kvm+xzWedRkuSLVsyL+3c3eCtN1/wZlyQle/YyjeyyGADxYa8+z/v",
-	"7LeWUXvnTmdlucsBGvuf2U8/OSUj5bgU8uJZe3Wvc3NP1pKABTqVCXJudhaxxnZnZZNt3ZY1pbBAB8vf",
-	"Wf2e3Xyk6J3HM0Kx7eVbaZOtbCJ2q7nfaqCxy465gLoNkU1OZQzlvTNyszOpJVFS5385v+zxMmLbzfZv",
-	"O1I2xQM1h50VnkLJWga+ldfl5UvTA8O5+DWpqkEmPW0SWsj7f9tpP1TO6OtmrW6ZtTotHjEiJj6KW8pC",
-	"hYdrUJk3iTk83Rlcg895SxndLqgMDRkJhkpJqYsiyCzFxdC2c+P5/tNGMX0XgNGMJosCb6UX7Qo0CRzz",
-	"agCVwLfIQZSb6rGUp+tkRzka79nWYOBV5PAxbPf56kGye0UOH+NxLtwqAbVAei9eLUXCpFjPUV7PgXIg",
-	"qd3aae8qtWcEvsgFK3ZRC1JoqIvGKsjsey7s5qXnRZx6ipZMXx+bjmFjb/xDcMDHFKLv6uWHx2tsc6/9",
-	"/8324+eIrWzyHF2Ra1ZdX49QoooDi2pTVWwRKGUnWa27bLspljIicvd2238u92Scc10LsCOEVPIf/R3E",
-	"8OFNduN0Jg/NM8zFWbHI+CvqmYTSkggQwi1S9TPFNCBDMjUbNjq02XiCxy5Hw83NZVrLH1Z15gpGDYpH",
-	"30zPF4wayAKwhRfdgA5JbDpsJBDTODhPn7iGhKfMQIQ9lCLpuwwXULYQeYAP3fuFPV7u07SF50COZsQN",
-	"IiDoh0bs14BKf7oGcezrWaIbzFkJM3QCe04SiaP+utS7tArIP90d2jxz217jEeRug/24gsIlzv4ZJfZx",
-	"OLjYMExOBVszyTdkYBYn3wWy5AKyfCLd40hLsso/DZE4xKg3hFAKsFLbi+cSM2S2mGfMxO9zWzO/hkI2",
-	"U1TZAsZCXkvx/ER0EmckBQZCnTGnHUueLdu9yDYETvSvR0XPC/A7240jubH/m84KNx1wAlvMLsAxuAZL",
-	"Wi3MJcIvnFULqFinqGLTguRadW/Q+wazr/d3zyP283rnh35zXSg44osF3/v6IKizoPEOeGOZhmfDqDge",
-	"/ZXMnRp7bHXjUBOUJH2Jm4FjHM4MII74xdKPjCZ66Qeh2B9WxpzU+lCTmW4ek2ByuKQmI/aAhYvQGPJX",
-	"LSLeimcSWRsclEV0Oyggz0BZ8oUYmnU5q0U4VYFbzGcW1nzQXT8CNg8H4YJrtPg6FMLFfFwWhtLVm2pB",
-	"P2Ln3mqU3N5U7lIPcnSVk8iC9Www1yU2PmNhCVJ17qy3t5oqpJozLct0ahUPfNOV40UVMA18yEuO+qZ+",
-	"xfMKGy9UdNfRA98Hh1aSXlJgCu9gW44nnm/qUNGHWE+KscMHCo5YSDDwYvH1q6/AqVimbdKDLEYJ8BKy",
-	"pDkvZccnMRpXiphDPm5FppGPW56FhwGtPoMcBFsh/YHSJL9cdKquZPf3+W77/1aVIRk7OlgVTCNVVsAx",
-	"5JYbm2L6vWIBLtNWhJ+XDthe5NdD670X4YvEzu7cXyaDVC0luVYjjoca0pxN/WhYFQEpQWMoHfXZU1+M",
-	"SlKWyXKZ4BqMi38/Mgl1/cWcqCr2u9o/fYc6W2vs5qPOje32r7vqBXdBrrDTJTiZDWwb+4sDvS7uYoBg",
-	"RSRSCBHEq2XDct53zlJ1OkHSOE/xG43O/Z3+ALhY4V9xbECqCFckCGQZ1LFTCQhUsClHGpNUAkcEDVBg",
-	"kQc+jwSVINrrLjAbOghsHQyuhg16UQvSL03RDbE0IqUgJ0k6zVpGixm1p0aplDYHyVkQPmpR9InyNDEV",
-	"/sik6P2Zi1pJmwc/zJC1ifGJ8QkuteuBgz2Tz4nFI56Y0rqwqvL8ZBkHtF6uinPC7ySPonnuMOcJe6fe",
-	"4qyzK8dFQ5vSLrkBBZI+jqyF6gVCP3CNxXAlyeHZj3AEz7NMXRAof0lC5wjdttCZafnB56X0kFI/APEg",
-	"RBmhktMTE0Nxkll9OYTzPtnJuPZZHeLTvaiOCSKBrgMYYIzz4T0zJMNDnWwKD1wpeCLgz4OPdDewDOS4",
-	"FAWOAT6h2DHEWeWYZyMARF1kOvOcLiKLDsUL45zqUqlngjVx+FdtefJjxgpD6x1bPkoj6z8cfQQGNpCL",
-	"1O2Et8h8OO+Th817+oinhPP3dT6VRyZBgRMfwBeqlFlzOT7WWcCk8y4rqG4p5Jv+ufig/5Gbf/JKxsgF",
-	"Ri4gXMBya6aTk0kMgHBxGeMozTd12+NNtNrjPPLRtmju0IsdUfXocxKlcGcUKPhEm/riusYtSqsDNsTt",
-	"t3D9TUtdhNKy41hKKCGblF1587O/Y2sFdjRtt0B2BHf/aUNMHL79nW03x1Ed+8Z/8dFim3to/8k/2YMW",
-	"Ov9BdPGL3W+w7Ub0LhrrrK6HB2dR5/4Gf1e81X68jjr31nIi43nBysdw5FZVuq7BgmeJ2B8dYhI9XA3A",
-	"X+x1wGXWJHR6J5j6zfNMvyY/q4MPfDQcF0V2wIMFAcdAVddHtG6S2IJKaC6gIrSE0hJk40U0J+4UVgNr",
-	"HB07m+LsvPsa2am6/pxpGOCMh+9FB1AyK9kbDba1i7LXpBB72Oo8WFeY4yswxMOFt0O4sHyiAc+Hqg+k",
-	"ro574cVGtN/6g93aVljFpYjIm2Uaqlvu0kuaJ9wICLzMQpq4mKu0jcSV36NMjaV3i0eLZ4djSIe/dEbE",
-	"beYc3ElcZ1aYVngh+ihtKn3lupAxTR6T+Rbiw4KRA9eQD+F5UfHCHICDomM9CBOE+c+BRU/AqsJ/vha3",
-	"iDnTXadqmTol6JpJw8IX0f4vIhRTQG5VPAwlTfpD8gCHNFXLHJbKS9CmTUKPy1wh3kvqEepekzg9Ueg6",
-	"aLF+3GqVgKKjQv1cOcJVk7xTcSckh4jtt9zdDJVDOltZQ/Ghr1tNtvWDCtfjzcpXnExOHtWgv0oAP9YG",
-	"ct00lvIWWGLrEIsmuSsirwLjBEUPi/24iJ5pvKSNjdZCXuNaCOflzGvNnbHDc5uqmc5qwOi6eN6KTe/8",
-	"Lmvs5acBHwJ9Uz1k4lih8PjIoU6EQ3lBTl6dqcyRPJGu8K/whPsb5GKHP0HOPez/ircmR54+8nRQZpzl",
-	"XpEMeVztK6IyaIZ9oVcD49i7f8FJdHzx8wgn/f/x1kz6lQV8RoD0FqYebrFydtsNduOhAnQSpeDe6qRD",
-	"XS/wFWccOSX63poNnRE6neR0qUwoLpQ0xZXQBqxH9MqrjVYm1BXnRgnCyAWNpXKiIkrOUiDb3EDpiiVy",
-	"H4yLtMSuGBdQeev9UFXFZuSFIy/seWE5qvaTcysmt2ZgrjOmahK+1bn9gDqNhRL808cBGsQOLdZ18LiZ",
-	"VV0f9YqphBt4ve+iATfQRaCoW2ZlNC0YoeGxQ0NRGigs/Ef1ek4ZpBfsVpNt3w1LKMpmAzOC0mh/8vB2",
-	"LUZIMUKK44MUPpDALgIVW7v7rab64Hhgj1BihBIjlDiZKEGom3P+P4aIn1fVd0tmOYURQIwAYgQQJwQg",
-	"MqXp8i6upmoXqlY+U/Xy4uXP5MNjf2fxQNX5TtwNg4Rs5W51TfnKuLjPjPZby+2bf+vcbaJMxc6idjJt",
-	"EjojejoxFtJX3fSEWEe3bOLge+6i+KLcBMLijTFGiG9v9NDLSlKepAEvJ0p+5iBBfwHRwsMflSg9Ltfp",
-	"bNehdSI/8fbe6z7xllPZ9Y03OlHpmmcz4einG56HebBczxb3PMVb0f9LNaXVKfWmymXL1bFVdwmdOjtx",
-	"dkJburL0rwAAAP//8tZ/Jrx/AAA=",
+	"H4sIAAAAAAAC/+w9XXPTSLZ/pct7H5K9IgkMuzWTqvswfCzDXmagEpiXrSmXYrVjLbLkkeRAdiq3AjFU",
+	"hmSXcCETA3bG7GSATGXvevOxY6qYP2S1/8OtbrVkSe6WW84HSUYvENtS9+nT57tPn/NNJmcUS4YOddvK",
+	"jH+TsXIFWJTJn5+W7cII/mcCWiVDtyD+UoFWzlRLtmromfEMqrfQ6zpA9SfO0l5GypRMowRNW4VkABPm",
+	"TWgVsrZxG+r4C3u2BDPjGcs2VX06Mydl+L+ULWjiH/7DhPnMeOY3o10wRymMowTAW/jBuTkpY8Kvy6oJ",
+	"lcz4n9y3veGlCCBfSd50xtSfYc7G05Gh/mCY04Z9Q7asO4apTMCvy9CyexftvKs4zXlne7FTbQG0voUq",
+	"G6ixCtCLZ2j7Hz1IgEVZ1ViY23U2n6H6e4B+eI8eLmekTFHVr0F92i5kxs9KUYxEFuiOyl3JFcOY1iD+",
+	"86KhQO5K3MfAdfwgwP8YpvoXGf8I8Hvg8t1cQdanIV0bGLpYMI0i/t6GuqUaOnqxOdyz4pyhwMRzZaRe",
+	"GjChopowZ2fLpto7oDtS8BkwVCxbNijKdq4A7AIEhg5B2YIKUHUgh6Y0XZQMZ/ohOufCFgJFAO1iKHde",
+	"1do/t1C9xSOenAkVqNuqrHFHukoesGfBJDRn1By00NoKqtSA03yJ6vPg6iVwE1M9GOqOJbDq7rzcxV4z",
+	"plWdzyT9lubzRc+2lygDMn5kckHgDS6wE9CCg3P284rzwzJvITq8kw2CHOHzhUUQGnYI7dXQw2XwMVpf",
+	"GQ5z/cdSjISMDOsD13nY6NxrJhQfnmAMwc5F3qQ6rZdLXKx1Xiyjlyvt5jxaf3DIUjBMHDGblwjLA1PV",
+	"LaqkIou6v4VebKL1FYAaq87OLourZRsqWZkgM2+YRfxXRpFteMZWi0xZyGcX8kt2BppqXoVKLI6ptl7b",
+	"cv49351kyjA0KOt4LFVhK+OSkhDgCEpVJSP5eI0ALAXxEZqLi/cv8buzl/E4XKrsXXYsF/P4zH13H0zG",
+	"WsRFo1g09JHLpmmYfOOq3Wo6Oy2A1lac77d4NhbEY+A/2Dq4ZyuL0LLkadhftnoP9sIfedCFIGaZX8qa",
+	"qhC1ewnalIrD0OZVqCn7A9cdQooBmwHPZQ95kU2vNTov3qLKv0B7e97lGLwForhXyCrJn6oNi1Y/M5aH",
+	"pjl/CbJpyrNHvXuXZzCUIxewNeX+Hctr2xudRy3gNJvoXZWrBWY8NyPu/bVdMHT2zNmxsXazhmW3EBIp",
+	"tOS/S7ItE2zJd6+6754dGyO8632MYjaKFBdOUazwvaMoWtg8XDKNHLQspvDerjqvt5yVGugOhharGakr",
+	"hVXd/uhcVwKrug2noenaDjbLcES79eSjRTDUBdmbJgZZ3U2JQ9Ffm6i+26k0Gf6ExuLSzmoVv11/j1/D",
+	"C1n4Fi3cY+lOpWwSvsoWGbTX+RseBr2ZB2h71/mxCtBSrd2sgKGiJYGSPA2zGpRn4HAESb8/z0R5Ub6b",
+	"tXKmoWlZBZbsQu98aK/mLM8D9Gijc2/LebUB2u8eofouGBo7c5Y7oWKUp7SAmtXLxSk6IbRlhY3afz9r",
+	"N+eBs/msszDvYxcM/XHy+hfDLDTphg3jrBnnQavzqMV6M37FnWoFrW/xVuy+K7haC2owZ7Oo4eLkJECV",
+	"RmehjtZXWDDa8K7dn4g6D/6K4WQvE5s6li0XS/GsXkMLdZ+KbunqXeC/CIqWICHZqq3BWGpt1JyfNplg",
+	"zpYYb3Yh7CxgS531ZtnU4qa8NXGtr6VHfg2iqp9cuKZatpAI/WnTecUNNRXU6YKmThdscbVLgfjMe5Ol",
+	"bwk7zqiWmnzcG/I0/BK/yRrXl8yJ5W4AICm4alExPGnLYiqr83CvvVMRw7eALopgUlR7Zbsmg8ArZV39",
+	"ugyzZVOzBkFuaEYpDtfBiWIw3qWt/tqL4yp6uq+HVwdxIjnunSf4e34IiluuQBXw/MijEl1MCPQY5HUZ",
+	"KEYkOc1NZ4uLvYjiF6AgDoZ8acwTmT3fE7rZt8uMRw+NxcLXf+vGHQ0q03DE/+uiodumOlV28dXD5JUW",
+	"eoAxSPzaZ7+gtRXQbjXR2lZntQac+7voxWYPLm/DWRwMCctBju7pCjpNnoKaxQehCpw3i6jecp7WnDd7",
+	"NHRJoapXiZn6uhL0AfrOiENaZVPL5oyyboutHW1XUX0Z4AhWo4Wqb0FAy4ra2ha0LExpHALyfuYTkmXL",
+	"ZlJ+9gSdkF5ikMktk+FlRugwsLAQkP7eSl3SoBBFN0GQaK+p+m3Ghr2ZR0t10P655axXO88qdN8Atq7Q",
+	"WrO93eswWAXZhEp2MIqlL9Nli8oN+paw5pEyllE2c2xSsGVzGtrMn+5Atjpp7+yhWgO41I2pFvwnoN+F",
+	"iDnw9f0N9HLZeVojX4+CcyIWeJQ23DX4EPdiL4wZqWdr/BUJ0sgX+OkpwxSgE7zolwuUXHpVA8c723iC",
+	"lra6soGcpHz3Fn23i95UgPPmXufeVhIHvKTmBuDLm+S9XvMEfytR0BMirGAYSozZx0Eex/rLQd3ufzzL",
+	"X5iU0VT99r5EFxEWTPHvrndfg/tUxpjAY/EIAte2nO99uqnvOverlBmxgnGaVWdnr7O6S8jpfjWkY0Qj",
+	"XAPKb7pXQcx46KeLEaSkm2oRaqoOE1ARFdXE4awQW7fFjWPrtumzZsjWoyitAGdpw/lhy5NxQ6i64axX",
+	"SWhsUTxO2M9YYjprh8HF3oJFse8BIYbyIRdLztONqI0FugjtrG6idzXQ3l7oPcTPq6ZlZy0I9YPwMPZh",
+	"PDKf1ORBgPNMGY5pyKM0YXEfY3YGjM2Hf8VWdmITk+UeuAgKGWDhNQZhkiJ7GsFiEjrsE7Ph0GR8BIfw",
+	"xb6ktq9cYuUhnSfJcpMvlbPGoFHJo4+uI+YLur0aWmqcSCHnL1gQ3bdMTQTJAdUZRnAf74vHnN54JF7u",
+	"queAJT3kGUOvmp0Xy8OCph/X0SO4yaqK1QcQT1B4Aj0KhLgcZccoePEG1lZ9rupKUS6NXFLzeRyX+dyY",
+	"YXADFps/tjwFU3vvvKoBVP2/9vZbbDzzdy1vGsV+pBeEwGd0znbz9YZtDDBPnOQloJNx+6GNo8Dx6d67",
+	"CmpUcJZQe28Fq2uOy5JwtXywhUCdgLpc5ESIX1dwAMVpPnPmV3jQDrypllpUNdlU7VkGturvne23ANUa",
+	"6P6W87gChsb+56zg2dFB7H13u0NwxiH0sqLa9G9+3lnIPvtbC7154qdcOj+9dZ7gVKJ76MWms1Jt/6uJ",
+	"nYvOatW5X8Uo8H8hxsTqI1Rf7jXnoJLkCMODnf6Pl9BXr7ozxGHiCtShKdswETbQQh0nYnASCvIGjaEo",
+	"MC+XNTsznpc1C0rR6EhzFTVqJKOPDsdLgZqLgf+aPGuU7ZvM07UQzE5jEUu61QqJP3inbVAvFzGipmVN",
+	"vjuL5zYhzEgZU1ZUWXNpOBfM2OiyeGQ/+qHs4LLOvJPlBMTiJV+QxJIsP2FF6qeseT/bsl1OSsGT7ksD",
+	"pbJJmRloWsxAOj3Z9i0T4mttY2k+qEUfjbZiqLsAJEuVY21MH8Ll5mBg42wf8mMasv07zFAJB3O50D22",
+	"UgaH6QtDgX1lmjuDRFfvAyyCbDWfZyD7yQrwFT4mlvbeFnrVpKq/B+myokAlm9AxYurUKOY95SxgyBaN",
+	"Gahk8VnoYDD45iIDDBO6ox/GEk1iv+x/bGoHMV2mQQ6ZgyZEaIN70NGzhvBmCFIh33mNmp48r1WhtJxE",
+	"CeBXoisn4whATcRFHxW39gPLkeAb/kVZL8taTHYT8bhIxhRxvcn4OPn3zTzfWNj/Ic7+D1xijk9YVpyQ",
+	"6TmE1proftN5s+gsbQD0tOk8egY6qxXnaY14yEsbzuvKsGiengnJD+PgNxMTV65cuBCTqOfvX3QEzAYS",
+	"sEqaao93YyzYEZFAEZrTcJwEM3dWiKPZPT5eWPQfkxUli+X4ON1c4PoS7EQ4BVLzI4Ks5Xm0cA8nwuFz",
+	"syEyM07EQs9bwzEDMTz9ANDvGhRi5+lGspNuZr4e/nYcZ/05P72VgPNuEbSb/0uS4XcBuv8P1KixIDVK",
+	"A/gE10t+Zg9zkXS/8C70xARarW5AIOnCS6qus5JlS6o+Dto7DXJnhzDtCP2ITwk8spgP+AGdlxWc2429",
+	"qFrDlSi9TF4yLNVLpJA17Xo+M/4nMVzd8N6c+0qKg7VWQe+qXaHBpL0ImXsolQDWCUQljPuIpb8ESR4f",
+	"lHdWG5R2+2afGCVRkRIfC6YSBV9leFXvEwHet486AXOGqRyApxqmcTF3nYjMgJvnCq2M5Mm/DKFbcnyr",
+	"QRuSqwrmNP6fsAlV7mQjXcuAbF2GsrmAVxjAQPw2HMx9JKpNBggjqEpomiTuEZm1bwJZdN67JcO0/0Dn",
+	"i3eA7u86O7vO/d12qwk61VW0VA9saVE2byvGHbyJRqnohv8gLKo6Bm3alEsF8qViEBhlfUYmJzIz0yLb",
+	"d813hkQjC+4FwJ7dLMmmXPT8ByJ+ZO1G8AlWjMOmUQ2B5F4BjH/BvAEcXoknh0TzHb1QRIJFJQ4PByV9",
+	"MvkuZSz1L1DIpBNFdijOTB6lk3iJlAIbEWP8h8xptkAudsNNCdi8ZyHeMELw4lxHQ+8Dr++5HIgoy5VN",
+	"E+q2SHinnz+wj3jZNI2OJoPdhJZt4Iwqz6OPYG1nD71coWaX82YZ5wPQVKZuGIKYhu6TJP9TOIAViowl",
+	"leeBmBbFfwQHkWAXQW0CEoq3S9jk1MdAMenQAxspHmyT5WJRNmf7WirdCRMsPPGi+6x2wDVyF5NkLR6i",
+	"hJfy4hla/eW4SYWUteNYW4AcJv2Yf+xJ0b3OQi1grpWgrmBc+pO7HzAJYwtcwXiQVQ0qsebZjYBNEJ79",
+	"o0sA/X2586TXirkraAjMCj73l0FiRXczeAL8chyGJ6Ab1E50KBcyQ52d+fb2L7wTOkUtuqViGNt3DjjV",
+	"FRxb+kjC53zO+h4+1dzcBd6NweZb9HKl65oLUK1nWokIrMB5nrChO+mez4zQ/3n3Cw70DC44Pqv+g64c",
+	"zDUg7+xJ7IQtgonuCdsgFxli7tccSOmJyNIiFxmSHKpFlt0nE84lhnirIpgUJmRURGlQ8AaHJbKevmuJ",
+	"X0Ri0NmgikDK0wkenFFtYJLQiKsCSnLZvbpOr7EnUgweHLcIofh445VGoOCsPaCHq4+4FZH6MTqPSViu",
+	"72R5yh9s5IKqaao+PUFrWPH3eNI21RIM5qbWd53Hz/HFX97GC+WW8dLKQlBeLMDcbaNsc1HZ3m6iRm3I",
+	"e27Yz9GLTREpaTInlyACpPdgX0BvaDIrF+DZsrNe4wn+KXcLsiVoqgZb/OahbJdNGBe5YdiWokEPXJgg",
+	"Z+jUIEt6vclLBesZtmSqOZjNJbij64liE9pQJ5czFXlW/E7wbahnNbWo2gPHEGlYNgi5FN2fwG4IkUOf",
+	"ILhLGvFqAJNfAh3QQ5D9tIA7ft/VBD9c1fMGgw33tpzHD7gWjqznoJaVbYrKLNQVNuV6pBh+LpFz5r1L",
+	"tPm+7Z8S5evEeO8aTCKmiGeCMNfARIvExiqFONGWxlSbotvK0e+BMRLhqIeeelR+cGTWWm7hBLIR8u9n",
+	"qmUb5myMkUKO853vvwWd9UVcyONhw3m9xS9iQIYTZroAJKIRHG+KPgsTWRFPAXv5dUkhj+pobtksxstx",
+	"iK9WOs97r49PzWbxR9k/SOVoOGEfLwBgTtazZQtmZZUtaVQrW9aJ0oAcWVSCJtYE2TItwiTgkw8itgYT",
+	"V0mVHn3D6l2NaJGRsEQKiZzg0GHQIliMoD20S1KYHHqJDkOkUu1DzV4SsfhMtcGnN64GUiLHM2MjYyNj",
+	"bg4D1OWSiiMz5Cts59sFQlWjM2dHcQHY0Twp8XsmWEWyZCQpBdqtKuiZm/46riqZ8cyEUbahFa4knHHR",
+	"Cy37gqHMusdcuk2Di3KppKk5MsDony2XOVy2FSp/zK5ZPBfeUtssQ/KFK2UISs6NjSWCJHI0dACF6KKx",
+	"jczNAvQK84KCbAGrnMtBqEBlBG/v+bGxA0MdpxIgByYLmjPQBDmjrClAN2xQ1hVoWrasK8AOwKyUIbAN",
+	"oOozeFxgzeq2fHcEjzondUlwmtTt5VMeu0Iwh9C6FYcPk8h66xofAoH1hSJUivxXRD4Y9rMHDXu49igD",
+	"8k9zOWhZQLVAWfdqZxNUsqh51Ks3KkDScXXGeQXG40n/olej+9DJP1hNPWWBlAUIC2i4EHqMJdFHhJM6",
+	"6odJvqFC7SeRao/zztOcrditd8/Jhuiplk8P3lXPe03QebzSbjWH+RSCp5Hc1C5oQ9MiKaiY6jIFKCvQ",
+	"9OJa45lQn4NMdK+lAKKihttXJ99CPOaUckbWtBhq+emt87SBkzRJDQC39lCIgvzbCuSimft4Agr6VNNS",
+	"IjrBRORdxyb5w4wSYBXioZIc/xFQkE3lv/Bu4aI77e1f0IsmuHSBkhV67qbLkGfBUOfBslv1FnSeV/Gz",
+	"5CnnzTLorC3GmGCXCCifw0OnKumbDLxb0oiRSW/4khm+LkNztjsBXnOGMU73em8veZ7vxeTNAjQh3g3d",
+	"AJQOsFViQV0BecMEdkG1PAqSwFTZJjaMu1oLFOVZMEX6zuTL2gg4djSFwfnoA4KTN8wpVVGgPuI+R29J",
+	"sWtGR1pp0PIXHHI8AkI8WPF2AE2uTrXAo327+CqTKr92819oqTFCyQVnutE3AQlQ4js/rn7E9RN2GuRG",
+	"UuQJXM6i1m5966y8BUM43keGwmW8QWd111l6F2zW1Kig7d0+KneCwn6yKHLwlm2cDkP9Wq+dKmrFGuKM",
+	"29gm5590sCm3pz2N92l9C1eQf/iKS1Z4ki+Dc6Qm3UmiGQzOJ0cOThBBOUPPa2rOtsAd1XYb5dFDZ2DZ",
+	"sg2BkQe2H7Rhkvl+TlBIG6YY6j6SYxNmP7j01OTgiO1gz0ws0oEuxg4ItKDjkJbbxO4waSrcJk+ImM4e",
+	"k0AbwNsiAx3eASZ0iwyQB6Yg1AFNjwWyBWT8c1mzT0E4+UTLYGJjzJ7xmwIK2hixsjfQVO8wuYTRuy+V",
+	"u8dQ7t72anKOdivoMF31fuVeicdOqpi2f36PFmsS6aq38C36bpcUXVlfxJeYNndBe3feabx1HldoqdMw",
+	"kfo1QmlEU7Xsm15ZnGMRfPo6cwCDeKk03YH8yna/GxOpKfbVIR7mCJUDPiUeXZT8R79RlbmkPNDeed9b",
+	"z5cTworQ9xXokvdRUDcZEedKdcdTlSN1EQehulNCcRic8x9UVcg61hN5NawhoOKbgn35YlQPdJkYiElc",
+	"0b9S80612tsLvuYg/S4A7Zt4TgJERnqfz46NDQvwUrANxslgKUGFQZDDVhhnhWpQ7lsvnTuOeonZ9iQV",
+	"FB9cUNi0iUhiazLcQ0RYhXrTpVpUoKFLyh9HyB/BG3hsTghfHo47+MQG+HFxg/atKQTnMfJ5C3Im+uAa",
+	"Ke6W+ClxkDz6HfVvs3CCULgGJ/V8lmpo/QkYcu9Dkhymx8/xCWug2QZq1IivtLuI7UL84/mxc7iA4QOs",
+	"EkjdCVx7s92cdx79KIHzY5+MA/Sm0vnuW4B7t9Vb/mB/f+D8sIzLlqIXz3iHtJPeFZcjPkA7e1iUdpTR",
+	"32Mm+88dOTgXNRUHbKEbqj7JQWWfmb0oBy/JzGMur5AsPyvspJpcaT7YB8wHOyk2nBRrsqGFe6jSijfZ",
+	"rkA7dUoOQnmNpAx1KhiqVI7xgTafdRbm/e4koWo6HP5yq/OcIBY7+OPW2EJFR3wPKOX0lNMh1+IchTNe",
+	"BSG2Xq3vOtvkfoJoNOSyO+BpivR7JaA/+BHzaQjQuPQxQv47RdGZVCANZHoYVrzQaTbRuypJ++fmZV+Q",
+	"7VzhJEmdQzI6KGMF0PGBLA4WIMfo4vyRJHOl0uk0m0s48i5kNHUe7rV3Kn3jEYRLJm35REmwI7APCEpS",
+	"AyFlwSALBnqjxIQCyRlXqEg9mwe96useK9LPKR/y+tmkXPgrNNOxKSfUjW0EhBqehXr+kSTDwCtobQV3",
+	"tCTH0LSTOr4BTG5KBJqqr/qN192R0cYT2vnAWSE9C86PfTIcz9eBTvC/ahchpjP+EfsIByhdUgchlYsf",
+	"QC6emqQDz6Aa9bpNM62q+AbqYMjt4DzqpieMuq1/fTFd8xujum0NcJ6R3+qgj+x2O2l73bhOU1iZ9iPn",
+	"j3tgAWHb2Oc8R2hohjqnp8Zm6vIFJJTfp5gtohjNgWn7Y/8qZHXDWa9igcS86RgSPOQwi0yY+oJ9mk+n",
+	"bJqyaZBNSdtnIT5F9QroLC+juttMMdgEeuhz2vR59PqNz6+BdrOGXjyTwB9MCDEdSuAK7vv8+TUJXLp+",
+	"UwJ/nLz+BbhIWj9LYPLLK8MjgW5Kbs9j3PDu1sQ1PJPblp0Wpeqs4ufIDRfSJarzeKuz+nYE0GzptRWA",
+	"1h7il92+1NipPT92TgI9rmp9Puzgkgc/6eObEmSdKO9U1L5xzYq4kZMInWAvcRFx99vR34Y5xTdzplRd",
+	"JoBGF5ZEikl0V6zAtGcU1Qp2shZH6Vzqyp62jPRUpfy63Wmv725Mna5wE91wL73YYwp35DSk2dUSEZwk",
+	"CmueOw5hTXLlR87lYAlzBr7c0G2R6V5t6H4mL2CemoU28JtnpvHQ1Cc4tj6B2+uaLws/vQo61Rb6NzbN",
+	"H5KisKG218R+B6hWQe+qzo/vvXMjXHn7fnMEtHcauEzxSo0+iY111Kih9RXn9RZa8zpZu/a+BFCl0Vmo",
+	"k77sgX7aocMq0HlZQWsrzuOK/27s4ZTXi3vjCe393v9UymsAnspvfjf09FgqFcOpGP5V29Gmf/gjFPj1",
+	"D6kYZfDQUkMs7nvCDpyONA/IxUwa/01tvb4cO/oNbRY6l5R3BbP1PGo8MXFTxiAURSfnhNZDesr+KfsL",
+	"sv+oCS3bMONiYd3ckgrw+rUFz1Jq7gnRzh56idNNaLQskbtFQEhFxgcRGamoSN2RE+2OlOSy5covdjK0",
+	"V4Gk/h4X22qsojfznAs/N8hIaQmSgytMkMZaUuF2fOwgE1rlooioWN9qN2v8fjzlYiolUimRSonTKSUs",
+	"24hpqxQqpcktoWmk9xRTAZEKiFMjIMpTgXG+ie3P297bch4/6NOcdzIwoB8zDX557DtYxnJ+YCEjoaWe",
+	"toLPgbWN5gowdzs2lcJNWwbt7SZq1HCyBKpVcK7DpG2qJQgu0gH8cs1uKG0IB9L+2cLZDrh6s0dfwQCb",
+	"RMcEzj9bXk/JpRr43dhHwwKkd5GUM/ZmPxrKO4TSfkGi8xbzoUr7BWG5oGqaqk9PQEU1Yc5O9WDabTZp",
+	"5EvK/O4DqO5Jd9+h13aSJfRKmhzXr8HtGO5Wpid3S10JGF+ysFdC4TPtG2SmU6MW8XJOZQ+EEHUYpi3H",
+	"dOOkugy3unq91asQ2zuNdrNObv1UWlGtSDUeVoKNH3FeIk5PdLsmnh87fxAa8YYL/akhuQNURGmPm8TH",
+	"LcdIgpe9zq9xfowru53vv+WJ6Vt4FN95IZ9ONK+QFYy4qzplQpls+GhBtWzDnO2jrfGOd9YX0aONzsOG",
+	"83orwfZ/Ric4Jm2XioZuFyx2td3ff+hquwFqo2g7PUQ3J2Vc2ejufvjFS3AGakapSKxd8lRGypRNDVOI",
+	"bZfGR0c1IydrBcOyxz8e+3gsM/fV3P8PAOVjPpyS8wAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
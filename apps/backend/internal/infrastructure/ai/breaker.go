@@ -0,0 +1,328 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+)
+
+// ErrCircuitOpen is returned for a provider that is skipped because its
+// circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// BreakerState is the state of a circuit breaker.
+type BreakerState string
+
+// Circuit breaker states
+const (
+	// BreakerClosed lets every request through.
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen skips the provider until OpenTimeout has passed.
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen lets a single probe request through, whose outcome
+	// closes or reopens the circuit.
+	BreakerHalfOpen BreakerState = "half_open"
+)
+
+// gaugeValue is the value of the state in the breaker state metric.
+func (s BreakerState) gaugeValue() float64 {
+	switch s {
+	case BreakerHalfOpen:
+		return 1
+	case BreakerOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// BreakerConfig configures the circuit breakers of a ProviderManager. Zero
+// values use the defaults.
+type BreakerConfig struct {
+	Window      time.Duration // rolling window of the error rate, default 1m
+	MinRequests int           // requests in the window before it can open, default 5
+	FailureRate float64       // failed share of the window that opens it, default 0.5
+	SlowCall    time.Duration // calls slower than this count as failed, default 60s
+	OpenTimeout time.Duration // time before an open circuit is probed, default 30s
+}
+
+// Default circuit breaker settings
+const (
+	DefaultBreakerWindow      = time.Minute
+	DefaultBreakerMinRequests = 5
+	DefaultBreakerFailureRate = 0.5
+	DefaultBreakerSlowCall    = 60 * time.Second
+	DefaultBreakerOpenTimeout = 30 * time.Second
+
+	breakerBuckets = 10
+)
+
+func (c BreakerConfig) withDefaults() BreakerConfig {
+	if c.Window <= 0 {
+		c.Window = DefaultBreakerWindow
+	}
+	if c.MinRequests <= 0 {
+		c.MinRequests = DefaultBreakerMinRequests
+	}
+	if c.FailureRate <= 0 || c.FailureRate > 1 {
+		c.FailureRate = DefaultBreakerFailureRate
+	}
+	if c.SlowCall <= 0 {
+		c.SlowCall = DefaultBreakerSlowCall
+	}
+	if c.OpenTimeout <= 0 {
+		c.OpenTimeout = DefaultBreakerOpenTimeout
+	}
+	return c
+}
+
+// BreakerStatus is a snapshot of a circuit breaker.
+type BreakerStatus struct {
+	Provider     ProviderType `json:"provider"`
+	Model        string       `json:"model"`
+	State        BreakerState `json:"state"`
+	Requests     int          `json:"requests"`
+	Failures     int          `json:"failures"`
+	FailureRate  float64      `json:"failure_rate"`
+	AvgLatencyMs int64        `json:"avg_latency_ms"`
+	OpenedAt     *time.Time   `json:"opened_at,omitempty"`
+	ChangedAt    time.Time    `json:"changed_at"`
+}
+
+type breakerKey struct {
+	provider ProviderType
+	model    string
+}
+
+// breakerBucket counts the calls of one slice of the rolling window.
+type breakerBucket struct {
+	start     time.Time
+	requests  int
+	failures  int
+	latencyMs int64
+}
+
+// circuitBreaker tracks the calls of one provider and model.
+type circuitBreaker struct {
+	state     BreakerState
+	buckets   [breakerBuckets]breakerBucket
+	probing   bool // a half-open probe is in flight
+	openedAt  time.Time
+	changedAt time.Time
+}
+
+// Breakers keeps a circuit breaker per provider and model. A nil *Breakers
+// lets every request through.
+type Breakers struct {
+	cfg      BreakerConfig
+	now      func() time.Time
+	mu       sync.Mutex
+	breakers map[breakerKey]*circuitBreaker
+}
+
+// NewBreakers creates the circuit breakers of a ProviderManager.
+func NewBreakers(cfg BreakerConfig) *Breakers {
+	return &Breakers{
+		cfg:      cfg.withDefaults(),
+		now:      time.Now,
+		breakers: make(map[breakerKey]*circuitBreaker),
+	}
+}
+
+// Allow reports whether a request may be sent to the provider and model.
+// Every allowed request must be followed by Record.
+func (b *Breakers) Allow(provider ProviderType, model string) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := breakerKey{provider, model}
+	cb := b.get(key)
+	now := b.now()
+	switch cb.state {
+	case BreakerOpen:
+		if now.Sub(cb.openedAt) < b.cfg.OpenTimeout {
+			return false
+		}
+		b.transition(key, cb, BreakerHalfOpen, now)
+		cb.probing = true
+		return true
+	case BreakerHalfOpen:
+		if cb.probing {
+			return false
+		}
+		cb.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Release gives back a request let through by Allow that was never sent, so
+// a half-open circuit can send another probe.
+func (b *Breakers) Release(provider ProviderType, model string) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.get(breakerKey{provider, model}).probing = false
+}
+
+// Record records the outcome of a request let through by Allow. A request
+// slower than SlowCall counts as failed even if it succeeded. Requests
+// cancelled by the caller say nothing about the provider and aren't counted.
+func (b *Breakers) Record(ctx context.Context, provider ProviderType, model string, latency time.Duration, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := breakerKey{provider, model}
+	cb := b.get(key)
+	now := b.now()
+
+	if ctx.Err() != nil {
+		cb.probing = false
+		return
+	}
+	failed := err != nil || latency > b.cfg.SlowCall
+
+	if cb.state == BreakerHalfOpen {
+		if !cb.probing {
+			return
+		}
+		cb.probing = false
+		if failed {
+			b.open(key, cb, now)
+		} else {
+			cb.buckets = [breakerBuckets]breakerBucket{}
+			b.transition(key, cb, BreakerClosed, now)
+		}
+		return
+	}
+	if cb.state == BreakerOpen {
+		// Let through before the circuit opened
+		return
+	}
+
+	bucket := b.bucket(cb, now)
+	bucket.requests++
+	bucket.latencyMs += latency.Milliseconds()
+	if failed {
+		bucket.failures++
+	}
+
+	requests, failures, _ := b.window(cb, now)
+	if requests >= b.cfg.MinRequests && float64(failures)/float64(requests) >= b.cfg.FailureRate {
+		b.open(key, cb, now)
+	}
+}
+
+// Status returns a snapshot of every circuit breaker, sorted by provider and
+// model.
+func (b *Breakers) Status() []BreakerStatus {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	result := make([]BreakerStatus, 0, len(b.breakers))
+	for key, cb := range b.breakers {
+		requests, failures, latencyMs := b.window(cb, now)
+		status := BreakerStatus{
+			Provider:  key.provider,
+			Model:     key.model,
+			State:     cb.state,
+			Requests:  requests,
+			Failures:  failures,
+			ChangedAt: cb.changedAt,
+		}
+		if requests > 0 {
+			status.FailureRate = float64(failures) / float64(requests)
+			status.AvgLatencyMs = latencyMs / int64(requests)
+		}
+		if cb.state != BreakerClosed {
+			openedAt := cb.openedAt
+			status.OpenedAt = &openedAt
+		}
+		result = append(result, status)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Provider != result[j].Provider {
+			return result[i].Provider < result[j].Provider
+		}
+		return result[i].Model < result[j].Model
+	})
+	return result
+}
+
+// State returns the state of the circuit breaker of a provider and model.
+func (b *Breakers) State(provider ProviderType, model string) BreakerState {
+	if b == nil {
+		return BreakerClosed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if cb, ok := b.breakers[breakerKey{provider, model}]; ok {
+		return cb.state
+	}
+	return BreakerClosed
+}
+
+func (b *Breakers) get(key breakerKey) *circuitBreaker {
+	cb, ok := b.breakers[key]
+	if !ok {
+		cb = &circuitBreaker{state: BreakerClosed, changedAt: b.now()}
+		b.breakers[key] = cb
+		metrics.AICircuitBreakerState.WithLabelValues(string(key.provider), key.model).Set(cb.state.gaugeValue())
+	}
+	return cb
+}
+
+func (b *Breakers) open(key breakerKey, cb *circuitBreaker, now time.Time) {
+	cb.openedAt = now
+	b.transition(key, cb, BreakerOpen, now)
+}
+
+func (b *Breakers) transition(key breakerKey, cb *circuitBreaker, state BreakerState, now time.Time) {
+	cb.state = state
+	cb.changedAt = now
+	metrics.AICircuitBreakerState.WithLabelValues(string(key.provider), key.model).Set(state.gaugeValue())
+	metrics.AICircuitBreakerTransitions.WithLabelValues(string(key.provider), key.model, string(state)).Inc()
+}
+
+// bucket returns the bucket of now, resetting it if it is from an earlier
+// pass over the window.
+func (b *Breakers) bucket(cb *circuitBreaker, now time.Time) *breakerBucket {
+	width := b.cfg.Window / breakerBuckets
+	start := now.Truncate(width)
+	bucket := &cb.buckets[(start.UnixNano()/int64(width))%breakerBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = breakerBucket{start: start}
+	}
+	return bucket
+}
+
+// window sums the buckets within the rolling window.
+func (b *Breakers) window(cb *circuitBreaker, now time.Time) (requests, failures int, latencyMs int64) {
+	for _, bucket := range cb.buckets {
+		if now.Sub(bucket.start) < b.cfg.Window {
+			requests += bucket.requests
+			failures += bucket.failures
+			latencyMs += bucket.latencyMs
+		}
+	}
+	return requests, failures, latencyMs
+}
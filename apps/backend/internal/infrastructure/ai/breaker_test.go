@@ -0,0 +1,144 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBreakers(cfg BreakerConfig) (*Breakers, *time.Time) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := NewBreakers(cfg)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func recordCalls(b *Breakers, n int, latency time.Duration, err error) {
+	for range n {
+		if b.Allow(ProviderGemini, "flash") {
+			b.Record(context.Background(), ProviderGemini, "flash", latency, err)
+		}
+	}
+}
+
+func TestBreakers_OpensOnFailureRate(t *testing.T) {
+	b, _ := newTestBreakers(BreakerConfig{MinRequests: 4, FailureRate: 0.5})
+	fail := errors.New("503")
+
+	// Below the minimum number of requests
+	recordCalls(b, 3, time.Second, fail)
+	assert.Equal(t, BreakerClosed, b.State(ProviderGemini, "flash"))
+
+	recordCalls(b, 1, time.Second, fail)
+	assert.Equal(t, BreakerOpen, b.State(ProviderGemini, "flash"))
+	assert.False(t, b.Allow(ProviderGemini, "flash"))
+
+	// Other models of the provider are unaffected
+	assert.True(t, b.Allow(ProviderGemini, "pro"))
+}
+
+func TestBreakers_StaysClosedBelowFailureRate(t *testing.T) {
+	b, _ := newTestBreakers(BreakerConfig{MinRequests: 4, FailureRate: 0.5})
+
+	recordCalls(b, 5, time.Second, nil)
+	recordCalls(b, 4, time.Second, errors.New("503"))
+	assert.Equal(t, BreakerClosed, b.State(ProviderGemini, "flash"))
+}
+
+func TestBreakers_SlowCallsCountAsFailures(t *testing.T) {
+	b, _ := newTestBreakers(BreakerConfig{MinRequests: 2, SlowCall: 10 * time.Second})
+
+	recordCalls(b, 2, 30*time.Second, nil)
+	assert.Equal(t, BreakerOpen, b.State(ProviderGemini, "flash"))
+}
+
+func TestBreakers_RollingWindow(t *testing.T) {
+	b, now := newTestBreakers(BreakerConfig{MinRequests: 4, Window: time.Minute})
+	fail := errors.New("503")
+
+	recordCalls(b, 3, time.Second, fail)
+	*now = now.Add(2 * time.Minute)
+
+	// The old failures have left the window
+	recordCalls(b, 1, time.Second, fail)
+	assert.Equal(t, BreakerClosed, b.State(ProviderGemini, "flash"))
+
+	status := b.Status()
+	require.Len(t, status, 1)
+	assert.Equal(t, 1, status[0].Requests)
+	assert.Equal(t, 1, status[0].Failures)
+}
+
+func TestBreakers_HalfOpenProbe(t *testing.T) {
+	b, now := newTestBreakers(BreakerConfig{MinRequests: 2, OpenTimeout: 30 * time.Second})
+	fail := errors.New("503")
+	ctx := context.Background()
+
+	recordCalls(b, 2, time.Second, fail)
+	require.Equal(t, BreakerOpen, b.State(ProviderGemini, "flash"))
+
+	*now = now.Add(31 * time.Second)
+
+	// A single probe is let through
+	require.True(t, b.Allow(ProviderGemini, "flash"))
+	assert.Equal(t, BreakerHalfOpen, b.State(ProviderGemini, "flash"))
+	assert.False(t, b.Allow(ProviderGemini, "flash"))
+
+	// A failed probe reopens the circuit
+	b.Record(ctx, ProviderGemini, "flash", time.Second, fail)
+	assert.Equal(t, BreakerOpen, b.State(ProviderGemini, "flash"))
+	assert.False(t, b.Allow(ProviderGemini, "flash"))
+
+	// A successful probe closes it
+	*now = now.Add(31 * time.Second)
+	require.True(t, b.Allow(ProviderGemini, "flash"))
+	b.Record(ctx, ProviderGemini, "flash", time.Second, nil)
+	assert.Equal(t, BreakerClosed, b.State(ProviderGemini, "flash"))
+
+	status := b.Status()
+	require.Len(t, status, 1)
+	assert.Zero(t, status[0].Requests, "closing resets the window")
+	assert.Nil(t, status[0].OpenedAt)
+}
+
+func TestBreakers_CancelledProbeIsNotCounted(t *testing.T) {
+	b, now := newTestBreakers(BreakerConfig{MinRequests: 1})
+	recordCalls(b, 1, time.Second, errors.New("503"))
+	*now = now.Add(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.True(t, b.Allow(ProviderGemini, "flash"))
+	b.Record(ctx, ProviderGemini, "flash", time.Second, context.Canceled)
+	assert.Equal(t, BreakerHalfOpen, b.State(ProviderGemini, "flash"))
+
+	// The next request probes instead
+	assert.True(t, b.Allow(ProviderGemini, "flash"))
+}
+
+func TestBreakers_ReleasedProbe(t *testing.T) {
+	b, now := newTestBreakers(BreakerConfig{MinRequests: 1})
+	recordCalls(b, 1, time.Second, errors.New("503"))
+	*now = now.Add(time.Minute)
+
+	require.True(t, b.Allow(ProviderGemini, "flash"))
+	b.Release(ProviderGemini, "flash")
+	assert.Equal(t, BreakerHalfOpen, b.State(ProviderGemini, "flash"))
+
+	// The next request probes instead
+	assert.True(t, b.Allow(ProviderGemini, "flash"))
+}
+
+func TestBreakers_Nil(t *testing.T) {
+	var b *Breakers
+	assert.True(t, b.Allow(ProviderGemini, "flash"))
+	b.Record(context.Background(), ProviderGemini, "flash", time.Second, errors.New("503"))
+	b.Release(ProviderGemini, "flash")
+	assert.Equal(t, BreakerClosed, b.State(ProviderGemini, "flash"))
+	assert.Empty(t, b.Status())
+}
@@ -0,0 +1,133 @@
+package ai
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// textSeparators are the boundaries SplitText prefers, in order: paragraphs,
+// lines, sentences and words.
+var textSeparators = []string{"\n\n", "\n", ". ", "? ", "! ", "。", " "}
+
+// tokenCount estimates tokens like EstimateTokens: about four ASCII
+// characters per token, and a token per other character.
+type tokenCount struct {
+	ascii, other int
+}
+
+func (c *tokenCount) add(s string) {
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			c.ascii++
+		} else {
+			c.other++
+		}
+	}
+}
+
+func (c tokenCount) tokens() int {
+	return (c.ascii+3)/4 + c.other
+}
+
+// EstimateTextTokens estimates the tokens of a text the way EstimateTokens
+// does for requests.
+func EstimateTextTokens(s string) int {
+	var c tokenCount
+	c.add(s)
+	return c.tokens()
+}
+
+// SplitText splits text into chunks of at most maxTokens estimated tokens.
+// It splits at the widest boundary that makes chunks fit, from paragraphs
+// down to words, and only splits a word when it is longer than a chunk.
+// Chunks never split a character.
+func SplitText(text string, maxTokens int) []string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+	if maxTokens <= 0 {
+		return []string{text}
+	}
+	return splitText(text, maxTokens, textSeparators)
+}
+
+func splitText(text string, maxTokens int, separators []string) []string {
+	if EstimateTextTokens(text) <= maxTokens {
+		return appendChunk(nil, text)
+	}
+	if len(separators) == 0 {
+		return splitRunes(text, maxTokens)
+	}
+
+	parts := strings.SplitAfter(text, separators[0])
+	if len(parts) == 1 {
+		return splitText(text, maxTokens, separators[1:])
+	}
+
+	var chunks []string
+	var current strings.Builder
+	currentTokens := 0
+	for _, part := range parts {
+		partTokens := EstimateTextTokens(part)
+		if partTokens > maxTokens {
+			chunks = appendChunk(chunks, current.String())
+			current.Reset()
+			currentTokens = 0
+			chunks = append(chunks, splitText(part, maxTokens, separators[1:])...)
+			continue
+		}
+		// The estimate of a concatenation is at most the sum of the parts
+		if currentTokens+partTokens > maxTokens {
+			chunks = appendChunk(chunks, current.String())
+			current.Reset()
+			currentTokens = 0
+		}
+		current.WriteString(part)
+		currentTokens += partTokens
+	}
+	return appendChunk(chunks, current.String())
+}
+
+// splitRunes splits text without a boundary to split at, such as a very long
+// word, between characters.
+func splitRunes(text string, maxTokens int) []string {
+	var chunks []string
+	var count tokenCount
+	start := 0
+	for i, r := range text {
+		next := count
+		next.add(string(r))
+		if next.tokens() > maxTokens && i > start {
+			chunks = appendChunk(chunks, text[start:i])
+			start = i
+			next = tokenCount{}
+			next.add(string(r))
+		}
+		count = next
+	}
+	return appendChunk(chunks, text[start:])
+}
+
+func appendChunk(chunks []string, chunk string) []string {
+	if chunk = strings.TrimSpace(chunk); chunk != "" {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// TruncateText returns the start of text within maxTokens estimated tokens,
+// cut at the widest boundary SplitText would use and marked with "...".
+func TruncateText(text string, maxTokens int) string {
+	if EstimateTextTokens(text) <= maxTokens {
+		return text
+	}
+	if maxTokens < 2 {
+		return ""
+	}
+	chunks := SplitText(text, maxTokens-1) // room for the marker
+	if len(chunks) == 0 {
+		return ""
+	}
+	return strings.TrimRight(chunks[0], ".") + "..."
+}
@@ -0,0 +1,101 @@
+package ai
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+)
+
+func TestSplitText_Paragraphs(t *testing.T) {
+	paragraphs := []string{
+		strings.Repeat("alpha ", 20),
+		strings.Repeat("beta ", 20),
+		strings.Repeat("gamma ", 20),
+	}
+	chunks := SplitText(strings.Join(paragraphs, "\n\n"), 50)
+
+	require.Len(t, chunks, 3, "each paragraph fits, two together don't")
+	for i, chunk := range chunks {
+		assert.Equal(t, strings.TrimSpace(paragraphs[i]), chunk)
+	}
+}
+
+func TestSplitText_Korean(t *testing.T) {
+	text := strings.Repeat("한국어 문장입니다. ", 200)
+	chunks := SplitText(text, 50)
+
+	require.Greater(t, len(chunks), 1)
+	for _, chunk := range chunks {
+		assert.True(t, utf8.ValidString(chunk), "chunks never split a character")
+		assert.LessOrEqual(t, EstimateTextTokens(chunk), 50)
+		assert.True(t, strings.HasSuffix(chunk, "."), "chunks end at a sentence: %q", chunk)
+	}
+	assert.Equal(t, strings.Count(text, "."), strings.Count(strings.Join(chunks, " "), "."), "nothing is lost")
+}
+
+func TestSplitText_LongWord(t *testing.T) {
+	word := strings.Repeat("가", 25)
+	chunks := SplitText(word, 10)
+
+	assert.Equal(t, []string{strings.Repeat("가", 10), strings.Repeat("가", 10), strings.Repeat("가", 5)}, chunks)
+}
+
+func TestSplitText_Short(t *testing.T) {
+	assert.Equal(t, []string{"short"}, SplitText("  short\n", 100))
+	assert.Nil(t, SplitText(" \n ", 100))
+}
+
+func TestTruncateText(t *testing.T) {
+	assert.Equal(t, "short", TruncateText("short", 10))
+
+	truncated := TruncateText("첫 문장입니다. 두 번째 문장입니다. 세 번째 문장입니다.", 10)
+	assert.Equal(t, "첫 문장입니다...", truncated)
+	assert.True(t, utf8.ValidString(truncated))
+}
+
+func TestContextWindow(t *testing.T) {
+	assert.Equal(t, 128_000, ContextWindow("gpt-4o-mini"))
+	assert.Equal(t, 1_047_576, ContextWindow("gpt-4.1-mini"))
+	assert.Equal(t, 1_048_576, ContextWindow(DefaultGeminiModel))
+	assert.Equal(t, 200_000, ContextWindow(DefaultClaudeModel))
+	assert.Equal(t, DefaultContextWindow, ContextWindow("llama3.1:8b"))
+}
+
+// localProvider stands in for a self-hosted model of unknown size.
+type localProvider struct {
+	*FakeProvider
+}
+
+func (localProvider) Model() string { return "llama3.1:8b" }
+
+func TestProviderManager_InputBudget(t *testing.T) {
+	local := localProvider{NewFakeProvider(nil).WithType(ProviderOpenAICompatible)}
+	pm := newStreamTestManager(t, NewFakeProvider(nil), local)
+	pm.configProvider = staticConfigProvider{cfg: &ent.AIConfig{
+		Provider:          string(ProviderFake),
+		FallbackProviders: []string{string(ProviderOpenAICompatible)},
+		Model:             DefaultFakeModel,
+		MaxTokens:         8192,
+	}}
+
+	// The fallback's unknown model has the default window, half of which is
+	// kept for the input
+	budget, err := pm.InputBudget(context.Background(), TaskMindmap)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultContextWindow/2, budget)
+
+	pm.configProvider = staticConfigProvider{cfg: &ent.AIConfig{
+		Provider:  string(ProviderFake),
+		Model:     DefaultFakeModel,
+		MaxTokens: 8192,
+	}}
+	budget, err = pm.InputBudget(context.Background(), TaskMindmap)
+	require.NoError(t, err)
+	assert.Equal(t, 128_000-8192, budget)
+}
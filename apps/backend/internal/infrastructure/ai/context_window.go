@@ -0,0 +1,67 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DefaultContextWindow is the context window assumed for unknown models,
+// such as those of self-hosted servers. It is small on purpose.
+const DefaultContextWindow = 8192
+
+// contextWindows are the context windows in tokens by model name prefix.
+// Longer prefixes are listed first so they take precedence.
+var contextWindows = []struct {
+	prefix string
+	tokens int
+}{
+	{"gpt-4.1", 1_047_576},
+	{"gpt-4o", 128_000},
+	{"gpt-4-turbo", 128_000},
+	{"gpt-3.5-turbo", 16_385},
+	{"o1", 200_000},
+	{"o3", 200_000},
+	{"o4", 200_000},
+	{"gemini-1.5-pro", 2_097_152},
+	{"gemini", 1_048_576},
+	{"claude", 200_000},
+	{DefaultFakeModel, 128_000},
+}
+
+// ContextWindow returns the context window in tokens of a model, input and
+// output together.
+func ContextWindow(model string) int {
+	model = strings.ToLower(model)
+	for _, w := range contextWindows {
+		if strings.HasPrefix(model, w.prefix) {
+			return w.tokens
+		}
+	}
+	return DefaultContextWindow
+}
+
+// InputBudget returns how many input tokens a request for the task may have
+// so it fits the context window of each of the task's providers, after the
+// output tokens of the task's config.
+func (pm *ProviderManager) InputBudget(ctx context.Context, task TaskType) (int, error) {
+	cfg, err := pm.configProvider.GetConfigForTask(ctx, string(task))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get config for task %s: %w", task, err)
+	}
+	providers := pm.getProvidersFromConfig(cfg)
+	if len(providers) == 0 {
+		return 0, fmt.Errorf("no available providers for task %s", task)
+	}
+
+	budget := 0
+	for _, provider := range providers {
+		window := ContextWindow(requestForProvider(cfg, provider, ChatRequest{}).Options.Model)
+		// Small windows keep at least half for the input
+		output := min(cfg.MaxTokens+cfg.ThinkingBudget, window/2)
+		if budget == 0 || window-output < budget {
+			budget = window - output
+		}
+	}
+	return budget, nil
+}
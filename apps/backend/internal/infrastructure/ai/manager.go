@@ -2,14 +2,18 @@ package ai
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/metrics"
 )
 
 // ConfigProvider provides AI configuration from database.
@@ -37,6 +41,11 @@ type ProviderManager struct {
 	providers      map[ProviderType]Provider
 	configProvider ConfigProvider
 	logProvider    LogProvider
+	breakers       *Breakers
+	limiter        Limiter
+	rateLimits     RateLimits
+	maxWait        time.Duration
+	cache          ResponseCache
 	mu             sync.RWMutex
 }
 
@@ -51,6 +60,9 @@ func NewProviderManager(
 		providers:      make(map[ProviderType]Provider),
 		configProvider: configProvider,
 		logProvider:    logProvider,
+		breakers:       NewBreakers(cfg.Breaker),
+		rateLimits:     cfg.RateLimits,
+		maxWait:        cfg.RateLimitMaxWait,
 	}
 
 	// Initialize all providers with API keys
@@ -86,6 +98,26 @@ func NewProviderManager(
 		slog.Info("initialized ai provider", "provider", "claude")
 	}
 
+	if cfg.OpenAICompatible.BaseURL != "" {
+		pm.providers[ProviderOpenAICompatible] = NewOpenAICompatibleProvider(cfg.OpenAICompatible)
+		slog.Info("initialized ai provider",
+			"provider", "openai_compatible",
+			"base_url", cfg.OpenAICompatible.BaseURL,
+		)
+	}
+
+	if cfg.Fake.Enabled {
+		script := &FakeScript{}
+		if cfg.Fake.ScriptPath != "" {
+			var err error
+			if script, err = LoadFakeScript(cfg.Fake.ScriptPath); err != nil {
+				return nil, err
+			}
+		}
+		pm.providers[ProviderFake] = NewFakeProvider(script)
+		slog.Warn("initialized fake ai provider, responses are scripted", "script", cfg.Fake.ScriptPath)
+	}
+
 	if len(pm.providers) == 0 {
 		slog.Warn("no ai providers configured (missing API keys)")
 	} else {
@@ -95,38 +127,62 @@ func NewProviderManager(
 	return pm, nil
 }
 
-// Chat executes a request using DB-configured provider for the task.
+// Chat executes a request using DB-configured provider for the task. Tasks
+// with a cache TTL are answered from the response cache when possible.
 func (pm *ProviderManager) Chat(ctx context.Context, task TaskType, req ChatRequest) (*ChatResponse, error) {
-	cfg, err := pm.configProvider.GetConfigForTask(ctx, string(task))
+	cfg, req, providers, err := pm.prepare(ctx, task, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get config for task %s: %w", task, err)
+		return nil, err
 	}
 
-	// Apply DB config to request options
-	req.Options.Temperature = cfg.Temperature
-	req.Options.MaxTokens = cfg.MaxTokens
-	req.Options.JSONMode = cfg.JSONMode
-	if cfg.ThinkingBudget > 0 {
-		req.Options.EnableThinking = true
-		req.Options.ThinkingBudget = cfg.ThinkingBudget
+	key, ttl := pm.cacheKey(cfg, task, providers, req)
+	if resp := pm.cachedResponse(ctx, task, key, req); resp != nil {
+		return resp, nil
 	}
-
-	providers := pm.getProvidersFromConfig(cfg)
-	if len(providers) == 0 {
-		return nil, fmt.Errorf("no available providers for task %s", task)
+	resp, err := pm.chat(ctx, task, cfg, req, providers)
+	if err == nil {
+		pm.storeResponse(ctx, task, key, ttl, resp)
 	}
+	return resp, err
+}
 
+// chat tries the providers in order until one succeeds.
+func (pm *ProviderManager) chat(ctx context.Context, task TaskType, cfg *ent.AIConfig, req ChatRequest, providers []Provider) (*ChatResponse, error) {
 	var lastErr error
 	for _, provider := range providers {
+		providerReq := requestForProvider(cfg, provider, req)
+		if !pm.breakers.Allow(provider.Type(), providerReq.Options.Model) {
+			lastErr = skipOpenCircuit(task, provider, providerReq.Options.Model)
+			continue
+		}
+		reservation, err := pm.reserve(ctx, provider, providerReq)
+		if err != nil {
+			pm.breakers.Release(provider.Type(), providerReq.Options.Model)
+			return nil, err
+		}
+
 		slog.Debug("attempting ai request",
 			"provider", provider.Type(),
-			"model", cfg.Model,
+			"model", providerReq.Options.Model,
 			"task", task,
 		)
 
-		resp, err := provider.Chat(ctx, req)
+		start := time.Now()
+		resp, err := provider.Chat(ctx, providerReq)
+		err = pm.finishCall(ctx, provider, providerReq.Options.Model, reservation, start, resp, err)
+		if errors.Is(err, ErrRateLimited) {
+			pm.logRequest(ctx, task, providerReq, nil, err.Error())
+			return nil, err
+		}
+		if err == nil {
+			err = validateOutput(providerReq, resp.Content)
+		}
+		if outErr := (*OutputError)(nil); errors.As(err, &outErr) {
+			pm.logRequest(ctx, task, providerReq, resp, err.Error())
+			return pm.repair(ctx, task, provider, providerReq, outErr, resp)
+		}
 		if err == nil {
-			pm.logRequest(ctx, task, req, resp, "")
+			pm.logRequest(ctx, task, providerReq, resp, "")
 			slog.Info("ai request successful",
 				"provider", resp.Provider,
 				"model", resp.Model,
@@ -136,7 +192,7 @@ func (pm *ProviderManager) Chat(ctx context.Context, task TaskType, req ChatRequ
 			return resp, nil
 		}
 
-		pm.logRequest(ctx, task, req, nil, err.Error())
+		pm.logRequest(ctx, task, providerReq, nil, err.Error())
 		lastErr = err
 		slog.Warn("ai provider failed, trying fallback",
 			"provider", provider.Type(),
@@ -147,6 +203,345 @@ func (pm *ProviderManager) Chat(ctx context.Context, task TaskType, req ChatRequ
 	return nil, fmt.Errorf("all ai providers failed, last error: %w", lastErr)
 }
 
+// ChatStream executes a streaming request using DB-configured provider for
+// the task and returns the complete response. A provider that fails before
+// streaming anything falls back to the next one; once deltas reached handler
+// the error is returned instead. Errors are returned, not passed to
+// handler.OnError. A cached response is passed to handler in one delta.
+func (pm *ProviderManager) ChatStream(ctx context.Context, task TaskType, req ChatRequest, handler StreamHandler) (*ChatResponse, error) {
+	cfg, req, providers, err := pm.prepare(ctx, task, req)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ttl := pm.cacheKey(cfg, task, providers, req)
+	if resp := pm.cachedResponse(ctx, task, key, req); resp != nil {
+		if resp.Thinking != "" && handler.OnThinking != nil {
+			handler.OnThinking(resp.Thinking)
+		}
+		if handler.OnContent != nil {
+			handler.OnContent(resp.Content)
+		}
+		if handler.OnDone != nil {
+			handler.OnDone(resp)
+		}
+		return resp, nil
+	}
+	resp, err := pm.chatStream(ctx, task, cfg, req, providers, handler)
+	if err == nil {
+		pm.storeResponse(ctx, task, key, ttl, resp)
+	}
+	return resp, err
+}
+
+// chatStream streams from the providers in order until one succeeds.
+func (pm *ProviderManager) chatStream(
+	ctx context.Context,
+	task TaskType,
+	cfg *ent.AIConfig,
+	req ChatRequest,
+	providers []Provider,
+	handler StreamHandler,
+) (*ChatResponse, error) {
+	var lastErr error
+	for _, provider := range providers {
+		providerReq := requestForProvider(cfg, provider, req)
+		if !pm.breakers.Allow(provider.Type(), providerReq.Options.Model) {
+			lastErr = skipOpenCircuit(task, provider, providerReq.Options.Model)
+			continue
+		}
+		reservation, err := pm.reserve(ctx, provider, providerReq)
+		if err != nil {
+			pm.breakers.Release(provider.Type(), providerReq.Options.Model)
+			return nil, err
+		}
+
+		slog.Debug("attempting ai stream",
+			"provider", provider.Type(),
+			"model", providerReq.Options.Model,
+			"task", task,
+		)
+
+		var resp *ChatResponse
+		streamed := false
+		start := time.Now()
+		err = provider.ChatStream(ctx, providerReq, StreamHandler{
+			OnThinking: func(delta string) {
+				streamed = true
+				if handler.OnThinking != nil {
+					handler.OnThinking(delta)
+				}
+			},
+			OnContent: func(delta string) {
+				streamed = true
+				if handler.OnContent != nil {
+					handler.OnContent(delta)
+				}
+			},
+			OnDone: func(r *ChatResponse) { resp = r },
+		})
+		err = pm.finishCall(ctx, provider, providerReq.Options.Model, reservation, start, resp, err)
+		if errors.Is(err, ErrRateLimited) && !streamed {
+			pm.logRequest(ctx, task, providerReq, nil, err.Error())
+			return nil, err
+		}
+		if err == nil && resp == nil {
+			err = ErrNoResponse
+		}
+		if err == nil {
+			err = validateOutput(providerReq, resp.Content)
+		}
+		if outErr := (*OutputError)(nil); errors.As(err, &outErr) {
+			pm.logRequest(ctx, task, providerReq, resp, err.Error())
+			resp, err = pm.repair(ctx, task, provider, providerReq, outErr, resp)
+			if err == nil && handler.OnDone != nil {
+				handler.OnDone(resp)
+			}
+			return resp, err
+		}
+		if err == nil {
+			pm.logRequest(ctx, task, providerReq, resp, "")
+			slog.Info("ai stream successful",
+				"provider", resp.Provider,
+				"model", resp.Model,
+				"tokens", resp.TotalTokens,
+				"latency_ms", resp.LatencyMs,
+			)
+			if handler.OnDone != nil {
+				handler.OnDone(resp)
+			}
+			return resp, nil
+		}
+
+		pm.logRequest(ctx, task, providerReq, nil, err.Error())
+		lastErr = err
+		if streamed {
+			return nil, fmt.Errorf("ai stream failed: %w", err)
+		}
+		slog.Warn("ai provider failed, trying fallback",
+			"provider", provider.Type(),
+			"error", err,
+		)
+	}
+
+	return nil, fmt.Errorf("all ai providers failed, last error: %w", lastErr)
+}
+
+// rateLimitReservation is the rate limit budget taken for a request.
+type rateLimitReservation struct {
+	key    string
+	window time.Time // minute the tokens were taken from
+	tokens int
+}
+
+// reserve takes a request and its estimated input tokens from the rate limit
+// budget of the provider and model. When the budget is exhausted it waits for
+// the budget to renew if the context deadline and maxWait allow, and returns
+// a RateLimitError otherwise. Without a limiter or a limit nothing is taken.
+func (pm *ProviderManager) reserve(ctx context.Context, provider Provider, req ChatRequest) (rateLimitReservation, error) {
+	model := req.Options.Model
+	key, limit, ok := pm.rateLimits.For(provider.Type(), model)
+	if pm.limiter == nil || !ok {
+		return rateLimitReservation{}, nil
+	}
+
+	tokens := EstimateTokens(req)
+	for {
+		window, wait, err := pm.limiter.Take(ctx, key, limit, tokens)
+		if err != nil {
+			// A limiter outage must not stop AI processing
+			slog.Warn("failed to take ai rate limit budget", "key", key, "error", err)
+			return rateLimitReservation{}, nil
+		}
+		if wait <= 0 {
+			return rateLimitReservation{key: key, window: window, tokens: tokens}, nil
+		}
+
+		if deadline, ok := ctx.Deadline(); wait > pm.maxWait || (ok && time.Until(deadline) < wait) {
+			metrics.AIRateLimited.WithLabelValues(string(provider.Type()), model, "requeued").Inc()
+			return rateLimitReservation{}, &RateLimitError{Provider: provider.Type(), Model: model, Wait: wait}
+		}
+
+		metrics.AIRateLimited.WithLabelValues(string(provider.Type()), model, "waited").Inc()
+		slog.Debug("waiting for ai rate limit", "key", key, "wait", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return rateLimitReservation{}, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// finishCall records the outcome of a provider call in its circuit breaker
+// and corrects the tokens taken from its rate limit budget, in the minute
+// they were taken from. A provider
+// rejecting the call for its rate limit is returned as a RateLimitError.
+func (pm *ProviderManager) finishCall(
+	ctx context.Context,
+	provider Provider,
+	model string,
+	reservation rateLimitReservation,
+	start time.Time,
+	resp *ChatResponse,
+	err error,
+) error {
+	if isProviderRateLimit(err) {
+		// The provider is up, it only wants fewer requests
+		pm.breakers.Record(ctx, provider.Type(), model, time.Since(start), nil)
+		metrics.AIRateLimited.WithLabelValues(string(provider.Type()), model, "rejected").Inc()
+		return &RateLimitError{Provider: provider.Type(), Model: model, Wait: DefaultRateLimitRetry, err: err}
+	}
+	pm.breakers.Record(ctx, provider.Type(), model, time.Since(start), err)
+
+	if reservation.key != "" && resp != nil {
+		if err := pm.limiter.AddTokens(ctx, reservation.key, reservation.window, resp.TotalTokens-reservation.tokens); err != nil {
+			slog.Warn("failed to correct ai rate limit budget", "key", reservation.key, "error", err)
+		}
+	}
+	return err
+}
+
+// skipOpenCircuit records a provider skipped for its open circuit and
+// returns the error to report if no other provider succeeds.
+func skipOpenCircuit(task TaskType, provider Provider, model string) error {
+	metrics.AICircuitBreakerSkips.WithLabelValues(string(provider.Type()), model).Inc()
+	slog.Warn("ai provider circuit open, skipping",
+		"provider", provider.Type(),
+		"model", model,
+		"task", task,
+	)
+	return fmt.Errorf("%s %s: %w", provider.Type(), model, ErrCircuitOpen)
+}
+
+// repair sends an answer that failed validation back to the provider with
+// the problems found, once. The tokens of the failed answer, when known, are
+// added to the repaired response so usage is tracked in full.
+func (pm *ProviderManager) repair(
+	ctx context.Context,
+	task TaskType,
+	provider Provider,
+	req ChatRequest,
+	outErr *OutputError,
+	failed *ChatResponse,
+) (*ChatResponse, error) {
+	slog.Warn("ai response failed validation, asking for a repair",
+		"provider", provider.Type(),
+		"task", task,
+		"problems", outErr.Problems,
+	)
+
+	repairReq := repairRequest(req, outErr)
+	reservation, err := pm.reserve(ctx, provider, repairReq)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	resp, err := provider.Chat(ctx, repairReq)
+	err = pm.finishCall(ctx, provider, repairReq.Options.Model, reservation, start, resp, err)
+	if err == nil {
+		err = validateOutput(repairReq, resp.Content)
+	}
+	if err != nil {
+		metrics.AIOutputRepairs.WithLabelValues(string(task), "failed").Inc()
+		pm.logRequest(ctx, task, repairReq, nil, err.Error())
+		return nil, fmt.Errorf("ai response repair failed: %w", err)
+	}
+
+	metrics.AIOutputRepairs.WithLabelValues(string(task), "success").Inc()
+	pm.logRequest(ctx, task, repairReq, resp, "")
+	slog.Info("ai response repaired",
+		"provider", resp.Provider,
+		"model", resp.Model,
+		"tokens", resp.TotalTokens,
+	)
+	if failed != nil {
+		resp.InputTokens += failed.InputTokens
+		resp.OutputTokens += failed.OutputTokens
+		resp.ThinkingTokens += failed.ThinkingTokens
+		resp.TotalTokens += failed.TotalTokens
+		resp.LatencyMs += failed.LatencyMs
+	}
+	return resp, nil
+}
+
+// repairRequest continues the conversation of req with the invalid answer
+// and a request to fix the problems found in it.
+func repairRequest(req ChatRequest, outErr *OutputError) ChatRequest {
+	messages := make([]Message, 0, len(req.Messages)+2)
+	messages = append(messages, req.Messages...)
+	if req.UserPrompt != "" {
+		messages = append(messages, Message{Role: RoleUser, Content: req.UserPrompt})
+	}
+	messages = append(messages, Message{Role: RoleAssistant, Content: outErr.Content})
+
+	var prompt strings.Builder
+	prompt.WriteString("Your previous answer was rejected for the following problems:\n")
+	for _, problem := range outErr.Problems {
+		prompt.WriteString("- " + problem + "\n")
+	}
+	prompt.WriteString("\nAnswer again with the corrected JSON only, in the same format.")
+
+	metadata := make(map[string]string, len(req.Metadata)+1)
+	maps.Copy(metadata, req.Metadata)
+	metadata["repair"] = "true"
+
+	req.Messages = messages
+	req.UserPrompt = prompt.String()
+	req.Metadata = metadata
+	return req
+}
+
+// prepare loads the DB config for the task, applies it to the request and
+// returns the providers to try in order.
+func (pm *ProviderManager) prepare(ctx context.Context, task TaskType, req ChatRequest) (*ent.AIConfig, ChatRequest, []Provider, error) {
+	cfg, err := pm.configProvider.GetConfigForTask(ctx, string(task))
+	if err != nil {
+		return nil, req, nil, fmt.Errorf("failed to get config for task %s: %w", task, err)
+	}
+
+	req.Task = task
+
+	// Apply DB config to request options
+	req.Options.Temperature = cfg.Temperature
+	req.Options.MaxTokens = cfg.MaxTokens
+	req.Options.JSONMode = cfg.JSONMode || req.Schema != nil
+	if cfg.ThinkingBudget > 0 {
+		req.Options.EnableThinking = true
+		req.Options.ThinkingBudget = cfg.ThinkingBudget
+	}
+
+	providers := pm.getProvidersFromConfig(cfg)
+	if len(providers) == 0 {
+		return nil, req, nil, fmt.Errorf("no available providers for task %s", task)
+	}
+	return cfg, req, providers, nil
+}
+
+// requestForProvider returns req for one of the providers of cfg. The
+// configured model belongs to the primary provider, fallbacks use their own
+// default.
+func requestForProvider(cfg *ent.AIConfig, provider Provider, req ChatRequest) ChatRequest {
+	req.Options.Model = provider.Model()
+	if provider.Type() == ProviderType(cfg.Provider) && cfg.Model != "" {
+		req.Options.Model = cfg.Model
+	}
+	return req
+}
+
+// SetLimiter enables the rate limits of the Config with a shared budget.
+func (pm *ProviderManager) SetLimiter(limiter Limiter) {
+	pm.limiter = limiter
+}
+
+// RegisterProvider adds a provider, replacing any provider of the same type.
+func (pm *ProviderManager) RegisterProvider(p Provider) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	pm.providers[p.Type()] = p
+}
+
 // getProvidersFromConfig returns providers based on DB config.
 func (pm *ProviderManager) getProvidersFromConfig(cfg *ent.AIConfig) []Provider {
 	pm.mu.RLock()
@@ -241,11 +636,18 @@ func (pm *ProviderManager) HasProviders() bool {
 	return len(pm.providers) > 0
 }
 
+// BreakerStatus returns the circuit breaker state of every provider and
+// model that has been called.
+func (pm *ProviderManager) BreakerStatus() []BreakerStatus {
+	return pm.breakers.Status()
+}
+
 // ProviderHealth represents the health status of a provider.
 type ProviderHealth struct {
 	Provider  ProviderType `json:"provider"`
 	Model     string       `json:"model"`
 	Healthy   bool         `json:"healthy"`
+	Circuit   BreakerState `json:"circuit"`
 	CheckedAt time.Time    `json:"checked_at"`
 }
 
@@ -265,6 +667,7 @@ func (pm *ProviderManager) CheckAllHealth(ctx context.Context) []ProviderHealth
 			Provider:  p.Type(),
 			Model:     p.Model(),
 			Healthy:   healthy,
+			Circuit:   pm.breakers.State(p.Type(), p.Model()),
 			CheckedAt: time.Now(),
 		})
 
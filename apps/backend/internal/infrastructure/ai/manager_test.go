@@ -0,0 +1,220 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+)
+
+type staticConfigProvider struct {
+	cfg *ent.AIConfig
+}
+
+func (s staticConfigProvider) GetConfigForTask(context.Context, string) (*ent.AIConfig, error) {
+	return s.cfg, nil
+}
+
+// brokenStreamProvider streams one delta and then fails.
+type brokenStreamProvider struct {
+	*FakeProvider
+}
+
+func (p brokenStreamProvider) ChatStream(_ context.Context, _ ChatRequest, handler StreamHandler) error {
+	handler.OnContent(`{"partial": `)
+	return errors.New("connection reset")
+}
+
+func newStreamTestManager(t *testing.T, primary Provider, fallback Provider) *ProviderManager {
+	t.Helper()
+	pm, err := NewProviderManager(context.Background(), Config{}, staticConfigProvider{cfg: &ent.AIConfig{
+		Provider:          string(primary.Type()),
+		FallbackProviders: []string{string(fallback.Type())},
+		JSONMode:          true,
+	}}, nil)
+	require.NoError(t, err)
+	pm.RegisterProvider(primary)
+	pm.RegisterProvider(fallback)
+	return pm
+}
+
+func TestProviderManager_ChatStream(t *testing.T) {
+	pm := newStreamTestManager(t,
+		NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: `{"a": "b c"}`, Thinking: "hmm"}}}),
+		NewFakeProvider(nil).WithType(ProviderOpenAI),
+	)
+
+	var content, thinking strings.Builder
+	var done *ChatResponse
+	resp, err := pm.ChatStream(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi"}, StreamHandler{
+		OnThinking: func(delta string) { thinking.WriteString(delta) },
+		OnContent:  func(delta string) { content.WriteString(delta) },
+		OnDone:     func(r *ChatResponse) { done = r },
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"a": "b c"}`, content.String())
+	assert.Equal(t, "hmm", thinking.String())
+	assert.Equal(t, ProviderFake, resp.Provider)
+	assert.Same(t, resp, done)
+}
+
+func TestProviderManager_ChatStream_FallbackBeforeStreaming(t *testing.T) {
+	fallback := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: `{"from": "fallback"}`}}}).WithType(ProviderOpenAI)
+	pm := newStreamTestManager(t,
+		NewFakeProvider(&FakeScript{Rules: []FakeRule{{Error: "overloaded"}}}),
+		fallback,
+	)
+
+	var content strings.Builder
+	resp, err := pm.ChatStream(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi"}, StreamHandler{
+		OnContent: func(delta string) { content.WriteString(delta) },
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, ProviderOpenAI, resp.Provider)
+	assert.Equal(t, `{"from": "fallback"}`, content.String())
+}
+
+func TestProviderManager_ChatStream_NoFallbackAfterStreaming(t *testing.T) {
+	fallback := NewFakeProvider(nil).WithType(ProviderOpenAI)
+	pm := newStreamTestManager(t, brokenStreamProvider{NewFakeProvider(nil)}, fallback)
+
+	_, err := pm.ChatStream(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi"}, StreamHandler{
+		OnContent: func(string) {},
+	})
+	assert.ErrorContains(t, err, "connection reset")
+	assert.Empty(t, fallback.Calls(), "deltas were already streamed")
+}
+
+func TestProviderManager_ChatStream_InvalidJSON(t *testing.T) {
+	pm := newStreamTestManager(t,
+		NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: "not json"}}}),
+		NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: "not json"}}}).WithType(ProviderOpenAI),
+	)
+
+	_, err := pm.ChatStream(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi"}, StreamHandler{})
+	assert.ErrorIs(t, err, ErrInvalidJSON)
+}
+
+func TestProviderManager_Chat_RepairsInvalidOutput(t *testing.T) {
+	schema, err := NewOutputSchema("test", []byte(testSchema))
+	require.NoError(t, err)
+
+	primary := NewFakeProvider(&FakeScript{Rules: []FakeRule{
+		{Content: `{"label": "x", "topics": []}`, Times: 1},
+		{Content: `{"label": "x", "topics": [{"id": "unknown"}]}`, Times: 1},
+		{Content: `{"label": "x", "topics": [{"id": "t1"}]}`},
+	}})
+	pm := newStreamTestManager(t, primary, NewFakeProvider(nil).WithType(ProviderOpenAI))
+
+	validate := func(content string) error {
+		if strings.Contains(content, "unknown") {
+			return errors.New(`topic "unknown" does not exist`)
+		}
+		return nil
+	}
+
+	// The schema problem is repaired, the repaired answer then fails validation
+	_, err = pm.Chat(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi", Schema: schema, Validate: validate})
+	assert.ErrorIs(t, err, ErrInvalidOutput)
+	assert.Contains(t, err.Error(), `topic "unknown" does not exist`)
+
+	calls := primary.Calls()
+	require.Len(t, calls, 2)
+	repair := calls[1]
+	assert.Equal(t, "true", repair.Metadata["repair"])
+	require.Len(t, repair.Messages, 2)
+	assert.Equal(t, Message{Role: RoleUser, Content: "hi"}, repair.Messages[0])
+	assert.Equal(t, Message{Role: RoleAssistant, Content: `{"label": "x", "topics": []}`}, repair.Messages[1])
+	assert.Contains(t, repair.UserPrompt, "$.topics: expected at least 1 items, got 0")
+
+	// The next request gets a valid answer straight away
+	resp, err := pm.Chat(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi", Schema: schema, Validate: validate})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Content, "t1")
+}
+
+func TestProviderManager_Chat_RepairsInvalidJSON(t *testing.T) {
+	primary := NewFakeProvider(&FakeScript{Rules: []FakeRule{
+		{InvalidJSON: true, Content: `{"broken": "json"}`, Times: 1},
+		{Content: `{"ok": true}`},
+	}})
+	pm := newStreamTestManager(t, primary, NewFakeProvider(nil).WithType(ProviderOpenAI))
+
+	resp, err := pm.Chat(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"ok": true}`, resp.Content)
+	assert.Len(t, primary.Calls(), 2)
+}
+
+func TestProviderManager_ChatStream_RepairsInvalidOutput(t *testing.T) {
+	schema, err := NewOutputSchema("test", []byte(testSchema))
+	require.NoError(t, err)
+
+	pm := newStreamTestManager(t,
+		NewFakeProvider(&FakeScript{Rules: []FakeRule{
+			{Content: `{"topics": [{"id": "t1"}]}`, Times: 1},
+			{Content: `{"label": "fixed", "topics": [{"id": "t1"}]}`},
+		}}),
+		NewFakeProvider(nil).WithType(ProviderOpenAI),
+	)
+
+	var done *ChatResponse
+	resp, err := pm.ChatStream(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi", Schema: schema}, StreamHandler{
+		OnDone: func(r *ChatResponse) { done = r },
+	})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Content, "fixed")
+	assert.Same(t, resp, done)
+
+	// The failed answer's tokens count towards the repaired response
+	assert.Greater(t, resp.InputTokens, estimateFakeTokens("hi"))
+}
+
+func TestProviderManager_Chat_SkipsOpenCircuit(t *testing.T) {
+	primary := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Error: "overloaded"}}})
+	fallback := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: `{"from": "fallback"}`}}}).WithType(ProviderOpenAI)
+	pm := newStreamTestManager(t, primary, fallback)
+	pm.breakers = NewBreakers(BreakerConfig{MinRequests: 2})
+
+	for range 2 {
+		resp, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "hi"})
+		require.NoError(t, err)
+		assert.Equal(t, ProviderOpenAI, resp.Provider)
+	}
+	require.Len(t, primary.Calls(), 2)
+	assert.Equal(t, BreakerOpen, pm.breakers.State(ProviderFake, primary.Model()))
+
+	// The open primary is skipped without a call
+	resp, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, ProviderOpenAI, resp.Provider)
+	assert.Len(t, primary.Calls(), 2)
+
+	status := pm.BreakerStatus()
+	require.Len(t, status, 2)
+	assert.Equal(t, ProviderFake, status[0].Provider)
+	assert.Equal(t, BreakerOpen, status[0].State)
+	assert.Equal(t, BreakerClosed, status[1].State)
+}
+
+func TestProviderManager_ChatStream_AllCircuitsOpen(t *testing.T) {
+	pm := newStreamTestManager(t,
+		NewFakeProvider(&FakeScript{Rules: []FakeRule{{Error: "overloaded"}}}),
+		NewFakeProvider(&FakeScript{Rules: []FakeRule{{Error: "overloaded"}}}).WithType(ProviderOpenAI),
+	)
+	pm.breakers = NewBreakers(BreakerConfig{MinRequests: 1})
+
+	_, err := pm.ChatStream(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi"}, StreamHandler{})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrCircuitOpen)
+
+	_, err = pm.ChatStream(context.Background(), TaskMindmap, ChatRequest{UserPrompt: "hi"}, StreamHandler{})
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // Common errors for AI providers.
@@ -12,8 +13,27 @@ var (
 	ErrProviderNotConfigured = errors.New("ai provider not configured")
 	ErrNoResponse            = errors.New("no response from ai provider")
 	ErrInvalidJSON           = errors.New("invalid json response")
+	ErrInvalidOutput         = errors.New("response does not match the requested output")
 )
 
+// OutputError reports a response that is not valid JSON or doesn't match the
+// requested output. It keeps the content, so the model can be asked to
+// repair it.
+type OutputError struct {
+	Content  string
+	Problems []string
+	err      error
+}
+
+func (e *OutputError) Error() string {
+	return fmt.Sprintf("%v: %s", e.err, strings.Join(e.Problems, "; "))
+}
+
+// Unwrap returns ErrInvalidJSON or ErrInvalidOutput.
+func (e *OutputError) Unwrap() error {
+	return e.err
+}
+
 // validateJSONResponse validates that the content is valid JSON when JSONMode is enabled.
 func validateJSONResponse(content string, jsonMode bool) error {
 	if !jsonMode {
@@ -21,7 +41,29 @@ func validateJSONResponse(content string, jsonMode bool) error {
 	}
 	var js json.RawMessage
 	if err := json.Unmarshal([]byte(content), &js); err != nil {
-		return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+		return &OutputError{Content: content, Problems: []string{err.Error()}, err: ErrInvalidJSON}
+	}
+	return nil
+}
+
+// validateOutput validates content against the output requested by req: valid
+// JSON in JSONMode, then the schema, then the request's own validation.
+func validateOutput(req ChatRequest, content string) error {
+	if err := validateJSONResponse(content, req.Options.JSONMode); err != nil {
+		return err
+	}
+
+	var problems []string
+	if req.Schema != nil {
+		problems = req.Schema.Validate(content)
+	}
+	if len(problems) == 0 && req.Validate != nil {
+		if err := req.Validate(content); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	if len(problems) > 0 {
+		return &OutputError{Content: content, Problems: problems, err: ErrInvalidOutput}
 	}
 	return nil
 }
@@ -64,6 +106,14 @@ func (b *BaseProvider) Model() string {
 	return b.model
 }
 
+// modelFor returns the model requested in opts, or the provider's default.
+func (b *BaseProvider) modelFor(opts ChatOptions) string {
+	if opts.Model != "" {
+		return opts.Model
+	}
+	return b.model
+}
+
 // Close releases resources (default no-op).
 func (b *BaseProvider) Close() error {
 	return nil
@@ -57,7 +57,7 @@ func (p *ClaudeProvider) Chat(ctx context.Context, req ChatRequest) (*ChatRespon
 	}
 
 	params := anthropic.MessageNewParams{
-		Model:     anthropic.Model(p.model),
+		Model:     anthropic.Model(p.modelFor(req.Options)),
 		MaxTokens: int64(req.Options.MaxTokens),
 		Messages:  anthropicMessages,
 	}
@@ -104,17 +104,21 @@ func (p *ClaudeProvider) Chat(ctx context.Context, req ChatRequest) (*ChatRespon
 		return nil, err
 	}
 
+	// Claude doesn't count prompt cache reads as input tokens
+	inputTokens := resp.Usage.InputTokens + resp.Usage.CacheReadInputTokens
+
 	return &ChatResponse{
-		Content:      content,
-		Thinking:     thinking,
-		Provider:     ProviderClaude,
-		Model:        string(resp.Model),
-		InputTokens:  int(resp.Usage.InputTokens),
-		OutputTokens: int(resp.Usage.OutputTokens),
-		TotalTokens:  int(resp.Usage.InputTokens + resp.Usage.OutputTokens),
-		LatencyMs:    time.Since(startTime).Milliseconds(),
-		RequestID:    resp.ID,
-		CreatedAt:    time.Now(),
+		Content:           content,
+		Thinking:          thinking,
+		Provider:          ProviderClaude,
+		Model:             string(resp.Model),
+		InputTokens:       int(inputTokens),
+		CachedInputTokens: int(resp.Usage.CacheReadInputTokens),
+		OutputTokens:      int(resp.Usage.OutputTokens),
+		TotalTokens:       int(inputTokens + resp.Usage.OutputTokens),
+		LatencyMs:         time.Since(startTime).Milliseconds(),
+		RequestID:         resp.ID,
+		CreatedAt:         time.Now(),
 	}, nil
 }
 
@@ -138,7 +142,7 @@ func (p *ClaudeProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 	}
 
 	params := anthropic.MessageNewParams{
-		Model:     anthropic.Model(p.model),
+		Model:     anthropic.Model(p.modelFor(req.Options)),
 		MaxTokens: int64(req.Options.MaxTokens),
 		Messages:  anthropicMessages,
 	}
@@ -148,12 +152,28 @@ func (p *ClaudeProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 			{Text: req.SystemPrompt},
 		}
 	}
+	if req.Options.Temperature > 0 {
+		params.Temperature = anthropic.Float(req.Options.Temperature)
+	}
+	if req.Options.TopP > 0 && req.Options.TopP < 1 {
+		params.TopP = anthropic.Float(req.Options.TopP)
+	}
 
 	stream := p.client.Messages.NewStreaming(ctx, params)
 
-	var fullThinking, fullContent string
+	var fullThinking, fullContent, requestID string
+	var inputTokens, cachedTokens, outputTokens int64
 	for stream.Next() {
 		event := stream.Current()
+		switch event.Type {
+		case "message_start":
+			requestID = event.Message.ID
+			cachedTokens = event.Message.Usage.CacheReadInputTokens
+			inputTokens = event.Message.Usage.InputTokens + cachedTokens
+		case "message_delta":
+			// Output tokens are cumulative
+			outputTokens = event.Usage.OutputTokens
+		}
 		if event.Type == "content_block_delta" {
 			delta := event.Delta
 			if delta.Type == "thinking_delta" {
@@ -179,12 +199,17 @@ func (p *ClaudeProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 
 	if handler.OnDone != nil {
 		handler.OnDone(&ChatResponse{
-			Content:   fullContent,
-			Thinking:  fullThinking,
-			Provider:  ProviderClaude,
-			Model:     p.model,
-			LatencyMs: time.Since(startTime).Milliseconds(),
-			CreatedAt: time.Now(),
+			Content:           fullContent,
+			Thinking:          fullThinking,
+			Provider:          ProviderClaude,
+			Model:             p.modelFor(req.Options),
+			InputTokens:       int(inputTokens),
+			CachedInputTokens: int(cachedTokens),
+			OutputTokens:      int(outputTokens),
+			TotalTokens:       int(inputTokens + outputTokens),
+			LatencyMs:         time.Since(startTime).Milliseconds(),
+			RequestID:         requestID,
+			CreatedAt:         time.Now(),
 		})
 	}
 	return nil
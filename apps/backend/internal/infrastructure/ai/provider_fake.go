@@ -0,0 +1,454 @@
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrFakeFailure is returned by the fake provider for scripted errors.
+var ErrFakeFailure = errors.New("fake ai provider failure")
+
+// FakeRule scripts the fake provider's response to matching requests.
+// A rule without content gets the generated response for the task, so a rule
+// can add latency or break the JSON without spelling out a response.
+type FakeRule struct {
+	// Task matches the request's task, empty matches every task
+	Task TaskType `json:"task,omitempty"`
+	// PromptHash matches PromptHash of the request, empty matches every prompt
+	PromptHash string `json:"prompt_hash,omitempty"`
+	// Times limits how many requests the rule answers, 0 means no limit
+	Times int `json:"times,omitempty"`
+
+	Content   string `json:"content,omitempty"`
+	Thinking  string `json:"thinking,omitempty"`
+	LatencyMs int    `json:"latency_ms,omitempty"`
+	// Error fails the request with ErrFakeFailure and this message
+	Error string `json:"error,omitempty"`
+	// Timeout blocks until the request context is done
+	Timeout bool `json:"timeout,omitempty"`
+	// InvalidJSON truncates the response so it no longer parses
+	InvalidJSON bool `json:"invalid_json,omitempty"`
+}
+
+// FakeScript configures the fake provider. The first matching rule answers a
+// request; requests no rule matches get a generated response.
+type FakeScript struct {
+	LatencyMs int        `json:"latency_ms,omitempty"`
+	Rules     []FakeRule `json:"rules,omitempty"`
+}
+
+// LoadFakeScript reads a FakeScript from a JSON file.
+func LoadFakeScript(path string) (*FakeScript, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fake ai script: %w", err)
+	}
+	var script FakeScript
+	if err := json.Unmarshal(data, &script); err != nil {
+		return nil, fmt.Errorf("parse fake ai script %s: %w", path, err)
+	}
+	return &script, nil
+}
+
+// PromptHash returns the key the fake provider matches prompts by: a short
+// hash of the system prompt, messages and user prompt.
+func PromptHash(req ChatRequest) string {
+	h := sha256.New()
+	for _, msg := range buildMessages(req) {
+		h.Write([]byte(msg.Role))
+		h.Write([]byte{0})
+		h.Write([]byte(msg.Content))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// FakeProvider implements the Provider interface without calling a model.
+// Responses are scripted or generated from the prompt, deterministically,
+// and token counts are estimated from the text.
+type FakeProvider struct {
+	BaseProvider
+	latency time.Duration
+
+	mu    sync.Mutex
+	rules []*fakeRuleState
+	calls []ChatRequest
+}
+
+type fakeRuleState struct {
+	FakeRule
+	used int
+}
+
+// NewFakeProvider creates a fake provider answering by script, which may be nil.
+func NewFakeProvider(script *FakeScript) *FakeProvider {
+	p := &FakeProvider{
+		BaseProvider: BaseProvider{
+			providerType: ProviderFake,
+			model:        DefaultFakeModel,
+		},
+	}
+	if script != nil {
+		p.latency = time.Duration(script.LatencyMs) * time.Millisecond
+		for _, rule := range script.Rules {
+			p.AddRule(rule)
+		}
+	}
+	return p
+}
+
+// WithType makes the provider report pt as its type, so tests can register
+// it in place of a real provider, e.g. as a fallback.
+func (p *FakeProvider) WithType(pt ProviderType) *FakeProvider {
+	p.providerType = pt
+	return p
+}
+
+// AddRule appends a rule, it only applies when no earlier rule matches.
+func (p *FakeProvider) AddRule(rule FakeRule) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rules = append(p.rules, &fakeRuleState{FakeRule: rule})
+}
+
+// Calls returns the requests the provider received.
+func (p *FakeProvider) Calls() []ChatRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]ChatRequest(nil), p.calls...)
+}
+
+// Chat answers the request from the first matching rule or the generator.
+func (p *FakeProvider) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
+	startTime := time.Now()
+	rule := p.match(req)
+
+	latency := p.latency
+	if rule.LatencyMs > 0 {
+		latency = time.Duration(rule.LatencyMs) * time.Millisecond
+	}
+	if rule.Timeout {
+		<-ctx.Done()
+		return nil, fmt.Errorf("fake chat: %w", ctx.Err())
+	}
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("fake chat: %w", ctx.Err())
+		}
+	}
+
+	if rule.Error != "" {
+		return nil, fmt.Errorf("%w: %s", ErrFakeFailure, rule.Error)
+	}
+
+	content := rule.Content
+	if content == "" {
+		content = generateFakeContent(req)
+	}
+	if rule.InvalidJSON {
+		content = content[:len(content)/2]
+	}
+	thinking := rule.Thinking
+	if thinking == "" && req.Options.EnableThinking {
+		thinking = fmt.Sprintf("Thinking about the %s task.", fakeTaskName(req.Task))
+	}
+
+	if err := validateJSONResponse(content, req.Options.JSONMode); err != nil {
+		return nil, err
+	}
+
+	var input int
+	for _, msg := range buildMessages(req) {
+		input += estimateFakeTokens(msg.Content)
+	}
+	output := estimateFakeTokens(content)
+	thinkingTokens := estimateFakeTokens(thinking)
+
+	return &ChatResponse{
+		Content:        content,
+		Thinking:       thinking,
+		Provider:       p.providerType,
+		Model:          p.modelFor(req.Options),
+		InputTokens:    input,
+		OutputTokens:   output,
+		ThinkingTokens: thinkingTokens,
+		TotalTokens:    input + output + thinkingTokens,
+		LatencyMs:      time.Since(startTime).Milliseconds(),
+		RequestID:      "fake-" + PromptHash(req),
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+// ChatStream answers like Chat and streams the response word by word.
+func (p *FakeProvider) ChatStream(ctx context.Context, req ChatRequest, handler StreamHandler) error {
+	resp, err := p.Chat(ctx, req)
+	if err != nil {
+		if handler.OnError != nil {
+			handler.OnError(err)
+		}
+		return err
+	}
+
+	for _, delta := range splitAfterSpaces(resp.Thinking) {
+		if handler.OnThinking != nil {
+			handler.OnThinking(delta)
+		}
+	}
+	for _, delta := range splitAfterSpaces(resp.Content) {
+		if handler.OnContent != nil {
+			handler.OnContent(delta)
+		}
+	}
+
+	if handler.OnDone != nil {
+		handler.OnDone(resp)
+	}
+	return nil
+}
+
+// IsHealthy always reports the fake provider healthy.
+func (p *FakeProvider) IsHealthy(_ context.Context) bool {
+	return true
+}
+
+// match records the call and returns the first rule matching it, or an
+// empty rule.
+func (p *FakeProvider) match(req ChatRequest) FakeRule {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, req)
+
+	hash := PromptHash(req)
+	for _, rule := range p.rules {
+		if rule.Task != "" && rule.Task != req.Task {
+			continue
+		}
+		if rule.PromptHash != "" && rule.PromptHash != hash {
+			continue
+		}
+		if rule.Times > 0 && rule.used >= rule.Times {
+			continue
+		}
+		rule.used++
+		return rule.FakeRule
+	}
+	return FakeRule{}
+}
+
+// estimateFakeTokens approximates a token count at four characters a token.
+func estimateFakeTokens(text string) int {
+	if text == "" {
+		return 0
+	}
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
+
+func splitAfterSpaces(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.SplitAfter(text, " ")
+}
+
+func fakeTaskName(task TaskType) string {
+	if task == "" {
+		return string(TaskGeneral)
+	}
+	return string(task)
+}
+
+// fakePage is a page listed in a mindmap prompt.
+type fakePage struct {
+	ID       string
+	Title    string
+	Keywords []string
+}
+
+// generateFakeContent builds a response in the shape each task's prompt asks
+// for, from what it can pick out of the user messages.
+func generateFakeContent(req ChatRequest) string {
+	var user []string
+	for _, msg := range buildMessages(req) {
+		if msg.Role == RoleUser {
+			user = append(user, msg.Content)
+		}
+	}
+	prompt := strings.Join(user, "\n\n")
+	var result any
+
+	switch req.Task {
+	case TaskTagExtraction:
+		title := promptField(prompt, "Page title")
+		result = map[string]any{
+			"keywords": fakeKeywords(title),
+			"summary":  fmt.Sprintf("Fake summary of %q.", title),
+		}
+	case TaskSummarize:
+		title := promptField(prompt, "Page title")
+		result = map[string]any{
+			"summary":  fmt.Sprintf("Fake summary of %q.\n\nIt has a second paragraph.", title),
+			"abstract": fmt.Sprintf("Fake abstract of %q.", title),
+			"entities": fakeKeywords(title),
+		}
+	case TaskMindmap:
+		// The clusters of the merge step are listed like pages
+		if req.Schema != nil && req.Schema.Name == "mindmap_merge" {
+			result = fakeMindmapMerge(promptPages(prompt))
+		} else {
+			result = fakeRelationshipGraph(promptPages(prompt))
+		}
+	default:
+		text := "Fake response " + PromptHash(req)[:8] + "."
+		if !req.Options.JSONMode {
+			return text
+		}
+		result = map[string]any{"response": text}
+	}
+
+	data, _ := json.Marshal(result)
+	return string(data)
+}
+
+// fakeRelationshipGraph groups pages into topics by their first keyword.
+func fakeRelationshipGraph(pages []fakePage) map[string]any {
+	var order []string
+	byKeyword := make(map[string][]fakePage)
+	for _, page := range pages {
+		keyword := "general"
+		if len(page.Keywords) > 0 {
+			keyword = page.Keywords[0]
+		}
+		if _, ok := byKeyword[keyword]; !ok {
+			order = append(order, keyword)
+		}
+		byKeyword[keyword] = append(byKeyword[keyword], page)
+	}
+
+	topics := make([]map[string]any, 0, len(order))
+	connections := make([]map[string]any, 0)
+	for i, keyword := range order {
+		id := fmt.Sprintf("topic-%d", i+1)
+		topicPages := make([]map[string]any, 0, len(byKeyword[keyword]))
+		for _, page := range byKeyword[keyword] {
+			topicPages = append(topicPages, map[string]any{
+				"url_id":    page.ID,
+				"title":     page.Title,
+				"relevance": 0.8,
+			})
+		}
+		topics = append(topics, map[string]any{
+			"id":          id,
+			"label":       keyword,
+			"keywords":    []string{keyword},
+			"description": fmt.Sprintf("Pages about %s.", keyword),
+			"pages":       topicPages,
+		})
+		if i > 0 {
+			connections = append(connections, map[string]any{
+				"from":            fmt.Sprintf("topic-%d", i),
+				"to":              id,
+				"shared_keywords": []string{},
+				"reason":          "Visited in the same session.",
+			})
+		}
+	}
+
+	core := "Browsing session"
+	if len(order) > 0 {
+		core = order[0]
+	}
+	return map[string]any{
+		"core": map[string]any{
+			"label":       core,
+			"description": fmt.Sprintf("A session of %d pages.", len(pages)),
+		},
+		"topics":      topics,
+		"connections": connections,
+	}
+}
+
+// fakeMindmapMerge groups page clusters into topics by their first keyword.
+func fakeMindmapMerge(clusters []fakePage) map[string]any {
+	graph := fakeRelationshipGraph(clusters)
+	for _, topic := range graph["topics"].([]map[string]any) {
+		var ids []string
+		for _, page := range topic["pages"].([]map[string]any) {
+			ids = append(ids, page["url_id"].(string))
+		}
+		delete(topic, "pages")
+		topic["clusters"] = ids
+	}
+	return graph
+}
+
+// promptField returns the value of a "Name: value" line in the prompt.
+func promptField(prompt, name string) string {
+	for _, line := range strings.Split(prompt, "\n") {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(line), name+":"); ok {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// promptPages parses the page list of a mindmap prompt.
+func promptPages(prompt string) []fakePage {
+	var pages []fakePage
+	for _, line := range strings.Split(prompt, "\n") {
+		line = strings.TrimSpace(line)
+		if id, ok := strings.CutPrefix(line, "- ID:"); ok {
+			pages = append(pages, fakePage{ID: strings.TrimSpace(id)})
+			continue
+		}
+		if len(pages) == 0 {
+			continue
+		}
+		page := &pages[len(pages)-1]
+		if title, ok := strings.CutPrefix(line, "Title:"); ok {
+			page.Title = strings.TrimSpace(title)
+		} else if keywords, ok := strings.CutPrefix(line, "Keywords:"); ok {
+			keywords = strings.Trim(strings.TrimSpace(keywords), "[]")
+			for _, k := range strings.Split(keywords, ",") {
+				if k = strings.TrimSpace(k); k != "" {
+					page.Keywords = append(page.Keywords, k)
+				}
+			}
+		}
+	}
+	return pages
+}
+
+// fakeKeywords picks up to five distinct words of the text.
+func fakeKeywords(text string) []string {
+	keywords := make([]string, 0, 5)
+	seen := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		word = strings.ToLower(word)
+		if utf8.RuneCountInString(word) < 2 || seen[word] {
+			continue
+		}
+		seen[word] = true
+		keywords = append(keywords, word)
+		if len(keywords) == 5 {
+			break
+		}
+	}
+	if len(keywords) == 0 {
+		keywords = append(keywords, "fake")
+	}
+	return keywords
+}
@@ -0,0 +1,255 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+)
+
+func TestFakeProvider_GeneratesTaskResponses(t *testing.T) {
+	p := NewFakeProvider(nil)
+	ctx := context.Background()
+
+	t.Run("tag extraction", func(t *testing.T) {
+		resp, err := p.Chat(ctx, ChatRequest{
+			Task:       TaskTagExtraction,
+			UserPrompt: "Analyze\n\nPage title: Go Generics Tutorial\nPage content:\n...",
+			Options:    ChatOptions{JSONMode: true},
+		})
+		require.NoError(t, err)
+
+		var result struct {
+			Keywords []string `json:"keywords"`
+			Summary  string   `json:"summary"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(resp.Content), &result))
+		assert.Equal(t, []string{"go", "generics", "tutorial"}, result.Keywords)
+		assert.Contains(t, result.Summary, "Go Generics Tutorial")
+	})
+
+	t.Run("mindmap", func(t *testing.T) {
+		prompt := `### Visited Pages
+
+- ID: 11111111-1111-1111-1111-111111111111
+  Title: Goroutines
+  Keywords: [go, concurrency]
+  Duration: 1000ms
+
+- ID: 22222222-2222-2222-2222-222222222222
+  Title: Channels
+  Keywords: [go]
+
+- ID: 33333333-3333-3333-3333-333333333333
+  Title: Rust ownership
+  Keywords: [rust]
+`
+		resp, err := p.Chat(ctx, ChatRequest{Task: TaskMindmap, UserPrompt: prompt, Options: ChatOptions{JSONMode: true}})
+		require.NoError(t, err)
+
+		var result struct {
+			Core struct {
+				Label string `json:"label"`
+			} `json:"core"`
+			Topics []struct {
+				ID    string `json:"id"`
+				Label string `json:"label"`
+				Pages []struct {
+					URLID string `json:"url_id"`
+				} `json:"pages"`
+			} `json:"topics"`
+			Connections []struct {
+				From string `json:"from"`
+				To   string `json:"to"`
+			} `json:"connections"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(resp.Content), &result))
+		assert.Equal(t, "go", result.Core.Label)
+		require.Len(t, result.Topics, 2)
+		assert.Equal(t, "go", result.Topics[0].Label)
+		assert.Len(t, result.Topics[0].Pages, 2)
+		assert.Equal(t, "33333333-3333-3333-3333-333333333333", result.Topics[1].Pages[0].URLID)
+		require.Len(t, result.Connections, 1)
+		assert.Equal(t, "topic-1", result.Connections[0].From)
+	})
+
+	t.Run("general", func(t *testing.T) {
+		resp, err := p.Chat(ctx, ChatRequest{UserPrompt: "hello"})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(resp.Content, "Fake response "))
+	})
+}
+
+func TestFakeProvider_Deterministic(t *testing.T) {
+	req := ChatRequest{Task: TaskSummarize, UserPrompt: "Page title: Same page", Options: ChatOptions{JSONMode: true}}
+
+	first, err := NewFakeProvider(nil).Chat(context.Background(), req)
+	require.NoError(t, err)
+	second, err := NewFakeProvider(nil).Chat(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.Content, second.Content)
+	assert.Equal(t, first.RequestID, second.RequestID)
+	assert.Equal(t, first.TotalTokens, second.TotalTokens)
+}
+
+func TestFakeProvider_SyntheticTokens(t *testing.T) {
+	p := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: "12345678"}}})
+
+	resp, err := p.Chat(context.Background(), ChatRequest{
+		SystemPrompt: "abcd",
+		UserPrompt:   "abcdefgh",
+		Options:      ChatOptions{EnableThinking: true},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, resp.InputTokens)
+	assert.Equal(t, 2, resp.OutputTokens)
+	assert.Positive(t, resp.ThinkingTokens)
+	assert.Equal(t, resp.InputTokens+resp.OutputTokens+resp.ThinkingTokens, resp.TotalTokens)
+	assert.Equal(t, ProviderFake, resp.Provider)
+	assert.Equal(t, DefaultFakeModel, resp.Model)
+}
+
+func TestFakeProvider_Rules(t *testing.T) {
+	ctx := context.Background()
+	matched := ChatRequest{Task: TaskMindmap, UserPrompt: "matched"}
+	other := ChatRequest{Task: TaskMindmap, UserPrompt: "other"}
+
+	p := NewFakeProvider(&FakeScript{Rules: []FakeRule{
+		{Task: TaskMindmap, PromptHash: PromptHash(matched), Content: `{"canned": true}`},
+		{Task: TaskTagExtraction, Content: "never"},
+		{Task: TaskMindmap, Error: "overloaded", Times: 1},
+	}})
+
+	resp, err := p.Chat(ctx, matched)
+	require.NoError(t, err)
+	assert.Equal(t, `{"canned": true}`, resp.Content)
+
+	// The error rule answers once, then requests fall through to the generator
+	_, err = p.Chat(ctx, other)
+	assert.ErrorIs(t, err, ErrFakeFailure)
+	assert.Contains(t, err.Error(), "overloaded")
+
+	resp, err = p.Chat(ctx, other)
+	require.NoError(t, err)
+	assert.Contains(t, resp.Content, `"core"`)
+
+	assert.Len(t, p.Calls(), 3)
+}
+
+func TestFakeProvider_InvalidJSON(t *testing.T) {
+	p := NewFakeProvider(&FakeScript{Rules: []FakeRule{{InvalidJSON: true}}})
+
+	_, err := p.Chat(context.Background(), ChatRequest{
+		Task:       TaskTagExtraction,
+		UserPrompt: "Page title: Broken",
+		Options:    ChatOptions{JSONMode: true},
+	})
+	assert.ErrorIs(t, err, ErrInvalidJSON)
+}
+
+func TestFakeProvider_LatencyAndTimeout(t *testing.T) {
+	t.Run("latency", func(t *testing.T) {
+		p := NewFakeProvider(&FakeScript{LatencyMs: 20})
+
+		start := time.Now()
+		_, err := p.Chat(context.Background(), ChatRequest{UserPrompt: "hi"})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+
+	t.Run("latency cut short by the context", func(t *testing.T) {
+		p := NewFakeProvider(&FakeScript{Rules: []FakeRule{{LatencyMs: 60000}}})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := p.Chat(ctx, ChatRequest{UserPrompt: "hi"})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		p := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Timeout: true}}})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := p.Chat(ctx, ChatRequest{UserPrompt: "hi"})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestFakeProvider_ChatStream(t *testing.T) {
+	p := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: "one two three", Thinking: "hmm"}}})
+
+	var content, thinking strings.Builder
+	var deltas int
+	var done *ChatResponse
+	err := p.ChatStream(context.Background(), ChatRequest{UserPrompt: "hi"}, StreamHandler{
+		OnThinking: func(delta string) { thinking.WriteString(delta) },
+		OnContent: func(delta string) {
+			deltas++
+			content.WriteString(delta)
+		},
+		OnDone: func(resp *ChatResponse) { done = resp },
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "one two three", content.String())
+	assert.Equal(t, 3, deltas)
+	assert.Equal(t, "hmm", thinking.String())
+	require.NotNil(t, done)
+	assert.Equal(t, "one two three", done.Content)
+}
+
+func TestLoadFakeScript(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "script.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"latency_ms": 5,
+		"rules": [{"task": "mindmap", "error": "boom", "times": 2}]
+	}`), 0o600))
+
+	script, err := LoadFakeScript(path)
+	require.NoError(t, err)
+	assert.Equal(t, 5, script.LatencyMs)
+	require.Len(t, script.Rules, 1)
+	assert.Equal(t, TaskMindmap, script.Rules[0].Task)
+	assert.Equal(t, 2, script.Rules[0].Times)
+
+	_, err = LoadFakeScript(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestProviderManager_FakeProviderFallback(t *testing.T) {
+	pm, err := NewProviderManager(context.Background(), Config{
+		Fake: FakeConfig{Enabled: true},
+	}, staticConfigProvider{cfg: &ent.AIConfig{
+		Provider:          string(ProviderFake),
+		Model:             "scripted",
+		FallbackProviders: []string{string(ProviderOpenAI)},
+		JSONMode:          true,
+	}}, nil)
+	require.NoError(t, err)
+
+	// Primary fails, the fallback stands in for OpenAI
+	pm.RegisterProvider(NewFakeProvider(&FakeScript{Rules: []FakeRule{{Error: "down"}}}))
+	fallback := NewFakeProvider(nil).WithType(ProviderOpenAI)
+	pm.RegisterProvider(fallback)
+
+	resp, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "Page title: Fallback"})
+	require.NoError(t, err)
+	assert.Equal(t, ProviderOpenAI, resp.Provider)
+	assert.Equal(t, DefaultFakeModel, resp.Model)
+
+	calls := fallback.Calls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, TaskTagExtraction, calls[0].Task)
+	assert.True(t, calls[0].Options.JSONMode)
+}
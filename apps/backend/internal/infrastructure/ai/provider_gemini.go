@@ -43,7 +43,7 @@ func NewGeminiProvider(ctx context.Context, cfg ProviderConfig) (*GeminiProvider
 // Chat sends a chat completion request to Google Gemini.
 func (p *GeminiProvider) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
 	startTime := time.Now()
-	model := p.client.GenerativeModel(p.model)
+	model := p.client.GenerativeModel(p.modelFor(req.Options))
 
 	model.SetTemperature(float32(req.Options.Temperature))
 	model.SetMaxOutputTokens(int32(req.Options.MaxTokens))
@@ -53,10 +53,13 @@ func (p *GeminiProvider) Chat(ctx context.Context, req ChatRequest) (*ChatRespon
 		model.StopSequences = req.Options.StopSequences
 	}
 
-	// JSON mode
+	// JSON mode, constrained to the schema when there is one
 	if req.Options.JSONMode {
 		model.ResponseMIMEType = "application/json"
 	}
+	if req.Schema != nil {
+		model.ResponseSchema = req.Schema.genaiSchema()
+	}
 
 	// System prompt
 	if req.SystemPrompt != "" {
@@ -97,32 +100,45 @@ func (p *GeminiProvider) Chat(ctx context.Context, req ChatRequest) (*ChatRespon
 		return nil, err
 	}
 
-	var inputTokens, outputTokens, totalTokens int
+	var inputTokens, cachedTokens, outputTokens, totalTokens int
 	if resp.UsageMetadata != nil {
 		inputTokens = int(resp.UsageMetadata.PromptTokenCount)
+		cachedTokens = int(resp.UsageMetadata.CachedContentTokenCount)
 		outputTokens = int(resp.UsageMetadata.CandidatesTokenCount)
 		totalTokens = int(resp.UsageMetadata.TotalTokenCount)
 	}
 
 	return &ChatResponse{
-		Content:      content.String(),
-		Provider:     ProviderGemini,
-		Model:        p.model,
-		InputTokens:  inputTokens,
-		OutputTokens: outputTokens,
-		TotalTokens:  totalTokens,
-		LatencyMs:    time.Since(startTime).Milliseconds(),
-		CreatedAt:    time.Now(),
+		Content:           content.String(),
+		Provider:          ProviderGemini,
+		Model:             p.modelFor(req.Options),
+		InputTokens:       inputTokens,
+		CachedInputTokens: cachedTokens,
+		OutputTokens:      outputTokens,
+		TotalTokens:       totalTokens,
+		LatencyMs:         time.Since(startTime).Milliseconds(),
+		CreatedAt:         time.Now(),
 	}, nil
 }
 
 // ChatStream sends a streaming chat completion request to Google Gemini.
 func (p *GeminiProvider) ChatStream(ctx context.Context, req ChatRequest, handler StreamHandler) error {
 	startTime := time.Now()
-	model := p.client.GenerativeModel(p.model)
+	model := p.client.GenerativeModel(p.modelFor(req.Options))
 
 	model.SetTemperature(float32(req.Options.Temperature))
 	model.SetMaxOutputTokens(int32(req.Options.MaxTokens))
+	model.SetTopP(float32(req.Options.TopP))
+
+	if len(req.Options.StopSequences) > 0 {
+		model.StopSequences = req.Options.StopSequences
+	}
+	if req.Options.JSONMode {
+		model.ResponseMIMEType = "application/json"
+	}
+	if req.Schema != nil {
+		model.ResponseSchema = req.Schema.genaiSchema()
+	}
 
 	if req.SystemPrompt != "" {
 		model.SystemInstruction = &genai.Content{
@@ -137,6 +153,7 @@ func (p *GeminiProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 
 	iter := model.GenerateContentStream(ctx, parts...)
 	var fullContent strings.Builder
+	var usage *genai.UsageMetadata
 
 	for {
 		resp, err := iter.Next()
@@ -149,6 +166,9 @@ func (p *GeminiProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 			}
 			return err
 		}
+		if resp.UsageMetadata != nil {
+			usage = resp.UsageMetadata
+		}
 		for _, cand := range resp.Candidates {
 			if cand.Content == nil {
 				continue
@@ -166,13 +186,20 @@ func (p *GeminiProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 	}
 
 	if handler.OnDone != nil {
-		handler.OnDone(&ChatResponse{
+		resp := &ChatResponse{
 			Content:   fullContent.String(),
 			Provider:  ProviderGemini,
-			Model:     p.model,
+			Model:     p.modelFor(req.Options),
 			LatencyMs: time.Since(startTime).Milliseconds(),
 			CreatedAt: time.Now(),
-		})
+		}
+		if usage != nil {
+			resp.InputTokens = int(usage.PromptTokenCount)
+			resp.CachedInputTokens = int(usage.CachedContentTokenCount)
+			resp.OutputTokens = int(usage.CandidatesTokenCount)
+			resp.TotalTokens = int(usage.TotalTokenCount)
+		}
+		handler.OnDone(resp)
 	}
 	return nil
 }
@@ -46,7 +46,7 @@ func (p *OpenAIProvider) Chat(ctx context.Context, req ChatRequest) (*ChatRespon
 	}
 
 	apiReq := openai.ChatCompletionRequest{
-		Model:       p.model,
+		Model:       p.modelFor(req.Options),
 		Messages:    chatMessages,
 		Temperature: float32(req.Options.Temperature),
 		MaxTokens:   req.Options.MaxTokens,
@@ -54,12 +54,7 @@ func (p *OpenAIProvider) Chat(ctx context.Context, req ChatRequest) (*ChatRespon
 		Stop:        req.Options.StopSequences,
 	}
 
-	// JSON mode
-	if req.Options.JSONMode {
-		apiReq.ResponseFormat = &openai.ChatCompletionResponseFormat{
-			Type: openai.ChatCompletionResponseFormatTypeJSONObject,
-		}
-	}
+	apiReq.ResponseFormat = openAIResponseFormat(req)
 
 	resp, err := p.client.CreateChatCompletion(ctx, apiReq)
 	if err != nil {
@@ -78,19 +73,29 @@ func (p *OpenAIProvider) Chat(ctx context.Context, req ChatRequest) (*ChatRespon
 	}
 
 	return &ChatResponse{
-		Content:      content,
-		Thinking:     "",
-		Provider:     ProviderOpenAI,
-		Model:        resp.Model,
-		InputTokens:  resp.Usage.PromptTokens,
-		OutputTokens: resp.Usage.CompletionTokens,
-		TotalTokens:  resp.Usage.TotalTokens,
-		LatencyMs:    time.Since(startTime).Milliseconds(),
-		RequestID:    resp.ID,
-		CreatedAt:    time.Now(),
+		Content:           content,
+		Thinking:          "",
+		Provider:          ProviderOpenAI,
+		Model:             resp.Model,
+		InputTokens:       resp.Usage.PromptTokens,
+		CachedInputTokens: cachedPromptTokens(resp.Usage),
+		OutputTokens:      resp.Usage.CompletionTokens,
+		TotalTokens:       resp.Usage.TotalTokens,
+		LatencyMs:         time.Since(startTime).Milliseconds(),
+		RequestID:         resp.ID,
+		CreatedAt:         time.Now(),
 	}, nil
 }
 
+// cachedPromptTokens returns the prompt tokens OpenAI read from its prompt
+// cache, which are billed at a lower price.
+func cachedPromptTokens(usage openai.Usage) int {
+	if usage.PromptTokensDetails == nil {
+		return 0
+	}
+	return usage.PromptTokensDetails.CachedTokens
+}
+
 // ChatStream sends a streaming chat completion request to OpenAI.
 func (p *OpenAIProvider) ChatStream(ctx context.Context, req ChatRequest, handler StreamHandler) error {
 	startTime := time.Now()
@@ -104,13 +109,20 @@ func (p *OpenAIProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 		}
 	}
 
-	stream, err := p.client.CreateChatCompletionStream(ctx, openai.ChatCompletionRequest{
-		Model:       p.model,
+	apiReq := openai.ChatCompletionRequest{
+		Model:       p.modelFor(req.Options),
 		Messages:    chatMessages,
 		Temperature: float32(req.Options.Temperature),
 		MaxTokens:   req.Options.MaxTokens,
+		TopP:        float32(req.Options.TopP),
+		Stop:        req.Options.StopSequences,
 		Stream:      true,
-	})
+		// Usage is only reported for streams when asked for, in a last chunk
+		StreamOptions: &openai.StreamOptions{IncludeUsage: true},
+	}
+	apiReq.ResponseFormat = openAIResponseFormat(req)
+
+	stream, err := p.client.CreateChatCompletionStream(ctx, apiReq)
 	if err != nil {
 		if handler.OnError != nil {
 			handler.OnError(err)
@@ -119,7 +131,8 @@ func (p *OpenAIProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 	}
 	defer func() { _ = stream.Close() }()
 
-	var fullContent string
+	var fullContent, requestID string
+	var usage openai.Usage
 	for {
 		chunk, err := stream.Recv()
 		if err == io.EOF {
@@ -131,6 +144,10 @@ func (p *OpenAIProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 			}
 			return err
 		}
+		requestID = chunk.ID
+		if chunk.Usage != nil {
+			usage = *chunk.Usage
+		}
 		if len(chunk.Choices) > 0 {
 			delta := chunk.Choices[0].Delta.Content
 			fullContent += delta
@@ -142,11 +159,16 @@ func (p *OpenAIProvider) ChatStream(ctx context.Context, req ChatRequest, handle
 
 	if handler.OnDone != nil {
 		handler.OnDone(&ChatResponse{
-			Content:   fullContent,
-			Provider:  ProviderOpenAI,
-			Model:     p.model,
-			LatencyMs: time.Since(startTime).Milliseconds(),
-			CreatedAt: time.Now(),
+			Content:           fullContent,
+			Provider:          ProviderOpenAI,
+			Model:             p.modelFor(req.Options),
+			InputTokens:       usage.PromptTokens,
+			CachedInputTokens: cachedPromptTokens(usage),
+			OutputTokens:      usage.CompletionTokens,
+			TotalTokens:       usage.TotalTokens,
+			LatencyMs:         time.Since(startTime).Milliseconds(),
+			RequestID:         requestID,
+			CreatedAt:         time.Now(),
 		})
 	}
 	return nil
@@ -164,3 +186,24 @@ func (p *OpenAIProvider) IsHealthy(ctx context.Context) bool {
 	}
 	return true
 }
+
+// openAIResponseFormat returns the structured output format of req: its
+// schema when it has one, any JSON object in JSON mode.
+func openAIResponseFormat(req ChatRequest) *openai.ChatCompletionResponseFormat {
+	switch {
+	case req.Schema != nil:
+		return &openai.ChatCompletionResponseFormat{
+			Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+			JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+				Name:   req.Schema.Name,
+				Schema: req.Schema,
+			},
+		}
+	case req.Options.JSONMode:
+		return &openai.ChatCompletionResponseFormat{
+			Type: openai.ChatCompletionResponseFormatTypeJSONObject,
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,445 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+const (
+	thinkOpenTag  = "<think>"
+	thinkCloseTag = "</think>"
+
+	// jsonInstruction is added to the system prompt when the endpoint can't
+	// enforce JSON output through response_format.
+	jsonInstruction = "Respond with a single valid JSON object and nothing else."
+)
+
+// OpenAICompatibleProvider implements the Provider interface for self-hosted
+// servers that speak the OpenAI chat completions API, such as Ollama, vLLM
+// and llama.cpp.
+//
+// Endpoints differ in what they support, so JSON mode and thinking are
+// declared per endpoint or, with CapabilityAuto, detected from responses.
+type OpenAICompatibleProvider struct {
+	BaseProvider
+	client   *openai.Client
+	jsonMode Capability
+	thinking Capability
+
+	// jsonModeRejected is set once the endpoint rejects response_format
+	jsonModeRejected atomic.Bool
+}
+
+// NewOpenAICompatibleProvider creates a provider for the OpenAI-compatible
+// endpoint at cfg.BaseURL.
+func NewOpenAICompatibleProvider(cfg OpenAICompatibleConfig) *OpenAICompatibleProvider {
+	clientCfg := openai.DefaultConfig(cfg.APIKey)
+	clientCfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+
+	return &OpenAICompatibleProvider{
+		BaseProvider: BaseProvider{
+			providerType: ProviderOpenAICompatible,
+			model:        cfg.Model,
+		},
+		client:   openai.NewClientWithConfig(clientCfg),
+		jsonMode: capabilityOrAuto(cfg.JSONMode),
+		thinking: capabilityOrAuto(cfg.Thinking),
+	}
+}
+
+// Chat sends a chat completion request to the endpoint.
+func (p *OpenAICompatibleProvider) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
+	startTime := time.Now()
+
+	apiReq, err := p.buildRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.CreateChatCompletion(ctx, apiReq)
+	if err != nil && apiReq.ResponseFormat != nil && p.jsonMode == CapabilityAuto && isBadRequest(err) {
+		// The endpoint doesn't understand response_format, ask for JSON in
+		// the prompt from now on
+		slog.Warn("openai-compatible endpoint rejected json mode, falling back to prompting",
+			"model", apiReq.Model,
+			"error", err,
+		)
+		p.jsonModeRejected.Store(true)
+		if apiReq, err = p.buildRequest(req); err != nil {
+			return nil, err
+		}
+		resp, err = p.client.CreateChatCompletion(ctx, apiReq)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("openai-compatible chat: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, ErrNoResponse
+	}
+
+	message := resp.Choices[0].Message
+	thinking, content := message.ReasoningContent, message.Content
+	if thinking == "" && p.thinking != CapabilityOff {
+		thinking, content = splitThinkTags(content)
+	}
+
+	if req.Options.JSONMode {
+		content = extractJSON(content)
+	}
+	if err := validateJSONResponse(content, req.Options.JSONMode); err != nil {
+		return nil, err
+	}
+
+	thinkingTokens := reasoningTokens(resp.Usage)
+	model := resp.Model
+	if model == "" {
+		model = apiReq.Model
+	}
+
+	return &ChatResponse{
+		Content:           content,
+		Thinking:          thinking,
+		Provider:          ProviderOpenAICompatible,
+		Model:             model,
+		InputTokens:       resp.Usage.PromptTokens,
+		CachedInputTokens: cachedPromptTokens(resp.Usage),
+		OutputTokens:      resp.Usage.CompletionTokens - thinkingTokens,
+		ThinkingTokens:    thinkingTokens,
+		TotalTokens:       resp.Usage.TotalTokens,
+		LatencyMs:         time.Since(startTime).Milliseconds(),
+		RequestID:         resp.ID,
+		CreatedAt:         time.Now(),
+	}, nil
+}
+
+// ChatStream sends a streaming chat completion request to the endpoint.
+// JSON mode is only requested through the prompt when streaming.
+func (p *OpenAICompatibleProvider) ChatStream(ctx context.Context, req ChatRequest, handler StreamHandler) error {
+	startTime := time.Now()
+
+	apiReq, err := p.buildRequest(req)
+	if err != nil {
+		if handler.OnError != nil {
+			handler.OnError(err)
+		}
+		return err
+	}
+	if apiReq.ResponseFormat != nil {
+		apiReq.ResponseFormat = nil
+		apiReq.Messages = withJSONInstruction(apiReq.Messages)
+	}
+	apiReq.Stream = true
+	apiReq.StreamOptions = &openai.StreamOptions{IncludeUsage: true}
+
+	stream, err := p.client.CreateChatCompletionStream(ctx, apiReq)
+	if err != nil {
+		if handler.OnError != nil {
+			handler.OnError(err)
+		}
+		return err
+	}
+	defer func() { _ = stream.Close() }()
+
+	var fullThinking, fullContent strings.Builder
+	emit := func(thinking, content string) {
+		if thinking != "" {
+			fullThinking.WriteString(thinking)
+			if handler.OnThinking != nil {
+				handler.OnThinking(thinking)
+			}
+		}
+		if content != "" {
+			fullContent.WriteString(content)
+			if handler.OnContent != nil {
+				handler.OnContent(content)
+			}
+		}
+	}
+
+	var splitter thinkTagSplitter
+	var usage openai.Usage
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if handler.OnError != nil {
+				handler.OnError(err)
+			}
+			return err
+		}
+		if chunk.Usage != nil {
+			usage = *chunk.Usage
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+
+		delta := chunk.Choices[0].Delta
+		emit(delta.ReasoningContent, "")
+		if p.thinking == CapabilityOff {
+			emit("", delta.Content)
+		} else {
+			emit(splitter.write(delta.Content))
+		}
+	}
+	emit(splitter.flush())
+
+	content := fullContent.String()
+	if req.Options.JSONMode {
+		content = extractJSON(content)
+	}
+
+	if handler.OnDone != nil {
+		handler.OnDone(&ChatResponse{
+			Content:           content,
+			Thinking:          fullThinking.String(),
+			Provider:          ProviderOpenAICompatible,
+			Model:             apiReq.Model,
+			InputTokens:       usage.PromptTokens,
+			CachedInputTokens: cachedPromptTokens(usage),
+			OutputTokens:      usage.CompletionTokens - reasoningTokens(usage),
+			ThinkingTokens:    reasoningTokens(usage),
+			TotalTokens:       usage.TotalTokens,
+			LatencyMs:         time.Since(startTime).Milliseconds(),
+			CreatedAt:         time.Now(),
+		})
+	}
+	return nil
+}
+
+// reasoningTokens returns the completion tokens spent on reasoning, which
+// are reported as part of the completion tokens.
+func reasoningTokens(usage openai.Usage) int {
+	if usage.CompletionTokensDetails == nil {
+		return 0
+	}
+	return usage.CompletionTokensDetails.ReasoningTokens
+}
+
+// IsHealthy checks if the endpoint is reachable by listing its models, which
+// doesn't load a model on servers that load them lazily.
+func (p *OpenAICompatibleProvider) IsHealthy(ctx context.Context) bool {
+	if _, err := p.client.ListModels(ctx); err != nil {
+		slog.Warn("openai-compatible health check failed", "error", err)
+		return false
+	}
+	return true
+}
+
+// buildRequest converts req to a chat completion request, using
+// response_format for JSON mode unless the endpoint doesn't support it.
+func (p *OpenAICompatibleProvider) buildRequest(req ChatRequest) (openai.ChatCompletionRequest, error) {
+	model := p.modelFor(req.Options)
+	if model == "" {
+		return openai.ChatCompletionRequest{}, fmt.Errorf("%w: no model for %s", ErrProviderNotConfigured, ProviderOpenAICompatible)
+	}
+
+	messages := buildMessages(req)
+	chatMessages := make([]openai.ChatCompletionMessage, len(messages))
+	for i, msg := range messages {
+		chatMessages[i] = openai.ChatCompletionMessage{
+			Role:    string(msg.Role),
+			Content: msg.Content,
+		}
+	}
+
+	apiReq := openai.ChatCompletionRequest{
+		Model:       model,
+		Messages:    chatMessages,
+		Temperature: float32(req.Options.Temperature),
+		MaxTokens:   req.Options.MaxTokens,
+		TopP:        float32(req.Options.TopP),
+		Stop:        req.Options.StopSequences,
+	}
+
+	if req.Options.JSONMode {
+		if p.supportsJSONMode() {
+			apiReq.ResponseFormat = &openai.ChatCompletionResponseFormat{
+				Type: openai.ChatCompletionResponseFormatTypeJSONObject,
+			}
+		} else {
+			apiReq.Messages = withJSONInstruction(apiReq.Messages)
+		}
+	}
+
+	// Only endpoints declared to serve a reasoning model get reasoning_effort,
+	// others may reject unknown parameters
+	if req.Options.EnableThinking && p.thinking == CapabilityOn {
+		apiReq.ReasoningEffort = reasoningEffort(req.Options.ThinkingBudget)
+	}
+
+	return apiReq, nil
+}
+
+func (p *OpenAICompatibleProvider) supportsJSONMode() bool {
+	switch p.jsonMode {
+	case CapabilityOn:
+		return true
+	case CapabilityOff:
+		return false
+	default:
+		return !p.jsonModeRejected.Load()
+	}
+}
+
+func capabilityOrAuto(c Capability) Capability {
+	if c == "" {
+		return CapabilityAuto
+	}
+	return c
+}
+
+// isBadRequest reports whether err is the endpoint refusing the request
+// itself, as opposed to a transport or server failure.
+func isBadRequest(err error) bool {
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.HTTPStatusCode == http.StatusBadRequest || apiErr.HTTPStatusCode == http.StatusUnprocessableEntity
+	}
+	var reqErr *openai.RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.HTTPStatusCode == http.StatusBadRequest || reqErr.HTTPStatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// withJSONInstruction appends jsonInstruction to the system message, adding
+// one if there is none.
+func withJSONInstruction(messages []openai.ChatCompletionMessage) []openai.ChatCompletionMessage {
+	result := make([]openai.ChatCompletionMessage, len(messages))
+	copy(result, messages)
+
+	if len(result) > 0 && result[0].Role == openai.ChatMessageRoleSystem {
+		result[0].Content = strings.TrimSpace(result[0].Content) + "\n\n" + jsonInstruction
+		return result
+	}
+	return append([]openai.ChatCompletionMessage{{
+		Role:    openai.ChatMessageRoleSystem,
+		Content: jsonInstruction,
+	}}, result...)
+}
+
+// reasoningEffort maps a thinking token budget to an OpenAI reasoning effort.
+func reasoningEffort(budget int) string {
+	switch {
+	case budget <= 0:
+		return "medium"
+	case budget < 4096:
+		return "low"
+	case budget < 16384:
+		return "medium"
+	default:
+		return "high"
+	}
+}
+
+// extractJSON strips what models commonly wrap JSON in when it isn't enforced
+// by the server: markdown code fences and prose before or after the value.
+func extractJSON(content string) string {
+	content = strings.TrimSpace(content)
+	if strings.HasPrefix(content, "```") {
+		content = strings.TrimPrefix(content, "```")
+		if i := strings.IndexByte(content, '\n'); i >= 0 {
+			content = content[i+1:] // language tag
+		}
+		content = strings.TrimSuffix(strings.TrimSpace(content), "```")
+		content = strings.TrimSpace(content)
+	}
+
+	start := strings.IndexAny(content, "{[")
+	if start < 0 {
+		return content
+	}
+	closing := "}"
+	if content[start] == '[' {
+		closing = "]"
+	}
+	end := strings.LastIndex(content, closing)
+	if end < start {
+		return content
+	}
+	return content[start : end+1]
+}
+
+// splitThinkTags separates <think>…</think> blocks, which reasoning models
+// served without a reasoning parser emit inline, from the answer.
+func splitThinkTags(content string) (thinking, answer string) {
+	var s thinkTagSplitter
+	t1, c1 := s.write(content)
+	t2, c2 := s.flush()
+	return strings.TrimSpace(t1 + t2), strings.TrimSpace(c1 + c2)
+}
+
+// thinkTagSplitter separates <think>…</think> blocks from streamed content.
+// Tags may be split across deltas, so text that could be the start of a tag
+// is held back until the next write.
+type thinkTagSplitter struct {
+	inThink bool
+	pending string
+}
+
+// write consumes a delta and returns the thinking and content text known so far.
+func (s *thinkTagSplitter) write(delta string) (thinking, content string) {
+	s.pending += delta
+
+	var thinkBuf, contentBuf strings.Builder
+	out := func(text string) {
+		if s.inThink {
+			thinkBuf.WriteString(text)
+		} else {
+			contentBuf.WriteString(text)
+		}
+	}
+
+	for {
+		tag := thinkOpenTag
+		if s.inThink {
+			tag = thinkCloseTag
+		}
+
+		if i := strings.Index(s.pending, tag); i >= 0 {
+			out(s.pending[:i])
+			s.pending = s.pending[i+len(tag):]
+			s.inThink = !s.inThink
+			continue
+		}
+
+		keep := partialTagSuffix(s.pending, tag)
+		out(s.pending[:len(s.pending)-keep])
+		s.pending = s.pending[len(s.pending)-keep:]
+		return thinkBuf.String(), contentBuf.String()
+	}
+}
+
+// flush returns the text held back by write.
+func (s *thinkTagSplitter) flush() (thinking, content string) {
+	rest := s.pending
+	s.pending = ""
+	if s.inThink {
+		return rest, ""
+	}
+	return "", rest
+}
+
+// partialTagSuffix returns the length of the longest suffix of text that is a
+// proper prefix of tag.
+func partialTagSuffix(text, tag string) int {
+	for n := min(len(tag)-1, len(text)); n > 0; n-- {
+		if strings.HasSuffix(text, tag[:n]) {
+			return n
+		}
+	}
+	return 0
+}
@@ -0,0 +1,390 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+)
+
+// fakeCompatibleServer emulates the chat completions endpoint of a local
+// model server.
+type fakeCompatibleServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []map[string]any
+
+	rejectJSONMode bool
+	reply          func(req map[string]any) map[string]any
+	streamChunks   []map[string]any
+	streamUsage    map[string]any
+}
+
+func newFakeCompatibleServer(t *testing.T) *fakeCompatibleServer {
+	t.Helper()
+	f := &fakeCompatibleServer{}
+	f.reply = func(map[string]any) map[string]any {
+		return map[string]any{"content": `{"ok": true}`}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/models", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"object":"list","data":[{"id":"llama3.1:8b","object":"model"}]}`))
+	})
+	mux.HandleFunc("/v1/chat/completions", func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		f.mu.Lock()
+		f.requests = append(f.requests, req)
+		f.mu.Unlock()
+
+		if _, ok := req["response_format"]; ok && f.rejectJSONMode {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"message":"response_format is not supported","type":"invalid_request_error"}}`))
+			return
+		}
+
+		if req["stream"] == true {
+			w.Header().Set("Content-Type", "text/event-stream")
+			for _, delta := range f.streamChunks {
+				chunk, _ := json.Marshal(map[string]any{
+					"id":      "chunk",
+					"model":   req["model"],
+					"choices": []any{map[string]any{"index": 0, "delta": delta}},
+				})
+				_, _ = fmt.Fprintf(w, "data: %s\n\n", chunk)
+			}
+			if f.streamUsage != nil {
+				chunk, _ := json.Marshal(map[string]any{"id": "chunk", "choices": []any{}, "usage": f.streamUsage})
+				_, _ = fmt.Fprintf(w, "data: %s\n\n", chunk)
+			}
+			_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":    "chatcmpl-1",
+			"model": req["model"],
+			"choices": []any{map[string]any{
+				"index":         0,
+				"message":       f.reply(req),
+				"finish_reason": "stop",
+			}},
+			"usage": map[string]any{"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15},
+		})
+	})
+
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeCompatibleServer) provider(jsonMode, thinking Capability) *OpenAICompatibleProvider {
+	return NewOpenAICompatibleProvider(OpenAICompatibleConfig{
+		BaseURL:  f.URL + "/v1/",
+		Model:    "llama3.1:8b",
+		JSONMode: jsonMode,
+		Thinking: thinking,
+	})
+}
+
+func (f *fakeCompatibleServer) request(i int) map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[i]
+}
+
+func (f *fakeCompatibleServer) requestCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.requests)
+}
+
+func jsonRequest() ChatRequest {
+	return ChatRequest{
+		SystemPrompt: "Extract tags.",
+		UserPrompt:   "some page",
+		Options:      ChatOptions{JSONMode: true, MaxTokens: 100},
+	}
+}
+
+func TestOpenAICompatibleProvider_Chat(t *testing.T) {
+	server := newFakeCompatibleServer(t)
+	p := server.provider(CapabilityAuto, CapabilityAuto)
+
+	resp, err := p.Chat(context.Background(), jsonRequest())
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"ok": true}`, resp.Content)
+	assert.Equal(t, ProviderOpenAICompatible, resp.Provider)
+	assert.Equal(t, "llama3.1:8b", resp.Model)
+	assert.Equal(t, 15, resp.TotalTokens)
+
+	req := server.request(0)
+	assert.Equal(t, "llama3.1:8b", req["model"])
+	assert.Equal(t, map[string]any{"type": "json_object"}, req["response_format"])
+}
+
+func TestOpenAICompatibleProvider_ModelOverride(t *testing.T) {
+	server := newFakeCompatibleServer(t)
+	p := server.provider(CapabilityAuto, CapabilityAuto)
+
+	req := jsonRequest()
+	req.Options.Model = "qwen2.5:14b"
+	resp, err := p.Chat(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "qwen2.5:14b", server.request(0)["model"])
+	assert.Equal(t, "qwen2.5:14b", resp.Model)
+}
+
+func TestOpenAICompatibleProvider_NoModel(t *testing.T) {
+	server := newFakeCompatibleServer(t)
+	p := NewOpenAICompatibleProvider(OpenAICompatibleConfig{BaseURL: server.URL + "/v1"})
+
+	_, err := p.Chat(context.Background(), jsonRequest())
+	assert.ErrorIs(t, err, ErrProviderNotConfigured)
+	assert.Zero(t, server.requestCount())
+}
+
+func TestOpenAICompatibleProvider_DetectsUnsupportedJSONMode(t *testing.T) {
+	server := newFakeCompatibleServer(t)
+	server.rejectJSONMode = true
+	server.reply = func(map[string]any) map[string]any {
+		return map[string]any{"content": "Sure! Here it is:\n```json\n{\"tags\": [\"go\"]}\n```"}
+	}
+	p := server.provider(CapabilityAuto, CapabilityAuto)
+
+	resp, err := p.Chat(context.Background(), jsonRequest())
+	require.NoError(t, err)
+	assert.Equal(t, `{"tags": ["go"]}`, resp.Content)
+
+	// Rejected request, then the retry asking for JSON in the prompt
+	require.Equal(t, 2, server.requestCount())
+	retry := server.request(1)
+	assert.NotContains(t, retry, "response_format")
+	messages := retry["messages"].([]any)
+	system := messages[0].(map[string]any)
+	assert.Equal(t, "system", system["role"])
+	assert.Contains(t, system["content"], jsonInstruction)
+
+	// The endpoint is remembered, later requests skip response_format
+	_, err = p.Chat(context.Background(), jsonRequest())
+	require.NoError(t, err)
+	require.Equal(t, 3, server.requestCount())
+	assert.NotContains(t, server.request(2), "response_format")
+}
+
+func TestOpenAICompatibleProvider_DeclaredJSONMode(t *testing.T) {
+	t.Run("on does not fall back", func(t *testing.T) {
+		server := newFakeCompatibleServer(t)
+		server.rejectJSONMode = true
+		p := server.provider(CapabilityOn, CapabilityAuto)
+
+		_, err := p.Chat(context.Background(), jsonRequest())
+		assert.Error(t, err)
+		assert.Equal(t, 1, server.requestCount())
+	})
+
+	t.Run("off never sends response_format", func(t *testing.T) {
+		server := newFakeCompatibleServer(t)
+		p := server.provider(CapabilityOff, CapabilityAuto)
+
+		_, err := p.Chat(context.Background(), jsonRequest())
+		require.NoError(t, err)
+		assert.NotContains(t, server.request(0), "response_format")
+	})
+}
+
+func TestOpenAICompatibleProvider_Thinking(t *testing.T) {
+	tests := []struct {
+		name         string
+		capability   Capability
+		message      map[string]any
+		wantThinking string
+		wantContent  string
+	}{
+		{
+			name:         "reasoning_content field",
+			capability:   CapabilityAuto,
+			message:      map[string]any{"content": `{"a": 1}`, "reasoning_content": "let me think"},
+			wantThinking: "let me think",
+			wantContent:  `{"a": 1}`,
+		},
+		{
+			name:         "inline think tags",
+			capability:   CapabilityAuto,
+			message:      map[string]any{"content": "<think>\nlet me think\n</think>\n\n{\"a\": 1}"},
+			wantThinking: "let me think",
+			wantContent:  `{"a": 1}`,
+		},
+		{
+			name:        "think tags left alone when off",
+			capability:  CapabilityOff,
+			message:     map[string]any{"content": "<think>hmm</think>{\"a\": 1}"},
+			wantContent: `{"a": 1}`,
+		},
+		{
+			name:        "no thinking",
+			capability:  CapabilityAuto,
+			message:     map[string]any{"content": `{"a": 1}`},
+			wantContent: `{"a": 1}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeCompatibleServer(t)
+			server.reply = func(map[string]any) map[string]any { return tt.message }
+			p := server.provider(CapabilityAuto, tt.capability)
+
+			resp, err := p.Chat(context.Background(), jsonRequest())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantThinking, resp.Thinking)
+			assert.Equal(t, tt.wantContent, resp.Content)
+		})
+	}
+}
+
+func TestOpenAICompatibleProvider_ReasoningEffort(t *testing.T) {
+	req := jsonRequest()
+	req.Options.EnableThinking = true
+	req.Options.ThinkingBudget = 10000
+
+	server := newFakeCompatibleServer(t)
+	_, err := server.provider(CapabilityAuto, CapabilityOn).Chat(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "medium", server.request(0)["reasoning_effort"])
+
+	// Undeclared endpoints may reject the parameter
+	_, err = server.provider(CapabilityAuto, CapabilityAuto).Chat(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotContains(t, server.request(1), "reasoning_effort")
+}
+
+func TestOpenAICompatibleProvider_ChatStream(t *testing.T) {
+	server := newFakeCompatibleServer(t)
+	server.streamChunks = []map[string]any{
+		{"role": "assistant", "content": "<thi"},
+		{"content": "nk>plan"},
+		{"content": "ning</th"},
+		{"content": "ink>Hello"},
+		{"content": " world <"},
+	}
+	server.streamUsage = map[string]any{
+		"prompt_tokens":             10,
+		"completion_tokens":         8,
+		"total_tokens":              18,
+		"completion_tokens_details": map[string]any{"reasoning_tokens": 3},
+	}
+	p := server.provider(CapabilityAuto, CapabilityAuto)
+
+	var thinking, content strings.Builder
+	var done *ChatResponse
+	err := p.ChatStream(context.Background(), ChatRequest{UserPrompt: "hi"}, StreamHandler{
+		OnThinking: func(delta string) { thinking.WriteString(delta) },
+		OnContent:  func(delta string) { content.WriteString(delta) },
+		OnDone:     func(resp *ChatResponse) { done = resp },
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "planning", thinking.String())
+	assert.Equal(t, "Hello world <", content.String())
+	require.NotNil(t, done)
+	assert.Equal(t, "planning", done.Thinking)
+	assert.Equal(t, "Hello world <", done.Content)
+	assert.Equal(t, "llama3.1:8b", done.Model)
+	assert.Equal(t, 5, done.OutputTokens, "reasoning is counted as thinking, as in Chat")
+	assert.Equal(t, 3, done.ThinkingTokens)
+}
+
+func TestOpenAICompatibleProvider_IsHealthy(t *testing.T) {
+	server := newFakeCompatibleServer(t)
+	assert.True(t, server.provider(CapabilityAuto, CapabilityAuto).IsHealthy(context.Background()))
+
+	down := NewOpenAICompatibleProvider(OpenAICompatibleConfig{BaseURL: "http://127.0.0.1:1/v1", Model: "x"})
+	assert.False(t, down.IsHealthy(context.Background()))
+}
+
+func TestExtractJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"plain object", `{"a": 1}`, `{"a": 1}`},
+		{"fenced with language", "```json\n{\"a\": 1}\n```", `{"a": 1}`},
+		{"fenced without language", "```\n[1, 2]\n```", `[1, 2]`},
+		{"surrounding prose", "Here you go: {\"a\": {\"b\": 2}} Hope it helps!", `{"a": {"b": 2}}`},
+		{"no json", "nothing here", "nothing here"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, extractJSON(tt.content))
+		})
+	}
+}
+
+func TestParseCapability(t *testing.T) {
+	assert.Equal(t, CapabilityOn, ParseCapability("true"))
+	assert.Equal(t, CapabilityOn, ParseCapability("ON"))
+	assert.Equal(t, CapabilityOff, ParseCapability("false"))
+	assert.Equal(t, CapabilityOff, ParseCapability("0"))
+	assert.Equal(t, CapabilityAuto, ParseCapability("auto"))
+	assert.Equal(t, CapabilityAuto, ParseCapability(""))
+}
+
+func TestIsKnownProvider(t *testing.T) {
+	for _, pt := range KnownProviders {
+		assert.True(t, IsKnownProvider(string(pt)))
+	}
+	assert.False(t, IsKnownProvider("ollama"))
+	assert.False(t, IsKnownProvider(""))
+}
+
+func TestProviderManager_UsesConfiguredModel(t *testing.T) {
+	server := newFakeCompatibleServer(t)
+
+	pm, err := NewProviderManager(context.Background(), Config{
+		OpenAICompatible: OpenAICompatibleConfig{BaseURL: server.URL + "/v1", Model: "llama3.1:8b"},
+	}, staticConfigProvider{cfg: &ent.AIConfig{
+		Provider:  string(ProviderOpenAICompatible),
+		Model:     "qwen2.5:14b",
+		JSONMode:  true,
+		MaxTokens: 100,
+	}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []ProviderType{ProviderOpenAICompatible}, pm.GetAvailableProviders())
+
+	resp, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "page"})
+	require.NoError(t, err)
+	assert.Equal(t, "qwen2.5:14b", resp.Model)
+	assert.Equal(t, "qwen2.5:14b", server.request(0)["model"])
+}
+
+func TestProviderManager_FallbackUsesItsOwnModel(t *testing.T) {
+	server := newFakeCompatibleServer(t)
+
+	pm, err := NewProviderManager(context.Background(), Config{
+		OpenAICompatible: OpenAICompatibleConfig{BaseURL: server.URL + "/v1", Model: "llama3.1:8b"},
+	}, staticConfigProvider{cfg: &ent.AIConfig{
+		Provider:          string(ProviderClaude), // not configured
+		Model:             DefaultClaudeModel,
+		FallbackProviders: []string{string(ProviderOpenAICompatible)},
+	}}, nil)
+	require.NoError(t, err)
+
+	_, err = pm.Chat(context.Background(), TaskGeneral, ChatRequest{UserPrompt: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, "llama3.1:8b", server.request(0)["model"])
+}
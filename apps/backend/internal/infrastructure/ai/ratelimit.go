@@ -0,0 +1,148 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/sashabaranov/go-openai"
+)
+
+// ErrRateLimited is returned when a request would exceed the rate limit of
+// its provider and model, or the provider rejected it for one.
+var ErrRateLimited = errors.New("ai rate limit reached")
+
+// DefaultRateLimitRetry is the retry delay of requests a provider rejected
+// for its rate limit.
+const DefaultRateLimitRetry = 30 * time.Second
+
+// RateLimitError reports when a rate-limited request may be retried. Worker
+// tasks failing with it are retried then, without counting as failed.
+type RateLimitError struct {
+	Provider ProviderType
+	Model    string
+	Wait     time.Duration
+	err      error
+}
+
+func (e *RateLimitError) Error() string {
+	if e.err != nil {
+		return fmt.Sprintf("%s %s: %v, retry in %s: %v", e.Provider, e.Model, ErrRateLimited, e.Wait, e.err)
+	}
+	return fmt.Sprintf("%s %s: %v, retry in %s", e.Provider, e.Model, ErrRateLimited, e.Wait)
+}
+
+// Unwrap returns ErrRateLimited and the provider error, if any.
+func (e *RateLimitError) Unwrap() []error {
+	if e.err != nil {
+		return []error{ErrRateLimited, e.err}
+	}
+	return []error{ErrRateLimited}
+}
+
+// RetryAfter returns the time to wait before retrying.
+func (e *RateLimitError) RetryAfter() time.Duration {
+	return e.Wait
+}
+
+// RateLimit is the budget of a provider and model per minute. Zero means
+// unlimited.
+type RateLimit struct {
+	RequestsPerMinute int
+	TokensPerMinute   int
+}
+
+// RateLimits are the rate limits by "provider" or "provider/model". A model
+// limit takes precedence over the limit of its provider.
+type RateLimits map[string]RateLimit
+
+// ParseRateLimits parses a comma-separated list of limits such as
+// "gemini=1000:4000000,openai/gpt-4o-mini=500:200000", where each limit is
+// requests:tokens per minute.
+func ParseRateLimits(spec string) (RateLimits, error) {
+	limits := make(RateLimits)
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(item, "=")
+		rpm, tpm, ok2 := strings.Cut(value, ":")
+		if !ok || !ok2 || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid rate limit %q, expected provider[/model]=rpm:tpm", item)
+		}
+		requests, err := strconv.Atoi(strings.TrimSpace(rpm))
+		if err != nil || requests < 0 {
+			return nil, fmt.Errorf("invalid requests per minute in rate limit %q", item)
+		}
+		tokens, err := strconv.Atoi(strings.TrimSpace(tpm))
+		if err != nil || tokens < 0 {
+			return nil, fmt.Errorf("invalid tokens per minute in rate limit %q", item)
+		}
+		limits[strings.TrimSpace(key)] = RateLimit{RequestsPerMinute: requests, TokensPerMinute: tokens}
+	}
+	return limits, nil
+}
+
+// For returns the rate limit of a provider and model, if any, and the key of
+// its budget. Models without a limit of their own share the budget of their
+// provider.
+func (l RateLimits) For(provider ProviderType, model string) (string, RateLimit, bool) {
+	key := string(provider) + "/" + model
+	if limit, ok := l[key]; ok {
+		return key, limit, true
+	}
+	limit, ok := l[string(provider)]
+	return string(provider), limit, ok
+}
+
+// Limiter keeps the rate limit budgets, shared by every worker.
+type Limiter interface {
+	// Take takes a request and tokens from the budget of key for the
+	// current minute and returns that minute's window. When the budget is
+	// exhausted nothing is taken and the time until it is renewed is
+	// returned.
+	Take(ctx context.Context, key string, limit RateLimit, tokens int) (window time.Time, wait time.Duration, err error)
+	// AddTokens corrects the tokens taken from the budget of key in window
+	// once the actual usage is known. tokens may be negative.
+	AddTokens(ctx context.Context, key string, window time.Time, tokens int) error
+}
+
+// EstimateTokens estimates the input tokens of a request before it is sent.
+// It errs on the high side: about four ASCII characters per token, and a
+// token per other character, as for Korean text.
+func EstimateTokens(req ChatRequest) int {
+	tokens := EstimateTextTokens(req.SystemPrompt) + EstimateTextTokens(req.UserPrompt)
+	for _, m := range req.Messages {
+		tokens += EstimateTextTokens(m.Content) + 4 // role and separators
+	}
+	return tokens
+}
+
+// isProviderRateLimit reports whether a provider rejected a request for its
+// rate limit (HTTP 429).
+func isProviderRateLimit(err error) bool {
+	var openaiAPIErr *openai.APIError
+	if errors.As(err, &openaiAPIErr) {
+		return openaiAPIErr.HTTPStatusCode == http.StatusTooManyRequests
+	}
+	var openaiReqErr *openai.RequestError
+	if errors.As(err, &openaiReqErr) {
+		return openaiReqErr.HTTPStatusCode == http.StatusTooManyRequests
+	}
+	var claudeErr *anthropic.Error
+	if errors.As(err, &claudeErr) {
+		return claudeErr.StatusCode == http.StatusTooManyRequests
+	}
+	// Gemini's REST client returns gax API errors
+	var httpErr interface{ HTTPCode() int }
+	if errors.As(err, &httpErr) {
+		return httpErr.HTTPCode() == http.StatusTooManyRequests
+	}
+	return false
+}
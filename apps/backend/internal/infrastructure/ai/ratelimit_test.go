@@ -0,0 +1,172 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryLimiter is a Limiter with a fixed number of requests. Once they are
+// used up it asks to wait, and lets the next request through.
+type memoryLimiter struct {
+	mu       sync.Mutex
+	left     int
+	wait     time.Duration
+	tokens   map[string]int
+	waitedOn int
+}
+
+func (l *memoryLimiter) Take(_ context.Context, key string, _ RateLimit, tokens int) (time.Time, time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.left == 0 {
+		l.waitedOn++
+		l.left = 1 // renewed once waited for
+		return time.Time{}, l.wait, nil
+	}
+	l.left--
+	l.tokens[key] += tokens
+	return time.Now().Truncate(time.Minute), 0, nil
+}
+
+func (l *memoryLimiter) AddTokens(_ context.Context, key string, _ time.Time, tokens int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens[key] += tokens
+	return nil
+}
+
+// rateLimitedProvider rejects every request like a provider over its quota.
+type rateLimitedProvider struct {
+	*FakeProvider
+}
+
+func (p rateLimitedProvider) Chat(context.Context, ChatRequest) (*ChatResponse, error) {
+	return nil, fmt.Errorf("openai chat: %w", &openai.APIError{HTTPStatusCode: 429, Message: "Rate limit reached"})
+}
+
+func TestParseRateLimits(t *testing.T) {
+	limits, err := ParseRateLimits(" gemini=1000:4000000, openai/gpt-4o-mini=500:0 ,")
+	require.NoError(t, err)
+
+	key, limit, ok := limits.For(ProviderOpenAI, "gpt-4o-mini")
+	require.True(t, ok)
+	assert.Equal(t, "openai/gpt-4o-mini", key)
+	assert.Equal(t, RateLimit{RequestsPerMinute: 500}, limit)
+
+	// Models without a limit share the provider budget
+	key, limit, ok = limits.For(ProviderGemini, "gemini-2.5-pro")
+	require.True(t, ok)
+	assert.Equal(t, "gemini", key)
+	assert.Equal(t, RateLimit{RequestsPerMinute: 1000, TokensPerMinute: 4000000}, limit)
+
+	_, _, ok = limits.For(ProviderOpenAI, "gpt-4o")
+	assert.False(t, ok)
+
+	for _, spec := range []string{"gemini", "gemini=10", "=1:2", "gemini=x:1", "gemini=1:-1"} {
+		_, err := ParseRateLimits(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 3, EstimateTokens(ChatRequest{UserPrompt: "hello world"}))
+	assert.Equal(t, 5, EstimateTokens(ChatRequest{UserPrompt: "안녕하세요"}))
+	assert.Equal(t, 1+4+1, EstimateTokens(ChatRequest{
+		SystemPrompt: "be",
+		Messages:     []Message{{Role: RoleUser, Content: "hi"}},
+	}))
+}
+
+func TestIsProviderRateLimit(t *testing.T) {
+	assert.True(t, isProviderRateLimit(fmt.Errorf("openai chat: %w", &openai.APIError{HTTPStatusCode: 429})))
+	assert.False(t, isProviderRateLimit(fmt.Errorf("openai chat: %w", &openai.APIError{HTTPStatusCode: 500})))
+	assert.False(t, isProviderRateLimit(errors.New("connection reset")))
+}
+
+func TestProviderManager_Chat_WaitsForRateLimit(t *testing.T) {
+	primary := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: `{"ok": true}`}}})
+	pm := newStreamTestManager(t, primary, NewFakeProvider(nil).WithType(ProviderOpenAI))
+	pm.rateLimits = RateLimits{string(ProviderFake): {RequestsPerMinute: 1}}
+	pm.maxWait = time.Second
+	limiter := &memoryLimiter{left: 0, wait: 10 * time.Millisecond, tokens: map[string]int{}}
+	pm.SetLimiter(limiter)
+
+	resp, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "hello world"})
+	require.NoError(t, err)
+	assert.Equal(t, ProviderFake, resp.Provider)
+	assert.Equal(t, 1, limiter.waitedOn)
+
+	// The estimate is corrected to the actual usage
+	assert.Equal(t, resp.TotalTokens, limiter.tokens[string(ProviderFake)])
+}
+
+func TestProviderManager_Chat_RateLimitedBeyondMaxWait(t *testing.T) {
+	fallback := NewFakeProvider(nil).WithType(ProviderOpenAI)
+	pm := newStreamTestManager(t, NewFakeProvider(nil), fallback)
+	pm.rateLimits = RateLimits{string(ProviderFake): {RequestsPerMinute: 1}}
+	pm.maxWait = time.Second
+	pm.SetLimiter(&memoryLimiter{left: 0, wait: 40 * time.Second, tokens: map[string]int{}})
+
+	_, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "hi"})
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	var rlErr *RateLimitError
+	require.ErrorAs(t, err, &rlErr)
+	assert.Equal(t, 40*time.Second, rlErr.RetryAfter())
+	assert.Empty(t, fallback.Calls(), "rate limits don't fall through to the fallback")
+}
+
+func TestProviderManager_Chat_OpenCircuitTakesNoBudget(t *testing.T) {
+	fallback := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: `{"from": "fallback"}`}}}).WithType(ProviderOpenAI)
+	pm := newStreamTestManager(t, NewFakeProvider(nil), fallback)
+	pm.breakers = NewBreakers(BreakerConfig{MinRequests: 1})
+	pm.breakers.Record(context.Background(), ProviderFake, DefaultFakeModel, time.Second, errors.New("503"))
+	require.Equal(t, BreakerOpen, pm.breakers.State(ProviderFake, DefaultFakeModel))
+	pm.rateLimits = RateLimits{string(ProviderFake): {RequestsPerMinute: 1}}
+	pm.maxWait = time.Second
+	limiter := &memoryLimiter{left: 0, wait: 40 * time.Second, tokens: map[string]int{}}
+	pm.SetLimiter(limiter)
+
+	resp, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "hi"})
+	require.NoError(t, err, "the exhausted budget of a skipped provider doesn't requeue")
+	assert.Equal(t, ProviderOpenAI, resp.Provider)
+	assert.Zero(t, limiter.waitedOn)
+	assert.Empty(t, limiter.tokens)
+}
+
+func TestProviderManager_Chat_RateLimitReleasesProbe(t *testing.T) {
+	pm := newStreamTestManager(t, NewFakeProvider(nil), NewFakeProvider(nil).WithType(ProviderOpenAI))
+	pm.breakers = NewBreakers(BreakerConfig{MinRequests: 1, OpenTimeout: time.Nanosecond})
+	pm.breakers.Record(context.Background(), ProviderFake, DefaultFakeModel, time.Second, errors.New("503"))
+	time.Sleep(time.Millisecond)
+	pm.rateLimits = RateLimits{string(ProviderFake): {RequestsPerMinute: 1}}
+	pm.maxWait = time.Second
+	pm.SetLimiter(&memoryLimiter{left: 0, wait: 40 * time.Second, tokens: map[string]int{}})
+
+	_, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "hi"})
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	assert.True(t, pm.breakers.Allow(ProviderFake, DefaultFakeModel), "the probe that was never sent can be sent later")
+}
+
+func TestProviderManager_Chat_ProviderRateLimit(t *testing.T) {
+	fallback := NewFakeProvider(nil).WithType(ProviderOpenAI)
+	pm := newStreamTestManager(t, rateLimitedProvider{NewFakeProvider(nil)}, fallback)
+
+	_, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "hi"})
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	var rlErr *RateLimitError
+	require.ErrorAs(t, err, &rlErr)
+	assert.Equal(t, DefaultRateLimitRetry, rlErr.RetryAfter())
+	assert.Empty(t, fallback.Calls())
+	assert.Equal(t, BreakerClosed, pm.breakers.State(ProviderFake, DefaultFakeModel))
+}
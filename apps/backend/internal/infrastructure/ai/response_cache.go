@@ -0,0 +1,143 @@
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+)
+
+// ResponseCache stores responses by the CacheKey of their request, shared by
+// every worker.
+type ResponseCache interface {
+	// Get returns the response stored under key, or nil if there is none.
+	Get(ctx context.Context, key string) (*ChatResponse, error)
+	// Set stores resp under key for ttl.
+	Set(ctx context.Context, key string, resp *ChatResponse, ttl time.Duration) error
+}
+
+// cacheKeyVersion is part of every cache key, so changing what goes into a
+// key doesn't serve responses stored under the old one.
+const cacheKeyVersion = "v1"
+
+// CacheKey returns the content address of a request: a hash of the task, the
+// provider and model, the options, the output schema and the prompt. Requests
+// differing only in metadata, such as the user, share a key. The model is
+// that of the primary provider, so responses of a fallback are stored under
+// the key of the configured model.
+func CacheKey(task TaskType, provider ProviderType, req ChatRequest) string {
+	key := struct {
+		Version  string       `json:"v"`
+		Task     TaskType     `json:"task"`
+		Provider ProviderType `json:"provider"`
+		Options  ChatOptions  `json:"options"`
+		Schema   string       `json:"schema,omitempty"`
+		Messages []Message    `json:"messages"`
+	}{
+		Version:  cacheKeyVersion,
+		Task:     task,
+		Provider: provider,
+		Options:  req.Options,
+	}
+	if req.Schema != nil {
+		key.Schema = string(req.Schema.raw)
+	}
+	for _, msg := range buildMessages(req) {
+		msg.Content = normalizePrompt(msg.Content)
+		key.Messages = append(key.Messages, msg)
+	}
+
+	data, _ := json.Marshal(key) // cannot fail, every field is marshalable
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizePrompt removes differences in a prompt that don't change its
+// meaning: line endings and whitespace at the end of lines.
+func normalizePrompt(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// SetResponseCache enables caching responses of tasks whose config sets a
+// cache TTL.
+func (pm *ProviderManager) SetResponseCache(cache ResponseCache) {
+	pm.cache = cache
+}
+
+// cacheKey returns the cache key of req and how long to keep its response,
+// or an empty key when the task isn't cached.
+func (pm *ProviderManager) cacheKey(cfg *ent.AIConfig, task TaskType, providers []Provider, req ChatRequest) (string, time.Duration) {
+	if pm.cache == nil || cfg.CacheTTLSeconds <= 0 {
+		return "", 0
+	}
+	primary := providers[0]
+	return CacheKey(task, primary.Type(), requestForProvider(cfg, primary, req)), time.Duration(cfg.CacheTTLSeconds) * time.Second
+}
+
+// cachedResponse returns the response cached under key if it still passes
+// validation. Hits are logged with no tokens, so they cost nothing. Cache
+// errors are treated as misses.
+func (pm *ProviderManager) cachedResponse(ctx context.Context, task TaskType, key string, req ChatRequest) *ChatResponse {
+	if key == "" {
+		return nil
+	}
+
+	start := time.Now()
+	cached, err := pm.cache.Get(ctx, key)
+	if err != nil {
+		slog.Warn("failed to read ai response cache", "task", task, "error", err)
+		metrics.AIResponseCache.WithLabelValues(string(task), "error").Inc()
+		return nil
+	}
+	if cached == nil {
+		metrics.AIResponseCache.WithLabelValues(string(task), "miss").Inc()
+		return nil
+	}
+	req.Options.Model = cached.Model
+	if err := validateOutput(req, cached.Content); err != nil {
+		// Validate may depend on state that changed since, such as the
+		// pages of a session
+		slog.Info("cached ai response no longer valid", "task", task, "error", err)
+		metrics.AIResponseCache.WithLabelValues(string(task), "invalid").Inc()
+		return nil
+	}
+	metrics.AIResponseCache.WithLabelValues(string(task), "hit").Inc()
+
+	resp := &ChatResponse{
+		Thinking:  cached.Thinking,
+		Content:   cached.Content,
+		Provider:  cached.Provider,
+		Model:     cached.Model,
+		LatencyMs: time.Since(start).Milliseconds(),
+		CreatedAt: time.Now(),
+		Cached:    true,
+	}
+	pm.logRequest(ctx, task, req, resp, "")
+	slog.Info("ai request served from cache",
+		"task", task,
+		"provider", resp.Provider,
+		"model", resp.Model,
+	)
+	return resp
+}
+
+// storeResponse caches a validated response under key.
+func (pm *ProviderManager) storeResponse(ctx context.Context, task TaskType, key string, ttl time.Duration, resp *ChatResponse) {
+	if key == "" {
+		return
+	}
+	if err := pm.cache.Set(ctx, key, resp, ttl); err != nil {
+		slog.Warn("failed to write ai response cache", "task", task, "error", err)
+	}
+}
@@ -0,0 +1,170 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+)
+
+// memoryCache is a ResponseCache in a map, ignoring TTLs.
+type memoryCache struct {
+	mu        sync.Mutex
+	responses map[string]ChatResponse
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (*ChatResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, ok := c.responses[key]
+	if !ok {
+		return nil, nil
+	}
+	return &resp, nil
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, resp *ChatResponse, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[key] = *resp
+	return nil
+}
+
+// recordingLogProvider keeps the logged requests.
+type recordingLogProvider struct {
+	mu   sync.Mutex
+	logs []LogRequest
+}
+
+func (l *recordingLogProvider) Log(_ context.Context, req LogRequest) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.logs = append(l.logs, req)
+	return nil
+}
+
+func newCacheTestManager(t *testing.T, ttlSeconds int) (*ProviderManager, *FakeProvider, *recordingLogProvider) {
+	t.Helper()
+	primary := NewFakeProvider(&FakeScript{Rules: []FakeRule{{Content: `{"tags": ["go"]}`, Thinking: "hmm"}}})
+	pm := newStreamTestManager(t, primary, NewFakeProvider(nil).WithType(ProviderOpenAI))
+	pm.configProvider = staticConfigProvider{cfg: &ent.AIConfig{
+		Provider:        string(ProviderFake),
+		JSONMode:        true,
+		CacheTTLSeconds: ttlSeconds,
+	}}
+	logs := &recordingLogProvider{}
+	pm.logProvider = logs
+	pm.SetResponseCache(&memoryCache{responses: map[string]ChatResponse{}})
+	return pm, primary, logs
+}
+
+func TestCacheKey(t *testing.T) {
+	req := ChatRequest{
+		SystemPrompt: "Extract tags",
+		UserPrompt:   "Go is fun\r\nreally  ",
+		Options:      ChatOptions{Model: "gemini-2.0-flash", Temperature: 0.3},
+		Metadata:     map[string]string{"user_id": "a"},
+	}
+	key := CacheKey(TaskTagExtraction, ProviderGemini, req)
+
+	same := req
+	same.UserPrompt = "Go is fun\nreally"
+	same.Metadata = map[string]string{"user_id": "b"}
+	assert.Equal(t, key, CacheKey(TaskTagExtraction, ProviderGemini, same), "whitespace and metadata don't matter")
+
+	other := req
+	other.Options.Model = "gemini-2.5-pro"
+	assert.NotEqual(t, key, CacheKey(TaskTagExtraction, ProviderGemini, other))
+	other = req
+	other.Options.Temperature = 0.7
+	assert.NotEqual(t, key, CacheKey(TaskTagExtraction, ProviderGemini, other))
+	other = req
+	other.UserPrompt = "Rust is fun"
+	assert.NotEqual(t, key, CacheKey(TaskTagExtraction, ProviderGemini, other))
+	assert.NotEqual(t, key, CacheKey(TaskSummarize, ProviderGemini, req))
+}
+
+func TestProviderManager_Chat_Cached(t *testing.T) {
+	pm, primary, logs := newCacheTestManager(t, 60)
+	ctx := context.Background()
+
+	first, err := pm.Chat(ctx, TaskTagExtraction, ChatRequest{UserPrompt: "hello"})
+	require.NoError(t, err)
+	assert.False(t, first.Cached)
+
+	second, err := pm.Chat(ctx, TaskTagExtraction, ChatRequest{UserPrompt: "hello"})
+	require.NoError(t, err)
+	assert.True(t, second.Cached)
+	assert.Equal(t, first.Content, second.Content)
+	assert.Equal(t, first.Model, second.Model)
+	assert.Zero(t, second.TotalTokens, "cache hits carry no tokens")
+	assert.Len(t, primary.Calls(), 1)
+
+	require.Len(t, logs.logs, 2)
+	assert.True(t, logs.logs[1].Response.Cached)
+
+	_, err = pm.Chat(ctx, TaskTagExtraction, ChatRequest{UserPrompt: "other"})
+	require.NoError(t, err)
+	assert.Len(t, primary.Calls(), 2)
+}
+
+func TestProviderManager_Chat_CacheDisabled(t *testing.T) {
+	pm, primary, _ := newCacheTestManager(t, 0)
+
+	for range 2 {
+		resp, err := pm.Chat(context.Background(), TaskTagExtraction, ChatRequest{UserPrompt: "hello"})
+		require.NoError(t, err)
+		assert.False(t, resp.Cached)
+	}
+	assert.Len(t, primary.Calls(), 2)
+}
+
+func TestProviderManager_Chat_CachedResponseRevalidated(t *testing.T) {
+	pm, primary, _ := newCacheTestManager(t, 60)
+	ctx := context.Background()
+
+	_, err := pm.Chat(ctx, TaskTagExtraction, ChatRequest{UserPrompt: "hello"})
+	require.NoError(t, err)
+
+	// A response no longer valid is asked for again
+	resp, err := pm.Chat(ctx, TaskTagExtraction, ChatRequest{
+		UserPrompt: "hello",
+		Validate: func(content string) error {
+			if len(primary.Calls()) == 1 {
+				return errors.New("stale")
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Cached)
+	assert.Len(t, primary.Calls(), 2)
+}
+
+func TestProviderManager_ChatStream_Cached(t *testing.T) {
+	pm, primary, _ := newCacheTestManager(t, 60)
+	ctx := context.Background()
+
+	_, err := pm.ChatStream(ctx, TaskMindmap, ChatRequest{UserPrompt: "hello"}, StreamHandler{})
+	require.NoError(t, err)
+
+	var content, thinking string
+	var done *ChatResponse
+	resp, err := pm.ChatStream(ctx, TaskMindmap, ChatRequest{UserPrompt: "hello"}, StreamHandler{
+		OnThinking: func(delta string) { thinking += delta },
+		OnContent:  func(delta string) { content += delta },
+		OnDone:     func(r *ChatResponse) { done = r },
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Cached)
+	assert.Equal(t, `{"tags": ["go"]}`, content)
+	assert.Equal(t, "hmm", thinking)
+	assert.Same(t, resp, done)
+	assert.Len(t, primary.Calls(), 1)
+}
@@ -0,0 +1,196 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// OutputSchema is the JSON Schema a response must match. Only the subset
+// providers accept for structured output is supported: type, properties,
+// required, items, enum, minItems, maxItems, minLength, minimum and maximum.
+type OutputSchema struct {
+	Name string
+	raw  json.RawMessage
+	root *schemaNode
+}
+
+type schemaNode struct {
+	Type        string                 `json:"type"`
+	Description string                 `json:"description,omitempty"`
+	Properties  map[string]*schemaNode `json:"properties,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	Items       *schemaNode            `json:"items,omitempty"`
+	Enum        []string               `json:"enum,omitempty"`
+	MinItems    *int                   `json:"minItems,omitempty"`
+	MaxItems    *int                   `json:"maxItems,omitempty"`
+	MinLength   *int                   `json:"minLength,omitempty"`
+	Minimum     *float64               `json:"minimum,omitempty"`
+	Maximum     *float64               `json:"maximum,omitempty"`
+}
+
+// NewOutputSchema parses a JSON Schema document.
+func NewOutputSchema(name string, schema []byte) (*OutputSchema, error) {
+	var root schemaNode
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("parse output schema %s: %w", name, err)
+	}
+	if err := root.check(name); err != nil {
+		return nil, fmt.Errorf("parse output schema %s: %w", name, err)
+	}
+	return &OutputSchema{Name: name, raw: schema, root: &root}, nil
+}
+
+// MarshalJSON returns the schema document, so it can be sent to providers.
+func (s *OutputSchema) MarshalJSON() ([]byte, error) {
+	return s.raw, nil
+}
+
+// Validate returns the problems of content against the schema, or nil when
+// it matches. Content must be valid JSON.
+func (s *OutputSchema) Validate(content string) []string {
+	var value any
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		return []string{err.Error()}
+	}
+	var problems []string
+	s.root.validate("$", value, &problems)
+	return problems
+}
+
+func (n *schemaNode) check(path string) error {
+	switch n.Type {
+	case "object":
+		for name, prop := range n.Properties {
+			if err := prop.check(path + "." + name); err != nil {
+				return err
+			}
+		}
+	case "array":
+		if n.Items == nil {
+			return fmt.Errorf("%s: array without items", path)
+		}
+		return n.Items.check(path + "[]")
+	case "string", "number", "integer", "boolean":
+	default:
+		return fmt.Errorf("%s: unsupported type %q", path, n.Type)
+	}
+	return nil
+}
+
+func (n *schemaNode) validate(path string, value any, problems *[]string) {
+	fail := func(format string, args ...any) {
+		*problems = append(*problems, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	switch n.Type {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			fail("expected an object")
+			return
+		}
+		for _, name := range n.Required {
+			if _, ok := obj[name]; !ok {
+				fail("missing required property %q", name)
+			}
+		}
+		names := make([]string, 0, len(n.Properties))
+		for name := range n.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if v, ok := obj[name]; ok {
+				n.Properties[name].validate(path+"."+name, v, problems)
+			}
+		}
+	case "array":
+		arr, ok := value.([]any)
+		if !ok {
+			fail("expected an array")
+			return
+		}
+		if n.MinItems != nil && len(arr) < *n.MinItems {
+			fail("expected at least %d items, got %d", *n.MinItems, len(arr))
+		}
+		if n.MaxItems != nil && len(arr) > *n.MaxItems {
+			fail("expected at most %d items, got %d", *n.MaxItems, len(arr))
+		}
+		for i, v := range arr {
+			n.Items.validate(fmt.Sprintf("%s[%d]", path, i), v, problems)
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			fail("expected a string")
+			return
+		}
+		if n.MinLength != nil && len([]rune(strings.TrimSpace(str))) < *n.MinLength {
+			fail("expected at least %d characters", *n.MinLength)
+		}
+		if len(n.Enum) > 0 && !slices.Contains(n.Enum, str) {
+			fail("expected one of %s", strings.Join(n.Enum, ", "))
+		}
+	case "number", "integer":
+		num, ok := value.(float64)
+		if !ok {
+			fail("expected a number")
+			return
+		}
+		if n.Type == "integer" && num != math.Trunc(num) {
+			fail("expected an integer")
+		}
+		if n.Minimum != nil && num < *n.Minimum {
+			fail("expected at least %v", *n.Minimum)
+		}
+		if n.Maximum != nil && num > *n.Maximum {
+			fail("expected at most %v", *n.Maximum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			fail("expected a boolean")
+		}
+	}
+}
+
+// genaiSchema converts the schema for Gemini's structured output.
+func (s *OutputSchema) genaiSchema() *genai.Schema {
+	return s.root.genai()
+}
+
+func (n *schemaNode) genai() *genai.Schema {
+	schema := &genai.Schema{
+		Description: n.Description,
+		Enum:        n.Enum,
+		Required:    n.Required,
+	}
+	switch n.Type {
+	case "object":
+		schema.Type = genai.TypeObject
+		schema.Properties = make(map[string]*genai.Schema, len(n.Properties))
+		for name, prop := range n.Properties {
+			schema.Properties[name] = prop.genai()
+		}
+	case "array":
+		schema.Type = genai.TypeArray
+		schema.Items = n.Items.genai()
+	case "string":
+		schema.Type = genai.TypeString
+		if len(n.Enum) > 0 {
+			schema.Format = "enum"
+		}
+	case "number":
+		schema.Type = genai.TypeNumber
+	case "integer":
+		schema.Type = genai.TypeInteger
+	case "boolean":
+		schema.Type = genai.TypeBoolean
+	}
+	return schema
+}
@@ -0,0 +1,92 @@
+package ai
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+  "type": "object",
+  "required": ["label", "topics"],
+  "properties": {
+    "label": {"type": "string", "minLength": 1},
+    "kind": {"type": "string", "enum": ["a", "b"]},
+    "topics": {
+      "type": "array",
+      "minItems": 1,
+      "items": {
+        "type": "object",
+        "required": ["id"],
+        "properties": {
+          "id": {"type": "string"},
+          "relevance": {"type": "number", "minimum": 0, "maximum": 1},
+          "count": {"type": "integer"},
+          "pinned": {"type": "boolean"}
+        }
+      }
+    }
+  }
+}`
+
+func TestOutputSchema_Validate(t *testing.T) {
+	schema, err := NewOutputSchema("test", []byte(testSchema))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		content  string
+		problems []string
+	}{
+		{"valid", `{"label": "x", "kind": "a", "topics": [{"id": "t", "relevance": 0.5, "count": 2, "pinned": true}]}`, nil},
+		{"extra properties are allowed", `{"label": "x", "topics": [{"id": "t"}], "other": 1}`, nil},
+		{"missing required", `{"topics": [{"id": "t"}]}`, []string{`$: missing required property "label"`}},
+		{"blank string", `{"label": "  ", "topics": [{"id": "t"}]}`, []string{"$.label: expected at least 1 characters"}},
+		{"enum", `{"label": "x", "kind": "c", "topics": [{"id": "t"}]}`, []string{"$.kind: expected one of a, b"}},
+		{"empty array", `{"label": "x", "topics": []}`, []string{"$.topics: expected at least 1 items, got 0"}},
+		{"nested problems", `{"label": "x", "topics": [{"relevance": 2, "count": 1.5, "pinned": "yes"}]}`, []string{
+			`$.topics[0]: missing required property "id"`,
+			"$.topics[0].count: expected an integer",
+			"$.topics[0].pinned: expected a boolean",
+			"$.topics[0].relevance: expected at most 1",
+		}},
+		{"wrong types", `{"label": 1, "topics": {}}`, []string{"$.label: expected a string", "$.topics: expected an array"}},
+		{"not an object", `[]`, []string{"$: expected an object"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.problems, schema.Validate(tt.content))
+		})
+	}
+}
+
+func TestNewOutputSchema_Invalid(t *testing.T) {
+	_, err := NewOutputSchema("bad", []byte(`{"type": "object", "properties": {"x": {"type": "null"}}}`))
+	assert.Error(t, err)
+
+	_, err = NewOutputSchema("bad", []byte(`{"type": "array"}`))
+	assert.Error(t, err)
+
+	_, err = NewOutputSchema("bad", []byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestOutputSchema_ProviderFormats(t *testing.T) {
+	schema, err := NewOutputSchema("test", []byte(testSchema))
+	require.NoError(t, err)
+
+	data, err := json.Marshal(schema)
+	require.NoError(t, err)
+	assert.JSONEq(t, testSchema, string(data))
+
+	g := schema.genaiSchema()
+	assert.Equal(t, genai.TypeObject, g.Type)
+	assert.Equal(t, []string{"label", "topics"}, g.Required)
+	assert.Equal(t, genai.TypeArray, g.Properties["topics"].Type)
+	assert.Equal(t, genai.TypeNumber, g.Properties["topics"].Items.Properties["relevance"].Type)
+	assert.Equal(t, "enum", g.Properties["kind"].Format)
+}
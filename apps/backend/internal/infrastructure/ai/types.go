@@ -1,7 +1,10 @@
 // Package ai provides unified AI provider integration for multiple providers.
 package ai
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // ProviderType identifies the AI provider.
 type ProviderType string
@@ -13,8 +16,33 @@ const (
 	ProviderGemini ProviderType = "gemini"
 	// ProviderClaude represents the Anthropic Claude provider.
 	ProviderClaude ProviderType = "claude"
+	// ProviderOpenAICompatible represents a self-hosted server speaking the
+	// OpenAI chat completions API (Ollama, vLLM, llama.cpp).
+	ProviderOpenAICompatible ProviderType = "openai_compatible"
+	// ProviderFake represents the scripted provider for tests and offline
+	// development.
+	ProviderFake ProviderType = "fake"
 )
 
+// KnownProviders lists every provider type an AI config can select.
+var KnownProviders = []ProviderType{
+	ProviderOpenAI,
+	ProviderGemini,
+	ProviderClaude,
+	ProviderOpenAICompatible,
+	ProviderFake,
+}
+
+// IsKnownProvider reports whether name is a supported provider type.
+func IsKnownProvider(name string) bool {
+	for _, pt := range KnownProviders {
+		if string(pt) == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Default models for each provider (centralized to avoid magic strings).
 const (
 	// DefaultOpenAIModel is the default model for OpenAI provider.
@@ -23,14 +51,43 @@ const (
 	DefaultGeminiModel = "gemini-2.0-flash"
 	// DefaultClaudeModel is the default model for Claude provider.
 	DefaultClaudeModel = "claude-sonnet-4-20250514"
+	// DefaultFakeModel is the default model for the fake provider.
+	DefaultFakeModel = "fake-model"
+)
+
+// Capability declares whether an endpoint supports an optional feature.
+type Capability string
+
+const (
+	// CapabilityAuto detects support from the endpoint's responses.
+	CapabilityAuto Capability = "auto"
+	// CapabilityOn declares the feature supported.
+	CapabilityOn Capability = "on"
+	// CapabilityOff declares the feature unsupported.
+	CapabilityOff Capability = "off"
 )
 
+// ParseCapability parses a capability setting. It accepts "auto" and the
+// usual boolean spellings, and falls back to CapabilityAuto.
+func ParseCapability(s string) Capability {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "on", "true", "1", "yes":
+		return CapabilityOn
+	case "off", "false", "0", "no":
+		return CapabilityOff
+	default:
+		return CapabilityAuto
+	}
+}
+
 // TaskType identifies the AI task for provider selection.
 type TaskType string
 
 const (
 	// TaskTagExtraction is used for extracting tags from page content.
 	TaskTagExtraction TaskType = "tag_extraction"
+	// TaskSummarize is used for full-page URL summarization.
+	TaskSummarize TaskType = "summarize"
 	// TaskMindmap is used for generating mindmaps from sessions.
 	TaskMindmap TaskType = "mindmap"
 	// TaskGeneral is used for general AI tasks.
@@ -64,6 +121,8 @@ type ChatOptions struct {
 	JSONMode       bool     `json:"json_mode,omitempty"`
 	EnableThinking bool     `json:"enable_thinking,omitempty"`
 	ThinkingBudget int      `json:"thinking_budget,omitempty"`
+	// Model overrides the provider's default model when set.
+	Model string `json:"model,omitempty"`
 }
 
 // DefaultChatOptions returns sensible defaults for chat options.
@@ -77,11 +136,21 @@ func DefaultChatOptions() ChatOptions {
 
 // ChatRequest represents a unified request structure for all providers.
 type ChatRequest struct {
+	// Task is set by the ProviderManager to the task the request is for
+	Task         TaskType          `json:"task,omitempty"`
 	SystemPrompt string            `json:"system_prompt"`
 	UserPrompt   string            `json:"user_prompt"`
 	Messages     []Message         `json:"messages,omitempty"`
 	Options      ChatOptions       `json:"options"`
 	Metadata     map[string]string `json:"metadata,omitempty"`
+
+	// Schema is the structured output the response must match. It implies
+	// JSONMode, and providers supporting structured output are given it.
+	Schema *OutputSchema `json:"-"`
+	// Validate checks the parsed response beyond the schema, e.g. that IDs
+	// refer to known records. A response failing validation is sent back to
+	// the provider once to be repaired.
+	Validate func(content string) error `json:"-"`
 }
 
 // ChatResponse represents a unified response structure from all providers.
@@ -90,11 +159,14 @@ type ChatResponse struct {
 	Thinking string `json:"thinking,omitempty"`
 	Content  string `json:"content"`
 
-	// Accurate token measurement (extracted from provider API response)
-	InputTokens    int `json:"input_tokens"`
-	OutputTokens   int `json:"output_tokens"`
-	ThinkingTokens int `json:"thinking_tokens,omitempty"`
-	TotalTokens    int `json:"total_tokens"`
+	// Accurate token measurement (extracted from provider API response).
+	// CachedInputTokens are the part of InputTokens read from the provider's
+	// prompt cache, ThinkingTokens are not part of OutputTokens.
+	InputTokens       int `json:"input_tokens"`
+	CachedInputTokens int `json:"cached_input_tokens,omitempty"`
+	OutputTokens      int `json:"output_tokens"`
+	ThinkingTokens    int `json:"thinking_tokens,omitempty"`
+	TotalTokens       int `json:"total_tokens"`
 
 	// Metadata
 	Provider  ProviderType `json:"provider"`
@@ -102,6 +174,9 @@ type ChatResponse struct {
 	LatencyMs int64        `json:"latency_ms"`
 	RequestID string       `json:"request_id,omitempty"`
 	CreatedAt time.Time    `json:"created_at"`
+	// Cached is set on responses served from the response cache. They
+	// carry no tokens and cost nothing.
+	Cached bool `json:"cached,omitempty"`
 }
 
 // StreamDelta represents a streaming response chunk.
@@ -134,4 +209,31 @@ type Config struct {
 	OpenAIAPIKey string
 	GeminiAPIKey string
 	ClaudeAPIKey string
+
+	OpenAICompatible OpenAICompatibleConfig
+	Fake             FakeConfig
+	Breaker          BreakerConfig
+
+	// Rate limits per provider and model, enforced once a Limiter is set.
+	// Requests wait for budget up to RateLimitMaxWait, within their context
+	// deadline, and fail with a RateLimitError otherwise.
+	RateLimits       RateLimits
+	RateLimitMaxWait time.Duration
+}
+
+// OpenAICompatibleConfig configures a self-hosted OpenAI-compatible endpoint.
+// The provider is enabled when BaseURL is set.
+type OpenAICompatibleConfig struct {
+	BaseURL  string // e.g. http://localhost:11434/v1 for Ollama
+	APIKey   string // optional, most local servers ignore it
+	Model    string // default model when the AI config doesn't name one
+	JSONMode Capability
+	Thinking Capability
+}
+
+// FakeConfig enables the scripted fake provider. It never calls a model and
+// must not be enabled in production.
+type FakeConfig struct {
+	Enabled    bool
+	ScriptPath string // optional JSON FakeScript with canned responses
 }
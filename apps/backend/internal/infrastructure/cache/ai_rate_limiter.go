@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+)
+
+const aiRateLimitKeyPrefix = "ai:ratelimit:"
+
+// takeBudget takes a request and ARGV[3] tokens from the budget of a minute
+// unless that exceeds ARGV[1] requests or ARGV[2] tokens (0 is unlimited).
+// A request larger than the whole token budget is let through alone.
+var takeBudget = redis.NewScript(`
+local rpm = tonumber(ARGV[1])
+local tpm = tonumber(ARGV[2])
+local tokens = tonumber(ARGV[3])
+local requests = tonumber(redis.call('GET', KEYS[1]) or '0')
+local used = tonumber(redis.call('GET', KEYS[2]) or '0')
+if rpm > 0 and requests >= rpm then
+	return 0
+end
+if tpm > 0 and used > 0 and used + tokens > tpm then
+	return 0
+end
+redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+redis.call('INCRBY', KEYS[2], tokens)
+redis.call('PEXPIRE', KEYS[2], ARGV[4])
+return 1
+`)
+
+// AIRateLimiter keeps the AI rate limit budgets in Redis, so every worker
+// shares them. Budgets are per minute of the clock.
+type AIRateLimiter struct {
+	rdb *redis.Client
+	now func() time.Time
+}
+
+var _ ai.Limiter = (*AIRateLimiter)(nil)
+
+// NewAIRateLimiter creates a new AIRateLimiter.
+func NewAIRateLimiter(rdb *redis.Client) *AIRateLimiter {
+	return &AIRateLimiter{rdb: rdb, now: time.Now}
+}
+
+// Take takes a request and tokens from the budget of key for the current
+// minute and returns the minute, or returns the time until the next minute
+// if it is exhausted.
+func (l *AIRateLimiter) Take(ctx context.Context, key string, limit ai.RateLimit, tokens int) (time.Time, time.Duration, error) {
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("eval").Observe(time.Since(start).Seconds())
+	}()
+
+	now := l.now()
+	window := now.Truncate(time.Minute)
+	requestsKey, tokensKey := l.keys(key, window)
+	taken, err := takeBudget.Run(ctx, l.rdb,
+		[]string{requestsKey, tokensKey},
+		limit.RequestsPerMinute, limit.TokensPerMinute, tokens, (2 * time.Minute).Milliseconds(),
+	).Int()
+	if err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("eval", "error").Inc()
+		return time.Time{}, 0, err
+	}
+	metrics.RedisCacheOperations.WithLabelValues("eval", "success").Inc()
+
+	if taken == 1 {
+		return window, 0, nil
+	}
+	return time.Time{}, window.Add(time.Minute).Sub(now), nil
+}
+
+// AddTokens adds tokens, possibly negative, to the budget of key for the
+// minute starting at window, which a call that outlasted its minute no
+// longer is.
+func (l *AIRateLimiter) AddTokens(ctx context.Context, key string, window time.Time, tokens int) error {
+	if tokens == 0 {
+		return nil
+	}
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("set").Observe(time.Since(start).Seconds())
+	}()
+
+	_, tokensKey := l.keys(key, window)
+	pipe := l.rdb.TxPipeline()
+	pipe.IncrBy(ctx, tokensKey, int64(tokens))
+	pipe.Expire(ctx, tokensKey, 2*time.Minute)
+	if _, err := pipe.Exec(ctx); err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("set", "error").Inc()
+		return err
+	}
+	metrics.RedisCacheOperations.WithLabelValues("set", "success").Inc()
+	return nil
+}
+
+func (l *AIRateLimiter) keys(key string, now time.Time) (requests, tokens string) {
+	prefix := aiRateLimitKeyPrefix + key + ":" + strconv.FormatInt(now.Unix()/60, 10)
+	return prefix + ":requests", prefix + ":tokens"
+}
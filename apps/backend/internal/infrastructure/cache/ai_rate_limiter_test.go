@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/internal/infrastructure/ai"
+)
+
+func setupAIRateLimiter(t *testing.T) (*AIRateLimiter, *time.Time) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := NewRedisClient(mr.Addr())
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	now := time.Date(2025, 1, 1, 12, 0, 45, 0, time.UTC)
+	l := NewAIRateLimiter(rdb)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestAIRateLimiter_RequestsPerMinute(t *testing.T) {
+	l, now := setupAIRateLimiter(t)
+	ctx := context.Background()
+	limit := ai.RateLimit{RequestsPerMinute: 2}
+
+	for range 2 {
+		_, wait, err := l.Take(ctx, "gemini", limit, 100)
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	}
+
+	_, wait, err := l.Take(ctx, "gemini", limit, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Second, wait, "until the next minute")
+
+	// Other keys have their own budget
+	_, wait, err = l.Take(ctx, "gemini/gemini-2.5-pro", limit, 100)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	// The budget renews every minute
+	*now = now.Add(15 * time.Second)
+	_, wait, err = l.Take(ctx, "gemini", limit, 100)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestAIRateLimiter_TokensPerMinute(t *testing.T) {
+	l, now := setupAIRateLimiter(t)
+	ctx := context.Background()
+	limit := ai.RateLimit{TokensPerMinute: 1000}
+
+	// A request larger than the budget is let through alone
+	_, wait, err := l.Take(ctx, "openai", limit, 1500)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	_, wait, err = l.Take(ctx, "openai", limit, 10)
+	require.NoError(t, err)
+	assert.Positive(t, wait)
+
+	// Correcting an overestimate frees budget
+	require.NoError(t, l.AddTokens(ctx, "openai", now.Truncate(time.Minute), -1000))
+	_, wait, err = l.Take(ctx, "openai", limit, 400)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	_, wait, err = l.Take(ctx, "openai", limit, 200)
+	require.NoError(t, err)
+	assert.Positive(t, wait)
+}
+
+func TestAIRateLimiter_AddTokensToReservedWindow(t *testing.T) {
+	l, now := setupAIRateLimiter(t)
+	ctx := context.Background()
+	limit := ai.RateLimit{TokensPerMinute: 1000}
+
+	window, wait, err := l.Take(ctx, "openai", limit, 100)
+	require.NoError(t, err)
+	require.Zero(t, wait)
+
+	// The call ends in the next minute and used more than estimated
+	*now = now.Add(30 * time.Second)
+	_, wait, err = l.Take(ctx, "openai", limit, 900)
+	require.NoError(t, err)
+	require.Zero(t, wait)
+	require.NoError(t, l.AddTokens(ctx, "openai", window, 500))
+
+	_, wait, err = l.Take(ctx, "openai", limit, 100)
+	require.NoError(t, err)
+	assert.Zero(t, wait, "the correction went to the minute of the reservation")
+}
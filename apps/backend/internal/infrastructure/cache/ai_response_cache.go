@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+)
+
+const aiResponseKeyPrefix = "ai:response:"
+
+// AIResponseCache keeps AI responses in Redis by the content address of
+// their request, so every worker shares them.
+type AIResponseCache struct {
+	rdb *redis.Client
+}
+
+var _ ai.ResponseCache = (*AIResponseCache)(nil)
+
+// NewAIResponseCache creates a new AIResponseCache.
+func NewAIResponseCache(rdb *redis.Client) *AIResponseCache {
+	return &AIResponseCache{rdb: rdb}
+}
+
+// Get returns the response stored under key, or nil if there is none.
+func (c *AIResponseCache) Get(ctx context.Context, key string) (*ai.ChatResponse, error) {
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("get").Observe(time.Since(start).Seconds())
+	}()
+
+	data, err := c.rdb.Get(ctx, aiResponseKeyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		metrics.RedisCacheOperations.WithLabelValues("get", "miss").Inc()
+		return nil, nil
+	}
+	if err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("get", "error").Inc()
+		return nil, err
+	}
+	metrics.RedisCacheOperations.WithLabelValues("get", "hit").Inc()
+
+	var resp ai.ChatResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Set stores resp under key for ttl.
+func (c *AIResponseCache) Set(ctx context.Context, key string, resp *ai.ChatResponse, ttl time.Duration) error {
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("set").Observe(time.Since(start).Seconds())
+	}()
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if err := c.rdb.Set(ctx, aiResponseKeyPrefix+key, data, ttl).Err(); err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("set", "error").Inc()
+		return err
+	}
+	metrics.RedisCacheOperations.WithLabelValues("set", "success").Inc()
+	return nil
+}
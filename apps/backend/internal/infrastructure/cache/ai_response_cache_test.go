@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/internal/infrastructure/ai"
+)
+
+func TestAIResponseCache(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := NewRedisClient(mr.Addr())
+	t.Cleanup(func() { _ = rdb.Close() })
+	c := NewAIResponseCache(rdb)
+	ctx := context.Background()
+
+	resp, err := c.Get(ctx, "abc")
+	require.NoError(t, err)
+	assert.Nil(t, resp)
+
+	require.NoError(t, c.Set(ctx, "abc", &ai.ChatResponse{
+		Content:     `{"tags": ["go"]}`,
+		Provider:    ai.ProviderGemini,
+		Model:       ai.DefaultGeminiModel,
+		TotalTokens: 120,
+	}, time.Hour))
+
+	resp, err = c.Get(ctx, "abc")
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, `{"tags": ["go"]}`, resp.Content)
+	assert.Equal(t, ai.ProviderGemini, resp.Provider)
+
+	mr.FastForward(time.Hour)
+	resp, err = c.Get(ctx, "abc")
+	require.NoError(t, err)
+	assert.Nil(t, resp, "expired")
+}
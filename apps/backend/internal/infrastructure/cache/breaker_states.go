@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+)
+
+const breakerStatesKeyPrefix = "ai:breakers:"
+
+// InstanceBreakers are the circuit breakers of one worker instance.
+type InstanceBreakers struct {
+	Instance    string             `json:"instance"`
+	PublishedAt time.Time          `json:"published_at"`
+	Breakers    []ai.BreakerStatus `json:"breakers"`
+}
+
+// BreakerStates shares the AI circuit breaker states of the workers, which
+// keep them in memory, with the API. Each worker publishes its states
+// periodically with a TTL, so stopped workers drop out.
+type BreakerStates struct {
+	rdb *redis.Client
+}
+
+// NewBreakerStates creates a new BreakerStates.
+func NewBreakerStates(rdb *redis.Client) *BreakerStates {
+	return &BreakerStates{rdb: rdb}
+}
+
+// Publish stores the circuit breaker states of a worker instance.
+func (s *BreakerStates) Publish(ctx context.Context, instance string, breakers []ai.BreakerStatus, ttl time.Duration) error {
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("set").Observe(time.Since(start).Seconds())
+	}()
+
+	data, err := json.Marshal(InstanceBreakers{
+		Instance:    instance,
+		PublishedAt: time.Now(),
+		Breakers:    breakers,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.rdb.Set(ctx, breakerStatesKeyPrefix+instance, data, ttl).Err(); err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("set", "error").Inc()
+		return err
+	}
+	metrics.RedisCacheOperations.WithLabelValues("set", "success").Inc()
+	return nil
+}
+
+// All returns the circuit breaker states of every running worker instance,
+// sorted by instance.
+func (s *BreakerStates) All(ctx context.Context) ([]InstanceBreakers, error) {
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("get").Observe(time.Since(start).Seconds())
+	}()
+
+	var keys []string
+	iter := s.rdb.Scan(ctx, 0, breakerStatesKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("get", "error").Inc()
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return []InstanceBreakers{}, nil
+	}
+
+	values, err := s.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("get", "error").Inc()
+		return nil, err
+	}
+	metrics.RedisCacheOperations.WithLabelValues("get", "hit").Inc()
+
+	result := make([]InstanceBreakers, 0, len(values))
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			// Expired between SCAN and MGET
+			continue
+		}
+		var states InstanceBreakers
+		if err := json.Unmarshal([]byte(data), &states); err != nil {
+			states = InstanceBreakers{Instance: strings.TrimPrefix(keys[i], breakerStatesKeyPrefix)}
+		}
+		result = append(result, states)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Instance < result[j].Instance })
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/internal/infrastructure/ai"
+)
+
+func TestBreakerStates(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := NewRedisClient(mr.Addr())
+	t.Cleanup(func() { _ = rdb.Close() })
+	states := NewBreakerStates(rdb)
+	ctx := context.Background()
+
+	all, err := states.All(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+
+	require.NoError(t, states.Publish(ctx, "worker-b", []ai.BreakerStatus{
+		{Provider: ai.ProviderGemini, Model: "gemini-2.5-flash", State: ai.BreakerOpen, Requests: 6, Failures: 6},
+	}, time.Minute))
+	require.NoError(t, states.Publish(ctx, "worker-a", nil, 2*time.Minute))
+
+	all, err = states.All(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "worker-a", all[0].Instance)
+	assert.Equal(t, "worker-b", all[1].Instance)
+	require.Len(t, all[1].Breakers, 1)
+	assert.Equal(t, ai.BreakerOpen, all[1].Breakers[0].State)
+
+	// Stopped workers drop out
+	mr.FastForward(90 * time.Second)
+	all, err = states.All(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "worker-a", all[0].Instance)
+}
@@ -0,0 +1,13 @@
+// Package cache provides Redis-backed caches shared by the API and worker.
+package cache
+
+import (
+	"github.com/redis/go-redis/v9"
+)
+
+// NewRedisClient creates a Redis client for the given address.
+func NewRedisClient(redisAddr string) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr: redisAddr,
+	})
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+)
+
+const (
+	revokedFamilyKeyPrefix = "auth:revoked:family:"
+	revokedUserKeyPrefix   = "auth:revoked:user:"
+)
+
+// RevocationList keeps revoked token families and users in Redis. Entries
+// expire once every access token they could match has expired, so the list
+// stays small.
+type RevocationList struct {
+	rdb *redis.Client
+}
+
+// NewRevocationList creates a new RevocationList.
+func NewRevocationList(rdb *redis.Client) *RevocationList {
+	return &RevocationList{rdb: rdb}
+}
+
+// RevokeFamily revokes every token of a login.
+func (l *RevocationList) RevokeFamily(ctx context.Context, familyID uuid.UUID, ttl time.Duration) error {
+	return l.set(ctx, revokedFamilyKeyPrefix+familyID.String(), "1", ttl)
+}
+
+// RevokeUser revokes every token of a user issued at or before before.
+func (l *RevocationList) RevokeUser(ctx context.Context, userID uuid.UUID, before time.Time, ttl time.Duration) error {
+	return l.set(ctx, revokedUserKeyPrefix+userID.String(), strconv.FormatInt(before.UnixMilli(), 10), ttl)
+}
+
+// IsRevoked reports whether a token of the given user and family, issued at
+// issuedAt, has been revoked.
+func (l *RevocationList) IsRevoked(ctx context.Context, userID, familyID uuid.UUID, issuedAt time.Time) (bool, error) {
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("get").Observe(time.Since(start).Seconds())
+	}()
+
+	values, err := l.rdb.MGet(ctx,
+		revokedFamilyKeyPrefix+familyID.String(),
+		revokedUserKeyPrefix+userID.String(),
+	).Result()
+	if err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("get", "error").Inc()
+		return false, err
+	}
+
+	revoked := values[0] != nil
+	if before, ok := values[1].(string); ok && !revoked {
+		ms, err := strconv.ParseInt(before, 10, 64)
+		if err != nil {
+			metrics.RedisCacheOperations.WithLabelValues("get", "error").Inc()
+			return false, errors.New("malformed user revocation entry")
+		}
+		revoked = issuedAt.UnixMilli() <= ms
+	}
+
+	if revoked {
+		metrics.RedisCacheOperations.WithLabelValues("get", "hit").Inc()
+	} else {
+		metrics.RedisCacheOperations.WithLabelValues("get", "miss").Inc()
+	}
+	return revoked, nil
+}
+
+func (l *RevocationList) set(ctx context.Context, key, value string, ttl time.Duration) error {
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("set").Observe(time.Since(start).Seconds())
+	}()
+
+	if err := l.rdb.Set(ctx, key, value, ttl).Err(); err != nil {
+		metrics.RedisCacheOperations.WithLabelValues("set", "error").Inc()
+		return err
+	}
+	metrics.RedisCacheOperations.WithLabelValues("set", "success").Inc()
+	return nil
+}
@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRevocationList(t *testing.T) (*RevocationList, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := NewRedisClient(mr.Addr())
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewRevocationList(rdb), mr
+}
+
+func TestRevocationList_RevokeFamily(t *testing.T) {
+	list, mr := setupRevocationList(t)
+	ctx := context.Background()
+	userID, familyID := uuid.New(), uuid.New()
+
+	revoked, err := list.IsRevoked(ctx, userID, familyID, time.Now())
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	require.NoError(t, list.RevokeFamily(ctx, familyID, 15*time.Minute))
+
+	revoked, err = list.IsRevoked(ctx, userID, familyID, time.Now())
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = list.IsRevoked(ctx, userID, uuid.New(), time.Now())
+	require.NoError(t, err)
+	assert.False(t, revoked, "other logins of the user stay valid")
+
+	mr.FastForward(16 * time.Minute)
+	revoked, err = list.IsRevoked(ctx, userID, familyID, time.Now())
+	require.NoError(t, err)
+	assert.False(t, revoked, "entry expires with the access tokens")
+}
+
+func TestRevocationList_RevokeUser(t *testing.T) {
+	list, _ := setupRevocationList(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	now := time.Now()
+
+	require.NoError(t, list.RevokeUser(ctx, userID, now, 15*time.Minute))
+
+	revoked, err := list.IsRevoked(ctx, userID, uuid.New(), now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.True(t, revoked, "tokens issued before the revocation are rejected")
+
+	revoked, err = list.IsRevoked(ctx, userID, uuid.New(), now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, revoked, "tokens issued after the revocation are accepted")
+
+	revoked, err = list.IsRevoked(ctx, userID, uuid.New(), now.Add(100*time.Millisecond))
+	require.NoError(t, err)
+	assert.False(t, revoked, "tokens issued later in the same second are accepted")
+
+	revoked, err = list.IsRevoked(ctx, uuid.New(), uuid.New(), now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+}
+
+func TestRevocationList_RedisDown(t *testing.T) {
+	list, mr := setupRevocationList(t)
+	mr.Close()
+
+	_, err := list.IsRevoked(context.Background(), uuid.New(), uuid.New(), time.Now())
+
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -20,7 +21,49 @@ type Config struct {
 	WorkerConcurrency  int
 	GoogleClientID     string
 	GoogleClientSecret string
+	AdminEmails        []string // users allowed to use the admin API
 	AI                 AIConfig
+	SummaryBackfill    SummaryBackfillConfig
+	Retention          RetentionConfig
+	Stripe             StripeConfig
+	Mail               MailConfig
+}
+
+// SummaryBackfillConfig controls the periodic URL summary backfill job.
+type SummaryBackfillConfig struct {
+	Interval    string // cron spec, empty disables the job
+	BatchSize   int    // max URLs enqueued per run
+	DailyTokens int    // max summarization tokens per 24h, 0 = unlimited
+}
+
+// RetentionConfig controls the periodic plan-based session retention job.
+type RetentionConfig struct {
+	Interval       string // cron spec, empty disables the job
+	PurgeAfterDays int    // days a soft-deleted session is kept before purge
+	DryRun         bool   // only report what would be deleted
+}
+
+// StripeConfig holds Stripe billing settings. Billing is disabled when
+// SecretKey is empty.
+type StripeConfig struct {
+	SecretKey       string
+	WebhookSecret   string
+	ProPriceID      string // Stripe price for the pro plan
+	SuccessURL      string // where Checkout redirects after payment
+	CancelURL       string // where Checkout redirects when abandoned
+	PortalReturnURL string // where the customer portal links back to
+}
+
+// MailConfig holds outgoing email settings. Without SMTPHost emails go to
+// the development sink instead of being delivered.
+type MailConfig struct {
+	AppURL       string // web app base URL used for links in emails
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	SinkDir      string // where the development sink writes .eml files, empty only logs
 }
 
 // AIConfig holds API keys for AI providers.
@@ -29,6 +72,28 @@ type AIConfig struct {
 	OpenAIAPIKey string
 	GeminiAPIKey string
 	ClaudeAPIKey string
+
+	// Self-hosted OpenAI-compatible endpoint (Ollama, vLLM, llama.cpp),
+	// enabled when the base URL is set
+	OpenAICompatibleBaseURL  string
+	OpenAICompatibleAPIKey   string
+	OpenAICompatibleModel    string
+	OpenAICompatibleJSONMode string // auto, true or false
+	OpenAICompatibleThinking string // auto, true or false
+
+	// Scripted fake provider for tests and offline development
+	FakeEnabled    bool
+	FakeScriptPath string
+
+	// Circuit breaker per provider and model
+	BreakerMinRequests     int // requests in the window before it can open
+	BreakerFailurePercent  int // failed share of the last minute that opens it
+	BreakerSlowCallSeconds int // calls slower than this count as failed
+	BreakerOpenSeconds     int // time before an open circuit is probed
+
+	// Requests and tokens per minute by provider[/model], shared by workers
+	RateLimits              string // e.g. gemini=1000:4000000,openai/gpt-4o-mini=500:200000
+	RateLimitMaxWaitSeconds int    // longer waits re-queue the task instead
 }
 
 // Load reads configuration from environment variables and returns a Config struct.
@@ -46,10 +111,55 @@ func Load() *Config {
 		WorkerConcurrency:  getEnvInt("WORKER_CONCURRENCY", 10),
 		GoogleClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
 		GoogleClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
+		AdminEmails:        getEnvList("ADMIN_EMAILS"),
 		AI: AIConfig{
 			OpenAIAPIKey: getEnv("OPENAI_API_KEY", ""),
 			GeminiAPIKey: getEnv("GEMINI_API_KEY", ""),
 			ClaudeAPIKey: getEnv("CLAUDE_API_KEY", ""),
+
+			OpenAICompatibleBaseURL:  getEnv("OPENAI_COMPATIBLE_BASE_URL", ""),
+			OpenAICompatibleAPIKey:   getEnv("OPENAI_COMPATIBLE_API_KEY", ""),
+			OpenAICompatibleModel:    getEnv("OPENAI_COMPATIBLE_MODEL", ""),
+			OpenAICompatibleJSONMode: getEnv("OPENAI_COMPATIBLE_JSON_MODE", "auto"),
+			OpenAICompatibleThinking: getEnv("OPENAI_COMPATIBLE_THINKING", "auto"),
+
+			FakeEnabled:    getEnvBool("AI_FAKE_ENABLED", false),
+			FakeScriptPath: getEnv("AI_FAKE_SCRIPT", ""),
+
+			BreakerMinRequests:     getEnvInt("AI_BREAKER_MIN_REQUESTS", 5),
+			BreakerFailurePercent:  getEnvInt("AI_BREAKER_FAILURE_PERCENT", 50),
+			BreakerSlowCallSeconds: getEnvInt("AI_BREAKER_SLOW_CALL_SECONDS", 60),
+			BreakerOpenSeconds:     getEnvInt("AI_BREAKER_OPEN_SECONDS", 30),
+
+			RateLimits:              getEnv("AI_RATE_LIMITS", ""),
+			RateLimitMaxWaitSeconds: getEnvInt("AI_RATE_LIMIT_MAX_WAIT_SECONDS", 20),
+		},
+		SummaryBackfill: SummaryBackfillConfig{
+			Interval:    getEnv("SUMMARY_BACKFILL_INTERVAL", "@every 30m"),
+			BatchSize:   getEnvInt("SUMMARY_BACKFILL_BATCH_SIZE", 20),
+			DailyTokens: getEnvInt("SUMMARY_BACKFILL_DAILY_TOKENS", 200000),
+		},
+		Retention: RetentionConfig{
+			Interval:       getEnv("SESSION_RETENTION_INTERVAL", "@daily"),
+			PurgeAfterDays: getEnvInt("SESSION_RETENTION_PURGE_AFTER_DAYS", 7),
+			DryRun:         getEnvBool("SESSION_RETENTION_DRY_RUN", false),
+		},
+		Stripe: StripeConfig{
+			SecretKey:       getEnv("STRIPE_SECRET_KEY", ""),
+			WebhookSecret:   getEnv("STRIPE_WEBHOOK_SECRET", ""),
+			ProPriceID:      getEnv("STRIPE_PRICE_PRO", ""),
+			SuccessURL:      getEnv("STRIPE_SUCCESS_URL", "http://localhost:3000/account?checkout=success"),
+			CancelURL:       getEnv("STRIPE_CANCEL_URL", "http://localhost:3000/account?checkout=canceled"),
+			PortalReturnURL: getEnv("STRIPE_PORTAL_RETURN_URL", "http://localhost:3000/account"),
+		},
+		Mail: MailConfig{
+			AppURL:       getEnv("APP_URL", "http://localhost:3000"),
+			From:         getEnv("MAIL_FROM", "MindHit <no-reply@mindhit.app>"),
+			SMTPHost:     getEnv("SMTP_HOST", ""),
+			SMTPPort:     getEnvInt("SMTP_PORT", 587),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			SinkDir:      getEnv("MAIL_SINK_DIR", ""),
 		},
 	}
 }
@@ -79,3 +189,23 @@ func getEnvInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList returns a comma-separated list, lowercased and without blanks.
+func getEnvList(key string) []string {
+	var result []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
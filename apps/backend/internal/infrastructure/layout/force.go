@@ -0,0 +1,75 @@
+package layout
+
+import "math"
+
+const forceIterations = 200
+
+// force starts from the galaxy layout and runs a spring-electric
+// simulation: edges pull the surfaces of the nodes they connect to an ideal
+// distance while every pair of nodes pushes apart, with moves capped by a
+// temperature that cools down to nothing. Fixed nodes don't move. The
+// simulation is deterministic, so a graph always gets the same layout.
+func force(h hierarchy, nodes []Node, radii []float64, dims int, gap float64) []vec {
+	pos := galaxy(h, radii, dims, gap)
+	for i, n := range nodes {
+		if n.Fixed != nil {
+			pos[i] = vec{n.Fixed.X, n.Fixed.Y, n.Fixed.Z}
+			if dims == 2 {
+				pos[i][2] = 0
+			}
+		}
+	}
+
+	// Hierarchy edges keep clusters together when the graph has few edges
+	links := h.links
+	for v, p := range h.parent {
+		if p >= 0 {
+			links = append(links, [2]int{p, v})
+		}
+	}
+
+	ideal := 2 * gap
+	extent := 0.0
+	for i, p := range pos {
+		ideal += 2 * radii[i] / float64(len(pos))
+		extent = math.Max(extent, p.norm()+radii[i])
+	}
+	start := math.Max(extent/10, ideal)
+
+	disp := make([]vec, len(pos))
+	for it := 0; it < forceIterations; it++ {
+		for i := range disp {
+			disp[i] = vec{}
+		}
+		for i := range pos {
+			for j := i + 1; j < len(pos); j++ {
+				delta := pos[i].sub(pos[j])
+				d := math.Max(delta.norm(), overlapTolerance)
+				surface := math.Max(d-radii[i]-radii[j], ideal/100)
+				push := delta.scale(ideal * ideal / surface / d)
+				disp[i] = disp[i].add(push)
+				disp[j] = disp[j].sub(push)
+			}
+		}
+		for _, l := range links {
+			a, b := l[0], l[1]
+			delta := pos[a].sub(pos[b])
+			d := math.Max(delta.norm(), overlapTolerance)
+			surface := math.Max(d-radii[a]-radii[b], 0)
+			pull := delta.scale(surface * surface / ideal / d)
+			disp[a] = disp[a].sub(pull)
+			disp[b] = disp[b].add(pull)
+		}
+
+		temperature := start * (1 - float64(it)/forceIterations)
+		for i := range pos {
+			if nodes[i].Fixed != nil {
+				continue
+			}
+			if l := disp[i].norm(); l > 0 {
+				pos[i] = pos[i].add(disp[i].scale(math.Min(l, temperature) / l))
+			}
+		}
+	}
+	return pos
+}
@@ -0,0 +1,62 @@
+package layout
+
+import "math"
+
+// galaxy makes each child of the root the center of a cluster of its
+// descendants, spread on a sunflower spiral in 2D or a ball in 3D, and
+// places the clusters around the root on a circle or a sphere.
+func galaxy(h hierarchy, radii []float64, dims int, gap float64) []vec {
+	pos := make([]vec, len(radii))
+	clusters := h.children[h.root]
+
+	members := make([][]int, len(clusters))
+	offsets := make([][]vec, len(clusters))
+	extents := make([]float64, len(clusters)+1)
+	extents[0] = radii[h.root]
+	for ci, c := range clusters {
+		members[ci] = append([]int{c}, h.descendants(c)...)
+		units := spiral(len(members[ci]), dims)
+		memberRadii := make([]float64, len(members[ci]))
+		for k, m := range members[ci] {
+			memberRadii[k] = radii[m]
+		}
+
+		scale := fit(units, memberRadii, gap)
+		offsets[ci] = make([]vec, len(units))
+		for k, u := range units {
+			offsets[ci][k] = u.scale(scale)
+			extents[ci+1] = math.Max(extents[ci+1], offsets[ci][k].norm()+memberRadii[k])
+		}
+	}
+
+	// Clusters are spheres of their extent around the root's
+	units := append([]vec{{}}, directions(len(clusters), dims)...)
+	scale := fit(units, extents, gap)
+	for ci := range clusters {
+		center := units[ci+1].scale(scale)
+		for k, m := range members[ci] {
+			pos[m] = center.add(offsets[ci][k])
+		}
+	}
+	return pos
+}
+
+// spiral returns n distinct points about a unit apart, the first at the
+// origin and the others around it on a sunflower spiral in 2D or in a ball
+// in 3D.
+func spiral(n, dims int) []vec {
+	result := make([]vec, n)
+	if n < 2 {
+		return result
+	}
+	shell := directions(n-1, dims)
+	for k := 1; k < n; k++ {
+		if dims == 2 {
+			r, angle := math.Sqrt(float64(k)), float64(k)*goldenAngle
+			result[k] = vec{r * math.Cos(angle), r * math.Sin(angle), 0}
+			continue
+		}
+		result[k] = shell[k-1].scale(math.Cbrt(float64(k)))
+	}
+	return result
+}
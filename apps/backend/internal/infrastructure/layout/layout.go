@@ -0,0 +1,319 @@
+// Package layout positions mindmap nodes in 2D or 3D so that no two nodes
+// overlap, taking their sizes into account.
+package layout
+
+import (
+	"fmt"
+	"math"
+)
+
+// Type is a layout algorithm.
+type Type string
+
+const (
+	// Galaxy groups each topic with its pages in a cluster, and places the
+	// clusters around the core.
+	Galaxy Type = "galaxy"
+	// Tree lays the hierarchy out top-down, one row per level.
+	Tree Type = "tree"
+	// Radial places each level of the hierarchy on a ring around the core.
+	Radial Type = "radial"
+	// Force lets edges pull nodes together while all nodes push each other
+	// apart.
+	Force Type = "force"
+)
+
+// Types lists the supported layouts.
+var Types = []Type{Galaxy, Tree, Radial, Force}
+
+// ParseType returns the layout named s.
+func ParseType(s string) (Type, error) {
+	for _, t := range Types {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unknown layout %q", s)
+}
+
+// DefaultGap is the space left between nodes when Options.Gap is unset.
+const DefaultGap = 10.0
+
+const (
+	maxSeparatePasses = 500
+	overlapTolerance  = 1e-6
+)
+
+// Position is a point in space. 2D layouts leave Z at 0.
+type Position struct {
+	X, Y, Z float64
+}
+
+// Node is a node to place.
+type Node struct {
+	ID   string
+	Kind string  // core, topic, subtopic or page, orders the hierarchy
+	Size float64 // diameter
+	// Fixed keeps the node where it is; other nodes make room around it.
+	Fixed *Position
+}
+
+// Edge connects two nodes.
+type Edge struct {
+	Source string
+	Target string
+}
+
+// Options tune a layout.
+type Options struct {
+	Dimensions int     // 2 or 3, 2 when unset
+	Gap        float64 // minimum space between two nodes, DefaultGap when unset
+}
+
+// Compute positions nodes with the layout t, keyed by node ID. Nodes are
+// spheres as wide as their size and end up at least the gap apart, unless
+// two fixed nodes already overlap.
+//
+// Tree, radial and galaxy follow the hierarchy rooted at the core: a node
+// hangs from the first node of a higher kind it shares an edge with, and
+// from the core when there is none.
+func Compute(t Type, nodes []Node, edges []Edge, opts Options) (map[string]Position, error) {
+	dims := opts.Dimensions
+	if dims == 0 {
+		dims = 2
+	}
+	if dims != 2 && dims != 3 {
+		return nil, fmt.Errorf("layouts have 2 or 3 dimensions, not %d", dims)
+	}
+	gap := opts.Gap
+	if gap <= 0 {
+		gap = DefaultGap
+	}
+	if _, err := ParseType(string(t)); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return map[string]Position{}, nil
+	}
+
+	radii := make([]float64, len(nodes))
+	fixed := make([]bool, len(nodes))
+	for i, n := range nodes {
+		radii[i] = math.Max(n.Size, 0) / 2
+		fixed[i] = n.Fixed != nil
+	}
+	h := newHierarchy(nodes, edges)
+
+	var pos []vec
+	switch t {
+	case Galaxy:
+		pos = galaxy(h, radii, dims, gap)
+	case Tree:
+		pos = tree(h, radii, gap)
+	case Radial:
+		pos = radial(h, radii, gap)
+	case Force:
+		pos = force(h, nodes, radii, dims, gap)
+	}
+	for i, n := range nodes {
+		if n.Fixed != nil {
+			pos[i] = vec{n.Fixed.X, n.Fixed.Y, n.Fixed.Z}
+			if dims == 2 {
+				pos[i][2] = 0
+			}
+		}
+	}
+	separate(pos, radii, fixed, gap)
+
+	result := make(map[string]Position, len(nodes))
+	for i, n := range nodes {
+		result[n.ID] = Position{X: pos[i][0], Y: pos[i][1], Z: pos[i][2]}
+	}
+	return result, nil
+}
+
+// kindRanks orders node kinds from the root of the hierarchy down. Unknown
+// kinds rank with pages.
+var kindRanks = map[string]int{"core": 0, "topic": 1, "subtopic": 2, "page": 3}
+
+func kindRank(kind string) int {
+	if r, ok := kindRanks[kind]; ok {
+		return r
+	}
+	return kindRanks["page"]
+}
+
+// hierarchy is the tree the layouts follow, over node indexes.
+type hierarchy struct {
+	root     int
+	parent   []int
+	children [][]int
+	links    [][2]int // all edges between known nodes
+}
+
+func newHierarchy(nodes []Node, edges []Edge) hierarchy {
+	index := make(map[string]int, len(nodes))
+	root := 0
+	for i, n := range nodes {
+		if _, ok := index[n.ID]; !ok {
+			index[n.ID] = i
+		}
+		if kindRank(n.Kind) < kindRank(nodes[root].Kind) {
+			root = i
+		}
+	}
+
+	h := hierarchy{
+		root:     root,
+		parent:   make([]int, len(nodes)),
+		children: make([][]int, len(nodes)),
+	}
+	for i := range h.parent {
+		h.parent[i] = -1
+	}
+	for _, e := range edges {
+		s, ok := index[e.Source]
+		if !ok {
+			continue
+		}
+		t, ok := index[e.Target]
+		if !ok || s == t {
+			continue
+		}
+		h.links = append(h.links, [2]int{s, t})
+
+		// Parents rank strictly higher, so the hierarchy has no cycles
+		if kindRank(nodes[s].Kind) > kindRank(nodes[t].Kind) {
+			s, t = t, s
+		}
+		if kindRank(nodes[s].Kind) == kindRank(nodes[t].Kind) || t == root || h.parent[t] >= 0 {
+			continue
+		}
+		h.parent[t] = s
+	}
+	for i := range nodes {
+		if i == root {
+			continue
+		}
+		if h.parent[i] < 0 {
+			h.parent[i] = root
+		}
+		h.children[h.parent[i]] = append(h.children[h.parent[i]], i)
+	}
+	return h
+}
+
+// descendants returns the nodes below v, depth first.
+func (h hierarchy) descendants(v int) []int {
+	var result []int
+	for _, c := range h.children[v] {
+		result = append(result, c)
+		result = append(result, h.descendants(c)...)
+	}
+	return result
+}
+
+// levels groups the nodes by their depth below the root.
+func (h hierarchy) levels() [][]int {
+	levels := [][]int{{h.root}}
+	for {
+		var next []int
+		for _, v := range levels[len(levels)-1] {
+			next = append(next, h.children[v]...)
+		}
+		if len(next) == 0 {
+			return levels
+		}
+		levels = append(levels, next)
+	}
+}
+
+// fit returns the smallest factor to scale offsets by so that spheres of
+// radii around them are at least gap apart. Offsets must be distinct.
+func fit(offsets []vec, radii []float64, gap float64) float64 {
+	scale := 0.0
+	for i := range offsets {
+		for j := i + 1; j < len(offsets); j++ {
+			d := offsets[i].sub(offsets[j]).norm()
+			if d == 0 {
+				continue
+			}
+			scale = math.Max(scale, (radii[i]+radii[j]+gap)/d)
+		}
+	}
+	return scale
+}
+
+// separate pushes overlapping nodes apart until none overlap, moving only
+// the nodes that aren't fixed.
+func separate(pos []vec, radii []float64, fixed []bool, gap float64) {
+	for pass := 0; pass < maxSeparatePasses; pass++ {
+		moved := false
+		for i := range pos {
+			for j := i + 1; j < len(pos); j++ {
+				if fixed[i] && fixed[j] {
+					continue
+				}
+				need := radii[i] + radii[j] + gap
+				delta := pos[j].sub(pos[i])
+				d := delta.norm()
+				if d >= need-overlapTolerance {
+					continue
+				}
+
+				dir := delta.scale(1 / d)
+				if d < overlapTolerance {
+					// Same spot: any direction will do, as long as it's stable
+					angle := float64(i+j+1) * goldenAngle
+					dir = vec{math.Cos(angle), math.Sin(angle), 0}
+				}
+				push := need - d
+				switch {
+				case fixed[i]:
+					pos[j] = pos[j].add(dir.scale(push))
+				case fixed[j]:
+					pos[i] = pos[i].sub(dir.scale(push))
+				default:
+					pos[i] = pos[i].sub(dir.scale(push / 2))
+					pos[j] = pos[j].add(dir.scale(push / 2))
+				}
+				moved = true
+			}
+		}
+		if !moved {
+			return
+		}
+	}
+}
+
+// goldenAngle spreads points evenly around a spiral.
+var goldenAngle = math.Pi * (3 - math.Sqrt(5))
+
+type vec [3]float64
+
+func (a vec) add(b vec) vec { return vec{a[0] + b[0], a[1] + b[1], a[2] + b[2]} }
+
+func (a vec) sub(b vec) vec { return vec{a[0] - b[0], a[1] - b[1], a[2] - b[2]} }
+
+func (a vec) scale(s float64) vec { return vec{a[0] * s, a[1] * s, a[2] * s} }
+
+func (a vec) norm() float64 { return math.Sqrt(a[0]*a[0] + a[1]*a[1] + a[2]*a[2]) }
+
+// directions returns n unit vectors spread evenly on a circle in 2D or a
+// sphere in 3D.
+func directions(n, dims int) []vec {
+	result := make([]vec, n)
+	for i := range result {
+		if dims == 2 {
+			angle := 2 * math.Pi * float64(i) / float64(n)
+			result[i] = vec{math.Cos(angle), math.Sin(angle), 0}
+			continue
+		}
+		// Fibonacci sphere
+		z := 1 - 2*(float64(i)+0.5)/float64(n)
+		r := math.Sqrt(1 - z*z)
+		angle := float64(i) * goldenAngle
+		result[i] = vec{r * math.Cos(angle), r * math.Sin(angle), z}
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+package layout
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testGraph is a core with topics of growing page counts, one of them with
+// subtopics, and a cross-topic edge.
+func testGraph() ([]Node, []Edge) {
+	nodes := []Node{{ID: "core", Kind: "core", Size: 100}}
+	var edges []Edge
+	for t := 0; t < 6; t++ {
+		topic := fmt.Sprintf("topic-%d", t)
+		nodes = append(nodes, Node{ID: topic, Kind: "topic", Size: 40 + float64(t)*8})
+		edges = append(edges, Edge{Source: "core", Target: topic})
+
+		parent := topic
+		for p := 0; p < t*4; p++ {
+			if t == 5 && p%6 == 0 {
+				parent = fmt.Sprintf("%s-sub-%d", topic, p)
+				nodes = append(nodes, Node{ID: parent, Kind: "subtopic", Size: 30})
+				edges = append(edges, Edge{Source: topic, Target: parent})
+			}
+			page := fmt.Sprintf("%s-page-%d", topic, p)
+			nodes = append(nodes, Node{ID: page, Kind: "page", Size: 8 + float64(p%5)*6})
+			edges = append(edges, Edge{Source: parent, Target: page})
+		}
+	}
+	edges = append(edges, Edge{Source: "topic-1", Target: "topic-4"})
+	return nodes, edges
+}
+
+func assertNoOverlap(t *testing.T, nodes []Node, positions map[string]Position, gap float64) {
+	t.Helper()
+	require.Len(t, positions, len(nodes))
+	for i, a := range nodes {
+		for _, b := range nodes[i+1:] {
+			pa, pb := positions[a.ID], positions[b.ID]
+			d := math.Sqrt((pa.X-pb.X)*(pa.X-pb.X) + (pa.Y-pb.Y)*(pa.Y-pb.Y) + (pa.Z-pb.Z)*(pa.Z-pb.Z))
+			if need := (a.Size+b.Size)/2 + gap; d < need-1e-4 {
+				t.Errorf("%s and %s are %.2f apart, need %.2f", a.ID, b.ID, d, need)
+			}
+		}
+	}
+}
+
+func TestCompute_NoOverlap(t *testing.T) {
+	nodes, edges := testGraph()
+	for _, typ := range Types {
+		for _, dims := range []int{2, 3} {
+			t.Run(fmt.Sprintf("%s %dD", typ, dims), func(t *testing.T) {
+				positions, err := Compute(typ, nodes, edges, Options{Dimensions: dims})
+				require.NoError(t, err)
+				assertNoOverlap(t, nodes, positions, DefaultGap)
+
+				flat := true
+				for _, p := range positions {
+					flat = flat && p.Z == 0
+				}
+				planar := dims == 2 || typ == Tree || typ == Radial
+				assert.Equal(t, planar, flat)
+			})
+		}
+	}
+}
+
+func TestCompute_Hierarchy(t *testing.T) {
+	nodes, edges := testGraph()
+
+	positions, err := Compute(Tree, nodes, edges, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, Position{}, positions["core"])
+	assert.Less(t, positions["topic-5-sub-0"].Y, positions["topic-5"].Y)
+	assert.Less(t, positions["topic-5-page-0"].Y, positions["topic-5-sub-0"].Y)
+	assert.Equal(t, positions["topic-1-page-0"].Y, positions["topic-5-sub-0"].Y, "pages of a topic are a level above pages of a subtopic")
+
+	positions, err = Compute(Radial, nodes, edges, Options{})
+	require.NoError(t, err)
+	dist := func(id string) float64 { return math.Hypot(positions[id].X, positions[id].Y) }
+	assert.Less(t, dist("topic-0"), dist("topic-1-page-0"))
+	assert.InDelta(t, dist("topic-0"), dist("topic-5"), 1e-9, "a level shares a ring")
+}
+
+func TestCompute_Fixed(t *testing.T) {
+	nodes, edges := testGraph()
+	nodes[3].Fixed = &Position{X: 12, Y: -30, Z: 40}
+
+	for _, typ := range Types {
+		positions, err := Compute(typ, nodes, edges, Options{Dimensions: 3})
+		require.NoError(t, err)
+		assert.Equal(t, Position{X: 12, Y: -30, Z: 40}, positions[nodes[3].ID], typ)
+		assertNoOverlap(t, nodes, positions, DefaultGap)
+	}
+
+	positions, err := Compute(Galaxy, nodes, edges, Options{Dimensions: 2})
+	require.NoError(t, err)
+	assert.Equal(t, Position{X: 12, Y: -30}, positions[nodes[3].ID], "flattened in 2D")
+}
+
+func TestCompute_Deterministic(t *testing.T) {
+	nodes, edges := testGraph()
+	first, err := Compute(Force, nodes, edges, Options{Dimensions: 3})
+	require.NoError(t, err)
+	second, err := Compute(Force, nodes, edges, Options{Dimensions: 3})
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestCompute_Edges(t *testing.T) {
+	positions, err := Compute(Galaxy, nil, nil, Options{})
+	require.NoError(t, err)
+	assert.Empty(t, positions)
+
+	// Without a core, the highest node is the root; unknown edges are ignored
+	nodes := []Node{{ID: "p", Kind: "page", Size: 10}, {ID: "t", Kind: "topic", Size: 40}}
+	positions, err = Compute(Radial, nodes, []Edge{{Source: "t", Target: "gone"}}, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, Position{}, positions["t"])
+	assertNoOverlap(t, nodes, positions, DefaultGap)
+
+	_, err = Compute("spiral", nodes, nil, Options{})
+	assert.Error(t, err)
+	_, err = Compute(Tree, nodes, nil, Options{Dimensions: 4})
+	assert.Error(t, err)
+}
+
+func TestParseType(t *testing.T) {
+	typ, err := ParseType("force")
+	require.NoError(t, err)
+	assert.Equal(t, Force, typ)
+
+	_, err = ParseType("")
+	assert.Error(t, err)
+}
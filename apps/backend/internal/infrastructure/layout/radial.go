@@ -0,0 +1,67 @@
+package layout
+
+import "math"
+
+// radial places the root at the center and each level of the hierarchy on a
+// ring around it in the XY plane. Every subtree gets a wedge of the circle
+// proportional to how much room it needs, and each ring is just wide enough
+// for its nodes not to overlap.
+func radial(h hierarchy, radii []float64, gap float64) []vec {
+	pos := make([]vec, len(radii))
+
+	weights := make([]float64, len(radii))
+	var weigh func(v int) float64
+	weigh = func(v int) float64 {
+		children := 0.0
+		for _, c := range h.children[v] {
+			children += weigh(c)
+		}
+		weights[v] = math.Max(2*radii[v]+gap, children)
+		return weights[v]
+	}
+	weigh(h.root)
+
+	angles := make([]float64, len(radii))
+	var spread func(v int, from, span float64)
+	spread = func(v int, from, span float64) {
+		angles[v] = from + span/2
+		total := 0.0
+		for _, c := range h.children[v] {
+			total += weights[c]
+		}
+		for _, c := range h.children[v] {
+			share := span * weights[c] / total
+			spread(c, from, share)
+			from += share
+		}
+	}
+	spread(h.root, 0, 2*math.Pi)
+
+	radius, prevRadius := 0.0, radii[h.root]
+	for _, level := range h.levels()[1:] {
+		levelRadius := 0.0
+		for _, v := range level {
+			levelRadius = math.Max(levelRadius, radii[v])
+		}
+		// Clear of the previous ring
+		radius += prevRadius + gap + levelRadius
+		// Wide enough for every pair on the ring, whose distance is the
+		// chord of the angle between them
+		for i, a := range level {
+			for _, b := range level[i+1:] {
+				between := math.Abs(angles[a] - angles[b])
+				between = math.Min(between, 2*math.Pi-between)
+				if between == 0 {
+					continue
+				}
+				chord := 2 * math.Sin(between/2)
+				radius = math.Max(radius, (radii[a]+radii[b]+gap)/chord)
+			}
+		}
+		for _, v := range level {
+			pos[v] = vec{radius * math.Cos(angles[v]), radius * math.Sin(angles[v]), 0}
+		}
+		prevRadius = levelRadius
+	}
+	return pos
+}
@@ -0,0 +1,60 @@
+package layout
+
+import "math"
+
+// tree lays the hierarchy out top-down in the XY plane: each level on a row
+// below the previous one, and each subtree in a band of its own, centered
+// over its children.
+func tree(h hierarchy, radii []float64, gap float64) []vec {
+	pos := make([]vec, len(radii))
+
+	widths := make([]float64, len(radii))
+	var width func(v int) float64
+	width = func(v int) float64 {
+		children := -gap
+		for _, c := range h.children[v] {
+			children += width(c) + gap
+		}
+		widths[v] = math.Max(2*radii[v], children)
+		return widths[v]
+	}
+	width(h.root)
+
+	var place func(v int, left float64)
+	place = func(v int, left float64) {
+		pos[v][0] = left + widths[v]/2
+		children := -gap
+		for _, c := range h.children[v] {
+			children += widths[c] + gap
+		}
+		left += (widths[v] - children) / 2
+		for _, c := range h.children[v] {
+			place(c, left)
+			left += widths[c] + gap
+		}
+	}
+	place(h.root, 0)
+
+	// Rows leave room for the edges between them
+	rowGap := 4 * gap
+	y, prevRadius := 0.0, 0.0
+	for depth, level := range h.levels() {
+		rowRadius := 0.0
+		for _, v := range level {
+			rowRadius = math.Max(rowRadius, radii[v])
+		}
+		if depth > 0 {
+			y -= prevRadius + rowGap + rowRadius
+		}
+		for _, v := range level {
+			pos[v][1] = y
+		}
+		prevRadius = rowRadius
+	}
+
+	rootX := pos[h.root][0]
+	for i := range pos {
+		pos[i][0] -= rootX
+	}
+	return pos
+}
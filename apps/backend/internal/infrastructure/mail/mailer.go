@@ -0,0 +1,24 @@
+// Package mail provides outgoing email delivery and the transactional email
+// templates.
+package mail
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoRecipient is returned when a message has no recipient.
+var ErrNoRecipient = errors.New("message has no recipient")
+
+// Message is a rendered email ready to be sent.
+type Message struct {
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Mailer delivers email messages.
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
@@ -0,0 +1,67 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// SinkMailer is a development mailer that never delivers anything. Each
+// message is written as an .eml file to a directory, where it can be opened
+// with any mail client, or logged when no directory is set.
+type SinkMailer struct {
+	dir  string
+	from *mail.Address
+}
+
+// NewSinkMailer creates a new SinkMailer writing to dir. An empty dir only
+// logs messages.
+func NewSinkMailer(dir string) (*SinkMailer, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create mail sink directory: %w", err)
+		}
+	}
+	return &SinkMailer{
+		dir:  dir,
+		from: &mail.Address{Name: "MindHit", Address: "no-reply@localhost"},
+	}, nil
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// Send writes or logs the message.
+func (m *SinkMailer) Send(ctx context.Context, msg *Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	if m.dir == "" {
+		slog.InfoContext(ctx, "email (not sent)",
+			"to", msg.To,
+			"subject", msg.Subject,
+			"text", msg.Text,
+		)
+		return nil
+	}
+
+	now := time.Now()
+	body, err := buildMessage(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s_%s.eml", now.Format("20060102T150405.000000000"), unsafeFileChars.ReplaceAllString(msg.To, "_"))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, body, 0o644); err != nil {
+		return fmt.Errorf("write mail sink file: %w", err)
+	}
+
+	slog.InfoContext(ctx, "email written to sink", "to", msg.To, "subject", msg.Subject, "path", path)
+	return nil
+}
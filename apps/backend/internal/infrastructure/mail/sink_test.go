@@ -0,0 +1,39 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinkMailer_WritesEMLFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	m, err := NewSinkMailer(dir)
+	require.NoError(t, err)
+
+	msg := testMessage()
+	require.NoError(t, m.Send(context.Background(), msg))
+	require.NoError(t, m.Send(context.Background(), msg))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	_, subject, parts := readMessage(t, raw)
+	assert.Equal(t, msg.Subject, subject)
+	assert.Equal(t, msg.HTML, parts["text/html"])
+}
+
+func TestSinkMailer_LogOnly(t *testing.T) {
+	m, err := NewSinkMailer("")
+	require.NoError(t, err)
+
+	assert.NoError(t, m.Send(context.Background(), testMessage()))
+	assert.ErrorIs(t, m.Send(context.Background(), &Message{}), ErrNoRecipient)
+}
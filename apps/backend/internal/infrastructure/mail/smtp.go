@@ -0,0 +1,133 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTPConfig holds the SMTP server settings.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // empty disables authentication
+	Password string
+	From     string // e.g. "MindHit <no-reply@mindhit.app>"
+}
+
+// SMTPMailer sends email through an SMTP server. STARTTLS is used when the
+// server offers it.
+type SMTPMailer struct {
+	cfg  SMTPConfig
+	from *mail.Address
+}
+
+// NewSMTPMailer creates a new SMTPMailer.
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address %q: %w", cfg.From, err)
+	}
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	return &SMTPMailer{cfg: cfg, from: from}, nil
+}
+
+// Send delivers the message. smtp.SendMail takes no context, so cancellation
+// is not honored once the connection is open.
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	body, err := buildMessage(m.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.cfg.Username != "" {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port))
+	if err := smtp.SendMail(addr, auth, m.from.Address, []string{msg.To}, body); err != nil {
+		return fmt.Errorf("smtp send: %w", err)
+	}
+	return nil
+}
+
+// buildMessage renders msg as a MIME message with text and HTML alternatives.
+func buildMessage(from *mail.Address, msg *Message, date time.Time) ([]byte, error) {
+	boundary, err := randomBoundary()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+	header("From", from.String())
+	header("To", msg.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", date.Format(time.RFC1123Z))
+	header("Message-ID", fmt.Sprintf("<%s@%s>", boundary, domainOf(from.Address)))
+	header("MIME-Version", "1.0")
+	header("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", boundary))
+	buf.WriteString("\r\n")
+
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain", msg.Text},
+		{"text/html", msg.HTML},
+	} {
+		if part.body == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		header("Content-Type", part.contentType+"; charset=utf-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+
+		qp := quotedprintable.NewWriter(&buf)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+
+	return buf.Bytes(), nil
+}
+
+func randomBoundary() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func domainOf(address string) string {
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return address[i+1:]
+	}
+	return "localhost"
+}
@@ -0,0 +1,172 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMessage() *Message {
+	return &Message{
+		To:      "user@example.com",
+		Subject: "[MindHit] 비밀번호 재설정 안내",
+		Text:    "링크: https://mindhit.test/reset-password?token=abc\n",
+		HTML:    "<p>링크</p>",
+	}
+}
+
+// readMessage parses a message built by buildMessage and returns its decoded
+// subject and parts by content type, with line endings normalized.
+func readMessage(t *testing.T, raw []byte) (*mail.Message, string, map[string]string) {
+	t.Helper()
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	parts := make(map[string]string)
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part) // quoted-printable is decoded by NextPart
+		require.NoError(t, err)
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		parts[contentType] = strings.ReplaceAll(string(body), "\r\n", "\n")
+	}
+	return parsed, subject, parts
+}
+
+func TestBuildMessage(t *testing.T) {
+	from := &mail.Address{Name: "MindHit", Address: "no-reply@mindhit.test"}
+	msg := testMessage()
+
+	raw, err := buildMessage(from, msg, time.Now())
+	require.NoError(t, err)
+
+	parsed, subject, parts := readMessage(t, raw)
+	assert.Equal(t, msg.Subject, subject)
+	assert.Equal(t, msg.To, parsed.Header.Get("To"))
+	assert.Contains(t, parsed.Header.Get("From"), "no-reply@mindhit.test")
+	assert.Contains(t, parsed.Header.Get("Message-ID"), "@mindhit.test>")
+	assert.Equal(t, msg.Text, parts["text/plain"])
+	assert.Equal(t, msg.HTML, parts["text/html"])
+}
+
+func TestNewSMTPMailer_InvalidFrom(t *testing.T) {
+	_, err := NewSMTPMailer(SMTPConfig{Host: "localhost", From: "not an address"})
+	assert.Error(t, err)
+}
+
+// fakeSMTPServer accepts one SMTP session without extensions and returns the
+// message data it received.
+func fakeSMTPServer(t *testing.T) (host string, port int, received <-chan string) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	ch := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+		reply("220 fake ESMTP")
+
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					ch <- data.String()
+					reply("250 OK")
+					continue
+				}
+				data.WriteString(line)
+				continue
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 fake")
+			case cmd == "DATA":
+				inData = true
+				reply("354 go ahead")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, ch
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	host, port, received := fakeSMTPServer(t)
+
+	m, err := NewSMTPMailer(SMTPConfig{
+		Host: host,
+		Port: port,
+		From: "MindHit <no-reply@mindhit.test>",
+	})
+	require.NoError(t, err)
+
+	msg := testMessage()
+	require.NoError(t, m.Send(context.Background(), msg))
+
+	select {
+	case raw := <-received:
+		_, subject, parts := readMessage(t, []byte(raw))
+		assert.Equal(t, msg.Subject, subject)
+		assert.Equal(t, msg.Text, parts["text/plain"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("smtp server received no message")
+	}
+}
+
+func TestSMTPMailer_NoRecipient(t *testing.T) {
+	m, err := NewSMTPMailer(SMTPConfig{Host: "localhost", Port: 1, From: "no-reply@mindhit.test"})
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), &Message{Subject: "x"})
+	assert.ErrorIs(t, err, ErrNoRecipient)
+}
+
+func TestSMTPMailer_DefaultPort(t *testing.T) {
+	m, err := NewSMTPMailer(SMTPConfig{Host: "localhost", From: "no-reply@mindhit.test"})
+	require.NoError(t, err)
+	assert.Equal(t, 587, m.cfg.Port)
+}
@@ -0,0 +1,130 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"maps"
+	"path"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Template names
+const (
+	TemplatePasswordReset     = "password_reset"
+	TemplateEmailVerification = "email_verification"
+	TemplateUsageWarning      = "usage_warning"
+)
+
+// DefaultLocale is used for locales without translated templates. It matches
+// the default language of user settings.
+const DefaultLocale = "ko"
+
+// ErrUnknownTemplate is returned when rendering a template that does not exist.
+var ErrUnknownTemplate = errors.New("unknown email template")
+
+//go:embed templates
+var templateFS embed.FS
+
+// Each template is a <locale>/<name>.txt file defining "subject" and "body",
+// and a <locale>/<name>.html file defining "content" for layout.html.
+type localizedTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// Renderer renders the embedded email templates.
+type Renderer struct {
+	templates map[string]map[string]*localizedTemplate // name -> locale -> template
+}
+
+// NewRenderer parses the embedded templates.
+func NewRenderer() (*Renderer, error) {
+	files, err := fs.Glob(templateFS, "templates/*/*.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Renderer{templates: make(map[string]map[string]*localizedTemplate)}
+	for _, file := range files {
+		locale := path.Base(path.Dir(file))
+		name := strings.TrimSuffix(path.Base(file), ".txt")
+
+		text, err := texttemplate.New(name).
+			Option("missingkey=error").
+			ParseFS(templateFS, file)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+
+		html, err := htmltemplate.New("layout.html").
+			Option("missingkey=error").
+			ParseFS(templateFS,
+				"templates/layout.html",
+				path.Join("templates", locale, "footer.html"),
+				path.Join("templates", locale, name+".html"),
+			)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s html: %w", file, err)
+		}
+
+		if r.templates[name] == nil {
+			r.templates[name] = make(map[string]*localizedTemplate)
+		}
+		r.templates[name][locale] = &localizedTemplate{text: text, html: html}
+	}
+	return r, nil
+}
+
+// Render renders a template in the given locale, falling back to the
+// language without region ("en" for "en-US") and then to DefaultLocale. The
+// returned message has no recipient.
+func (r *Renderer) Render(name, locale string, data map[string]string) (*Message, error) {
+	t, err := r.lookup(name, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("render %s subject: %w", name, err)
+	}
+	if err := t.text.ExecuteTemplate(&text, "body", data); err != nil {
+		return nil, fmt.Errorf("render %s text: %w", name, err)
+	}
+
+	msg := &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+	}
+
+	htmlData := make(map[string]string, len(data)+1)
+	maps.Copy(htmlData, data)
+	htmlData["Subject"] = msg.Subject
+	if err := t.html.Execute(&html, htmlData); err != nil {
+		return nil, fmt.Errorf("render %s html: %w", name, err)
+	}
+
+	msg.HTML = html.String()
+	return msg, nil
+}
+
+func (r *Renderer) lookup(name, locale string) (*localizedTemplate, error) {
+	byLocale, ok := r.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, name)
+	}
+
+	locale = strings.ToLower(locale)
+	language, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	for _, l := range []string{locale, language, DefaultLocale} {
+		if t, ok := byLocale[l]; ok {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s has no %s translation", ErrUnknownTemplate, name, DefaultLocale)
+}
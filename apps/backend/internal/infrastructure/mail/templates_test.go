@@ -0,0 +1,99 @@
+package mail
+
+import (
+	"html"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTemplateData() map[string]map[string]string {
+	return map[string]map[string]string{
+		TemplatePasswordReset: {
+			"ResetURL": "https://mindhit.test/reset-password?token=abc",
+		},
+		TemplateEmailVerification: {
+			"VerifyURL": "https://mindhit.test/verify-email?token=abc",
+		},
+		TemplateUsageWarning: {
+			"Percent":    "80",
+			"TokensUsed": "40000",
+			"TokenLimit": "50000",
+			"UsageURL":   "https://mindhit.test/account",
+		},
+	}
+}
+
+func TestRenderer_RendersEveryTemplateInEveryLocale(t *testing.T) {
+	r, err := NewRenderer()
+	require.NoError(t, err)
+
+	for name, data := range testTemplateData() {
+		for _, locale := range []string{"ko", "en"} {
+			t.Run(name+"/"+locale, func(t *testing.T) {
+				msg, err := r.Render(name, locale, data)
+				require.NoError(t, err)
+
+				assert.NotEmpty(t, msg.Subject)
+				assert.NotContains(t, msg.Subject, "\n")
+				assert.Contains(t, msg.HTML, "<html>")
+				assert.Contains(t, msg.HTML, html.EscapeString(msg.Subject))
+				for _, v := range data {
+					assert.Contains(t, msg.Text, v)
+				}
+			})
+		}
+	}
+}
+
+func TestRenderer_LocaleFallback(t *testing.T) {
+	r, err := NewRenderer()
+	require.NoError(t, err)
+	data := testTemplateData()[TemplateEmailVerification]
+
+	en, err := r.Render(TemplateEmailVerification, "en", data)
+	require.NoError(t, err)
+	ko, err := r.Render(TemplateEmailVerification, "ko", data)
+	require.NoError(t, err)
+
+	tests := []struct {
+		locale string
+		want   *Message
+	}{
+		{"en-US", en},
+		{"EN_gb", en},
+		{"ja", ko},
+		{"", ko},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			msg, err := r.Render(TemplateEmailVerification, tt.locale, data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Subject, msg.Subject)
+		})
+	}
+}
+
+func TestRenderer_EscapesHTML(t *testing.T) {
+	r, err := NewRenderer()
+	require.NoError(t, err)
+
+	msg, err := r.Render(TemplatePasswordReset, "en", map[string]string{
+		"ResetURL": `javascript:alert("x")`,
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, msg.HTML, "javascript:")
+}
+
+func TestRenderer_Errors(t *testing.T) {
+	r, err := NewRenderer()
+	require.NoError(t, err)
+
+	_, err = r.Render("no_such_template", "en", nil)
+	assert.ErrorIs(t, err, ErrUnknownTemplate)
+
+	_, err = r.Render(TemplatePasswordReset, "en", map[string]string{})
+	assert.Error(t, err, "missing template data should fail")
+}
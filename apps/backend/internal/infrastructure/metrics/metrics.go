@@ -94,6 +94,63 @@ var (
 		},
 		[]string{"provider", "operation", "error_type"},
 	)
+
+	// AIOutputRepairs counts the responses sent back to be repaired after
+	// failing validation.
+	AIOutputRepairs = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mindhit_ai_output_repairs_total",
+			Help: "Total number of AI responses sent back for repair",
+		},
+		[]string{"task", "result"}, // result: success/failed
+	)
+
+	// AICircuitBreakerState reports the circuit breaker state of each
+	// provider and model: 0 closed, 1 half-open, 2 open.
+	AICircuitBreakerState = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "mindhit_ai_circuit_breaker_state",
+			Help: "AI provider circuit breaker state (0 closed, 1 half-open, 2 open)",
+		},
+		[]string{"provider", "model"},
+	)
+
+	// AICircuitBreakerTransitions counts the circuit breaker state changes.
+	AICircuitBreakerTransitions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mindhit_ai_circuit_breaker_transitions_total",
+			Help: "Total number of AI provider circuit breaker state changes",
+		},
+		[]string{"provider", "model", "state"}, // state: the state changed to
+	)
+
+	// AICircuitBreakerSkips counts the requests that skipped a provider
+	// because its circuit breaker was open.
+	AICircuitBreakerSkips = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mindhit_ai_circuit_breaker_skips_total",
+			Help: "Total number of AI requests that skipped a provider with an open circuit",
+		},
+		[]string{"provider", "model"},
+	)
+
+	// AIRateLimited counts the AI requests held back by a rate limit.
+	AIRateLimited = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mindhit_ai_rate_limited_total",
+			Help: "Total number of AI requests held back by a rate limit",
+		},
+		[]string{"provider", "model", "outcome"}, // outcome: waited/requeued/rejected
+	)
+
+	// AIResponseCache counts AI response cache lookups by result.
+	AIResponseCache = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mindhit_ai_response_cache_total",
+			Help: "Total number of AI response cache lookups",
+		},
+		[]string{"task", "result"}, // result: hit/miss/invalid/error
+	)
 )
 
 // Worker/Job metrics
@@ -104,7 +161,7 @@ var (
 			Name: "mindhit_worker_jobs_processed_total",
 			Help: "Total number of worker jobs processed",
 		},
-		[]string{"job_type", "status"}, // job_type: session_processing/cleanup/tag_extraction/mindmap_generation, status: success/failed
+		[]string{"job_type", "status"}, // job_type: session_processing/cleanup/tag_extraction/url_summarize/summary_backfill/mindmap_generation, status: success/failed
 	)
 
 	// WorkerJobDuration observes the worker job processing duration in seconds.
@@ -267,3 +324,15 @@ var (
 		[]string{"plan", "limit_type"}, // limit_type: daily/monthly
 	)
 )
+
+// Email metrics
+var (
+	// EmailsSent counts the emails handed to the mailer by template and result.
+	EmailsSent = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mindhit_emails_sent_total",
+			Help: "Total number of emails sent by template",
+		},
+		[]string{"template", "status"}, // status: "success", "failed"
+	)
+)
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mindhit/api/internal/service"
+)
+
+// Admin creates a middleware allowing only the users with one of the given
+// emails. It must run after Auth. Without emails every request is rejected.
+func Admin(authService *service.AuthService, emails []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := GetUserID(c)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": gin.H{
+					"code":    "UNAUTHORIZED",
+					"message": "authentication required",
+				},
+			})
+			return
+		}
+
+		user, err := authService.GetUserByID(c.Request.Context(), userID)
+		if err != nil || !slices.Contains(emails, strings.ToLower(user.Email)) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": gin.H{
+					"code":    "FORBIDDEN",
+					"message": "admin access required",
+				},
+			})
+			return
+		}
+
+		c.Set(UserEmailKey, user.Email)
+		c.Next()
+	}
+}
+
+// GetUserEmail returns the email of an admin request, for audit fields.
+func GetUserEmail(c *gin.Context) string {
+	return c.GetString(UserEmailKey)
+}
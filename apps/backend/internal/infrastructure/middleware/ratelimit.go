@@ -113,6 +113,18 @@ func AuthRateLimit() gin.HandlerFunc {
 	return RateLimit(10, time.Minute)
 }
 
+// StreamRateLimit returns the rate limiter for the mindmap progress stream
+// and its tickets, which are served outside the generated API handlers.
+// 30 requests per minute per IP. Disabled in test environment.
+func StreamRateLimit() gin.HandlerFunc {
+	if isTestEnvironment() {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+	return RateLimit(30, time.Minute)
+}
+
 // authRateLimitPaths defines paths that should have auth rate limiting applied.
 var authRateLimitPaths = map[string]bool{
 	"/v1/auth/signup":          true,
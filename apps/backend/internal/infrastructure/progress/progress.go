@@ -0,0 +1,156 @@
+// Package progress relays mindmap generation progress from the worker to the
+// API instances streaming it to users, through Redis pub/sub.
+package progress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+)
+
+const mindmapChannelPrefix = "mindmap:progress:"
+
+// EventType identifies a progress event.
+type EventType string
+
+const (
+	// EventStage reports that generation moved to another stage.
+	EventStage EventType = "stage"
+	// EventThinking carries a delta of the model's reasoning.
+	EventThinking EventType = "thinking"
+	// EventContent carries a delta of the model's response.
+	EventContent EventType = "content"
+	// EventTopic carries a topic as soon as the model finished writing it.
+	EventTopic EventType = "topic"
+	// EventDone reports that the mindmap was saved.
+	EventDone EventType = "done"
+	// EventError reports that generation failed for good.
+	EventError EventType = "error"
+)
+
+// Stages of mindmap generation reported by EventStage.
+const (
+	StageLoading    = "loading"    // collecting the session's pages
+	StageGenerating = "generating" // waiting for the model
+	StageSaving     = "saving"     // building and saving the mindmap
+	StageRetrying   = "retrying"   // the attempt failed, another one is queued
+)
+
+// Topic is a mindmap topic as produced by the model.
+type Topic struct {
+	ID          string   `json:"id"`
+	Label       string   `json:"label"`
+	Keywords    []string `json:"keywords,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// Event is a progress event of one session's mindmap generation.
+type Event struct {
+	Type      EventType `json:"type"`
+	Stage     string    `json:"stage,omitempty"`
+	Delta     string    `json:"delta,omitempty"`
+	Topic     *Topic    `json:"topic,omitempty"`
+	MindmapID string    `json:"mindmap_id,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Final reports whether no more events follow e.
+func (e Event) Final() bool {
+	return e.Type == EventDone || e.Type == EventError
+}
+
+// Broker publishes and subscribes to mindmap progress. Events are not stored,
+// subscribers only receive events published after they subscribed.
+type Broker struct {
+	rdb *redis.Client
+}
+
+// NewBroker creates a new Broker.
+func NewBroker(rdb *redis.Client) *Broker {
+	return &Broker{rdb: rdb}
+}
+
+// Publish sends an event to the subscribers of a session.
+func (b *Broker) Publish(ctx context.Context, sessionID uuid.UUID, event Event) error {
+	start := time.Now()
+	defer func() {
+		metrics.RedisOperationDuration.WithLabelValues("publish").Observe(time.Since(start).Seconds())
+	}()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal progress event: %w", err)
+	}
+	return b.rdb.Publish(ctx, mindmapChannelPrefix+sessionID.String(), data).Err()
+}
+
+// Subscribe subscribes to the events of a session. The subscription is
+// active when Subscribe returns; it ends when ctx is done or it is closed.
+func (b *Broker) Subscribe(ctx context.Context, sessionID uuid.UUID) (*Subscription, error) {
+	pubsub := b.rdb.Subscribe(ctx, mindmapChannelPrefix+sessionID.String())
+
+	// Wait for the confirmation so no event published afterwards is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("subscribe to mindmap progress: %w", err)
+	}
+
+	sub := &Subscription{
+		pubsub: pubsub,
+		events: make(chan Event),
+	}
+	go sub.relay(ctx)
+	return sub, nil
+}
+
+// Subscription receives the progress events of a session.
+type Subscription struct {
+	pubsub *redis.PubSub
+	events chan Event
+}
+
+// Events returns the channel events are delivered on. It is closed when the
+// subscription ends.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() error {
+	return s.pubsub.Close()
+}
+
+func (s *Subscription) relay(ctx context.Context) {
+	defer close(s.events)
+
+	messages := s.pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			_ = s.pubsub.Close()
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			var event Event
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				slog.Warn("dropping malformed mindmap progress event", "channel", msg.Channel, "error", err)
+				continue
+			}
+			select {
+			case s.events <- event:
+			case <-ctx.Done():
+				_ = s.pubsub.Close()
+				return
+			}
+		}
+	}
+}
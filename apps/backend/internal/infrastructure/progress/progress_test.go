@@ -0,0 +1,91 @@
+package progress
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupBroker(t *testing.T) *Broker {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewBroker(rdb)
+}
+
+func receive(t *testing.T, sub *Subscription) Event {
+	t.Helper()
+	select {
+	case event, ok := <-sub.Events():
+		require.True(t, ok, "subscription ended")
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event received")
+		return Event{}
+	}
+}
+
+func TestBroker_PublishSubscribe(t *testing.T) {
+	broker := setupBroker(t)
+	ctx := context.Background()
+	sessionID := uuid.New()
+
+	sub, err := broker.Subscribe(ctx, sessionID)
+	require.NoError(t, err)
+	defer func() { _ = sub.Close() }()
+
+	other, err := broker.Subscribe(ctx, uuid.New())
+	require.NoError(t, err)
+	defer func() { _ = other.Close() }()
+
+	require.NoError(t, broker.Publish(ctx, sessionID, Event{Type: EventStage, Stage: StageGenerating}))
+	require.NoError(t, broker.Publish(ctx, sessionID, Event{
+		Type:  EventTopic,
+		Topic: &Topic{ID: "topic-1", Label: "Go", Keywords: []string{"go"}},
+	}))
+	require.NoError(t, broker.Publish(ctx, sessionID, Event{Type: EventDone, MindmapID: "m-1"}))
+
+	assert.Equal(t, Event{Type: EventStage, Stage: StageGenerating}, receive(t, sub))
+	topic := receive(t, sub)
+	require.NotNil(t, topic.Topic)
+	assert.Equal(t, "Go", topic.Topic.Label)
+	done := receive(t, sub)
+	assert.True(t, done.Final())
+	assert.Equal(t, "m-1", done.MindmapID)
+
+	select {
+	case event := <-other.Events():
+		t.Fatalf("other session received %v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBroker_SubscriptionEndsWithContext(t *testing.T) {
+	broker := setupBroker(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sub, err := broker.Subscribe(ctx, uuid.New())
+	require.NoError(t, err)
+
+	cancel()
+	select {
+	case _, ok := <-sub.Events():
+		assert.False(t, ok)
+	case <-time.After(2 * time.Second):
+		t.Fatal("subscription did not end")
+	}
+}
+
+func TestEvent_Final(t *testing.T) {
+	assert.True(t, Event{Type: EventDone}.Final())
+	assert.True(t, Event{Type: EventError}.Final())
+	assert.False(t, Event{Type: EventStage}.Final())
+	assert.False(t, Event{Type: EventContent}.Final())
+}
@@ -0,0 +1,267 @@
+// Package prompt provides the named, versioned and localized templates of
+// the prompts sent to AI providers.
+package prompt
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/mindhit/api/ent"
+)
+
+// Template names, matching the AI task types
+const (
+	TagExtraction = "tag_extraction"
+	Summarize     = "summarize"
+	Mindmap       = "mindmap"
+)
+
+// Template names of the map-reduce steps for inputs too large for one request
+const (
+	// SummarizeChunk summarizes one part of a long page
+	SummarizeChunk = "summarize_chunk"
+	// MindmapMerge organizes the page clusters of a large session into topics
+	MindmapMerge = "mindmap_merge"
+)
+
+// KnownTemplates lists every template name.
+var KnownTemplates = []string{TagExtraction, Summarize, Mindmap, SummarizeChunk, MindmapMerge}
+
+// IsKnownTemplate reports whether name is a template name.
+func IsKnownTemplate(name string) bool {
+	return slices.Contains(KnownTemplates, name)
+}
+
+// DefaultLocale is used for languages without a template. It matches the
+// default language of user settings.
+const DefaultLocale = "ko"
+
+// Sources of a rendered prompt
+const (
+	SourceEmbedded = "embedded"
+	SourceDB       = "db"
+)
+
+// ErrUnknownTemplate is returned when rendering a template that does not exist.
+var ErrUnknownTemplate = errors.New("unknown prompt template")
+
+//go:embed templates schemas
+var templateFS embed.FS
+
+// Each template is a <locale>/<name>.v<version>.tmpl file defining "user"
+// and optionally "system". The JSON Schema of a template's output, shared by
+// its versions and locales, is schemas/<name>.json.
+var templateFile = regexp.MustCompile(`^([a-z_]+)\.v([0-9]+)\.tmpl$`)
+
+// OverrideProvider provides template overrides from the database.
+type OverrideProvider interface {
+	// GetPromptOverride returns the active override of a template, or nil
+	// when there is none.
+	GetPromptOverride(ctx context.Context, name, locale string) (*ent.PromptTemplate, error)
+}
+
+// Prompt is a rendered template.
+type Prompt struct {
+	Name    string
+	Version int
+	Locale  string
+	Source  string
+	System  string
+	User    string
+	// Schema is the JSON Schema of the expected output, nil for free text.
+	Schema json.RawMessage
+}
+
+// Metadata returns the request metadata identifying the template.
+func (p *Prompt) Metadata() map[string]string {
+	return map[string]string{
+		"prompt_name":    p.Name,
+		"prompt_version": strconv.Itoa(p.Version),
+		"prompt_locale":  p.Locale,
+		"prompt_source":  p.Source,
+	}
+}
+
+type versionedTemplate struct {
+	version int
+	tmpl    *template.Template
+}
+
+// Registry renders prompt templates. Overrides from the database take
+// precedence over the embedded templates.
+type Registry struct {
+	templates map[string]map[string]versionedTemplate // name -> locale -> latest version
+	schemas   map[string]json.RawMessage
+	overrides OverrideProvider
+}
+
+// NewRegistry parses the embedded templates. Without an override provider
+// only the embedded templates are used.
+func NewRegistry(overrides OverrideProvider) (*Registry, error) {
+	files, err := fs.Glob(templateFS, "templates/*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Registry{
+		templates: make(map[string]map[string]versionedTemplate),
+		schemas:   make(map[string]json.RawMessage),
+		overrides: overrides,
+	}
+	for _, file := range files {
+		m := templateFile.FindStringSubmatch(path.Base(file))
+		if m == nil {
+			return nil, fmt.Errorf("invalid prompt template file name %s", file)
+		}
+		name, locale := m[1], path.Base(path.Dir(file))
+		version, _ := strconv.Atoi(m[2])
+
+		if current, ok := r.templates[name][locale]; ok && current.version > version {
+			continue
+		}
+
+		body, err := fs.ReadFile(templateFS, file)
+		if err != nil {
+			return nil, err
+		}
+		tmpl, err := Parse(name, string(body))
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+
+		if r.templates[name] == nil {
+			r.templates[name] = make(map[string]versionedTemplate)
+		}
+		r.templates[name][locale] = versionedTemplate{version: version, tmpl: tmpl}
+	}
+
+	schemas, err := fs.Glob(templateFS, "schemas/*.json")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range schemas {
+		schema, err := fs.ReadFile(templateFS, file)
+		if err != nil {
+			return nil, err
+		}
+		if !json.Valid(schema) {
+			return nil, fmt.Errorf("parse %s: invalid json", file)
+		}
+		r.schemas[strings.TrimSuffix(path.Base(file), ".json")] = schema
+	}
+	return r, nil
+}
+
+var embedded = sync.OnceValues(func() (*Registry, error) {
+	return NewRegistry(nil)
+})
+
+// Embedded returns a shared registry of the embedded templates.
+func Embedded() *Registry {
+	r, err := embedded()
+	if err != nil {
+		// The templates are compiled in, so this is a programming error
+		panic(err)
+	}
+	return r
+}
+
+// Render renders a template in the given locale, falling back to the
+// language without region ("en" for "en-US") and then to DefaultLocale.
+func (r *Registry) Render(ctx context.Context, name, locale string, data any) (*Prompt, error) {
+	if _, ok := r.templates[name]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, name)
+	}
+
+	locale = strings.ToLower(locale)
+	language, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	for _, l := range []string{locale, language, DefaultLocale} {
+		if l == "" {
+			continue
+		}
+		if p := r.renderOverride(ctx, name, l, data); p != nil {
+			return p, nil
+		}
+		if t, ok := r.templates[name][l]; ok {
+			return render(t.tmpl, &Prompt{Name: name, Version: t.version, Locale: l, Source: SourceEmbedded, Schema: r.schemas[name]}, data)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s has no %s variant", ErrUnknownTemplate, name, DefaultLocale)
+}
+
+// Schema returns the JSON Schema of a template's output, nil for free text
+// and unknown templates.
+func (r *Registry) Schema(name string) json.RawMessage {
+	return r.schemas[name]
+}
+
+// renderOverride renders the database override of a template. A broken
+// override is logged and skipped, so a bad edit doesn't stop AI processing.
+func (r *Registry) renderOverride(ctx context.Context, name, locale string, data any) *Prompt {
+	if r.overrides == nil {
+		return nil
+	}
+
+	override, err := r.overrides.GetPromptOverride(ctx, name, locale)
+	if err != nil {
+		slog.Warn("failed to get prompt override", "name", name, "locale", locale, "error", err)
+		return nil
+	}
+	if override == nil {
+		return nil
+	}
+
+	tmpl, err := Parse(name, override.Body)
+	if err == nil {
+		var p *Prompt
+		p, err = render(tmpl, &Prompt{Name: name, Version: override.Version, Locale: locale, Source: SourceDB, Schema: r.schemas[name]}, data)
+		if err == nil {
+			return p
+		}
+	}
+	slog.Error("invalid prompt override, using embedded template",
+		"name", name, "locale", locale, "version", override.Version, "error", err)
+	return nil
+}
+
+// Parse parses a template body, which must define "user".
+func Parse(name, body string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	if tmpl.Lookup("user") == nil {
+		return nil, errors.New(`template does not define "user"`)
+	}
+	return tmpl, nil
+}
+
+func render(tmpl *template.Template, p *Prompt, data any) (*Prompt, error) {
+	var user bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&user, "user", data); err != nil {
+		return nil, fmt.Errorf("render %s user prompt: %w", p.Name, err)
+	}
+	p.User = strings.TrimSpace(user.String())
+
+	if tmpl.Lookup("system") != nil {
+		var system bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&system, "system", data); err != nil {
+			return nil, fmt.Errorf("render %s system prompt: %w", p.Name, err)
+		}
+		p.System = strings.TrimSpace(system.String())
+	}
+	return p, nil
+}
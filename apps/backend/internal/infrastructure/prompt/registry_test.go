@@ -0,0 +1,156 @@
+package prompt
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+)
+
+type staticOverrides map[string]*ent.PromptTemplate // name/locale -> override
+
+func (o staticOverrides) GetPromptOverride(_ context.Context, name, locale string) (*ent.PromptTemplate, error) {
+	if override, ok := o[name+"/"+locale]; ok {
+		return override, nil
+	}
+	return nil, nil
+}
+
+type failingOverrides struct{}
+
+func (failingOverrides) GetPromptOverride(context.Context, string, string) (*ent.PromptTemplate, error) {
+	return nil, errors.New("db down")
+}
+
+func testTemplateData() map[string]map[string]string {
+	return map[string]map[string]string{
+		TagExtraction:  {"Title": "Go Generics", "Content": "Type parameters..."},
+		Summarize:      {"Title": "Go Generics", "Content": "Type parameters..."},
+		Mindmap:        {"Pages": "- ID: 1\n  Title: Go Generics", "Highlights": "(No highlights)"},
+		SummarizeChunk: {"Title": "Go Generics", "Part": "1", "Parts": "2", "Content": "Type parameters..."},
+		MindmapMerge:   {"Clusters": "- ID: c1\n  Label: Generics", "Highlights": "(No highlights)"},
+	}
+}
+
+func TestRegistry_RendersEveryTemplateInEveryLocale(t *testing.T) {
+	r, err := NewRegistry(nil)
+	require.NoError(t, err)
+
+	for name, data := range testTemplateData() {
+		for _, locale := range []string{"ko", "en"} {
+			t.Run(name+"/"+locale, func(t *testing.T) {
+				p, err := r.Render(context.Background(), name, locale, data)
+				require.NoError(t, err)
+
+				assert.Equal(t, name, p.Name)
+				assert.Equal(t, locale, p.Locale)
+				assert.Equal(t, SourceEmbedded, p.Source)
+				assert.Positive(t, p.Version)
+				for _, v := range data {
+					assert.Contains(t, p.User, v)
+				}
+			})
+		}
+	}
+}
+
+func TestRegistry_Schemas(t *testing.T) {
+	r := Embedded()
+
+	for _, name := range KnownTemplates {
+		p, err := r.Render(context.Background(), name, "ko", testTemplateData()[name])
+		require.NoError(t, err)
+		require.NotNil(t, p.Schema, name)
+		assert.Equal(t, p.Schema, r.Schema(name), name)
+
+		_, err = ai.NewOutputSchema(name, p.Schema)
+		assert.NoError(t, err, name)
+	}
+	assert.Nil(t, r.Schema("unknown"))
+}
+
+func TestRegistry_LocaleFallback(t *testing.T) {
+	r := Embedded()
+	data := testTemplateData()[TagExtraction]
+
+	p, err := r.Render(context.Background(), TagExtraction, "en-US", data)
+	require.NoError(t, err)
+	assert.Equal(t, "en", p.Locale)
+	assert.Contains(t, p.User, "(English)")
+
+	p, err = r.Render(context.Background(), TagExtraction, "fr", data)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultLocale, p.Locale)
+	assert.Contains(t, p.User, "(Korean)")
+}
+
+func TestRegistry_Errors(t *testing.T) {
+	r := Embedded()
+
+	_, err := r.Render(context.Background(), "missing", "ko", nil)
+	assert.ErrorIs(t, err, ErrUnknownTemplate)
+
+	// A missing variable fails instead of rendering "<no value>"
+	_, err = r.Render(context.Background(), TagExtraction, "ko", map[string]string{"Title": "only title"})
+	assert.Error(t, err)
+}
+
+func TestRegistry_Overrides(t *testing.T) {
+	data := testTemplateData()[TagExtraction]
+
+	r, err := NewRegistry(staticOverrides{
+		TagExtraction + "/en": {
+			Version: 7,
+			Body:    `{{define "system"}}You are terse.{{end}}{{define "user"}}Tags for {{.Title}}{{end}}`,
+		},
+		Summarize + "/en": {Version: 2, Body: `{{define "user"}}{{.Missing}}{{end}}`},
+	})
+	require.NoError(t, err)
+
+	p, err := r.Render(context.Background(), TagExtraction, "en", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Tags for Go Generics", p.User)
+	assert.Equal(t, "You are terse.", p.System)
+	assert.Equal(t, map[string]string{
+		"prompt_name":    TagExtraction,
+		"prompt_version": "7",
+		"prompt_locale":  "en",
+		"prompt_source":  SourceDB,
+	}, p.Metadata())
+
+	// Other locales keep the embedded template
+	p, err = r.Render(context.Background(), TagExtraction, "ko", data)
+	require.NoError(t, err)
+	assert.Equal(t, SourceEmbedded, p.Source)
+
+	// A broken override falls back to the embedded template
+	p, err = r.Render(context.Background(), Summarize, "en", data)
+	require.NoError(t, err)
+	assert.Equal(t, SourceEmbedded, p.Source)
+	assert.Equal(t, "en", p.Locale)
+}
+
+func TestRegistry_OverrideProviderFailure(t *testing.T) {
+	r, err := NewRegistry(failingOverrides{})
+	require.NoError(t, err)
+
+	p, err := r.Render(context.Background(), Mindmap, "ko", testTemplateData()[Mindmap])
+	require.NoError(t, err)
+	assert.Equal(t, SourceEmbedded, p.Source)
+}
+
+func TestParse(t *testing.T) {
+	_, err := Parse("x", `{{define "user"}}ok{{end}}`)
+	assert.NoError(t, err)
+
+	_, err = Parse("x", `{{define "system"}}no user{{end}}`)
+	assert.Error(t, err)
+
+	_, err = Parse("x", `{{define "user"}}{{.Unclosed{{end}}`)
+	assert.Error(t, err)
+}
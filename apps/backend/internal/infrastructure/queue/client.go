@@ -2,20 +2,26 @@
 package queue
 
 import (
+	"errors"
+
 	"github.com/hibiken/asynq"
 )
 
 // Client wraps asynq.Client for job enqueueing.
 type Client struct {
-	client *asynq.Client
+	client    *asynq.Client
+	inspector *asynq.Inspector
 }
 
 // NewClient creates a new queue client.
 func NewClient(redisAddr string) *Client {
-	client := asynq.NewClient(asynq.RedisClientOpt{
+	opt := asynq.RedisClientOpt{
 		Addr: redisAddr,
-	})
-	return &Client{client: client}
+	}
+	return &Client{
+		client:    asynq.NewClient(opt),
+		inspector: asynq.NewInspector(opt),
+	}
 }
 
 // Enqueue adds a task to the queue with optional options.
@@ -23,7 +29,20 @@ func (c *Client) Enqueue(task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInf
 	return c.client.Enqueue(task, opts...)
 }
 
+// TaskDone reports whether the task with the given ID won't run anymore:
+// it completed, was archived after failing for good, or is gone.
+func (c *Client) TaskDone(queue, taskID string) (bool, error) {
+	info, err := c.inspector.GetTaskInfo(queue, taskID)
+	if errors.Is(err, asynq.ErrTaskNotFound) || errors.Is(err, asynq.ErrQueueNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return info.State == asynq.TaskStateCompleted || info.State == asynq.TaskStateArchived, nil
+}
+
 // Close closes the client connection.
 func (c *Client) Close() error {
-	return c.client.Close()
+	return errors.Join(c.client.Close(), c.inspector.Close())
 }
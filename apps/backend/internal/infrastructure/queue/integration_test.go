@@ -58,6 +58,36 @@ func TestClient_Enqueue_Integration(t *testing.T) {
 	_ = inspector.DeleteTask(info.Queue, info.ID)
 }
 
+func TestClient_TaskDone_Integration(t *testing.T) {
+	skipIfNoRedis(t)
+
+	client := NewClient(getTestRedisAddr())
+	defer func() { _ = client.Close() }()
+
+	inspector := asynq.NewInspector(asynq.RedisClientOpt{Addr: getTestRedisAddr()})
+	defer func() { _ = inspector.Close() }()
+
+	task, err := NewSessionProcessTask("test-task-done")
+	require.NoError(t, err)
+	info, err := client.Enqueue(task, asynq.TaskID("test:task-done:"+time.Now().String()))
+	require.NoError(t, err)
+	defer func() { _ = inspector.DeleteTask(info.Queue, info.ID) }()
+
+	done, err := client.TaskDone(info.Queue, info.ID)
+	require.NoError(t, err)
+	assert.False(t, done, "pending tasks may still run")
+
+	require.NoError(t, inspector.ArchiveTask(info.Queue, info.ID))
+	done, err = client.TaskDone(info.Queue, info.ID)
+	require.NoError(t, err)
+	assert.True(t, done, "archived tasks won't run again")
+
+	require.NoError(t, inspector.DeleteTask(info.Queue, info.ID))
+	done, err = client.TaskDone(info.Queue, info.ID)
+	require.NoError(t, err)
+	assert.True(t, done, "deleted tasks won't run again")
+}
+
 func TestClient_EnqueueMultipleTasks_Integration(t *testing.T) {
 	skipIfNoRedis(t)
 
@@ -93,7 +123,10 @@ func TestScheduler_RegisterPeriodicTasks_Integration(t *testing.T) {
 	scheduler, err := NewScheduler(getTestRedisAddr())
 	require.NoError(t, err)
 
-	err = scheduler.RegisterPeriodicTasks()
+	err = scheduler.RegisterPeriodicTasks(PeriodicConfig{
+		SummaryBackfillInterval:  "@every 30m",
+		SummaryBackfillBatchSize: 10,
+	})
 	require.NoError(t, err)
 
 	// Scheduler should be able to shut down cleanly
@@ -200,7 +233,7 @@ func TestClient_EnqueueScheduled_Integration(t *testing.T) {
 	client := NewClient(getTestRedisAddr())
 	defer func() { _ = client.Close() }()
 
-	task, err := NewMindmapGenerateTask("session-scheduled")
+	task, err := NewMindmapGenerateTask("session-scheduled", "")
 	require.NoError(t, err)
 
 	// Schedule for 1 hour from now
@@ -20,8 +20,26 @@ func NewScheduler(redisAddr string) (*Scheduler, error) {
 	return &Scheduler{scheduler: scheduler}, nil
 }
 
+// PeriodicConfig holds settings for periodic tasks.
+type PeriodicConfig struct {
+	// SummaryBackfillInterval is the cron spec for the URL summary backfill.
+	// Empty disables the backfill.
+	SummaryBackfillInterval string
+	// SummaryBackfillBatchSize is the maximum number of URLs per backfill run.
+	SummaryBackfillBatchSize int
+	// SummaryBackfillDailyTokens caps backfill token spend per 24 hours.
+	SummaryBackfillDailyTokens int
+	// RetentionInterval is the cron spec for plan-based session retention.
+	// Empty disables retention.
+	RetentionInterval string
+	// RetentionPurgeAfterDays is how long soft-deleted sessions are kept.
+	RetentionPurgeAfterDays int
+	// RetentionDryRun only reports what retention would delete.
+	RetentionDryRun bool
+}
+
 // RegisterPeriodicTasks registers all periodic tasks.
-func (s *Scheduler) RegisterPeriodicTasks() error {
+func (s *Scheduler) RegisterPeriodicTasks(cfg PeriodicConfig) error {
 	// Cleanup stale sessions every hour
 	cleanupTask, err := NewSessionCleanupTask(24) // 24 hours max age
 	if err != nil {
@@ -34,6 +52,45 @@ func (s *Scheduler) RegisterPeriodicTasks() error {
 	}
 
 	slog.Info("registered periodic cleanup task", "interval", "1h")
+
+	// Enforce plan session retention
+	if cfg.RetentionInterval != "" {
+		retentionTask, err := NewSessionRetentionTask(cfg.RetentionPurgeAfterDays, cfg.RetentionDryRun)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.scheduler.Register(cfg.RetentionInterval, retentionTask, asynq.Queue("low"))
+		if err != nil {
+			return err
+		}
+
+		slog.Info("registered periodic session retention task",
+			"interval", cfg.RetentionInterval,
+			"purge_after_days", cfg.RetentionPurgeAfterDays,
+			"dry_run", cfg.RetentionDryRun,
+		)
+	}
+
+	// Backfill full-page summaries in small batches
+	if cfg.SummaryBackfillInterval != "" && cfg.SummaryBackfillBatchSize > 0 {
+		backfillTask, err := NewURLSummaryBackfillTask(cfg.SummaryBackfillBatchSize, cfg.SummaryBackfillDailyTokens)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.scheduler.Register(cfg.SummaryBackfillInterval, backfillTask, asynq.Queue("low"))
+		if err != nil {
+			return err
+		}
+
+		slog.Info("registered periodic summary backfill task",
+			"interval", cfg.SummaryBackfillInterval,
+			"batch_size", cfg.SummaryBackfillBatchSize,
+			"daily_token_budget", cfg.SummaryBackfillDailyTokens,
+		)
+	}
+
 	return nil
 }
 
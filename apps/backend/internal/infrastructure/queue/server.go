@@ -2,7 +2,10 @@ package queue
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"math/rand/v2"
+	"time"
 
 	"github.com/hibiken/asynq"
 )
@@ -39,11 +42,24 @@ func NewServer(cfg ServerConfig) *Server {
 			Concurrency: cfg.Concurrency,
 			Queues:      cfg.Queues,
 			ErrorHandler: asynq.ErrorHandlerFunc(func(_ context.Context, task *asynq.Task, err error) {
+				if delay, ok := retryAfter(err); ok {
+					slog.Warn("task delayed",
+						"type", task.Type(),
+						"retry_in", delay,
+						"error", err,
+					)
+					return
+				}
 				slog.Error("task failed",
 					"type", task.Type(),
 					"error", err,
 				)
 			}),
+			RetryDelayFunc: retryDelay,
+			IsFailure: func(err error) bool {
+				_, delayed := retryAfter(err)
+				return !delayed
+			},
 		},
 	)
 
@@ -53,6 +69,32 @@ func NewServer(cfg ServerConfig) *Server {
 	}
 }
 
+// retryAfterError is implemented by errors that know when a task can be
+// retried, such as AI rate limits.
+type retryAfterError interface {
+	RetryAfter() time.Duration
+}
+
+// retryAfter returns the delay of an error that knows when its task can be
+// retried. Such tasks are retried then, without counting as failed, so they
+// don't use up their retries.
+func retryAfter(err error) (time.Duration, bool) {
+	var ra retryAfterError
+	if !errors.As(err, &ra) {
+		return 0, false
+	}
+	return ra.RetryAfter(), true
+}
+
+// retryDelay spreads the retries of delayed tasks over a few seconds, so
+// they don't hit a renewed rate limit budget at once.
+func retryDelay(n int, err error, task *asynq.Task) time.Duration {
+	if delay, ok := retryAfter(err); ok {
+		return delay + rand.N(5*time.Second)
+	}
+	return asynq.DefaultRetryDelayFunc(n, err, task)
+}
+
 // HandleFunc registers a handler function for a task type.
 func (s *Server) HandleFunc(pattern string, handler func(context.Context, *asynq.Task) error) {
 	s.mux.HandleFunc(pattern, handler)
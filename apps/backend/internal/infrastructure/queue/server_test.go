@@ -1,8 +1,12 @@
 package queue
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hibiken/asynq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,3 +49,20 @@ func TestNewServer_NilQueues_UsesDefault(t *testing.T) {
 
 	assert.NotNil(t, server)
 }
+
+type delayedError struct{ delay time.Duration }
+
+func (e delayedError) Error() string             { return "rate limited" }
+func (e delayedError) RetryAfter() time.Duration { return e.delay }
+
+func TestRetryDelay(t *testing.T) {
+	task := asynq.NewTask(TypeMindmapGenerate, nil)
+
+	delay := retryDelay(0, fmt.Errorf("generate: %w", delayedError{time.Minute}), task)
+	assert.GreaterOrEqual(t, delay, time.Minute)
+	assert.Less(t, delay, time.Minute+5*time.Second)
+
+	_, ok := retryAfter(errors.New("boom"))
+	assert.False(t, ok)
+	assert.Positive(t, retryDelay(0, errors.New("boom"), task))
+}
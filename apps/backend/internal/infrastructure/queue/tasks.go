@@ -8,21 +8,37 @@ import (
 
 // Task types
 const (
-	TypeSessionProcess   = "session:process"
-	TypeSessionCleanup   = "session:cleanup"
-	TypeURLSummarize     = "url:summarize"
-	TypeURLTagExtraction = "url:tag_extraction"
-	TypeMindmapGenerate  = "mindmap:generate"
+	TypeSessionProcess     = "session:process"
+	TypeSessionCleanup     = "session:cleanup"
+	TypeSessionRetention   = "session:retention"
+	TypeURLSummarize       = "url:summarize"
+	TypeURLSummaryBackfill = "url:summary_backfill"
+	TypeURLTagExtraction   = "url:tag_extraction"
+	TypeMindmapGenerate    = "mindmap:generate"
+	TypeKnowledgeUpdate    = "knowledge:update"
+	TypeEmailSend          = "email:send"
 )
 
 // SessionProcessPayload is the payload for session processing.
 type SessionProcessPayload struct {
 	SessionID string `json:"session_id"`
+	// WaitRound counts how many times processing has been re-queued while
+	// waiting for the session's tag extraction tasks to finish.
+	WaitRound int `json:"wait_round,omitempty"`
 }
 
 // NewSessionProcessTask creates a new session process task.
 func NewSessionProcessTask(sessionID string) (*asynq.Task, error) {
-	payload, err := json.Marshal(SessionProcessPayload{SessionID: sessionID})
+	return NewSessionProcessWaitTask(sessionID, 0)
+}
+
+// NewSessionProcessWaitTask creates a session process task that re-checks
+// tag extraction progress for the given wait round.
+func NewSessionProcessWaitTask(sessionID string, waitRound int) (*asynq.Task, error) {
+	payload, err := json.Marshal(SessionProcessPayload{
+		SessionID: sessionID,
+		WaitRound: waitRound,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -43,32 +59,72 @@ func NewSessionCleanupTask(maxAgeHours int) (*asynq.Task, error) {
 	return asynq.NewTask(TypeSessionCleanup, payload), nil
 }
 
+// SessionRetentionPayload is the payload for plan-based session retention.
+type SessionRetentionPayload struct {
+	// PurgeAfterDays is how long a soft-deleted session is kept before it
+	// is removed for good.
+	PurgeAfterDays int `json:"purge_after_days"`
+	// DryRun only reports what would be deleted.
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// NewSessionRetentionTask creates a new session retention task.
+func NewSessionRetentionTask(purgeAfterDays int, dryRun bool) (*asynq.Task, error) {
+	payload, err := json.Marshal(SessionRetentionPayload{
+		PurgeAfterDays: purgeAfterDays,
+		DryRun:         dryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeSessionRetention, payload), nil
+}
+
 // URLSummarizePayload is the payload for URL summarization.
 type URLSummarizePayload struct {
-	SessionID string `json:"session_id"`
-	URL       string `json:"url"`
+	// URLContentID identifies the user's copy of the page to summarize.
+	URLContentID string `json:"url_content_id"`
 }
 
 // NewURLSummarizeTask creates a new URL summarize task.
-func NewURLSummarizeTask(sessionID, url string) (*asynq.Task, error) {
-	payload, err := json.Marshal(URLSummarizePayload{
-		SessionID: sessionID,
-		URL:       url,
-	})
+func NewURLSummarizeTask(urlContentID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(URLSummarizePayload{URLContentID: urlContentID})
 	if err != nil {
 		return nil, err
 	}
 	return asynq.NewTask(TypeURLSummarize, payload), nil
 }
 
+// URLSummaryBackfillPayload is the payload for the periodic summary backfill.
+type URLSummaryBackfillPayload struct {
+	// BatchSize is the maximum number of pages enqueued per run.
+	BatchSize int `json:"batch_size"`
+	// DailyTokenBudget caps summarization tokens spent over the last 24 hours.
+	// Zero means no token cap.
+	DailyTokenBudget int `json:"daily_token_budget"`
+}
+
+// NewURLSummaryBackfillTask creates a new URL summary backfill task.
+func NewURLSummaryBackfillTask(batchSize, dailyTokenBudget int) (*asynq.Task, error) {
+	payload, err := json.Marshal(URLSummaryBackfillPayload{
+		BatchSize:        batchSize,
+		DailyTokenBudget: dailyTokenBudget,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeURLSummaryBackfill, payload), nil
+}
+
 // URLTagExtractionPayload is the payload for URL tag extraction.
 type URLTagExtractionPayload struct {
-	URLID string `json:"url_id"`
+	// URLContentID identifies the user's copy of the page to tag.
+	URLContentID string `json:"url_content_id"`
 }
 
 // NewURLTagExtractionTask creates a new URL tag extraction task.
-func NewURLTagExtractionTask(urlID string) (*asynq.Task, error) {
-	payload, err := json.Marshal(URLTagExtractionPayload{URLID: urlID})
+func NewURLTagExtractionTask(urlContentID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(URLTagExtractionPayload{URLContentID: urlContentID})
 	if err != nil {
 		return nil, err
 	}
@@ -78,13 +134,57 @@ func NewURLTagExtractionTask(urlID string) (*asynq.Task, error) {
 // MindmapGeneratePayload is the payload for mindmap generation.
 type MindmapGeneratePayload struct {
 	SessionID string `json:"session_id"`
+	// MindmapID is the MindmapGraph row to fill in. When empty the handler
+	// uses (or creates) the session's mindmap.
+	MindmapID string `json:"mindmap_id,omitempty"`
 }
 
 // NewMindmapGenerateTask creates a new mindmap generate task.
-func NewMindmapGenerateTask(sessionID string) (*asynq.Task, error) {
-	payload, err := json.Marshal(MindmapGeneratePayload{SessionID: sessionID})
+func NewMindmapGenerateTask(sessionID, mindmapID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(MindmapGeneratePayload{
+		SessionID: sessionID,
+		MindmapID: mindmapID,
+	})
 	if err != nil {
 		return nil, err
 	}
 	return asynq.NewTask(TypeMindmapGenerate, payload), nil
 }
+
+// KnowledgeUpdatePayload is the payload for merging a mindmap into its
+// user's knowledge graph.
+type KnowledgeUpdatePayload struct {
+	MindmapID string `json:"mindmap_id"`
+}
+
+// NewKnowledgeUpdateTask creates a new knowledge update task.
+func NewKnowledgeUpdateTask(mindmapID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(KnowledgeUpdatePayload{MindmapID: mindmapID})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeKnowledgeUpdate, payload), nil
+}
+
+// EmailSendPayload is the payload for sending a transactional email. The
+// template is rendered by the worker.
+type EmailSendPayload struct {
+	To       string            `json:"to"`
+	Template string            `json:"template"`
+	Locale   string            `json:"locale"`
+	Data     map[string]string `json:"data"`
+}
+
+// NewEmailSendTask creates a new email send task.
+func NewEmailSendTask(to, template, locale string, data map[string]string) (*asynq.Task, error) {
+	payload, err := json.Marshal(EmailSendPayload{
+		To:       to,
+		Template: template,
+		Locale:   locale,
+		Data:     data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeEmailSend, payload), nil
+}
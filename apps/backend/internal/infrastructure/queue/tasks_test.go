@@ -22,6 +22,21 @@ func TestNewSessionProcessTask(t *testing.T) {
 	assert.Equal(t, sessionID, payload.SessionID)
 }
 
+func TestNewSessionProcessWaitTask(t *testing.T) {
+	sessionID := "test-session-123"
+
+	task, err := NewSessionProcessWaitTask(sessionID, 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, TypeSessionProcess, task.Type())
+
+	var payload SessionProcessPayload
+	err = json.Unmarshal(task.Payload(), &payload)
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, payload.SessionID)
+	assert.Equal(t, 3, payload.WaitRound)
+}
+
 func TestNewSessionCleanupTask(t *testing.T) {
 	maxAgeHours := 24
 
@@ -36,11 +51,23 @@ func TestNewSessionCleanupTask(t *testing.T) {
 	assert.Equal(t, maxAgeHours, payload.MaxAgeHours)
 }
 
+func TestNewSessionRetentionTask(t *testing.T) {
+	task, err := NewSessionRetentionTask(7, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, TypeSessionRetention, task.Type())
+
+	var payload SessionRetentionPayload
+	err = json.Unmarshal(task.Payload(), &payload)
+	require.NoError(t, err)
+	assert.Equal(t, 7, payload.PurgeAfterDays)
+	assert.True(t, payload.DryRun)
+}
+
 func TestNewURLSummarizeTask(t *testing.T) {
-	sessionID := "session-456"
-	url := "https://example.com/page"
+	urlContentID := "url-content-456"
 
-	task, err := NewURLSummarizeTask(sessionID, url)
+	task, err := NewURLSummarizeTask(urlContentID)
 
 	require.NoError(t, err)
 	assert.Equal(t, TypeURLSummarize, task.Type())
@@ -48,14 +75,27 @@ func TestNewURLSummarizeTask(t *testing.T) {
 	var payload URLSummarizePayload
 	err = json.Unmarshal(task.Payload(), &payload)
 	require.NoError(t, err)
-	assert.Equal(t, sessionID, payload.SessionID)
-	assert.Equal(t, url, payload.URL)
+	assert.Equal(t, urlContentID, payload.URLContentID)
+}
+
+func TestNewURLSummaryBackfillTask(t *testing.T) {
+	task, err := NewURLSummaryBackfillTask(20, 100000)
+
+	require.NoError(t, err)
+	assert.Equal(t, TypeURLSummaryBackfill, task.Type())
+
+	var payload URLSummaryBackfillPayload
+	err = json.Unmarshal(task.Payload(), &payload)
+	require.NoError(t, err)
+	assert.Equal(t, 20, payload.BatchSize)
+	assert.Equal(t, 100000, payload.DailyTokenBudget)
 }
 
 func TestNewMindmapGenerateTask(t *testing.T) {
 	sessionID := "session-789"
+	mindmapID := "mindmap-789"
 
-	task, err := NewMindmapGenerateTask(sessionID)
+	task, err := NewMindmapGenerateTask(sessionID, mindmapID)
 
 	require.NoError(t, err)
 	assert.Equal(t, TypeMindmapGenerate, task.Type())
@@ -64,11 +104,46 @@ func TestNewMindmapGenerateTask(t *testing.T) {
 	err = json.Unmarshal(task.Payload(), &payload)
 	require.NoError(t, err)
 	assert.Equal(t, sessionID, payload.SessionID)
+	assert.Equal(t, mindmapID, payload.MindmapID)
+}
+
+func TestNewKnowledgeUpdateTask(t *testing.T) {
+	mindmapID := "mindmap-321"
+
+	task, err := NewKnowledgeUpdateTask(mindmapID)
+
+	require.NoError(t, err)
+	assert.Equal(t, TypeKnowledgeUpdate, task.Type())
+
+	var payload KnowledgeUpdatePayload
+	err = json.Unmarshal(task.Payload(), &payload)
+	require.NoError(t, err)
+	assert.Equal(t, mindmapID, payload.MindmapID)
+}
+
+func TestNewEmailSendTask(t *testing.T) {
+	data := map[string]string{"ResetURL": "https://mindhit.test/reset-password?token=abc"}
+
+	task, err := NewEmailSendTask("user@example.com", "password_reset", "en", data)
+
+	require.NoError(t, err)
+	assert.Equal(t, TypeEmailSend, task.Type())
+
+	var payload EmailSendPayload
+	err = json.Unmarshal(task.Payload(), &payload)
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", payload.To)
+	assert.Equal(t, "password_reset", payload.Template)
+	assert.Equal(t, "en", payload.Locale)
+	assert.Equal(t, data, payload.Data)
 }
 
 func TestTaskTypes(t *testing.T) {
 	assert.Equal(t, "session:process", TypeSessionProcess)
 	assert.Equal(t, "session:cleanup", TypeSessionCleanup)
 	assert.Equal(t, "url:summarize", TypeURLSummarize)
+	assert.Equal(t, "url:summary_backfill", TypeURLSummaryBackfill)
 	assert.Equal(t, "mindmap:generate", TypeMindmapGenerate)
+	assert.Equal(t, "knowledge:update", TypeKnowledgeUpdate)
+	assert.Equal(t, "email:send", TypeEmailSend)
 }
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -10,6 +12,10 @@ import (
 	"github.com/mindhit/api/internal/infrastructure/ai"
 )
 
+// ErrUnknownAIProvider is returned when an AI config names a provider that
+// doesn't exist.
+var ErrUnknownAIProvider = errors.New("unknown ai provider")
+
 // AIConfigService manages AI provider configuration in DB with caching.
 type AIConfigService struct {
 	client *ent.Client
@@ -102,12 +108,19 @@ type UpsertAIConfigRequest struct {
 	MaxTokens         int      `json:"max_tokens"`
 	ThinkingBudget    int      `json:"thinking_budget,omitempty"`
 	JSONMode          bool     `json:"json_mode"`
+	CacheTTLSeconds   int      `json:"cache_ttl_seconds,omitempty"`
 	Enabled           bool     `json:"enabled"`
 	UpdatedBy         string   `json:"updated_by,omitempty"`
 }
 
 // Upsert creates or updates an AI config.
 func (s *AIConfigService) Upsert(ctx context.Context, req UpsertAIConfigRequest) (*ent.AIConfig, error) {
+	for _, provider := range append([]string{req.Provider}, req.FallbackProviders...) {
+		if !ai.IsKnownProvider(provider) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownAIProvider, provider)
+		}
+	}
+
 	// Check if config exists
 	existing, err := s.client.AIConfig.Query().
 		Where(aiconfig.TaskTypeEQ(req.TaskType)).
@@ -128,6 +141,7 @@ func (s *AIConfigService) Upsert(ctx context.Context, req UpsertAIConfigRequest)
 			SetMaxTokens(req.MaxTokens).
 			SetThinkingBudget(req.ThinkingBudget).
 			SetJSONMode(req.JSONMode).
+			SetCacheTTLSeconds(req.CacheTTLSeconds).
 			SetEnabled(req.Enabled).
 			SetUpdatedBy(req.UpdatedBy).
 			Save(ctx)
@@ -142,6 +156,7 @@ func (s *AIConfigService) Upsert(ctx context.Context, req UpsertAIConfigRequest)
 			SetMaxTokens(req.MaxTokens).
 			SetThinkingBudget(req.ThinkingBudget).
 			SetJSONMode(req.JSONMode).
+			SetCacheTTLSeconds(req.CacheTTLSeconds).
 			SetEnabled(req.Enabled).
 			SetUpdatedBy(req.UpdatedBy).
 			Save(ctx)
@@ -187,6 +202,17 @@ func (s *AIConfigService) SeedDefaultConfigs(ctx context.Context) error {
 			Temperature:       0.3,
 			MaxTokens:         1024,
 			JSONMode:          true,
+			CacheTTLSeconds:   int((7 * 24 * time.Hour).Seconds()), // popular URLs are captured again
+			Enabled:           true,
+		},
+		{
+			TaskType:          string(ai.TaskSummarize),
+			Provider:          string(ai.ProviderGemini),
+			Model:             ai.DefaultGeminiModel,
+			FallbackProviders: []string{string(ai.ProviderOpenAI)},
+			Temperature:       0.3,
+			MaxTokens:         2048,
+			JSONMode:          true,
 			Enabled:           true,
 		},
 		{
@@ -184,6 +184,41 @@ func TestAIConfigService_Upsert_Update(t *testing.T) {
 	assert.Equal(t, "gemini-2.0-flash", updated.Model)
 }
 
+func TestAIConfigService_Upsert_UnknownProvider(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewAIConfigService(client)
+
+	_, err := svc.Upsert(ctx, UpsertAIConfigRequest{
+		TaskType: "bad_provider",
+		Provider: "ollama",
+		Model:    "llama3.1:8b",
+		Enabled:  true,
+	})
+	assert.ErrorIs(t, err, ErrUnknownAIProvider)
+
+	_, err = svc.Upsert(ctx, UpsertAIConfigRequest{
+		TaskType:          "bad_fallback",
+		Provider:          "openai_compatible",
+		Model:             "llama3.1:8b",
+		FallbackProviders: []string{"openai", "mistral"},
+		Enabled:           true,
+	})
+	assert.ErrorIs(t, err, ErrUnknownAIProvider)
+
+	cfg, err := svc.Upsert(ctx, UpsertAIConfigRequest{
+		TaskType:          "local_task",
+		Provider:          "openai_compatible",
+		Model:             "llama3.1:8b",
+		FallbackProviders: []string{"openai"},
+		Enabled:           true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "openai_compatible", cfg.Provider)
+}
+
 func TestAIConfigService_Delete(t *testing.T) {
 	client := testutil.SetupTestDB(t)
 	defer testutil.CleanupTestDB(t, client)
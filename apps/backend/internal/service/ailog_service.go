@@ -3,7 +3,11 @@ package service
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 
 	"github.com/mindhit/api/ent"
@@ -13,12 +17,16 @@ import (
 
 // AILogService manages AI request logging.
 type AILogService struct {
-	client *ent.Client
+	client  *ent.Client
+	pricing *ModelPricingService
 }
 
 // NewAILogService creates a new AILogService.
 func NewAILogService(client *ent.Client) *AILogService {
-	return &AILogService{client: client}
+	return &AILogService{
+		client:  client,
+		pricing: NewModelPricingService(client),
+	}
 }
 
 // AILogRequest represents the data needed to create an AI log entry.
@@ -44,6 +52,7 @@ func (s *AILogService) Log(ctx context.Context, req AILogRequest) (*ent.AILog, e
 		model        = ""
 		content      = ""
 		inputTokens  = 0
+		cachedTokens = 0
 		outputTokens = 0
 		thinkingTkns = 0
 		totalTokens  = 0
@@ -57,6 +66,7 @@ func (s *AILogService) Log(ctx context.Context, req AILogRequest) (*ent.AILog, e
 		model = req.Response.Model
 		content = req.Response.Content
 		inputTokens = req.Response.InputTokens
+		cachedTokens = req.Response.CachedInputTokens
 		outputTokens = req.Response.OutputTokens
 		thinkingTkns = req.Response.ThinkingTokens
 		totalTokens = req.Response.TotalTokens
@@ -71,6 +81,7 @@ func (s *AILogService) Log(ctx context.Context, req AILogRequest) (*ent.AILog, e
 		SetModel(model).
 		SetContent(content).
 		SetInputTokens(inputTokens).
+		SetCachedInputTokens(cachedTokens).
 		SetOutputTokens(outputTokens).
 		SetThinkingTokens(thinkingTkns).
 		SetTotalTokens(totalTokens).
@@ -98,34 +109,33 @@ func (s *AILogService) Log(ctx context.Context, req AILogRequest) (*ent.AILog, e
 	if req.ErrorMessage != "" {
 		builder.SetErrorMessage(req.ErrorMessage)
 	}
-
-	// Calculate estimated cost
-	if req.Response != nil {
-		cost := s.estimateCost(req.Response)
-		builder.SetEstimatedCostCents(cost)
+	// Request metadata, including the prompt template name and version
+	if len(req.Request.Metadata) > 0 {
+		metadata := make(map[string]interface{}, len(req.Request.Metadata))
+		for k, v := range req.Request.Metadata {
+			metadata[k] = v
+		}
+		builder.SetMetadata(metadata)
 	}
 
-	return builder.Save(ctx)
-}
-
-// estimateCost calculates cost in cents based on provider and tokens.
-func (s *AILogService) estimateCost(resp *ai.ChatResponse) int {
-	// Pricing per 1M tokens (approximate, in cents)
-	pricing := map[ai.ProviderType]struct{ input, output int }{
-		ai.ProviderOpenAI: {input: 250, output: 1000}, // GPT-4o
-		ai.ProviderClaude: {input: 300, output: 1500}, // Claude 3.5 Sonnet
-		ai.ProviderGemini: {input: 35, output: 105},   // Gemini 1.5 Flash
+	// Calculate the cost, cache hits cost nothing
+	if req.Response != nil && req.Response.Cached {
+		builder.SetCached(true).SetCostMicroCents(0)
+	} else if req.Response != nil {
+		cost, err := s.pricing.Cost(ctx, provider, model, time.Now(), TokenUsage{
+			Input:       inputTokens,
+			CachedInput: cachedTokens,
+			Output:      outputTokens,
+			Thinking:    thinkingTkns,
+		})
+		if err != nil {
+			// The log is worth more than its cost, which can be backfilled
+			slog.Warn("failed to price ai request", "provider", provider, "model", model, "error", err)
+		}
+		builder.SetNillableCostMicroCents(cost)
 	}
 
-	p, ok := pricing[resp.Provider]
-	if !ok {
-		return 0
-	}
-
-	inputCost := (resp.InputTokens * p.input) / 1000000
-	outputCost := (resp.OutputTokens * p.output) / 1000000
-
-	return inputCost + outputCost
+	return builder.Save(ctx)
 }
 
 // GetBySession retrieves all AI logs for a session.
@@ -138,9 +148,52 @@ func (s *AILogService) GetBySession(ctx context.Context, sessionID uuid.UUID) ([
 
 // UsageStats holds aggregated usage statistics.
 type UsageStats struct {
-	TotalTokens  int `json:"total_tokens"`
-	TotalCost    int `json:"total_cost_cents"`
-	RequestCount int `json:"request_count"`
+	TotalTokens         int   `json:"total_tokens"`
+	TotalCostMicroCents int64 `json:"total_cost_micro_cents"`
+	RequestCount        int   `json:"request_count"`
+}
+
+// GetTaskTokensSince returns the total tokens spent on a task type since the given time.
+func (s *AILogService) GetTaskTokensSince(ctx context.Context, taskType string, since time.Time) (int, error) {
+	tokens, err := s.client.AILog.Query().
+		Where(
+			ailog.TaskTypeEQ(taskType),
+			ailog.CreatedAtGTE(since),
+		).
+		Select(ailog.FieldTotalTokens).
+		Ints(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, t := range tokens {
+		total += t
+	}
+	return total, nil
+}
+
+// GetSourceTokensSince returns the total tokens spent since the given time
+// on requests whose "source" metadata is source.
+func (s *AILogService) GetSourceTokensSince(ctx context.Context, source string, since time.Time) (int, error) {
+	tokens, err := s.client.AILog.Query().
+		Where(
+			func(sel *sql.Selector) {
+				sel.Where(sqljson.ValueEQ(ailog.FieldMetadata, source, sqljson.Path("source")))
+			},
+			ailog.CreatedAtGTE(since),
+		).
+		Select(ailog.FieldTotalTokens).
+		Ints(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, t := range tokens {
+		total += t
+	}
+	return total, nil
 }
 
 // GetUsageStats returns token usage statistics for a user.
@@ -155,7 +208,9 @@ func (s *AILogService) GetUsageStats(ctx context.Context, userID uuid.UUID) (*Us
 	stats := &UsageStats{RequestCount: len(logs)}
 	for _, log := range logs {
 		stats.TotalTokens += log.TotalTokens
-		stats.TotalCost += log.EstimatedCostCents
+		if log.CostMicroCents != nil {
+			stats.TotalCostMicroCents += *log.CostMicroCents
+		}
 	}
 
 	return stats, nil
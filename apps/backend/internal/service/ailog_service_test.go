@@ -162,6 +162,62 @@ func TestAILogService_Log_NilResponse(t *testing.T) {
 	assert.Equal(t, "timeout", log.ErrorMessage)
 }
 
+func TestAILogService_Log_Metadata(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewAILogService(client)
+
+	log, err := svc.Log(ctx, AILogRequest{
+		TaskType: ai.TaskSummarize,
+		Request: ai.ChatRequest{
+			UserPrompt: "Summarize",
+			Metadata: map[string]string{
+				"prompt_name":    "summarize",
+				"prompt_version": "3",
+				"url_content_id": "content-1",
+			},
+		},
+		Response: &ai.ChatResponse{
+			Provider:  ai.ProviderGemini,
+			Model:     "gemini-2.0-flash",
+			CreatedAt: time.Now(),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "summarize", log.Metadata["prompt_name"])
+	assert.Equal(t, "3", log.Metadata["prompt_version"])
+	assert.Equal(t, "content-1", log.Metadata["url_content_id"])
+}
+
+func TestAILogService_Log_Cached(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewAILogService(client)
+
+	log, err := svc.Log(ctx, AILogRequest{
+		TaskType: ai.TaskTagExtraction,
+		Request:  ai.ChatRequest{UserPrompt: "Extract tags"},
+		Response: &ai.ChatResponse{
+			Content:   `{"keywords": ["go"]}`,
+			Provider:  ai.ProviderClaude,
+			Model:     ai.DefaultClaudeModel,
+			CreatedAt: time.Now(),
+			Cached:    true,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, log.Cached)
+	require.NotNil(t, log.CostMicroCents)
+	assert.Zero(t, *log.CostMicroCents)
+	assert.Zero(t, log.TotalTokens)
+}
+
 func TestAILogService_GetBySession(t *testing.T) {
 	client := testutil.SetupTestDB(t)
 	defer testutil.CleanupTestDB(t, client)
@@ -273,56 +329,107 @@ func TestAILogService_GetUsageStats(t *testing.T) {
 	assert.Equal(t, 2, stats.RequestCount)
 }
 
-func TestAILogService_estimateCost(t *testing.T) {
-	svc := &AILogService{}
+func TestAILogService_Log_Cost(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewAILogService(client)
+	require.NoError(t, svc.pricing.SeedDefaultPricing(ctx))
 
 	tests := []struct {
 		name     string
 		response *ai.ChatResponse
-		expected int
+		expected *int64
 	}{
 		{
-			name: "OpenAI small request",
+			name: "small request keeps sub-cent precision",
 			response: &ai.ChatResponse{
 				Provider:     ai.ProviderOpenAI,
+				Model:        "gpt-4o-2024-08-06",
 				InputTokens:  1000,
 				OutputTokens: 500,
 			},
-			expected: 0, // Too small to register in cents
+			expected: ptr(int64(750_000)), // $0.0025 + $0.005
 		},
 		{
-			name: "OpenAI large request",
+			name: "cached input and thinking tokens",
 			response: &ai.ChatResponse{
-				Provider:     ai.ProviderOpenAI,
-				InputTokens:  1000000,
-				OutputTokens: 500000,
+				Provider:          ai.ProviderClaude,
+				Model:             ai.DefaultClaudeModel,
+				InputTokens:       1000,
+				CachedInputTokens: 800,
+				OutputTokens:      100,
+				ThinkingTokens:    400,
 			},
-			expected: 750, // 250 + 500
+			expected: ptr(int64(834_000)), // 200 × $3 + 800 × $0.30 + 500 × $15, per 1M
 		},
 		{
-			name: "Gemini request",
+			name: "model prefix picks the closest price",
 			response: &ai.ChatResponse{
-				Provider:     ai.ProviderGemini,
-				InputTokens:  1000000,
-				OutputTokens: 500000,
+				Provider:     ai.ProviderOpenAI,
+				Model:        "gpt-4o-mini",
+				InputTokens:  1000,
+				OutputTokens: 1000,
 			},
-			expected: 87, // 35 + 52 (rounded)
+			expected: ptr(int64(75_000)),
 		},
 		{
-			name: "Unknown provider",
+			name: "model without a price",
 			response: &ai.ChatResponse{
-				Provider:     "unknown",
-				InputTokens:  1000000,
-				OutputTokens: 500000,
+				Provider:     ai.ProviderOpenAICompatible,
+				Model:        "llama3.1:8b",
+				InputTokens:  1000,
+				OutputTokens: 500,
 			},
-			expected: 0,
+			expected: nil,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cost := svc.estimateCost(tt.response)
-			assert.Equal(t, tt.expected, cost)
+			tt.response.CreatedAt = time.Now()
+			log, err := svc.Log(ctx, AILogRequest{
+				TaskType: ai.TaskSummarize,
+				Response: tt.response,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, log.CostMicroCents)
+			assert.Equal(t, tt.response.CachedInputTokens, log.CachedInputTokens)
 		})
 	}
 }
+
+func ptr[T any](v T) *T { return &v }
+
+func TestAILogService_GetSourceTokensSince(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewAILogService(client)
+	source := "test-" + uuid.NewString()
+
+	logSummary := func(metadata map[string]string, tokens int) {
+		_, err := svc.Log(ctx, AILogRequest{
+			TaskType: ai.TaskSummarize,
+			Request:  ai.ChatRequest{UserPrompt: "Summarize", Metadata: metadata},
+			Response: &ai.ChatResponse{
+				Provider:    ai.ProviderGemini,
+				Model:       "gemini-2.0-flash",
+				TotalTokens: tokens,
+				CreatedAt:   time.Now(),
+			},
+		})
+		require.NoError(t, err)
+	}
+	logSummary(map[string]string{"source": source}, 150)
+	logSummary(map[string]string{"source": source, "part": "1/2"}, 50)
+	logSummary(map[string]string{"part": "1/2"}, 400)
+	logSummary(nil, 400)
+
+	tokens, err := svc.GetSourceTokensSince(ctx, source, time.Now().Add(-time.Hour))
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, tokens, "only requests labeled with the source count")
+}
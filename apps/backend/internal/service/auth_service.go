@@ -13,6 +13,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/emailverificationtoken"
 	"github.com/mindhit/api/ent/passwordresettoken"
 	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/internal/infrastructure/metrics"
@@ -28,8 +29,12 @@ var (
 	ErrTokenUsed            = errors.New("token already used")
 	ErrTokenInvalid         = errors.New("invalid token")
 	ErrHardDeleteNotAllowed = errors.New("hard delete only allowed in test environment")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
 )
 
+// emailVerificationTTL is how long an email verification link stays valid.
+const emailVerificationTTL = 24 * time.Hour
+
 // AuthService handles user authentication operations.
 type AuthService struct {
 	client *ent.Client
@@ -252,6 +257,100 @@ func (s *AuthService) ResetPassword(ctx context.Context, token, newPassword stri
 	return tx.Commit()
 }
 
+// RequestEmailVerification creates an email verification token for a user
+// who signed up with email and returns it, invalidating earlier ones.
+// The caller is responsible for sending the email
+func (s *AuthService) RequestEmailVerification(ctx context.Context, userID uuid.UUID) (string, error) {
+	u, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if u.EmailVerifiedAt != nil {
+		return "", ErrEmailAlreadyVerified
+	}
+
+	// Invalidate existing unused tokens
+	_, err = s.client.EmailVerificationToken.
+		Update().
+		Where(
+			emailverificationtoken.UserIDEQ(u.ID),
+			emailverificationtoken.UsedEQ(false),
+		).
+		SetUsed(true).
+		Save(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := generateSecureToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.client.EmailVerificationToken.
+		Create().
+		SetToken(token).
+		SetUserID(u.ID).
+		SetExpiresAt(time.Now().Add(emailVerificationTTL)).
+		Save(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// VerifyEmail validates the token and marks the user's email as verified
+func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
+	verificationToken, err := s.client.EmailVerificationToken.
+		Query().
+		Where(
+			emailverificationtoken.TokenEQ(token),
+			emailverificationtoken.UsedEQ(false),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrTokenInvalid
+		}
+		return err
+	}
+
+	if time.Now().After(verificationToken.ExpiresAt) {
+		return ErrTokenExpired
+	}
+
+	if verificationToken.Edges.User.Status != user.StatusActive {
+		return ErrUserInactive
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.User.
+		UpdateOneID(verificationToken.Edges.User.ID).
+		SetEmailVerifiedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.EmailVerificationToken.
+		UpdateOneID(verificationToken.ID).
+		SetUsed(true).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
 // DeleteUser deletes a user account.
 // If hardDelete is true, the user is permanently removed from the database (only allowed in test environment).
 // Otherwise, the user is soft-deleted (status set to "inactive", deleted_at set).
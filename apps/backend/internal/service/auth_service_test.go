@@ -314,3 +314,79 @@ func TestAuthService_ResetPassword_ExpiredToken(t *testing.T) {
 
 	assert.ErrorIs(t, err, service.ErrTokenExpired)
 }
+
+func TestAuthService_VerifyEmail_Success(t *testing.T) {
+	client, authService := setupAuthServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	email := uniqueAuthEmail("verify-success")
+
+	user, err := authService.Signup(ctx, email, "password123")
+	require.NoError(t, err)
+	assert.Nil(t, user.EmailVerifiedAt)
+
+	token, err := authService.RequestEmailVerification(ctx, user.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+
+	err = authService.VerifyEmail(ctx, token)
+	require.NoError(t, err)
+
+	user, err = authService.GetUserByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, user.EmailVerifiedAt)
+
+	// A token works once
+	err = authService.VerifyEmail(ctx, token)
+	assert.ErrorIs(t, err, service.ErrTokenInvalid)
+
+	// Nothing left to verify
+	_, err = authService.RequestEmailVerification(ctx, user.ID)
+	assert.ErrorIs(t, err, service.ErrEmailAlreadyVerified)
+}
+
+func TestAuthService_RequestEmailVerification_InvalidatesExistingTokens(t *testing.T) {
+	client, authService := setupAuthServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	email := uniqueAuthEmail("verify-resend")
+
+	user, err := authService.Signup(ctx, email, "password123")
+	require.NoError(t, err)
+
+	first, err := authService.RequestEmailVerification(ctx, user.ID)
+	require.NoError(t, err)
+	second, err := authService.RequestEmailVerification(ctx, user.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	err = authService.VerifyEmail(ctx, first)
+	assert.ErrorIs(t, err, service.ErrTokenInvalid)
+
+	err = authService.VerifyEmail(ctx, second)
+	assert.NoError(t, err)
+}
+
+func TestAuthService_VerifyEmail_ExpiredToken(t *testing.T) {
+	client, authService := setupAuthServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	email := uniqueAuthEmail("verify-expired")
+
+	user, err := authService.Signup(ctx, email, "password123")
+	require.NoError(t, err)
+
+	expiredToken, err := client.EmailVerificationToken.Create().
+		SetToken(fmt.Sprintf("expired-%s", uuid.New().String())).
+		SetUserID(user.ID).
+		SetExpiresAt(time.Now().Add(-1 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	err = authService.VerifyEmail(ctx, expiredToken.Token)
+
+	assert.ErrorIs(t, err, service.ErrTokenExpired)
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/user"
+	"github.com/mindhit/api/ent/usersettings"
+	"github.com/mindhit/api/internal/infrastructure/mail"
+	"github.com/mindhit/api/internal/infrastructure/queue"
+)
+
+// emailMaxRetry bounds delivery attempts of a transactional email.
+const emailMaxRetry = 8
+
+// EmailService queues transactional emails. Emails are rendered and sent by
+// the worker, so a slow or failing mail server never blocks a request.
+type EmailService struct {
+	client      *ent.Client
+	queueClient *queue.Client
+	appURL      string
+}
+
+// NewEmailService creates a new EmailService. appURL is the web app base URL
+// used for links in emails. Without a queue client emails are only logged.
+func NewEmailService(client *ent.Client, queueClient *queue.Client, appURL string) *EmailService {
+	return &EmailService{
+		client:      client,
+		queueClient: queueClient,
+		appURL:      strings.TrimRight(appURL, "/"),
+	}
+}
+
+// SendPasswordReset queues the password reset email for a reset token.
+func (s *EmailService) SendPasswordReset(ctx context.Context, email, token string) error {
+	settings := s.settings(ctx, user.EmailEQ(email), user.StatusEQ(user.StatusActive))
+
+	return s.enqueue(email, mail.TemplatePasswordReset, emailLocale(settings), map[string]string{
+		"ResetURL": s.link("/reset-password", url.Values{"token": {token}}),
+	}, asynq.Queue("critical"))
+}
+
+// SendEmailVerification queues the email verification email for a
+// verification token.
+func (s *EmailService) SendEmailVerification(ctx context.Context, u *ent.User, token string) error {
+	settings := s.settings(ctx, user.IDEQ(u.ID))
+
+	return s.enqueue(u.Email, mail.TemplateEmailVerification, emailLocale(settings), map[string]string{
+		"VerifyURL": s.link("/verify-email", url.Values{"token": {token}}),
+	}, asynq.Queue("critical"))
+}
+
+// SendUsageWarning queues a warning that the user has used threshold percent
+// of their token limit in the period starting at periodStart. It is sent once
+// per period and threshold, and not at all to users who turned email
+// notifications off.
+func (s *EmailService) SendUsageWarning(ctx context.Context, userID uuid.UUID, status *LimitStatus, periodStart time.Time, threshold int) error {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	settings := s.settings(ctx, user.IDEQ(userID))
+	if settings != nil && !settings.EmailNotifications {
+		return nil
+	}
+
+	taskID := fmt.Sprintf("%s:%s:%s:%d:%d", queue.TypeEmailSend, mail.TemplateUsageWarning, userID, periodStart.Unix(), threshold)
+	err = s.enqueue(u.Email, mail.TemplateUsageWarning, emailLocale(settings), map[string]string{
+		"Percent":    strconv.Itoa(threshold),
+		"TokensUsed": strconv.Itoa(status.TokensUsed),
+		"TokenLimit": strconv.Itoa(status.TokenLimit),
+		"UsageURL":   s.link("/account", nil),
+	},
+		asynq.TaskID(taskID),
+		// Keep the finished task so the ID still dedupes later crossings
+		asynq.Retention(24*time.Hour),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+func (s *EmailService) enqueue(to, template, locale string, data map[string]string, opts ...asynq.Option) error {
+	if s.queueClient == nil {
+		slog.Warn("email queue not configured, dropping email", "template", template, "to", to)
+		return nil
+	}
+
+	task, err := queue.NewEmailSendTask(to, template, locale, data)
+	if err != nil {
+		return fmt.Errorf("create email task: %w", err)
+	}
+
+	opts = append([]asynq.Option{asynq.MaxRetry(emailMaxRetry)}, opts...)
+	if _, err := s.queueClient.Enqueue(task, opts...); err != nil {
+		return fmt.Errorf("enqueue email task: %w", err)
+	}
+	return nil
+}
+
+// settings returns the settings of the user matching the predicates, or nil
+// when there are none.
+func (s *EmailService) settings(ctx context.Context, preds ...predicate.User) *ent.UserSettings {
+	settings, err := s.client.UserSettings.Query().
+		Where(usersettings.HasUserWith(preds...)).
+		First(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Warn("failed to get user settings for email", "error", err)
+		}
+		return nil
+	}
+	return settings
+}
+
+func (s *EmailService) link(path string, query url.Values) string {
+	if len(query) == 0 {
+		return s.appURL + path
+	}
+	return s.appURL + path + "?" + query.Encode()
+}
+
+// emailLocale returns the preferred language in settings, or the default one.
+func emailLocale(settings *ent.UserSettings) string {
+	if settings == nil || settings.Language == "" {
+		return mail.DefaultLocale
+	}
+	return settings.Language
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/mail"
+)
+
+func TestEmailService_Link(t *testing.T) {
+	s := NewEmailService(nil, nil, "https://mindhit.app/")
+
+	assert.Equal(t, "https://mindhit.app/account", s.link("/account", nil))
+	assert.Equal(t,
+		"https://mindhit.app/reset-password?token=a%2Bb%26c",
+		s.link("/reset-password", map[string][]string{"token": {"a+b&c"}}),
+	)
+}
+
+func TestEmailLocale(t *testing.T) {
+	assert.Equal(t, mail.DefaultLocale, emailLocale(nil))
+	assert.Equal(t, mail.DefaultLocale, emailLocale(&ent.UserSettings{}))
+	assert.Equal(t, "en", emailLocale(&ent.UserSettings{Language: "en"}))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/rawevent"
 	"github.com/mindhit/api/ent/session"
+	enturl "github.com/mindhit/api/ent/url"
 	"github.com/mindhit/api/internal/infrastructure/metrics"
 )
 
@@ -23,6 +24,17 @@ var (
 	ErrSessionNotAcceptingEvents = errors.New("session is not accepting events")
 )
 
+const (
+	// pageVisitMatchTolerance is how far apart two timestamps may be while
+	// still referring to the same page visit (e.g. a duplicate page_visit, or
+	// a visit inferred from a page_leave that arrived first).
+	pageVisitMatchTolerance = 2 * time.Second
+
+	// maxInferredDwell caps the dwell time assigned to visits whose
+	// page_leave event never arrived.
+	maxInferredDwell = 30 * time.Minute
+)
+
 // EventService handles event-related business logic.
 type EventService struct {
 	client     *ent.Client
@@ -69,13 +81,19 @@ func (s *EventService) ProcessBatchEvents(
 		return 0, ErrSessionNotAcceptingEvents
 	}
 
+	// Captured page content is stored per user
+	userID, err := sess.QueryUser().OnlyID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query session user: %w", err)
+	}
+
 	// Record batch size metric
 	metrics.EventBatchSize.Observe(float64(len(events)))
 
 	processed := 0
 
 	for _, event := range events {
-		if err := s.processEvent(ctx, sessionID, event); err != nil {
+		if err := s.processEvent(ctx, sessionID, userID, event); err != nil {
 			// Log error but continue processing
 			continue
 		}
@@ -90,6 +108,7 @@ func (s *EventService) ProcessBatchEvents(
 func (s *EventService) processEvent(
 	ctx context.Context,
 	sessionID uuid.UUID,
+	userID uuid.UUID,
 	event BatchEvent,
 ) error {
 	// Store raw event
@@ -113,7 +132,13 @@ func (s *EventService) processEvent(
 	// Process specific event types
 	switch event.Type {
 	case "page_visit":
-		return s.processPageVisit(ctx, sessionID, event)
+		return s.processPageVisit(ctx, sessionID, userID, event)
+	case "page_leave":
+		return s.processPageLeave(ctx, sessionID, userID, event)
+	case "scroll":
+		return s.processScroll(ctx, sessionID, event)
+	case "click":
+		return s.processClick(ctx, sessionID, event)
 	case "highlight":
 		return s.processHighlight(ctx, sessionID, event)
 	}
@@ -124,16 +149,35 @@ func (s *EventService) processEvent(
 func (s *EventService) processPageVisit(
 	ctx context.Context,
 	sessionID uuid.UUID,
+	userID uuid.UUID,
 	event BatchEvent,
 ) error {
 	if event.URL == "" {
 		return nil
 	}
 
-	// Get or create URL
-	url, err := s.urlService.GetOrCreate(ctx, event.URL, event.Title, event.Content)
+	url, err := s.captureURL(ctx, userID, event)
+	if err != nil {
+		return err
+	}
+
+	enteredAt := time.UnixMilli(event.Timestamp)
+
+	// Skip duplicates and visits already inferred from an earlier page_leave
+	exists, err := s.client.PageVisit.
+		Query().
+		Where(
+			pagevisit.HasSessionWith(session.IDEQ(sessionID)),
+			pagevisit.HasURLWith(enturl.IDEQ(url.ID)),
+			pagevisit.EnteredAtGTE(enteredAt.Add(-pageVisitMatchTolerance)),
+			pagevisit.EnteredAtLTE(enteredAt.Add(pageVisitMatchTolerance)),
+		).
+		Exist(ctx)
 	if err != nil {
-		return fmt.Errorf("get or create url: %w", err)
+		return fmt.Errorf("query page visit: %w", err)
+	}
+	if exists {
+		return nil
 	}
 
 	// Create page visit
@@ -141,7 +185,106 @@ func (s *EventService) processPageVisit(
 		Create().
 		SetSessionID(sessionID).
 		SetURLID(url.ID).
-		SetEnteredAt(time.UnixMilli(event.Timestamp)).
+		SetEnteredAt(enteredAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("create page visit: %w", err)
+	}
+
+	return nil
+}
+
+func (s *EventService) processPageLeave(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+	event BatchEvent,
+) error {
+	if event.URL == "" {
+		return nil
+	}
+
+	leftAt := time.UnixMilli(event.Timestamp)
+	durationMs, _ := payloadFloat(event.Payload, "duration_ms")
+	maxScrollDepth, _ := payloadFloat(event.Payload, "max_scroll_depth")
+	maxScrollDepth = clampScrollDepth(maxScrollDepth)
+
+	visit, err := s.findPageVisit(ctx, sessionID, event.URL, leftAt)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("find page visit: %w", err)
+	}
+	if visit == nil || !leaveCloses(visit, leftAt, int64(durationMs)) {
+		// The page_visit was lost or has not arrived yet, possibly for a
+		// revisit of a page already left: infer the visit from the leave
+		// event so the dwell time is not dropped.
+		return s.createVisitFromLeave(ctx, sessionID, userID, event, leftAt, int64(durationMs), maxScrollDepth)
+	}
+
+	update := s.client.PageVisit.UpdateOne(visit)
+	changed := false
+
+	// Keep the latest leave when the same visit is closed more than once
+	if visit.LeftAt == nil || leftAt.After(*visit.LeftAt) {
+		dwell := int64(durationMs)
+		if dwell <= 0 {
+			dwell = leftAt.Sub(visit.EnteredAt).Milliseconds()
+		}
+		update.SetLeftAt(leftAt).SetDurationMs(int(max(dwell, 0)))
+		changed = true
+	}
+	if maxScrollDepth > visit.MaxScrollDepth {
+		update.SetMaxScrollDepth(maxScrollDepth)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("update page visit: %w", err)
+	}
+
+	return nil
+}
+
+// leaveCloses reports whether a page_leave closes visit: the visit is still
+// open, or the leave is a repeat of the one that closed it, so it entered
+// when the leave says it did.
+func leaveCloses(visit *ent.PageVisit, leftAt time.Time, durationMs int64) bool {
+	if visit.LeftAt == nil {
+		return true
+	}
+	if durationMs <= 0 {
+		return leftAt.Sub(*visit.LeftAt).Abs() <= pageVisitMatchTolerance
+	}
+	enteredAt := leftAt.Add(-time.Duration(durationMs) * time.Millisecond)
+	return enteredAt.Sub(visit.EnteredAt).Abs() <= pageVisitMatchTolerance
+}
+
+func (s *EventService) createVisitFromLeave(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+	event BatchEvent,
+	leftAt time.Time,
+	durationMs int64,
+	maxScrollDepth float64,
+) error {
+	url, err := s.captureURL(ctx, userID, event)
+	if err != nil {
+		return err
+	}
+
+	durationMs = max(durationMs, 0)
+	_, err = s.client.PageVisit.
+		Create().
+		SetSessionID(sessionID).
+		SetURLID(url.ID).
+		SetEnteredAt(leftAt.Add(-time.Duration(durationMs) * time.Millisecond)).
+		SetLeftAt(leftAt).
+		SetDurationMs(int(durationMs)).
+		SetMaxScrollDepth(maxScrollDepth).
 		Save(ctx)
 
 	if err != nil {
@@ -151,6 +294,161 @@ func (s *EventService) processPageVisit(
 	return nil
 }
 
+func (s *EventService) processScroll(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	event BatchEvent,
+) error {
+	if event.URL == "" {
+		return nil
+	}
+
+	depth, ok := payloadFloat(event.Payload, "scroll_depth")
+	if !ok {
+		return nil
+	}
+	depth = clampScrollDepth(depth)
+
+	visit, err := s.findPageVisit(ctx, sessionID, event.URL, time.UnixMilli(event.Timestamp))
+	if ent.IsNotFound(err) {
+		// page_leave carries the final max depth, so nothing is lost here
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("find page visit: %w", err)
+	}
+
+	// Conditional update so concurrent batches can only raise the depth
+	err = s.client.PageVisit.
+		Update().
+		Where(
+			pagevisit.IDEQ(visit.ID),
+			pagevisit.MaxScrollDepthLT(depth),
+		).
+		SetMaxScrollDepth(depth).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update scroll depth: %w", err)
+	}
+
+	return nil
+}
+
+func (s *EventService) processClick(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	event BatchEvent,
+) error {
+	if event.URL == "" {
+		return nil
+	}
+
+	visit, err := s.findPageVisit(ctx, sessionID, event.URL, time.UnixMilli(event.Timestamp))
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("find page visit: %w", err)
+	}
+
+	err = s.client.PageVisit.
+		UpdateOneID(visit.ID).
+		AddClickCount(1).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update click count: %w", err)
+	}
+
+	return nil
+}
+
+// captureURL resolves the canonical URL for an event and stores what was
+// captured from the page in the user's own copy.
+func (s *EventService) captureURL(
+	ctx context.Context,
+	userID uuid.UUID,
+	event BatchEvent,
+) (*ent.URL, error) {
+	url, err := s.urlService.GetOrCreate(ctx, event.URL)
+	if err != nil {
+		return nil, fmt.Errorf("get or create url: %w", err)
+	}
+
+	if _, err := s.urlService.SaveContent(ctx, userID, url.ID, event.Title, event.Content); err != nil {
+		return nil, fmt.Errorf("save url content: %w", err)
+	}
+
+	return url, nil
+}
+
+// findPageVisit returns the most recent visit to rawURL in the session that
+// started at or before the given time.
+func (s *EventService) findPageVisit(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	rawURL string,
+	at time.Time,
+) (*ent.PageVisit, error) {
+	urlHash := hashURL(normalizeURL(rawURL))
+
+	return s.client.PageVisit.
+		Query().
+		Where(
+			pagevisit.HasSessionWith(session.IDEQ(sessionID)),
+			pagevisit.HasURLWith(enturl.URLHashEQ(urlHash)),
+			pagevisit.EnteredAtLTE(at.Add(pageVisitMatchTolerance)),
+		).
+		Order(ent.Desc(pagevisit.FieldEnteredAt)).
+		First(ctx)
+}
+
+// closeOpenPageVisits closes visits whose page_leave never arrived. Each one
+// is assumed to end when the next page in the session was entered (or when
+// the session ended), capped at maxInferredDwell.
+func closeOpenPageVisits(
+	ctx context.Context,
+	client *ent.Client,
+	sessionID uuid.UUID,
+	endedAt time.Time,
+) error {
+	visits, err := client.PageVisit.
+		Query().
+		Where(pagevisit.HasSessionWith(session.IDEQ(sessionID))).
+		Order(ent.Asc(pagevisit.FieldEnteredAt)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query page visits: %w", err)
+	}
+
+	for i, visit := range visits {
+		if visit.LeftAt != nil {
+			continue
+		}
+
+		leftAt := endedAt
+		if i+1 < len(visits) {
+			leftAt = visits[i+1].EnteredAt
+		}
+		if limit := visit.EnteredAt.Add(maxInferredDwell); leftAt.After(limit) {
+			leftAt = limit
+		}
+		if leftAt.Before(visit.EnteredAt) {
+			leftAt = visit.EnteredAt
+		}
+
+		err := client.PageVisit.
+			UpdateOne(visit).
+			SetLeftAt(leftAt).
+			SetDurationMs(int(leftAt.Sub(visit.EnteredAt).Milliseconds())).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("close page visit: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (s *EventService) processHighlight(
 	ctx context.Context,
 	sessionID uuid.UUID,
@@ -201,6 +499,7 @@ func (s *EventService) ProcessBatchEventsFromJSON(
 			URL:       value.Get("url").String(),
 			Title:     value.Get("title").String(),
 			Content:   value.Get("content").String(),
+			Payload:   payloadFromJSON(value.Get("payload")),
 		})
 		return true
 	})
@@ -306,6 +605,36 @@ func (s *EventService) GetEventStats(
 	}, nil
 }
 
+func payloadFromJSON(value gjson.Result) map[string]interface{} {
+	if !value.IsObject() {
+		return nil
+	}
+	payload, _ := value.Value().(map[string]interface{})
+	return payload
+}
+
+// payloadFloat reads a numeric payload value regardless of how it was decoded.
+func payloadFloat(payload map[string]interface{}, key string) (float64, bool) {
+	switch v := payload[key].(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func clampScrollDepth(depth float64) float64 {
+	return min(max(depth, 0), 1)
+}
+
 func toJSON(v interface{}) (string, error) {
 	bytes, err := json.Marshal(v)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/internal/service"
 	"github.com/mindhit/api/internal/testutil"
 )
@@ -164,6 +165,177 @@ func TestEventService_ProcessBatchEvents_PausedSessionAcceptsEvents(t *testing.T
 	assert.Equal(t, 1, processed)
 }
 
+// ==================== Engagement Event Tests ====================
+
+func TestEventService_ProcessBatchEvents_PageLeaveUpdatesVisit(t *testing.T) {
+	client, eventService, _, sessionService, authService := setupEventServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("page-leave"))
+	sess, err := sessionService.Start(ctx, user.ID)
+	require.NoError(t, err)
+
+	enteredAt := time.Now().Add(-time.Minute).UnixMilli()
+	events := []service.BatchEvent{
+		{Type: "page_visit", Timestamp: enteredAt, URL: "https://example.com/leave", Title: "Leave"},
+		{Type: "scroll", Timestamp: enteredAt + 1000, URL: "https://example.com/leave", Payload: map[string]interface{}{"scroll_depth": 0.4}},
+		{Type: "click", Timestamp: enteredAt + 2000, URL: "https://example.com/leave"},
+		{Type: "click", Timestamp: enteredAt + 3000, URL: "https://example.com/leave"},
+		{
+			Type:      "page_leave",
+			Timestamp: enteredAt + 45000,
+			URL:       "https://example.com/leave",
+			Payload:   map[string]interface{}{"duration_ms": float64(45000), "max_scroll_depth": 0.8},
+		},
+	}
+
+	processed, err := eventService.ProcessBatchEvents(ctx, sess.ID, events)
+	require.NoError(t, err)
+	assert.Equal(t, 5, processed)
+
+	visits, err := sess.QueryPageVisits().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, visits, 1)
+	require.NotNil(t, visits[0].LeftAt)
+	require.NotNil(t, visits[0].DurationMs)
+	assert.Equal(t, 45000, *visits[0].DurationMs)
+	assert.InDelta(t, 0.8, visits[0].MaxScrollDepth, 0.0001)
+	assert.Equal(t, 2, visits[0].ClickCount)
+}
+
+func TestEventService_ProcessBatchEvents_PageLeaveBeforeVisit(t *testing.T) {
+	client, eventService, _, sessionService, authService := setupEventServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("leave-first"))
+	sess, err := sessionService.Start(ctx, user.ID)
+	require.NoError(t, err)
+
+	enteredAt := time.Now().Add(-time.Minute).UnixMilli()
+
+	// Leave arrives in an earlier batch than the visit it closes
+	_, err = eventService.ProcessBatchEvents(ctx, sess.ID, []service.BatchEvent{
+		{
+			Type:      "page_leave",
+			Timestamp: enteredAt + 30000,
+			URL:       "https://example.com/out-of-order",
+			Payload:   map[string]interface{}{"duration_ms": float64(30000), "max_scroll_depth": 0.5},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = eventService.ProcessBatchEvents(ctx, sess.ID, []service.BatchEvent{
+		{Type: "page_visit", Timestamp: enteredAt, URL: "https://example.com/out-of-order"},
+	})
+	require.NoError(t, err)
+
+	visits, err := sess.QueryPageVisits().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, visits, 1)
+	require.NotNil(t, visits[0].DurationMs)
+	assert.Equal(t, 30000, *visits[0].DurationMs)
+	assert.InDelta(t, 0.5, visits[0].MaxScrollDepth, 0.0001)
+}
+
+func TestEventService_ProcessBatchEvents_RevisitLeaveBeforeVisit(t *testing.T) {
+	client, eventService, _, sessionService, authService := setupEventServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("revisit"))
+	sess, err := sessionService.Start(ctx, user.ID)
+	require.NoError(t, err)
+
+	firstAt := time.Now().Add(-2 * time.Minute).UnixMilli()
+	secondAt := firstAt + 60000
+	_, err = eventService.ProcessBatchEvents(ctx, sess.ID, []service.BatchEvent{
+		{Type: "page_visit", Timestamp: firstAt, URL: "https://example.com/revisit"},
+		{
+			Type:      "page_leave",
+			Timestamp: firstAt + 10000,
+			URL:       "https://example.com/revisit",
+			Payload:   map[string]interface{}{"duration_ms": float64(10000)},
+		},
+		// The second visit's page_leave arrives before its page_visit
+		{
+			Type:      "page_leave",
+			Timestamp: secondAt + 20000,
+			URL:       "https://example.com/revisit",
+			Payload:   map[string]interface{}{"duration_ms": float64(20000)},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = eventService.ProcessBatchEvents(ctx, sess.ID, []service.BatchEvent{
+		{Type: "page_visit", Timestamp: secondAt, URL: "https://example.com/revisit"},
+	})
+	require.NoError(t, err)
+
+	visits, err := sess.QueryPageVisits().Order(ent.Asc(pagevisit.FieldEnteredAt)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, visits, 2)
+	require.NotNil(t, visits[0].DurationMs)
+	assert.Equal(t, 10000, *visits[0].DurationMs, "the first visit keeps its own leave")
+	require.NotNil(t, visits[1].DurationMs)
+	assert.Equal(t, 20000, *visits[1].DurationMs)
+	assert.Equal(t, secondAt, visits[1].EnteredAt.UnixMilli())
+}
+
+func TestEventService_ProcessBatchEventsFromJSON_KeepsPayload(t *testing.T) {
+	client, eventService, _, sessionService, authService := setupEventServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("json-payload"))
+	sess, err := sessionService.Start(ctx, user.ID)
+	require.NoError(t, err)
+
+	now := time.Now().UnixMilli()
+	jsonData := fmt.Sprintf(`{"events":[
+		{"type":"page_visit","timestamp":%d,"url":"https://example.com/json"},
+		{"type":"page_leave","timestamp":%d,"url":"https://example.com/json","payload":{"duration_ms":5000,"max_scroll_depth":0.3}}
+	]}`, now-5000, now)
+
+	processed, err := eventService.ProcessBatchEventsFromJSON(ctx, sess.ID, jsonData)
+	require.NoError(t, err)
+	assert.Equal(t, 2, processed)
+
+	visits, err := sess.QueryPageVisits().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, visits, 1)
+	require.NotNil(t, visits[0].DurationMs)
+	assert.Equal(t, 5000, *visits[0].DurationMs)
+}
+
+func TestSessionService_Stop_ClosesOpenPageVisits(t *testing.T) {
+	client, eventService, _, sessionService, authService := setupEventServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("missing-leave"))
+	sess, err := sessionService.Start(ctx, user.ID)
+	require.NoError(t, err)
+
+	enteredAt := time.Now().Add(-2 * time.Minute).UnixMilli()
+	_, err = eventService.ProcessBatchEvents(ctx, sess.ID, []service.BatchEvent{
+		{Type: "page_visit", Timestamp: enteredAt, URL: "https://example.com/first"},
+		{Type: "page_visit", Timestamp: enteredAt + 20000, URL: "https://example.com/second"},
+	})
+	require.NoError(t, err)
+
+	_, err = sessionService.Stop(ctx, sess.ID, user.ID)
+	require.NoError(t, err)
+
+	first, err := sess.QueryPageVisits().
+		Where(pagevisit.EnteredAtEQ(time.UnixMilli(enteredAt))).
+		Only(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, first.DurationMs)
+	assert.Equal(t, 20000, *first.DurationMs)
+}
+
 // ==================== GetEventsBySession Tests ====================
 
 func TestEventService_GetEventsBySession_Success(t *testing.T) {
@@ -299,6 +471,42 @@ func TestEventService_GetEventStats_EmptySession(t *testing.T) {
 	assert.Equal(t, 0, stats["unique_urls"])
 }
 
+func TestEventService_ProcessBatchEvents_ContentIsPerUser(t *testing.T) {
+	client, eventService, urlService, sessionService, authService := setupEventServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	pageURL := uniqueURL("shared")
+	alice := createTestUser(t, authService, uniqueEmail("content-alice"))
+	bob := createTestUser(t, authService, uniqueEmail("content-bob"))
+
+	capture := func(userID uuid.UUID, content string) {
+		sess, err := sessionService.Start(ctx, userID)
+		require.NoError(t, err)
+		_, err = eventService.ProcessBatchEvents(ctx, sess.ID, []service.BatchEvent{{
+			Type:      "page_visit",
+			Timestamp: time.Now().UnixMilli(),
+			URL:       pageURL,
+			Title:     "Inbox",
+			Content:   content,
+		}})
+		require.NoError(t, err)
+	}
+	capture(alice.ID, "alice's private mail")
+	capture(bob.ID, "bob's private mail")
+
+	url, err := urlService.GetOrCreate(ctx, pageURL)
+	require.NoError(t, err)
+
+	aliceContent, err := urlService.GetContent(ctx, alice.ID, url.ID)
+	require.NoError(t, err)
+	bobContent, err := urlService.GetContent(ctx, bob.ID, url.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice's private mail", aliceContent.Content)
+	assert.Equal(t, "bob's private mail", bobContent.Content)
+}
+
 // ==================== URL Service Tests ====================
 
 // uniqueURL generates a unique URL for test isolation
@@ -313,13 +521,11 @@ func TestURLService_GetOrCreate_NewURL(t *testing.T) {
 	ctx := context.Background()
 	testURL := uniqueURL("new")
 
-	url, err := urlService.GetOrCreate(ctx, testURL, "Test Page", "Page content")
+	url, err := urlService.GetOrCreate(ctx, testURL)
 
 	require.NoError(t, err)
 	assert.NotNil(t, url)
 	assert.Equal(t, testURL, url.URL)
-	assert.Equal(t, "Test Page", url.Title)
-	assert.Equal(t, "Page content", url.Content)
 }
 
 func TestURLService_GetOrCreate_ExistingURL(t *testing.T) {
@@ -330,33 +536,14 @@ func TestURLService_GetOrCreate_ExistingURL(t *testing.T) {
 	testURL := uniqueURL("existing")
 
 	// Create first
-	url1, err := urlService.GetOrCreate(ctx, testURL, "Title 1", "")
+	url1, err := urlService.GetOrCreate(ctx, testURL)
 	require.NoError(t, err)
 
 	// Get existing
-	url2, err := urlService.GetOrCreate(ctx, testURL, "Title 2", "")
+	url2, err := urlService.GetOrCreate(ctx, testURL)
 	require.NoError(t, err)
 
 	assert.Equal(t, url1.ID, url2.ID)
-	assert.Equal(t, "Title 1", url2.Title) // Original title preserved
-}
-
-func TestURLService_GetOrCreate_UpdatesContent(t *testing.T) {
-	client, _, urlService, _, _ := setupEventServiceTest(t)
-	defer testutil.CleanupTestDB(t, client)
-
-	ctx := context.Background()
-	testURL := uniqueURL("content")
-
-	// Create without content
-	_, err := urlService.GetOrCreate(ctx, testURL, "Title", "")
-	require.NoError(t, err)
-
-	// Get with content - should update
-	url, err := urlService.GetOrCreate(ctx, testURL, "Title", "New content")
-	require.NoError(t, err)
-
-	assert.Equal(t, "New content", url.Content)
 }
 
 func TestURLService_GetOrCreate_NormalizesURL(t *testing.T) {
@@ -367,11 +554,11 @@ func TestURLService_GetOrCreate_NormalizesURL(t *testing.T) {
 	basePath := uuid.New().String()[:8]
 
 	// Create with trailing slash
-	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/%s/", basePath), "Title", "")
+	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/%s/", basePath))
 	require.NoError(t, err)
 
 	// Get without trailing slash - should match
-	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/%s", basePath), "Title", "")
+	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/%s", basePath))
 	require.NoError(t, err)
 
 	assert.Equal(t, url1.ID, url2.ID)
@@ -385,11 +572,11 @@ func TestURLService_GetOrCreate_CaseInsensitive(t *testing.T) {
 	basePath := uuid.New().String()[:8]
 
 	// Create with uppercase
-	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("HTTPS://EXAMPLE.COM/%s", basePath), "Title", "")
+	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("HTTPS://EXAMPLE.COM/%s", basePath))
 	require.NoError(t, err)
 
 	// Get with lowercase - should match
-	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/%s", basePath), "Title", "")
+	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/%s", basePath))
 	require.NoError(t, err)
 
 	assert.Equal(t, url1.ID, url2.ID)
@@ -1,7 +1,10 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"time"
 
@@ -16,20 +19,46 @@ type TokenType string
 const (
 	AccessToken  TokenType = "access"
 	RefreshToken TokenType = "refresh"
+	StreamTicket TokenType = "stream"
 )
 
+// streamTicketExpiration is how long a stream ticket can open a stream.
+const streamTicketExpiration = time.Minute
+
+// revocationCheckTimeout bounds the revocation list lookup done on every
+// access token validation.
+const revocationCheckTimeout = 200 * time.Millisecond
+
+// ErrTokenRevoked is returned for access tokens whose login was revoked.
+var ErrTokenRevoked = errors.New("token has been revoked")
+
+// TokenRevocationList records revoked token families and users until their
+// access tokens have expired.
+type TokenRevocationList interface {
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, ttl time.Duration) error
+	RevokeUser(ctx context.Context, userID uuid.UUID, before time.Time, ttl time.Duration) error
+	IsRevoked(ctx context.Context, userID, familyID uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
 // JWTService handles JWT token generation and validation.
 type JWTService struct {
 	secret            []byte
 	accessExpiration  time.Duration
 	refreshExpiration time.Duration
 	isDev             bool
+	revocations       TokenRevocationList
 }
 
 // Claims represents the JWT token claims.
 type Claims struct {
 	UserID    uuid.UUID `json:"user_id"`
 	TokenType TokenType `json:"token_type"`
+	FamilyID  uuid.UUID `json:"fid"` // login the token belongs to
+	// IssuedAtMs is when the token was issued in Unix milliseconds. iat only
+	// has seconds, which can't tell a login from a revocation in the same
+	// second.
+	IssuedAtMs int64      `json:"iat_ms,omitempty"`
+	SessionID  *uuid.UUID `json:"sid,omitempty"` // session a stream ticket opens
 	jwt.RegisteredClaims
 }
 
@@ -38,6 +67,10 @@ type TokenPair struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 	ExpiresIn    int64  `json:"expires_in"` // Access token expiry in seconds
+
+	FamilyID         uuid.UUID `json:"-"`
+	RefreshTokenID   uuid.UUID `json:"-"`
+	RefreshExpiresAt time.Time `json:"-"`
 }
 
 // NewJWTService creates a new JWTService with the given secret.
@@ -51,39 +84,84 @@ func NewJWTService(secret string) *JWTService {
 	}
 }
 
-// GenerateTokenPair creates both access and refresh tokens
+// SetRevocationList makes access token validation reject tokens revoked in
+// list.
+func (s *JWTService) SetRevocationList(list TokenRevocationList) {
+	s.revocations = list
+}
+
+// AccessTokenTTL returns how long access tokens are valid.
+func (s *JWTService) AccessTokenTTL() time.Duration {
+	return s.accessExpiration
+}
+
+// GenerateTokenPair creates both access and refresh tokens for a new login.
 func (s *JWTService) GenerateTokenPair(userID uuid.UUID) (*TokenPair, error) {
-	accessToken, err := s.generateToken(userID, AccessToken, s.accessExpiration)
+	return s.GenerateTokenPairInFamily(userID, uuid.New())
+}
+
+// GenerateTokenPairInFamily creates both access and refresh tokens for an
+// existing login, as when rotating a refresh token.
+func (s *JWTService) GenerateTokenPairInFamily(userID, familyID uuid.UUID) (*TokenPair, error) {
+	accessToken, _, err := s.generateToken(userID, familyID, AccessToken, s.accessExpiration)
 	if err != nil {
 		return nil, fmt.Errorf("generate access token: %w", err)
 	}
 
-	refreshToken, err := s.generateToken(userID, RefreshToken, s.refreshExpiration)
+	refreshToken, refreshClaims, err := s.generateToken(userID, familyID, RefreshToken, s.refreshExpiration)
 	if err != nil {
 		return nil, fmt.Errorf("generate refresh token: %w", err)
 	}
 
 	return &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    int64(s.accessExpiration.Seconds()),
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresIn:        int64(s.accessExpiration.Seconds()),
+		FamilyID:         familyID,
+		RefreshTokenID:   uuid.MustParse(refreshClaims.ID),
+		RefreshExpiresAt: refreshClaims.ExpiresAt.Time,
 	}, nil
 }
 
 // GenerateAccessToken creates only access token (for refresh)
 func (s *JWTService) GenerateAccessToken(userID uuid.UUID) (string, int64, error) {
-	token, err := s.generateToken(userID, AccessToken, s.accessExpiration)
+	token, _, err := s.generateToken(userID, uuid.Nil, AccessToken, s.accessExpiration)
 	if err != nil {
 		return "", 0, err
 	}
 	return token, int64(s.accessExpiration.Seconds()), nil
 }
 
-func (s *JWTService) generateToken(userID uuid.UUID, tokenType TokenType, expiration time.Duration) (string, error) {
+func (s *JWTService) generateToken(userID, familyID uuid.UUID, tokenType TokenType, expiration time.Duration) (string, *Claims, error) {
+	claims := newClaims(userID, familyID, tokenType, expiration)
+	signed, err := s.sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return signed, claims, nil
+}
+
+// GenerateStreamTicket creates a short-lived ticket that only opens the
+// progress stream of one session, for clients such as EventSource that
+// can't send headers. The ticket belongs to the login of access, the claims
+// of the access token it is issued for.
+func (s *JWTService) GenerateStreamTicket(access *Claims, sessionID uuid.UUID) (string, int64, error) {
+	claims := newClaims(access.UserID, access.FamilyID, StreamTicket, streamTicketExpiration)
+	claims.SessionID = &sessionID
+	ticket, err := s.sign(claims)
+	if err != nil {
+		return "", 0, err
+	}
+	return ticket, int64(streamTicketExpiration.Seconds()), nil
+}
+
+func newClaims(userID, familyID uuid.UUID, tokenType TokenType, expiration time.Duration) *Claims {
 	now := time.Now()
-	claims := Claims{
-		UserID:    userID,
-		TokenType: tokenType,
+	return &Claims{
+		UserID:     userID,
+		TokenType:  tokenType,
+		FamilyID:   familyID,
+		IssuedAtMs: now.UnixMilli(),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        uuid.New().String(), // jti: unique token identifier
 			ExpiresAt: jwt.NewNumericDate(now.Add(expiration)),
@@ -92,9 +170,10 @@ func (s *JWTService) generateToken(userID uuid.UUID, tokenType TokenType, expira
 			Subject:   userID.String(),
 		},
 	}
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secret)
+func (s *JWTService) sign(claims *Claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
 }
 
 // ValidateToken validates any token type
@@ -152,9 +231,61 @@ func (s *JWTService) ValidateAccessToken(tokenString string) (*Claims, error) {
 		return nil, fmt.Errorf("invalid token type: expected access token")
 	}
 
+	if s.isRevoked(claims) {
+		return nil, ErrTokenRevoked
+	}
+
 	return claims, nil
 }
 
+// ValidateStreamTicket validates a stream ticket for the progress stream of
+// sessionID. Tickets are revoked together with their login.
+func (s *JWTService) ValidateStreamTicket(ticket string, sessionID uuid.UUID) (*Claims, error) {
+	claims, err := s.ValidateToken(ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != StreamTicket {
+		return nil, fmt.Errorf("invalid token type: expected stream ticket")
+	}
+	if claims.SessionID == nil || *claims.SessionID != sessionID {
+		return nil, fmt.Errorf("stream ticket is for another session")
+	}
+
+	if s.isRevoked(claims) {
+		return nil, ErrTokenRevoked
+	}
+
+	return claims, nil
+}
+
+// isRevoked checks the revocation list. Lookup failures are logged and let
+// the token through: refresh tokens are still checked against the database,
+// so an outage only extends a revoked login to the access token's lifetime.
+func (s *JWTService) isRevoked(claims *Claims) bool {
+	if s.revocations == nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), revocationCheckTimeout)
+	defer cancel()
+
+	var issuedAt time.Time
+	switch {
+	case claims.IssuedAtMs != 0:
+		issuedAt = time.UnixMilli(claims.IssuedAtMs)
+	case claims.IssuedAt != nil:
+		issuedAt = claims.IssuedAt.Time
+	}
+	revoked, err := s.revocations.IsRevoked(ctx, claims.UserID, claims.FamilyID, issuedAt)
+	if err != nil {
+		slog.Warn("failed to check token revocation", "error", err, "user_id", claims.UserID)
+		return false
+	}
+	return revoked
+}
+
 // IsTestToken checks if the token is a test token (for middleware use)
 func (s *JWTService) IsTestToken(tokenString string) bool {
 	if !s.isDev {
@@ -1,6 +1,8 @@
 package service_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -171,3 +173,100 @@ func TestJWTService_TokensAreUniqueEvenInSameSecond(t *testing.T) {
 	assert.NotEqual(t, claims2.ID, claims3.ID, "jti should be unique")
 	assert.NotEqual(t, claims1.ID, claims3.ID, "jti should be unique")
 }
+
+// fakeRevocationList is an in-memory TokenRevocationList.
+type fakeRevocationList struct {
+	families map[uuid.UUID]bool
+	users    map[uuid.UUID]time.Time
+	err      error
+}
+
+func (f *fakeRevocationList) RevokeFamily(_ context.Context, familyID uuid.UUID, _ time.Duration) error {
+	f.families[familyID] = true
+	return nil
+}
+
+func (f *fakeRevocationList) RevokeUser(_ context.Context, userID uuid.UUID, before time.Time, _ time.Duration) error {
+	f.users[userID] = before
+	return nil
+}
+
+func (f *fakeRevocationList) IsRevoked(_ context.Context, userID, familyID uuid.UUID, issuedAt time.Time) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	before, ok := f.users[userID]
+	return f.families[familyID] || (ok && !issuedAt.After(before)), nil
+}
+
+func TestJWTService_ValidateAccessToken_Revoked(t *testing.T) {
+	jwtService := service.NewJWTService("test-secret-key")
+	list := &fakeRevocationList{families: map[uuid.UUID]bool{}, users: map[uuid.UUID]time.Time{}}
+	jwtService.SetRevocationList(list)
+	userID := uuid.New()
+
+	revoked, err := jwtService.GenerateTokenPair(userID)
+	require.NoError(t, err)
+	other, err := jwtService.GenerateTokenPair(userID)
+	require.NoError(t, err)
+	require.NoError(t, list.RevokeFamily(context.Background(), revoked.FamilyID, time.Minute))
+
+	_, err = jwtService.ValidateAccessToken(revoked.AccessToken)
+	assert.ErrorIs(t, err, service.ErrTokenRevoked)
+
+	_, err = jwtService.ValidateAccessToken(other.AccessToken)
+	assert.NoError(t, err, "other logins stay valid")
+
+	require.NoError(t, list.RevokeUser(context.Background(), userID, time.Now(), time.Minute))
+	_, err = jwtService.ValidateAccessToken(other.AccessToken)
+	assert.ErrorIs(t, err, service.ErrTokenRevoked)
+
+	time.Sleep(2 * time.Millisecond)
+	relogin, err := jwtService.GenerateTokenPair(userID)
+	require.NoError(t, err)
+	_, err = jwtService.ValidateAccessToken(relogin.AccessToken)
+	assert.NoError(t, err, "a login right after the revocation is valid")
+}
+
+func TestJWTService_StreamTicket(t *testing.T) {
+	jwtService := service.NewJWTService("test-secret-key")
+	list := &fakeRevocationList{families: map[uuid.UUID]bool{}, users: map[uuid.UUID]time.Time{}}
+	jwtService.SetRevocationList(list)
+	userID, sessionID := uuid.New(), uuid.New()
+
+	tokenPair, err := jwtService.GenerateTokenPair(userID)
+	require.NoError(t, err)
+	access, err := jwtService.ValidateAccessToken(tokenPair.AccessToken)
+	require.NoError(t, err)
+
+	ticket, expiresIn, err := jwtService.GenerateStreamTicket(access, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), expiresIn)
+
+	claims, err := jwtService.ValidateStreamTicket(ticket, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, userID, claims.UserID)
+
+	_, err = jwtService.ValidateStreamTicket(ticket, uuid.New())
+	assert.Error(t, err, "tickets open one session's stream")
+	_, err = jwtService.ValidateAccessToken(ticket)
+	assert.Error(t, err, "tickets are not access tokens")
+	_, err = jwtService.ValidateStreamTicket(tokenPair.AccessToken, sessionID)
+	assert.Error(t, err, "access tokens are not tickets")
+
+	require.NoError(t, list.RevokeFamily(context.Background(), tokenPair.FamilyID, time.Minute))
+	_, err = jwtService.ValidateStreamTicket(ticket, sessionID)
+	assert.ErrorIs(t, err, service.ErrTokenRevoked)
+}
+
+func TestJWTService_ValidateAccessToken_RevocationListUnavailable(t *testing.T) {
+	jwtService := service.NewJWTService("test-secret-key")
+	jwtService.SetRevocationList(&fakeRevocationList{err: errors.New("connection refused")})
+
+	tokenPair, err := jwtService.GenerateTokenPair(uuid.New())
+	require.NoError(t, err)
+
+	_, err = jwtService.ValidateAccessToken(tokenPair.AccessToken)
+
+	assert.NoError(t, err, "lookup failures let the token through")
+}
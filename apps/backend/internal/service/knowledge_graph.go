@@ -0,0 +1,579 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/session"
+	enturl "github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
+)
+
+// Knowledge graph query limits.
+const (
+	DefaultKnowledgeTopicLimit = 50
+	MaxKnowledgeTopicLimit     = 200
+	DefaultNeighborhoodLimit   = 20
+	MaxNeighborhoodLimit       = 100
+	MaxNeighborhoodDepth       = 2
+)
+
+// KnowledgeTopicInfo is a knowledge topic with how often the user's research
+// came back to it.
+type KnowledgeTopicInfo struct {
+	Topic    *ent.KnowledgeTopic
+	Sessions int // sessions that mention the topic
+	URLs     int // distinct pages under the topic
+}
+
+// KnowledgeContribution is what a session contributed to a knowledge topic.
+type KnowledgeContribution struct {
+	Session *ent.Session
+	// Labels are the labels of the session's topic nodes merged into the
+	// topic.
+	Labels   []string
+	Keywords []string
+	URLs     []KnowledgeURL
+	// NewURLs counts the pages the session was the first to bring to the
+	// topic.
+	NewURLs int
+}
+
+// KnowledgeURL is a page of the knowledge graph.
+type KnowledgeURL struct {
+	ID    uuid.UUID
+	URL   string
+	Title string // title of the user's copy of the page, if any
+	// TopicIDs are the topics the page is under, in a neighborhood.
+	TopicIDs []uuid.UUID
+	Sessions int // sessions the page came up in under those topics
+}
+
+// KnowledgeNeighbor is a topic of a neighborhood, Depth links away from its
+// center.
+type KnowledgeNeighbor struct {
+	KnowledgeTopicInfo
+	Depth int
+}
+
+// KnowledgeLink relates two knowledge topics by the sessions, pages and
+// keywords they share.
+type KnowledgeLink struct {
+	Source   uuid.UUID
+	Target   uuid.UUID
+	Sessions int
+	URLs     int
+	Keywords []string
+	// Weight is the number of shared sessions and pages, plus half the
+	// number of shared keywords.
+	Weight float64
+}
+
+// KnowledgeNeighborhood is everything related to a knowledge topic: the
+// topics linked to it, up to a depth, the links among them all and the
+// pages that recur across them.
+type KnowledgeNeighborhood struct {
+	Center    KnowledgeTopicInfo
+	Neighbors []KnowledgeNeighbor
+	Links     []KnowledgeLink
+	URLs      []KnowledgeURL
+}
+
+// knowledgeTopicQuery queries the user's topics with their mentions in
+// sessions that weren't deleted, and the sessions and pages of those.
+func (s *KnowledgeService) knowledgeTopicQuery(userID uuid.UUID) *ent.KnowledgeTopicQuery {
+	return s.client.KnowledgeTopic.Query().
+		Where(knowledgetopic.HasUserWith(user.ID(userID))).
+		WithMentions(func(q *ent.KnowledgeMentionQuery) {
+			q.Where(knowledgeMentionActive()).
+				WithSession().
+				WithUrls().
+				Order(ent.Asc(knowledgemention.FieldSeenAt), ent.Asc(knowledgemention.FieldCreatedAt))
+		})
+}
+
+// getTopic returns one of the user's topics, loaded as knowledgeTopicQuery
+// loads them.
+func (s *KnowledgeService) getTopic(ctx context.Context, userID, topicID uuid.UUID) (*ent.KnowledgeTopic, error) {
+	topic, err := s.knowledgeTopicQuery(userID).
+		Where(knowledgetopic.ID(topicID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrKnowledgeTopicNotFound
+		}
+		return nil, err
+	}
+	if len(topic.Edges.Mentions) == 0 {
+		return nil, ErrKnowledgeTopicNotFound
+	}
+	return topic, nil
+}
+
+func knowledgeTopicInfo(t *ent.KnowledgeTopic) KnowledgeTopicInfo {
+	sessions := make(map[uuid.UUID]bool)
+	urls := make(map[uuid.UUID]bool)
+	for _, m := range t.Edges.Mentions {
+		sessions[m.Edges.Session.ID] = true
+		for _, u := range m.Edges.Urls {
+			urls[u.ID] = true
+		}
+	}
+	return KnowledgeTopicInfo{Topic: t, Sessions: len(sessions), URLs: len(urls)}
+}
+
+// ListTopics returns the user's knowledge topics, the most recently seen
+// first. With a query, it returns the topics whose labels or keywords
+// contain it, the ones whose own label does first.
+func (s *KnowledgeService) ListTopics(ctx context.Context, userID uuid.UUID, query string, limit int) ([]KnowledgeTopicInfo, error) {
+	if limit <= 0 {
+		limit = DefaultKnowledgeTopicLimit
+	}
+	limit = min(limit, MaxKnowledgeTopicLimit)
+
+	page := func(limit int, where ...predicate.KnowledgeTopic) ([]*ent.KnowledgeTopic, error) {
+		topics, err := s.knowledgeTopicQuery(userID).
+			Where(knowledgetopic.HasMentionsWith(knowledgeMentionActive())).
+			Where(where...).
+			Order(ent.Desc(knowledgetopic.FieldLastSeenAt), ent.Asc(knowledgetopic.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query topics: %w", err)
+		}
+		return topics, nil
+	}
+	if query == "" {
+		topics, err := page(limit)
+		if err != nil {
+			return nil, err
+		}
+		return knowledgeTopicInfos(topics), nil
+	}
+
+	labeled := knowledgetopic.LabelContainsFold(query)
+	topics, err := page(limit, labeled)
+	if err != nil {
+		return nil, err
+	}
+	if len(topics) < limit {
+		related, err := page(limit-len(topics),
+			knowledgetopic.Not(labeled),
+			knowledgetopic.Or(
+				knowledgetopic.HasMentionsWith(knowledgeMentionActive(), knowledgemention.LabelContainsFold(query)),
+				keywordsContainFold(query),
+			),
+		)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, related...)
+	}
+	return knowledgeTopicInfos(topics), nil
+}
+
+func knowledgeTopicInfos(topics []*ent.KnowledgeTopic) []KnowledgeTopicInfo {
+	result := make([]KnowledgeTopicInfo, len(topics))
+	for i, t := range topics {
+		result[i] = knowledgeTopicInfo(t)
+	}
+	return result
+}
+
+// knowledgeMentionActive matches the mentions of sessions that weren't
+// deleted.
+func knowledgeMentionActive() predicate.KnowledgeMention {
+	return knowledgemention.HasSessionWith(session.StatusNEQ(session.Status(sessionStatusInactive)))
+}
+
+// keywordsContainFold matches the topics with a keyword that contains sub,
+// case insensitively.
+func keywordsContainFold(sub string) predicate.KnowledgeTopic {
+	pattern := "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(sub) + "%"
+	return predicate.KnowledgeTopic(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("EXISTS (SELECT 1 FROM jsonb_array_elements_text(").
+				Ident(s.C(knowledgetopic.FieldKeywords)).
+				WriteString(") AS keyword WHERE keyword ILIKE ").
+				Arg(pattern).
+				WriteString(")")
+		}))
+	})
+}
+
+// GetTopic returns one of the user's knowledge topics with the sessions
+// that contributed to it, the latest first.
+func (s *KnowledgeService) GetTopic(ctx context.Context, userID, topicID uuid.UUID) (*KnowledgeTopicInfo, []KnowledgeContribution, error) {
+	topic, err := s.getTopic(ctx, userID, topicID)
+	if err != nil {
+		return nil, nil, err
+	}
+	contributions, err := s.contributions(ctx, userID, topic)
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Reverse(contributions)
+	info := knowledgeTopicInfo(topic)
+	return &info, contributions, nil
+}
+
+// Timeline returns how one of the user's knowledge topics came up over
+// time: what each session that mentions it called it and the pages it
+// brought, the earliest first.
+func (s *KnowledgeService) Timeline(ctx context.Context, userID, topicID uuid.UUID) (*KnowledgeTopicInfo, []KnowledgeContribution, error) {
+	topic, err := s.getTopic(ctx, userID, topicID)
+	if err != nil {
+		return nil, nil, err
+	}
+	contributions, err := s.contributions(ctx, userID, topic)
+	if err != nil {
+		return nil, nil, err
+	}
+	info := knowledgeTopicInfo(topic)
+	return &info, contributions, nil
+}
+
+// contributions groups the mentions of a topic by session, the earliest
+// session first.
+func (s *KnowledgeService) contributions(ctx context.Context, userID uuid.UUID, topic *ent.KnowledgeTopic) ([]KnowledgeContribution, error) {
+	var (
+		result   []KnowledgeContribution
+		index    = make(map[uuid.UUID]int)
+		keywords = make(map[uuid.UUID][][]string)
+		seenURLs = make(map[uuid.UUID]bool)
+		urlIDs   []uuid.UUID
+	)
+	// Mentions are ordered by session start
+	for _, m := range topic.Edges.Mentions {
+		sess := m.Edges.Session
+		i, ok := index[sess.ID]
+		if !ok {
+			i = len(result)
+			index[sess.ID] = i
+			result = append(result, KnowledgeContribution{Session: sess})
+		}
+		c := &result[i]
+		if !slices.Contains(c.Labels, m.Label) {
+			c.Labels = append(c.Labels, m.Label)
+		}
+		keywords[sess.ID] = append(keywords[sess.ID], m.Keywords)
+		for _, u := range m.Edges.Urls {
+			if slices.ContainsFunc(c.URLs, func(k KnowledgeURL) bool { return k.ID == u.ID }) {
+				continue
+			}
+			c.URLs = append(c.URLs, KnowledgeURL{ID: u.ID, URL: u.URL})
+			urlIDs = append(urlIDs, u.ID)
+			if !seenURLs[u.ID] {
+				seenURLs[u.ID] = true
+				c.NewURLs++
+			}
+		}
+	}
+
+	titles, err := s.urlTitles(ctx, userID, urlIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		c := &result[i]
+		c.Keywords = topKeywords(keywords[c.Session.ID], maxKnowledgeKeywords)
+		for j := range c.URLs {
+			c.URLs[j].Title = titles[c.URLs[j].ID]
+		}
+	}
+	return result, nil
+}
+
+// urlTitles returns the titles of the user's copies of pages, by URL ID.
+func (s *KnowledgeService) urlTitles(ctx context.Context, userID uuid.UUID, urlIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	titles := make(map[uuid.UUID]string)
+	if len(urlIDs) == 0 {
+		return titles, nil
+	}
+	contents, err := s.client.URLContent.Query().
+		Where(
+			urlcontent.HasUserWith(user.ID(userID)),
+			urlcontent.HasURLWith(enturl.IDIn(urlIDs...)),
+		).
+		WithURL().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query url contents: %w", err)
+	}
+	for _, c := range contents {
+		if c.Edges.URL != nil && c.Title != "" {
+			titles[c.Edges.URL.ID] = c.Title
+		}
+	}
+	return titles, nil
+}
+
+// knowledgeTopicLinks indexes what topics share, to find the links of each.
+type knowledgeTopicLinks struct {
+	topics    []*ent.KnowledgeTopic
+	index     map[uuid.UUID]int
+	sessions  []map[uuid.UUID]bool
+	urls      []map[uuid.UUID]bool
+	keywords  []map[string]bool
+	bySession map[uuid.UUID][]int
+	byURL     map[uuid.UUID][]int
+	byKeyword map[string][]int
+}
+
+func newKnowledgeTopicLinks(topics []*ent.KnowledgeTopic) *knowledgeTopicLinks {
+	g := &knowledgeTopicLinks{
+		index:     make(map[uuid.UUID]int),
+		bySession: make(map[uuid.UUID][]int),
+		byURL:     make(map[uuid.UUID][]int),
+		byKeyword: make(map[string][]int),
+	}
+	for _, t := range topics {
+		if len(t.Edges.Mentions) == 0 {
+			continue
+		}
+		i := len(g.topics)
+		g.topics = append(g.topics, t)
+		g.index[t.ID] = i
+		g.sessions = append(g.sessions, make(map[uuid.UUID]bool))
+		g.urls = append(g.urls, make(map[uuid.UUID]bool))
+		g.keywords = append(g.keywords, make(map[string]bool))
+		for _, m := range t.Edges.Mentions {
+			if id := m.Edges.Session.ID; !g.sessions[i][id] {
+				g.sessions[i][id] = true
+				g.bySession[id] = append(g.bySession[id], i)
+			}
+			for _, u := range m.Edges.Urls {
+				if !g.urls[i][u.ID] {
+					g.urls[i][u.ID] = true
+					g.byURL[u.ID] = append(g.byURL[u.ID], i)
+				}
+			}
+		}
+		for _, k := range t.Keywords {
+			if k = normalizeKeyword(k); k != "" && !g.keywords[i][k] {
+				g.keywords[i][k] = true
+				g.byKeyword[k] = append(g.byKeyword[k], i)
+			}
+		}
+	}
+	return g
+}
+
+// link returns what topics i and j share. They are linked when they share
+// a session or a page, or enough keywords.
+func (g *knowledgeTopicLinks) link(i, j int) (KnowledgeLink, bool) {
+	l := KnowledgeLink{Source: g.topics[i].ID, Target: g.topics[j].ID}
+	for id := range g.sessions[i] {
+		if g.sessions[j][id] {
+			l.Sessions++
+		}
+	}
+	for id := range g.urls[i] {
+		if g.urls[j][id] {
+			l.URLs++
+		}
+	}
+	// Keep the topic's own keyword order
+	for _, k := range g.topics[i].Keywords {
+		if n := normalizeKeyword(k); g.keywords[j][n] && !slices.Contains(l.Keywords, k) {
+			l.Keywords = append(l.Keywords, k)
+		}
+	}
+	if l.Sessions == 0 && l.URLs == 0 && len(l.Keywords) < minSharedKeywords {
+		return KnowledgeLink{}, false
+	}
+	l.Weight = float64(l.Sessions+l.URLs) + float64(len(l.Keywords))/2
+	return l, true
+}
+
+// neighbors returns the topics linked to topic i, with their links.
+func (g *knowledgeTopicLinks) neighbors(i int) map[int]KnowledgeLink {
+	candidates := make(map[int]bool)
+	for id := range g.sessions[i] {
+		for _, j := range g.bySession[id] {
+			candidates[j] = true
+		}
+	}
+	for id := range g.urls[i] {
+		for _, j := range g.byURL[id] {
+			candidates[j] = true
+		}
+	}
+	for k := range g.keywords[i] {
+		for _, j := range g.byKeyword[k] {
+			candidates[j] = true
+		}
+	}
+	delete(candidates, i)
+
+	result := make(map[int]KnowledgeLink, len(candidates))
+	for j := range candidates {
+		if l, ok := g.link(i, j); ok {
+			result[j] = l
+		}
+	}
+	return result
+}
+
+// Neighborhood returns everything related to one of the user's knowledge
+// topics: the topics linked to it up to depth links away, at most limit of
+// them, the closest and most strongly linked first, along with the links
+// among all of them and the pages that recur across them.
+func (s *KnowledgeService) Neighborhood(ctx context.Context, userID, topicID uuid.UUID, depth, limit int) (*KnowledgeNeighborhood, error) {
+	depth = min(max(depth, 1), MaxNeighborhoodDepth)
+	if limit <= 0 {
+		limit = DefaultNeighborhoodLimit
+	}
+	limit = min(limit, MaxNeighborhoodLimit)
+
+	topics, err := s.knowledgeTopicQuery(userID).
+		Order(ent.Asc(knowledgetopic.FieldCreatedAt), ent.Asc(knowledgetopic.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query topics: %w", err)
+	}
+	g := newKnowledgeTopicLinks(topics)
+	center, ok := g.index[topicID]
+	if !ok {
+		return nil, ErrKnowledgeTopicNotFound
+	}
+
+	// Breadth first, ranking each ring by its strongest link inwards
+	type reached struct {
+		topic  int
+		depth  int
+		weight float64
+	}
+	included := map[int]bool{center: true}
+	var ring []reached
+	var found []reached
+	frontier := []int{center}
+	for d := 1; d <= depth && len(found) < limit; d++ {
+		best := make(map[int]float64)
+		for _, i := range frontier {
+			for j, l := range g.neighbors(i) {
+				if !included[j] && l.Weight > best[j] {
+					best[j] = l.Weight
+				}
+			}
+		}
+		ring = ring[:0]
+		for j, w := range best {
+			ring = append(ring, reached{topic: j, depth: d, weight: w})
+		}
+		slices.SortFunc(ring, func(a, b reached) int {
+			if c := cmp.Compare(b.weight, a.weight); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.topic, b.topic)
+		})
+		ring = ring[:min(len(ring), limit-len(found))]
+
+		frontier = frontier[:0]
+		for _, r := range ring {
+			included[r.topic] = true
+			frontier = append(frontier, r.topic)
+		}
+		found = append(found, ring...)
+	}
+
+	result := &KnowledgeNeighborhood{Center: knowledgeTopicInfo(g.topics[center])}
+	members := []int{center}
+	for _, r := range found {
+		result.Neighbors = append(result.Neighbors, KnowledgeNeighbor{
+			KnowledgeTopicInfo: knowledgeTopicInfo(g.topics[r.topic]),
+			Depth:              r.depth,
+		})
+		members = append(members, r.topic)
+	}
+	for a, i := range members {
+		for _, j := range members[a+1:] {
+			if l, ok := g.link(i, j); ok {
+				result.Links = append(result.Links, l)
+			}
+		}
+	}
+
+	result.URLs, err = s.recurringURLs(ctx, userID, g, members)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// recurringURLs returns the pages that come up under several of the member
+// topics, or in several sessions, the most recurring first.
+func (s *KnowledgeService) recurringURLs(ctx context.Context, userID uuid.UUID, g *knowledgeTopicLinks, members []int) ([]KnowledgeURL, error) {
+	type usage struct {
+		url      *ent.URL
+		topics   []uuid.UUID
+		sessions map[uuid.UUID]bool
+		first    time.Time
+	}
+	usages := make(map[uuid.UUID]*usage)
+	for _, i := range members {
+		t := g.topics[i]
+		for _, m := range t.Edges.Mentions {
+			for _, u := range m.Edges.Urls {
+				us, ok := usages[u.ID]
+				if !ok {
+					us = &usage{url: u, sessions: make(map[uuid.UUID]bool), first: m.SeenAt}
+					usages[u.ID] = us
+				}
+				if !slices.Contains(us.topics, t.ID) {
+					us.topics = append(us.topics, t.ID)
+				}
+				us.sessions[m.Edges.Session.ID] = true
+				if m.SeenAt.Before(us.first) {
+					us.first = m.SeenAt
+				}
+			}
+		}
+	}
+
+	var recurring []*usage
+	var ids []uuid.UUID
+	for id, us := range usages {
+		if len(us.topics) > 1 || len(us.sessions) > 1 {
+			recurring = append(recurring, us)
+			ids = append(ids, id)
+		}
+	}
+	slices.SortFunc(recurring, func(a, b *usage) int {
+		if c := cmp.Compare(len(b.topics)+len(b.sessions), len(a.topics)+len(a.sessions)); c != 0 {
+			return c
+		}
+		if c := a.first.Compare(b.first); c != 0 {
+			return c
+		}
+		return strings.Compare(a.url.URL, b.url.URL)
+	})
+
+	titles, err := s.urlTitles(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]KnowledgeURL, len(recurring))
+	for i, us := range recurring {
+		result[i] = KnowledgeURL{
+			ID:       us.url.ID,
+			URL:      us.url.URL,
+			Title:    titles[us.url.ID],
+			TopicIDs: us.topics,
+			Sessions: len(us.sessions),
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+const (
+	// knowledgeMergeThreshold is the similarity above which a mindmap topic
+	// is merged into a knowledge topic instead of starting a new one.
+	knowledgeMergeThreshold = 0.6
+	// minSharedKeywords is how many keywords two topics must share before
+	// their keywords count towards their similarity.
+	minSharedKeywords = 2
+	// maxKnowledgeKeywords caps the keywords kept on a knowledge topic.
+	maxKnowledgeKeywords = 10
+)
+
+// knowledgeTerms is what topics are compared by: their labels and their
+// normalized keywords.
+type knowledgeTerms struct {
+	labels   []string
+	keywords map[string]bool
+}
+
+func newKnowledgeTerms(label string, keywords []string) *knowledgeTerms {
+	t := &knowledgeTerms{keywords: make(map[string]bool)}
+	t.add(label, keywords)
+	return t
+}
+
+// add makes t also go by label and keywords, once a topic is merged into it.
+func (t *knowledgeTerms) add(label string, keywords []string) {
+	if !slices.Contains(t.labels, label) {
+		t.labels = append(t.labels, label)
+	}
+	for _, k := range keywords {
+		if k = normalizeKeyword(k); k != "" {
+			t.keywords[k] = true
+		}
+	}
+}
+
+func normalizeKeyword(k string) string {
+	return strings.Join(strings.Fields(strings.ToLower(k)), " ")
+}
+
+// topicSimilarity scores how likely two topics are the same, from 0 to 1:
+// the similarity of their closest labels, or the overlap of their keywords
+// once they share enough of them, whichever is higher.
+func topicSimilarity(a, b *knowledgeTerms) float64 {
+	score := 0.0
+	for _, la := range a.labels {
+		for _, lb := range b.labels {
+			score = max(score, labelSimilarity(la, lb))
+		}
+	}
+	shared := 0
+	for k := range a.keywords {
+		if b.keywords[k] {
+			shared++
+		}
+	}
+	if shared >= minSharedKeywords {
+		smaller := min(len(a.keywords), len(b.keywords))
+		score = max(score, float64(shared)/float64(smaller))
+	}
+	return score
+}
+
+// bestKnowledgeMatch returns the index of the candidate most similar to t,
+// or -1 when none is similar enough. Ties go to the earlier candidate.
+func bestKnowledgeMatch(t *knowledgeTerms, candidates []*knowledgeTerms) int {
+	best, bestScore := -1, knowledgeMergeThreshold
+	for i, c := range candidates {
+		if score := topicSimilarity(t, c); score >= bestScore && (best < 0 || score > bestScore) {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// topKeywords returns the keywords used most across lists, most frequent
+// first and then in order of appearance, at most limit of them. Keywords
+// that differ only in case or spacing count as one, spelled as first seen.
+func topKeywords(lists [][]string, limit int) []string {
+	type counted struct {
+		spelling string
+		count    int
+	}
+	seen := make(map[string]*counted)
+	var order []*counted
+	for _, list := range lists {
+		for _, k := range list {
+			norm := normalizeKeyword(k)
+			if norm == "" {
+				continue
+			}
+			if c, ok := seen[norm]; ok {
+				c.count++
+				continue
+			}
+			c := &counted{spelling: strings.TrimSpace(k), count: 1}
+			seen[norm] = c
+			order = append(order, c)
+		}
+	}
+	slices.SortStableFunc(order, func(a, b *counted) int { return cmp.Compare(b.count, a.count) })
+	if len(order) > limit {
+		order = order[:limit]
+	}
+	result := make([]string, len(order))
+	for i, c := range order {
+		result[i] = c.spelling
+	}
+	return result
+}
+
+// knowledgeLabel returns the label a topic goes by most often, given the
+// labels of its mentions from the earliest. Ties go to the latest label.
+func knowledgeLabel(labels []string) string {
+	counts := make(map[string]int, len(labels))
+	best := ""
+	for _, l := range labels {
+		counts[l]++
+		if counts[l] >= counts[best] {
+			best = l
+		}
+	}
+	return best
+}
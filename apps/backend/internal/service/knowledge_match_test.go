@@ -0,0 +1,128 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *knowledgeTerms
+		same bool
+	}{
+		{
+			name: "same label, other case and spacing",
+			a:    newKnowledgeTerms("Go Generics", nil),
+			b:    newKnowledgeTerms("go generics", nil),
+			same: true,
+		},
+		{
+			name: "close Korean labels",
+			a:    newKnowledgeTerms("리액트 상태 관리", nil),
+			b:    newKnowledgeTerms("리액트 상태관리", nil),
+			same: true,
+		},
+		{
+			name: "different labels, shared keywords",
+			a:    newKnowledgeTerms("Type parameters", []string{"generics", "Go", "constraints"}),
+			b:    newKnowledgeTerms("Go 1.18 features", []string{"go", "generics", "fuzzing", "workspaces"}),
+			same: true,
+		},
+		{
+			name: "one shared keyword is not enough",
+			a:    newKnowledgeTerms("Type parameters", []string{"go"}),
+			b:    newKnowledgeTerms("Goroutines", []string{"go", "concurrency"}),
+			same: false,
+		},
+		{
+			name: "unrelated",
+			a:    newKnowledgeTerms("React hooks", []string{"react", "hooks"}),
+			b:    newKnowledgeTerms("Postgres indexes", []string{"postgres", "btree"}),
+			same: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := topicSimilarity(tt.a, tt.b)
+			assert.Equal(t, tt.same, score >= knowledgeMergeThreshold, "similarity %.2f", score)
+			assert.Equal(t, score, topicSimilarity(tt.b, tt.a), "symmetric")
+		})
+	}
+}
+
+func TestTopicSimilarity_MergedLabels(t *testing.T) {
+	merged := newKnowledgeTerms("Go generics", nil)
+	merged.add("Type parameters in Go", nil)
+
+	assert.GreaterOrEqual(t, topicSimilarity(newKnowledgeTerms("Type parameters in Go", nil), merged), knowledgeMergeThreshold,
+		"a topic is compared with every label merged into it")
+}
+
+func TestBestKnowledgeMatch(t *testing.T) {
+	candidates := []*knowledgeTerms{
+		newKnowledgeTerms("Postgres indexes", nil),
+		newKnowledgeTerms("Go generics", nil),
+		newKnowledgeTerms("Go generic", nil),
+		newKnowledgeTerms("GO GENERICS", nil),
+	}
+
+	assert.Equal(t, 2, bestKnowledgeMatch(newKnowledgeTerms("Go generic", nil), candidates), "the best match, not the first")
+	assert.Equal(t, 1, bestKnowledgeMatch(newKnowledgeTerms("go generics", nil), candidates), "ties go to the earlier")
+	assert.Equal(t, -1, bestKnowledgeMatch(newKnowledgeTerms("Kubernetes", nil), candidates))
+	assert.Equal(t, -1, bestKnowledgeMatch(newKnowledgeTerms("Go generics", nil), nil))
+}
+
+func TestTopKeywords(t *testing.T) {
+	lists := [][]string{
+		{"Go", "generics", "type sets"},
+		{"go", "Constraints"},
+		{"GO", "constraints", "  type   sets "},
+		{""},
+	}
+
+	assert.Equal(t, []string{"Go", "type sets", "Constraints", "generics"}, topKeywords(lists, 10))
+	assert.Equal(t, []string{"Go", "type sets"}, topKeywords(lists, 2))
+	assert.Empty(t, topKeywords(nil, 10))
+}
+
+func TestKnowledgeLabel(t *testing.T) {
+	assert.Equal(t, "Go generics", knowledgeLabel([]string{"Go generics", "Type parameters", "Go generics"}))
+	assert.Equal(t, "Type parameters", knowledgeLabel([]string{"Go generics", "Type parameters"}), "ties go to the latest")
+	assert.Equal(t, "Go generics", knowledgeLabel([]string{"Go generics"}))
+}
+
+func TestKnowledgeNodes(t *testing.T) {
+	url1, url2 := uuid.New(), uuid.New()
+	data := MindmapData{
+		Nodes: []MindmapNode{
+			{ID: "core", Label: "Go", Type: "core"},
+			{ID: "t1", Label: " Generics ", Type: "topic", Data: map[string]interface{}{
+				"keywords": []interface{}{"generics", "type parameters"},
+			}},
+			{ID: "s1", Label: "Constraints", Type: "subtopic"},
+			{ID: "t2", Label: "", Type: "topic"},
+			{ID: "p1", Label: "Tutorial", Type: "page", Data: map[string]interface{}{"url_id": url1.String()}},
+			{ID: "p2", Label: "Proposal", Type: "page", Data: map[string]interface{}{"url_id": url2.String()}},
+			{ID: "p3", Label: "Broken", Type: "page", Data: map[string]interface{}{"url_id": "not-a-uuid"}},
+		},
+		Edges: []MindmapEdge{
+			{Source: "core", Target: "t1"},
+			{Source: "t1", Target: "s1"},
+			{Source: "t1", Target: "p1"},
+			{Source: "p2", Target: "t1"},
+			{Source: "t1", Target: "p1"},
+			{Source: "s1", Target: "p2"},
+			{Source: "s1", Target: "p3"},
+		},
+	}
+
+	nodes := knowledgeNodes(data)
+
+	assert.Equal(t, []knowledgeNode{
+		{id: "t1", label: "Generics", keywords: []string{"generics", "type parameters"}, urlIDs: []uuid.UUID{url1, url2}},
+		{id: "s1", label: "Constraints", urlIDs: []uuid.UUID{url2}},
+	}, nodes, "topics and subtopics with labels, with their pages once each")
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/session"
+	enturl "github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/user"
+)
+
+// ErrKnowledgeTopicNotFound is returned when a knowledge topic doesn't exist
+// or belongs to another user.
+var ErrKnowledgeTopicNotFound = errors.New("knowledge topic not found")
+
+// KnowledgeService maintains each user's knowledge graph, which merges the
+// topics of their mindmaps across sessions and links the topics that share
+// sessions, pages or keywords.
+type KnowledgeService struct {
+	client *ent.Client
+}
+
+// NewKnowledgeService creates a new KnowledgeService.
+func NewKnowledgeService(client *ent.Client) *KnowledgeService {
+	return &KnowledgeService{client: client}
+}
+
+// knowledgeNode is a topic node of a mindmap, with the URLs of its pages.
+type knowledgeNode struct {
+	id       string
+	label    string
+	keywords []string
+	urlIDs   []uuid.UUID
+}
+
+// knowledgeNodes returns the topic and subtopic nodes of a mindmap. Their
+// pages are the page nodes they share an edge with.
+func knowledgeNodes(data MindmapData) []knowledgeNode {
+	pageURLs := make(map[string]uuid.UUID)
+	for _, n := range data.Nodes {
+		raw, _ := n.Data["url_id"].(string)
+		if id, err := uuid.Parse(raw); err == nil && n.Type == "page" {
+			pageURLs[n.ID] = id
+		}
+	}
+	pages := make(map[string][]uuid.UUID)
+	for _, e := range data.Edges {
+		for _, pair := range [][2]string{{e.Source, e.Target}, {e.Target, e.Source}} {
+			if id, ok := pageURLs[pair[1]]; ok && !slices.Contains(pages[pair[0]], id) {
+				pages[pair[0]] = append(pages[pair[0]], id)
+			}
+		}
+	}
+
+	var nodes []knowledgeNode
+	seen := make(map[string]bool)
+	for _, n := range topicNodes(data) {
+		label := strings.TrimSpace(n.Label)
+		if label == "" || seen[n.ID] {
+			continue
+		}
+		seen[n.ID] = true
+		nodes = append(nodes, knowledgeNode{
+			id:       n.ID,
+			label:    label,
+			keywords: stringList(n.Data["keywords"]),
+			urlIDs:   pages[n.ID],
+		})
+	}
+	return nodes
+}
+
+// stringList reads a list of strings from node data, which JSON storage
+// turns into a []interface{}.
+func stringList(v interface{}) []string {
+	switch list := v.(type) {
+	case []string:
+		return list
+	case []interface{}:
+		result := make([]string, 0, len(list))
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// UpdateFromMindmap merges the topics of a completed mindmap into its
+// user's knowledge graph, replacing what the session contributed before.
+// Each topic node joins the knowledge topic most similar to it, by label or
+// keywords, or starts a new one.
+func (s *KnowledgeService) UpdateFromMindmap(ctx context.Context, mindmapID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	sessionID, err := tx.MindmapGraph.Query().
+		Where(mindmapgraph.ID(mindmapID)).
+		QuerySession().
+		OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrMindmapNotFound
+		}
+		return err
+	}
+	if err := lockKnowledgeGraphs(ctx, tx, sessionID); err != nil {
+		return err
+	}
+
+	// Read the mindmap under the lock, so an update that waited for it
+	// doesn't merge an older graph over a newer one
+	mindmap, err := tx.MindmapGraph.Query().
+		Where(mindmapgraph.ID(mindmapID)).
+		WithSession(func(q *ent.SessionQuery) { q.WithUser() }).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrMindmapNotFound
+		}
+		return err
+	}
+	if mindmap.Status != mindmapgraph.StatusCompleted {
+		return ErrMindmapNotCompleted
+	}
+	sess := mindmap.Edges.Session
+
+	var nodes []knowledgeNode
+	// A deleted session only takes its topics out of the graph
+	if sess.Status != session.Status(sessionStatusInactive) {
+		data, err := ConvertMapsToData(mindmap.Nodes, mindmap.GraphEdges, mindmap.Layout)
+		if err != nil {
+			return fmt.Errorf("read mindmap: %w", err)
+		}
+		nodes = knowledgeNodes(data)
+	}
+	if err := replaceMentions(ctx, tx, sess.Edges.User.ID, sess.ID, sess.StartedAt, nodes); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// RemoveSession takes the topics of a session out of its user's knowledge
+// graph, deleting the knowledge topics only it mentioned.
+func (s *KnowledgeService) RemoveSession(ctx context.Context, sessionID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := PurgeKnowledgeSessions(ctx, tx, []uuid.UUID{sessionID}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// PurgeKnowledgeSessions takes sessions out of their users' knowledge graphs
+// within tx, for callers that hard-delete the sessions in the same
+// transaction.
+func PurgeKnowledgeSessions(ctx context.Context, tx *ent.Tx, sessionIDs []uuid.UUID) error {
+	if err := lockKnowledgeGraphs(ctx, tx, sessionIDs...); err != nil {
+		return err
+	}
+	affected, err := deleteKnowledgeMentions(ctx, tx, sessionIDs...)
+	if err != nil {
+		return err
+	}
+	return refreshKnowledgeTopics(ctx, tx, affected)
+}
+
+// lockKnowledgeGraphs locks the users who own sessions until tx ends, so
+// changes to a user's knowledge graph run one at a time. Concurrent merges
+// would otherwise read the same topics and each create the same new one.
+func lockKnowledgeGraphs(ctx context.Context, tx *ent.Tx, sessionIDs ...uuid.UUID) error {
+	_, err := tx.User.Query().
+		Where(user.HasSessionsWith(session.IDIn(sessionIDs...))).
+		Order(ent.Asc(user.FieldID)).
+		ForUpdate().
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("lock knowledge graph: %w", err)
+	}
+	return nil
+}
+
+// replaceMentions replaces the mentions of a session with nodes, seen at
+// the start of the session, and refreshes the topics that gained or lost
+// mentions.
+func replaceMentions(ctx context.Context, tx *ent.Tx, userID, sessionID uuid.UUID, seenAt time.Time, nodes []knowledgeNode) error {
+	affected, err := deleteKnowledgeMentions(ctx, tx, sessionID)
+	if err != nil {
+		return err
+	}
+	if len(nodes) > 0 {
+		added, err := mergeKnowledgeNodes(ctx, tx, userID, sessionID, seenAt, nodes)
+		if err != nil {
+			return err
+		}
+		affected = append(affected, added...)
+	}
+	return refreshKnowledgeTopics(ctx, tx, affected)
+}
+
+// deleteKnowledgeMentions deletes the mentions of sessions and returns the
+// topics they belonged to.
+func deleteKnowledgeMentions(ctx context.Context, tx *ent.Tx, sessionIDs ...uuid.UUID) ([]uuid.UUID, error) {
+	inSessions := knowledgemention.HasSessionWith(session.IDIn(sessionIDs...))
+	affected, err := tx.KnowledgeMention.Query().
+		Where(inSessions).
+		QueryTopic().
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query mentions: %w", err)
+	}
+	if _, err := tx.KnowledgeMention.Delete().Where(inSessions).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("delete mentions: %w", err)
+	}
+	return affected, nil
+}
+
+// mergeKnowledgeNodes records nodes as mentions of the user's knowledge
+// topics and returns the topics they went to.
+func mergeKnowledgeNodes(ctx context.Context, tx *ent.Tx, userID, sessionID uuid.UUID, seenAt time.Time, nodes []knowledgeNode) ([]uuid.UUID, error) {
+	topics, err := tx.KnowledgeTopic.Query().
+		Where(knowledgetopic.HasUserWith(user.ID(userID))).
+		WithMentions().
+		Order(ent.Asc(knowledgetopic.FieldCreatedAt), ent.Asc(knowledgetopic.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query topics: %w", err)
+	}
+	ids := make([]uuid.UUID, len(topics))
+	candidates := make([]*knowledgeTerms, len(topics))
+	for i, t := range topics {
+		ids[i] = t.ID
+		candidates[i] = newKnowledgeTerms(t.Label, t.Keywords)
+		for _, m := range t.Edges.Mentions {
+			candidates[i].add(m.Label, m.Keywords)
+		}
+	}
+
+	// Mindmaps can outlive the pages they name
+	var urlIDs []uuid.UUID
+	for _, n := range nodes {
+		urlIDs = append(urlIDs, n.urlIDs...)
+	}
+	existing, err := tx.URL.Query().Where(enturl.IDIn(urlIDs...)).IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query urls: %w", err)
+	}
+
+	var merged []uuid.UUID
+	for _, n := range nodes {
+		terms := newKnowledgeTerms(n.label, n.keywords)
+		var topicID uuid.UUID
+		if i := bestKnowledgeMatch(terms, candidates); i >= 0 {
+			topicID = ids[i]
+			candidates[i].add(n.label, n.keywords)
+		} else {
+			topic, err := tx.KnowledgeTopic.Create().
+				SetUserID(userID).
+				SetLabel(n.label).
+				SetKeywords(n.keywords).
+				SetFirstSeenAt(seenAt).
+				SetLastSeenAt(seenAt).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("create topic: %w", err)
+			}
+			topicID = topic.ID
+			ids = append(ids, topicID)
+			candidates = append(candidates, terms)
+		}
+
+		var pages []uuid.UUID
+		for _, id := range n.urlIDs {
+			if slices.Contains(existing, id) {
+				pages = append(pages, id)
+			}
+		}
+		if err := tx.KnowledgeMention.Create().
+			SetTopicID(topicID).
+			SetSessionID(sessionID).
+			SetNodeID(n.id).
+			SetLabel(n.label).
+			SetKeywords(n.keywords).
+			SetSeenAt(seenAt).
+			AddURLIDs(pages...).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("create mention: %w", err)
+		}
+		merged = append(merged, topicID)
+	}
+	return merged, nil
+}
+
+// refreshKnowledgeTopics recomputes the label, keywords and dates of topics
+// from their mentions, and deletes the topics left without any.
+func refreshKnowledgeTopics(ctx context.Context, tx *ent.Tx, topicIDs []uuid.UUID) error {
+	done := make(map[uuid.UUID]bool, len(topicIDs))
+	for _, id := range topicIDs {
+		if done[id] {
+			continue
+		}
+		done[id] = true
+
+		mentions, err := tx.KnowledgeMention.Query().
+			Where(knowledgemention.HasTopicWith(knowledgetopic.ID(id))).
+			Order(ent.Asc(knowledgemention.FieldSeenAt), ent.Asc(knowledgemention.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query mentions: %w", err)
+		}
+		if len(mentions) == 0 {
+			if err := tx.KnowledgeTopic.DeleteOneID(id).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+				return fmt.Errorf("delete topic: %w", err)
+			}
+			continue
+		}
+
+		labels := make([]string, len(mentions))
+		keywords := make([][]string, len(mentions))
+		for i, m := range mentions {
+			labels[i] = m.Label
+			keywords[i] = m.Keywords
+		}
+		if err := tx.KnowledgeTopic.UpdateOneID(id).
+			SetLabel(knowledgeLabel(labels)).
+			SetKeywords(topKeywords(keywords, maxKnowledgeKeywords)).
+			SetFirstSeenAt(mentions[0].SeenAt).
+			SetLastSeenAt(mentions[len(mentions)-1].SeenAt).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("update topic: %w", err)
+		}
+	}
+	return nil
+}
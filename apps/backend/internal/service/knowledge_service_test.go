@@ -0,0 +1,221 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/knowledgemention"
+	"github.com/mindhit/api/ent/knowledgetopic"
+	"github.com/mindhit/api/internal/service"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+type knowledgeTestTopic struct {
+	label    string
+	keywords []string
+	urls     []uuid.UUID
+}
+
+// knowledgeTestData builds a mindmap with a topic node for each topic and a
+// page node for each of their URLs.
+func knowledgeTestData(topics ...knowledgeTestTopic) service.MindmapData {
+	data := revisionTestData("unused")
+	data.Nodes, data.Edges = data.Nodes[:1], nil
+	for i, topic := range topics {
+		topicID := fmt.Sprintf("topic-%d", i)
+		data.Nodes = append(data.Nodes, service.MindmapNode{
+			ID: topicID, Label: topic.label, Type: "topic",
+			Data: map[string]interface{}{"keywords": topic.keywords},
+		})
+		data.Edges = append(data.Edges, service.MindmapEdge{Source: "core", Target: topicID, Weight: 1})
+		for _, id := range topic.urls {
+			data.Nodes = append(data.Nodes, service.MindmapNode{
+				ID: id.String(), Label: "Page", Type: "page",
+				Data: map[string]interface{}{"url_id": id.String()},
+			})
+			data.Edges = append(data.Edges, service.MindmapEdge{Source: topicID, Target: id.String(), Weight: 1})
+		}
+	}
+	return data
+}
+
+// completeKnowledgeSession stops a new session, completes its mindmap with
+// data and merges it into the knowledge graph.
+func completeKnowledgeSession(t *testing.T, mindmapService *service.MindmapService, sessionService *service.SessionService, knowledgeService *service.KnowledgeService, userID uuid.UUID, data service.MindmapData) *ent.Session {
+	t.Helper()
+	ctx := context.Background()
+	sess := createStoppedSession(t, sessionService, userID)
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, userID, false)
+	require.NoError(t, err)
+	_, err = mindmapService.SetCompleted(ctx, mindmap.ID, data)
+	require.NoError(t, err)
+	require.NoError(t, knowledgeService.UpdateFromMindmap(ctx, mindmap.ID))
+	return sess
+}
+
+func createKnowledgeTestURL(t *testing.T, client *ent.Client, path string) *ent.URL {
+	t.Helper()
+	u, err := client.URL.Create().
+		SetURL("https://example.com/" + path).
+		SetURLHash(uuid.NewString()).
+		Save(context.Background())
+	require.NoError(t, err)
+	return u
+}
+
+func findKnowledgeTopic(topics []service.KnowledgeTopicInfo, label string) *service.KnowledgeTopicInfo {
+	for i := range topics {
+		if topics[i].Topic.Label == label {
+			return &topics[i]
+		}
+	}
+	return nil
+}
+
+func TestKnowledgeService_MergesTopicsAcrossSessions(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+	knowledgeService := service.NewKnowledgeService(client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("knowledge-merge"))
+	tutorial := createKnowledgeTestURL(t, client, "generics-tutorial")
+	compose := createKnowledgeTestURL(t, client, "compose")
+
+	first := completeKnowledgeSession(t, mindmapService, sessionService, knowledgeService, user.ID, knowledgeTestData(
+		knowledgeTestTopic{label: "Go generics", keywords: []string{"generics", "type parameters"}, urls: []uuid.UUID{tutorial.ID}},
+		knowledgeTestTopic{label: "Docker", keywords: []string{"containers"}, urls: []uuid.UUID{compose.ID}},
+	))
+	second := completeKnowledgeSession(t, mindmapService, sessionService, knowledgeService, user.ID, knowledgeTestData(
+		knowledgeTestTopic{label: "Go Generics", keywords: []string{"Generics", "constraints"}, urls: []uuid.UUID{tutorial.ID}},
+		knowledgeTestTopic{label: "Kubernetes", keywords: []string{"containers", "orchestration"}},
+	))
+
+	topics, err := knowledgeService.ListTopics(ctx, user.ID, "", 0)
+	require.NoError(t, err)
+	require.Len(t, topics, 3, "the generics topics of both sessions are merged")
+	generics := findKnowledgeTopic(topics, "Go generics")
+	require.NotNil(t, generics)
+	assert.Equal(t, 2, generics.Sessions)
+	assert.Equal(t, 1, generics.URLs, "the tutorial counts once")
+	assert.Equal(t, "generics", generics.Topic.Keywords[0], "shared keywords rank first")
+	assert.WithinDuration(t, first.StartedAt, generics.Topic.FirstSeenAt, time.Millisecond)
+	assert.WithinDuration(t, second.StartedAt, generics.Topic.LastSeenAt, time.Millisecond)
+
+	found, err := knowledgeService.ListTopics(ctx, user.ID, "generic", 0)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, generics.Topic.ID, found[0].Topic.ID)
+	found, err = knowledgeService.ListTopics(ctx, user.ID, "ORCHESTR", 0)
+	require.NoError(t, err)
+	require.Len(t, found, 1, "keywords match too")
+	assert.Equal(t, "Kubernetes", found[0].Topic.Label)
+	found, err = knowledgeService.ListTopics(ctx, user.ID, "", 1)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, findKnowledgeTopic(topics, found[0].Topic.Label).Sessions, found[0].Sessions, "a page of topics loads their mentions in full")
+
+	_, timeline, err := knowledgeService.Timeline(ctx, user.ID, generics.Topic.ID)
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	assert.Equal(t, first.ID, timeline[0].Session.ID)
+	assert.Equal(t, 1, timeline[0].NewURLs)
+	assert.Equal(t, []string{"Go Generics"}, timeline[1].Labels)
+	assert.Equal(t, 0, timeline[1].NewURLs, "the tutorial came up before")
+
+	_, contributions, err := knowledgeService.GetTopic(ctx, user.ID, generics.Topic.ID)
+	require.NoError(t, err)
+	require.Len(t, contributions, 2)
+	assert.Equal(t, second.ID, contributions[0].Session.ID, "latest session first")
+
+	hood, err := knowledgeService.Neighborhood(ctx, user.ID, generics.Topic.ID, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, hood.Neighbors, 2, "the topics that share a session")
+	require.Len(t, hood.URLs, 1)
+	assert.Equal(t, tutorial.ID, hood.URLs[0].ID)
+	assert.Equal(t, 2, hood.URLs[0].Sessions, "the tutorial recurs")
+	require.Len(t, hood.Links, 2, "one shared keyword doesn't link Docker and Kubernetes")
+	for _, l := range hood.Links {
+		assert.Contains(t, []uuid.UUID{l.Source, l.Target}, generics.Topic.ID)
+		assert.Equal(t, 1, l.Sessions)
+	}
+
+	other := createTestUser(t, authService, uniqueEmail("knowledge-other"))
+	_, err = knowledgeService.Neighborhood(ctx, other.ID, generics.Topic.ID, 1, 0)
+	assert.ErrorIs(t, err, service.ErrKnowledgeTopicNotFound)
+}
+
+func TestKnowledgeService_RegenerationAndDeletion(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+	knowledgeService := service.NewKnowledgeService(client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("knowledge-regen"))
+	sess := completeKnowledgeSession(t, mindmapService, sessionService, knowledgeService, user.ID,
+		knowledgeTestData(knowledgeTestTopic{label: "Go generics"}))
+
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, true)
+	require.NoError(t, err)
+	_, err = mindmapService.SetCompleted(ctx, mindmap.ID, knowledgeTestData(knowledgeTestTopic{label: "Rust ownership"}))
+	require.NoError(t, err)
+	require.NoError(t, knowledgeService.UpdateFromMindmap(ctx, mindmap.ID))
+
+	topics, err := knowledgeService.ListTopics(ctx, user.ID, "", 0)
+	require.NoError(t, err)
+	require.Len(t, topics, 1, "the regenerated mindmap replaces what the session contributed")
+	assert.Equal(t, "Rust ownership", topics[0].Topic.Label)
+
+	require.NoError(t, sessionService.Delete(ctx, sess.ID, user.ID))
+	topics, err = knowledgeService.ListTopics(ctx, user.ID, "", 0)
+	require.NoError(t, err)
+	assert.Empty(t, topics, "deleting the session takes its topics out")
+
+	require.NoError(t, knowledgeService.UpdateFromMindmap(ctx, mindmap.ID))
+	topics, err = knowledgeService.ListTopics(ctx, user.ID, "", 0)
+	require.NoError(t, err)
+	assert.Empty(t, topics, "a deleted session's mindmap isn't merged again")
+}
+
+func TestKnowledgeService_ConcurrentUpdatesOfOneUser(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+	knowledgeService := service.NewKnowledgeService(client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("knowledge-concurrent"))
+	var mindmapIDs []uuid.UUID
+	for range 4 {
+		sess := createStoppedSession(t, sessionService, user.ID)
+		mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+		require.NoError(t, err)
+		_, err = mindmapService.SetCompleted(ctx, mindmap.ID, knowledgeTestData(knowledgeTestTopic{label: "Go generics"}))
+		require.NoError(t, err)
+		mindmapIDs = append(mindmapIDs, mindmap.ID, mindmap.ID)
+	}
+
+	errs := make(chan error, len(mindmapIDs))
+	for _, id := range mindmapIDs {
+		go func() { errs <- knowledgeService.UpdateFromMindmap(ctx, id) }()
+	}
+	for range mindmapIDs {
+		require.NoError(t, <-errs)
+	}
+
+	topics, err := knowledgeService.ListTopics(ctx, user.ID, "", 0)
+	require.NoError(t, err)
+	require.Len(t, topics, 1, "sessions finishing together share one topic")
+	assert.Equal(t, 4, topics[0].Sessions)
+	mentions, err := client.KnowledgeMention.Query().
+		Where(knowledgemention.HasTopicWith(knowledgetopic.ID(topics[0].Topic.ID))).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4, mentions, "an update that runs twice doesn't duplicate mentions")
+}
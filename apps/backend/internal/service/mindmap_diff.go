@@ -0,0 +1,285 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// sameIDSimilarity is the label similarity a topic keeping its node ID
+	// needs to count as the same topic. Generated IDs such as topic-0 get
+	// reused for unrelated topics, so the ID alone is not enough.
+	sameIDSimilarity = 0.3
+	// sameIDPageOverlap is the share of pages a topic keeping its node ID
+	// needs to count as the same topic, whatever its label.
+	sameIDPageOverlap = 0.5
+	// renameSimilarity is the label similarity two topics with different node
+	// IDs need to count as one renamed topic.
+	renameSimilarity = 0.6
+)
+
+// MindmapDiff is the structural difference between two mindmap graphs.
+type MindmapDiff struct {
+	From          int               `json:"from"`
+	To            int               `json:"to"`
+	AddedTopics   []DiffTopic       `json:"added_topics"`
+	RemovedTopics []DiffTopic       `json:"removed_topics"`
+	RenamedTopics []DiffTopicRename `json:"renamed_topics"`
+	MovedPages    []DiffPageMove    `json:"moved_pages"`
+}
+
+// DiffTopic is a topic node in a diff.
+type DiffTopic struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
+// DiffTopicRename is a topic that exists in both graphs under another label.
+type DiffTopicRename struct {
+	From       DiffTopic `json:"from"`
+	To         DiffTopic `json:"to"`
+	Similarity float64   `json:"similarity"`
+}
+
+// DiffPageMove is a page that belongs to another topic in the newer graph.
+type DiffPageMove struct {
+	ID    string    `json:"id"`
+	Label string    `json:"label"`
+	From  DiffTopic `json:"from"`
+	To    DiffTopic `json:"to"`
+}
+
+// DiffMindmaps compares the topics and pages of two graphs. Topics are
+// matched by node ID when their labels or pages agree, then by label
+// similarity; matched topics with different labels are renamed. Pages are
+// matched by node ID, which is their URL ID, and moved when their topic
+// doesn't match the topic they had.
+func DiffMindmaps(from, to MindmapData) MindmapDiff {
+	fromTopics, toTopics := topicNodes(from), topicNodes(to)
+	fromParents, toParents := pageParents(from), pageParents(to)
+	matched := matchTopics(fromTopics, toTopics, fromParents, toParents)
+
+	diff := MindmapDiff{
+		AddedTopics:   []DiffTopic{},
+		RemovedTopics: []DiffTopic{},
+		RenamedTopics: []DiffTopicRename{},
+		MovedPages:    []DiffPageMove{},
+	}
+
+	toIndex := make(map[string]MindmapNode, len(toTopics))
+	matchedTo := make(map[string]bool, len(matched))
+	for _, n := range toTopics {
+		toIndex[n.ID] = n
+	}
+	for _, n := range fromTopics {
+		toID, ok := matched[n.ID]
+		if !ok {
+			diff.RemovedTopics = append(diff.RemovedTopics, diffTopic(n))
+			continue
+		}
+		matchedTo[toID] = true
+		if m := toIndex[toID]; normalizeLabel(n.Label) != normalizeLabel(m.Label) {
+			diff.RenamedTopics = append(diff.RenamedTopics, DiffTopicRename{
+				From:       diffTopic(n),
+				To:         diffTopic(m),
+				Similarity: labelSimilarity(n.Label, m.Label),
+			})
+		}
+	}
+	for _, n := range toTopics {
+		if !matchedTo[n.ID] {
+			diff.AddedTopics = append(diff.AddedTopics, diffTopic(n))
+		}
+	}
+
+	fromIndex := make(map[string]MindmapNode, len(fromTopics))
+	for _, n := range fromTopics {
+		fromIndex[n.ID] = n
+	}
+	for _, page := range to.Nodes {
+		if page.Type != "page" {
+			continue
+		}
+		fromParent, ok := fromParents[page.ID]
+		if !ok {
+			continue
+		}
+		toParent, ok := toParents[page.ID]
+		if !ok {
+			continue
+		}
+		if matched[fromParent] == toParent {
+			continue
+		}
+		diff.MovedPages = append(diff.MovedPages, DiffPageMove{
+			ID:    page.ID,
+			Label: page.Label,
+			From:  diffTopic(fromIndex[fromParent]),
+			To:    diffTopic(toIndex[toParent]),
+		})
+	}
+
+	return diff
+}
+
+// matchTopics pairs the topics of two graphs, returning the matching to-ID
+// of each matched from-ID.
+func matchTopics(fromTopics, toTopics []MindmapNode, fromParents, toParents map[string]string) map[string]string {
+	matched := make(map[string]string)
+	taken := make(map[string]bool)
+
+	toIndex := make(map[string]MindmapNode, len(toTopics))
+	for _, n := range toTopics {
+		toIndex[n.ID] = n
+	}
+	fromPages, toPages := topicPages(fromParents), topicPages(toParents)
+	for _, n := range fromTopics {
+		m, ok := toIndex[n.ID]
+		if !ok {
+			continue
+		}
+		if labelSimilarity(n.Label, m.Label) >= sameIDSimilarity ||
+			pageOverlap(fromPages[n.ID], toPages[m.ID]) >= sameIDPageOverlap {
+			matched[n.ID] = m.ID
+			taken[m.ID] = true
+		}
+	}
+
+	type candidate struct {
+		from, to   string
+		similarity float64
+	}
+	var candidates []candidate
+	for _, n := range fromTopics {
+		if _, ok := matched[n.ID]; ok {
+			continue
+		}
+		for _, m := range toTopics {
+			if taken[m.ID] {
+				continue
+			}
+			if sim := labelSimilarity(n.Label, m.Label); sim >= renameSimilarity {
+				candidates = append(candidates, candidate{from: n.ID, to: m.ID, similarity: sim})
+			}
+		}
+	}
+	// Best pairs first, the stable sort keeps graph order among ties
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(b.similarity, a.similarity)
+	})
+	for _, c := range candidates {
+		if _, ok := matched[c.from]; ok || taken[c.to] {
+			continue
+		}
+		matched[c.from] = c.to
+		taken[c.to] = true
+	}
+
+	return matched
+}
+
+// topicNodes returns the topic and subtopic nodes of a graph.
+func topicNodes(data MindmapData) []MindmapNode {
+	var topics []MindmapNode
+	for _, n := range data.Nodes {
+		if n.Type == "topic" || n.Type == "subtopic" {
+			topics = append(topics, n)
+		}
+	}
+	return topics
+}
+
+// pageParents maps each page of a graph to the topic it belongs to, the
+// source of its first edge from a topic.
+func pageParents(data MindmapData) map[string]string {
+	types := make(map[string]string, len(data.Nodes))
+	for _, n := range data.Nodes {
+		types[n.ID] = n.Type
+	}
+
+	parents := make(map[string]string)
+	for _, e := range data.Edges {
+		if types[e.Target] != "page" {
+			continue
+		}
+		if t := types[e.Source]; t != "topic" && t != "subtopic" {
+			continue
+		}
+		if _, ok := parents[e.Target]; !ok {
+			parents[e.Target] = e.Source
+		}
+	}
+	return parents
+}
+
+// topicPages inverts pageParents into the set of pages of each topic.
+func topicPages(parents map[string]string) map[string]map[string]bool {
+	pages := make(map[string]map[string]bool)
+	for page, topic := range parents {
+		if pages[topic] == nil {
+			pages[topic] = make(map[string]bool)
+		}
+		pages[topic][page] = true
+	}
+	return pages
+}
+
+// pageOverlap is the Jaccard index of two sets of pages.
+func pageOverlap(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for page := range a {
+		if b[page] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// labelSimilarity is the Dice coefficient of the character bigrams of two
+// normalized labels, from 0 to 1. Bigrams work for Korean labels, which
+// don't split into words the way English ones do.
+func labelSimilarity(a, b string) float64 {
+	a, b = normalizeLabel(a), normalizeLabel(b)
+	if a == b {
+		return 1
+	}
+	ab, bb := bigrams(a), bigrams(b)
+	if len(ab) == 0 || len(bb) == 0 {
+		return 0
+	}
+	shared := 0
+	for g := range ab {
+		if bb[g] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(ab)+len(bb))
+}
+
+// normalizeLabel lowercases a label and keeps only its letters and digits.
+func normalizeLabel(label string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, label)
+}
+
+func bigrams(s string) map[string]bool {
+	runes := []rune(s)
+	grams := make(map[string]bool, len(runes))
+	for i := 0; i+1 < len(runes); i++ {
+		grams[string(runes[i:i+2])] = true
+	}
+	return grams
+}
+
+func diffTopic(n MindmapNode) DiffTopic {
+	return DiffTopic{ID: n.ID, Label: n.Label}
+}
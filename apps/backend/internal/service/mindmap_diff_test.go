@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func diffTestGraph(topics []MindmapNode, pages map[string][]string) MindmapData {
+	data := MindmapData{Nodes: []MindmapNode{{ID: "core", Label: "Core", Type: "core"}}}
+	for _, topic := range topics {
+		topic.Type = "topic"
+		data.Nodes = append(data.Nodes, topic)
+		data.Edges = append(data.Edges, MindmapEdge{Source: "core", Target: topic.ID, Weight: 1})
+		for _, page := range pages[topic.ID] {
+			data.Nodes = append(data.Nodes, MindmapNode{ID: page, Label: "Page " + page, Type: "page"})
+			data.Edges = append(data.Edges, MindmapEdge{Source: topic.ID, Target: page, Weight: 1})
+		}
+	}
+	return data
+}
+
+func TestDiffMindmaps(t *testing.T) {
+	from := diffTestGraph([]MindmapNode{
+		{ID: "topic-0", Label: "Go generics"},
+		{ID: "topic-1", Label: "Databases"},
+		{ID: "topic-2", Label: "Rust ownership"},
+		{ID: "topic-3", Label: "CI pipelines"},
+	}, map[string][]string{
+		"topic-0": {"p1", "p2"},
+		"topic-1": {"p3"},
+		"topic-2": {"p4"},
+		"topic-3": {"p5", "p6"},
+	})
+	to := diffTestGraph([]MindmapNode{
+		{ID: "topic-0", Label: "Generics in Go"},
+		{ID: "topic-1", Label: "Kubernetes"},
+		{ID: "topic-5", Label: "Ownership in Rust"},
+		{ID: "topic-3", Label: "Build automation"},
+	}, map[string][]string{
+		"topic-0": {"p1"},
+		"topic-1": {"p3", "p7", "p8"},
+		"topic-5": {"p4", "p2"},
+		"topic-3": {"p5", "p6"},
+	})
+
+	diff := DiffMindmaps(from, to)
+
+	assert.Equal(t, []DiffTopic{{ID: "topic-1", Label: "Databases"}}, diff.RemovedTopics,
+		"a reused ID with an unrelated label and pages is another topic")
+	assert.Equal(t, []DiffTopic{{ID: "topic-1", Label: "Kubernetes"}}, diff.AddedTopics)
+
+	if assert.Len(t, diff.RenamedTopics, 3) {
+		assert.Equal(t, "Generics in Go", diff.RenamedTopics[0].To.Label, "same ID, similar label")
+		assert.InDelta(t, 0.8, diff.RenamedTopics[0].Similarity, 1e-9)
+		assert.Equal(t, DiffTopic{ID: "topic-5", Label: "Ownership in Rust"}, diff.RenamedTopics[1].To, "similar label")
+		assert.Equal(t, "Build automation", diff.RenamedTopics[2].To.Label, "same ID and pages")
+	}
+
+	assert.Equal(t, []DiffPageMove{
+		{ID: "p3", Label: "Page p3", From: DiffTopic{ID: "topic-1", Label: "Databases"}, To: DiffTopic{ID: "topic-1", Label: "Kubernetes"}},
+		{ID: "p2", Label: "Page p2", From: DiffTopic{ID: "topic-0", Label: "Go generics"}, To: DiffTopic{ID: "topic-5", Label: "Ownership in Rust"}},
+	}, diff.MovedPages)
+}
+
+func TestDiffMindmaps_Unchanged(t *testing.T) {
+	data := diffTestGraph([]MindmapNode{{ID: "topic-0", Label: "Go generics"}}, map[string][]string{"topic-0": {"p1"}})
+
+	diff := DiffMindmaps(data, data)
+
+	assert.Empty(t, diff.AddedTopics)
+	assert.Empty(t, diff.RemovedTopics)
+	assert.Empty(t, diff.RenamedTopics)
+	assert.Empty(t, diff.MovedPages)
+	assert.NotNil(t, diff.MovedPages, "lists encode as empty arrays")
+}
+
+func TestLabelSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, labelSimilarity("Go Generics!", "go generics"))
+	assert.Less(t, labelSimilarity("Databases", "Kubernetes"), sameIDSimilarity)
+	assert.Greater(t, labelSimilarity("머신러닝 기초", "머신러닝의 기초"), renameSimilarity)
+	assert.Equal(t, 0.0, labelSimilarity("A", "B"))
+}
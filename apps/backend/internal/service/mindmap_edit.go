@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/mindmapedit"
+	"github.com/mindhit/api/ent/mindmapgraph"
+)
+
+// Mindmap edit errors.
+var (
+	// ErrInvalidMindmapEdit is returned for an edit that doesn't apply to the
+	// current graph.
+	ErrInvalidMindmapEdit = errors.New("invalid mindmap edit")
+	// ErrMindmapNotCompleted is returned when a mindmap without a generated
+	// graph is edited.
+	ErrMindmapNotCompleted = errors.New("mindmap is not completed")
+	// ErrMindmapChanged is returned when a mindmap changed while it was being
+	// edited.
+	ErrMindmapChanged = errors.New("mindmap changed while it was edited")
+)
+
+// MindmapEditOp is the kind of a manual mindmap edit.
+type MindmapEditOp string
+
+// Mindmap edit operations.
+const (
+	EditRename   MindmapEditOp = "rename"    // NodeID topic gets Label
+	EditRecolor  MindmapEditOp = "recolor"   // NodeID topic gets Color
+	EditPin      MindmapEditOp = "pin"       // NodeID topic is kept by regenerations, at Position when set
+	EditDelete   MindmapEditOp = "delete"    // NodeID topic is removed with its subtopics and the pages only they had
+	EditMerge    MindmapEditOp = "merge"     // NodeIDs topics move into TargetID, renamed to Label when set
+	EditSplit    MindmapEditOp = "split"     // PageIDs of NodeID topic move to a new topic named Label
+	EditMovePage MindmapEditOp = "move_page" // NodeID page moves to TargetID topic
+	EditAddEdge  MindmapEditOp = "add_edge"  // NodeID gets connected to TargetID, with Label
+	EditNote     MindmapEditOp = "note"      // NodeID gets Note, removed when empty
+)
+
+// Mindmap edit limits.
+const (
+	maxMindmapEdits        = 100
+	maxEditLabelLength     = 100
+	maxEditNoteLength      = 2000
+	maxEditEdgeLabelLength = 200
+)
+
+var editColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// MindmapEdit is a manual change to a mindmap graph. The fields used depend
+// on Op.
+type MindmapEdit struct {
+	Op       MindmapEditOp `json:"op"`
+	NodeID   string        `json:"node_id,omitempty"`
+	NodeIDs  []string      `json:"node_ids,omitempty"`
+	TargetID string        `json:"target_id,omitempty"`
+	PageIDs  []string      `json:"page_ids,omitempty"`
+	Label    string        `json:"label,omitempty"`
+	Color    string        `json:"color,omitempty"`
+	Pinned   *bool         `json:"pinned,omitempty"`
+	Position *Position     `json:"position,omitempty"`
+	Note     string        `json:"note,omitempty"`
+
+	// Recorded when the edit is applied, to replay it onto a regenerated
+	// graph where topic IDs may mean other topics.
+
+	// Anchors are the labels of the topics the edit refers to.
+	Anchors map[string]string `json:"anchors,omitempty"`
+	// NewID is the ID of the topic a split created.
+	NewID string `json:"new_id,omitempty"`
+	// Snapshot is a pinned topic with its subtopics and pages, put back when
+	// a regenerated graph no longer has the topic. SnapshotEdges connect
+	// them.
+	Snapshot      []MindmapNode `json:"snapshot,omitempty"`
+	SnapshotEdges []MindmapEdge `json:"snapshot_edges,omitempty"`
+}
+
+// Validate checks that the edit has the arguments of its operation.
+func (e MindmapEdit) Validate() error {
+	needNode := func() error {
+		if e.NodeID == "" {
+			return fmt.Errorf("%s needs node_id", e.Op)
+		}
+		return nil
+	}
+	needTarget := func() error {
+		if e.TargetID == "" {
+			return fmt.Errorf("%s needs target_id", e.Op)
+		}
+		return nil
+	}
+	label := func(required bool) error {
+		if required && e.Label == "" {
+			return fmt.Errorf("%s needs label", e.Op)
+		}
+		if utf8.RuneCountInString(e.Label) > maxEditLabelLength {
+			return fmt.Errorf("label is longer than %d characters", maxEditLabelLength)
+		}
+		return nil
+	}
+
+	switch e.Op {
+	case EditRename, EditSplit:
+		if err := needNode(); err != nil {
+			return err
+		}
+		if e.Op == EditSplit && len(e.PageIDs) == 0 {
+			return errors.New("split needs page_ids")
+		}
+		return label(true)
+	case EditRecolor:
+		if err := needNode(); err != nil {
+			return err
+		}
+		if !editColorPattern.MatchString(e.Color) {
+			return errors.New("color must be a hex color such as #3B82F6")
+		}
+	case EditPin:
+		if err := needNode(); err != nil {
+			return err
+		}
+		if e.Pinned == nil {
+			return errors.New("pin needs pinned")
+		}
+	case EditDelete:
+		return needNode()
+	case EditMerge:
+		if len(e.NodeIDs) == 0 {
+			return errors.New("merge needs node_ids")
+		}
+		if err := needTarget(); err != nil {
+			return err
+		}
+		return label(false)
+	case EditMovePage:
+		if err := needNode(); err != nil {
+			return err
+		}
+		return needTarget()
+	case EditAddEdge:
+		if err := needNode(); err != nil {
+			return err
+		}
+		if err := needTarget(); err != nil {
+			return err
+		}
+		if utf8.RuneCountInString(e.Label) > maxEditEdgeLabelLength {
+			return fmt.Errorf("label is longer than %d characters", maxEditEdgeLabelLength)
+		}
+	case EditNote:
+		if err := needNode(); err != nil {
+			return err
+		}
+		if utf8.RuneCountInString(e.Note) > maxEditNoteLength {
+			return fmt.Errorf("note is longer than %d characters", maxEditNoteLength)
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", e.Op)
+	}
+	return nil
+}
+
+// ApplyEdits applies edits to a graph, in order, recording on each what
+// replaying it needs. It fails on the first edit that doesn't apply.
+func ApplyEdits(data MindmapData, edits []MindmapEdit) (MindmapData, error) {
+	g := newEditGraph(cloneMindmapData(data))
+	for i := range edits {
+		if err := edits[i].Validate(); err != nil {
+			return MindmapData{}, fmt.Errorf("%w: edit %d: %v", ErrInvalidMindmapEdit, i, err)
+		}
+		if err := g.apply(&edits[i], false); err != nil {
+			return MindmapData{}, fmt.Errorf("%w: edit %d: %v", ErrInvalidMindmapEdit, i, err)
+		}
+	}
+	return g.data, nil
+}
+
+// ReplayEdits applies logged edits to a regenerated graph. Topics are found
+// by ID when their label still matches the one the edit was made on, and by
+// label otherwise; pages by ID. Edits whose nodes are gone are skipped and
+// counted, except pins, which put their topic back.
+func ReplayEdits(data MindmapData, edits []MindmapEdit) (MindmapData, int) {
+	g := newEditGraph(cloneMindmapData(data))
+	skipped := 0
+	for i := range edits {
+		if err := g.apply(&edits[i], true); err != nil {
+			skipped++
+		}
+	}
+	return g.data, skipped
+}
+
+// Edit applies edits to the current graph of a session's mindmap and appends
+// them to its edit log, all or none.
+func (s *MindmapService) Edit(ctx context.Context, sessionID, userID uuid.UUID, edits []MindmapEdit) (*ent.MindmapGraph, error) {
+	if len(edits) == 0 || len(edits) > maxMindmapEdits {
+		return nil, fmt.Errorf("%w: between 1 and %d edits at a time", ErrInvalidMindmapEdit, maxMindmapEdits)
+	}
+
+	mindmap, err := s.GetBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mindmap.Status != mindmapgraph.StatusCompleted {
+		return nil, ErrMindmapNotCompleted
+	}
+
+	current, err := ConvertMapsToData(mindmap.Nodes, mindmap.GraphEdges, mindmap.Layout)
+	if err != nil {
+		return nil, fmt.Errorf("read mindmap: %w", err)
+	}
+	edited, err := ApplyEdits(current, edits)
+	if err != nil {
+		return nil, err
+	}
+	// Merges and splits change the hierarchy the layout follows
+	if err := LayoutMindmap(&edited); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Any change since the mindmap was read, another edit, a regeneration or
+	// a restore, moves updated_at
+	updated, err := tx.MindmapGraph.UpdateOne(mindmap).
+		Where(
+			mindmapgraph.UpdatedAt(mindmap.UpdatedAt),
+			mindmapgraph.StatusEQ(mindmapgraph.StatusCompleted),
+		).
+		SetNodes(ConvertNodesToMaps(edited.Nodes)).
+		SetGraphEdges(ConvertEdgesToMaps(edited.Edges)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMindmapChanged
+		}
+		return nil, err
+	}
+
+	builders := make([]*ent.MindmapEditCreate, len(edits))
+	for i, e := range edits {
+		payload, err := editPayload(e)
+		if err != nil {
+			return nil, err
+		}
+		builders[i] = tx.MindmapEdit.Create().
+			SetMindmapID(mindmap.ID).
+			SetOp(mindmapedit.Op(e.Op)).
+			SetPayload(payload)
+	}
+	if err := tx.MindmapEdit.CreateBulk(builders...).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("log mindmap edits: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	// Renames, merges and splits change the topics the knowledge graph has
+	s.enqueueKnowledgeUpdate(updated)
+	return updated, nil
+}
+
+// ListEdits returns the edit log of a session's mindmap, oldest first.
+func (s *MindmapService) ListEdits(ctx context.Context, sessionID, userID uuid.UUID) ([]*ent.MindmapEdit, error) {
+	mindmap, err := s.GetBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.MindmapEdit.Query().
+		Where(mindmapedit.HasMindmapWith(mindmapgraph.ID(mindmap.ID))).
+		Order(ent.Asc(mindmapedit.FieldID)).
+		All(ctx)
+}
+
+// loadEdits reads the edit log of a mindmap, oldest first.
+func loadEdits(ctx context.Context, tx *ent.Tx, mindmapID uuid.UUID) ([]MindmapEdit, error) {
+	records, err := tx.MindmapEdit.Query().
+		Where(mindmapedit.HasMindmapWith(mindmapgraph.ID(mindmapID))).
+		Order(ent.Asc(mindmapedit.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query mindmap edits: %w", err)
+	}
+
+	edits := make([]MindmapEdit, len(records))
+	for i, r := range records {
+		if edits[i], err = EditFromRecord(r); err != nil {
+			return nil, err
+		}
+	}
+	return edits, nil
+}
+
+// EditFromRecord reads the edit of an edit log entry.
+func EditFromRecord(r *ent.MindmapEdit) (MindmapEdit, error) {
+	raw, err := json.Marshal(r.Payload)
+	if err != nil {
+		return MindmapEdit{}, err
+	}
+	var e MindmapEdit
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return MindmapEdit{}, fmt.Errorf("read mindmap edit %d: %w", r.ID, err)
+	}
+	e.Op = MindmapEditOp(r.Op)
+	return e, nil
+}
+
+func editPayload(e MindmapEdit) (map[string]interface{}, error) {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+	delete(payload, "op") // Stored in its own column
+	return payload, nil
+}
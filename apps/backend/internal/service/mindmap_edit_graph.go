@@ -0,0 +1,526 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// editGraph applies mindmap edits to a graph.
+//
+// Applying an edit checks it against the graph and records on it the labels
+// of the topics it refers to, the ID of a topic it creates and the snapshot
+// of a topic it pins. Replaying an edit uses what was recorded to find its
+// topics in a regenerated graph.
+type editGraph struct {
+	data MindmapData
+}
+
+func newEditGraph(data MindmapData) *editGraph {
+	return &editGraph{data: data}
+}
+
+func (g *editGraph) apply(e *MindmapEdit, replay bool) error {
+	switch e.Op {
+	case EditRename:
+		id, err := g.topic(e, e.NodeID, replay)
+		if err != nil {
+			return err
+		}
+		g.find(id).Label = e.Label
+	case EditRecolor:
+		id, err := g.topic(e, e.NodeID, replay)
+		if err != nil {
+			return err
+		}
+		g.find(id).Color = e.Color
+	case EditPin:
+		return g.pin(e, replay)
+	case EditDelete:
+		id, err := g.topic(e, e.NodeID, replay)
+		if err != nil {
+			return err
+		}
+		tree := g.subtree(id)
+		var pages []string
+		for _, t := range tree {
+			pages = append(pages, g.pages(t)...)
+		}
+		for _, t := range tree {
+			g.removeNode(t)
+		}
+		for _, page := range pages {
+			if g.find(page) != nil && len(g.parents(page)) == 0 {
+				g.removeNode(page)
+			}
+		}
+	case EditMerge:
+		return g.merge(e, replay)
+	case EditSplit:
+		return g.split(e, replay)
+	case EditMovePage:
+		page, err := g.page(e.NodeID)
+		if err != nil {
+			return err
+		}
+		target, err := g.topic(e, e.TargetID, replay)
+		if err != nil {
+			return err
+		}
+		g.attach(target, page)
+	case EditAddEdge:
+		source, err := g.node(e, e.NodeID, replay)
+		if err != nil {
+			return err
+		}
+		target, err := g.node(e, e.TargetID, replay)
+		if err != nil {
+			return err
+		}
+		if source == target {
+			return errors.New("a node can't be connected to itself")
+		}
+		if g.connected(source, target) {
+			return fmt.Errorf("nodes %s and %s are already connected", source, target)
+		}
+		g.data.Edges = append(g.data.Edges, MindmapEdge{
+			Source: source,
+			Target: target,
+			Weight: 1,
+			Label:  e.Label,
+			Manual: true,
+		})
+	case EditNote:
+		id, err := g.node(e, e.NodeID, replay)
+		if err != nil {
+			return err
+		}
+		n := g.find(id)
+		if e.Note == "" {
+			delete(n.Data, "note")
+		} else {
+			setNodeData(n, "note", e.Note)
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", e.Op)
+	}
+	return nil
+}
+
+// pin marks a topic as pinned, or unpins it. A pinned topic a regenerated
+// graph no longer has is put back from its snapshot.
+func (g *editGraph) pin(e *MindmapEdit, replay bool) error {
+	if e.Pinned == nil {
+		return errors.New("pin needs pinned")
+	}
+	id, err := g.topic(e, e.NodeID, replay)
+	if err != nil {
+		if !replay || !*e.Pinned || len(e.Snapshot) == 0 {
+			return err
+		}
+		snapshot := cloneMindmapData(MindmapData{Nodes: e.Snapshot, Edges: e.SnapshotEdges})
+		id = g.restorePinned(snapshot.Nodes, snapshot.Edges)
+	}
+
+	n := g.find(id)
+	if *e.Pinned {
+		setNodeData(n, "pinned", true)
+	} else {
+		delete(n.Data, "pinned")
+	}
+	if e.Position != nil {
+		pos := *e.Position
+		n.Position = &pos
+	}
+
+	if !replay && *e.Pinned {
+		tree := g.subtree(id)
+		nodes := make([]MindmapNode, len(tree))
+		for i, t := range tree {
+			nodes[i] = *g.find(t)
+		}
+		var edges []MindmapEdge
+		for _, edge := range g.data.Edges {
+			if !slices.Contains(tree, edge.Source) {
+				continue
+			}
+			switch child := g.find(edge.Target); {
+			case child == nil:
+			case child.Type == "page":
+				if !slices.ContainsFunc(nodes, func(n MindmapNode) bool { return n.ID == child.ID }) {
+					nodes = append(nodes, *child)
+				}
+				edges = append(edges, edge)
+			case slices.Contains(tree, child.ID):
+				edges = append(edges, edge)
+			}
+		}
+		snapshot := cloneMindmapData(MindmapData{Nodes: nodes, Edges: edges})
+		e.Snapshot, e.SnapshotEdges = snapshot.Nodes, snapshot.Edges
+	}
+	return nil
+}
+
+// restorePinned adds a pinned topic back with the subtopics and pages it
+// had, moving the pages the graph has elsewhere, and returns its ID.
+// Snapshots without edges, recorded before subtopics were kept, hang every
+// page under the topic.
+func (g *editGraph) restorePinned(snapshot []MindmapNode, edges []MindmapEdge) string {
+	ids := make(map[string]string, len(snapshot))
+	for i, n := range snapshot {
+		if n.Type == "page" {
+			if g.find(n.ID) == nil {
+				g.data.Nodes = append(g.data.Nodes, n)
+			}
+			ids[n.ID] = n.ID
+			continue
+		}
+		for g.find(n.ID) != nil {
+			n.ID += "-pinned" // The ID names another topic now
+		}
+		ids[snapshot[i].ID] = n.ID
+		g.data.Nodes = append(g.data.Nodes, n)
+	}
+	topic := ids[snapshot[0].ID]
+	if core := g.coreID(); core != "" {
+		g.data.Edges = append(g.data.Edges, MindmapEdge{Source: core, Target: topic, Weight: 1})
+	}
+
+	if len(edges) == 0 {
+		for _, page := range snapshot[1:] {
+			g.attach(topic, page.ID)
+		}
+		return topic
+	}
+	for _, edge := range edges {
+		source, target := ids[edge.Source], ids[edge.Target]
+		if source == "" || target == "" {
+			continue
+		}
+		if g.find(target).Type == "page" {
+			g.attach(source, target)
+			g.data.Edges[len(g.data.Edges)-1].Weight = edge.Weight // As it was when pinned
+			continue
+		}
+		edge.Source, edge.Target = source, target
+		g.data.Edges = append(g.data.Edges, edge)
+	}
+	return topic
+}
+
+// merge moves the pages and connections of topics into a target topic and
+// removes them.
+func (g *editGraph) merge(e *MindmapEdit, replay bool) error {
+	target, err := g.topic(e, e.TargetID, replay)
+	if err != nil {
+		return err
+	}
+
+	var sources []string
+	for _, id := range e.NodeIDs {
+		source, err := g.topic(e, id, replay)
+		if err == nil && source == target {
+			err = errors.New("a topic can't be merged into itself")
+		}
+		if err != nil {
+			if replay {
+				continue
+			}
+			return err
+		}
+		sources = append(sources, source)
+	}
+	if len(sources) == 0 {
+		return errors.New("no topics to merge")
+	}
+
+	for _, source := range sources {
+		for i := range g.data.Edges {
+			edge := &g.data.Edges[i]
+			if edge.Source == source {
+				edge.Source = target
+			}
+			if edge.Target == source {
+				edge.Target = target
+			}
+		}
+		g.removeNode(source)
+	}
+	g.dedupeEdges()
+
+	if e.Label != "" {
+		g.find(target).Label = e.Label
+	}
+	return nil
+}
+
+// split moves pages of a topic to a new topic.
+func (g *editGraph) split(e *MindmapEdit, replay bool) error {
+	source, err := g.topic(e, e.NodeID, replay)
+	if err != nil {
+		return err
+	}
+
+	sourcePages := g.pages(source)
+	var pages []string
+	for _, id := range e.PageIDs {
+		if !slices.Contains(sourcePages, id) {
+			if replay {
+				continue
+			}
+			return fmt.Errorf("page %s is not in topic %s", id, source)
+		}
+		pages = append(pages, id)
+	}
+	if len(pages) == 0 {
+		return errors.New("no pages to split")
+	}
+
+	if !replay {
+		e.NewID = "manual-" + uuid.NewString()
+	}
+	if e.NewID == "" || g.find(e.NewID) != nil {
+		return fmt.Errorf("can't create topic %q", e.NewID)
+	}
+
+	src := g.find(source)
+	topic := MindmapNode{
+		ID:    e.NewID,
+		Label: e.Label,
+		Type:  "topic",
+		Size:  src.Size,
+		Color: src.Color,
+		Data:  map[string]interface{}{"manual": true},
+	}
+	if src.Position != nil {
+		pos := *src.Position
+		topic.Position = &pos
+	}
+	g.data.Nodes = append(g.data.Nodes, topic)
+
+	parent := g.coreID()
+	if parent == "" {
+		parent = source
+	}
+	g.data.Edges = append(g.data.Edges, MindmapEdge{Source: parent, Target: topic.ID, Weight: 1})
+	for _, page := range pages {
+		g.attach(topic.ID, page)
+	}
+	return nil
+}
+
+// topic returns the ID of a topic an edit refers to. Applying, it must be a
+// topic of the graph, and its label is recorded; replaying, it is resolved.
+func (g *editGraph) topic(e *MindmapEdit, id string, replay bool) (string, error) {
+	if replay {
+		if resolved, ok := g.resolveTopic(id, e.Anchors[id]); ok {
+			return resolved, nil
+		}
+		return "", fmt.Errorf("topic %s is gone", id)
+	}
+
+	n := g.find(id)
+	if n == nil {
+		return "", fmt.Errorf("node %s not found", id)
+	}
+	if !isTopicNode(*n) {
+		return "", fmt.Errorf("node %s is not a topic", id)
+	}
+	if e.Anchors == nil {
+		e.Anchors = make(map[string]string)
+	}
+	e.Anchors[id] = n.Label
+	return id, nil
+}
+
+// node returns the ID of any node an edit refers to, resolving topics.
+func (g *editGraph) node(e *MindmapEdit, id string, replay bool) (string, error) {
+	n := g.find(id)
+	if n != nil && !isTopicNode(*n) {
+		return id, nil
+	}
+	if n == nil && !replay {
+		return "", fmt.Errorf("node %s not found", id)
+	}
+	return g.topic(e, id, replay)
+}
+
+// page returns the ID of a page node. Page IDs are URL IDs, the same in
+// every graph of a session.
+func (g *editGraph) page(id string) (string, error) {
+	n := g.find(id)
+	if n == nil {
+		return "", fmt.Errorf("node %s not found", id)
+	}
+	if n.Type != "page" {
+		return "", fmt.Errorf("node %s is not a page", id)
+	}
+	return id, nil
+}
+
+// resolveTopic finds the topic an edit made on the topic id, labeled anchor,
+// refers to. A topic keeping the ID must keep a similar label, as generated
+// IDs get reused; topics the user created keep theirs. Otherwise the topic
+// with the most similar label is used.
+func (g *editGraph) resolveTopic(id, anchor string) (string, bool) {
+	if n := g.find(id); n != nil && isTopicNode(*n) {
+		if anchor == "" || n.Data["manual"] == true || labelSimilarity(anchor, n.Label) >= sameIDSimilarity {
+			return id, true
+		}
+	}
+	if anchor == "" {
+		return "", false
+	}
+
+	best, bestSimilarity := "", 0.0
+	for _, n := range g.data.Nodes {
+		if !isTopicNode(n) {
+			continue
+		}
+		if sim := labelSimilarity(anchor, n.Label); sim >= renameSimilarity && sim > bestSimilarity {
+			best, bestSimilarity = n.ID, sim
+		}
+	}
+	return best, best != ""
+}
+
+// attach moves a page under a topic, keeping the weight it had.
+func (g *editGraph) attach(topic, page string) {
+	weight, found := 1.0, false
+	g.removeEdges(func(edge MindmapEdge) bool {
+		if edge.Target != page {
+			return false
+		}
+		if n := g.find(edge.Source); n == nil || !isTopicNode(*n) {
+			return false
+		}
+		if !found {
+			weight, found = edge.Weight, true
+		}
+		return true
+	})
+	g.data.Edges = append(g.data.Edges, MindmapEdge{Source: topic, Target: page, Weight: weight})
+}
+
+func (g *editGraph) find(id string) *MindmapNode {
+	for i := range g.data.Nodes {
+		if g.data.Nodes[i].ID == id {
+			return &g.data.Nodes[i]
+		}
+	}
+	return nil
+}
+
+func (g *editGraph) coreID() string {
+	for _, n := range g.data.Nodes {
+		if n.Type == "core" {
+			return n.ID
+		}
+	}
+	return ""
+}
+
+// subtree returns a topic followed by the subtopics under it, and under
+// those, that no topic outside of it has.
+func (g *editGraph) subtree(topic string) []string {
+	tree := []string{topic}
+	for i := 0; i < len(tree); i++ {
+		for _, edge := range g.data.Edges {
+			if edge.Source != tree[i] || slices.Contains(tree, edge.Target) {
+				continue
+			}
+			n := g.find(edge.Target)
+			if n == nil || n.Type != "subtopic" {
+				continue
+			}
+			owned := true
+			for _, parent := range g.parents(n.ID) {
+				owned = owned && slices.Contains(tree, parent)
+			}
+			if owned {
+				tree = append(tree, n.ID)
+			}
+		}
+	}
+	return tree
+}
+
+// pages returns the pages of a topic.
+func (g *editGraph) pages(topic string) []string {
+	var pages []string
+	for _, edge := range g.data.Edges {
+		if edge.Source == topic {
+			if n := g.find(edge.Target); n != nil && n.Type == "page" {
+				pages = append(pages, edge.Target)
+			}
+		}
+	}
+	return pages
+}
+
+// parents returns the topics of a page.
+func (g *editGraph) parents(page string) []string {
+	var topics []string
+	for _, edge := range g.data.Edges {
+		if edge.Target == page {
+			if n := g.find(edge.Source); n != nil && isTopicNode(*n) {
+				topics = append(topics, edge.Source)
+			}
+		}
+	}
+	return topics
+}
+
+func (g *editGraph) connected(a, b string) bool {
+	for _, edge := range g.data.Edges {
+		if (edge.Source == a && edge.Target == b) || (edge.Source == b && edge.Target == a) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeNode removes a node and its edges.
+func (g *editGraph) removeNode(id string) {
+	g.data.Nodes = slices.DeleteFunc(g.data.Nodes, func(n MindmapNode) bool { return n.ID == id })
+	g.removeEdges(func(edge MindmapEdge) bool { return edge.Source == id || edge.Target == id })
+}
+
+func (g *editGraph) removeEdges(remove func(MindmapEdge) bool) {
+	g.data.Edges = slices.DeleteFunc(g.data.Edges, remove)
+}
+
+// dedupeEdges removes loops and repeated edges, keeping the first of each.
+func (g *editGraph) dedupeEdges() {
+	seen := make(map[[2]string]bool, len(g.data.Edges))
+	g.removeEdges(func(edge MindmapEdge) bool {
+		key := [2]string{edge.Source, edge.Target}
+		if edge.Source == edge.Target || seen[key] {
+			return true
+		}
+		seen[key] = true
+		return false
+	})
+}
+
+func isTopicNode(n MindmapNode) bool {
+	return n.Type == "topic" || n.Type == "subtopic"
+}
+
+func setNodeData(n *MindmapNode, key string, value interface{}) {
+	if n.Data == nil {
+		n.Data = make(map[string]interface{})
+	}
+	n.Data[key] = value
+}
+
+// cloneMindmapData deep-copies a graph, so edits don't change the original.
+func cloneMindmapData(data MindmapData) MindmapData {
+	clone, err := ConvertMapsToData(ConvertNodesToMaps(data.Nodes), ConvertEdgesToMaps(data.Edges), ConvertLayoutToMap(data.Layout))
+	if err != nil {
+		return data // Only JSON values are stored, this doesn't happen
+	}
+	return clone
+}
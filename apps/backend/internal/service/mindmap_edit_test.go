@@ -0,0 +1,178 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func editTestNode(data MindmapData, id string) *MindmapNode {
+	for i := range data.Nodes {
+		if data.Nodes[i].ID == id {
+			return &data.Nodes[i]
+		}
+	}
+	return nil
+}
+
+func editTestPages(data MindmapData, topic string) []string {
+	return newEditGraph(data).pages(topic)
+}
+
+func TestMindmapEdit_Validate(t *testing.T) {
+	pinned := true
+	tests := []struct {
+		edit  MindmapEdit
+		valid bool
+	}{
+		{MindmapEdit{Op: EditRename, NodeID: "topic-0", Label: "Go"}, true},
+		{MindmapEdit{Op: EditRename, NodeID: "topic-0"}, false},
+		{MindmapEdit{Op: EditRecolor, NodeID: "topic-0", Color: "#3B82F6"}, true},
+		{MindmapEdit{Op: EditRecolor, NodeID: "topic-0", Color: "blue"}, false},
+		{MindmapEdit{Op: EditPin, NodeID: "topic-0", Pinned: &pinned}, true},
+		{MindmapEdit{Op: EditPin, NodeID: "topic-0"}, false},
+		{MindmapEdit{Op: EditMerge, NodeIDs: []string{"topic-1"}, TargetID: "topic-0"}, true},
+		{MindmapEdit{Op: EditMerge, TargetID: "topic-0"}, false},
+		{MindmapEdit{Op: EditSplit, NodeID: "topic-0", Label: "Go"}, false},
+		{MindmapEdit{Op: EditMovePage, NodeID: "p1"}, false},
+		{MindmapEdit{Op: EditNote, NodeID: "p1", Note: ""}, true},
+		{MindmapEdit{Op: "archive", NodeID: "topic-0"}, false},
+	}
+	for _, tt := range tests {
+		err := tt.edit.Validate()
+		assert.Equal(t, tt.valid, err == nil, "%+v: %v", tt.edit, err)
+	}
+}
+
+func TestApplyEdits(t *testing.T) {
+	base := diffTestGraph([]MindmapNode{
+		{ID: "topic-0", Label: "Go generics"},
+		{ID: "topic-1", Label: "Go interfaces"},
+		{ID: "topic-2", Label: "Databases"},
+	}, map[string][]string{
+		"topic-0": {"p1", "p2", "p3"},
+		"topic-1": {"p4"},
+		"topic-2": {"p5"},
+	})
+
+	edits := []MindmapEdit{
+		{Op: EditRename, NodeID: "topic-0", Label: "Generics"},
+		{Op: EditMerge, NodeIDs: []string{"topic-1"}, TargetID: "topic-0", Label: "Go types"},
+		{Op: EditSplit, NodeID: "topic-0", Label: "Constraints", PageIDs: []string{"p3"}},
+		{Op: EditMovePage, NodeID: "p2", TargetID: "topic-2"},
+		{Op: EditAddEdge, NodeID: "topic-0", TargetID: "topic-2", Label: "storage of types"},
+		{Op: EditNote, NodeID: "p1", Note: "read again"},
+	}
+	data, err := ApplyEdits(base, edits)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Go types", editTestNode(data, "topic-0").Label)
+	assert.Nil(t, editTestNode(data, "topic-1"), "merged")
+	assert.ElementsMatch(t, []string{"p1", "p4"}, editTestPages(data, "topic-0"))
+	assert.ElementsMatch(t, []string{"p5", "p2"}, editTestPages(data, "topic-2"))
+
+	split := editTestNode(data, edits[2].NewID)
+	require.NotNil(t, split)
+	assert.Equal(t, "Constraints", split.Label)
+	assert.Equal(t, true, split.Data["manual"])
+	assert.Equal(t, []string{"p3"}, editTestPages(data, split.ID))
+
+	assert.Contains(t, data.Edges, MindmapEdge{Source: "topic-0", Target: "topic-2", Weight: 1, Label: "storage of types", Manual: true})
+	assert.Equal(t, "read again", editTestNode(data, "p1").Data["note"])
+	assert.Equal(t, map[string]string{"topic-0": "Generics", "topic-1": "Go interfaces"}, edits[1].Anchors)
+
+	assert.Equal(t, "Go generics", editTestNode(base, "topic-0").Label, "the original graph is unchanged")
+}
+
+func TestApplyEdits_Invalid(t *testing.T) {
+	base := diffTestGraph([]MindmapNode{{ID: "topic-0", Label: "Go generics"}}, map[string][]string{"topic-0": {"p1"}})
+
+	tests := []MindmapEdit{
+		{Op: EditRename, NodeID: "topic-9", Label: "Go"},
+		{Op: EditRename, NodeID: "p1", Label: "Go"},
+		{Op: EditMerge, NodeIDs: []string{"topic-0"}, TargetID: "topic-0"},
+		{Op: EditSplit, NodeID: "topic-0", Label: "Other", PageIDs: []string{"p9"}},
+		{Op: EditMovePage, NodeID: "topic-0", TargetID: "topic-0"},
+		{Op: EditAddEdge, NodeID: "topic-0", TargetID: "p1"},
+	}
+	for _, edit := range tests {
+		_, err := ApplyEdits(base, []MindmapEdit{edit})
+		assert.ErrorIs(t, err, ErrInvalidMindmapEdit, "%+v", edit)
+	}
+}
+
+func TestApplyEdits_Delete(t *testing.T) {
+	base := diffTestGraph([]MindmapNode{
+		{ID: "topic-0", Label: "Go generics"},
+		{ID: "topic-1", Label: "Databases"},
+	}, map[string][]string{
+		"topic-0": {"p1", "p2"},
+		"topic-1": {"p2"},
+	})
+
+	data, err := ApplyEdits(base, []MindmapEdit{{Op: EditDelete, NodeID: "topic-0"}})
+	require.NoError(t, err)
+
+	assert.Nil(t, editTestNode(data, "topic-0"))
+	assert.Nil(t, editTestNode(data, "p1"), "a page only the topic had")
+	assert.NotNil(t, editTestNode(data, "p2"), "a page another topic has")
+	for _, edge := range data.Edges {
+		assert.NotEqual(t, "topic-0", edge.Source)
+		assert.NotEqual(t, "topic-0", edge.Target)
+	}
+}
+
+func TestReplayEdits(t *testing.T) {
+	before := diffTestGraph([]MindmapNode{
+		{ID: "topic-0", Label: "Go generics"},
+		{ID: "topic-1", Label: "Databases"},
+		{ID: "topic-2", Label: "CI pipelines"},
+	}, map[string][]string{
+		"topic-0": {"p1"},
+		"topic-1": {"p2", "p3"},
+		"topic-2": {"p4"},
+	})
+	pinned := true
+	edits := []MindmapEdit{
+		{Op: EditRename, NodeID: "topic-0", Label: "Generics"},
+		{Op: EditRecolor, NodeID: "topic-1", Color: "#000000"},
+		{Op: EditPin, NodeID: "topic-2", Pinned: &pinned, Position: &Position{X: 10, Y: 20}},
+		{Op: EditNote, NodeID: "p2", Note: "key page"},
+	}
+	_, err := ApplyEdits(before, edits)
+	require.NoError(t, err)
+
+	// The regenerated graph reuses topic-0 for another topic, moves the
+	// generics topic to topic-3 and drops the CI topic
+	after := diffTestGraph([]MindmapNode{
+		{ID: "topic-0", Label: "Kubernetes"},
+		{ID: "topic-1", Label: "Databases"},
+		{ID: "topic-3", Label: "Go generics"},
+	}, map[string][]string{
+		"topic-0": {"p4", "p5"},
+		"topic-1": {"p2"},
+		"topic-3": {"p1"},
+	})
+
+	data, skipped := ReplayEdits(after, edits)
+
+	assert.Equal(t, 0, skipped)
+	assert.Equal(t, "Kubernetes", editTestNode(data, "topic-0").Label, "a reused ID is another topic")
+	assert.Equal(t, "Generics", editTestNode(data, "topic-3").Label, "found by label")
+	assert.Equal(t, "#000000", editTestNode(data, "topic-1").Color)
+	assert.Equal(t, "key page", editTestNode(data, "p2").Data["note"])
+
+	restored := editTestNode(data, "topic-2")
+	require.NotNil(t, restored, "a pinned topic is put back")
+	assert.Equal(t, "CI pipelines", restored.Label)
+	assert.Equal(t, true, restored.Data["pinned"])
+	assert.Equal(t, &Position{X: 10, Y: 20}, restored.Position)
+	assert.Equal(t, []string{"p4"}, editTestPages(data, "topic-2"))
+	assert.Equal(t, []string{"p5"}, editTestPages(data, "topic-0"))
+
+	// Without its topic, an edit is skipped
+	edits = append(edits, MindmapEdit{Op: EditRename, NodeID: "topic-9", Label: "Gone", Anchors: map[string]string{"topic-9": "Serverless"}})
+	_, skipped = ReplayEdits(after, edits)
+	assert.Equal(t, 1, skipped)
+}
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/session"
+	enturl "github.com/mindhit/api/ent/url"
+)
+
+// Mindmap export errors.
+var (
+	// ErrUnknownExportFormat is returned for a format mindmaps don't export to.
+	ErrUnknownExportFormat = errors.New("unknown export format")
+	// ErrExportNotInPlan is returned for a format the user's plan doesn't
+	// include.
+	ErrExportNotInPlan = errors.New("export format is not included in the plan")
+)
+
+// ExportFormat is a file format a mindmap exports to.
+type ExportFormat string
+
+// Mindmap export formats.
+const (
+	ExportMarkdown ExportFormat = "markdown" // outline as nested lists
+	ExportOPML     ExportFormat = "opml"     // outline for outliners
+	ExportFreeMind ExportFormat = "freemind" // .mm mind map
+	ExportGraphML  ExportFormat = "graphml"  // graph with all node and edge data
+	ExportDOT      ExportFormat = "dot"      // Graphviz graph
+	ExportCanvas   ExportFormat = "canvas"   // Obsidian JSON Canvas
+	ExportSVG      ExportFormat = "svg"      // rendered image
+)
+
+type exportFormat struct {
+	feature     string // plan feature that unlocks the format
+	contentType string
+	extension   string
+	render      func(w io.Writer, doc *exportDoc) error
+}
+
+// exportFormats are the supported formats. Outlines come with Markdown
+// export and graph formats with JSON export, as plans list them.
+var exportFormats = map[ExportFormat]exportFormat{
+	ExportMarkdown: {"export_md", "text/markdown; charset=utf-8", "md", renderMarkdown},
+	ExportOPML:     {"export_md", "text/x-opml; charset=utf-8", "opml", renderOPML},
+	ExportFreeMind: {"export_json", "application/x-freemind", "mm", renderFreeMind},
+	ExportGraphML:  {"export_json", "application/graphml+xml", "graphml", renderGraphML},
+	ExportDOT:      {"export_json", "text/vnd.graphviz", "dot", renderDOT},
+	ExportCanvas:   {"export_json", "application/json", "canvas", renderCanvas},
+	ExportSVG:      {"export_svg", "image/svg+xml", "svg", renderSVG},
+}
+
+// MindmapExport is a mindmap rendered to a file.
+type MindmapExport struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// exportDoc is a mindmap with what its exports show besides the graph.
+type exportDoc struct {
+	title string
+	data  MindmapData
+	// urls maps page node IDs to the URLs of their pages.
+	urls map[string]string
+	// highlights maps page node IDs to the highlights made on their pages,
+	// and "" to the highlights of no page.
+	highlights map[string][]exportHighlight
+}
+
+type exportHighlight struct {
+	Text string
+	Note string
+}
+
+// Export renders the current graph of a session's mindmap in format, with
+// page nodes linking to their URLs and the session's highlights.
+func (s *MindmapService) Export(ctx context.Context, sessionID, userID uuid.UUID, format ExportFormat) (*MindmapExport, error) {
+	f, ok := exportFormats[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownExportFormat, format)
+	}
+
+	mindmap, err := s.GetBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := s.subscriptionService.HasFeature(ctx, userID, f.feature)
+	if err != nil {
+		return nil, fmt.Errorf("check plan: %w", err)
+	}
+	if !allowed {
+		return nil, fmt.Errorf("%w: %s", ErrExportNotInPlan, format)
+	}
+	if mindmap.Status != mindmapgraph.StatusCompleted {
+		return nil, ErrMindmapNotCompleted
+	}
+
+	data, err := ConvertMapsToData(mindmap.Nodes, mindmap.GraphEdges, mindmap.Layout)
+	if err != nil {
+		return nil, fmt.Errorf("read mindmap: %w", err)
+	}
+	doc, err := s.loadExportDoc(ctx, sessionID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := f.render(&buf, doc); err != nil {
+		return nil, fmt.Errorf("render %s: %w", format, err)
+	}
+	return &MindmapExport{
+		Filename:    fmt.Sprintf("mindmap-%s.%s", sessionID, f.extension),
+		ContentType: f.contentType,
+		Content:     buf.Bytes(),
+	}, nil
+}
+
+// loadExportDoc reads the session title, page URLs and highlights of a
+// mindmap. Page nodes name their URL in data.url_id.
+func (s *MindmapService) loadExportDoc(ctx context.Context, sessionID uuid.UUID, data MindmapData) (*exportDoc, error) {
+	sess, err := s.client.Session.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	doc := &exportDoc{
+		data:       data,
+		urls:       make(map[string]string),
+		highlights: make(map[string][]exportHighlight),
+	}
+	if sess.Title != nil {
+		doc.title = *sess.Title
+	}
+	for _, n := range data.Nodes {
+		if n.Type == "core" && doc.title == "" {
+			doc.title = n.Label
+		}
+	}
+	if doc.title == "" {
+		doc.title = "Mindmap"
+	}
+
+	nodesByURL := make(map[uuid.UUID][]string)
+	for _, n := range data.Nodes {
+		raw, _ := n.Data["url_id"].(string)
+		if id, err := uuid.Parse(raw); err == nil {
+			nodesByURL[id] = append(nodesByURL[id], n.ID)
+		}
+	}
+	ids := make([]uuid.UUID, 0, len(nodesByURL))
+	for id := range nodesByURL {
+		ids = append(ids, id)
+	}
+	urls, err := s.client.URL.Query().Where(enturl.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query urls: %w", err)
+	}
+	for _, u := range urls {
+		for _, nodeID := range nodesByURL[u.ID] {
+			doc.urls[nodeID] = u.URL
+		}
+	}
+
+	highlights, err := s.client.Highlight.Query().
+		Where(highlight.HasSessionWith(session.ID(sessionID))).
+		WithPageVisit(func(q *ent.PageVisitQuery) { q.WithURL() }).
+		Order(ent.Asc(highlight.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query highlights: %w", err)
+	}
+	for _, h := range highlights {
+		hl := exportHighlight{Text: h.Text, Note: h.Note}
+		var nodeIDs []string
+		if pv := h.Edges.PageVisit; pv != nil && pv.Edges.URL != nil {
+			nodeIDs = nodesByURL[pv.Edges.URL.ID]
+		}
+		if len(nodeIDs) == 0 {
+			nodeIDs = []string{""}
+		}
+		for _, nodeID := range nodeIDs {
+			doc.highlights[nodeID] = append(doc.highlights[nodeID], hl)
+		}
+	}
+	return doc, nil
+}
+
+// outlineNode is a node of a mindmap's outline: the hierarchy from the core
+// through topics and subtopics down to pages. A page under two topics is
+// under both.
+type outlineNode struct {
+	node     *MindmapNode
+	children []*outlineNode
+}
+
+// exportRanks order node types from the core down. Unknown types rank with
+// pages.
+var exportRanks = map[string]int{"core": 0, "topic": 1, "subtopic": 2, "page": 3}
+
+func exportRank(n *MindmapNode) int {
+	if r, ok := exportRanks[n.Type]; ok {
+		return r
+	}
+	return exportRanks["page"]
+}
+
+// outline returns the outline of the mindmap. Nodes the core doesn't reach
+// hang from it; without a core, the root has no node.
+func (d *exportDoc) outline() *outlineNode {
+	nodes := make(map[string]*MindmapNode, len(d.data.Nodes))
+	for i := range d.data.Nodes {
+		if _, ok := nodes[d.data.Nodes[i].ID]; !ok {
+			nodes[d.data.Nodes[i].ID] = &d.data.Nodes[i]
+		}
+	}
+	below := make(map[string][]string)
+	for _, e := range d.data.Edges {
+		s, t := nodes[e.Source], nodes[e.Target]
+		if s == nil || t == nil {
+			continue
+		}
+		if exportRank(s) > exportRank(t) {
+			s, t = t, s
+		}
+		if exportRank(s) < exportRank(t) {
+			below[s.ID] = append(below[s.ID], t.ID)
+		}
+	}
+
+	reached := make(map[string]bool)
+	// Ranks grow down the outline, so it has no cycles
+	var build func(n *MindmapNode) *outlineNode
+	build = func(n *MindmapNode) *outlineNode {
+		reached[n.ID] = true
+		o := &outlineNode{node: n}
+		seen := make(map[string]bool)
+		for _, id := range below[n.ID] {
+			if !seen[id] {
+				seen[id] = true
+				o.children = append(o.children, build(nodes[id]))
+			}
+		}
+		return o
+	}
+
+	root := &outlineNode{}
+	for i := range d.data.Nodes {
+		if d.data.Nodes[i].Type == "core" {
+			root = build(&d.data.Nodes[i])
+			break
+		}
+	}
+	// Highest first, so an unreached topic takes its pages along
+	for rank := 0; rank <= exportRanks["page"]; rank++ {
+		for i := range d.data.Nodes {
+			if n := &d.data.Nodes[i]; !reached[n.ID] && exportRank(n) == rank {
+				root.children = append(root.children, build(n))
+			}
+		}
+	}
+	return root
+}
+
+// connections returns the edges outside the outline, between nodes of the
+// same rank such as related topics.
+func (d *exportDoc) connections() []MindmapEdge {
+	ranks := make(map[string]int, len(d.data.Nodes))
+	for i := range d.data.Nodes {
+		ranks[d.data.Nodes[i].ID] = exportRank(&d.data.Nodes[i])
+	}
+	var result []MindmapEdge
+	for _, e := range d.data.Edges {
+		s, ok := ranks[e.Source]
+		if !ok {
+			continue
+		}
+		if t, ok := ranks[e.Target]; ok && s == t {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// label returns the label of a node, by ID.
+func (d *exportDoc) label(id string) string {
+	for _, n := range d.data.Nodes {
+		if n.ID == id {
+			return n.Label
+		}
+	}
+	return id
+}
+
+// nodeText returns a text field of a node's data.
+func nodeText(n *MindmapNode, key string) string {
+	if n == nil {
+		return ""
+	}
+	s, _ := n.Data[key].(string)
+	return s
+}
@@ -0,0 +1,469 @@
+package service
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mindhit/api/internal/infrastructure/layout"
+)
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+var graphMLKeys = []graphMLKey{
+	{"label", "node", "label", "string"},
+	{"type", "node", "type", "string"},
+	{"color", "node", "color", "string"},
+	{"size", "node", "size", "double"},
+	{"x", "node", "x", "double"},
+	{"y", "node", "y", "double"},
+	{"z", "node", "z", "double"},
+	{"url", "node", "url", "string"},
+	{"description", "node", "description", "string"},
+	{"note", "node", "note", "string"},
+	{"highlights", "node", "highlights", "string"},
+	{"weight", "edge", "weight", "double"},
+	{"edge_label", "edge", "label", "string"},
+	{"manual", "edge", "manual", "boolean"},
+}
+
+// renderGraphML writes the graph as GraphML, with the node and edge fields
+// as data. Highlights of a page are one per line.
+func renderGraphML(w io.Writer, d *exportDoc) error {
+	graph := graphMLGraph{ID: "mindmap", EdgeDefault: "directed"}
+	for i := range d.data.Nodes {
+		n := &d.data.Nodes[i]
+		node := graphMLNode{ID: n.ID}
+		add := func(key, value string) {
+			if value != "" {
+				node.Data = append(node.Data, graphMLData{Key: key, Value: value})
+			}
+		}
+		add("label", n.Label)
+		add("type", n.Type)
+		add("color", n.Color)
+		add("size", formatFloat(n.Size))
+		if n.Position != nil {
+			add("x", formatFloat(n.Position.X))
+			add("y", formatFloat(n.Position.Y))
+			add("z", formatFloat(n.Position.Z))
+		}
+		add("url", d.urls[n.ID])
+		add("description", nodeText(n, "description"))
+		add("note", nodeText(n, "note"))
+		add("highlights", highlightLines(d.highlights[n.ID]))
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	for i, e := range d.data.Edges {
+		edge := graphMLEdge{
+			ID:     fmt.Sprintf("e%d", i),
+			Source: e.Source,
+			Target: e.Target,
+			Data:   []graphMLData{{Key: "weight", Value: formatFloat(e.Weight)}},
+		}
+		if e.Label != "" {
+			edge.Data = append(edge.Data, graphMLData{Key: "edge_label", Value: e.Label})
+		}
+		if e.Manual {
+			edge.Data = append(edge.Data, graphMLData{Key: "manual", Value: "true"})
+		}
+		graph.Edges = append(graph.Edges, edge)
+	}
+	return writeXML(w, graphMLDocument{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys:  graphMLKeys,
+		Graph: graph,
+	})
+}
+
+var dotShapes = map[string]string{"core": "doublecircle", "topic": "ellipse", "subtopic": "ellipse", "page": "box"}
+
+// renderDOT writes the graph in Graphviz DOT. Pages link to their URLs with
+// their highlights as tooltips, and connections between topics are dashed.
+func renderDOT(w io.Writer, d *exportDoc) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(d.title))
+	fmt.Fprintf(&b, "  graph [label=%s, labelloc=t, overlap=false];\n", dotQuote(d.title))
+	b.WriteString("  node [style=filled, fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [color=\"#94A3B8\"];\n\n")
+
+	for i := range d.data.Nodes {
+		n := &d.data.Nodes[i]
+		shape, ok := dotShapes[n.Type]
+		if !ok {
+			shape = "box"
+		}
+		attrs := []string{"label=" + dotQuote(n.Label), "shape=" + shape}
+		if n.Color != "" {
+			attrs = append(attrs, "fillcolor="+dotQuote(n.Color))
+		}
+		if url := d.urls[n.ID]; url != "" {
+			attrs = append(attrs, "URL="+dotQuote(url))
+		}
+		if tooltip := joinNonEmpty("\n", nodeText(n, "description"), highlightLines(d.highlights[n.ID])); tooltip != "" {
+			attrs = append(attrs, "tooltip="+dotQuote(tooltip))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(n.ID), strings.Join(attrs, ", "))
+	}
+	b.WriteString("\n")
+
+	connection := make(map[[2]string]bool)
+	for _, e := range d.connections() {
+		connection[[2]string{e.Source, e.Target}] = true
+	}
+	for _, e := range d.data.Edges {
+		var attrs []string
+		if e.Label != "" {
+			attrs = append(attrs, "label="+dotQuote(e.Label))
+		}
+		if connection[[2]string{e.Source, e.Target}] {
+			attrs = append(attrs, "style=dashed", "constraint=false")
+		}
+		fmt.Fprintf(&b, "  %s -> %s", dotQuote(e.Source), dotQuote(e.Target))
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(attrs, ", "))
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", `\n`)
+
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
+
+// JSON Canvas card sizes and spacing.
+const (
+	canvasCardWidth      = 300
+	canvasCardHeight     = 80
+	canvasCoreHeight     = 120
+	canvasHighlightLines = 60
+	canvasGap            = 40
+)
+
+type canvasDocument struct {
+	Nodes []canvasNode `json:"nodes"`
+	Edges []canvasEdge `json:"edges"`
+}
+
+type canvasNode struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Text   string `json:"text"`
+	Color  string `json:"color,omitempty"`
+}
+
+type canvasEdge struct {
+	ID       string `json:"id"`
+	FromNode string `json:"fromNode"`
+	ToNode   string `json:"toNode"`
+	Label    string `json:"label,omitempty"`
+}
+
+// canvasHighlightsID is the card of the highlights of no page.
+const canvasHighlightsID = "highlights"
+
+// renderCanvas writes the graph as an Obsidian JSON Canvas of Markdown
+// cards: pages link to their URLs and quote their highlights. Cards are
+// placed by the mindmap's layout, in 2D and spaced for their size.
+func renderCanvas(w io.Writer, d *exportDoc) error {
+	var cards []canvasNode
+	var edges []canvasEdge
+	for i := range d.data.Nodes {
+		n := &d.data.Nodes[i]
+		card := canvasNode{ID: n.ID, Type: "text", Width: canvasCardWidth, Height: canvasCardHeight, Color: n.Color}
+
+		var text strings.Builder
+		label := markdownEscaper.Replace(n.Label)
+		switch url := d.urls[n.ID]; {
+		case url != "":
+			fmt.Fprintf(&text, "[%s](%s)", label, markdownURLEscaper.Replace(url))
+		case n.Type == "core":
+			fmt.Fprintf(&text, "# %s", label)
+			card.Height = canvasCoreHeight
+		case exportRank(n) < exportRanks["page"]:
+			fmt.Fprintf(&text, "## %s", label)
+		default:
+			text.WriteString(label)
+		}
+		if desc := nodeText(n, "description"); desc != "" {
+			fmt.Fprintf(&text, "\n\n%s", markdownEscaper.Replace(desc))
+		}
+		if note := nodeText(n, "note"); note != "" {
+			fmt.Fprintf(&text, "\n\n*Note:* %s", markdownEscaper.Replace(note))
+		}
+		for _, hl := range d.highlights[n.ID] {
+			text.WriteString("\n\n")
+			writeCanvasHighlight(&text, hl)
+			card.Height += canvasHighlightLines
+		}
+		card.Text = text.String()
+		cards = append(cards, card)
+	}
+	for i, e := range d.data.Edges {
+		edges = append(edges, canvasEdge{ID: fmt.Sprintf("e%d", i), FromNode: e.Source, ToNode: e.Target, Label: e.Label})
+	}
+
+	if hls := d.highlights[""]; len(hls) > 0 {
+		var text strings.Builder
+		text.WriteString("## Highlights")
+		for _, hl := range hls {
+			text.WriteString("\n\n")
+			writeCanvasHighlight(&text, hl)
+		}
+		cards = append(cards, canvasNode{
+			ID:     canvasHighlightsID,
+			Type:   "text",
+			Width:  canvasCardWidth,
+			Height: canvasCardHeight + len(hls)*canvasHighlightLines,
+			Text:   text.String(),
+		})
+		for _, n := range d.data.Nodes {
+			if n.Type == "core" {
+				edges = append(edges, canvasEdge{ID: "e-highlights", FromNode: n.ID, ToNode: canvasHighlightsID})
+				break
+			}
+		}
+	}
+
+	// A card fits in the circle of its diagonal. The highlights card hangs
+	// from the core like a topic.
+	nodes := make([]layout.Node, len(cards))
+	for i, c := range cards {
+		kind := "topic"
+		if i < len(d.data.Nodes) {
+			kind = d.data.Nodes[i].Type
+		}
+		nodes[i] = layout.Node{ID: c.ID, Kind: kind, Size: math.Hypot(float64(c.Width), float64(c.Height))}
+	}
+	layoutEdges := make([]layout.Edge, len(edges))
+	for i, e := range edges {
+		layoutEdges[i] = layout.Edge{Source: e.FromNode, Target: e.ToNode}
+	}
+	positions, err := exportPositions(d, nodes, layoutEdges, canvasGap)
+	if err != nil {
+		return err
+	}
+	for i := range cards {
+		// Canvas Y grows downwards
+		p := positions[cards[i].ID]
+		cards[i].X = int(math.Round(p.X)) - cards[i].Width/2
+		cards[i].Y = int(math.Round(-p.Y)) - cards[i].Height/2
+	}
+
+	if cards == nil {
+		cards = []canvasNode{}
+	}
+	if edges == nil {
+		edges = []canvasEdge{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(canvasDocument{Nodes: cards, Edges: edges})
+}
+
+func writeCanvasHighlight(b *strings.Builder, hl exportHighlight) {
+	fmt.Fprintf(b, "> %s", markdownEscaper.Replace(hl.Text))
+	if hl.Note != "" {
+		fmt.Fprintf(b, "\n\n%s", markdownEscaper.Replace(hl.Note))
+	}
+}
+
+// SVG rendering sizes.
+const (
+	svgMargin        = 40
+	svgGap           = 20
+	svgMaxLabelRunes = 40
+	svgCharWidth     = 0.6 // of the font size
+)
+
+var svgFontSizes = map[string]float64{"core": 18, "topic": 14, "subtopic": 12, "page": 11}
+
+// renderSVG draws the graph in 2D with the mindmap's layout: nodes as
+// circles of their size and color under their labels, pages linking to
+// their URLs, and highlights as tooltips. Each node is given room for its
+// label.
+func renderSVG(w io.Writer, d *exportDoc) error {
+	type svgNode struct {
+		n        *MindmapNode
+		label    string
+		fontSize float64
+	}
+	items := make([]svgNode, len(d.data.Nodes))
+	nodes := make([]layout.Node, len(d.data.Nodes))
+	for i := range d.data.Nodes {
+		n := &d.data.Nodes[i]
+		label := n.Label
+		if utf8.RuneCountInString(label) > svgMaxLabelRunes {
+			label = string([]rune(label)[:svgMaxLabelRunes-1]) + "…"
+		}
+		fontSize, ok := svgFontSizes[n.Type]
+		if !ok {
+			fontSize = svgFontSizes["page"]
+		}
+		items[i] = svgNode{n: n, label: label, fontSize: fontSize}
+		labelWidth := float64(utf8.RuneCountInString(label)) * fontSize * svgCharWidth
+		nodes[i] = layout.Node{ID: n.ID, Kind: n.Type, Size: math.Max(n.Size, labelWidth)}
+	}
+	edges := make([]layout.Edge, len(d.data.Edges))
+	for i, e := range d.data.Edges {
+		edges[i] = layout.Edge{Source: e.Source, Target: e.Target}
+	}
+	positions, err := exportPositions(d, nodes, edges, svgGap)
+	if err != nil {
+		return err
+	}
+
+	// SVG Y grows downwards
+	minX, minY, maxX, maxY := 0.0, 0.0, 0.0, 0.0
+	for i, item := range items {
+		p, r := positions[item.n.ID], nodes[i].Size/2
+		minX, maxX = math.Min(minX, p.X-r), math.Max(maxX, p.X+r)
+		minY, maxY = math.Min(minY, -p.Y-r), math.Max(maxY, -p.Y+r)
+	}
+	minX, minY = minX-svgMargin, minY-svgMargin
+	width, height := maxX-minX+svgMargin, maxY-minY+svgMargin
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="%s %s %s %s" width="%s" height="%s" font-family="sans-serif">`+"\n",
+		formatFloat(minX), formatFloat(minY), formatFloat(width), formatFloat(height), formatFloat(width), formatFloat(height))
+	fmt.Fprintf(&b, "  <title>%s</title>\n", xmlText(d.title))
+	fmt.Fprintf(&b, `  <rect x="%s" y="%s" width="%s" height="%s" fill="#FFFFFF"/>`+"\n",
+		formatFloat(minX), formatFloat(minY), formatFloat(width), formatFloat(height))
+
+	b.WriteString(`  <g stroke="#CBD5E1" stroke-width="1.5">` + "\n")
+	connection := make(map[[2]string]bool)
+	for _, e := range d.connections() {
+		connection[[2]string{e.Source, e.Target}] = true
+	}
+	for _, e := range d.data.Edges {
+		s, ok := positions[e.Source]
+		if !ok {
+			continue
+		}
+		t, ok := positions[e.Target]
+		if !ok {
+			continue
+		}
+		dash := ""
+		if connection[[2]string{e.Source, e.Target}] {
+			dash = ` stroke-dasharray="6 4"`
+		}
+		fmt.Fprintf(&b, `    <line x1="%s" y1="%s" x2="%s" y2="%s"%s/>`+"\n",
+			formatFloat(s.X), formatFloat(-s.Y), formatFloat(t.X), formatFloat(-t.Y), dash)
+	}
+	b.WriteString("  </g>\n")
+
+	b.WriteString(`  <g text-anchor="middle" dominant-baseline="central" fill="#1F2937" stroke-linejoin="round">` + "\n")
+	for _, item := range items {
+		n, p := item.n, positions[item.n.ID]
+		indent := "    "
+		url := d.urls[n.ID]
+		if url != "" {
+			fmt.Fprintf(&b, `    <a href="%s" xlink:href="%s" target="_blank">`+"\n", xmlText(url), xmlText(url))
+			indent += "  "
+		}
+		fmt.Fprintf(&b, "%s<g>\n", indent)
+		title := joinNonEmpty("\n", n.Label, nodeText(n, "description"), highlightLines(d.highlights[n.ID]))
+		fmt.Fprintf(&b, "%s  <title>%s</title>\n", indent, xmlText(title))
+		color := n.Color
+		if color == "" {
+			color = "#94A3B8"
+		}
+		fmt.Fprintf(&b, `%s  <circle cx="%s" cy="%s" r="%s" fill="%s" stroke="none"/>`+"\n",
+			indent, formatFloat(p.X), formatFloat(-p.Y), formatFloat(math.Max(n.Size, 4)/2), xmlText(color))
+		fmt.Fprintf(&b, `%s  <text x="%s" y="%s" font-size="%s" stroke="#FFFFFF" stroke-width="3" paint-order="stroke">%s</text>`+"\n",
+			indent, formatFloat(p.X), formatFloat(-p.Y), formatFloat(item.fontSize), xmlText(item.label))
+		fmt.Fprintf(&b, "%s</g>\n", indent)
+		if url != "" {
+			b.WriteString("    </a>\n")
+		}
+	}
+	b.WriteString("  </g>\n</svg>\n")
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// exportPositions lays nodes out in 2D with the mindmap's layout. Exports
+// size nodes for their cards and labels, so pinned positions don't apply.
+func exportPositions(d *exportDoc, nodes []layout.Node, edges []layout.Edge, gap float64) (map[string]layout.Position, error) {
+	layoutType, err := layout.ParseType(d.data.Layout.Type)
+	if err != nil {
+		layoutType = layout.Galaxy
+	}
+	return layout.Compute(layoutType, nodes, edges, layout.Options{Dimensions: 2, Gap: gap})
+}
+
+func highlightLines(hls []exportHighlight) string {
+	lines := make([]string, len(hls))
+	for i, hl := range hls {
+		lines[i] = strings.ReplaceAll(hl.Text, "\n", " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func xmlText(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// formatFloat formats v with at most two decimals.
+func formatFloat(v float64) string {
+	v = math.Round(v*100) / 100
+	if v == 0 {
+		v = 0 // not -0
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
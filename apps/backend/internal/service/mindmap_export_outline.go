@@ -0,0 +1,310 @@
+package service
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+		"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`, "\r", "", "\n", " ",
+	)
+	markdownURLEscaper = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E")
+)
+
+// renderMarkdown writes the outline as nested lists, topics in bold with
+// their descriptions, pages as links with their highlights quoted, followed
+// by the connections between topics.
+func renderMarkdown(w io.Writer, d *exportDoc) error {
+	var b strings.Builder
+	root := d.outline()
+
+	fmt.Fprintf(&b, "# %s\n", markdownEscaper.Replace(d.title))
+	if desc := nodeText(root.node, "description"); desc != "" {
+		fmt.Fprintf(&b, "\n%s\n", markdownEscaper.Replace(desc))
+	}
+	if note := nodeText(root.node, "note"); note != "" {
+		fmt.Fprintf(&b, "\n*Note:* %s\n", markdownEscaper.Replace(note))
+	}
+	if len(root.children) > 0 {
+		b.WriteString("\n")
+	}
+	for _, c := range root.children {
+		writeMarkdownItem(&b, d, c, "")
+	}
+
+	if hls := d.highlights[""]; len(hls) > 0 {
+		b.WriteString("\n## Highlights\n\n")
+		for _, hl := range hls {
+			writeMarkdownHighlight(&b, hl, "")
+		}
+	}
+	if conns := d.connections(); len(conns) > 0 {
+		b.WriteString("\n## Connections\n\n")
+		for _, e := range conns {
+			fmt.Fprintf(&b, "- %s → %s", markdownEscaper.Replace(d.label(e.Source)), markdownEscaper.Replace(d.label(e.Target)))
+			if e.Label != "" {
+				fmt.Fprintf(&b, ": %s", markdownEscaper.Replace(e.Label))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownItem(b *strings.Builder, d *exportDoc, o *outlineNode, indent string) {
+	n := o.node
+	label := markdownEscaper.Replace(n.Label)
+	if url := d.urls[n.ID]; url != "" {
+		fmt.Fprintf(b, "%s- [%s](%s)\n", indent, label, markdownURLEscaper.Replace(url))
+	} else if exportRank(n) < exportRanks["page"] {
+		fmt.Fprintf(b, "%s- **%s**", indent, label)
+		if desc := nodeText(n, "description"); desc != "" {
+			fmt.Fprintf(b, ": %s", markdownEscaper.Replace(desc))
+		}
+		b.WriteString("\n")
+	} else {
+		fmt.Fprintf(b, "%s- %s\n", indent, label)
+	}
+
+	inner := indent + "  "
+	if note := nodeText(n, "note"); note != "" {
+		fmt.Fprintf(b, "%s*Note:* %s\n", inner, markdownEscaper.Replace(note))
+	}
+	for _, hl := range d.highlights[n.ID] {
+		writeMarkdownHighlight(b, hl, inner)
+	}
+	for _, c := range o.children {
+		writeMarkdownItem(b, d, c, inner)
+	}
+}
+
+func writeMarkdownHighlight(b *strings.Builder, hl exportHighlight, indent string) {
+	fmt.Fprintf(b, "%s> %s", indent, markdownEscaper.Replace(hl.Text))
+	if hl.Note != "" {
+		fmt.Fprintf(b, " (%s)", markdownEscaper.Replace(hl.Note))
+	}
+	b.WriteString("\n")
+}
+
+// writeXML writes v as an indented XML document.
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type opmlDocument struct {
+	XMLName  xml.Name      `xml:"opml"`
+	Version  string        `xml:"version,attr"`
+	Title    string        `xml:"head>title"`
+	Outlines []opmlOutline `xml:"body>outline"`
+}
+
+type opmlOutline struct {
+	Text     string        `xml:"text,attr"`
+	Type     string        `xml:"type,attr,omitempty"`
+	URL      string        `xml:"url,attr,omitempty"`
+	Note     string        `xml:"_note,attr,omitempty"`
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+// renderOPML writes the outline as OPML 2.0. Pages are link outlines, with
+// their highlights as children, and descriptions and notes are outline
+// notes.
+func renderOPML(w io.Writer, d *exportDoc) error {
+	var build func(o *outlineNode) opmlOutline
+	build = func(o *outlineNode) opmlOutline {
+		n := o.node
+		out := opmlOutline{Text: n.Label, Note: joinNonEmpty("\n\n", nodeText(n, "description"), nodeText(n, "note"))}
+		if url := d.urls[n.ID]; url != "" {
+			out.Type, out.URL = "link", url
+		}
+		for _, hl := range d.highlights[n.ID] {
+			out.Outlines = append(out.Outlines, opmlOutline{Text: hl.Text, Note: hl.Note})
+		}
+		for _, c := range o.children {
+			out.Outlines = append(out.Outlines, build(c))
+		}
+		return out
+	}
+
+	root := d.outline()
+	var top []opmlOutline
+	if root.node != nil {
+		top = []opmlOutline{build(root)}
+	} else {
+		for _, c := range root.children {
+			top = append(top, build(c))
+		}
+	}
+	if hls := d.highlights[""]; len(hls) > 0 {
+		group := opmlOutline{Text: "Highlights"}
+		for _, hl := range hls {
+			group.Outlines = append(group.Outlines, opmlOutline{Text: hl.Text, Note: hl.Note})
+		}
+		if root.node != nil {
+			top[0].Outlines = append(top[0].Outlines, group)
+		} else {
+			top = append(top, group)
+		}
+	}
+	return writeXML(w, opmlDocument{Version: "2.0", Title: d.title, Outlines: top})
+}
+
+type freeMindMap struct {
+	XMLName xml.Name     `xml:"map"`
+	Version string       `xml:"version,attr"`
+	Node    freeMindNode `xml:"node"`
+}
+
+type freeMindNode struct {
+	ID       string          `xml:"ID,attr"`
+	Text     string          `xml:"TEXT,attr"`
+	Position string          `xml:"POSITION,attr,omitempty"`
+	Color    string          `xml:"BACKGROUND_COLOR,attr,omitempty"`
+	Link     string          `xml:"LINK,attr,omitempty"`
+	Font     *freeMindFont   `xml:"font,omitempty"`
+	Note     *freeMindNote   `xml:"richcontent,omitempty"`
+	Arrows   []freeMindArrow `xml:"arrowlink"`
+	Nodes    []freeMindNode  `xml:"node"`
+	source   string          // ID of the mindmap node
+}
+
+type freeMindFont struct {
+	Name   string `xml:"NAME,attr"`
+	Size   int    `xml:"SIZE,attr"`
+	Italic bool   `xml:"ITALIC,attr,omitempty"`
+}
+
+type freeMindNote struct {
+	Type string `xml:"TYPE,attr"`
+	HTML string `xml:",innerxml"`
+}
+
+type freeMindArrow struct {
+	Destination string `xml:"DESTINATION,attr"`
+	EndArrow    string `xml:"ENDARROW,attr"`
+}
+
+// renderFreeMind writes the outline as a FreeMind map. Pages link to their
+// URLs, highlights are italic children, descriptions and notes are node
+// notes, and connections are arrow links.
+func renderFreeMind(w io.Writer, d *exportDoc) error {
+	count := 0
+	nextID := func() string {
+		count++
+		return fmt.Sprintf("ID_%d", count)
+	}
+	firstIDs := make(map[string]string)
+	highlightNodes := func(hls []exportHighlight) []freeMindNode {
+		var result []freeMindNode
+		for _, hl := range hls {
+			result = append(result, freeMindNode{
+				ID:   nextID(),
+				Text: hl.Text,
+				Font: &freeMindFont{Name: "SansSerif", Size: 12, Italic: true},
+				Note: freeMindNoteOf(hl.Note),
+			})
+		}
+		return result
+	}
+
+	var build func(o *outlineNode) freeMindNode
+	build = func(o *outlineNode) freeMindNode {
+		n := o.node
+		fm := freeMindNode{
+			ID:     nextID(),
+			Text:   n.Label,
+			Color:  n.Color,
+			Link:   d.urls[n.ID],
+			Note:   freeMindNoteOf(joinNonEmpty("\n\n", nodeText(n, "description"), nodeText(n, "note"))),
+			Nodes:  highlightNodes(d.highlights[n.ID]),
+			source: n.ID,
+		}
+		if _, ok := firstIDs[n.ID]; !ok {
+			firstIDs[n.ID] = fm.ID
+		}
+		for _, c := range o.children {
+			fm.Nodes = append(fm.Nodes, build(c))
+		}
+		return fm
+	}
+
+	root := d.outline()
+	var top freeMindNode
+	if root.node != nil {
+		top = build(root)
+	} else {
+		top = freeMindNode{ID: nextID(), Text: d.title}
+		for _, c := range root.children {
+			top.Nodes = append(top.Nodes, build(c))
+		}
+	}
+	if hls := d.highlights[""]; len(hls) > 0 {
+		top.Nodes = append(top.Nodes, freeMindNode{ID: nextID(), Text: "Highlights", Nodes: highlightNodes(hls)})
+	}
+	// FreeMind puts the children of the root on the side they name
+	for i := range top.Nodes {
+		top.Nodes[i].Position = "right"
+		if i%2 == 1 {
+			top.Nodes[i].Position = "left"
+		}
+	}
+
+	arrows := make(map[string][]freeMindArrow)
+	for _, e := range d.connections() {
+		if dest, ok := firstIDs[e.Target]; ok {
+			arrows[e.Source] = append(arrows[e.Source], freeMindArrow{Destination: dest, EndArrow: "Default"})
+		}
+	}
+	var link func(fm *freeMindNode)
+	link = func(fm *freeMindNode) {
+		if fm.source != "" && firstIDs[fm.source] == fm.ID {
+			fm.Arrows = arrows[fm.source]
+		}
+		for i := range fm.Nodes {
+			link(&fm.Nodes[i])
+		}
+	}
+	link(&top)
+
+	return writeXML(w, freeMindMap{Version: "1.0.1", Node: top})
+}
+
+func freeMindNoteOf(text string) *freeMindNote {
+	if text == "" {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString("<html><head></head><body>")
+	for _, p := range strings.Split(text, "\n\n") {
+		b.WriteString("<p>")
+		_ = xml.EscapeText(&b, []byte(p))
+		b.WriteString("</p>")
+	}
+	b.WriteString("</body></html>")
+	return &freeMindNote{Type: "NOTE", HTML: b.String()}
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	var kept []string
+	for _, p := range parts {
+		if p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, sep)
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportTestDoc is a mindmap with a page under two topics, a connection
+// between the topics, and highlights on a page and on no page.
+func exportTestDoc() *exportDoc {
+	data := diffTestGraph([]MindmapNode{
+		{ID: "topic-0", Label: "Go generics", Color: "#3B82F6", Data: map[string]interface{}{"description": "Type parameters in Go"}},
+		{ID: "topic-1", Label: "Databases", Color: "#10B981"},
+	}, map[string][]string{
+		"topic-0": {"p1", "p2"},
+		"topic-1": {"p3"},
+	})
+	data.Nodes[0].Data = map[string]interface{}{"description": "What I read today"}
+	editTestNode(data, "p1").Data = map[string]interface{}{"note": "read again"}
+	data.Edges = append(data.Edges,
+		MindmapEdge{Source: "topic-1", Target: "p1", Weight: 0.5},
+		MindmapEdge{Source: "topic-0", Target: "topic-1", Weight: 0.2, Label: "both typed"},
+	)
+	data.Layout = MindmapLayout{Type: "radial"}
+
+	return &exportDoc{
+		title: "Go & <SQL>",
+		data:  data,
+		urls: map[string]string{
+			"p1": "https://go.dev/doc/tutorial/generics",
+			"p2": "https://example.com/a b(c)",
+		},
+		highlights: map[string][]exportHighlight{
+			"p1": {{Text: "Type parameters", Note: "key idea"}},
+			"":   {{Text: "Remember constraints"}},
+		},
+	}
+}
+
+func renderTestExport(t *testing.T, format ExportFormat) string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, exportFormats[format].render(&buf, exportTestDoc()))
+	return buf.String()
+}
+
+// assertXML checks that s is well-formed XML.
+func assertXML(t *testing.T, s string) {
+	t.Helper()
+	dec := xml.NewDecoder(strings.NewReader(s))
+	for {
+		_, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		require.NoError(t, err)
+	}
+}
+
+func TestExportDoc_Outline(t *testing.T) {
+	root := exportTestDoc().outline()
+
+	require.NotNil(t, root.node)
+	assert.Equal(t, "core", root.node.ID)
+	require.Len(t, root.children, 2)
+	topics := map[string][]string{}
+	for _, topic := range root.children {
+		for _, page := range topic.children {
+			topics[topic.node.ID] = append(topics[topic.node.ID], page.node.ID)
+		}
+	}
+	assert.Equal(t, map[string][]string{"topic-0": {"p1", "p2"}, "topic-1": {"p3", "p1"}}, topics, "a page under two topics is under both")
+
+	conns := exportTestDoc().connections()
+	require.Len(t, conns, 1)
+	assert.Equal(t, "both typed", conns[0].Label)
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	out := renderTestExport(t, ExportMarkdown)
+
+	assert.True(t, strings.HasPrefix(out, "# Go & \\<SQL\\>\n\nWhat I read today\n"), out)
+	assert.Contains(t, out, "- **Go generics**: Type parameters in Go\n")
+	assert.Contains(t, out, "  - [Page p1](https://go.dev/doc/tutorial/generics)\n    *Note:* read again\n    > Type parameters (key idea)\n")
+	assert.Contains(t, out, "  - [Page p2](https://example.com/a%20b%28c%29)\n")
+	assert.Contains(t, out, "  - Page p3\n")
+	assert.Contains(t, out, "## Highlights\n\n> Remember constraints\n")
+	assert.Contains(t, out, "## Connections\n\n- Go generics → Databases: both typed\n")
+}
+
+func TestRenderOPML(t *testing.T) {
+	out := renderTestExport(t, ExportOPML)
+	assertXML(t, out)
+
+	var doc opmlDocument
+	require.NoError(t, xml.Unmarshal([]byte(out), &doc))
+	assert.Equal(t, "Go & <SQL>", doc.Title)
+	require.Len(t, doc.Outlines, 1)
+	core := doc.Outlines[0]
+	require.Len(t, core.Outlines, 3, "two topics and the highlights")
+	page := core.Outlines[0].Outlines[0]
+	assert.Equal(t, opmlOutline{
+		Text: "Page p1", Type: "link", URL: "https://go.dev/doc/tutorial/generics", Note: "read again",
+		Outlines: []opmlOutline{{Text: "Type parameters", Note: "key idea"}},
+	}, page)
+	assert.Equal(t, "Highlights", core.Outlines[2].Text)
+}
+
+func TestRenderFreeMind(t *testing.T) {
+	out := renderTestExport(t, ExportFreeMind)
+	assertXML(t, out)
+
+	assert.Contains(t, out, `<map version="1.0.1">`)
+	assert.Contains(t, out, `TEXT="Page p1" LINK="https://go.dev/doc/tutorial/generics"`)
+	assert.Contains(t, out, `TEXT="Type parameters"`)
+	assert.Contains(t, out, `POSITION="left"`)
+	assert.Contains(t, out, `<p>Type parameters in Go</p>`)
+	assert.Equal(t, 1, strings.Count(out, "<arrowlink "), "the connection, on the first Go generics node")
+}
+
+func TestRenderGraphML(t *testing.T) {
+	out := renderTestExport(t, ExportGraphML)
+	assertXML(t, out)
+
+	var doc graphMLDocument
+	require.NoError(t, xml.Unmarshal([]byte(out), &doc))
+	assert.Len(t, doc.Graph.Nodes, 6)
+	assert.Len(t, doc.Graph.Edges, 7)
+	assert.Contains(t, doc.Graph.Nodes[2].Data, graphMLData{Key: "url", Value: "https://go.dev/doc/tutorial/generics"})
+	assert.Contains(t, doc.Graph.Nodes[2].Data, graphMLData{Key: "highlights", Value: "Type parameters"})
+	assert.Contains(t, doc.Graph.Edges[6].Data, graphMLData{Key: "edge_label", Value: "both typed"})
+}
+
+func TestRenderDOT(t *testing.T) {
+	out := renderTestExport(t, ExportDOT)
+
+	assert.True(t, strings.HasPrefix(out, `digraph "Go & <SQL>" {`), out)
+	assert.Contains(t, out, `"p1" [label="Page p1", shape=box, URL="https://go.dev/doc/tutorial/generics", tooltip="Type parameters"];`)
+	assert.Contains(t, out, `"topic-0" -> "topic-1" [label="both typed", style=dashed, constraint=false];`)
+	assert.Contains(t, out, `"core" -> "topic-0";`)
+}
+
+func TestRenderCanvas(t *testing.T) {
+	out := renderTestExport(t, ExportCanvas)
+
+	var doc canvasDocument
+	require.NoError(t, json.Unmarshal([]byte(out), &doc))
+	require.Len(t, doc.Nodes, 7, "nodes and the highlights card")
+	assert.Len(t, doc.Edges, 8)
+
+	cards := map[string]canvasNode{}
+	for _, c := range doc.Nodes {
+		cards[c.ID] = c
+	}
+	assert.Equal(t, "[Page p1](https://go.dev/doc/tutorial/generics)\n\n*Note:* read again\n\n> Type parameters\n\nkey idea", cards["p1"].Text)
+	assert.Equal(t, "## Highlights\n\n> Remember constraints", cards[canvasHighlightsID].Text)
+
+	for i, a := range doc.Nodes {
+		for _, b := range doc.Nodes[i+1:] {
+			apart := a.X+a.Width <= b.X || b.X+b.Width <= a.X || a.Y+a.Height <= b.Y || b.Y+b.Height <= a.Y
+			assert.True(t, apart, "%s and %s overlap", a.ID, b.ID)
+		}
+	}
+}
+
+func TestRenderSVG(t *testing.T) {
+	out := renderTestExport(t, ExportSVG)
+	assertXML(t, out)
+
+	assert.Contains(t, out, `<title>Go &amp; &lt;SQL&gt;</title>`)
+	assert.Contains(t, out, `<a href="https://go.dev/doc/tutorial/generics" xlink:href="https://go.dev/doc/tutorial/generics" target="_blank">`)
+	assert.Contains(t, out, `<title>Page p1&#xA;Type parameters</title>`)
+	assert.Contains(t, out, `stroke-dasharray="6 4"`)
+}
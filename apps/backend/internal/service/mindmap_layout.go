@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/internal/infrastructure/layout"
+)
+
+// ErrInvalidLayout is returned for an unknown layout type or dimension count.
+var ErrInvalidLayout = errors.New("invalid mindmap layout")
+
+const defaultLayoutDimensions = 2
+
+// LayoutMindmap positions the nodes of data with the layout its Layout
+// names, galaxy when unset, and records the dimensions in the layout params.
+// Pinned nodes keep their positions and the others make room around them.
+func LayoutMindmap(data *MindmapData) error {
+	layoutType := layout.Galaxy
+	if data.Layout.Type != "" {
+		t, err := layout.ParseType(data.Layout.Type)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidLayout, err)
+		}
+		layoutType = t
+	}
+	dims := layoutDimensions(data.Layout.Params)
+
+	nodes := make([]layout.Node, len(data.Nodes))
+	for i, n := range data.Nodes {
+		nodes[i] = layout.Node{ID: n.ID, Kind: n.Type, Size: n.Size}
+		if pinned, _ := n.Data["pinned"].(bool); pinned && n.Position != nil {
+			nodes[i].Fixed = &layout.Position{X: n.Position.X, Y: n.Position.Y, Z: n.Position.Z}
+		}
+	}
+	edges := make([]layout.Edge, len(data.Edges))
+	for i, e := range data.Edges {
+		edges[i] = layout.Edge{Source: e.Source, Target: e.Target}
+	}
+
+	positions, err := layout.Compute(layoutType, nodes, edges, layout.Options{Dimensions: dims})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidLayout, err)
+	}
+	for i := range data.Nodes {
+		p := positions[data.Nodes[i].ID]
+		data.Nodes[i].Position = &Position{X: p.X, Y: p.Y, Z: p.Z}
+	}
+
+	params := map[string]interface{}{
+		"center": []float64{0, 0, 0},
+		"scale":  1.0,
+	}
+	for k, v := range data.Layout.Params {
+		params[k] = v
+	}
+	params["dimensions"] = dims
+	data.Layout = MindmapLayout{Type: string(layoutType), Params: params}
+	return nil
+}
+
+// keepLayout makes data use the layout type and dimensions of a stored
+// layout, the one the user last chose, when it has one.
+func keepLayout(data *MindmapData, stored map[string]interface{}) {
+	t, _ := stored["type"].(string)
+	if t == "" {
+		return
+	}
+	params, _ := stored["params"].(map[string]interface{})
+	data.Layout.Type = t
+	if data.Layout.Params == nil {
+		data.Layout.Params = map[string]interface{}{}
+	}
+	data.Layout.Params["dimensions"] = layoutDimensions(params)
+}
+
+// layoutDimensions reads the dimension count of layout params, which JSON
+// storage turns into a float64.
+func layoutDimensions(params map[string]interface{}) int {
+	switch v := params["dimensions"].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	return defaultLayoutDimensions
+}
+
+// Relayout positions the nodes of a session's mindmap with another layout,
+// without generating it again. Zero dimensions keep the current ones. The
+// layout is kept by later edits, regenerations and restores.
+func (s *MindmapService) Relayout(ctx context.Context, sessionID, userID uuid.UUID, layoutType string, dimensions int) (*ent.MindmapGraph, error) {
+	if _, err := layout.ParseType(layoutType); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLayout, err)
+	}
+	if dimensions != 0 && dimensions != 2 && dimensions != 3 {
+		return nil, fmt.Errorf("%w: dimensions must be 2 or 3", ErrInvalidLayout)
+	}
+
+	mindmap, err := s.GetBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mindmap.Status != mindmapgraph.StatusCompleted {
+		return nil, ErrMindmapNotCompleted
+	}
+
+	data, err := ConvertMapsToData(mindmap.Nodes, mindmap.GraphEdges, mindmap.Layout)
+	if err != nil {
+		return nil, fmt.Errorf("read mindmap: %w", err)
+	}
+	if data.Layout.Params == nil {
+		data.Layout.Params = map[string]interface{}{}
+	}
+	data.Layout.Type = layoutType
+	if dimensions != 0 {
+		data.Layout.Params["dimensions"] = dimensions
+	}
+	if err := LayoutMindmap(&data); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.client.MindmapGraph.UpdateOne(mindmap).
+		Where(
+			mindmapgraph.UpdatedAt(mindmap.UpdatedAt),
+			mindmapgraph.StatusEQ(mindmapgraph.StatusCompleted),
+		).
+		SetNodes(ConvertNodesToMaps(data.Nodes)).
+		SetLayout(ConvertLayoutToMap(data.Layout)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMindmapChanged
+		}
+		return nil, err
+	}
+	return updated, nil
+}
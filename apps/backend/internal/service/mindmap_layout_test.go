@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayoutMindmap(t *testing.T) {
+	data := diffTestGraph([]MindmapNode{
+		{ID: "topic-0", Label: "Go generics"},
+		{ID: "topic-1", Label: "Databases"},
+	}, map[string][]string{
+		"topic-0": {"p1", "p2"},
+		"topic-1": {"p3"},
+	})
+	data.Layout = MindmapLayout{}
+	pinned := editTestNode(data, "topic-1")
+	pinned.Position = &Position{X: 500, Y: 500}
+	pinned.Data = map[string]interface{}{"pinned": true}
+	editTestNode(data, "topic-0").Position = &Position{X: 500, Y: 500}
+
+	require.NoError(t, LayoutMindmap(&data))
+
+	assert.Equal(t, "galaxy", data.Layout.Type)
+	assert.Equal(t, 2, data.Layout.Params["dimensions"])
+	assert.Equal(t, &Position{X: 500, Y: 500}, editTestNode(data, "topic-1").Position, "pinned")
+	assert.NotEqual(t, &Position{X: 500, Y: 500}, editTestNode(data, "topic-0").Position, "not pinned")
+	for _, n := range data.Nodes {
+		assert.NotNil(t, n.Position, n.ID)
+	}
+
+	data.Layout.Type = "spiral"
+	assert.ErrorIs(t, LayoutMindmap(&data), ErrInvalidLayout)
+}
+
+func TestKeepLayout(t *testing.T) {
+	data := MindmapData{Layout: MindmapLayout{Type: "galaxy"}}
+	keepLayout(&data, map[string]interface{}{})
+	assert.Equal(t, "galaxy", data.Layout.Type, "nothing stored")
+
+	keepLayout(&data, map[string]interface{}{
+		"type":   "tree",
+		"params": map[string]interface{}{"dimensions": 3.0},
+	})
+	assert.Equal(t, "tree", data.Layout.Type)
+	assert.Equal(t, 3, data.Layout.Params["dimensions"])
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
+)
+
+// ListRevisions returns a session's mindmap and its revisions, newest first,
+// without their graphs.
+func (s *MindmapService) ListRevisions(ctx context.Context, sessionID, userID uuid.UUID) (*ent.MindmapGraph, []*ent.MindmapRevision, error) {
+	mindmap, err := s.GetBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	revisions, err := s.client.MindmapRevision.Query().
+		Where(mindmaprevision.HasMindmapWith(mindmapgraph.ID(mindmap.ID))).
+		Select(
+			mindmaprevision.FieldVersion,
+			mindmaprevision.FieldRestoredFrom,
+			mindmaprevision.FieldGeneratedAt,
+			mindmaprevision.FieldCreatedAt,
+		).
+		Order(ent.Desc(mindmaprevision.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return mindmap, revisions, nil
+}
+
+// GetRevision returns a session's mindmap and one of its revisions.
+func (s *MindmapService) GetRevision(ctx context.Context, sessionID, userID uuid.UUID, version int) (*ent.MindmapGraph, *ent.MindmapRevision, error) {
+	mindmap, err := s.GetBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	revision, err := s.getRevision(ctx, s.client.MindmapRevision, mindmap.ID, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	return mindmap, revision, nil
+}
+
+// RestoreRevision makes a revision, with the edit log replayed onto it and
+// laid out like the current graph, the current graph of a session's mindmap.
+// The restore is recorded as a new revision, so the graph it replaces and the
+// history after the restored version are kept.
+func (s *MindmapService) RestoreRevision(ctx context.Context, sessionID, userID uuid.UUID, version int) (*ent.MindmapGraph, error) {
+	mindmap, err := s.GetBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mindmap.Status != mindmapgraph.StatusCompleted && mindmap.Status != mindmapgraph.StatusFailed {
+		return nil, ErrMindmapGenerating
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	revision, err := s.getRevision(ctx, tx.MindmapRevision, mindmap.ID, version)
+	if err != nil {
+		return nil, err
+	}
+	if version == mindmap.Version && mindmap.Status == mindmapgraph.StatusCompleted {
+		return mindmap, nil // Already current
+	}
+
+	data, err := ConvertMapsToData(revision.Nodes, revision.GraphEdges, revision.Layout)
+	if err != nil {
+		return nil, fmt.Errorf("read revision %d: %w", version, err)
+	}
+	edits, err := loadEdits(ctx, tx, mindmap.ID)
+	if err != nil {
+		return nil, err
+	}
+	edited, _ := ReplayEdits(data, edits)
+	keepLayout(&edited, mindmap.Layout)
+	if err := LayoutMindmap(&edited); err != nil {
+		return nil, err
+	}
+
+	// The version and status guard against a regeneration or restore that
+	// started since the mindmap was read
+	restored, err := tx.MindmapGraph.UpdateOne(mindmap).
+		Where(
+			mindmapgraph.Version(mindmap.Version),
+			mindmapgraph.StatusIn(mindmapgraph.StatusCompleted, mindmapgraph.StatusFailed),
+		).
+		SetStatus(mindmapgraph.StatusCompleted).
+		SetNodes(ConvertNodesToMaps(edited.Nodes)).
+		SetGraphEdges(ConvertEdgesToMaps(edited.Edges)).
+		SetLayout(ConvertLayoutToMap(edited.Layout)).
+		SetGeneratedAt(revision.GeneratedAt).
+		AddVersion(1).
+		ClearErrorMessage().
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMindmapGenerating
+		}
+		return nil, err
+	}
+	err = recordRevision(ctx, tx, restored, revision.Nodes, revision.GraphEdges, revision.Layout, &version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	s.enqueueKnowledgeUpdate(restored)
+	return restored, nil
+}
+
+// DiffRevisions compares two revisions of a session's mindmap.
+func (s *MindmapService) DiffRevisions(ctx context.Context, sessionID, userID uuid.UUID, from, to int) (*MindmapDiff, error) {
+	mindmap, err := s.GetBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromRev, err := s.getRevision(ctx, s.client.MindmapRevision, mindmap.ID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := s.getRevision(ctx, s.client.MindmapRevision, mindmap.ID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromData, err := ConvertMapsToData(fromRev.Nodes, fromRev.GraphEdges, fromRev.Layout)
+	if err != nil {
+		return nil, fmt.Errorf("read revision %d: %w", from, err)
+	}
+	toData, err := ConvertMapsToData(toRev.Nodes, toRev.GraphEdges, toRev.Layout)
+	if err != nil {
+		return nil, fmt.Errorf("read revision %d: %w", to, err)
+	}
+
+	diff := DiffMindmaps(fromData, toData)
+	diff.From = from
+	diff.To = to
+	return &diff, nil
+}
+
+func (s *MindmapService) getRevision(ctx context.Context, revisions *ent.MindmapRevisionClient, mindmapID uuid.UUID, version int) (*ent.MindmapRevision, error) {
+	revision, err := revisions.Query().
+		Where(
+			mindmaprevision.HasMindmapWith(mindmapgraph.ID(mindmapID)),
+			mindmaprevision.Version(version),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMindmapRevisionNotFound
+		}
+		return nil, err
+	}
+	return revision, nil
+}
+
+// recordRevision records the generated graph of a mindmap, before edits, as
+// the revision of its version. restoredFrom is the version a restore copied
+// the graph from.
+func recordRevision(ctx context.Context, tx *ent.Tx, mindmap *ent.MindmapGraph, nodes, edges []map[string]interface{}, layout map[string]interface{}, restoredFrom *int) error {
+	err := tx.MindmapRevision.Create().
+		SetMindmapID(mindmap.ID).
+		SetVersion(mindmap.Version).
+		SetNodes(nodes).
+		SetGraphEdges(edges).
+		SetLayout(layout).
+		SetNillableRestoredFrom(restoredFrom).
+		SetGeneratedAt(mindmap.GeneratedAt).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("record mindmap revision %d: %w", mindmap.Version, err)
+	}
+	return nil
+}
@@ -4,29 +4,46 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
 
 	"github.com/mindhit/api/ent"
 	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
 	"github.com/mindhit/api/ent/session"
 	"github.com/mindhit/api/ent/user"
+	"github.com/mindhit/api/internal/infrastructure/queue"
 )
 
 // Mindmap service errors.
 var (
 	ErrMindmapNotFound = errors.New("mindmap not found")
 	ErrSessionNotReady = errors.New("session not ready for mindmap generation")
+	// ErrMindmapRevisionNotFound is returned for a version without a revision.
+	ErrMindmapRevisionNotFound = errors.New("mindmap revision not found")
+	// ErrMindmapGenerating is returned when a mindmap can't change while it is
+	// being generated.
+	ErrMindmapGenerating = errors.New("mindmap is being generated")
 )
 
 // MindmapService handles mindmap operations.
 type MindmapService struct {
-	client *ent.Client
+	client              *ent.Client
+	queueClient         *queue.Client
+	subscriptionService *SubscriptionService
 }
 
 // NewMindmapService creates a new MindmapService.
-func NewMindmapService(client *ent.Client) *MindmapService {
-	return &MindmapService{client: client}
+func NewMindmapService(client *ent.Client, queueClient *queue.Client) *MindmapService {
+	return &MindmapService{
+		client:              client,
+		queueClient:         queueClient,
+		subscriptionService: NewSubscriptionService(client),
+	}
 }
 
 // GetBySessionID retrieves a mindmap for a session.
@@ -86,14 +103,207 @@ func (s *MindmapService) SetFailed(ctx context.Context, mindmapID uuid.UUID, err
 		Save(ctx)
 }
 
-// SetCompleted marks a mindmap as completed with data.
+// SetCompleted marks a mindmap as completed with generated data and records
+// it as a revision of the mindmap's version. The edit log is replayed onto
+// the data for the current graph, laid out like the graph it replaces. A
+// version that already has a revision, when a generation job is retried, is
+// bumped so revisions stay immutable. The user's knowledge graph is updated
+// in the background.
 func (s *MindmapService) SetCompleted(ctx context.Context, mindmapID uuid.UUID, data MindmapData) (*ent.MindmapGraph, error) {
-	return s.client.MindmapGraph.UpdateOneID(mindmapID).
+	if err := LayoutMindmap(&data); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	current, err := tx.MindmapGraph.Get(ctx, mindmapID)
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := tx.MindmapRevision.Query().
+		Where(
+			mindmaprevision.HasMindmapWith(mindmapgraph.ID(mindmapID)),
+			mindmaprevision.Version(current.Version),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version := current.Version
+	if recorded {
+		version++
+	}
+
+	edits, err := loadEdits(ctx, tx, mindmapID)
+	if err != nil {
+		return nil, err
+	}
+	edited, skipped := ReplayEdits(data, edits)
+	if skipped > 0 {
+		slog.Info("mindmap edits no longer apply", "mindmap_id", mindmapID, "edits", len(edits), "skipped", skipped)
+	}
+	keepLayout(&edited, current.Layout)
+	if err := LayoutMindmap(&edited); err != nil {
+		return nil, err
+	}
+
+	mindmap, err := tx.MindmapGraph.UpdateOne(current).
 		SetStatus(mindmapgraph.StatusCompleted).
-		SetNodes(ConvertNodesToMaps(data.Nodes)).
-		SetGraphEdges(ConvertEdgesToMaps(data.Edges)).
-		SetLayout(ConvertLayoutToMap(data.Layout)).
+		SetNodes(ConvertNodesToMaps(edited.Nodes)).
+		SetGraphEdges(ConvertEdgesToMaps(edited.Edges)).
+		SetLayout(ConvertLayoutToMap(edited.Layout)).
+		SetGeneratedAt(time.Now()).
+		SetVersion(version).
+		ClearErrorMessage().
 		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = recordRevision(ctx, tx, mindmap, ConvertNodesToMaps(data.Nodes), ConvertEdgesToMaps(data.Edges), ConvertLayoutToMap(data.Layout), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	s.enqueueKnowledgeUpdate(mindmap)
+	return mindmap, nil
+}
+
+// RequestGeneration makes sure the session has a mindmap and enqueues its
+// generation. With force, a finished mindmap is reset to pending and its
+// version bumped; a mindmap that is already generating is left alone.
+func (s *MindmapService) RequestGeneration(ctx context.Context, sessionID, userID uuid.UUID, force bool) (*ent.MindmapGraph, error) {
+	sess, err := s.client.Session.Query().
+		Where(
+			session.ID(sessionID),
+			session.HasUserWith(user.IDEQ(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	if sess.SessionStatus == session.SessionStatusRecording || sess.SessionStatus == session.SessionStatusPaused {
+		return nil, ErrSessionNotReady
+	}
+
+	mindmap, created, err := s.getOrCreate(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !created && force {
+		mindmap, err = s.resetForRegeneration(ctx, mindmap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Pending covers new, reset and previously un-enqueued mindmaps
+	if mindmap.Status == mindmapgraph.StatusPending {
+		if err := s.enqueueGeneration(sessionID, mindmap.ID); err != nil {
+			slog.Error("failed to enqueue mindmap generation", "mindmap_id", mindmap.ID, "error", err)
+			return mindmap, nil // Don't fail the request; a later call re-enqueues
+		}
+	}
+
+	return mindmap, nil
+}
+
+// QueueForSession prepares the session's mindmap for (re)generation and
+// enqueues the job. It is used by the session processing pipeline.
+func (s *MindmapService) QueueForSession(ctx context.Context, sessionID uuid.UUID) (*ent.MindmapGraph, error) {
+	mindmap, created, err := s.getOrCreate(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !created {
+		mindmap, err = s.resetForRegeneration(ctx, mindmap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if mindmap.Status != mindmapgraph.StatusPending {
+		return mindmap, nil
+	}
+
+	if err := s.enqueueGeneration(sessionID, mindmap.ID); err != nil {
+		return nil, err
+	}
+
+	return mindmap, nil
+}
+
+// resetForRegeneration moves a completed or failed mindmap back to pending
+// and bumps its version. The graph it replaces stays in its revision.
+func (s *MindmapService) resetForRegeneration(ctx context.Context, mindmap *ent.MindmapGraph) (*ent.MindmapGraph, error) {
+	if mindmap.Status != mindmapgraph.StatusCompleted && mindmap.Status != mindmapgraph.StatusFailed {
+		return mindmap, nil
+	}
+
+	return s.client.MindmapGraph.UpdateOne(mindmap).
+		SetStatus(mindmapgraph.StatusPending).
+		AddVersion(1).
+		ClearErrorMessage().
+		Save(ctx)
+}
+
+// enqueueGeneration enqueues a mindmap:generate job. The task ID is derived
+// from the mindmap so a mindmap is never queued twice at the same time.
+func (s *MindmapService) enqueueGeneration(sessionID, mindmapID uuid.UUID) error {
+	if s.queueClient == nil {
+		return nil
+	}
+
+	task, err := queue.NewMindmapGenerateTask(sessionID.String(), mindmapID.String())
+	if err != nil {
+		return fmt.Errorf("create mindmap task: %w", err)
+	}
+
+	_, err = s.queueClient.Enqueue(task,
+		asynq.TaskID(queue.TypeMindmapGenerate+":"+mindmapID.String()),
+		asynq.MaxRetry(3),
+	)
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return fmt.Errorf("enqueue mindmap task: %w", err)
+	}
+
+	slog.Info("mindmap generation enqueued", "session_id", sessionID, "mindmap_id", mindmapID)
+	return nil
+}
+
+// enqueueKnowledgeUpdate enqueues a knowledge:update job that merges the
+// current graph of a mindmap into its user's knowledge graph. The task ID is
+// derived from the mindmap and when it last changed, so the same graph is
+// never queued twice while a newer one still is. The mindmap is already
+// saved, so a failure is only logged.
+func (s *MindmapService) enqueueKnowledgeUpdate(mindmap *ent.MindmapGraph) {
+	if s.queueClient == nil {
+		return
+	}
+
+	task, err := queue.NewKnowledgeUpdateTask(mindmap.ID.String())
+	if err == nil {
+		_, err = s.queueClient.Enqueue(task,
+			asynq.TaskID(fmt.Sprintf("%s:%s:%d", queue.TypeKnowledgeUpdate, mindmap.ID, mindmap.UpdatedAt.UnixNano())),
+			asynq.Queue("low"),
+			asynq.MaxRetry(3),
+		)
+	}
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		slog.Error("failed to enqueue knowledge update", "mindmap_id", mindmap.ID, "error", err)
+	}
 }
 
 // GetOrCreateForSession gets existing mindmap or creates a new pending one.
@@ -112,9 +322,21 @@ func (s *MindmapService) GetOrCreateForSession(ctx context.Context, sessionID, u
 		return nil, false, err
 	}
 
+	return s.getOrCreate(ctx, sess.ID)
+}
+
+// GetOrCreateBySessionID is GetOrCreateForSession without the ownership
+// check, for internal callers such as worker jobs.
+func (s *MindmapService) GetOrCreateBySessionID(ctx context.Context, sessionID uuid.UUID) (*ent.MindmapGraph, bool, error) {
+	return s.getOrCreate(ctx, sessionID)
+}
+
+// getOrCreate returns the session's mindmap, creating a pending one when
+// missing. The unique session edge guarantees at most one mindmap per session.
+func (s *MindmapService) getOrCreate(ctx context.Context, sessionID uuid.UUID) (*ent.MindmapGraph, bool, error) {
 	// Try to get existing mindmap
 	mindmap, err := s.client.MindmapGraph.Query().
-		Where(mindmapgraph.HasSessionWith(session.ID(sess.ID))).
+		Where(mindmapgraph.HasSessionWith(session.ID(sessionID))).
 		Only(ctx)
 	if err == nil {
 		return mindmap, false, nil
@@ -126,6 +348,16 @@ func (s *MindmapService) GetOrCreateForSession(ctx context.Context, sessionID, u
 	// Create new mindmap
 	mindmap, err = s.CreatePending(ctx, sessionID)
 	if err != nil {
+		// Lost a race with a concurrent create
+		if ent.IsConstraintError(err) {
+			mindmap, err = s.client.MindmapGraph.Query().
+				Where(mindmapgraph.HasSessionWith(session.ID(sessionID))).
+				Only(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+			return mindmap, false, nil
+		}
 		return nil, false, err
 	}
 
@@ -0,0 +1,323 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/internal/service"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+func setupMindmapServiceTest(t *testing.T) (*ent.Client, *service.MindmapService, *service.SessionService, *service.AuthService) {
+	t.Helper()
+	client := testutil.SetupTestDB(t)
+	mindmapService := service.NewMindmapService(client, nil) // nil queue client for tests
+	sessionService := service.NewSessionService(client, nil)
+	authService := service.NewAuthService(client)
+	return client, mindmapService, sessionService, authService
+}
+
+func createStoppedSession(t *testing.T, sessionService *service.SessionService, userID uuid.UUID) *ent.Session {
+	t.Helper()
+	ctx := context.Background()
+	sess, err := sessionService.Start(ctx, userID)
+	require.NoError(t, err)
+	sess, err = sessionService.Stop(ctx, sess.ID, userID)
+	require.NoError(t, err)
+	return sess
+}
+
+// ==================== RequestGeneration Tests ====================
+
+func TestMindmapService_RequestGeneration_CreatesPending(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-request"))
+	sess := createStoppedSession(t, sessionService, user.ID)
+
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, mindmapgraph.StatusPending, mindmap.Status)
+	assert.Equal(t, 1, mindmap.Version)
+}
+
+func TestMindmapService_RequestGeneration_NoDuplicates(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-dup"))
+	sess := createStoppedSession(t, sessionService, user.ID)
+
+	first, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+	require.NoError(t, err)
+	second, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ID, second.ID)
+	count, err := sess.QueryMindmap().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestMindmapService_RequestGeneration_ForceBumpsVersion(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-force"))
+	sess := createStoppedSession(t, sessionService, user.ID)
+
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+	require.NoError(t, err)
+	_, err = mindmapService.SetFailed(ctx, mindmap.ID, "provider error")
+	require.NoError(t, err)
+
+	regenerated, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, mindmap.ID, regenerated.ID)
+	assert.Equal(t, mindmapgraph.StatusPending, regenerated.Status)
+	assert.Equal(t, 2, regenerated.Version)
+	assert.Empty(t, regenerated.ErrorMessage)
+}
+
+func TestMindmapService_RequestGeneration_SessionNotReady(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-recording"))
+	sess, err := sessionService.Start(ctx, user.ID)
+	require.NoError(t, err)
+
+	_, err = mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+
+	assert.ErrorIs(t, err, service.ErrSessionNotReady)
+}
+
+func TestMindmapService_RequestGeneration_NotOwner(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	owner := createTestUser(t, authService, uniqueEmail("mindmap-owner"))
+	other := createTestUser(t, authService, uniqueEmail("mindmap-other"))
+	sess := createStoppedSession(t, sessionService, owner.ID)
+
+	_, err := mindmapService.RequestGeneration(ctx, sess.ID, other.ID, false)
+
+	assert.ErrorIs(t, err, service.ErrSessionNotFound)
+}
+
+// ==================== Revision Tests ====================
+
+func revisionTestData(topic string) service.MindmapData {
+	return service.MindmapData{
+		Nodes: []service.MindmapNode{
+			{ID: "core", Label: "Core", Type: "core"},
+			{ID: "topic-0", Label: topic, Type: "topic"},
+		},
+		Edges:  []service.MindmapEdge{{Source: "core", Target: "topic-0", Weight: 1}},
+		Layout: service.MindmapLayout{Type: "galaxy"},
+	}
+}
+
+func TestMindmapService_SetCompleted_RecordsRevisions(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-revisions"))
+	sess := createStoppedSession(t, sessionService, user.ID)
+
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+	require.NoError(t, err)
+	_, err = mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Go generics"))
+	require.NoError(t, err)
+	_, err = mindmapService.RequestGeneration(ctx, sess.ID, user.ID, true)
+	require.NoError(t, err)
+	second, err := mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Generics in Go"))
+	require.NoError(t, err)
+
+	// A retried job completes the same version again
+	retried, err := mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Go type parameters"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, second.Version)
+	assert.Equal(t, 3, retried.Version, "a recorded version is never overwritten")
+
+	_, revisions, err := mindmapService.ListRevisions(ctx, sess.ID, user.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	assert.Equal(t, 3, revisions[0].Version, "newest first")
+
+	_, first, err := mindmapService.GetRevision(ctx, sess.ID, user.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Go generics", first.Nodes[1]["label"])
+
+	_, _, err = mindmapService.GetRevision(ctx, sess.ID, user.ID, 9)
+	assert.ErrorIs(t, err, service.ErrMindmapRevisionNotFound)
+}
+
+func TestMindmapService_RestoreRevision(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-restore"))
+	sess := createStoppedSession(t, sessionService, user.ID)
+
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+	require.NoError(t, err)
+	_, err = mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Go generics"))
+	require.NoError(t, err)
+	_, err = mindmapService.RequestGeneration(ctx, sess.ID, user.ID, true)
+	require.NoError(t, err)
+
+	_, err = mindmapService.RestoreRevision(ctx, sess.ID, user.ID, 1)
+	assert.ErrorIs(t, err, service.ErrMindmapGenerating, "a pending regeneration")
+
+	_, err = mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Databases"))
+	require.NoError(t, err)
+
+	restored, err := mindmapService.RestoreRevision(ctx, sess.ID, user.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, restored.Version)
+	assert.Equal(t, mindmapgraph.StatusCompleted, restored.Status)
+	assert.Equal(t, "Go generics", restored.Nodes[1]["label"])
+
+	_, rev, err := mindmapService.GetRevision(ctx, sess.ID, user.ID, 3)
+	require.NoError(t, err)
+	require.NotNil(t, rev.RestoredFrom)
+	assert.Equal(t, 1, *rev.RestoredFrom)
+
+	diff, err := mindmapService.DiffRevisions(ctx, sess.ID, user.ID, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []service.DiffTopic{{ID: "topic-0", Label: "Databases"}}, diff.RemovedTopics)
+	assert.Equal(t, []service.DiffTopic{{ID: "topic-0", Label: "Go generics"}}, diff.AddedTopics)
+}
+
+// ==================== Edit Tests ====================
+
+func TestMindmapService_Edit_ReplayedOnRegeneration(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-edit"))
+	sess := createStoppedSession(t, sessionService, user.ID)
+
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+	require.NoError(t, err)
+
+	_, err = mindmapService.Edit(ctx, sess.ID, user.ID, []service.MindmapEdit{{Op: service.EditRename, NodeID: "topic-0", Label: "Generics"}})
+	assert.ErrorIs(t, err, service.ErrMindmapNotCompleted)
+
+	_, err = mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Go generics"))
+	require.NoError(t, err)
+
+	_, err = mindmapService.Edit(ctx, sess.ID, user.ID, []service.MindmapEdit{{Op: service.EditRename, NodeID: "topic-9", Label: "Generics"}})
+	assert.ErrorIs(t, err, service.ErrInvalidMindmapEdit)
+
+	edited, err := mindmapService.Edit(ctx, sess.ID, user.ID, []service.MindmapEdit{
+		{Op: service.EditRename, NodeID: "topic-0", Label: "Generics"},
+		{Op: service.EditNote, NodeID: "core", Note: "for the talk"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Generics", edited.Nodes[1]["label"])
+
+	records, err := mindmapService.ListEdits(ctx, sess.ID, user.ID)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	edit, err := service.EditFromRecord(records[0])
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"topic-0": "Go generics"}, edit.Anchors)
+
+	_, err = mindmapService.RequestGeneration(ctx, sess.ID, user.ID, true)
+	require.NoError(t, err)
+	regenerated, err := mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Go generics"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Generics", regenerated.Nodes[1]["label"], "edits are replayed")
+	_, rev, err := mindmapService.GetRevision(ctx, sess.ID, user.ID, regenerated.Version)
+	require.NoError(t, err)
+	assert.Equal(t, "Go generics", rev.Nodes[1]["label"], "revisions keep the generated graph")
+}
+
+func TestMindmapService_Relayout_KeptByRegeneration(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-layout"))
+	sess := createStoppedSession(t, sessionService, user.ID)
+
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+	require.NoError(t, err)
+	completed, err := mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Go generics"))
+	require.NoError(t, err)
+	assert.Equal(t, "galaxy", completed.Layout["type"])
+	assert.NotNil(t, completed.Nodes[1]["position"], "positioned when saved")
+
+	_, err = mindmapService.Relayout(ctx, sess.ID, user.ID, "spiral", 0)
+	assert.ErrorIs(t, err, service.ErrInvalidLayout)
+	_, err = mindmapService.Relayout(ctx, sess.ID, user.ID, "tree", 4)
+	assert.ErrorIs(t, err, service.ErrInvalidLayout)
+
+	relaid, err := mindmapService.Relayout(ctx, sess.ID, user.ID, "force", 3)
+	require.NoError(t, err)
+	assert.Equal(t, completed.Version, relaid.Version, "not a new revision")
+	assert.Equal(t, "force", relaid.Layout["type"])
+
+	_, err = mindmapService.RequestGeneration(ctx, sess.ID, user.ID, true)
+	require.NoError(t, err)
+	regenerated, err := mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Go generics"))
+	require.NoError(t, err)
+
+	data, err := service.ConvertMapsToData(regenerated.Nodes, regenerated.GraphEdges, regenerated.Layout)
+	require.NoError(t, err)
+	assert.Equal(t, "force", data.Layout.Type, "the chosen layout is kept")
+	assert.Equal(t, 3.0, data.Layout.Params["dimensions"])
+}
+
+func TestMindmapService_Export_GatedByPlan(t *testing.T) {
+	client, mindmapService, sessionService, authService := setupMindmapServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("mindmap-export"))
+	sess := createStoppedSession(t, sessionService, user.ID)
+
+	mindmap, err := mindmapService.RequestGeneration(ctx, sess.ID, user.ID, false)
+	require.NoError(t, err)
+	_, err = mindmapService.SetCompleted(ctx, mindmap.ID, revisionTestData("Go generics"))
+	require.NoError(t, err)
+
+	_, err = mindmapService.Export(ctx, sess.ID, user.ID, "pdf")
+	assert.ErrorIs(t, err, service.ErrUnknownExportFormat)
+	_, err = mindmapService.Export(ctx, sess.ID, user.ID, service.ExportMarkdown)
+	assert.ErrorIs(t, err, service.ErrExportNotInPlan, "the free plan has no Markdown export")
+
+	_, err = client.Plan.UpdateOneID(service.FreePlanID).
+		SetFeatures(map[string]bool{"export_md": true}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	export, err := mindmapService.Export(ctx, sess.ID, user.ID, service.ExportMarkdown)
+	require.NoError(t, err)
+	assert.Equal(t, "mindmap-"+sess.ID.String()+".md", export.Filename)
+	assert.Equal(t, "text/markdown; charset=utf-8", export.ContentType)
+	assert.Contains(t, string(export.Content), "- **Go generics**")
+	_, err = mindmapService.Export(ctx, sess.ID, user.ID, service.ExportSVG)
+	assert.ErrorIs(t, err, service.ErrExportNotInPlan)
+}
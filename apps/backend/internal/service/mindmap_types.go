@@ -1,5 +1,7 @@
 package service
 
+import "encoding/json"
+
 // MindmapNode represents a node in the mindmap graph.
 type MindmapNode struct {
 	ID       string                 `json:"id"`
@@ -24,11 +26,12 @@ type MindmapEdge struct {
 	Target string  `json:"target"`
 	Weight float64 `json:"weight"`
 	Label  string  `json:"label,omitempty"`
+	Manual bool    `json:"manual,omitempty"` // added by the user
 }
 
 // MindmapLayout defines the layout configuration.
 type MindmapLayout struct {
-	Type   string                 `json:"type"` // galaxy, tree, radial
+	Type   string                 `json:"type"` // galaxy, tree, radial, force
 	Params map[string]interface{} `json:"params"`
 }
 
@@ -75,6 +78,9 @@ func ConvertEdgesToMaps(edges []MindmapEdge) []map[string]interface{} {
 		if edge.Label != "" {
 			m["label"] = edge.Label
 		}
+		if edge.Manual {
+			m["manual"] = true
+		}
 		result[i] = m
 	}
 	return result
@@ -87,3 +93,20 @@ func ConvertLayoutToMap(layout MindmapLayout) map[string]interface{} {
 		"params": layout.Params,
 	}
 }
+
+// ConvertMapsToData converts the Ent storage of a graph back to MindmapData.
+func ConvertMapsToData(nodes, edges []map[string]interface{}, layout map[string]interface{}) (MindmapData, error) {
+	raw, err := json.Marshal(map[string]interface{}{
+		"nodes":  nodes,
+		"edges":  edges,
+		"layout": layout,
+	})
+	if err != nil {
+		return MindmapData{}, err
+	}
+	var data MindmapData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return MindmapData{}, err
+	}
+	return data, nil
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/modelpricing"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+)
+
+// Model pricing errors.
+var (
+	ErrInvalidModelPricing  = errors.New("invalid model pricing")
+	ErrModelPricingExists   = errors.New("model pricing already exists")
+	ErrModelPricingNotFound = errors.New("model pricing not found")
+)
+
+// microCentsPerUSDPerMillion converts a price in USD per 1M tokens to
+// micro-cents per token: 100 cents, a million micro-cents per cent, over a
+// million tokens.
+const microCentsPerUSDPerMillion = 100
+
+// ModelPricingService manages the prices of AI models in DB with caching,
+// and computes the cost of AI requests from them.
+type ModelPricingService struct {
+	client *ent.Client
+
+	// In-memory cache of all prices
+	cache     []*ent.ModelPricing
+	cacheMu   sync.RWMutex
+	cacheTime time.Time
+	cacheTTL  time.Duration
+}
+
+// NewModelPricingService creates a new ModelPricingService.
+func NewModelPricingService(client *ent.Client) *ModelPricingService {
+	return &ModelPricingService{
+		client:   client,
+		cacheTTL: 5 * time.Minute,
+	}
+}
+
+// TokenUsage is the token usage of an AI request, as recorded in AI logs.
+// CachedInput is part of Input, Thinking is not part of Output.
+type TokenUsage struct {
+	Input       int
+	CachedInput int
+	Output      int
+	Thinking    int
+}
+
+// PriceFor returns the price of a model at the given time, or nil when the
+// model has no price. A price matches the models its model name is a prefix
+// of, the longest match wins.
+func (s *ModelPricingService) PriceFor(ctx context.Context, provider, model string, at time.Time) (*ent.ModelPricing, error) {
+	pricings, err := s.all(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model = strings.ToLower(model)
+	var best *ent.ModelPricing
+	for _, p := range pricings {
+		if p.Provider != provider || !strings.HasPrefix(model, strings.ToLower(p.Model)) {
+			continue
+		}
+		if at.Before(p.EffectiveFrom) || (p.EffectiveUntil != nil && !at.Before(*p.EffectiveUntil)) {
+			continue
+		}
+		if best == nil || len(p.Model) > len(best.Model) ||
+			(len(p.Model) == len(best.Model) && p.EffectiveFrom.After(best.EffectiveFrom)) {
+			best = p
+		}
+	}
+	return best, nil
+}
+
+// Cost returns the cost in micro-cents of an AI request made at the given
+// time, or nil when its model has no price.
+func (s *ModelPricingService) Cost(ctx context.Context, provider, model string, at time.Time, usage TokenUsage) (*int64, error) {
+	price, err := s.PriceFor(ctx, provider, model, at)
+	if err != nil || price == nil {
+		return nil, err
+	}
+	cost := CostMicroCents(price, usage)
+	return &cost, nil
+}
+
+// CostMicroCents computes the cost in micro-cents of the token usage at a
+// price. Thinking tokens default to the output price and cached input tokens
+// to the input price.
+func CostMicroCents(price *ent.ModelPricing, usage TokenUsage) int64 {
+	thinkingPrice := price.OutputPrice
+	if price.ThinkingPrice != nil {
+		thinkingPrice = *price.ThinkingPrice
+	}
+	cachedInputPrice := price.InputPrice
+	if price.CachedInputPrice != nil {
+		cachedInputPrice = *price.CachedInputPrice
+	}
+
+	cachedInput := min(usage.CachedInput, usage.Input)
+	usd := float64(usage.Input-cachedInput)*price.InputPrice +
+		float64(cachedInput)*cachedInputPrice +
+		float64(usage.Output)*price.OutputPrice +
+		float64(usage.Thinking)*thinkingPrice
+	return int64(math.Round(usd * microCentsPerUSDPerMillion))
+}
+
+// all returns every price, from the cache while it is fresh.
+func (s *ModelPricingService) all(ctx context.Context) ([]*ent.ModelPricing, error) {
+	s.cacheMu.RLock()
+	if time.Since(s.cacheTime) < s.cacheTTL {
+		pricings := s.cache
+		s.cacheMu.RUnlock()
+		return pricings, nil
+	}
+	s.cacheMu.RUnlock()
+
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+
+	// Double-check after acquiring write lock (another goroutine may have refreshed)
+	if time.Since(s.cacheTime) < s.cacheTTL {
+		return s.cache, nil
+	}
+
+	pricings, err := s.client.ModelPricing.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.cache = pricings
+	s.cacheTime = time.Now()
+	return pricings, nil
+}
+
+// InvalidateCache clears the cache.
+func (s *ModelPricingService) InvalidateCache() {
+	s.cacheMu.Lock()
+	s.cache = nil
+	s.cacheTime = time.Time{}
+	s.cacheMu.Unlock()
+}
+
+// List returns all prices, the latest first for each model.
+func (s *ModelPricingService) List(ctx context.Context) ([]*ent.ModelPricing, error) {
+	return s.client.ModelPricing.Query().
+		Order(
+			ent.Asc(modelpricing.FieldProvider),
+			ent.Asc(modelpricing.FieldModel),
+			ent.Desc(modelpricing.FieldEffectiveFrom),
+		).
+		All(ctx)
+}
+
+// ModelPricingRequest is the request for creating/updating a model price.
+// Prices are in USD per 1M tokens.
+type ModelPricingRequest struct {
+	Provider         string     `json:"provider"`
+	Model            string     `json:"model"`
+	InputPrice       float64    `json:"input_price"`
+	OutputPrice      float64    `json:"output_price"`
+	ThinkingPrice    *float64   `json:"thinking_price,omitempty"`
+	CachedInputPrice *float64   `json:"cached_input_price,omitempty"`
+	EffectiveFrom    time.Time  `json:"effective_from"`
+	EffectiveUntil   *time.Time `json:"effective_until,omitempty"`
+	UpdatedBy        string     `json:"-"`
+}
+
+// validate checks the request, and defaults the effective date to now.
+func (r *ModelPricingRequest) validate() error {
+	if !ai.IsKnownProvider(r.Provider) {
+		return fmt.Errorf("%w: %q", ErrUnknownAIProvider, r.Provider)
+	}
+	if strings.TrimSpace(r.Model) == "" {
+		return fmt.Errorf("%w: model is required", ErrInvalidModelPricing)
+	}
+	for _, price := range []*float64{&r.InputPrice, &r.OutputPrice, r.ThinkingPrice, r.CachedInputPrice} {
+		if price != nil && (*price < 0 || math.IsNaN(*price) || math.IsInf(*price, 0)) {
+			return fmt.Errorf("%w: prices must be non-negative", ErrInvalidModelPricing)
+		}
+	}
+	if r.EffectiveFrom.IsZero() {
+		r.EffectiveFrom = time.Now()
+	}
+	if r.EffectiveUntil != nil && !r.EffectiveUntil.After(r.EffectiveFrom) {
+		return fmt.Errorf("%w: effective_until must be after effective_from", ErrInvalidModelPricing)
+	}
+	return nil
+}
+
+// Create adds a model price.
+func (s *ModelPricingService) Create(ctx context.Context, req ModelPricingRequest) (*ent.ModelPricing, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	pricing, err := s.client.ModelPricing.Create().
+		SetProvider(req.Provider).
+		SetModel(req.Model).
+		SetInputPrice(req.InputPrice).
+		SetOutputPrice(req.OutputPrice).
+		SetNillableThinkingPrice(req.ThinkingPrice).
+		SetNillableCachedInputPrice(req.CachedInputPrice).
+		SetEffectiveFrom(req.EffectiveFrom).
+		SetNillableEffectiveUntil(req.EffectiveUntil).
+		SetUpdatedBy(req.UpdatedBy).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrModelPricingExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.InvalidateCache()
+	return pricing, nil
+}
+
+// Update replaces a model price.
+func (s *ModelPricingService) Update(ctx context.Context, id int, req ModelPricingRequest) (*ent.ModelPricing, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	update := s.client.ModelPricing.UpdateOneID(id).
+		SetProvider(req.Provider).
+		SetModel(req.Model).
+		SetInputPrice(req.InputPrice).
+		SetOutputPrice(req.OutputPrice).
+		SetEffectiveFrom(req.EffectiveFrom).
+		SetUpdatedBy(req.UpdatedBy)
+	if req.ThinkingPrice != nil {
+		update.SetThinkingPrice(*req.ThinkingPrice)
+	} else {
+		update.ClearThinkingPrice()
+	}
+	if req.CachedInputPrice != nil {
+		update.SetCachedInputPrice(*req.CachedInputPrice)
+	} else {
+		update.ClearCachedInputPrice()
+	}
+	if req.EffectiveUntil != nil {
+		update.SetEffectiveUntil(*req.EffectiveUntil)
+	} else {
+		update.ClearEffectiveUntil()
+	}
+
+	pricing, err := update.Save(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, ErrModelPricingNotFound
+	case ent.IsConstraintError(err):
+		return nil, ErrModelPricingExists
+	case err != nil:
+		return nil, err
+	}
+
+	s.InvalidateCache()
+	return pricing, nil
+}
+
+// Delete removes a model price.
+func (s *ModelPricingService) Delete(ctx context.Context, id int) error {
+	err := s.client.ModelPricing.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return ErrModelPricingNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	s.InvalidateCache()
+	return nil
+}
+
+// defaultPricingFrom is when the default prices take effect, early enough to
+// price every AI log recorded before prices were managed.
+var defaultPricingFrom = time.Unix(0, 0).UTC()
+
+// SeedDefaultPricing creates the prices of the default models if they don't
+// have one.
+func (s *ModelPricingService) SeedDefaultPricing(ctx context.Context) error {
+	price := func(usd float64) *float64 { return &usd }
+	defaults := []ModelPricingRequest{
+		{
+			Provider:         string(ai.ProviderOpenAI),
+			Model:            "gpt-4o-mini",
+			InputPrice:       0.15,
+			OutputPrice:      0.60,
+			CachedInputPrice: price(0.075),
+		},
+		{
+			Provider:         string(ai.ProviderOpenAI),
+			Model:            ai.DefaultOpenAIModel,
+			InputPrice:       2.50,
+			OutputPrice:      10.00,
+			CachedInputPrice: price(1.25),
+		},
+		{
+			Provider:         string(ai.ProviderGemini),
+			Model:            ai.DefaultGeminiModel,
+			InputPrice:       0.10,
+			OutputPrice:      0.40,
+			CachedInputPrice: price(0.025),
+		},
+		{
+			Provider:         string(ai.ProviderClaude),
+			Model:            "claude-sonnet-4",
+			InputPrice:       3.00,
+			OutputPrice:      15.00,
+			CachedInputPrice: price(0.30),
+		},
+	}
+
+	for _, pricing := range defaults {
+		exists, err := s.client.ModelPricing.Query().
+			Where(
+				modelpricing.ProviderEQ(pricing.Provider),
+				modelpricing.ModelEQ(pricing.Model),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		pricing.EffectiveFrom = defaultPricingFrom
+		pricing.UpdatedBy = "seed"
+		if _, err := s.Create(ctx, pricing); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
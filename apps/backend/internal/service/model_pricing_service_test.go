@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+func TestCostMicroCents(t *testing.T) {
+	price := &ent.ModelPricing{InputPrice: 2.50, OutputPrice: 10.00}
+
+	tests := []struct {
+		name     string
+		price    *ent.ModelPricing
+		usage    TokenUsage
+		expected int64
+	}{
+		{
+			name:     "single token",
+			price:    price,
+			usage:    TokenUsage{Input: 1},
+			expected: 250,
+		},
+		{
+			name:     "input and output",
+			price:    price,
+			usage:    TokenUsage{Input: 1_000_000, Output: 500_000},
+			expected: 750_000_000, // $7.50
+		},
+		{
+			name:     "thinking and cached input default to the output and input prices",
+			price:    price,
+			usage:    TokenUsage{Input: 100, CachedInput: 40, Thinking: 10},
+			expected: 35_000,
+		},
+		{
+			name: "thinking and cached input prices",
+			price: &ent.ModelPricing{
+				InputPrice:       2.50,
+				OutputPrice:      10.00,
+				ThinkingPrice:    ptr(3.50),
+				CachedInputPrice: ptr(1.25),
+			},
+			usage:    TokenUsage{Input: 100, CachedInput: 40, Thinking: 10},
+			expected: 23_500,
+		},
+		{
+			name:     "fractions of a micro-cent are rounded",
+			price:    &ent.ModelPricing{InputPrice: 0.004},
+			usage:    TokenUsage{Input: 2},
+			expected: 1, // 0.8
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CostMicroCents(tt.price, tt.usage))
+		})
+	}
+}
+
+func TestModelPricingService_PriceFor(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewModelPricingService(client)
+
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	jun := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	old, err := svc.Create(ctx, ModelPricingRequest{
+		Provider: string(ai.ProviderGemini), Model: "gemini-2.0-flash",
+		InputPrice: 0.35, OutputPrice: 1.05, EffectiveFrom: jan,
+	})
+	require.NoError(t, err)
+	current, err := svc.Create(ctx, ModelPricingRequest{
+		Provider: string(ai.ProviderGemini), Model: "gemini-2.0-flash",
+		InputPrice: 0.10, OutputPrice: 0.40, EffectiveFrom: jun,
+	})
+	require.NoError(t, err)
+	lite, err := svc.Create(ctx, ModelPricingRequest{
+		Provider: string(ai.ProviderGemini), Model: "gemini-2.0-flash-lite",
+		InputPrice: 0.075, OutputPrice: 0.30, EffectiveFrom: jan,
+		EffectiveUntil: &jun,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		provider ai.ProviderType
+		model    string
+		at       time.Time
+		expected *ent.ModelPricing
+	}{
+		{"before any price", ai.ProviderGemini, "gemini-2.0-flash", jan.Add(-time.Hour), nil},
+		{"earlier price", ai.ProviderGemini, "gemini-2.0-flash", jun.Add(-time.Hour), old},
+		{"later price", ai.ProviderGemini, "gemini-2.0-flash-001", jun, current},
+		{"longest prefix", ai.ProviderGemini, "gemini-2.0-flash-lite", jan, lite},
+		{"after effective until", ai.ProviderGemini, "gemini-2.0-flash-lite", jun, current},
+		{"other provider", ai.ProviderOpenAICompatible, "gemini-2.0-flash", jun, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := svc.PriceFor(ctx, string(tt.provider), tt.model, tt.at)
+			require.NoError(t, err)
+			if tt.expected == nil {
+				assert.Nil(t, price)
+				return
+			}
+			require.NotNil(t, price)
+			assert.Equal(t, tt.expected.ID, price.ID)
+		})
+	}
+}
+
+func TestModelPricingService_Create_Invalid(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewModelPricingService(client)
+	now := time.Now()
+
+	_, err := svc.Create(ctx, ModelPricingRequest{Provider: "acme", Model: "m"})
+	assert.ErrorIs(t, err, ErrUnknownAIProvider)
+
+	_, err = svc.Create(ctx, ModelPricingRequest{Provider: string(ai.ProviderOpenAI), Model: "gpt-4o", InputPrice: -1})
+	assert.ErrorIs(t, err, ErrInvalidModelPricing)
+
+	_, err = svc.Create(ctx, ModelPricingRequest{
+		Provider: string(ai.ProviderOpenAI), Model: "gpt-4o",
+		EffectiveFrom: now, EffectiveUntil: &now,
+	})
+	assert.ErrorIs(t, err, ErrInvalidModelPricing)
+
+	req := ModelPricingRequest{Provider: string(ai.ProviderOpenAI), Model: "gpt-4o", EffectiveFrom: now}
+	_, err = svc.Create(ctx, req)
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, req)
+	assert.ErrorIs(t, err, ErrModelPricingExists)
+}
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"google.golang.org/api/idtoken"
 
@@ -119,11 +120,14 @@ func (s *OAuthService) FindOrCreateGoogleUser(ctx context.Context, info *GoogleU
 		Only(ctx)
 	if err == nil {
 		// Link Google ID to existing email account
-		updated, updateErr := s.client.User.
+		update := s.client.User.
 			UpdateOneID(u.ID).
 			SetGoogleID(info.GoogleID).
-			SetNillableAvatarURL(&info.Picture).
-			Save(ctx)
+			SetNillableAvatarURL(&info.Picture)
+		if u.EmailVerifiedAt == nil {
+			update.SetEmailVerifiedAt(time.Now()) // Google verified the address
+		}
+		updated, updateErr := update.Save(ctx)
 		return updated, false, updateErr
 	}
 	if !ent.IsNotFound(err) {
@@ -137,7 +141,8 @@ func (s *OAuthService) FindOrCreateGoogleUser(ctx context.Context, info *GoogleU
 		SetGoogleID(info.GoogleID).
 		SetNillableAvatarURL(&info.Picture).
 		SetAuthProvider("google").
-		SetStatus("active"). // Google already verified email
+		SetEmailVerifiedAt(time.Now()). // Google already verified email
+		SetStatus("active").
 		Save(ctx)
 	if err != nil {
 		return nil, false, err
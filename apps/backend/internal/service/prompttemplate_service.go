@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/prompttemplate"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+)
+
+var (
+	// ErrUnknownPromptTemplate is returned when overriding a template that
+	// doesn't exist.
+	ErrUnknownPromptTemplate = errors.New("unknown prompt template")
+	// ErrInvalidPromptTemplate is returned when a template body doesn't parse.
+	ErrInvalidPromptTemplate = errors.New("invalid prompt template")
+)
+
+// PromptTemplateService manages prompt template overrides in DB with caching.
+type PromptTemplateService struct {
+	client *ent.Client
+
+	// In-memory cache, nil entries mean no override
+	cache     map[string]*ent.PromptTemplate
+	cacheMu   sync.RWMutex
+	cacheTime time.Time
+	cacheTTL  time.Duration
+}
+
+// NewPromptTemplateService creates a new PromptTemplateService.
+func NewPromptTemplateService(client *ent.Client) *PromptTemplateService {
+	return &PromptTemplateService{
+		client:   client,
+		cache:    make(map[string]*ent.PromptTemplate),
+		cacheTTL: 5 * time.Minute,
+	}
+}
+
+// GetPromptOverride returns the enabled override with the highest version,
+// or nil when there is none.
+func (s *PromptTemplateService) GetPromptOverride(ctx context.Context, name, locale string) (*ent.PromptTemplate, error) {
+	key := name + "/" + locale
+
+	s.cacheMu.RLock()
+	if time.Since(s.cacheTime) < s.cacheTTL {
+		if tmpl, ok := s.cache[key]; ok {
+			s.cacheMu.RUnlock()
+			return tmpl, nil
+		}
+	}
+	s.cacheMu.RUnlock()
+
+	tmpl, err := s.client.PromptTemplate.Query().
+		Where(
+			prompttemplate.NameEQ(name),
+			prompttemplate.LocaleEQ(locale),
+			prompttemplate.EnabledEQ(true),
+		).
+		Order(ent.Desc(prompttemplate.FieldVersion)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	s.cacheMu.Lock()
+	if time.Since(s.cacheTime) >= s.cacheTTL {
+		s.cache = make(map[string]*ent.PromptTemplate)
+		s.cacheTime = time.Now()
+	}
+	s.cache[key] = tmpl
+	s.cacheMu.Unlock()
+
+	return tmpl, nil
+}
+
+// InvalidateCache clears the cache.
+func (s *PromptTemplateService) InvalidateCache() {
+	s.cacheMu.Lock()
+	s.cache = make(map[string]*ent.PromptTemplate)
+	s.cacheTime = time.Time{}
+	s.cacheMu.Unlock()
+}
+
+// GetAll returns all prompt template overrides.
+func (s *PromptTemplateService) GetAll(ctx context.Context) ([]*ent.PromptTemplate, error) {
+	return s.client.PromptTemplate.Query().
+		Order(
+			ent.Asc(prompttemplate.FieldName),
+			ent.Asc(prompttemplate.FieldLocale),
+			ent.Desc(prompttemplate.FieldVersion),
+		).
+		All(ctx)
+}
+
+// UpsertPromptTemplateRequest is the request for creating/updating a prompt
+// template override.
+type UpsertPromptTemplateRequest struct {
+	Name      string `json:"name"`
+	Locale    string `json:"locale"`
+	Version   int    `json:"version"`
+	Body      string `json:"body"`
+	Enabled   bool   `json:"enabled"`
+	UpdatedBy string `json:"updated_by,omitempty"`
+}
+
+// Upsert creates or updates a version of a prompt template override.
+func (s *PromptTemplateService) Upsert(ctx context.Context, req UpsertPromptTemplateRequest) (*ent.PromptTemplate, error) {
+	if !prompt.IsKnownTemplate(req.Name) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPromptTemplate, req.Name)
+	}
+	if _, err := prompt.Parse(req.Name, req.Body); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPromptTemplate, err)
+	}
+
+	existing, err := s.client.PromptTemplate.Query().
+		Where(
+			prompttemplate.NameEQ(req.Name),
+			prompttemplate.LocaleEQ(req.Locale),
+			prompttemplate.VersionEQ(req.Version),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	var tmpl *ent.PromptTemplate
+	if existing != nil {
+		tmpl, err = existing.Update().
+			SetBody(req.Body).
+			SetEnabled(req.Enabled).
+			SetUpdatedBy(req.UpdatedBy).
+			Save(ctx)
+	} else {
+		tmpl, err = s.client.PromptTemplate.Create().
+			SetName(req.Name).
+			SetLocale(req.Locale).
+			SetVersion(req.Version).
+			SetBody(req.Body).
+			SetEnabled(req.Enabled).
+			SetUpdatedBy(req.UpdatedBy).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.InvalidateCache()
+	return tmpl, nil
+}
+
+// Compile-time check that the service provides prompt overrides.
+var _ prompt.OverrideProvider = (*PromptTemplateService)(nil)
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+func TestPromptTemplateService_GetPromptOverride(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewPromptTemplateService(client)
+
+	// No override
+	tmpl, err := svc.GetPromptOverride(ctx, prompt.TagExtraction, "en")
+	require.NoError(t, err)
+	assert.Nil(t, tmpl)
+
+	for _, req := range []UpsertPromptTemplateRequest{
+		{Name: prompt.TagExtraction, Locale: "en", Version: 2, Body: `{{define "user"}}v2{{end}}`, Enabled: true},
+		{Name: prompt.TagExtraction, Locale: "en", Version: 3, Body: `{{define "user"}}v3{{end}}`, Enabled: true},
+		{Name: prompt.TagExtraction, Locale: "en", Version: 4, Body: `{{define "user"}}v4{{end}}`, Enabled: false},
+	} {
+		_, err := svc.Upsert(ctx, req)
+		require.NoError(t, err)
+	}
+
+	// The enabled override with the highest version wins
+	tmpl, err = svc.GetPromptOverride(ctx, prompt.TagExtraction, "en")
+	require.NoError(t, err)
+	require.NotNil(t, tmpl)
+	assert.Equal(t, 3, tmpl.Version)
+
+	tmpl, err = svc.GetPromptOverride(ctx, prompt.TagExtraction, "ko")
+	require.NoError(t, err)
+	assert.Nil(t, tmpl)
+}
+
+func TestPromptTemplateService_Upsert_Update(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewPromptTemplateService(client)
+
+	req := UpsertPromptTemplateRequest{Name: prompt.Mindmap, Locale: "ko", Version: 2, Body: `{{define "user"}}old{{end}}`, Enabled: true}
+	_, err := svc.Upsert(ctx, req)
+	require.NoError(t, err)
+
+	// Cached before the update, invalidated by it
+	_, err = svc.GetPromptOverride(ctx, prompt.Mindmap, "ko")
+	require.NoError(t, err)
+
+	req.Body = `{{define "user"}}new{{end}}`
+	req.UpdatedBy = "admin@mindhit.test"
+	_, err = svc.Upsert(ctx, req)
+	require.NoError(t, err)
+
+	tmpl, err := svc.GetPromptOverride(ctx, prompt.Mindmap, "ko")
+	require.NoError(t, err)
+	require.NotNil(t, tmpl)
+	assert.Equal(t, `{{define "user"}}new{{end}}`, tmpl.Body)
+	assert.Equal(t, "admin@mindhit.test", tmpl.UpdatedBy)
+
+	all, err := svc.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+}
+
+func TestPromptTemplateService_Upsert_Invalid(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	svc := NewPromptTemplateService(client)
+
+	_, err := svc.Upsert(ctx, UpsertPromptTemplateRequest{Name: "unknown", Locale: "ko", Version: 1, Body: `{{define "user"}}x{{end}}`})
+	assert.ErrorIs(t, err, ErrUnknownPromptTemplate)
+
+	_, err = svc.Upsert(ctx, UpsertPromptTemplateRequest{Name: prompt.Summarize, Locale: "ko", Version: 1, Body: `no user template`})
+	assert.ErrorIs(t, err, ErrInvalidPromptTemplate)
+}
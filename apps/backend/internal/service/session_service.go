@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/hibiken/asynq"
 
 	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/plan"
 	"github.com/mindhit/api/ent/session"
 	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/internal/infrastructure/metrics"
@@ -24,19 +26,43 @@ var (
 	ErrSessionNotFound     = errors.New("session not found")
 	ErrSessionNotOwned     = errors.New("session not owned by user")
 	ErrInvalidSessionState = errors.New("invalid session state transition")
+	ErrSessionLimitReached = errors.New("concurrent session limit reached")
 )
 
+// SessionLimitError is returned by Start when the user's plan does not allow
+// another recording session. It matches ErrSessionLimitReached with errors.Is.
+type SessionLimitError struct {
+	PlanID string
+	Limit  int
+	Active int
+	// UpgradeAvailable reports whether another plan allows more concurrent sessions.
+	UpgradeAvailable bool
+}
+
+func (e *SessionLimitError) Error() string {
+	return fmt.Sprintf("%s: %d of %d sessions active on plan %q", ErrSessionLimitReached, e.Active, e.Limit, e.PlanID)
+}
+
+// Is reports whether target is ErrSessionLimitReached.
+func (e *SessionLimitError) Is(target error) bool {
+	return target == ErrSessionLimitReached
+}
+
 // SessionService handles session-related business logic.
 type SessionService struct {
-	client      *ent.Client
-	queueClient *queue.Client
+	client              *ent.Client
+	queueClient         *queue.Client
+	subscriptionService *SubscriptionService
+	knowledgeService    *KnowledgeService
 }
 
 // NewSessionService creates a new SessionService instance.
 func NewSessionService(client *ent.Client, queueClient *queue.Client) *SessionService {
 	return &SessionService{
-		client:      client,
-		queueClient: queueClient,
+		client:              client,
+		queueClient:         queueClient,
+		subscriptionService: NewSubscriptionService(client),
+		knowledgeService:    NewKnowledgeService(client),
 	}
 }
 
@@ -45,20 +71,92 @@ func (s *SessionService) activeSessions() *ent.SessionQuery {
 	return s.client.Session.Query().Where(session.StatusNEQ(session.Status(sessionStatusInactive)))
 }
 
-// Start creates a new recording session
+// Start creates a new recording session.
+// Returns a *SessionLimitError when the user already has as many
+// recording/paused sessions as their plan allows.
 func (s *SessionService) Start(ctx context.Context, userID uuid.UUID) (*ent.Session, error) {
-	sess, err := s.client.Session.
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Starts of one user run one at a time, so each counts the sessions the
+	// others created
+	if _, err := tx.User.Query().Where(user.IDEQ(userID)).ForUpdate().IDs(ctx); err != nil {
+		return nil, fmt.Errorf("lock user: %w", err)
+	}
+
+	if err := s.checkConcurrentLimit(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	sess, err := tx.Session.
 		Create().
 		SetUserID(userID).
 		SetSessionStatus(session.SessionStatusRecording).
 		SetStartedAt(time.Now()).
 		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	metrics.SessionsCreated.Inc()
 
-	if err == nil {
-		metrics.SessionsCreated.Inc()
+	return sess.Unwrap(), nil
+}
+
+// checkConcurrentLimit enforces the plan's max_concurrent_sessions within tx.
+func (s *SessionService) checkConcurrentLimit(ctx context.Context, tx *ent.Tx, userID uuid.UUID) error {
+	p, err := s.subscriptionService.GetUserPlan(ctx, userID)
+	if ent.IsNotFound(err) {
+		// Plans are not seeded; nothing to enforce
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get user plan: %w", err)
+	}
+
+	if p.MaxConcurrentSessions == nil {
+		return nil
+	}
+	limit := *p.MaxConcurrentSessions
+
+	active, err := tx.Session.Query().
+		Where(
+			session.StatusNEQ(session.Status(sessionStatusInactive)),
+			session.HasUserWith(user.IDEQ(userID)),
+			session.SessionStatusIn(session.SessionStatusRecording, session.SessionStatusPaused),
+		).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("count active sessions: %w", err)
+	}
+
+	if active < limit {
+		return nil
 	}
 
-	return sess, err
+	upgradeAvailable, err := tx.Plan.
+		Query().
+		Where(plan.Or(
+			plan.MaxConcurrentSessionsIsNil(),
+			plan.MaxConcurrentSessionsGT(limit),
+		)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("query plans: %w", err)
+	}
+
+	return &SessionLimitError{
+		PlanID:           p.ID,
+		Limit:            limit,
+		Active:           active,
+		UpgradeAvailable: upgradeAvailable,
+	}
 }
 
 // Pause pauses a recording session
@@ -116,6 +214,11 @@ func (s *SessionService) Stop(ctx context.Context, sessionID, userID uuid.UUID)
 		return nil, err
 	}
 
+	// Close visits whose page_leave never arrived
+	if err := closeOpenPageVisits(ctx, s.client, sessionID, now); err != nil {
+		slog.Error("failed to close open page visits", "session_id", sessionID, "error", err)
+	}
+
 	// Record session duration metric
 	if sess.StartedAt != (time.Time{}) {
 		duration := now.Sub(sess.StartedAt).Seconds()
@@ -203,7 +306,8 @@ func (s *SessionService) Update(ctx context.Context, sessionID, userID uuid.UUID
 	return update.Save(ctx)
 }
 
-// Delete soft-deletes a session by setting status to "deleted" and deleted_at timestamp.
+// Delete soft-deletes a session by setting status to "deleted" and deleted_at
+// timestamp, and takes its topics out of the user's knowledge graph.
 func (s *SessionService) Delete(ctx context.Context, sessionID, userID uuid.UUID) error {
 	sess, err := s.getOwnedSession(ctx, sessionID, userID)
 	if err != nil {
@@ -216,7 +320,10 @@ func (s *SessionService) Delete(ctx context.Context, sessionID, userID uuid.UUID
 		SetStatus(session.Status(sessionStatusInactive)).
 		SetDeletedAt(now).
 		Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	return s.knowledgeService.RemoveSession(ctx, sess.ID)
 }
 
 // getOwnedSession retrieves an active session and verifies ownership.
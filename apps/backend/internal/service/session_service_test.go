@@ -3,7 +3,9 @@ package service_test
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/mindhit/api/ent"
 	"github.com/mindhit/api/ent/session"
+	entuser "github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/internal/service"
 	"github.com/mindhit/api/internal/testutil"
 )
@@ -51,6 +54,107 @@ func TestSessionService_Start_Success(t *testing.T) {
 	assert.False(t, sess.StartedAt.IsZero())
 }
 
+// subscribeToLimitedPlan puts a user on a fresh plan allowing maxSessions
+// concurrent sessions.
+func subscribeToLimitedPlan(t *testing.T, client *ent.Client, userID uuid.UUID, maxSessions int) *ent.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	p, err := client.Plan.Create().
+		SetID("limited-" + uuid.New().String()[:8]).
+		SetName("Limited").
+		SetMaxConcurrentSessions(maxSessions).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Subscription.Create().
+		SetUserID(userID).
+		SetPlan(p).
+		SetCurrentPeriodStart(time.Now()).
+		SetCurrentPeriodEnd(time.Now().AddDate(0, 0, 30)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestSessionService_Start_ConcurrentLimit(t *testing.T) {
+	client, sessionService, authService := setupSessionServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("start-limit"))
+	p := subscribeToLimitedPlan(t, client, user.ID, 1)
+
+	_, err := sessionService.Start(ctx, user.ID)
+	require.NoError(t, err)
+
+	_, err = sessionService.Start(ctx, user.ID)
+
+	require.ErrorIs(t, err, service.ErrSessionLimitReached)
+	var limitErr *service.SessionLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, p.ID, limitErr.PlanID)
+	assert.Equal(t, 1, limitErr.Limit)
+	assert.Equal(t, 1, limitErr.Active)
+}
+
+func TestSessionService_Start_ConcurrentStartsKeepLimit(t *testing.T) {
+	client, sessionService, authService := setupSessionServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("start-concurrent"))
+	subscribeToLimitedPlan(t, client, user.ID, 2)
+
+	const starts = 8
+	errs := make([]error, starts)
+	var wg sync.WaitGroup
+	for i := range starts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = sessionService.Start(ctx, user.ID)
+		}()
+	}
+	wg.Wait()
+
+	started := 0
+	for _, err := range errs {
+		if err == nil {
+			started++
+			continue
+		}
+		assert.ErrorIs(t, err, service.ErrSessionLimitReached)
+	}
+	assert.Equal(t, 2, started)
+
+	active, err := client.Session.Query().
+		Where(session.SessionStatusEQ(session.SessionStatusRecording)).
+		Where(session.HasUserWith(entuser.IDEQ(user.ID))).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, active)
+}
+
+func TestSessionService_Start_StoppedSessionsDontCount(t *testing.T) {
+	client, sessionService, authService := setupSessionServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("start-after-stop"))
+	subscribeToLimitedPlan(t, client, user.ID, 1)
+
+	sess, err := sessionService.Start(ctx, user.ID)
+	require.NoError(t, err)
+	_, err = sessionService.Stop(ctx, sess.ID, user.ID)
+	require.NoError(t, err)
+
+	_, err = sessionService.Start(ctx, user.ID)
+
+	assert.NoError(t, err)
+}
+
 // ==================== Pause Tests ====================
 
 func TestSessionService_Pause_Success(t *testing.T) {
@@ -288,9 +392,11 @@ func TestSessionService_ListByUser_Pagination(t *testing.T) {
 	ctx := context.Background()
 	user := createTestUser(t, authService, uniqueEmail("test"))
 
-	// Create 5 sessions
+	// Create 5 sessions, stopping each to stay within the plan's concurrent limit
 	for i := 0; i < 5; i++ {
-		_, err := sessionService.Start(ctx, user.ID)
+		sess, err := sessionService.Start(ctx, user.ID)
+		require.NoError(t, err)
+		_, err = sessionService.Stop(ctx, sess.ID, user.ID)
 		require.NoError(t, err)
 	}
 
@@ -537,7 +643,6 @@ func TestSessionService_GetWithDetails_WithRelatedData(t *testing.T) {
 	urlRecord, err := client.URL.Create().
 		SetURL("https://example.com/test-" + urlHash).
 		SetURLHash(urlHash).
-		SetTitle("Test Page").
 		Save(ctx)
 	require.NoError(t, err)
 
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stripe/stripe-go/v76"
+	stripeclient "github.com/stripe/stripe-go/v76/client"
+	"github.com/stripe/stripe-go/v76/webhook"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/subscription"
+	"github.com/mindhit/api/ent/user"
+)
+
+// StripeService errors
+var (
+	ErrBillingNotConfigured     = errors.New("billing is not configured")
+	ErrPlanNotPurchasable       = errors.New("plan cannot be purchased")
+	ErrAlreadySubscribed        = errors.New("user already has a paid subscription")
+	ErrNoBillingAccount         = errors.New("user has no billing account")
+	ErrInvalidWebhookSignature  = errors.New("invalid webhook signature")
+	errUnexpectedWebhookPayload = errors.New("unexpected webhook payload")
+)
+
+// StripeConfig holds the settings StripeService needs.
+type StripeConfig struct {
+	SecretKey     string
+	WebhookSecret string
+	// PriceIDs maps plan IDs to Stripe price IDs. Only plans listed here can
+	// be bought through Checkout.
+	PriceIDs        map[string]string
+	SuccessURL      string
+	CancelURL       string
+	PortalReturnURL string
+	// APIBaseURL overrides the Stripe API endpoint, for tests.
+	APIBaseURL string
+}
+
+// StripeService creates Stripe Checkout and customer portal sessions and
+// turns Stripe webhooks into subscription changes.
+type StripeService struct {
+	client              *ent.Client
+	subscriptionService *SubscriptionService
+	api                 *stripeclient.API
+	cfg                 StripeConfig
+}
+
+// NewStripeService creates a new StripeService instance. Without a secret key
+// every method returns ErrBillingNotConfigured.
+func NewStripeService(client *ent.Client, subscriptionService *SubscriptionService, cfg StripeConfig) *StripeService {
+	s := &StripeService{
+		client:              client,
+		subscriptionService: subscriptionService,
+		cfg:                 cfg,
+	}
+	if cfg.SecretKey == "" {
+		return s
+	}
+
+	var backends *stripe.Backends
+	if cfg.APIBaseURL != "" {
+		backends = stripe.NewBackendsWithConfig(&stripe.BackendConfig{
+			URL:               stripe.String(cfg.APIBaseURL),
+			MaxNetworkRetries: stripe.Int64(0),
+		})
+	}
+	s.api = stripeclient.New(cfg.SecretKey, backends)
+	return s
+}
+
+// CreateCheckoutSession starts a Stripe Checkout for the plan and returns the
+// URL to redirect the user to.
+func (s *StripeService) CreateCheckoutSession(ctx context.Context, userID uuid.UUID, planID string) (string, error) {
+	if s.api == nil {
+		return "", ErrBillingNotConfigured
+	}
+
+	priceID, ok := s.cfg.PriceIDs[planID]
+	if !ok || priceID == "" {
+		return "", ErrPlanNotPurchasable
+	}
+
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("get user: %w", err)
+	}
+
+	// Changing a paid plan goes through the customer portal
+	paid, err := s.client.Subscription.Query().
+		Where(
+			subscription.HasUserWith(user.IDEQ(userID)),
+			subscription.StatusIn(CurrentSubscriptionStatuses...),
+			subscription.StripeSubscriptionIDNotNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("check paid subscription: %w", err)
+	}
+	if paid {
+		return "", ErrAlreadySubscribed
+	}
+
+	customerID, err := s.customerID(ctx, userID)
+	if err != nil && !errors.Is(err, ErrNoBillingAccount) {
+		return "", err
+	}
+
+	metadata := map[string]string{
+		"user_id": userID.String(),
+		"plan_id": planID,
+	}
+	params := &stripe.CheckoutSessionParams{
+		Mode:              stripe.String(string(stripe.CheckoutSessionModeSubscription)),
+		ClientReferenceID: stripe.String(userID.String()),
+		SuccessURL:        stripe.String(s.cfg.SuccessURL),
+		CancelURL:         stripe.String(s.cfg.CancelURL),
+		LineItems: []*stripe.CheckoutSessionLineItemParams{
+			{Price: stripe.String(priceID), Quantity: stripe.Int64(1)},
+		},
+		Metadata: metadata,
+		SubscriptionData: &stripe.CheckoutSessionSubscriptionDataParams{
+			Metadata: metadata,
+		},
+	}
+	if customerID != "" {
+		params.Customer = stripe.String(customerID)
+	} else {
+		params.CustomerEmail = stripe.String(u.Email)
+	}
+	params.Context = ctx
+
+	sess, err := s.api.CheckoutSessions.New(params)
+	if err != nil {
+		return "", fmt.Errorf("create checkout session: %w", err)
+	}
+	return sess.URL, nil
+}
+
+// CreatePortalSession opens a Stripe customer portal session for the user and
+// returns its URL.
+func (s *StripeService) CreatePortalSession(ctx context.Context, userID uuid.UUID) (string, error) {
+	if s.api == nil {
+		return "", ErrBillingNotConfigured
+	}
+
+	customerID, err := s.customerID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	params := &stripe.BillingPortalSessionParams{
+		Customer:  stripe.String(customerID),
+		ReturnURL: stripe.String(s.cfg.PortalReturnURL),
+	}
+	params.Context = ctx
+
+	sess, err := s.api.BillingPortalSessions.New(params)
+	if err != nil {
+		return "", fmt.Errorf("create portal session: %w", err)
+	}
+	return sess.URL, nil
+}
+
+// HandleWebhook verifies a Stripe webhook and applies it to the user's
+// subscription. Event types that are not billing related are ignored.
+func (s *StripeService) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	if s.api == nil || s.cfg.WebhookSecret == "" {
+		return ErrBillingNotConfigured
+	}
+
+	event, err := webhook.ConstructEventWithOptions(payload, signature, s.cfg.WebhookSecret,
+		webhook.ConstructEventOptions{IgnoreAPIVersionMismatch: true})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhookSignature, err)
+	}
+
+	ev := StripeEventRef{
+		ID:        event.ID,
+		Type:      string(event.Type),
+		CreatedAt: time.Unix(event.Created, 0).UTC(),
+	}
+
+	switch event.Type {
+	case "checkout.session.completed":
+		var sess stripe.CheckoutSession
+		if err := json.Unmarshal(event.Data.Raw, &sess); err != nil {
+			return fmt.Errorf("%w: %v", errUnexpectedWebhookPayload, err)
+		}
+		return s.handleCheckoutCompleted(ctx, ev, &sess)
+
+	case "customer.subscription.updated", "customer.subscription.deleted":
+		var sub stripe.Subscription
+		if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
+			return fmt.Errorf("%w: %v", errUnexpectedWebhookPayload, err)
+		}
+		return s.subscriptionService.SyncStripeSubscription(ctx, ev, s.subscriptionState(&sub))
+
+	case "invoice.payment_failed", "invoice.payment_succeeded":
+		var inv stripe.Invoice
+		if err := json.Unmarshal(event.Data.Raw, &inv); err != nil {
+			return fmt.Errorf("%w: %v", errUnexpectedWebhookPayload, err)
+		}
+		if inv.Subscription == nil {
+			return nil // one-off invoice
+		}
+		paid := event.Type == "invoice.payment_succeeded"
+		return s.subscriptionService.SetStripePaymentResult(ctx, ev, inv.Subscription.ID, paid)
+
+	default:
+		slog.Debug("ignoring stripe event", "event_id", event.ID, "type", event.Type)
+		return nil
+	}
+}
+
+// handleCheckoutCompleted activates the subscription a Checkout session
+// created. The event only carries the subscription ID, so its state is
+// fetched from Stripe.
+func (s *StripeService) handleCheckoutCompleted(ctx context.Context, ev StripeEventRef, sess *stripe.CheckoutSession) error {
+	if sess.Mode != stripe.CheckoutSessionModeSubscription || sess.Subscription == nil {
+		return nil
+	}
+
+	params := &stripe.SubscriptionParams{}
+	params.Context = ctx
+	sub, err := s.api.Subscriptions.Get(sess.Subscription.ID, params)
+	if err != nil {
+		return fmt.Errorf("get stripe subscription: %w", err)
+	}
+
+	state := s.subscriptionState(sub)
+	if state.UserID == uuid.Nil {
+		state.UserID, _ = uuid.Parse(sess.ClientReferenceID)
+	}
+	if state.PlanID == "" {
+		state.PlanID = sess.Metadata["plan_id"]
+	}
+	if state.StripeCustomerID == "" && sess.Customer != nil {
+		state.StripeCustomerID = sess.Customer.ID
+	}
+
+	return s.subscriptionService.SyncStripeSubscription(ctx, ev, state)
+}
+
+// subscriptionState converts a Stripe subscription. The plan is resolved from
+// the subscribed price, so plan changes made in the portal are picked up, and
+// falls back to the checkout metadata.
+func (s *StripeService) subscriptionState(sub *stripe.Subscription) StripeSubscriptionState {
+	state := StripeSubscriptionState{
+		StripeSubscriptionID: sub.ID,
+		Status:               subscriptionStatus(sub.Status),
+		CurrentPeriodStart:   time.Unix(sub.CurrentPeriodStart, 0).UTC(),
+		CurrentPeriodEnd:     time.Unix(sub.CurrentPeriodEnd, 0).UTC(),
+		CancelAtPeriodEnd:    sub.CancelAtPeriodEnd,
+		PlanID:               sub.Metadata["plan_id"],
+	}
+	state.UserID, _ = uuid.Parse(sub.Metadata["user_id"])
+	if sub.Customer != nil {
+		state.StripeCustomerID = sub.Customer.ID
+	}
+
+	if sub.Items != nil {
+		for _, item := range sub.Items.Data {
+			if item.Price == nil {
+				continue
+			}
+			if planID := s.planForPrice(item.Price.ID); planID != "" {
+				state.PlanID = planID
+				break
+			}
+		}
+	}
+	return state
+}
+
+// planForPrice returns the plan sold at a Stripe price, or "" if none is.
+func (s *StripeService) planForPrice(priceID string) string {
+	for planID, id := range s.cfg.PriceIDs {
+		if id == priceID {
+			return planID
+		}
+	}
+	return ""
+}
+
+// customerID returns the Stripe customer of a user from their subscriptions.
+func (s *StripeService) customerID(ctx context.Context, userID uuid.UUID) (string, error) {
+	sub, err := s.client.Subscription.Query().
+		Where(
+			subscription.HasUserWith(user.IDEQ(userID)),
+			subscription.StripeCustomerIDNotNil(),
+		).
+		Order(ent.Desc(subscription.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", ErrNoBillingAccount
+	}
+	if err != nil {
+		return "", fmt.Errorf("get stripe customer: %w", err)
+	}
+	return *sub.StripeCustomerID, nil
+}
+
+// subscriptionStatus maps a Stripe subscription status to ours. Subscriptions
+// that never got paid or were paused give no access.
+func subscriptionStatus(status stripe.SubscriptionStatus) subscription.Status {
+	switch status {
+	case stripe.SubscriptionStatusActive:
+		return subscription.StatusActive
+	case stripe.SubscriptionStatusTrialing:
+		return subscription.StatusTrialing
+	case stripe.SubscriptionStatusPastDue, stripe.SubscriptionStatusUnpaid:
+		return subscription.StatusPastDue
+	default:
+		return subscription.StatusCanceled
+	}
+}
@@ -0,0 +1,304 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/subscription"
+	"github.com/mindhit/api/internal/service"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+const testWebhookSecret = "whsec_test"
+
+type stripeTestEnv struct {
+	client              *ent.Client
+	fake                *testutil.FakeStripe
+	stripeService       *service.StripeService
+	subscriptionService *service.SubscriptionService
+	authService         *service.AuthService
+	plan                *ent.Plan
+	priceID             string
+}
+
+func setupStripeServiceTest(t *testing.T) *stripeTestEnv {
+	t.Helper()
+	client := testutil.SetupTestDB(t)
+	fake := testutil.NewFakeStripe(t)
+
+	p, err := client.Plan.Create().
+		SetID("paid-" + uuid.New().String()[:8]).
+		SetName("Paid").
+		SetPriceCents(1200).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	priceID := "price_" + p.ID
+	subscriptionService := service.NewSubscriptionService(client)
+	stripeService := service.NewStripeService(client, subscriptionService, service.StripeConfig{
+		SecretKey:       "sk_test_fake",
+		WebhookSecret:   testWebhookSecret,
+		PriceIDs:        map[string]string{p.ID: priceID},
+		SuccessURL:      "http://localhost:3000/account?checkout=success",
+		CancelURL:       "http://localhost:3000/account?checkout=canceled",
+		PortalReturnURL: "http://localhost:3000/account",
+		APIBaseURL:      fake.URL,
+	})
+
+	return &stripeTestEnv{
+		client:              client,
+		fake:                fake,
+		stripeService:       stripeService,
+		subscriptionService: subscriptionService,
+		authService:         service.NewAuthService(client),
+		plan:                p,
+		priceID:             priceID,
+	}
+}
+
+// deliver signs and sends a webhook event to the service.
+func (e *stripeTestEnv) deliver(t *testing.T, eventType string, created time.Time, object map[string]any) error {
+	t.Helper()
+	eventID := "evt_" + uuid.New().String()[:12]
+	payload, signature := testutil.SignedStripeEvent(t, testWebhookSecret, eventID, eventType, created, object)
+	return e.stripeService.HandleWebhook(context.Background(), payload, signature)
+}
+
+// checkout completes a Checkout for the user and returns the Stripe
+// subscription ID.
+func (e *stripeTestEnv) checkout(t *testing.T, userID uuid.UUID) string {
+	t.Helper()
+	suffix := uuid.New().String()[:8]
+	subID := "sub_" + suffix
+	metadata := map[string]string{"user_id": userID.String(), "plan_id": e.plan.ID}
+	e.fake.SetSubscription(testutil.StripeSubscription(subID, "cus_"+suffix, e.priceID, "active", metadata))
+
+	err := e.deliver(t, "checkout.session.completed", time.Now(), map[string]any{
+		"id":                  "cs_" + suffix,
+		"object":              "checkout.session",
+		"mode":                "subscription",
+		"client_reference_id": userID.String(),
+		"customer":            "cus_" + suffix,
+		"subscription":        subID,
+		"metadata":            metadata,
+	})
+	require.NoError(t, err)
+	return subID
+}
+
+func getStripeSubscription(t *testing.T, client *ent.Client, stripeSubscriptionID string) *ent.Subscription {
+	t.Helper()
+	sub, err := client.Subscription.Query().
+		Where(subscription.StripeSubscriptionIDEQ(stripeSubscriptionID)).
+		WithPlan().
+		Only(context.Background())
+	require.NoError(t, err)
+	return sub
+}
+
+// ==================== Checkout / Portal Tests ====================
+
+func TestStripeService_CreateCheckoutSession(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-checkout"))
+
+	url, err := env.stripeService.CreateCheckoutSession(context.Background(), user.ID, env.plan.ID)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, url)
+	requests := env.fake.CheckoutRequests()
+	require.Len(t, requests, 1)
+	form := requests[0]
+	assert.Equal(t, "subscription", form.Get("mode"))
+	assert.Equal(t, env.priceID, form.Get("line_items[0][price]"))
+	assert.Equal(t, user.ID.String(), form.Get("client_reference_id"))
+	assert.Equal(t, user.Email, form.Get("customer_email"))
+	assert.Equal(t, env.plan.ID, form.Get("subscription_data[metadata][plan_id]"))
+	assert.Equal(t, user.ID.String(), form.Get("subscription_data[metadata][user_id]"))
+}
+
+func TestStripeService_CreateCheckoutSession_UnknownPlan(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-checkout-free"))
+
+	_, err := env.stripeService.CreateCheckoutSession(context.Background(), user.ID, service.FreePlanID)
+
+	assert.ErrorIs(t, err, service.ErrPlanNotPurchasable)
+	assert.Empty(t, env.fake.CheckoutRequests())
+}
+
+func TestStripeService_CreateCheckoutSession_AlreadySubscribed(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-checkout-twice"))
+	env.checkout(t, user.ID)
+
+	_, err := env.stripeService.CreateCheckoutSession(context.Background(), user.ID, env.plan.ID)
+
+	assert.ErrorIs(t, err, service.ErrAlreadySubscribed)
+}
+
+func TestStripeService_CreateCheckoutSession_NotConfigured(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	stripeService := service.NewStripeService(client, service.NewSubscriptionService(client), service.StripeConfig{})
+
+	_, err := stripeService.CreateCheckoutSession(context.Background(), uuid.New(), "pro")
+
+	assert.ErrorIs(t, err, service.ErrBillingNotConfigured)
+}
+
+func TestStripeService_CreatePortalSession(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	ctx := context.Background()
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-portal"))
+
+	_, err := env.stripeService.CreatePortalSession(ctx, user.ID)
+	require.ErrorIs(t, err, service.ErrNoBillingAccount)
+
+	subID := env.checkout(t, user.ID)
+	url, err := env.stripeService.CreatePortalSession(ctx, user.ID)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, url)
+	requests := env.fake.PortalRequests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, *getStripeSubscription(t, env.client, subID).StripeCustomerID, requests[0].Get("customer"))
+}
+
+// ==================== Webhook Tests ====================
+
+func TestStripeService_HandleWebhook_InvalidSignature(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	payload, signature := testutil.SignedStripeEvent(t, "whsec_other", "evt_bad", "invoice.payment_failed", time.Now(),
+		map[string]any{"id": "in_bad", "object": "invoice", "subscription": "sub_bad"})
+
+	err := env.stripeService.HandleWebhook(context.Background(), payload, signature)
+
+	assert.ErrorIs(t, err, service.ErrInvalidWebhookSignature)
+}
+
+func TestStripeService_HandleWebhook_CheckoutCompleted(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	ctx := context.Background()
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-completed"))
+	_, err := testutil.EnsureFreePlan(t, env.client)
+	require.NoError(t, err)
+	_, err = env.subscriptionService.CreateFreeSubscription(ctx, user.ID)
+	require.NoError(t, err)
+
+	subID := env.checkout(t, user.ID)
+
+	current, err := env.subscriptionService.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, subID, *current.StripeSubscriptionID)
+	assert.Equal(t, env.plan.ID, current.Edges.Plan.ID)
+	assert.Equal(t, subscription.StatusActive, current.Status)
+
+	others, err := user.QuerySubscriptions().
+		Where(subscription.IDNEQ(current.ID)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, others, 1)
+	for _, other := range others {
+		assert.Equal(t, subscription.StatusCanceled, other.Status)
+	}
+}
+
+func TestStripeService_HandleWebhook_DuplicateEvent(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	ctx := context.Background()
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-duplicate"))
+	subID := env.checkout(t, user.ID)
+
+	payload, signature := testutil.SignedStripeEvent(t, testWebhookSecret, "evt_dup_"+subID, "invoice.payment_failed", time.Now(),
+		map[string]any{"id": "in_dup", "object": "invoice", "subscription": subID})
+	require.NoError(t, env.stripeService.HandleWebhook(ctx, payload, signature))
+	require.Equal(t, subscription.StatusPastDue, getStripeSubscription(t, env.client, subID).Status)
+
+	// Recover, then redeliver the failure: it must not apply again
+	err := env.deliver(t, "invoice.payment_succeeded", time.Now(),
+		map[string]any{"id": "in_ok", "object": "invoice", "subscription": subID})
+	require.NoError(t, err)
+	require.NoError(t, env.stripeService.HandleWebhook(ctx, payload, signature))
+
+	assert.Equal(t, subscription.StatusActive, getStripeSubscription(t, env.client, subID).Status)
+}
+
+func TestStripeService_HandleWebhook_SubscriptionUpdated(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-updated"))
+	subID := env.checkout(t, user.ID)
+
+	updated := testutil.StripeSubscription(subID, "cus_x", env.priceID, "active", nil)
+	updated["cancel_at_period_end"] = true
+	err := env.deliver(t, "customer.subscription.updated", time.Now().Add(time.Minute), updated)
+
+	require.NoError(t, err)
+	sub := getStripeSubscription(t, env.client, subID)
+	assert.True(t, sub.CancelAtPeriodEnd)
+	assert.Equal(t, env.plan.ID, sub.Edges.Plan.ID)
+}
+
+func TestStripeService_HandleWebhook_StaleEventIgnored(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-stale"))
+	subID := env.checkout(t, user.ID)
+
+	stale := testutil.StripeSubscription(subID, "cus_x", env.priceID, "past_due", nil)
+	err := env.deliver(t, "customer.subscription.updated", time.Now().Add(-time.Hour), stale)
+
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusActive, getStripeSubscription(t, env.client, subID).Status)
+}
+
+func TestStripeService_HandleWebhook_SubscriptionDeleted(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	ctx := context.Background()
+	user := createTestUser(t, env.authService, uniqueEmail("stripe-deleted"))
+	subID := env.checkout(t, user.ID)
+
+	deleted := testutil.StripeSubscription(subID, "cus_x", env.priceID, "canceled", nil)
+	err := env.deliver(t, "customer.subscription.deleted", time.Now().Add(time.Minute), deleted)
+
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusCanceled, getStripeSubscription(t, env.client, subID).Status)
+	_, err = env.subscriptionService.GetSubscription(ctx, user.ID)
+	assert.ErrorIs(t, err, service.ErrSubscriptionNotFound)
+}
+
+func TestStripeService_HandleWebhook_UnknownSubscriptionIgnored(t *testing.T) {
+	env := setupStripeServiceTest(t)
+	defer testutil.CleanupTestDB(t, env.client)
+
+	err := env.deliver(t, "invoice.payment_failed", time.Now(),
+		map[string]any{"id": "in_unknown", "object": "invoice", "subscription": "sub_unknown"})
+
+	assert.NoError(t, err)
+}
@@ -4,6 +4,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,8 +16,17 @@ import (
 	"github.com/mindhit/api/ent/user"
 )
 
-// Free plan ID
-const freePlanID = "free"
+// FreePlanID is the plan of users without an active subscription.
+const FreePlanID = "free"
+
+// CurrentSubscriptionStatuses are the statuses in which a subscription gives
+// the user its plan. Past-due subscriptions keep access while Stripe retries
+// the payment.
+var CurrentSubscriptionStatuses = []subscription.Status{
+	subscription.StatusActive,
+	subscription.StatusTrialing,
+	subscription.StatusPastDue,
+}
 
 // SubscriptionService errors
 var (
@@ -32,16 +44,17 @@ func NewSubscriptionService(client *ent.Client) *SubscriptionService {
 	return &SubscriptionService{client: client}
 }
 
-// GetSubscription returns the active subscription for a user.
+// GetSubscription returns the current subscription for a user.
 func (s *SubscriptionService) GetSubscription(ctx context.Context, userID uuid.UUID) (*ent.Subscription, error) {
 	sub, err := s.client.Subscription.
 		Query().
 		Where(
-			subscription.StatusEQ(subscription.StatusActive),
+			subscription.StatusIn(CurrentSubscriptionStatuses...),
 			subscription.HasUserWith(user.IDEQ(userID)),
 		).
 		WithPlan().
-		Only(ctx)
+		Order(ent.Desc(subscription.FieldCreatedAt)).
+		First(ctx)
 
 	if ent.IsNotFound(err) {
 		return nil, ErrSubscriptionNotFound
@@ -57,7 +70,7 @@ func (s *SubscriptionService) GetAvailablePlans(ctx context.Context) ([]*ent.Pla
 // CreateFreeSubscription creates a free subscription for a new user.
 // This should be called during user registration.
 func (s *SubscriptionService) CreateFreeSubscription(ctx context.Context, userID uuid.UUID) (*ent.Subscription, error) {
-	freePlan, err := s.client.Plan.Get(ctx, freePlanID)
+	freePlan, err := s.client.Plan.Get(ctx, FreePlanID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, ErrPlanNotFound
@@ -87,7 +100,7 @@ func (s *SubscriptionService) GetUserPlan(ctx context.Context, userID uuid.UUID)
 	}
 
 	// No active subscription, return free plan
-	return s.client.Plan.Get(ctx, freePlanID)
+	return s.client.Plan.Get(ctx, FreePlanID)
 }
 
 // HasFeature checks if a user has access to a specific feature.
@@ -104,6 +117,199 @@ func (s *SubscriptionService) HasFeature(ctx context.Context, userID uuid.UUID,
 	return plan.Features[feature], nil
 }
 
+// StripeEventRef identifies the Stripe event a subscription change comes from.
+type StripeEventRef struct {
+	ID        string
+	Type      string
+	CreatedAt time.Time
+}
+
+// StripeSubscriptionState is the state of a subscription as reported by
+// Stripe.
+type StripeSubscriptionState struct {
+	StripeSubscriptionID string
+	StripeCustomerID     string
+	// UserID is taken from the checkout metadata. It is only needed the first
+	// time a Stripe subscription is seen.
+	UserID             uuid.UUID
+	PlanID             string // empty keeps the current plan
+	Status             subscription.Status
+	CurrentPeriodStart time.Time
+	CurrentPeriodEnd   time.Time
+	CancelAtPeriodEnd  bool
+}
+
+// SyncStripeSubscription applies a Stripe subscription state. A subscription
+// seen for the first time is created and replaces the user's other current
+// subscriptions.
+//
+// Each event is applied at most once, and events older than the last one
+// applied to the subscription are ignored.
+func (s *SubscriptionService) SyncStripeSubscription(ctx context.Context, ev StripeEventRef, state StripeSubscriptionState) error {
+	return s.applyStripeEvent(ctx, ev, func(tx *ent.Tx) error {
+		sub, err := tx.Subscription.Query().
+			Where(subscription.StripeSubscriptionIDEQ(state.StripeSubscriptionID)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("get subscription: %w", err)
+		}
+
+		if sub == nil {
+			if state.UserID == uuid.Nil {
+				slog.Warn("stripe subscription has no user, ignoring",
+					"stripe_subscription_id", state.StripeSubscriptionID,
+					"event_id", ev.ID,
+				)
+				return nil
+			}
+			if state.PlanID == "" {
+				return fmt.Errorf("stripe subscription %s: %w", state.StripeSubscriptionID, ErrPlanNotFound)
+			}
+
+			sub, err = tx.Subscription.Create().
+				SetUserID(state.UserID).
+				SetPlanID(state.PlanID).
+				SetStatus(state.Status).
+				SetCurrentPeriodStart(state.CurrentPeriodStart).
+				SetCurrentPeriodEnd(state.CurrentPeriodEnd).
+				SetCancelAtPeriodEnd(state.CancelAtPeriodEnd).
+				SetStripeSubscriptionID(state.StripeSubscriptionID).
+				SetStripeCustomerID(state.StripeCustomerID).
+				SetStripeEventAt(ev.CreatedAt).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create subscription: %w", err)
+			}
+		} else {
+			if isStaleStripeEvent(sub, ev) {
+				return nil
+			}
+
+			update := tx.Subscription.UpdateOne(sub).
+				SetStatus(state.Status).
+				SetCurrentPeriodStart(state.CurrentPeriodStart).
+				SetCurrentPeriodEnd(state.CurrentPeriodEnd).
+				SetCancelAtPeriodEnd(state.CancelAtPeriodEnd).
+				SetStripeEventAt(ev.CreatedAt)
+			if state.PlanID != "" {
+				update.SetPlanID(state.PlanID)
+			}
+			if state.StripeCustomerID != "" {
+				update.SetStripeCustomerID(state.StripeCustomerID)
+			}
+			if sub, err = update.Save(ctx); err != nil {
+				return fmt.Errorf("update subscription: %w", err)
+			}
+		}
+
+		if !slices.Contains(CurrentSubscriptionStatuses, sub.Status) {
+			return nil
+		}
+
+		// The Stripe subscription replaces whatever the user had before
+		userID, err := sub.QueryUser().OnlyID(ctx)
+		if err != nil {
+			return fmt.Errorf("get subscription user: %w", err)
+		}
+		err = tx.Subscription.Update().
+			Where(
+				subscription.IDNEQ(sub.ID),
+				subscription.StatusIn(CurrentSubscriptionStatuses...),
+				subscription.HasUserWith(user.IDEQ(userID)),
+			).
+			SetStatus(subscription.StatusCanceled).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("cancel replaced subscriptions: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetStripePaymentResult moves a Stripe subscription between active and
+// past_due after an invoice payment attempt. Canceled subscriptions are left
+// alone.
+func (s *SubscriptionService) SetStripePaymentResult(ctx context.Context, ev StripeEventRef, stripeSubscriptionID string, paid bool) error {
+	return s.applyStripeEvent(ctx, ev, func(tx *ent.Tx) error {
+		sub, err := tx.Subscription.Query().
+			Where(subscription.StripeSubscriptionIDEQ(stripeSubscriptionID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			slog.Warn("stripe subscription not found, ignoring payment result",
+				"stripe_subscription_id", stripeSubscriptionID,
+				"event_id", ev.ID,
+			)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get subscription: %w", err)
+		}
+		if isStaleStripeEvent(sub, ev) {
+			return nil
+		}
+
+		status := sub.Status
+		switch {
+		case paid && sub.Status == subscription.StatusPastDue:
+			status = subscription.StatusActive
+		case !paid && (sub.Status == subscription.StatusActive || sub.Status == subscription.StatusTrialing):
+			status = subscription.StatusPastDue
+		}
+
+		err = tx.Subscription.UpdateOne(sub).
+			SetStatus(status).
+			SetStripeEventAt(ev.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("update subscription: %w", err)
+		}
+		return nil
+	})
+}
+
+// applyStripeEvent records the event and runs apply in the same transaction.
+// An event that was already recorded is skipped, which makes webhook
+// redeliveries harmless.
+func (s *SubscriptionService) applyStripeEvent(ctx context.Context, ev StripeEventRef, apply func(tx *ent.Tx) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.StripeEvent.Create().
+		SetID(ev.ID).
+		SetType(ev.Type).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		slog.Debug("stripe event already processed, skipping", "event_id", ev.ID, "type", ev.Type)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("record stripe event: %w", err)
+	}
+
+	if err := apply(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// isStaleStripeEvent reports whether a newer Stripe event has already been
+// applied to the subscription. Stripe does not guarantee delivery order.
+func isStaleStripeEvent(sub *ent.Subscription, ev StripeEventRef) bool {
+	if sub.StripeEventAt == nil || !ev.CreatedAt.Before(*sub.StripeEventAt) {
+		return false
+	}
+	slog.Info("ignoring out-of-order stripe event",
+		"event_id", ev.ID,
+		"type", ev.Type,
+		"subscription_id", sub.ID,
+	)
+	return true
+}
+
 // SubscriptionInfo represents subscription details for API response.
 type SubscriptionInfo struct {
 	ID                 uuid.UUID `json:"id"`
@@ -132,7 +338,7 @@ func (s *SubscriptionService) GetSubscriptionInfo(ctx context.Context, userID uu
 	if err != nil {
 		if errors.Is(err, ErrSubscriptionNotFound) {
 			// Return virtual free subscription
-			plan, planErr := s.client.Plan.Get(ctx, freePlanID)
+			plan, planErr := s.client.Plan.Get(ctx, FreePlanID)
 			if planErr != nil {
 				return nil, planErr
 			}
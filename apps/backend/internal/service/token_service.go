@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/refreshtoken"
+)
+
+// TokenService errors
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected")
+)
+
+// TokenService issues persisted refresh tokens and handles rotation and
+// revocation. Every refresh replaces the presented token; presenting a
+// replaced token again means it leaked, so its whole family is revoked.
+type TokenService struct {
+	client      *ent.Client
+	jwtService  *JWTService
+	revocations TokenRevocationList
+}
+
+// NewTokenService creates a new TokenService instance. revocations may be nil,
+// in which case revoked access tokens stay valid until they expire.
+func NewTokenService(client *ent.Client, jwtService *JWTService, revocations TokenRevocationList) *TokenService {
+	return &TokenService{
+		client:      client,
+		jwtService:  jwtService,
+		revocations: revocations,
+	}
+}
+
+// Issue creates a token pair for a new login.
+func (s *TokenService) Issue(ctx context.Context, userID uuid.UUID) (*TokenPair, error) {
+	pair, err := s.jwtService.GenerateTokenPair(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.saveRefreshToken(ctx, s.client.RefreshToken, userID, pair); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// Refresh exchanges a refresh token for a new token pair in the same family.
+func (s *TokenService) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	claims, err := s.jwtService.ValidateRefreshToken(refreshToken)
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+	tokenID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stored, err := tx.RefreshToken.Get(ctx, tokenID)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get refresh token: %w", err)
+	}
+	if stored.RevokedAt != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// Only one exchange of a token can win, even when two race
+	n, err := tx.RefreshToken.Update().
+		Where(
+			refreshtoken.IDEQ(tokenID),
+			refreshtoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("mark refresh token used: %w", err)
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		slog.WarnContext(ctx, "refresh token reused, revoking family",
+			"user_id", stored.UserID,
+			"family_id", stored.FamilyID,
+		)
+		if err := s.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	pair, err := s.jwtService.GenerateTokenPairInFamily(stored.UserID, stored.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.saveRefreshToken(ctx, tx.RefreshToken, stored.UserID, pair); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	return pair, nil
+}
+
+// RevokeFamily revokes a login: its refresh tokens and, through the
+// revocation list, its access tokens.
+func (s *TokenService) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	// Tokens issued before families existed carry none
+	if familyID == uuid.Nil {
+		return nil
+	}
+
+	err := s.client.RefreshToken.Update().
+		Where(
+			refreshtoken.FamilyIDEQ(familyID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("revoke refresh token family: %w", err)
+	}
+
+	if s.revocations != nil {
+		if err := s.revocations.RevokeFamily(ctx, familyID, s.jwtService.AccessTokenTTL()); err != nil {
+			slog.ErrorContext(ctx, "failed to cache family revocation", "error", err, "family_id", familyID)
+		}
+	}
+	return nil
+}
+
+// RevokeAll revokes every login of a user.
+func (s *TokenService) RevokeAll(ctx context.Context, userID uuid.UUID) error {
+	now := time.Now()
+	err := s.client.RefreshToken.Update().
+		Where(
+			refreshtoken.UserIDEQ(userID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("revoke refresh tokens: %w", err)
+	}
+
+	if s.revocations != nil {
+		if err := s.revocations.RevokeUser(ctx, userID, now, s.jwtService.AccessTokenTTL()); err != nil {
+			slog.ErrorContext(ctx, "failed to cache user revocation", "error", err, "user_id", userID)
+		}
+	}
+	return nil
+}
+
+func (s *TokenService) saveRefreshToken(ctx context.Context, client *ent.RefreshTokenClient, userID uuid.UUID, pair *TokenPair) error {
+	err := client.Create().
+		SetID(pair.RefreshTokenID).
+		SetFamilyID(pair.FamilyID).
+		SetUserID(userID).
+		SetExpiresAt(pair.RefreshExpiresAt).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("save refresh token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/service"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+func setupTokenServiceTest(t *testing.T) (*ent.Client, *service.TokenService, *service.JWTService, *fakeRevocationList, *service.AuthService) {
+	t.Helper()
+	client := testutil.SetupTestDB(t)
+	jwtService := service.NewJWTService("test-secret-key")
+	list := &fakeRevocationList{families: map[uuid.UUID]bool{}, users: map[uuid.UUID]time.Time{}}
+	jwtService.SetRevocationList(list)
+	return client, service.NewTokenService(client, jwtService, list), jwtService, list, service.NewAuthService(client)
+}
+
+func TestTokenService_Refresh_Rotates(t *testing.T) {
+	client, tokenService, _, _, authService := setupTokenServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("token-rotate"))
+	issued, err := tokenService.Issue(ctx, user.ID)
+	require.NoError(t, err)
+
+	rotated, err := tokenService.Refresh(ctx, issued.RefreshToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, issued.FamilyID, rotated.FamilyID)
+	assert.NotEqual(t, issued.RefreshTokenID, rotated.RefreshTokenID)
+
+	stored, err := client.RefreshToken.Get(ctx, issued.RefreshTokenID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.UsedAt)
+}
+
+func TestTokenService_Refresh_ReuseRevokesFamily(t *testing.T) {
+	client, tokenService, jwtService, _, authService := setupTokenServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("token-reuse"))
+	issued, err := tokenService.Issue(ctx, user.ID)
+	require.NoError(t, err)
+	other, err := tokenService.Issue(ctx, user.ID)
+	require.NoError(t, err)
+
+	rotated, err := tokenService.Refresh(ctx, issued.RefreshToken)
+	require.NoError(t, err)
+
+	_, err = tokenService.Refresh(ctx, issued.RefreshToken)
+	assert.ErrorIs(t, err, service.ErrRefreshTokenReused)
+
+	_, err = tokenService.Refresh(ctx, rotated.RefreshToken)
+	assert.ErrorIs(t, err, service.ErrInvalidRefreshToken)
+	_, err = jwtService.ValidateAccessToken(rotated.AccessToken)
+	assert.ErrorIs(t, err, service.ErrTokenRevoked)
+
+	// Other logins are untouched
+	_, err = tokenService.Refresh(ctx, other.RefreshToken)
+	assert.NoError(t, err)
+}
+
+func TestTokenService_RevokeAll(t *testing.T) {
+	client, tokenService, jwtService, _, authService := setupTokenServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	user := createTestUser(t, authService, uniqueEmail("token-revoke-all"))
+	first, err := tokenService.Issue(ctx, user.ID)
+	require.NoError(t, err)
+	second, err := tokenService.Issue(ctx, user.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, tokenService.RevokeAll(ctx, user.ID))
+
+	for _, pair := range []*service.TokenPair{first, second} {
+		_, err = tokenService.Refresh(ctx, pair.RefreshToken)
+		assert.ErrorIs(t, err, service.ErrInvalidRefreshToken)
+		_, err = jwtService.ValidateAccessToken(pair.AccessToken)
+		assert.ErrorIs(t, err, service.ErrTokenRevoked)
+	}
+}
@@ -7,13 +7,20 @@ import (
 	"encoding/hex"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/mindhit/api/ent"
 	enturl "github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
 )
 
+// SummaryRetryAfter is how long a content whose summary failed is left out
+// of summary backfills.
+const SummaryRetryAfter = 7 * 24 * time.Hour
+
 // URLService handles URL-related business logic.
 type URLService struct {
 	client *ent.Client
@@ -24,13 +31,8 @@ func NewURLService(client *ent.Client) *URLService {
 	return &URLService{client: client}
 }
 
-// GetOrCreate retrieves an existing URL or creates a new one.
-func (s *URLService) GetOrCreate(
-	ctx context.Context,
-	rawURL string,
-	title string,
-	content string,
-) (*ent.URL, error) {
+// GetOrCreate retrieves the canonical URL record or creates a new one.
+func (s *URLService) GetOrCreate(ctx context.Context, rawURL string) (*ent.URL, error) {
 	// Normalize URL
 	normalizedURL := normalizeURL(rawURL)
 	urlHash := hashURL(normalizedURL)
@@ -42,13 +44,6 @@ func (s *URLService) GetOrCreate(
 		Only(ctx)
 
 	if err == nil {
-		// Update content if provided and not already set
-		if content != "" && existing.Content == "" {
-			return s.client.URL.
-				UpdateOne(existing).
-				SetContent(content).
-				Save(ctx)
-		}
 		return existing, nil
 	}
 
@@ -57,19 +52,81 @@ func (s *URLService) GetOrCreate(
 	}
 
 	// Create new URL
-	create := s.client.URL.
+	created, err := s.client.URL.
 		Create().
 		SetURL(normalizedURL).
-		SetURLHash(urlHash)
+		SetURLHash(urlHash).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Created concurrently by another request
+		return s.GetByHash(ctx, urlHash)
+	}
+	return created, err
+}
 
-	if title != "" {
-		create.SetTitle(title)
+// SaveContent stores what a user captured for a URL in that user's own copy,
+// creating it on first capture. Title and content are only filled in when
+// not already set, so a later partial capture never overwrites a full one.
+func (s *URLService) SaveContent(
+	ctx context.Context,
+	userID uuid.UUID,
+	urlID uuid.UUID,
+	title string,
+	content string,
+) (*ent.URLContent, error) {
+	existing, err := s.GetContent(ctx, userID, urlID)
+	if ent.IsNotFound(err) {
+		create := s.client.URLContent.
+			Create().
+			SetUserID(userID).
+			SetURLID(urlID)
+
+		if title != "" {
+			create.SetTitle(title)
+		}
+		if content != "" {
+			create.SetContent(content)
+		}
+
+		created, err := create.Save(ctx)
+		if !ent.IsConstraintError(err) {
+			return created, err
+		}
+		// Created concurrently by another batch; fill it in below
+		existing, err = s.GetContent(ctx, userID, urlID)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	update := s.client.URLContent.UpdateOne(existing)
+	changed := false
+	if title != "" && existing.Title == "" {
+		update.SetTitle(title)
+		changed = true
 	}
-	if content != "" {
-		create.SetContent(content)
+	if content != "" && existing.Content == "" {
+		update.SetContent(content)
+		changed = true
+	}
+	if !changed {
+		return existing, nil
 	}
 
-	return create.Save(ctx)
+	return update.Save(ctx)
+}
+
+// GetContent retrieves a user's copy of a URL.
+func (s *URLService) GetContent(ctx context.Context, userID, urlID uuid.UUID) (*ent.URLContent, error) {
+	return s.client.URLContent.
+		Query().
+		Where(
+			urlcontent.HasUserWith(user.IDEQ(userID)),
+			urlcontent.HasURLWith(enturl.IDEQ(urlID)),
+		).
+		Only(ctx)
 }
 
 // GetByHash retrieves a URL by its hash.
@@ -80,30 +137,37 @@ func (s *URLService) GetByHash(ctx context.Context, urlHash string) (*ent.URL, e
 		Only(ctx)
 }
 
-// UpdateSummary updates the AI-generated summary for a URL.
+// UpdateSummary updates the AI-generated summary for a user's copy of a URL.
 func (s *URLService) UpdateSummary(
 	ctx context.Context,
-	urlID uuid.UUID,
+	contentID uuid.UUID,
 	summary string,
 	keywords []string,
-) (*ent.URL, error) {
-	return s.client.URL.
-		UpdateOneID(urlID).
+) (*ent.URLContent, error) {
+	return s.client.URLContent.
+		UpdateOneID(contentID).
 		SetSummary(summary).
 		SetKeywords(keywords).
 		Save(ctx)
 }
 
-// GetURLsWithoutSummary retrieves URLs that need summarization.
-// Only returns URLs with non-empty content and no summary.
-func (s *URLService) GetURLsWithoutSummary(ctx context.Context, limit int) ([]*ent.URL, error) {
-	return s.client.URL.
+// GetContentsWithoutSummary retrieves captured contents that need summarization.
+// Only returns contents that are non-empty and have no full-page summary yet
+// (the short summary from tag extraction does not count), oldest first.
+// Contents whose summary failed are retried once SummaryRetryAfter has passed.
+func (s *URLService) GetContentsWithoutSummary(ctx context.Context, limit int) ([]*ent.URLContent, error) {
+	return s.client.URLContent.
 		Query().
 		Where(
-			enturl.ContentNotNil(),
-			enturl.ContentNEQ(""),
-			enturl.SummaryIsNil(),
+			urlcontent.ContentNotNil(),
+			urlcontent.ContentNEQ(""),
+			urlcontent.SummarizedAtIsNil(),
+			urlcontent.Or(
+				urlcontent.SummaryFailedAtIsNil(),
+				urlcontent.SummaryFailedAtLT(time.Now().Add(-SummaryRetryAfter)),
+			),
 		).
+		Order(ent.Asc(urlcontent.FieldCreatedAt)).
 		Limit(limit).
 		All(ctx)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,18 @@ func setupURLServiceTest(t *testing.T) (*ent.Client, *service.URLService) {
 	return client, urlService
 }
 
+// createURLContent captures a unique URL for a fresh user.
+func createURLContent(t *testing.T, client *ent.Client, urlService *service.URLService, prefix, content string) *ent.URLContent {
+	t.Helper()
+	ctx := context.Background()
+	user := createTestUser(t, service.NewAuthService(client), uniqueEmail(prefix))
+	url, err := urlService.GetOrCreate(ctx, uniqueTestURL(prefix))
+	require.NoError(t, err)
+	c, err := urlService.SaveContent(ctx, user.ID, url.ID, "Test", content)
+	require.NoError(t, err)
+	return c
+}
+
 // uniqueTestURL generates a unique URL for URL service testing
 func uniqueTestURL(prefix string) string {
 	return fmt.Sprintf("https://example.com/%s-%s", prefix, uuid.New().String()[:8])
@@ -33,15 +46,12 @@ func TestURLService_GetOrCreate_CreateNew(t *testing.T) {
 
 	ctx := context.Background()
 	rawURL := uniqueTestURL("new")
-	title := "Test Page"
-	content := "Test content"
 
-	url, err := urlService.GetOrCreate(ctx, rawURL, title, content)
+	url, err := urlService.GetOrCreate(ctx, rawURL)
 
 	require.NoError(t, err)
 	assert.NotNil(t, url)
-	assert.Equal(t, title, url.Title)
-	assert.Equal(t, content, url.Content)
+	assert.Equal(t, rawURL, url.URL)
 	assert.NotEmpty(t, url.URLHash)
 }
 
@@ -51,134 +61,186 @@ func TestURLService_GetOrCreate_ReturnExisting(t *testing.T) {
 
 	ctx := context.Background()
 	rawURL := uniqueTestURL("existing")
-	title := "Test Page"
-	content := "Test content"
 
 	// Create first
-	url1, err := urlService.GetOrCreate(ctx, rawURL, title, content)
+	url1, err := urlService.GetOrCreate(ctx, rawURL)
 	require.NoError(t, err)
 
 	// Get same URL again
-	url2, err := urlService.GetOrCreate(ctx, rawURL, "Different Title", "Different content")
+	url2, err := urlService.GetOrCreate(ctx, rawURL)
 
 	require.NoError(t, err)
 	assert.Equal(t, url1.ID, url2.ID)
-	assert.Equal(t, title, url2.Title) // Original title preserved
 }
 
-func TestURLService_GetOrCreate_UpdateEmptyContent(t *testing.T) {
+func TestURLService_GetOrCreate_NormalizeURL(t *testing.T) {
 	client, urlService := setupURLServiceTest(t)
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
-	rawURL := uniqueTestURL("update-content")
-	title := "Test Page"
+	base := uuid.New().String()[:8]
 
-	// Create without content
-	url1, err := urlService.GetOrCreate(ctx, rawURL, title, "")
+	// Create with trailing slash
+	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s/", base))
 	require.NoError(t, err)
-	assert.Empty(t, url1.Content)
 
-	// Get again with content - should update
-	newContent := "New content"
-	url2, err := urlService.GetOrCreate(ctx, rawURL, title, newContent)
+	// Same URL without trailing slash should return same record
+	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s", base))
 
 	require.NoError(t, err)
 	assert.Equal(t, url1.ID, url2.ID)
-	assert.Equal(t, newContent, url2.Content)
 }
 
-func TestURLService_GetOrCreate_DontOverwriteExistingContent(t *testing.T) {
+func TestURLService_GetOrCreate_NormalizeFragment(t *testing.T) {
 	client, urlService := setupURLServiceTest(t)
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
-	rawURL := uniqueTestURL("keep-content")
-	title := "Test Page"
-	originalContent := "Original content"
+	base := uuid.New().String()[:8]
 
-	// Create with content
-	url1, err := urlService.GetOrCreate(ctx, rawURL, title, originalContent)
+	// Create without fragment
+	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s", base))
 	require.NoError(t, err)
 
-	// Get again with different content - should NOT update
-	url2, err := urlService.GetOrCreate(ctx, rawURL, title, "New content")
+	// Same URL with fragment should return same record
+	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s#section1", base))
 
 	require.NoError(t, err)
 	assert.Equal(t, url1.ID, url2.ID)
-	assert.Equal(t, originalContent, url2.Content) // Original content preserved
 }
 
-func TestURLService_GetOrCreate_NormalizeURL(t *testing.T) {
+func TestURLService_GetOrCreate_CaseInsensitiveHost(t *testing.T) {
 	client, urlService := setupURLServiceTest(t)
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
 	base := uuid.New().String()[:8]
 
-	// Create with trailing slash
-	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s/", base), "Title", "")
+	// Create with uppercase host
+	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://EXAMPLE.COM/page-%s", base))
 	require.NoError(t, err)
 
-	// Same URL without trailing slash should return same record
-	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s", base), "Title", "")
+	// Same URL with lowercase host should return same record
+	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s", base))
 
 	require.NoError(t, err)
 	assert.Equal(t, url1.ID, url2.ID)
 }
 
-func TestURLService_GetOrCreate_NormalizeFragment(t *testing.T) {
+func TestURLService_GetOrCreate_EmptyPath(t *testing.T) {
 	client, urlService := setupURLServiceTest(t)
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
 	base := uuid.New().String()[:8]
 
-	// Create without fragment
-	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s", base), "Title", "")
+	// Create with empty path
+	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://%s.example.com", base))
 	require.NoError(t, err)
 
-	// Same URL with fragment should return same record
-	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s#section1", base), "Title", "")
+	// Same URL with explicit root path should return same record
+	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://%s.example.com/", base))
 
 	require.NoError(t, err)
 	assert.Equal(t, url1.ID, url2.ID)
 }
 
-func TestURLService_GetOrCreate_CaseInsensitiveHost(t *testing.T) {
+// ==================== SaveContent Tests ====================
+
+func TestURLService_SaveContent_CreateNew(t *testing.T) {
 	client, urlService := setupURLServiceTest(t)
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
-	base := uuid.New().String()[:8]
+	user := createTestUser(t, service.NewAuthService(client), uniqueEmail("save-content"))
+	url, err := urlService.GetOrCreate(ctx, uniqueTestURL("save-content"))
+	require.NoError(t, err)
+
+	content, err := urlService.SaveContent(ctx, user.ID, url.ID, "Test Page", "Test content")
 
-	// Create with uppercase host
-	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://EXAMPLE.COM/page-%s", base), "Title", "")
 	require.NoError(t, err)
+	assert.Equal(t, "Test Page", content.Title)
+	assert.Equal(t, "Test content", content.Content)
+}
 
-	// Same URL with lowercase host should return same record
-	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://example.com/page-%s", base), "Title", "")
+func TestURLService_SaveContent_UpdateEmptyContent(t *testing.T) {
+	client, urlService := setupURLServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
 
+	ctx := context.Background()
+	user := createTestUser(t, service.NewAuthService(client), uniqueEmail("update-content"))
+	url, err := urlService.GetOrCreate(ctx, uniqueTestURL("update-content"))
 	require.NoError(t, err)
-	assert.Equal(t, url1.ID, url2.ID)
+
+	// Create without content
+	first, err := urlService.SaveContent(ctx, user.ID, url.ID, "Test Page", "")
+	require.NoError(t, err)
+	assert.Empty(t, first.Content)
+
+	// Save again with content - should update
+	second, err := urlService.SaveContent(ctx, user.ID, url.ID, "Test Page", "New content")
+
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, "New content", second.Content)
 }
 
-func TestURLService_GetOrCreate_EmptyPath(t *testing.T) {
+func TestURLService_SaveContent_DontOverwriteExistingContent(t *testing.T) {
 	client, urlService := setupURLServiceTest(t)
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
-	base := uuid.New().String()[:8]
+	user := createTestUser(t, service.NewAuthService(client), uniqueEmail("keep-content"))
+	url, err := urlService.GetOrCreate(ctx, uniqueTestURL("keep-content"))
+	require.NoError(t, err)
 
-	// Create with empty path
-	url1, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://%s.example.com", base), "Title", "")
+	// Create with content
+	first, err := urlService.SaveContent(ctx, user.ID, url.ID, "Test Page", "Original content")
 	require.NoError(t, err)
 
-	// Same URL with explicit root path should return same record
-	url2, err := urlService.GetOrCreate(ctx, fmt.Sprintf("https://%s.example.com/", base), "Title", "")
+	// Save again with different content - should NOT update
+	second, err := urlService.SaveContent(ctx, user.ID, url.ID, "Different Title", "New content")
 
 	require.NoError(t, err)
-	assert.Equal(t, url1.ID, url2.ID)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, "Test Page", second.Title)          // Original title preserved
+	assert.Equal(t, "Original content", second.Content) // Original content preserved
+}
+
+func TestURLService_SaveContent_SeparatePerUser(t *testing.T) {
+	client, urlService := setupURLServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	authService := service.NewAuthService(client)
+	owner := createTestUser(t, authService, uniqueEmail("content-owner"))
+	other := createTestUser(t, authService, uniqueEmail("content-other"))
+	url, err := urlService.GetOrCreate(ctx, uniqueTestURL("shared"))
+	require.NoError(t, err)
+
+	ownerContent, err := urlService.SaveContent(ctx, owner.ID, url.ID, "Inbox", "Private content")
+	require.NoError(t, err)
+
+	// Another user visiting the same URL starts from an empty copy
+	otherContent, err := urlService.SaveContent(ctx, other.ID, url.ID, "", "")
+
+	require.NoError(t, err)
+	assert.NotEqual(t, ownerContent.ID, otherContent.ID)
+	assert.Empty(t, otherContent.Title)
+	assert.Empty(t, otherContent.Content)
+}
+
+func TestURLService_GetContent_NotFound(t *testing.T) {
+	client, urlService := setupURLServiceTest(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	url, err := urlService.GetOrCreate(ctx, uniqueTestURL("no-content"))
+	require.NoError(t, err)
+
+	_, err = urlService.GetContent(ctx, uuid.New(), url.ID)
+
+	assert.True(t, ent.IsNotFound(err))
 }
 
 // ==================== GetByHash Tests ====================
@@ -191,7 +253,7 @@ func TestURLService_GetByHash_Success(t *testing.T) {
 	rawURL := uniqueTestURL("hash-test")
 
 	// Create URL first
-	created, err := urlService.GetOrCreate(ctx, rawURL, "Test", "Content")
+	created, err := urlService.GetOrCreate(ctx, rawURL)
 	require.NoError(t, err)
 
 	// Get by hash
@@ -219,11 +281,9 @@ func TestURLService_UpdateSummary_Success(t *testing.T) {
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
-	rawURL := uniqueTestURL("summary")
 
-	// Create URL first
-	created, err := urlService.GetOrCreate(ctx, rawURL, "Test", "Content")
-	require.NoError(t, err)
+	// Capture a page first
+	created := createURLContent(t, client, urlService, "summary", "Content")
 	assert.Empty(t, created.Summary)
 	assert.Nil(t, created.Keywords)
 
@@ -243,14 +303,12 @@ func TestURLService_UpdateSummary_OverwriteExisting(t *testing.T) {
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
-	rawURL := uniqueTestURL("overwrite-summary")
 
-	// Create URL first
-	created, err := urlService.GetOrCreate(ctx, rawURL, "Test", "Content")
-	require.NoError(t, err)
+	// Capture a page first
+	created := createURLContent(t, client, urlService, "overwrite-summary", "Content")
 
 	// Set initial summary
-	_, err = urlService.UpdateSummary(ctx, created.ID, "First summary", []string{"first"})
+	_, err := urlService.UpdateSummary(ctx, created.ID, "First summary", []string{"first"})
 	require.NoError(t, err)
 
 	// Update with new summary
@@ -276,109 +334,59 @@ func TestURLService_UpdateSummary_NotFound(t *testing.T) {
 	assert.Error(t, err)
 }
 
-// ==================== GetURLsWithoutSummary Tests ====================
+// ==================== GetContentsWithoutSummary Tests ====================
 
-func TestURLService_GetURLsWithoutSummary_Success(t *testing.T) {
+func TestURLService_GetContentsWithoutSummary_LimitWorks(t *testing.T) {
 	client, urlService := setupURLServiceTest(t)
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
 
-	// Create URL with content but no summary
-	url1, err := urlService.GetOrCreate(ctx, uniqueTestURL("no-summary-1"), "Test 1", "Content 1")
-	require.NoError(t, err)
-	_ = url1
-
-	// Create URL with content and summary
-	url2, err := urlService.GetOrCreate(ctx, uniqueTestURL("with-summary"), "Test 2", "Content 2")
-	require.NoError(t, err)
-	_, err = urlService.UpdateSummary(ctx, url2.ID, "Summary", []string{"keyword"})
-	require.NoError(t, err)
-
-	// Create URL with content but no summary
-	url3, err := urlService.GetOrCreate(ctx, uniqueTestURL("no-summary-2"), "Test 3", "Content 3")
-	require.NoError(t, err)
-	_ = url3
-
-	// Get URLs without summary
-	urls, err := urlService.GetURLsWithoutSummary(ctx, 10)
-
-	require.NoError(t, err)
-	assert.GreaterOrEqual(t, len(urls), 2) // At least url1 and url3
-}
-
-func TestURLService_GetURLsWithoutSummary_LimitWorks(t *testing.T) {
-	client, urlService := setupURLServiceTest(t)
-	defer testutil.CleanupTestDB(t, client)
-
-	ctx := context.Background()
-
-	// Create 5 URLs with content but no summary
+	// Capture 5 pages with content but no summary
 	for i := 0; i < 5; i++ {
-		_, err := urlService.GetOrCreate(ctx, uniqueTestURL(fmt.Sprintf("limit-test-%d", i)), "Test", "Content")
-		require.NoError(t, err)
+		createURLContent(t, client, urlService, fmt.Sprintf("limit-test-%d", i), "Content")
 	}
 
 	// Get with limit of 2
-	urls, err := urlService.GetURLsWithoutSummary(ctx, 2)
-
-	require.NoError(t, err)
-	assert.Len(t, urls, 2)
-}
-
-func TestURLService_GetURLsWithoutSummary_ExcludesEmptyContent(t *testing.T) {
-	client, urlService := setupURLServiceTest(t)
-	defer testutil.CleanupTestDB(t, client)
-
-	ctx := context.Background()
-
-	// Create URL without content (should not be returned)
-	urlWithoutContent, err := urlService.GetOrCreate(ctx, uniqueTestURL("empty-content"), "Test", "")
-	require.NoError(t, err)
+	contents, err := urlService.GetContentsWithoutSummary(ctx, 2)
 
-	// Create URL with content but no summary (should be returned)
-	urlWithContent, err := urlService.GetOrCreate(ctx, uniqueTestURL("has-content"), "Test", "Content")
 	require.NoError(t, err)
-
-	// Get all URLs without summary - use large limit to include our test URLs
-	urls, err := urlService.GetURLsWithoutSummary(ctx, 10000)
-
-	require.NoError(t, err)
-
-	// Check that URL with content is included and URL without content is excluded
-	foundWithContent := false
-	foundWithoutContent := false
-	for _, u := range urls {
-		if u.ID == urlWithContent.ID {
-			foundWithContent = true
-		}
-		if u.ID == urlWithoutContent.ID {
-			foundWithoutContent = true
-		}
-	}
-	assert.True(t, foundWithContent, "Expected URL with content to be in results")
-	assert.False(t, foundWithoutContent, "URL without content should not be in results")
+	assert.Len(t, contents, 2)
 }
 
-func TestURLService_GetURLsWithoutSummary_Empty(t *testing.T) {
+func TestURLService_GetContentsWithoutSummary_ExcludesEmptyAndSummarized(t *testing.T) {
 	client, urlService := setupURLServiceTest(t)
 	defer testutil.CleanupTestDB(t, client)
 
 	ctx := context.Background()
 
-	// Create URL with summary only
-	url, err := urlService.GetOrCreate(ctx, uniqueTestURL("summarized"), "Test", "Content")
-	require.NoError(t, err)
-	_, err = urlService.UpdateSummary(ctx, url.ID, "Summary", []string{"keyword"})
-	require.NoError(t, err)
-
-	// Get URLs without summary - may have other URLs from shared test DB
-	urls, err := urlService.GetURLsWithoutSummary(ctx, 10)
-
-	require.NoError(t, err)
-
-	// The summarized URL should not be in the results
-	for _, u := range urls {
-		assert.NotEqual(t, url.ID, u.ID, "Summarized URL should not appear in results")
+	withoutContent := createURLContent(t, client, urlService, "empty-content", "")
+	withContent := createURLContent(t, client, urlService, "has-content", "Content")
+	summarized := createURLContent(t, client, urlService, "summarized", "Content")
+	require.NoError(t, client.URLContent.UpdateOne(summarized).SetSummarizedAt(time.Now()).Exec(ctx))
+	failed := createURLContent(t, client, urlService, "failed", "Content")
+	require.NoError(t, client.URLContent.UpdateOne(failed).
+		SetSummaryError("ai summarize: timeout").
+		SetSummaryFailedAt(time.Now()).
+		Exec(ctx))
+	failedLongAgo := createURLContent(t, client, urlService, "failed-long-ago", "Content")
+	require.NoError(t, client.URLContent.UpdateOne(failedLongAgo).
+		SetSummaryError("ai summarize: timeout").
+		SetSummaryFailedAt(time.Now().Add(-service.SummaryRetryAfter-time.Hour)).
+		Exec(ctx))
+
+	// Use large limit to include our test contents
+	contents, err := urlService.GetContentsWithoutSummary(ctx, 10000)
+
+	require.NoError(t, err)
+
+	found := make(map[uuid.UUID]bool)
+	for _, c := range contents {
+		found[c.ID] = true
 	}
+	assert.True(t, found[withContent.ID], "Expected content to be in results")
+	assert.False(t, found[withoutContent.ID], "Empty content should not be in results")
+	assert.False(t, found[summarized.ID], "Summarized content should not be in results")
+	assert.False(t, found[failed.ID], "Recently failed content should not be in results")
+	assert.True(t, found[failedLongAgo.ID], "Failed content is retried after a while")
 }
@@ -3,6 +3,7 @@ package service
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,9 +17,14 @@ import (
 // Default token limit for free plan users without subscription
 const defaultFreeTokenLimit = 50000
 
+// usageWarningThresholds are the percentages of the token limit at which the
+// user is emailed.
+var usageWarningThresholds = []int{80, 100}
+
 // UsageService handles token usage tracking and limit checking.
 type UsageService struct {
-	client *ent.Client
+	client       *ent.Client
+	emailService *EmailService
 }
 
 // NewUsageService creates a new UsageService instance.
@@ -26,6 +32,12 @@ func NewUsageService(client *ent.Client) *UsageService {
 	return &UsageService{client: client}
 }
 
+// SetEmailService enables usage warning emails. Without it no warnings are
+// sent.
+func (s *UsageService) SetEmailService(emailService *EmailService) {
+	s.emailService = emailService
+}
+
 // UsageRecord represents a token usage record to be created.
 type UsageRecord struct {
 	UserID    uuid.UUID
@@ -73,8 +85,52 @@ func (s *UsageService) RecordUsage(ctx context.Context, record UsageRecord) erro
 		builder.SetAiModel(record.AIModel)
 	}
 
-	_, err := builder.Save(ctx)
-	return err
+	var before *LimitStatus
+	if s.emailService != nil {
+		before, _ = s.CheckLimit(ctx, record.UserID)
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		return err
+	}
+
+	if before != nil {
+		s.warnOnThreshold(ctx, record.UserID, before, record.Tokens, periodStart)
+	}
+	return nil
+}
+
+// warnOnThreshold emails the user when recording tokens pushed their usage
+// past a warning threshold. Failures are logged; they never fail the
+// recording.
+func (s *UsageService) warnOnThreshold(ctx context.Context, userID uuid.UUID, before *LimitStatus, tokens int, periodStart time.Time) {
+	threshold := crossedUsageThreshold(before, tokens)
+	if threshold == 0 {
+		return
+	}
+
+	after := *before
+	after.TokensUsed += tokens
+	if err := s.emailService.SendUsageWarning(ctx, userID, &after, periodStart, threshold); err != nil {
+		slog.ErrorContext(ctx, "failed to queue usage warning", "error", err, "user_id", userID, "threshold", threshold)
+	}
+}
+
+// crossedUsageThreshold returns the highest warning threshold that adding
+// tokens to the usage in before crosses, or 0 if none is crossed.
+func crossedUsageThreshold(before *LimitStatus, tokens int) int {
+	if before.IsUnlimited || before.TokenLimit <= 0 || tokens <= 0 {
+		return 0
+	}
+
+	crossed := 0
+	for _, threshold := range usageWarningThresholds {
+		limit := before.TokenLimit * threshold / 100
+		if before.TokensUsed < limit && before.TokensUsed+tokens >= limit {
+			crossed = threshold
+		}
+	}
+	return crossed
 }
 
 // CheckLimit checks if the user can use AI based on their token limits.
@@ -83,7 +139,7 @@ func (s *UsageService) CheckLimit(ctx context.Context, userID uuid.UUID) (*Limit
 	sub, _ := s.client.Subscription.
 		Query().
 		Where(
-			subscription.StatusEQ(subscription.StatusActive),
+			subscription.StatusIn(CurrentSubscriptionStatuses...),
 			subscription.HasUserWith(user.IDEQ(userID)),
 		).
 		WithPlan().
@@ -262,7 +318,7 @@ func (s *UsageService) calculatePeriodStartForDate(ctx context.Context, userID u
 	sub, err := s.client.Subscription.
 		Query().
 		Where(
-			subscription.StatusEQ(subscription.StatusActive),
+			subscription.StatusIn(CurrentSubscriptionStatuses...),
 			subscription.HasUserWith(user.IDEQ(userID)),
 		).
 		Only(ctx)
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossedUsageThreshold(t *testing.T) {
+	tests := []struct {
+		name   string
+		before LimitStatus
+		tokens int
+		want   int
+	}{
+		{"below first threshold", LimitStatus{TokensUsed: 1000, TokenLimit: 50000}, 1000, 0},
+		{"crosses 80 percent", LimitStatus{TokensUsed: 39000, TokenLimit: 50000}, 1000, 80},
+		{"already past 80 percent", LimitStatus{TokensUsed: 40000, TokenLimit: 50000}, 1000, 0},
+		{"crosses 100 percent", LimitStatus{TokensUsed: 49000, TokenLimit: 50000}, 2000, 100},
+		{"crosses both at once", LimitStatus{TokensUsed: 1000, TokenLimit: 50000}, 60000, 100},
+		{"already over limit", LimitStatus{TokensUsed: 50000, TokenLimit: 50000}, 1000, 0},
+		{"unlimited plan", LimitStatus{TokensUsed: 39000, IsUnlimited: true}, 100000, 0},
+		{"no tokens", LimitStatus{TokensUsed: 39999, TokenLimit: 50000}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, crossedUsageThreshold(&tt.before, tt.tokens))
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package testutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stripe/stripe-go/v76/webhook"
+)
+
+// FakeStripe is a local stand-in for the parts of the Stripe API the billing
+// code calls. Point StripeConfig.APIBaseURL at URL.
+type FakeStripe struct {
+	URL string
+
+	mu               sync.Mutex
+	subscriptions    map[string]map[string]any
+	checkoutRequests []url.Values
+	portalRequests   []url.Values
+}
+
+// NewFakeStripe starts a fake Stripe API server that is closed when the test
+// ends.
+func NewFakeStripe(t *testing.T) *FakeStripe {
+	t.Helper()
+
+	f := &FakeStripe{subscriptions: make(map[string]map[string]any)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/checkout/sessions", func(w http.ResponseWriter, r *http.Request) {
+		form := f.record(r, &f.checkoutRequests)
+		writeStripeJSON(w, http.StatusOK, map[string]any{
+			"id":     "cs_test_" + form.Get("client_reference_id"),
+			"object": "checkout.session",
+			"mode":   form.Get("mode"),
+			"url":    "https://checkout.stripe.test/c/pay/cs_test",
+		})
+	})
+	mux.HandleFunc("POST /v1/billing_portal/sessions", func(w http.ResponseWriter, r *http.Request) {
+		form := f.record(r, &f.portalRequests)
+		writeStripeJSON(w, http.StatusOK, map[string]any{
+			"id":         "bps_test",
+			"object":     "billing_portal.session",
+			"customer":   form.Get("customer"),
+			"return_url": form.Get("return_url"),
+			"url":        "https://billing.stripe.test/p/session/bps_test",
+		})
+	})
+	mux.HandleFunc("GET /v1/subscriptions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		sub, ok := f.subscriptions[r.PathValue("id")]
+		f.mu.Unlock()
+		if !ok {
+			writeStripeJSON(w, http.StatusNotFound, map[string]any{
+				"error": map[string]any{
+					"type":    "invalid_request_error",
+					"message": "No such subscription: '" + r.PathValue("id") + "'",
+				},
+			})
+			return
+		}
+		writeStripeJSON(w, http.StatusOK, sub)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	f.URL = server.URL
+	return f
+}
+
+// SetSubscription makes GET /v1/subscriptions/{id} return sub.
+func (f *FakeStripe) SetSubscription(sub map[string]any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.subscriptions[sub["id"].(string)] = sub
+}
+
+// CheckoutRequests returns the form bodies of the Checkout sessions created.
+func (f *FakeStripe) CheckoutRequests() []url.Values {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]url.Values(nil), f.checkoutRequests...)
+}
+
+// PortalRequests returns the form bodies of the portal sessions created.
+func (f *FakeStripe) PortalRequests() []url.Values {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]url.Values(nil), f.portalRequests...)
+}
+
+func (f *FakeStripe) record(r *http.Request, into *[]url.Values) url.Values {
+	_ = r.ParseForm()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	*into = append(*into, r.PostForm)
+	return r.PostForm
+}
+
+func writeStripeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// StripeSubscription builds a Stripe subscription object as the API returns
+// it.
+func StripeSubscription(id, customerID, priceID, status string, metadata map[string]string) map[string]any {
+	now := time.Now()
+	return map[string]any{
+		"id":                   id,
+		"object":               "subscription",
+		"customer":             customerID,
+		"status":               status,
+		"cancel_at_period_end": false,
+		"current_period_start": now.Unix(),
+		"current_period_end":   now.AddDate(0, 1, 0).Unix(),
+		"metadata":             metadata,
+		"items": map[string]any{
+			"object": "list",
+			"data": []any{
+				map[string]any{
+					"id":     "si_" + strings.TrimPrefix(id, "sub_"),
+					"object": "subscription_item",
+					"price":  map[string]any{"id": priceID, "object": "price"},
+				},
+			},
+		},
+	}
+}
+
+// SignedStripeEvent builds a webhook event around object and signs it with
+// secret. It returns the payload and the Stripe-Signature header value.
+func SignedStripeEvent(t *testing.T, secret, eventID, eventType string, created time.Time, object map[string]any) ([]byte, string) {
+	t.Helper()
+
+	payload, err := json.Marshal(map[string]any{
+		"id":      eventID,
+		"object":  "event",
+		"type":    eventType,
+		"created": created.Unix(),
+		"data":    map[string]any{"object": object},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal stripe event: %v", err)
+	}
+
+	signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+		Payload:   payload,
+		Secret:    secret,
+		Timestamp: time.Now(),
+	})
+	return signed.Payload, signed.Header
+}
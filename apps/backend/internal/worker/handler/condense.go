@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+)
+
+const (
+	// promptReserveTokens is kept free for the template around the content
+	// of a prompt.
+	promptReserveTokens = 1024
+	// chunkTokens is the size of the parts long pages are summarized in.
+	chunkTokens = 4000
+	// maxCondenseRounds bounds how often part summaries that still don't fit
+	// are summarized again, before the rest is cut off.
+	maxCondenseRounds = 3
+)
+
+// contentBudget returns how many tokens of content a prompt for the task may
+// include: at most limit, and no more than fits the context window of the
+// task's models.
+func (h *handlers) contentBudget(ctx context.Context, task ai.TaskType, limit int) int {
+	budget, err := h.aiManager.InputBudget(ctx, task)
+	if err != nil {
+		slog.Warn("failed to get ai input budget", "task", task, "error", err)
+		return limit
+	}
+	return max(min(limit, budget-promptReserveTokens), promptReserveTokens)
+}
+
+// condenseContent returns the content of a page within maxTokens. Longer
+// content is split into parts that are summarized separately (map), and the
+// part summaries stand in for the content (reduce), so nothing of the page is
+// thrown away. Summaries that still don't fit are condensed again. metadata
+// is added to the requests of the part summaries.
+func (h *handlers) condenseContent(ctx context.Context, c *ent.URLContent, maxTokens int, metadata map[string]string) (string, error) {
+	content := c.Content
+	chunkSize := min(chunkTokens, h.contentBudget(ctx, ai.TaskSummarize, chunkTokens))
+
+	for round := 1; ai.EstimateTextTokens(content) > maxTokens; round++ {
+		if round > maxCondenseRounds {
+			return ai.TruncateText(content, maxTokens), nil
+		}
+
+		chunks := ai.SplitText(content, chunkSize)
+		slog.Info("condensing long page content",
+			"url_content_id", c.ID,
+			"round", round,
+			"tokens", ai.EstimateTextTokens(content),
+			"parts", len(chunks),
+		)
+
+		summaries := make([]string, 0, len(chunks))
+		for i, chunk := range chunks {
+			summary, err := h.summarizeChunk(ctx, c, chunk, i+1, len(chunks), metadata)
+			if err != nil {
+				return "", fmt.Errorf("summarize part %d of %d: %w", i+1, len(chunks), err)
+			}
+			summaries = append(summaries, fmt.Sprintf("[Part %d/%d]\n%s", i+1, len(chunks), summary))
+		}
+		content = strings.Join(summaries, "\n\n")
+	}
+	return content, nil
+}
+
+// summarizeChunk summarizes one part of a long page.
+func (h *handlers) summarizeChunk(ctx context.Context, c *ent.URLContent, chunk string, part, parts int, extra map[string]string) (string, error) {
+	metadata := map[string]string{
+		"url_content_id": c.ID.String(),
+		"part":           strconv.Itoa(part) + "/" + strconv.Itoa(parts),
+	}
+	maps.Copy(metadata, extra)
+	req, err := h.promptRequest(ctx, prompt.SummarizeChunk,
+		map[string]string{
+			"Title":   c.Title,
+			"Part":    strconv.Itoa(part),
+			"Parts":   strconv.Itoa(parts),
+			"Content": chunk,
+		},
+		metadata,
+		user.HasURLContentsWith(urlcontent.IDEQ(c.ID)),
+	)
+	if err != nil {
+		return "", fmt.Errorf("render prompt: %w", err)
+	}
+	req.Options = ai.ChatOptions{
+		MaxTokens: 1024,
+		JSONMode:  true,
+	}
+
+	response, err := h.aiManager.Chat(ctx, ai.TaskSummarize, req)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Summary string `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(response.Content), &result); err != nil {
+		return "", fmt.Errorf("parse ai response: %w", err)
+	}
+	return result.Summary, nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/mindhit/api/internal/infrastructure/mail"
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+	"github.com/mindhit/api/internal/infrastructure/queue"
+)
+
+// HandleEmailSend renders a transactional email and sends it. Delivery
+// failures are retried by the queue; emails that cannot be rendered are not.
+func (h *handlers) HandleEmailSend(ctx context.Context, t *asynq.Task) error {
+	var payload queue.EmailSendPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("unmarshal payload: %w: %w", err, asynq.SkipRetry)
+	}
+
+	if h.mailer == nil || h.mailRenderer == nil {
+		slog.Warn("mailer not configured, dropping email", "template", payload.Template)
+		return nil
+	}
+
+	msg, err := h.mailRenderer.Render(payload.Template, payload.Locale, payload.Data)
+	if err != nil {
+		// Rendering is deterministic, a retry would fail the same way
+		metrics.EmailsSent.WithLabelValues(payload.Template, "failed").Inc()
+		return fmt.Errorf("render email: %w: %w", err, asynq.SkipRetry)
+	}
+	msg.To = payload.To
+
+	if err := h.mailer.Send(ctx, msg); err != nil {
+		metrics.EmailsSent.WithLabelValues(payload.Template, "failed").Inc()
+		if errors.Is(err, mail.ErrNoRecipient) {
+			return fmt.Errorf("send email: %w: %w", err, asynq.SkipRetry)
+		}
+		return fmt.Errorf("send email: %w", err)
+	}
+
+	metrics.EmailsSent.WithLabelValues(payload.Template, "success").Inc()
+	slog.Info("email sent", "template", payload.Template, "locale", payload.Locale)
+	return nil
+}
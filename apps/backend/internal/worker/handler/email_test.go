@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/internal/infrastructure/mail"
+	"github.com/mindhit/api/internal/infrastructure/queue"
+)
+
+type fakeMailer struct {
+	sent []*mail.Message
+	err  error
+}
+
+func (m *fakeMailer) Send(_ context.Context, msg *mail.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func newEmailTestHandlers(t *testing.T, mailer mail.Mailer) *handlers {
+	t.Helper()
+	renderer, err := mail.NewRenderer()
+	require.NoError(t, err)
+	return &handlers{mailer: mailer, mailRenderer: renderer}
+}
+
+func emailTask(t *testing.T, template string, data map[string]string) *asynq.Task {
+	t.Helper()
+	task, err := queue.NewEmailSendTask("user@example.com", template, "en", data)
+	require.NoError(t, err)
+	return task
+}
+
+func TestHandleEmailSend(t *testing.T) {
+	mailer := &fakeMailer{}
+	h := newEmailTestHandlers(t, mailer)
+
+	task := emailTask(t, mail.TemplatePasswordReset, map[string]string{
+		"ResetURL": "https://mindhit.test/reset-password?token=abc",
+	})
+	require.NoError(t, h.HandleEmailSend(context.Background(), task))
+
+	require.Len(t, mailer.sent, 1)
+	assert.Equal(t, "user@example.com", mailer.sent[0].To)
+	assert.Contains(t, mailer.sent[0].Subject, "Reset your password")
+	assert.Contains(t, mailer.sent[0].Text, "https://mindhit.test/reset-password?token=abc")
+}
+
+func TestHandleEmailSend_SendFailureIsRetried(t *testing.T) {
+	h := newEmailTestHandlers(t, &fakeMailer{err: errors.New("connection refused")})
+
+	task := emailTask(t, mail.TemplatePasswordReset, map[string]string{"ResetURL": "https://mindhit.test"})
+	err := h.HandleEmailSend(context.Background(), task)
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, asynq.SkipRetry)
+}
+
+func TestHandleEmailSend_UnknownTemplateIsNotRetried(t *testing.T) {
+	mailer := &fakeMailer{}
+	h := newEmailTestHandlers(t, mailer)
+
+	err := h.HandleEmailSend(context.Background(), emailTask(t, "no_such_template", nil))
+
+	assert.ErrorIs(t, err, asynq.SkipRetry)
+	assert.ErrorIs(t, err, mail.ErrUnknownTemplate)
+	assert.Empty(t, mailer.sent)
+}
+
+func TestHandleEmailSend_InvalidPayload(t *testing.T) {
+	h := newEmailTestHandlers(t, &fakeMailer{})
+
+	task := asynq.NewTask(queue.TypeEmailSend, []byte("invalid json"))
+	err := h.HandleEmailSend(context.Background(), task)
+
+	assert.ErrorIs(t, err, asynq.SkipRetry)
+}
+
+func TestHandleEmailSend_NoMailer(t *testing.T) {
+	h := &handlers{}
+
+	payload, _ := json.Marshal(queue.EmailSendPayload{To: "user@example.com", Template: mail.TemplatePasswordReset})
+	err := h.HandleEmailSend(context.Background(), asynq.NewTask(queue.TypeEmailSend, payload))
+
+	assert.NoError(t, err)
+}
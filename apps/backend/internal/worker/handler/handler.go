@@ -4,6 +4,9 @@ package handler
 import (
 	"github.com/mindhit/api/ent"
 	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/mail"
+	"github.com/mindhit/api/internal/infrastructure/progress"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
 	"github.com/mindhit/api/internal/infrastructure/queue"
 	"github.com/mindhit/api/internal/service"
 )
@@ -12,23 +15,51 @@ import (
 func RegisterHandlers(
 	server *queue.Server,
 	client *ent.Client,
+	queueClient *queue.Client,
 	aiManager *ai.ProviderManager,
 	usageService *service.UsageService,
+	mailer mail.Mailer,
+	mailRenderer *mail.Renderer,
+	progressBroker *progress.Broker,
+	prompts *prompt.Registry,
 ) {
 	h := &handlers{
-		client:       client,
-		aiManager:    aiManager,
-		usageService: usageService,
+		client:           client,
+		queueClient:      queueClient,
+		aiManager:        aiManager,
+		usageService:     usageService,
+		mindmapService:   service.NewMindmapService(client, queueClient),
+		knowledgeService: service.NewKnowledgeService(client),
+		urlService:       service.NewURLService(client),
+		aiLogService:     service.NewAILogService(client),
+		mailer:           mailer,
+		mailRenderer:     mailRenderer,
+		progressBroker:   progressBroker,
+		prompts:          prompts,
 	}
 
 	server.HandleFunc(queue.TypeSessionProcess, h.HandleSessionProcess)
 	server.HandleFunc(queue.TypeSessionCleanup, h.HandleSessionCleanup)
+	server.HandleFunc(queue.TypeSessionRetention, h.HandleSessionRetention)
 	server.HandleFunc(queue.TypeURLTagExtraction, h.HandleURLTagExtraction)
+	server.HandleFunc(queue.TypeURLSummarize, h.HandleURLSummarize)
+	server.HandleFunc(queue.TypeURLSummaryBackfill, h.HandleURLSummaryBackfill)
 	server.HandleFunc(queue.TypeMindmapGenerate, h.HandleMindmapGenerate)
+	server.HandleFunc(queue.TypeKnowledgeUpdate, h.HandleKnowledgeUpdate)
+	server.HandleFunc(queue.TypeEmailSend, h.HandleEmailSend)
 }
 
 type handlers struct {
-	client       *ent.Client
-	aiManager    *ai.ProviderManager
-	usageService *service.UsageService
+	client           *ent.Client
+	queueClient      *queue.Client
+	aiManager        *ai.ProviderManager
+	usageService     *service.UsageService
+	mindmapService   *service.MindmapService
+	knowledgeService *service.KnowledgeService
+	urlService       *service.URLService
+	aiLogService     *service.AILogService
+	mailer           mail.Mailer
+	mailRenderer     *mail.Renderer
+	progressBroker   *progress.Broker
+	prompts          *prompt.Registry
 }
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+
+	"github.com/mindhit/api/internal/infrastructure/queue"
+	"github.com/mindhit/api/internal/service"
+)
+
+// HandleKnowledgeUpdate merges the current graph of a mindmap into its
+// user's knowledge graph. A mindmap that is gone or generating again is
+// skipped; the task enqueued when it completes again takes over.
+func (h *handlers) HandleKnowledgeUpdate(ctx context.Context, t *asynq.Task) error {
+	var payload queue.KnowledgeUpdatePayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("unmarshal payload: %w: %w", err, asynq.SkipRetry)
+	}
+	mindmapID, err := uuid.Parse(payload.MindmapID)
+	if err != nil {
+		return fmt.Errorf("invalid mindmap ID: %w: %w", err, asynq.SkipRetry)
+	}
+
+	err = h.knowledgeService.UpdateFromMindmap(ctx, mindmapID)
+	if errors.Is(err, service.ErrMindmapNotFound) || errors.Is(err, service.ErrMindmapNotCompleted) {
+		slog.Info("skipping knowledge update", "mindmap_id", mindmapID, "reason", err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("update knowledge graph: %w", err)
+	}
+
+	slog.Info("knowledge graph updated", "mindmap_id", mindmapID)
+	return nil
+}
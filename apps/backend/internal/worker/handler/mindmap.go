@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
@@ -13,64 +14,20 @@ import (
 	"github.com/hibiken/asynq"
 
 	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/session"
+	enturl "github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/internal/infrastructure/ai"
 	"github.com/mindhit/api/internal/infrastructure/metrics"
+	"github.com/mindhit/api/internal/infrastructure/progress"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
 	"github.com/mindhit/api/internal/infrastructure/queue"
 	"github.com/mindhit/api/internal/service"
 )
 
-const relationshipGraphPrompt = `Analyze the pages and tags from this browsing session to create a relationship graph.
-
-## Session Data
-
-### Visited Pages (URL + keywords + summary)
-
-%s
-
-### Highlights (user-selected text)
-
-%s
-
-## Requirements
-
-1. **Core theme (core)**: One central theme spanning the entire session
-2. **Main topics (topics)**: 3-5 groups based on common keywords
-3. **Page connections**: Map pages to their relevant topics
-4. **Topic connections**: Relationships between topics with overlapping keywords
-
-## Respond in JSON format
-
-{
-  "core": {
-    "label": "Core theme (Korean)",
-    "description": "Session summary (1-2 sentences)"
-  },
-  "topics": [
-    {
-      "id": "topic-1",
-      "label": "Topic name (Korean)",
-      "keywords": ["related", "keywords"],
-      "description": "Topic description",
-      "pages": [
-        {
-          "url_id": "uuid",
-          "title": "Page title",
-          "relevance": 0.9
-        }
-      ]
-    }
-  ],
-  "connections": [
-    {
-      "from": "topic-1",
-      "to": "topic-2",
-      "shared_keywords": ["shared keyword"],
-      "reason": "Connection reason"
-    }
-  ]
-}`
-
 // RelationshipGraphResponse represents the AI response structure.
 type RelationshipGraphResponse struct {
 	Core struct {
@@ -97,7 +54,9 @@ type RelationshipGraphResponse struct {
 }
 
 // HandleMindmapGenerate processes mindmap generation for a session.
-func (h *handlers) HandleMindmapGenerate(ctx context.Context, t *asynq.Task) error {
+// It is the last step of session processing, so the session is marked
+// failed once generation fails for good.
+func (h *handlers) HandleMindmapGenerate(ctx context.Context, t *asynq.Task) (err error) {
 	start := time.Now()
 	jobType := "mindmap_generation"
 
@@ -121,9 +80,48 @@ func (h *handlers) HandleMindmapGenerate(ctx context.Context, t *asynq.Task) err
 	// Check if AI manager is available
 	if h.aiManager == nil {
 		slog.Warn("ai manager not configured, skipping mindmap generation")
+		h.failUnprocessableMindmap(ctx, payload.MindmapID, "ai provider not configured")
+		h.newMindmapProgress(ctx, sessionID).fail(errors.New("ai provider not configured"))
+		return nil
+	}
+
+	mindmap, err := h.resolveMindmap(ctx, sessionID, payload.MindmapID)
+	if err != nil {
+		return fmt.Errorf("get mindmap: %w", err)
+	}
+
+	// Completed or failed rows were handled by an earlier task; a forced
+	// regeneration resets the row to pending before enqueueing again
+	if mindmap.Status != mindmapgraph.StatusPending && mindmap.Status != mindmapgraph.StatusGenerating {
+		slog.Info("mindmap not pending, skipping", "mindmap_id", mindmap.ID, "status", mindmap.Status)
 		return nil
 	}
 
+	prog := h.newMindmapProgress(ctx, sessionID)
+
+	// Fail the mindmap (and the session, if still processing) when no retry is left
+	defer func() {
+		if err == nil {
+			return
+		}
+		if !isFinalAttempt(ctx, err) {
+			prog.stage(progress.StageRetrying)
+			return
+		}
+		prog.fail(err)
+		metrics.WorkerJobsProcessed.WithLabelValues(jobType, "failed").Inc()
+		metrics.MindmapsGenerated.WithLabelValues("failed").Inc()
+		if _, setErr := h.mindmapService.SetFailed(ctx, mindmap.ID, err.Error()); setErr != nil {
+			slog.Error("failed to mark mindmap failed", "mindmap_id", mindmap.ID, "error", setErr)
+		}
+		h.failSession(ctx, sessionID, err)
+	}()
+
+	if _, err = h.mindmapService.UpdateStatus(ctx, mindmap.ID, mindmapgraph.StatusGenerating); err != nil {
+		return fmt.Errorf("update mindmap status: %w", err)
+	}
+	prog.stage(progress.StageLoading)
+
 	// Get session with all related data
 	sess, err := h.client.Session.
 		Query().
@@ -139,8 +137,34 @@ func (h *handlers) HandleMindmapGenerate(ctx context.Context, t *asynq.Task) err
 		return fmt.Errorf("get session: %w", err)
 	}
 
+	// Get user ID for metadata and usage tracking
+	var userID uuid.UUID
+	if sess.Edges.User != nil {
+		userID = sess.Edges.User.ID
+	}
+
+	// Only the session owner's copy of each page may reach the prompt
+	contents, err := h.client.URLContent.
+		Query().
+		Where(
+			urlcontent.HasUserWith(user.IDEQ(userID)),
+			urlcontent.HasURLWith(enturl.HasPageVisitsWith(pagevisit.HasSessionWith(session.IDEQ(sessionID)))),
+		).
+		WithURL().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("get url contents: %w", err)
+	}
+
+	contentByURL := make(map[uuid.UUID]*ent.URLContent, len(contents))
+	for _, c := range contents {
+		if c.Edges.URL != nil {
+			contentByURL[c.Edges.URL.ID] = c
+		}
+	}
+
 	// Build page data with keywords
-	var pageData strings.Builder
+	var pages []mindmapPage
 	durationMsMap := make(map[string]int)
 
 	for _, pv := range sess.Edges.PageVisits {
@@ -148,6 +172,10 @@ func (h *handlers) HandleMindmapGenerate(ctx context.Context, t *asynq.Task) err
 			continue
 		}
 		u := pv.Edges.URL
+		c, ok := contentByURL[u.ID]
+		if !ok {
+			c = &ent.URLContent{}
+		}
 
 		durationMs := 0
 		if pv.DurationMs != nil {
@@ -155,7 +183,9 @@ func (h *handlers) HandleMindmapGenerate(ctx context.Context, t *asynq.Task) err
 		}
 		durationMsMap[u.ID.String()] = durationMs
 
-		pageData.WriteString(fmt.Sprintf(`
+		pages = append(pages, mindmapPage{
+			urlID: u.ID.String(),
+			entry: fmt.Sprintf(`
 - ID: %s
   Title: %s
   URL: %s
@@ -163,31 +193,26 @@ func (h *handlers) HandleMindmapGenerate(ctx context.Context, t *asynq.Task) err
   Summary: %s
   Duration: %dms
 `,
-			u.ID.String(),
-			u.Title,
-			u.URL,
-			strings.Join(u.Keywords, ", "),
-			u.Summary,
-			durationMs,
-		))
+				u.ID.String(),
+				c.Title,
+				u.URL,
+				strings.Join(c.Keywords, ", "),
+				promptSummary(c),
+				durationMs,
+			),
+		})
 	}
 
 	// Build highlights text
 	var highlights strings.Builder
 	if len(sess.Edges.Highlights) > 0 {
 		for _, hl := range sess.Edges.Highlights {
-			highlights.WriteString(fmt.Sprintf("- \"%s\"\n", hl.Text))
+			highlights.WriteString(fmt.Sprintf("- \"%s\"\n", truncateContent(hl.Text, mindmapHighlightBytes)))
 		}
 	} else {
 		highlights.WriteString("(No highlights)")
 	}
 
-	// Get user ID for metadata and usage tracking
-	var userID uuid.UUID
-	if sess.Edges.User != nil {
-		userID = sess.Edges.User.ID
-	}
-
 	// Check usage limit before AI call
 	if h.usageService != nil && userID != uuid.Nil {
 		status, err := h.usageService.CheckLimit(ctx, userID)
@@ -199,93 +224,253 @@ func (h *handlers) HandleMindmapGenerate(ctx context.Context, t *asynq.Task) err
 				"tokens_used", status.TokensUsed,
 				"token_limit", status.TokenLimit,
 			)
-			return fmt.Errorf("token limit exceeded: used %d/%d", status.TokensUsed, status.TokenLimit)
+			return fmt.Errorf("token limit exceeded: used %d/%d: %w", status.TokensUsed, status.TokenLimit, asynq.SkipRetry)
 		}
 	}
 
-	// Generate relationship graph using AI
-	req := ai.ChatRequest{
-		UserPrompt: fmt.Sprintf(relationshipGraphPrompt, pageData.String(), highlights.String()),
-		Options: ai.ChatOptions{
-			MaxTokens: 4096,
-			JSONMode:  true,
-		},
-		Metadata: map[string]string{
-			"session_id": sessionID.String(),
-			"user_id":    userID.String(),
-		},
+	input := mindmapInput{
+		sessionID:  sessionID,
+		userID:     userID,
+		pages:      pages,
+		highlights: ai.TruncateText(highlights.String(), mindmapHighlightTokens),
+		durations:  durationMsMap,
 	}
 
-	response, err := h.aiManager.Chat(ctx, ai.TaskMindmap, req)
+	// Sessions too large for one request are clustered first
+	prog.stage(progress.StageGenerating)
+	var result *mindmapResult
+	if budget := h.contentBudget(ctx, ai.TaskMindmap, mindmapInputTokens); input.tokens() <= budget {
+		result, err = h.generateMindmap(ctx, input, prog)
+	} else {
+		result, err = h.generateClusteredMindmap(ctx, input, budget, prog)
+	}
 	if err != nil {
-		return fmt.Errorf("ai generate mindmap: %w", err)
+		return err
 	}
+	prog.stage(progress.StageSaving)
 
-	// Record token usage
-	if h.usageService != nil && userID != uuid.Nil {
+	// Record token usage, cached responses don't count against it
+	if h.usageService != nil && userID != uuid.Nil && result.tokens > 0 {
 		if err := h.usageService.RecordUsage(ctx, service.UsageRecord{
 			UserID:    userID,
 			SessionID: sessionID,
 			Operation: "mindmap",
-			Tokens:    response.TotalTokens,
-			AIModel:   response.Model,
+			Tokens:    result.tokens,
+			AIModel:   result.model,
 		}); err != nil {
 			slog.Error("failed to record usage", "error", err)
 		}
 	}
 
-	var aiResp RelationshipGraphResponse
-	if err := json.Unmarshal([]byte(response.Content), &aiResp); err != nil {
-		return fmt.Errorf("parse ai response: %w", err)
-	}
-
-	// Convert AI response to mindmap data
-	mindmapData := buildMindmapFromRelationship(aiResp, durationMsMap)
-
-	// Convert to storage format ([]map[string]interface{})
-	nodesData := service.ConvertNodesToMaps(mindmapData.Nodes)
-	edgesData := service.ConvertEdgesToMaps(mindmapData.Edges)
-	layoutData := service.ConvertLayoutToMap(mindmapData.Layout)
-
 	// Save mindmap to database
-	_, err = h.client.MindmapGraph.
-		Create().
-		SetSessionID(sessionID).
-		SetNodes(nodesData).
-		SetGraphEdges(edgesData).
-		SetLayout(layoutData).
-		Save(ctx)
-
-	if err != nil {
+	if _, err = h.mindmapService.SetCompleted(ctx, mindmap.ID, result.data); err != nil {
 		return fmt.Errorf("save mindmap: %w", err)
 	}
 
-	// Update session status to completed
-	_, err = h.client.Session.
-		UpdateOneID(sessionID).
+	// Complete the session if this run finished its processing chain
+	err = h.client.Session.
+		Update().
+		Where(
+			session.IDEQ(sessionID),
+			session.SessionStatusEQ(session.SessionStatusProcessing),
+		).
 		SetSessionStatus(session.SessionStatusCompleted).
-		Save(ctx)
+		Exec(ctx)
 
 	if err != nil {
 		return fmt.Errorf("update session status: %w", err)
 	}
 
+	prog.done(mindmap.ID)
+
 	// Record success metrics
 	metrics.WorkerJobsProcessed.WithLabelValues(jobType, "success").Inc()
 	metrics.MindmapsGenerated.WithLabelValues("success").Inc()
-	metrics.MindmapNodeCount.Observe(float64(len(mindmapData.Nodes)))
-	metrics.MindmapEdgeCount.Observe(float64(len(mindmapData.Edges)))
+	metrics.MindmapNodeCount.Observe(float64(len(result.data.Nodes)))
+	metrics.MindmapEdgeCount.Observe(float64(len(result.data.Edges)))
 
 	slog.Info("mindmap generated",
 		"session_id", payload.SessionID,
-		"topics", len(aiResp.Topics),
-		"connections", len(aiResp.Connections),
-		"provider", response.Provider,
-		"tokens", response.TotalTokens,
+		"mindmap_id", mindmap.ID,
+		"version", mindmap.Version,
+		"pages", len(pages),
+		"topics", result.topics,
+		"connections", result.connections,
+		"provider", result.provider,
+		"tokens", result.tokens,
 	)
 	return nil
 }
 
+// generateMindmap generates the relationship graph of a session in one
+// request, streaming the response to the progress subscribers.
+func (h *handlers) generateMindmap(ctx context.Context, in mindmapInput, prog *mindmapProgress) (*mindmapResult, error) {
+	req, err := h.promptRequest(ctx, prompt.Mindmap,
+		map[string]string{"Pages": joinPages(in.pages), "Highlights": in.highlights},
+		in.metadata(),
+		user.IDEQ(in.userID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("render prompt: %w", err)
+	}
+	req.Options = ai.ChatOptions{
+		MaxTokens: 4096,
+		JSONMode:  true,
+	}
+	req.Validate = func(content string) error {
+		return validateRelationshipGraph(content, in.durations)
+	}
+
+	// Stream the response so users can follow along
+	response, err := h.aiManager.ChatStream(ctx, ai.TaskMindmap, req, ai.StreamHandler{
+		OnThinking: prog.thinking,
+		OnContent:  prog.content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ai generate mindmap: %w", err)
+	}
+
+	var aiResp RelationshipGraphResponse
+	if err := json.Unmarshal([]byte(response.Content), &aiResp); err != nil {
+		return nil, fmt.Errorf("parse ai response: %w", err)
+	}
+
+	return &mindmapResult{
+		data:        buildMindmapFromRelationship(aiResp, in.durations),
+		topics:      len(aiResp.Topics),
+		connections: len(aiResp.Connections),
+		provider:    response.Provider,
+		model:       response.Model,
+		tokens:      response.TotalTokens,
+	}, nil
+}
+
+// validateRelationshipGraph checks that a relationship graph only refers
+// to the session's pages and its own topics.
+func validateRelationshipGraph(content string, sessionURLs map[string]int) error {
+	var resp RelationshipGraphResponse
+	if err := json.Unmarshal([]byte(content), &resp); err != nil {
+		return err
+	}
+
+	var errs []error
+	topics := make(map[string]bool, len(resp.Topics))
+	for i, topic := range resp.Topics {
+		if topics[topic.ID] {
+			errs = append(errs, fmt.Errorf("topics[%d]: duplicate topic id %q", i, topic.ID))
+		}
+		topics[topic.ID] = true
+
+		if len(topic.Pages) == 0 {
+			errs = append(errs, fmt.Errorf("topics[%d]: topic %q has no pages", i, topic.ID))
+		}
+		for j, page := range topic.Pages {
+			if _, ok := sessionURLs[page.URLID]; !ok {
+				errs = append(errs, fmt.Errorf("topics[%d].pages[%d]: url_id %q is not a page of this session", i, j, page.URLID))
+			}
+		}
+	}
+	if len(resp.Topics) == 0 && len(sessionURLs) > 0 {
+		errs = append(errs, errors.New("topics: expected at least one topic for the session's pages"))
+	}
+
+	for i, conn := range resp.Connections {
+		if !topics[conn.From] {
+			errs = append(errs, fmt.Errorf("connections[%d]: unknown topic %q", i, conn.From))
+		}
+		if !topics[conn.To] {
+			errs = append(errs, fmt.Errorf("connections[%d]: unknown topic %q", i, conn.To))
+		}
+		if conn.From == conn.To {
+			errs = append(errs, fmt.Errorf("connections[%d]: topic %q is connected to itself", i, conn.From))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// resolveMindmap returns the mindmap row a task should fill in. Tasks
+// without a mindmap ID use the session's mindmap, creating it if needed.
+func (h *handlers) resolveMindmap(ctx context.Context, sessionID uuid.UUID, rawMindmapID string) (*ent.MindmapGraph, error) {
+	if rawMindmapID == "" {
+		mindmap, _, err := h.mindmapService.GetOrCreateBySessionID(ctx, sessionID)
+		return mindmap, err
+	}
+
+	mindmapID, err := uuid.Parse(rawMindmapID)
+	if err != nil {
+		return nil, fmt.Errorf("parse mindmap id: %w", err)
+	}
+
+	return h.client.MindmapGraph.Get(ctx, mindmapID)
+}
+
+// failUnprocessableMindmap marks a mindmap failed when the worker cannot
+// generate it at all.
+func (h *handlers) failUnprocessableMindmap(ctx context.Context, rawMindmapID, reason string) {
+	mindmapID, err := uuid.Parse(rawMindmapID)
+	if err != nil || h.mindmapService == nil {
+		return
+	}
+
+	if _, err := h.mindmapService.SetFailed(ctx, mindmapID, reason); err != nil {
+		slog.Error("failed to mark mindmap failed", "mindmap_id", mindmapID, "error", err)
+	}
+}
+
+// promptSummary returns the short form of a page summary for prompts: the
+// abstract when a full-page summary exists, otherwise the tag summary.
+func promptSummary(c *ent.URLContent) string {
+	if c.Abstract != "" {
+		return c.Abstract
+	}
+	return c.Summary
+}
+
+// failSession marks a session as failed at the end of its processing chain.
+// Sessions that already finished (e.g. a manual regeneration) are untouched.
+func (h *handlers) failSession(ctx context.Context, sessionID uuid.UUID, cause error) {
+	err := h.client.Session.
+		Update().
+		Where(
+			session.IDEQ(sessionID),
+			session.SessionStatusEQ(session.SessionStatusProcessing),
+		).
+		SetSessionStatus(session.SessionStatusFailed).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("failed to mark session failed", "session_id", sessionID, "error", err)
+		return
+	}
+
+	slog.Warn("session processing failed", "session_id", sessionID, "error", cause)
+}
+
+// isFinalAttempt reports whether asynq will not retry the task after err.
+func isFinalAttempt(ctx context.Context, err error) bool {
+	retried, ok := asynq.GetRetryCount(ctx)
+	maxRetry, hasMax := asynq.GetMaxRetry(ctx)
+	if !ok || !hasMax {
+		return errors.Is(err, asynq.SkipRetry)
+	}
+	return finalAttempt(err, retried, maxRetry)
+}
+
+// finalAttempt reports whether a task already retried the given number of
+// times won't be retried after err. Errors that know when their task can be
+// retried don't count as failures, so the queue retries them past maxRetry.
+func finalAttempt(err error, retried, maxRetry int) bool {
+	if errors.Is(err, asynq.SkipRetry) {
+		return true
+	}
+	var delayed interface{ RetryAfter() time.Duration }
+	if errors.As(err, &delayed) {
+		return false
+	}
+	return retried >= maxRetry
+}
+
+// buildMindmapFromRelationship builds a mindmap of topics and their pages.
+// Nodes are positioned by the layout when the mindmap is saved.
 func buildMindmapFromRelationship(
 	resp RelationshipGraphResponse,
 	durationMsMap map[string]int,
@@ -296,27 +481,23 @@ func buildMindmapFromRelationship(
 	// Core node
 	coreID := "core"
 	nodes = append(nodes, service.MindmapNode{
-		ID:       coreID,
-		Label:    resp.Core.Label,
-		Type:     "core",
-		Size:     100,
-		Color:    "#FFD700",
-		Position: &service.Position{X: 0, Y: 0, Z: 0},
+		ID:    coreID,
+		Label: resp.Core.Label,
+		Type:  "core",
+		Size:  100,
+		Color: "#FFD700",
 		Data: map[string]interface{}{
 			"description": resp.Core.Description,
 		},
 	})
 
 	// Topic nodes
-	topicCount := len(resp.Topics)
 	for i, topic := range resp.Topics {
 		topicID := topic.ID
 		if topicID == "" {
 			topicID = fmt.Sprintf("topic-%d", i)
 		}
 
-		angle := (float64(i) / float64(topicCount)) * 2 * math.Pi
-		radius := 200.0
 		topicSize := 40.0 + float64(len(topic.Pages))*10
 		if topicSize > 80 {
 			topicSize = 80
@@ -328,11 +509,6 @@ func buildMindmapFromRelationship(
 			Type:  "topic",
 			Size:  topicSize,
 			Color: getTopicColor(i),
-			Position: &service.Position{
-				X: radius * math.Cos(angle),
-				Y: radius * math.Sin(angle),
-				Z: 0,
-			},
 			Data: map[string]interface{}{
 				"description": topic.Description,
 				"keywords":    topic.Keywords,
@@ -346,28 +522,15 @@ func buildMindmapFromRelationship(
 		})
 
 		// Page nodes
-		for j, page := range topic.Pages {
+		for _, page := range topic.Pages {
 			pageID := page.URLID
-			subAngle := angle + (float64(j)-float64(len(topic.Pages))/2)*0.4
-			subRadius := 60.0 + float64(j)*15
-
-			size := 15.0
-			if durationMs, ok := durationMsMap[page.URLID]; ok {
-				size = math.Min(40, 15+float64(durationMs)/20000)
-			}
-			size *= (0.5 + page.Relevance*0.5)
 
 			nodes = append(nodes, service.MindmapNode{
 				ID:    pageID,
 				Label: page.Title,
 				Type:  "page",
-				Size:  size,
+				Size:  pageNodeSize(durationMsMap, page.URLID, page.Relevance),
 				Color: getTopicColor(i),
-				Position: &service.Position{
-					X: radius*math.Cos(angle) + subRadius*math.Cos(subAngle),
-					Y: radius*math.Sin(angle) + subRadius*math.Sin(subAngle),
-					Z: 0,
-				},
 				Data: map[string]interface{}{
 					"url_id":    page.URLID,
 					"relevance": page.Relevance,
@@ -405,6 +568,16 @@ func buildMindmapFromRelationship(
 	}
 }
 
+// pageNodeSize sizes a page node by the time spent on the page and its
+// relevance to the topic.
+func pageNodeSize(durationMsMap map[string]int, urlID string, relevance float64) float64 {
+	size := 15.0
+	if durationMs, ok := durationMsMap[urlID]; ok {
+		size = math.Min(40, 15+float64(durationMs)/20000)
+	}
+	return size * (0.5 + relevance*0.5)
+}
+
 func getTopicColor(index int) string {
 	colors := []string{
 		"#3B82F6", "#10B981", "#F59E0B", "#EF4444",
@@ -0,0 +1,453 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+
+	"github.com/mindhit/api/ent/user"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+	"github.com/mindhit/api/internal/service"
+)
+
+const (
+	// mindmapInputTokens is the most session data a mindmap request
+	// includes, even for models with a larger context window. Larger
+	// sessions are clustered first.
+	mindmapInputTokens = 24000
+	// mindmapHighlightTokens bounds the highlights of a mindmap request.
+	mindmapHighlightTokens = 2000
+	// mindmapHighlightBytes bounds a single highlight.
+	mindmapHighlightBytes = 500
+)
+
+// mindmapPage is a page of a session as described to the model.
+type mindmapPage struct {
+	urlID string
+	entry string
+}
+
+// mindmapInput is the session data a mindmap is generated from.
+type mindmapInput struct {
+	sessionID  uuid.UUID
+	userID     uuid.UUID
+	pages      []mindmapPage
+	highlights string
+	durations  map[string]int // url ID -> duration in ms
+}
+
+// tokens estimates the tokens of the session data.
+func (in mindmapInput) tokens() int {
+	tokens := ai.EstimateTextTokens(in.highlights)
+	for _, p := range in.pages {
+		tokens += ai.EstimateTextTokens(p.entry)
+	}
+	return tokens
+}
+
+func (in mindmapInput) metadata() map[string]string {
+	return map[string]string{
+		"session_id": in.sessionID.String(),
+		"user_id":    in.userID.String(),
+	}
+}
+
+// mindmapResult is a generated mindmap and what it took.
+type mindmapResult struct {
+	data        service.MindmapData
+	topics      int
+	connections int
+	provider    ai.ProviderType
+	model       string
+	tokens      int // tokens used, not counting cached responses
+}
+
+func joinPages(pages []mindmapPage) string {
+	var b strings.Builder
+	for _, p := range pages {
+		b.WriteString(p.entry)
+	}
+	return b.String()
+}
+
+// batchPages splits pages into batches of at most maxTokens, in order. A
+// page larger than maxTokens is a batch of its own.
+func batchPages(pages []mindmapPage, maxTokens int) [][]mindmapPage {
+	var batches [][]mindmapPage
+	var batch []mindmapPage
+	tokens := 0
+	for _, p := range pages {
+		pageTokens := ai.EstimateTextTokens(p.entry)
+		if len(batch) > 0 && tokens+pageTokens > maxTokens {
+			batches = append(batches, batch)
+			batch, tokens = nil, 0
+		}
+		batch = append(batch, p)
+		tokens += pageTokens
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// MindmapMergeResponse represents the AI response organizing the page
+// clusters of a large session into topics.
+type MindmapMergeResponse struct {
+	Core struct {
+		Label       string `json:"label"`
+		Description string `json:"description"`
+	} `json:"core"`
+	Topics []struct {
+		ID          string   `json:"id"`
+		Label       string   `json:"label"`
+		Keywords    []string `json:"keywords"`
+		Description string   `json:"description"`
+		Clusters    []string `json:"clusters"`
+	} `json:"topics"`
+	Connections []struct {
+		From           string   `json:"from"`
+		To             string   `json:"to"`
+		SharedKeywords []string `json:"shared_keywords"`
+		Reason         string   `json:"reason"`
+	} `json:"connections"`
+}
+
+// mindmapCluster is a topic of one batch of a large session's pages. The
+// clusters become the subtopics of the merged mindmap.
+type mindmapCluster struct {
+	ID          string
+	Label       string
+	Keywords    []string
+	Description string
+	Pages       []clusterPage
+}
+
+type clusterPage struct {
+	URLID     string
+	Title     string
+	Relevance float64
+}
+
+// generateClusteredMindmap generates the mindmap of a session too large for
+// one request. Batches of pages that fit are clustered into topics first
+// (map), and the clusters are then organized into topics (reduce), so the
+// mindmap becomes a hierarchy of topics, clusters and pages.
+func (h *handlers) generateClusteredMindmap(ctx context.Context, in mindmapInput, budget int, prog *mindmapProgress) (*mindmapResult, error) {
+	result := &mindmapResult{}
+	batches := batchPages(in.pages, budget-ai.EstimateTextTokens(in.highlights))
+
+	var clusters []mindmapCluster
+	for i, batch := range batches {
+		graph, response, err := h.clusterPages(ctx, in, batch, i+1, len(batches))
+		if err != nil {
+			return nil, fmt.Errorf("cluster pages %d of %d: %w", i+1, len(batches), err)
+		}
+		result.tokens += response.TotalTokens
+
+		for _, topic := range graph.Topics {
+			cluster := mindmapCluster{
+				ID:          "c" + strconv.Itoa(len(clusters)+1),
+				Label:       topic.Label,
+				Keywords:    topic.Keywords,
+				Description: topic.Description,
+			}
+			for _, page := range topic.Pages {
+				cluster.Pages = append(cluster.Pages, clusterPage{URLID: page.URLID, Title: page.Title, Relevance: page.Relevance})
+			}
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	descriptions := describeClusters(clusters)
+	if ai.EstimateTextTokens(descriptions)+ai.EstimateTextTokens(in.highlights) > budget {
+		return nil, fmt.Errorf("session too large: %d page clusters exceed the input budget: %w", len(clusters), asynq.SkipRetry)
+	}
+
+	req, err := h.promptRequest(ctx, prompt.MindmapMerge,
+		map[string]string{"Clusters": descriptions, "Highlights": in.highlights},
+		in.metadata(),
+		user.IDEQ(in.userID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("render prompt: %w", err)
+	}
+	req.Options = ai.ChatOptions{
+		MaxTokens: 4096,
+		JSONMode:  true,
+	}
+	clusterIDs := make(map[string]bool, len(clusters))
+	for _, c := range clusters {
+		clusterIDs[c.ID] = true
+	}
+	req.Validate = func(content string) error {
+		return validateMindmapMerge(content, clusterIDs)
+	}
+
+	// Stream the response so users can follow along
+	response, err := h.aiManager.ChatStream(ctx, ai.TaskMindmap, req, ai.StreamHandler{
+		OnThinking: prog.thinking,
+		OnContent:  prog.content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ai merge mindmap clusters: %w", err)
+	}
+
+	var merged MindmapMergeResponse
+	if err := json.Unmarshal([]byte(response.Content), &merged); err != nil {
+		return nil, fmt.Errorf("parse ai response: %w", err)
+	}
+
+	result.data = buildClusteredMindmap(merged, clusters, in.durations)
+	result.topics = len(merged.Topics)
+	result.connections = len(merged.Connections)
+	result.provider = response.Provider
+	result.model = response.Model
+	result.tokens += response.TotalTokens
+	return result, nil
+}
+
+// clusterPages groups one batch of a large session's pages into topics.
+func (h *handlers) clusterPages(ctx context.Context, in mindmapInput, batch []mindmapPage, n, batches int) (*RelationshipGraphResponse, *ai.ChatResponse, error) {
+	metadata := in.metadata()
+	metadata["batch"] = strconv.Itoa(n) + "/" + strconv.Itoa(batches)
+	req, err := h.promptRequest(ctx, prompt.Mindmap,
+		map[string]string{"Pages": joinPages(batch), "Highlights": in.highlights},
+		metadata,
+		user.IDEQ(in.userID),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("render prompt: %w", err)
+	}
+	req.Options = ai.ChatOptions{
+		MaxTokens: 4096,
+		JSONMode:  true,
+	}
+	batchURLs := make(map[string]int, len(batch))
+	for _, p := range batch {
+		batchURLs[p.urlID] = in.durations[p.urlID]
+	}
+	req.Validate = func(content string) error {
+		return validateRelationshipGraph(content, batchURLs)
+	}
+
+	response, err := h.aiManager.Chat(ctx, ai.TaskMindmap, req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ai generate mindmap: %w", err)
+	}
+
+	var graph RelationshipGraphResponse
+	if err := json.Unmarshal([]byte(response.Content), &graph); err != nil {
+		return nil, nil, fmt.Errorf("parse ai response: %w", err)
+	}
+	return &graph, response, nil
+}
+
+// describeClusters describes the clusters for the merge prompt.
+func describeClusters(clusters []mindmapCluster) string {
+	var b strings.Builder
+	for _, c := range clusters {
+		fmt.Fprintf(&b, `
+- ID: %s
+  Label: %s
+  Keywords: [%s]
+  Description: %s
+  Pages: %d
+`,
+			c.ID,
+			c.Label,
+			strings.Join(c.Keywords, ", "),
+			c.Description,
+			len(c.Pages),
+		)
+	}
+	return b.String()
+}
+
+// validateMindmapMerge checks that merged topics only refer to known
+// clusters, each at most once. Clusters left out become topics of their own.
+func validateMindmapMerge(content string, clusters map[string]bool) error {
+	var resp MindmapMergeResponse
+	if err := json.Unmarshal([]byte(content), &resp); err != nil {
+		return err
+	}
+
+	var errs []error
+	topics := make(map[string]bool, len(resp.Topics))
+	assigned := make(map[string]string, len(clusters)) // cluster -> topic
+	for i, topic := range resp.Topics {
+		if topics[topic.ID] {
+			errs = append(errs, fmt.Errorf("topics[%d]: duplicate topic id %q", i, topic.ID))
+		}
+		if clusters[topic.ID] {
+			errs = append(errs, fmt.Errorf("topics[%d]: topic id %q is a cluster id", i, topic.ID))
+		}
+		topics[topic.ID] = true
+
+		for j, id := range topic.Clusters {
+			if !clusters[id] {
+				errs = append(errs, fmt.Errorf("topics[%d].clusters[%d]: unknown cluster %q", i, j, id))
+				continue
+			}
+			if other, ok := assigned[id]; ok {
+				errs = append(errs, fmt.Errorf("topics[%d].clusters[%d]: cluster %q is already in topic %q", i, j, id, other))
+				continue
+			}
+			assigned[id] = topic.ID
+		}
+	}
+
+	for i, conn := range resp.Connections {
+		if !topics[conn.From] {
+			errs = append(errs, fmt.Errorf("connections[%d]: unknown topic %q", i, conn.From))
+		}
+		if !topics[conn.To] {
+			errs = append(errs, fmt.Errorf("connections[%d]: unknown topic %q", i, conn.To))
+		}
+		if conn.From == conn.To {
+			errs = append(errs, fmt.Errorf("connections[%d]: topic %q is connected to itself", i, conn.From))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// buildClusteredMindmap builds a mindmap of topics, their clusters as
+// subtopics, and the clusters' pages. Nodes are positioned by the layout
+// when the mindmap is saved.
+func buildClusteredMindmap(
+	resp MindmapMergeResponse,
+	clusters []mindmapCluster,
+	durationMsMap map[string]int,
+) service.MindmapData {
+	clusterByID := make(map[string]mindmapCluster, len(clusters))
+	for _, c := range clusters {
+		clusterByID[c.ID] = c
+	}
+
+	type topic struct {
+		id, label, description string
+		keywords               []string
+		clusters               []mindmapCluster
+	}
+	var topics []topic
+	assigned := make(map[string]bool, len(clusters))
+	for i, t := range resp.Topics {
+		id := t.ID
+		if id == "" {
+			id = fmt.Sprintf("topic-%d", i)
+		}
+		merged := topic{id: id, label: t.Label, description: t.Description, keywords: t.Keywords}
+		for _, clusterID := range t.Clusters {
+			if c, ok := clusterByID[clusterID]; ok && !assigned[clusterID] {
+				merged.clusters = append(merged.clusters, c)
+				assigned[clusterID] = true
+			}
+		}
+		topics = append(topics, merged)
+	}
+	// Clusters the model left out stay in the mindmap as topics
+	for _, c := range clusters {
+		if !assigned[c.ID] {
+			topics = append(topics, topic{
+				id:          "topic-" + c.ID,
+				label:       c.Label,
+				description: c.Description,
+				keywords:    c.Keywords,
+				clusters:    []mindmapCluster{c},
+			})
+		}
+	}
+
+	coreID := "core"
+	nodes := []service.MindmapNode{{
+		ID:    coreID,
+		Label: resp.Core.Label,
+		Type:  "core",
+		Size:  100,
+		Color: "#FFD700",
+		Data: map[string]interface{}{
+			"description": resp.Core.Description,
+		},
+	}}
+	var edges []service.MindmapEdge
+
+	for i, t := range topics {
+		pageCount := 0
+		for _, c := range t.clusters {
+			pageCount += len(c.Pages)
+		}
+		nodes = append(nodes, service.MindmapNode{
+			ID:    t.id,
+			Label: t.label,
+			Type:  "topic",
+			Size:  math.Min(80, 40+float64(pageCount)*5),
+			Color: getTopicColor(i),
+			Data: map[string]interface{}{
+				"description": t.description,
+				"keywords":    t.keywords,
+			},
+		})
+		edges = append(edges, service.MindmapEdge{Source: coreID, Target: t.id, Weight: 1.0})
+
+		// Subtopic nodes under their topic, pages under their subtopic
+		for _, c := range t.clusters {
+			nodes = append(nodes, service.MindmapNode{
+				ID:    c.ID,
+				Label: c.Label,
+				Type:  "subtopic",
+				Size:  math.Min(50, 25+float64(len(c.Pages))*5),
+				Color: getTopicColor(i),
+				Data: map[string]interface{}{
+					"description": c.Description,
+					"keywords":    c.Keywords,
+				},
+			})
+			edges = append(edges, service.MindmapEdge{Source: t.id, Target: c.ID, Weight: 1.0})
+
+			for _, page := range c.Pages {
+				nodes = append(nodes, service.MindmapNode{
+					ID:    page.URLID,
+					Label: page.Title,
+					Type:  "page",
+					Size:  pageNodeSize(durationMsMap, page.URLID, page.Relevance),
+					Color: getTopicColor(i),
+					Data: map[string]interface{}{
+						"url_id":    page.URLID,
+						"relevance": page.Relevance,
+					},
+				})
+				edges = append(edges, service.MindmapEdge{Source: c.ID, Target: page.URLID, Weight: page.Relevance})
+			}
+		}
+	}
+
+	// Cross-topic connections
+	for _, conn := range resp.Connections {
+		edges = append(edges, service.MindmapEdge{
+			Source: conn.From,
+			Target: conn.To,
+			Weight: float64(len(conn.SharedKeywords)) * 0.2,
+			Label:  conn.Reason,
+		})
+	}
+
+	return service.MindmapData{
+		Nodes: nodes,
+		Edges: edges,
+		Layout: service.MindmapLayout{
+			Type: "galaxy",
+			Params: map[string]interface{}{
+				"center": []float64{0, 0, 0},
+				"scale":  1.0,
+			},
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/internal/service"
+)
+
+func TestBatchPages(t *testing.T) {
+	page := func(id string, tokens int) mindmapPage {
+		return mindmapPage{urlID: id, entry: strings.Repeat("abcd", tokens)}
+	}
+	pages := []mindmapPage{page("a", 40), page("b", 40), page("c", 30), page("d", 150), page("e", 10)}
+
+	batches := batchPages(pages, 100)
+	var ids [][]string
+	for _, batch := range batches {
+		var batchIDs []string
+		for _, p := range batch {
+			batchIDs = append(batchIDs, p.urlID)
+		}
+		ids = append(ids, batchIDs)
+	}
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}, {"d"}, {"e"}}, ids, "pages larger than a batch are batched alone")
+	assert.Nil(t, batchPages(nil, 100))
+}
+
+func TestValidateMindmapMerge(t *testing.T) {
+	clusters := map[string]bool{"c1": true, "c2": true, "c3": true}
+
+	valid := `{
+		"core": {"label": "Go", "description": ""},
+		"topics": [
+			{"id": "topic-1", "label": "Language", "keywords": [], "clusters": ["c1", "c2"]},
+			{"id": "topic-2", "label": "Tools", "keywords": [], "clusters": ["c3"]}
+		],
+		"connections": [{"from": "topic-1", "to": "topic-2"}]
+	}`
+	assert.NoError(t, validateMindmapMerge(valid, clusters))
+
+	// Clusters left out are allowed, they become topics
+	assert.NoError(t, validateMindmapMerge(`{"core": {}, "topics": [
+		{"id": "topic-1", "label": "Language", "clusters": ["c1"]}
+	], "connections": []}`, clusters))
+
+	err := validateMindmapMerge(`{
+		"core": {"label": "Go", "description": ""},
+		"topics": [
+			{"id": "topic-1", "label": "Language", "clusters": ["c1", "c9"]},
+			{"id": "c2", "label": "Tools", "clusters": ["c1"]}
+		],
+		"connections": [{"from": "topic-1", "to": "topic-1"}]
+	}`, clusters)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown cluster "c9"`)
+	assert.Contains(t, err.Error(), `topic id "c2" is a cluster id`)
+	assert.Contains(t, err.Error(), `cluster "c1" is already in topic "topic-1"`)
+	assert.Contains(t, err.Error(), `topic "topic-1" is connected to itself`)
+}
+
+func TestBuildClusteredMindmap(t *testing.T) {
+	var merged MindmapMergeResponse
+	merged.Core.Label = "Go"
+	merged.Topics = append(merged.Topics, struct {
+		ID          string   `json:"id"`
+		Label       string   `json:"label"`
+		Keywords    []string `json:"keywords"`
+		Description string   `json:"description"`
+		Clusters    []string `json:"clusters"`
+	}{ID: "topic-1", Label: "Language", Clusters: []string{"c1", "c2"}})
+
+	clusters := []mindmapCluster{
+		{ID: "c1", Label: "Generics", Pages: []clusterPage{{URLID: "u1", Title: "Type parameters", Relevance: 0.9}}},
+		{ID: "c2", Label: "Errors", Pages: []clusterPage{{URLID: "u2", Relevance: 0.5}, {URLID: "u3", Relevance: 0.5}}},
+		{ID: "c3", Label: "Tooling", Pages: []clusterPage{{URLID: "u4", Relevance: 1}}},
+	}
+
+	data := buildClusteredMindmap(merged, clusters, map[string]int{"u1": 60000})
+
+	types := make(map[string]string)
+	for _, n := range data.Nodes {
+		types[n.ID] = n.Type
+	}
+	assert.Equal(t, map[string]string{
+		"core":     "core",
+		"topic-1":  "topic",
+		"c1":       "subtopic",
+		"c2":       "subtopic",
+		"u1":       "page",
+		"u2":       "page",
+		"u3":       "page",
+		"topic-c3": "topic",
+		"c3":       "subtopic",
+		"u4":       "page",
+	}, types, "the cluster left out is a topic of its own")
+
+	assert.Contains(t, data.Edges, service.MindmapEdge{Source: "topic-1", Target: "c2", Weight: 1.0})
+	assert.Contains(t, data.Edges, service.MindmapEdge{Source: "c1", Target: "u1", Weight: 0.9})
+	assert.Contains(t, data.Edges, service.MindmapEdge{Source: "core", Target: "topic-c3", Weight: 1.0})
+}
+
+func TestBuildClusteredMindmap_EditsKeepHierarchy(t *testing.T) {
+	var merged MindmapMergeResponse
+	merged.Core.Label = "Go"
+	merged.Topics = append(merged.Topics, struct {
+		ID          string   `json:"id"`
+		Label       string   `json:"label"`
+		Keywords    []string `json:"keywords"`
+		Description string   `json:"description"`
+		Clusters    []string `json:"clusters"`
+	}{ID: "topic-1", Label: "Language", Clusters: []string{"c1", "c2"}})
+	clusters := []mindmapCluster{
+		{ID: "c1", Label: "Generics", Pages: []clusterPage{{URLID: "u1", Relevance: 0.9}}},
+		{ID: "c2", Label: "Errors", Pages: []clusterPage{{URLID: "u2", Relevance: 0.5}, {URLID: "u3", Relevance: 0.5}}},
+		{ID: "c3", Label: "Tooling", Pages: []clusterPage{{URLID: "u4", Relevance: 1}}},
+	}
+	data := buildClusteredMindmap(merged, clusters, nil)
+	ids := func(data service.MindmapData) []string {
+		var ids []string
+		for _, n := range data.Nodes {
+			ids = append(ids, n.ID)
+		}
+		return ids
+	}
+
+	deleted, err := service.ApplyEdits(data, []service.MindmapEdit{{Op: service.EditDelete, NodeID: "topic-1"}})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"core", "topic-c3", "c3", "u4"}, ids(deleted), "subtopics and their pages go with the topic")
+
+	pinned := true
+	edits := []service.MindmapEdit{{Op: service.EditPin, NodeID: "topic-1", Pinned: &pinned}}
+	_, err = service.ApplyEdits(data, edits)
+	require.NoError(t, err)
+
+	// The regenerated graph no longer has the pinned topic
+	regenerated := buildClusteredMindmap(MindmapMergeResponse{}, clusters[2:], nil)
+	restored, skipped := service.ReplayEdits(regenerated, edits)
+	assert.Zero(t, skipped)
+	assert.ElementsMatch(t, []string{"core", "topic-c3", "c3", "u4", "topic-1", "c1", "c2", "u1", "u2", "u3"}, ids(restored))
+	assert.Contains(t, restored.Edges, service.MindmapEdge{Source: "topic-1", Target: "c2", Weight: 1.0})
+	assert.Contains(t, restored.Edges, service.MindmapEdge{Source: "c2", Target: "u3", Weight: 0.5})
+	assert.Contains(t, restored.Edges, service.MindmapEdge{Source: "core", Target: "topic-1", Weight: 1})
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mindhit/api/internal/infrastructure/progress"
+)
+
+const (
+	// progressFlushBytes and progressFlushInterval bound how long deltas are
+	// held back, so a fast model doesn't publish a message per token.
+	progressFlushBytes    = 512
+	progressFlushInterval = 100 * time.Millisecond
+)
+
+// mindmapProgress publishes the generation progress of one session. Without
+// a broker it does nothing. It is not safe for concurrent use.
+type mindmapProgress struct {
+	ctx       context.Context
+	broker    *progress.Broker
+	sessionID uuid.UUID
+
+	pendingType progress.EventType
+	pending     strings.Builder
+	lastFlush   time.Time
+	topics      topicScanner
+	failed      bool
+}
+
+func (h *handlers) newMindmapProgress(ctx context.Context, sessionID uuid.UUID) *mindmapProgress {
+	return &mindmapProgress{
+		ctx:       ctx,
+		broker:    h.progressBroker,
+		sessionID: sessionID,
+		lastFlush: time.Now(),
+	}
+}
+
+func (p *mindmapProgress) stage(stage string) {
+	p.flush()
+	p.publish(progress.Event{Type: progress.EventStage, Stage: stage})
+}
+
+func (p *mindmapProgress) thinking(delta string) {
+	p.buffer(progress.EventThinking, delta)
+}
+
+// content buffers a response delta and publishes the topics it completes.
+func (p *mindmapProgress) content(delta string) {
+	if p.broker == nil {
+		return
+	}
+	p.buffer(progress.EventContent, delta)
+	for _, topic := range p.topics.write(delta) {
+		p.flush()
+		p.publish(progress.Event{Type: progress.EventTopic, Topic: &topic})
+	}
+}
+
+func (p *mindmapProgress) done(mindmapID uuid.UUID) {
+	p.flush()
+	p.publish(progress.Event{Type: progress.EventDone, MindmapID: mindmapID.String()})
+}
+
+func (p *mindmapProgress) fail(err error) {
+	p.flush()
+	p.publish(progress.Event{Type: progress.EventError, Error: err.Error()})
+}
+
+func (p *mindmapProgress) buffer(eventType progress.EventType, delta string) {
+	if p.broker == nil || delta == "" {
+		return
+	}
+	if p.pendingType != eventType {
+		p.flush()
+		p.pendingType = eventType
+	}
+	p.pending.WriteString(delta)
+	if p.pending.Len() >= progressFlushBytes || time.Since(p.lastFlush) >= progressFlushInterval {
+		p.flush()
+	}
+}
+
+// flush publishes the buffered deltas.
+func (p *mindmapProgress) flush() {
+	p.lastFlush = time.Now()
+	if p.pending.Len() == 0 {
+		return
+	}
+	p.publish(progress.Event{Type: p.pendingType, Delta: p.pending.String()})
+	p.pending.Reset()
+}
+
+// publish sends an event. Progress is best effort and never fails the job,
+// and after a failed publish the rest are skipped.
+func (p *mindmapProgress) publish(event progress.Event) {
+	if p.broker == nil || p.failed {
+		return
+	}
+	if err := p.broker.Publish(p.ctx, p.sessionID, event); err != nil {
+		p.failed = true
+		slog.Warn("failed to publish mindmap progress", "session_id", p.sessionID, "error", err)
+	}
+}
+
+// topicScanner picks complete topic objects out of a streamed relationship
+// graph response, so they can be shown before the response is complete.
+type topicScanner struct {
+	buf         []byte
+	depth       int
+	inString    bool
+	escaped     bool
+	stringStart int
+	lastKey     string // last string at the top level of the response object
+	topicsDepth int    // depth inside the topics array, 0 outside of it
+	topicStart  int
+}
+
+// write consumes a delta and returns the topics completed by it. Structural
+// JSON characters are ASCII, so scanning bytes is safe for UTF-8 content.
+func (s *topicScanner) write(delta string) []progress.Topic {
+	var topics []progress.Topic
+	for i := 0; i < len(delta); i++ {
+		c := delta[i]
+		pos := len(s.buf)
+		s.buf = append(s.buf, c)
+
+		if s.inString {
+			switch {
+			case s.escaped:
+				s.escaped = false
+			case c == '\\':
+				s.escaped = true
+			case c == '"':
+				s.inString = false
+				if s.depth == 1 {
+					s.lastKey = string(s.buf[s.stringStart+1 : pos])
+				}
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			s.inString = true
+			s.stringStart = pos
+		case '{', '[':
+			if c == '[' && s.depth == 1 && s.lastKey == "topics" {
+				s.topicsDepth = 2
+			}
+			if c == '{' && s.topicsDepth > 0 && s.depth == s.topicsDepth {
+				s.topicStart = pos
+			}
+			s.depth++
+		case '}', ']':
+			s.depth--
+			if c == '}' && s.topicsDepth > 0 && s.depth == s.topicsDepth {
+				var topic progress.Topic
+				if err := json.Unmarshal(s.buf[s.topicStart:pos+1], &topic); err == nil && topic.Label != "" {
+					topics = append(topics, topic)
+				}
+			}
+			if c == ']' && s.topicsDepth > 0 && s.depth == 1 {
+				s.topicsDepth = 0
+			}
+		}
+	}
+	return topics
+}
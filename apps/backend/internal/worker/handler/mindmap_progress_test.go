@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/internal/infrastructure/progress"
+)
+
+const streamedMindmap = `{
+  "core": {"label": "Go {concurrency}", "description": "a \"quoted\" core"},
+  "topics": [
+    {"id": "topic-1", "label": "Goroutines", "keywords": ["go", "]"], "pages": [{"url_id": "a", "title": "x"}]},
+    {"id": "topic-2", "label": "채널", "description": "escaped \\ and \" chars"}
+  ],
+  "connections": [{"from": "topic-1", "to": "topic-2", "label": "uses"}]
+}`
+
+func TestTopicScanner(t *testing.T) {
+	for _, size := range []int{1, 3, 7, len(streamedMindmap)} {
+		var s topicScanner
+		var topics []progress.Topic
+		for i := 0; i < len(streamedMindmap); i += size {
+			end := min(i+size, len(streamedMindmap))
+			topics = append(topics, s.write(streamedMindmap[i:end])...)
+		}
+
+		require.Len(t, topics, 2, "delta size %d", size)
+		assert.Equal(t, "topic-1", topics[0].ID)
+		assert.Equal(t, "Goroutines", topics[0].Label)
+		assert.Equal(t, []string{"go", "]"}, topics[0].Keywords)
+		assert.Equal(t, "채널", topics[1].Label)
+		assert.Equal(t, `escaped \ and " chars`, topics[1].Description)
+	}
+}
+
+func TestTopicScanner_IgnoresOtherArrays(t *testing.T) {
+	var s topicScanner
+	topics := s.write(`{"connections": [{"from": "a", "label": "b"}], "core": {"topics": [{"label": "nested"}]}}`)
+	assert.Empty(t, topics)
+}
+
+func TestMindmapProgress(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	broker := progress.NewBroker(rdb)
+
+	ctx := context.Background()
+	sessionID := uuid.New()
+	sub, err := broker.Subscribe(ctx, sessionID)
+	require.NoError(t, err)
+	defer func() { _ = sub.Close() }()
+
+	h := &handlers{progressBroker: broker}
+	prog := h.newMindmapProgress(ctx, sessionID)
+
+	prog.stage(progress.StageGenerating)
+	prog.thinking("let me ")
+	prog.thinking("think")
+	for _, c := range streamedMindmap {
+		prog.content(string(c))
+	}
+	prog.fail(errors.New("boom"))
+
+	var events []progress.Event
+	for {
+		select {
+		case event := <-sub.Events():
+			events = append(events, event)
+			if !event.Final() {
+				continue
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("no final event received")
+		}
+		break
+	}
+
+	// Deltas are coalesced instead of published one by one
+	var content strings.Builder
+	var topics []string
+	var contentEvents int
+	for _, event := range events {
+		switch event.Type {
+		case progress.EventContent:
+			contentEvents++
+			content.WriteString(event.Delta)
+		case progress.EventTopic:
+			topics = append(topics, event.Topic.Label)
+		}
+	}
+	assert.Equal(t, progress.Event{Type: progress.EventStage, Stage: progress.StageGenerating}, events[0])
+	assert.Equal(t, progress.Event{Type: progress.EventThinking, Delta: "let me think"}, events[1])
+	assert.Equal(t, streamedMindmap, content.String())
+	assert.Less(t, contentEvents, len([]rune(streamedMindmap))/10)
+	assert.Equal(t, []string{"Goroutines", "채널"}, topics)
+	assert.Equal(t, progress.Event{Type: progress.EventError, Error: "boom"}, events[len(events)-1])
+}
+
+func TestMindmapProgress_NoBroker(t *testing.T) {
+	h := &handlers{}
+	prog := h.newMindmapProgress(context.Background(), uuid.New())
+
+	// Without a broker every call is a no-op
+	prog.stage(progress.StageGenerating)
+	prog.content(streamedMindmap)
+	prog.done(uuid.New())
+	assert.Zero(t, prog.pending.Len())
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"math"
 	"testing"
 	"time"
 
@@ -186,6 +187,45 @@ func TestBuildMindmapFromRelationship_EmptyTopics(t *testing.T) {
 	assert.Empty(t, result.Edges)
 }
 
+func TestValidateRelationshipGraph(t *testing.T) {
+	sessionURLs := map[string]int{"url-1": 1000, "url-2": 2000}
+
+	valid := `{
+		"core": {"label": "Go", "description": "Learning Go"},
+		"topics": [
+			{"id": "topic-1", "label": "Generics", "keywords": ["go"], "pages": [{"url_id": "url-1", "relevance": 0.9}]},
+			{"id": "topic-2", "label": "Tooling", "keywords": ["go"], "pages": [{"url_id": "url-2", "relevance": 0.5}]}
+		],
+		"connections": [{"from": "topic-1", "to": "topic-2"}]
+	}`
+	assert.NoError(t, validateRelationshipGraph(valid, sessionURLs))
+
+	invalid := `{
+		"core": {"label": "Go", "description": "Learning Go"},
+		"topics": [
+			{"id": "topic-1", "label": "Generics", "pages": [{"url_id": "url-9"}]},
+			{"id": "topic-1", "label": "Duplicate", "pages": []}
+		],
+		"connections": [{"from": "topic-1", "to": "topic-3"}, {"from": "topic-1", "to": "topic-1"}]
+	}`
+	err := validateRelationshipGraph(invalid, sessionURLs)
+	require.Error(t, err)
+	for _, problem := range []string{
+		`url_id "url-9" is not a page of this session`,
+		`duplicate topic id "topic-1"`,
+		`topic "topic-1" has no pages`,
+		`unknown topic "topic-3"`,
+		`topic "topic-1" is connected to itself`,
+	} {
+		assert.Contains(t, err.Error(), problem)
+	}
+
+	// Sessions without pages have no topics
+	empty := `{"core": {"label": "Empty", "description": ""}, "topics": [], "connections": []}`
+	assert.NoError(t, validateRelationshipGraph(empty, map[string]int{}))
+	assert.Error(t, validateRelationshipGraph(empty, sessionURLs))
+}
+
 func TestGetTopicColor(t *testing.T) {
 	// First color
 	assert.Equal(t, "#3B82F6", getTopicColor(0))
@@ -233,7 +273,7 @@ func TestHandleMindmapGenerate_WithSession(t *testing.T) {
 }
 
 func TestMindmapNodePositioning(t *testing.T) {
-	// Test that nodes are positioned correctly in a radial layout
+	// Test that nodes are positioned around the core by the default layout
 	response := RelationshipGraphResponse{
 		Core: struct {
 			Label       string `json:"label"`
@@ -261,20 +301,28 @@ func TestMindmapNodePositioning(t *testing.T) {
 	}
 
 	result := buildMindmapFromRelationship(response, make(map[string]int))
+	for _, node := range result.Nodes {
+		assert.Nil(t, node.Position, "positioned when saved")
+	}
+	require.NoError(t, service.LayoutMindmap(&result))
 
 	// Core should be at center
 	assert.NotNil(t, result.Nodes[0].Position)
 	assert.Equal(t, 0.0, result.Nodes[0].Position.X)
 	assert.Equal(t, 0.0, result.Nodes[0].Position.Y)
 
-	// Topics should be at radius 200 from center
+	// Topics without pages share a ring around the core, clear of it
+	var radius float64
 	for i, node := range result.Nodes {
-		if node.Type == "topic" && node.Position != nil {
-			// Calculate distance from origin
-			dist := node.Position.X*node.Position.X + node.Position.Y*node.Position.Y
-			// Should be approximately 200^2 = 40000
-			assert.InDelta(t, 40000, dist, 1, "Topic %d should be at radius 200", i)
+		if node.Type != "topic" {
+			continue
+		}
+		dist := math.Hypot(node.Position.X, node.Position.Y)
+		assert.Greater(t, dist, (result.Nodes[0].Size+node.Size)/2, "Topic %d should not overlap the core", i)
+		if radius == 0 {
+			radius = dist
 		}
+		assert.InDelta(t, radius, dist, 1e-6, "Topic %d should be on the ring", i)
 	}
 }
 
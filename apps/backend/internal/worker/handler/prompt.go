@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/usersettings"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+)
+
+// promptRequest renders a prompt template in the preferred language of the
+// user matching the predicates. The template is recorded in the metadata and
+// its output schema is required of the response.
+func (h *handlers) promptRequest(
+	ctx context.Context,
+	name string,
+	data any,
+	metadata map[string]string,
+	preds ...predicate.User,
+) (ai.ChatRequest, error) {
+	prompts := h.prompts
+	if prompts == nil {
+		prompts = prompt.Embedded()
+	}
+
+	p, err := prompts.Render(ctx, name, h.userLanguage(ctx, preds...), data)
+	if err != nil {
+		return ai.ChatRequest{}, err
+	}
+
+	md := p.Metadata()
+	maps.Copy(md, metadata)
+	req := ai.ChatRequest{
+		SystemPrompt: p.System,
+		UserPrompt:   p.User,
+		Metadata:     md,
+	}
+	if p.Schema != nil {
+		if req.Schema, err = ai.NewOutputSchema(name, p.Schema); err != nil {
+			return ai.ChatRequest{}, err
+		}
+	}
+	return req, nil
+}
+
+// userLanguage returns the preferred language of the user matching the
+// predicates, or the default one.
+func (h *handlers) userLanguage(ctx context.Context, preds ...predicate.User) string {
+	settings, err := h.client.UserSettings.Query().
+		Where(usersettings.HasUserWith(preds...)).
+		First(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Warn("failed to get user settings for prompt", "error", err)
+		}
+		return prompt.DefaultLocale
+	}
+	if settings.Language == "" {
+		return prompt.DefaultLocale
+	}
+	return settings.Language
+}
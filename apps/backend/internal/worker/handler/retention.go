@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/highlight"
+	"github.com/mindhit/api/ent/mindmapedit"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/mindmaprevision"
+	"github.com/mindhit/api/ent/pagevisit"
+	"github.com/mindhit/api/ent/plan"
+	"github.com/mindhit/api/ent/predicate"
+	"github.com/mindhit/api/ent/rawevent"
+	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/ent/subscription"
+	"github.com/mindhit/api/ent/user"
+	"github.com/mindhit/api/internal/infrastructure/queue"
+	"github.com/mindhit/api/internal/service"
+)
+
+// purgeBatchSize bounds how many sessions are hard-deleted per transaction.
+const purgeBatchSize = 200
+
+// HandleSessionRetention enforces each plan's session_retention_days.
+//
+// Finished sessions older than the plan's window are soft-deleted first.
+// Soft-deleted sessions, including ones deleted by their owner, are purged
+// together with their page visits, highlights, raw events and mindmap, with
+// its revisions and edit log, once they have been deleted for PurgeAfterDays.
+// They are also taken out of the knowledge graph. Token usage and AI logs are
+// kept for billing. In dry-run mode nothing is written and the counts are
+// logged.
+func (h *handlers) HandleSessionRetention(ctx context.Context, t *asynq.Task) error {
+	var payload queue.SessionRetentionPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	slog.Info("starting session retention",
+		"purge_after_days", payload.PurgeAfterDays,
+		"dry_run", payload.DryRun,
+	)
+
+	plans, err := h.client.Plan.Query().
+		Where(plan.SessionRetentionDaysNotNil()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get plans: %w", err)
+	}
+
+	now := time.Now()
+	expired := 0
+
+	for _, p := range plans {
+		cutoff := now.AddDate(0, 0, -*p.SessionRetentionDays)
+		where := []predicate.Session{
+			session.StatusEQ(session.StatusActive),
+			session.SessionStatusIn(session.SessionStatusCompleted, session.SessionStatusFailed),
+			session.StartedAtLT(cutoff),
+			session.HasUserWith(onPlan(p.ID)),
+		}
+
+		var count int
+		if payload.DryRun {
+			count, err = h.client.Session.Query().Where(where...).Count(ctx)
+		} else {
+			count, err = h.client.Session.Update().
+				Where(where...).
+				SetStatus(session.StatusInactive).
+				SetDeletedAt(now).
+				Save(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to expire sessions of plan %s: %w", p.ID, err)
+		}
+
+		slog.Info("session retention expired sessions",
+			"plan", p.ID,
+			"retention_days", *p.SessionRetentionDays,
+			"expired", count,
+			"dry_run", payload.DryRun,
+		)
+		expired += count
+	}
+
+	purgeIDs, err := h.client.Session.Query().
+		Where(
+			session.StatusEQ(session.StatusInactive),
+			session.DeletedAtLT(now.AddDate(0, 0, -payload.PurgeAfterDays)),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sessions to purge: %w", err)
+	}
+
+	if !payload.DryRun {
+		for start := 0; start < len(purgeIDs); start += purgeBatchSize {
+			end := min(start+purgeBatchSize, len(purgeIDs))
+			if err := h.purgeSessions(ctx, purgeIDs[start:end]); err != nil {
+				return err
+			}
+		}
+	}
+
+	slog.Info("session retention completed",
+		"expired", expired,
+		"purged", len(purgeIDs),
+		"dry_run", payload.DryRun,
+	)
+	return nil
+}
+
+// onPlan matches users whose effective plan is planID. Users without a
+// current subscription are on the free plan.
+func onPlan(planID string) predicate.User {
+	subscribed := user.HasSubscriptionsWith(
+		subscription.StatusIn(service.CurrentSubscriptionStatuses...),
+		subscription.HasPlanWith(plan.IDEQ(planID)),
+	)
+	if planID != service.FreePlanID {
+		return subscribed
+	}
+	return user.Or(
+		subscribed,
+		user.Not(user.HasSubscriptionsWith(subscription.StatusIn(service.CurrentSubscriptionStatuses...))),
+	)
+}
+
+// purgeSessions permanently deletes sessions and the data recorded in them.
+func (h *handlers) purgeSessions(ctx context.Context, ids []uuid.UUID) error {
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start purge transaction: %w", err)
+	}
+
+	if err := purgeSessionsTx(ctx, tx, ids); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit purge: %w", err)
+	}
+	return nil
+}
+
+func purgeSessionsTx(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error {
+	inSessions := session.IDIn(ids...)
+
+	// Highlights reference page visits, so they go first
+	if _, err := tx.Highlight.Delete().Where(highlight.HasSessionWith(inSessions)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to purge highlights: %w", err)
+	}
+	if _, err := tx.PageVisit.Delete().Where(pagevisit.HasSessionWith(inSessions)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to purge page visits: %w", err)
+	}
+	if _, err := tx.RawEvent.Delete().Where(rawevent.HasSessionWith(inSessions)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to purge raw events: %w", err)
+	}
+	inMindmaps := mindmapgraph.HasSessionWith(inSessions)
+	if _, err := tx.MindmapRevision.Delete().Where(mindmaprevision.HasMindmapWith(inMindmaps)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to purge mindmap revisions: %w", err)
+	}
+	if _, err := tx.MindmapEdit.Delete().Where(mindmapedit.HasMindmapWith(inMindmaps)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to purge mindmap edits: %w", err)
+	}
+	if _, err := tx.MindmapGraph.Delete().Where(inMindmaps).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to purge mindmaps: %w", err)
+	}
+	if err := service.PurgeKnowledgeSessions(ctx, tx, ids); err != nil {
+		return fmt.Errorf("failed to purge knowledge mentions: %w", err)
+	}
+	if _, err := tx.Session.Delete().Where(inSessions).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to purge sessions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/mindmapedit"
+	"github.com/mindhit/api/ent/mindmapgraph"
+	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/internal/infrastructure/queue"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+// createRetentionUser creates a user subscribed to a fresh plan that keeps
+// sessions for retentionDays.
+func createRetentionUser(t *testing.T, client *ent.Client, retentionDays int) *ent.User {
+	t.Helper()
+	ctx := context.Background()
+
+	p, err := client.Plan.Create().
+		SetID("retention-" + uuid.New().String()[:8]).
+		SetName("Retention").
+		SetSessionRetentionDays(retentionDays).
+		Save(ctx)
+	require.NoError(t, err)
+
+	u, err := client.User.Create().
+		SetEmail("test-retention-" + uuid.New().String() + "@example.com").
+		SetPasswordHash("hashed").
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Subscription.Create().
+		SetUser(u).
+		SetPlan(p).
+		SetCurrentPeriodStart(time.Now()).
+		SetCurrentPeriodEnd(time.Now().AddDate(0, 0, 30)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	return u
+}
+
+func retentionTask(t *testing.T, purgeAfterDays int, dryRun bool) *asynq.Task {
+	t.Helper()
+	task, err := queue.NewSessionRetentionTask(purgeAfterDays, dryRun)
+	require.NoError(t, err)
+	return task
+}
+
+func TestHandleSessionRetention_ExpiresOldSessions(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	h := &handlers{client: client}
+	u := createRetentionUser(t, client, 30)
+
+	oldSess, err := client.Session.Create().
+		SetUserID(u.ID).
+		SetSessionStatus(session.SessionStatusCompleted).
+		SetStartedAt(time.Now().AddDate(0, 0, -40)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	recentSess, err := client.Session.Create().
+		SetUserID(u.ID).
+		SetSessionStatus(session.SessionStatusCompleted).
+		SetStartedAt(time.Now().AddDate(0, 0, -5)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Dry run changes nothing
+	require.NoError(t, h.HandleSessionRetention(ctx, retentionTask(t, 7, true)))
+	got, err := client.Session.Get(ctx, oldSess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, session.StatusActive, got.Status)
+
+	require.NoError(t, h.HandleSessionRetention(ctx, retentionTask(t, 7, false)))
+
+	got, err = client.Session.Get(ctx, oldSess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, session.StatusInactive, got.Status)
+	assert.NotNil(t, got.DeletedAt)
+
+	got, err = client.Session.Get(ctx, recentSess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, session.StatusActive, got.Status)
+}
+
+func TestHandleSessionRetention_PurgesSoftDeleted(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	h := &handlers{client: client}
+	u := createRetentionUser(t, client, 30)
+
+	deletedSess, err := client.Session.Create().
+		SetUserID(u.ID).
+		SetSessionStatus(session.SessionStatusCompleted).
+		SetStatus(session.StatusInactive).
+		SetDeletedAt(time.Now().AddDate(0, 0, -10)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Highlight.Create().
+		SetSession(deletedSess).
+		SetText("kept until purge").
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, h.HandleSessionRetention(ctx, retentionTask(t, 7, false)))
+
+	_, err = client.Session.Get(ctx, deletedSess.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestHandleSessionRetention_PurgesCompletedMindmap(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	h := &handlers{client: client}
+	u := createRetentionUser(t, client, 30)
+
+	deletedSess, err := client.Session.Create().
+		SetUserID(u.ID).
+		SetSessionStatus(session.SessionStatusCompleted).
+		SetStatus(session.StatusInactive).
+		SetDeletedAt(time.Now().AddDate(0, 0, -10)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	mindmap, err := client.MindmapGraph.Create().
+		SetSession(deletedSess).
+		SetStatus(mindmapgraph.StatusCompleted).
+		Save(ctx)
+	require.NoError(t, err)
+	revision, err := client.MindmapRevision.Create().
+		SetMindmap(mindmap).
+		SetVersion(1).
+		SetGeneratedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+	edit, err := client.MindmapEdit.Create().
+		SetMindmap(mindmap).
+		SetOp(mindmapedit.OpRename).
+		SetPayload(map[string]interface{}{"node_id": "topic-0", "label": "Renamed"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, h.HandleSessionRetention(ctx, retentionTask(t, 7, false)))
+
+	_, err = client.Session.Get(ctx, deletedSess.ID)
+	assert.True(t, ent.IsNotFound(err))
+	_, err = client.MindmapRevision.Get(ctx, revision.ID)
+	assert.True(t, ent.IsNotFound(err))
+	_, err = client.MindmapEdit.Get(ctx, edit.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestHandleSessionRetention_PurgesKnowledgeMentions(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	h := &handlers{client: client}
+	u := createRetentionUser(t, client, 30)
+
+	deletedSess, err := client.Session.Create().
+		SetUserID(u.ID).
+		SetSessionStatus(session.SessionStatusCompleted).
+		SetStatus(session.StatusInactive).
+		SetDeletedAt(time.Now().AddDate(0, 0, -10)).
+		Save(ctx)
+	require.NoError(t, err)
+	keptSess, err := client.Session.Create().
+		SetUserID(u.ID).
+		SetSessionStatus(session.SessionStatusCompleted).
+		Save(ctx)
+	require.NoError(t, err)
+
+	createTopic := func(label string, sessions ...*ent.Session) *ent.KnowledgeTopic {
+		topic, err := client.KnowledgeTopic.Create().
+			SetUserID(u.ID).
+			SetLabel(label).
+			SetFirstSeenAt(sessions[0].StartedAt).
+			SetLastSeenAt(sessions[len(sessions)-1].StartedAt).
+			Save(ctx)
+		require.NoError(t, err)
+		for _, sess := range sessions {
+			_, err := client.KnowledgeMention.Create().
+				SetTopic(topic).
+				SetSession(sess).
+				SetNodeID("topic-0").
+				SetLabel(label).
+				SetSeenAt(sess.StartedAt).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+		return topic
+	}
+	only := createTopic("Go generics", deletedSess)
+	shared := createTopic("Docker", deletedSess, keptSess)
+
+	require.NoError(t, h.HandleSessionRetention(ctx, retentionTask(t, 7, false)))
+
+	_, err = client.Session.Get(ctx, deletedSess.ID)
+	assert.True(t, ent.IsNotFound(err))
+	_, err = client.KnowledgeTopic.Get(ctx, only.ID)
+	assert.True(t, ent.IsNotFound(err), "a topic only the purged session mentioned goes with it")
+	got, err := client.KnowledgeTopic.Get(ctx, shared.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, keptSess.StartedAt, got.FirstSeenAt, time.Millisecond)
+}
+
+func TestHandleSessionRetention_InvalidPayload(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	h := &handlers{client: client}
+	task := asynq.NewTask(queue.TypeSessionRetention, []byte("invalid json"))
+
+	err := h.HandleSessionRetention(context.Background(), task)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal payload")
+}
@@ -3,24 +3,46 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
 
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/ent/pagevisit"
 	"github.com/mindhit/api/ent/session"
+	enturl "github.com/mindhit/api/ent/url"
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/internal/infrastructure/queue"
 )
 
+const (
+	// tagWaitInterval is how long session processing waits before checking
+	// tag extraction progress again.
+	tagWaitInterval = 10 * time.Second
+
+	// maxTagWaitRounds bounds the total wait for tag extraction. After that
+	// the mindmap is generated with whatever tags are available.
+	maxTagWaitRounds = 30
+)
+
 // HandleSessionProcess processes a completed session.
+//
+// The pipeline is: tag extraction for every page the session owner captured
+// that has no keywords yet, then mindmap generation once those have finished
+// (or the wait times out). The session is marked completed/failed by the
+// mindmap handler at the end of the chain.
 func (h *handlers) HandleSessionProcess(ctx context.Context, t *asynq.Task) error {
 	var payload queue.SessionProcessPayload
 	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
 		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
 
-	slog.Info("processing session", "session_id", payload.SessionID)
+	slog.Info("processing session", "session_id", payload.SessionID, "wait_round", payload.WaitRound)
 
 	sessionID, err := uuid.Parse(payload.SessionID)
 	if err != nil {
@@ -44,18 +66,151 @@ func (h *handlers) HandleSessionProcess(ctx context.Context, t *asynq.Task) erro
 		return nil // Not an error, just skip
 	}
 
-	// TODO: Phase 9에서 AI 처리 로직 추가
-	// 1. URL 요약
-	// 2. 마인드맵 생성
+	// Without a queue or AI there is nothing to run
+	if h.queueClient == nil || h.aiManager == nil {
+		slog.Warn("ai pipeline not configured, completing session without mindmap",
+			"session_id", payload.SessionID,
+		)
+		return h.completeSession(ctx, sessionID)
+	}
+
+	// The session owner's copy of every page visited in the session
+	contents, err := h.client.URLContent.Query().
+		Where(
+			urlcontent.HasUserWith(user.HasSessionsWith(session.IDEQ(sessionID))),
+			urlcontent.HasURLWith(enturl.HasPageVisitsWith(pagevisit.HasSessionWith(session.IDEQ(sessionID)))),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get session url contents: %w", err)
+	}
+
+	// Pages captured without content still go into the mindmap by their URL
+	if len(contents) == 0 {
+		visited, err := h.client.PageVisit.Query().
+			Where(pagevisit.HasSessionWith(session.IDEQ(sessionID))).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check session page visits: %w", err)
+		}
+		if !visited {
+			slog.Info("session has no page visits, skipping mindmap", "session_id", payload.SessionID)
+			return h.completeSession(ctx, sessionID)
+		}
+	}
+
+	pending := contentsAwaitingTags(contents)
+
+	// Fan out tag extraction on the first round only
+	if payload.WaitRound == 0 {
+		for _, c := range pending {
+			if err := h.enqueueTagExtraction(c.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Extraction that failed for good won't tag its page, don't wait for it
+	pending, err = h.tagsInProgress(pending)
+	if err != nil {
+		return err
+	}
+
+	// Fan in: re-check later until every page is tagged or the wait runs out
+	if len(pending) > 0 {
+		if payload.WaitRound < maxTagWaitRounds {
+			task, err := queue.NewSessionProcessWaitTask(payload.SessionID, payload.WaitRound+1)
+			if err != nil {
+				return fmt.Errorf("failed to create session process task: %w", err)
+			}
+			if _, err := h.queueClient.Enqueue(task, asynq.ProcessIn(tagWaitInterval), asynq.MaxRetry(3)); err != nil {
+				return fmt.Errorf("failed to enqueue session process task: %w", err)
+			}
+
+			slog.Info("waiting for tag extraction",
+				"session_id", payload.SessionID,
+				"pending", len(pending),
+				"wait_round", payload.WaitRound+1,
+			)
+			return nil
+		}
+
+		slog.Warn("tag extraction did not finish in time, generating mindmap with partial tags",
+			"session_id", payload.SessionID,
+			"pending", len(pending),
+		)
+	}
+
+	if _, err := h.mindmapService.QueueForSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to queue mindmap generation: %w", err)
+	}
+
+	return nil
+}
+
+// enqueueTagExtraction enqueues tag extraction for a user's copy of a URL. The
+// task ID is derived from it so concurrent sessions of the same user sharing
+// the page do not duplicate work.
+func (h *handlers) enqueueTagExtraction(contentID uuid.UUID) error {
+	task, err := queue.NewURLTagExtractionTask(contentID.String())
+	if err != nil {
+		return fmt.Errorf("failed to create tag extraction task: %w", err)
+	}
+
+	_, err = h.queueClient.Enqueue(task,
+		asynq.TaskID(tagExtractionTaskID(contentID)),
+		asynq.MaxRetry(3),
+	)
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return fmt.Errorf("failed to enqueue tag extraction task: %w", err)
+	}
+
+	return nil
+}
 
-	// Mark as completed for now
-	_, err = h.client.Session.UpdateOneID(sessionID).
+// completeSession marks a session as completed.
+func (h *handlers) completeSession(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := h.client.Session.UpdateOneID(sessionID).
 		SetSessionStatus(session.SessionStatusCompleted).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to update session status: %w", err)
 	}
 
-	slog.Info("session processing completed", "session_id", payload.SessionID)
+	slog.Info("session processing completed", "session_id", sessionID)
 	return nil
 }
+
+// tagExtractionTaskID is the ID of the tag extraction task of a content.
+func tagExtractionTaskID(contentID uuid.UUID) string {
+	return queue.TypeURLTagExtraction + ":" + contentID.String()
+}
+
+// tagsInProgress returns the contents whose tag extraction may still run.
+// Extraction that was archived after its last retry, or that ended without
+// keywords, is not waited for.
+func (h *handlers) tagsInProgress(contents []*ent.URLContent) ([]*ent.URLContent, error) {
+	var running []*ent.URLContent
+	for _, c := range contents {
+		done, err := h.queueClient.TaskDone("default", tagExtractionTaskID(c.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tag extraction state: %w", err)
+		}
+		if !done {
+			running = append(running, c)
+		}
+	}
+	return running, nil
+}
+
+// contentsAwaitingTags returns the captured pages that tag extraction can
+// still fill in: those with content but no keywords yet.
+func contentsAwaitingTags(contents []*ent.URLContent) []*ent.URLContent {
+	var pending []*ent.URLContent
+	for _, c := range contents {
+		if len(c.Keywords) == 0 && c.Content != "" {
+			pending = append(pending, c)
+		}
+	}
+	return pending
+}
@@ -3,6 +3,8 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,7 +13,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mindhit/api/ent"
 	"github.com/mindhit/api/ent/session"
+	"github.com/mindhit/api/internal/infrastructure/ai"
 	"github.com/mindhit/api/internal/infrastructure/queue"
 	"github.com/mindhit/api/internal/testutil"
 )
@@ -139,3 +143,34 @@ func TestHandleSessionProcess_InvalidSessionID(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid session ID")
 }
+
+func TestContentsAwaitingTags(t *testing.T) {
+	contents := []*ent.URLContent{
+		{ID: uuid.New(), Content: "untagged content"},
+		{ID: uuid.New(), Content: "tagged content", Keywords: []string{"go"}},
+		{ID: uuid.New()}, // no content, nothing to extract
+	}
+
+	pending := contentsAwaitingTags(contents)
+
+	require.Len(t, pending, 1)
+	assert.Equal(t, contents[0].ID, pending[0].ID)
+}
+
+func TestIsFinalAttempt(t *testing.T) {
+	ctx := context.Background()
+
+	assert.True(t, isFinalAttempt(ctx, fmt.Errorf("limit: %w", asynq.SkipRetry)))
+	// Outside the asynq server there is no retry metadata
+	assert.False(t, isFinalAttempt(ctx, errors.New("transient")))
+}
+
+func TestFinalAttempt(t *testing.T) {
+	transient := errors.New("transient")
+	rateLimited := fmt.Errorf("generate: %w", &ai.RateLimitError{Provider: ai.ProviderOpenAI, Wait: time.Minute})
+
+	assert.False(t, finalAttempt(transient, 2, 3))
+	assert.True(t, finalAttempt(transient, 3, 3))
+	assert.True(t, finalAttempt(fmt.Errorf("limit: %w", asynq.SkipRetry), 0, 3))
+	assert.False(t, finalAttempt(rateLimited, 3, 3), "delayed retries don't use up the retries")
+}
@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/metrics"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+	"github.com/mindhit/api/internal/infrastructure/queue"
+)
+
+// summarizeContentTokens is the most page content a summarize prompt
+// includes.
+const summarizeContentTokens = 8000
+
+// summaryBackfillSource labels the AI requests of summary backfills, whose
+// daily token budget only counts them. Parts of long pages are summarized
+// under the same task for tag extraction too.
+const summaryBackfillSource = "summary_backfill"
+
+// SummaryResult represents the AI response for full-page summarization.
+type SummaryResult struct {
+	Summary  string   `json:"summary"`
+	Abstract string   `json:"abstract"`
+	Entities []string `json:"entities"`
+}
+
+// HandleURLSummarize generates a full-page summary, abstract and key entities
+// for a user's copy of a URL.
+func (h *handlers) HandleURLSummarize(ctx context.Context, t *asynq.Task) (err error) {
+	start := time.Now()
+	jobType := "url_summarize"
+
+	defer func() {
+		metrics.WorkerJobDuration.WithLabelValues(jobType).Observe(time.Since(start).Seconds())
+	}()
+
+	var payload queue.URLSummarizePayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	contentID, err := uuid.Parse(payload.URLContentID)
+	if err != nil {
+		return fmt.Errorf("parse url content id: %w", err)
+	}
+
+	slog.Info("summarizing url", "url_content_id", payload.URLContentID)
+
+	// Check if AI manager is available
+	if h.aiManager == nil {
+		slog.Warn("ai manager not configured, skipping summarization")
+		return nil
+	}
+
+	// Get the user's copy of the page from database
+	c, err := h.client.URLContent.Get(ctx, contentID)
+	if err != nil {
+		return fmt.Errorf("get url content: %w", err)
+	}
+
+	// Skip if already summarized
+	if c.SummarizedAt != nil {
+		slog.Debug("url content already summarized, skipping", "url_content_id", contentID)
+		return nil
+	}
+
+	// Skip if no content
+	if c.Content == "" {
+		slog.Warn("url content is empty, skipping", "url_content_id", contentID)
+		return nil
+	}
+
+	// Record the failure so backfill runs hold off on the page for a while
+	defer func() {
+		if err == nil || !isFinalAttempt(ctx, err) {
+			return
+		}
+		metrics.WorkerJobsProcessed.WithLabelValues(jobType, "failed").Inc()
+		updateErr := h.client.URLContent.UpdateOneID(contentID).
+			SetSummaryError(err.Error()).
+			SetSummaryFailedAt(time.Now()).
+			Exec(ctx)
+		if updateErr != nil {
+			slog.Error("failed to record url content summary failure", "url_content_id", contentID, "error", updateErr)
+		}
+	}()
+
+	// Long pages are summarized in parts, which are then combined
+	metadata := map[string]string{
+		"url_content_id": contentID.String(),
+		"source":         summaryBackfillSource,
+	}
+	content, err := h.condenseContent(ctx, c, h.contentBudget(ctx, ai.TaskSummarize, summarizeContentTokens), metadata)
+	if err != nil {
+		return fmt.Errorf("condense content: %w", err)
+	}
+
+	// Generate summary using AI
+	req, err := h.promptRequest(ctx, prompt.Summarize,
+		map[string]string{"Title": c.Title, "Content": content},
+		metadata,
+		user.HasURLContentsWith(urlcontent.IDEQ(contentID)),
+	)
+	if err != nil {
+		return fmt.Errorf("render prompt: %w", err)
+	}
+	req.Options = ai.ChatOptions{
+		MaxTokens: 2048,
+		JSONMode:  true,
+	}
+
+	response, err := h.aiManager.Chat(ctx, ai.TaskSummarize, req)
+	if err != nil {
+		return fmt.Errorf("ai summarize: %w", err)
+	}
+
+	var result SummaryResult
+	if err := json.Unmarshal([]byte(response.Content), &result); err != nil {
+		return fmt.Errorf("parse ai response: %w", err)
+	}
+
+	if result.Summary == "" {
+		return fmt.Errorf("parse ai response: empty summary")
+	}
+
+	// Update content with summary, abstract and entities
+	_, err = h.client.URLContent.UpdateOneID(contentID).
+		SetSummary(result.Summary).
+		SetAbstract(result.Abstract).
+		SetEntities(result.Entities).
+		SetSummarizedAt(time.Now()).
+		ClearSummaryError().
+		ClearSummaryFailedAt().
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("update url content: %w", err)
+	}
+
+	metrics.WorkerJobsProcessed.WithLabelValues(jobType, "success").Inc()
+
+	slog.Info("summarized url",
+		"url_content_id", contentID,
+		"entities", len(result.Entities),
+		"provider", response.Provider,
+		"tokens", response.TotalTokens,
+	)
+	return nil
+}
+
+// HandleURLSummaryBackfill enqueues summarization for captured pages that have
+// none yet.
+// The daily token budget only counts the requests of backfilled summaries,
+// and is checked once per run, so a run may overshoot it by at most one
+// batch.
+func (h *handlers) HandleURLSummaryBackfill(ctx context.Context, t *asynq.Task) error {
+	start := time.Now()
+	jobType := "summary_backfill"
+
+	defer func() {
+		metrics.WorkerJobDuration.WithLabelValues(jobType).Observe(time.Since(start).Seconds())
+	}()
+
+	var payload queue.URLSummaryBackfillPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	if h.aiManager == nil || h.queueClient == nil {
+		slog.Warn("ai pipeline not configured, skipping summary backfill")
+		return nil
+	}
+
+	if payload.BatchSize <= 0 {
+		return nil
+	}
+
+	if payload.DailyTokenBudget > 0 {
+		used, err := h.aiLogService.GetSourceTokensSince(ctx, summaryBackfillSource, time.Now().Add(-24*time.Hour))
+		if err != nil {
+			return fmt.Errorf("get summarize token usage: %w", err)
+		}
+		if used >= payload.DailyTokenBudget {
+			slog.Info("summary backfill budget exhausted, skipping",
+				"tokens_used", used,
+				"daily_token_budget", payload.DailyTokenBudget,
+			)
+			return nil
+		}
+	}
+
+	contents, err := h.urlService.GetContentsWithoutSummary(ctx, payload.BatchSize)
+	if err != nil {
+		return fmt.Errorf("get url contents without summary: %w", err)
+	}
+
+	enqueued := 0
+	for _, c := range contents {
+		task, err := queue.NewURLSummarizeTask(c.ID.String())
+		if err != nil {
+			return fmt.Errorf("create summarize task: %w", err)
+		}
+
+		_, err = h.queueClient.Enqueue(task,
+			asynq.TaskID(queue.TypeURLSummarize+":"+c.ID.String()),
+			asynq.Queue("low"),
+			asynq.MaxRetry(2),
+		)
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("enqueue summarize task: %w", err)
+		}
+		enqueued++
+	}
+
+	metrics.WorkerJobsProcessed.WithLabelValues(jobType, "success").Inc()
+
+	slog.Info("summary backfill enqueued", "candidates", len(contents), "enqueued", enqueued)
+	return nil
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
+	"github.com/mindhit/api/internal/infrastructure/queue"
+	"github.com/mindhit/api/internal/testutil"
+)
+
+func TestHandleURLSummarize_NoAIManager(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	h := &handlers{
+		client:    client,
+		aiManager: nil, // No AI manager
+	}
+
+	payload, _ := json.Marshal(queue.URLSummarizePayload{URLContentID: uuid.New().String()})
+	task := asynq.NewTask(queue.TypeURLSummarize, payload)
+
+	// Should return nil (skip) when AI manager is not configured
+	err := h.HandleURLSummarize(ctx, task)
+	assert.NoError(t, err)
+}
+
+func TestHandleURLSummarize_InvalidPayload(t *testing.T) {
+	h := &handlers{}
+
+	task := asynq.NewTask(queue.TypeURLSummarize, []byte("invalid json"))
+
+	err := h.HandleURLSummarize(context.Background(), task)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unmarshal payload")
+}
+
+func TestHandleURLSummarize_InvalidUUID(t *testing.T) {
+	h := &handlers{}
+
+	payload, _ := json.Marshal(queue.URLSummarizePayload{URLContentID: "not-a-uuid"})
+	task := asynq.NewTask(queue.TypeURLSummarize, payload)
+
+	err := h.HandleURLSummarize(context.Background(), task)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "parse url content id")
+}
+
+func TestHandleURLSummarize_LongContentInParts(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	fake := ai.NewFakeProvider(nil)
+	pm := newFakeAIManager(t, client, nil)
+	pm.RegisterProvider(fake)
+	h := &handlers{client: client, aiManager: pm}
+
+	c := createTagExtractionContent(t, client)
+	longContent := strings.Repeat("타입 매개변수는 함수가 여러 타입에서 동작하게 합니다. ", 2000)
+	require.NoError(t, client.URLContent.UpdateOneID(c.ID).SetContent(longContent).Exec(ctx))
+
+	payload, _ := json.Marshal(queue.URLSummarizePayload{URLContentID: c.ID.String()})
+	require.NoError(t, h.HandleURLSummarize(ctx, asynq.NewTask(queue.TypeURLSummarize, payload)))
+
+	calls := fake.Calls()
+	require.Greater(t, len(calls), 2)
+	for _, call := range calls[:len(calls)-1] {
+		assert.Equal(t, prompt.SummarizeChunk, call.Metadata["prompt_name"])
+		assert.True(t, utf8.ValidString(call.UserPrompt))
+	}
+	// The part summaries are combined into the page summary
+	last := calls[len(calls)-1]
+	assert.Equal(t, prompt.Summarize, last.Metadata["prompt_name"])
+	assert.Contains(t, last.UserPrompt, "[Part 1/")
+
+	updated, err := client.URLContent.Get(ctx, c.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, updated.SummarizedAt)
+}
+
+func TestHandleURLSummaryBackfill_NoAIManager(t *testing.T) {
+	h := &handlers{}
+
+	payload, _ := json.Marshal(queue.URLSummaryBackfillPayload{BatchSize: 10})
+	task := asynq.NewTask(queue.TypeURLSummaryBackfill, payload)
+
+	// Should return nil (skip) when AI pipeline is not configured
+	err := h.HandleURLSummaryBackfill(context.Background(), task)
+	assert.NoError(t, err)
+}
+
+func TestPromptSummary(t *testing.T) {
+	assert.Equal(t, "tag summary", promptSummary(&ent.URLContent{Summary: "tag summary"}))
+	assert.Equal(t, "short abstract", promptSummary(&ent.URLContent{
+		Summary:  "first paragraph\n\nsecond paragraph",
+		Abstract: "short abstract",
+	}))
+}
@@ -6,29 +6,22 @@ import (
 	"fmt"
 	"log/slog"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
 
+	"github.com/mindhit/api/ent/urlcontent"
+	"github.com/mindhit/api/ent/user"
 	"github.com/mindhit/api/internal/infrastructure/ai"
 	"github.com/mindhit/api/internal/infrastructure/metrics"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
 	"github.com/mindhit/api/internal/infrastructure/queue"
 )
 
-const tagExtractionPrompt = `Analyze the web page and extract the following:
-
-1. Core keywords 3-5 (Korean nouns)
-2. 1-2 sentence summary (Korean)
-
-Page title: %s
-Page content:
-%s
-
-Respond in JSON format:
-{
-  "keywords": ["키워드1", "키워드2", "키워드3"],
-  "summary": "페이지 요약"
-}`
+// tagExtractionContentTokens is the most page content a tag extraction
+// prompt includes.
+const tagExtractionContentTokens = 2000
 
 // TagResult represents the AI response for tag extraction.
 type TagResult struct {
@@ -36,7 +29,7 @@ type TagResult struct {
 	Summary  string   `json:"summary"`
 }
 
-// HandleURLTagExtraction processes tag extraction for a URL.
+// HandleURLTagExtraction processes tag extraction for a user's copy of a URL.
 func (h *handlers) HandleURLTagExtraction(ctx context.Context, t *asynq.Task) error {
 	start := time.Now()
 	jobType := "tag_extraction"
@@ -50,12 +43,12 @@ func (h *handlers) HandleURLTagExtraction(ctx context.Context, t *asynq.Task) er
 		return fmt.Errorf("unmarshal payload: %w", err)
 	}
 
-	urlID, err := uuid.Parse(payload.URLID)
+	contentID, err := uuid.Parse(payload.URLContentID)
 	if err != nil {
-		return fmt.Errorf("parse url id: %w", err)
+		return fmt.Errorf("parse url content id: %w", err)
 	}
 
-	slog.Info("extracting tags", "url_id", payload.URLID)
+	slog.Info("extracting tags", "url_content_id", payload.URLContentID)
 
 	// Check if AI manager is available
 	if h.aiManager == nil {
@@ -63,34 +56,42 @@ func (h *handlers) HandleURLTagExtraction(ctx context.Context, t *asynq.Task) er
 		return nil
 	}
 
-	// Get URL from database
-	u, err := h.client.URL.Get(ctx, urlID)
+	// Get the user's copy of the page from database
+	c, err := h.client.URLContent.Get(ctx, contentID)
 	if err != nil {
-		return fmt.Errorf("get url: %w", err)
+		return fmt.Errorf("get url content: %w", err)
 	}
 
 	// Skip if already has keywords
-	if len(u.Keywords) > 0 {
-		slog.Debug("url already has keywords, skipping", "url", u.URL)
+	if len(c.Keywords) > 0 {
+		slog.Debug("url content already has keywords, skipping", "url_content_id", contentID)
 		return nil
 	}
 
 	// Skip if no content
-	if u.Content == "" {
-		slog.Warn("url has no content, skipping", "url", u.URL)
+	if c.Content == "" {
+		slog.Warn("url content is empty, skipping", "url_content_id", contentID)
 		return nil
 	}
 
+	// Long pages are condensed instead of cut off
+	content, err := h.condenseContent(ctx, c, h.contentBudget(ctx, ai.TaskTagExtraction, tagExtractionContentTokens), nil)
+	if err != nil {
+		return fmt.Errorf("condense content: %w", err)
+	}
+
 	// Generate tags using AI
-	req := ai.ChatRequest{
-		UserPrompt: fmt.Sprintf(tagExtractionPrompt, u.Title, truncateContent(u.Content, 8000)),
-		Options: ai.ChatOptions{
-			MaxTokens: 500,
-			JSONMode:  true,
-		},
-		Metadata: map[string]string{
-			"url_id": urlID.String(),
-		},
+	req, err := h.promptRequest(ctx, prompt.TagExtraction,
+		map[string]string{"Title": c.Title, "Content": content},
+		map[string]string{"url_content_id": contentID.String()},
+		user.HasURLContentsWith(urlcontent.IDEQ(contentID)),
+	)
+	if err != nil {
+		return fmt.Errorf("render prompt: %w", err)
+	}
+	req.Options = ai.ChatOptions{
+		MaxTokens: 500,
+		JSONMode:  true,
 	}
 
 	response, err := h.aiManager.Chat(ctx, ai.TaskTagExtraction, req)
@@ -103,20 +104,22 @@ func (h *handlers) HandleURLTagExtraction(ctx context.Context, t *asynq.Task) er
 		return fmt.Errorf("parse ai response: %w", err)
 	}
 
-	// Update URL with keywords and summary
-	_, err = h.client.URL.UpdateOneID(urlID).
-		SetKeywords(result.Keywords).
-		SetSummary(result.Summary).
-		Save(ctx)
+	// Update content with keywords; keep a full-page summary if one exists
+	update := h.client.URLContent.UpdateOneID(contentID).
+		SetKeywords(result.Keywords)
+	if c.SummarizedAt == nil {
+		update.SetSummary(result.Summary)
+	}
+	_, err = update.Save(ctx)
 
 	if err != nil {
-		return fmt.Errorf("update url: %w", err)
+		return fmt.Errorf("update url content: %w", err)
 	}
 
 	metrics.WorkerJobsProcessed.WithLabelValues(jobType, "success").Inc()
 
 	slog.Info("extracted tags",
-		"url", u.URL,
+		"url_content_id", contentID,
 		"keywords", result.Keywords,
 		"provider", response.Provider,
 		"tokens", response.TotalTokens,
@@ -124,9 +127,14 @@ func (h *handlers) HandleURLTagExtraction(ctx context.Context, t *asynq.Task) er
 	return nil
 }
 
+// truncateContent cuts content to at most maxLen bytes, without splitting a
+// character.
 func truncateContent(content string, maxLen int) string {
 	if len(content) <= maxLen {
 		return content
 	}
+	for maxLen > 0 && !utf8.RuneStart(content[maxLen]) {
+		maxLen--
+	}
 	return content[:maxLen] + "..."
 }
@@ -8,8 +8,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/mindhit/api/ent"
+	"github.com/mindhit/api/internal/infrastructure/ai"
+	"github.com/mindhit/api/internal/infrastructure/prompt"
 	"github.com/mindhit/api/internal/infrastructure/queue"
+	"github.com/mindhit/api/internal/service"
 	"github.com/mindhit/api/internal/testutil"
 )
 
@@ -24,7 +29,7 @@ func TestHandleURLTagExtraction_NoAIManager(t *testing.T) {
 	}
 
 	// Create task payload
-	payload, _ := json.Marshal(queue.URLTagExtractionPayload{URLID: uuid.New().String()})
+	payload, _ := json.Marshal(queue.URLTagExtractionPayload{URLContentID: uuid.New().String()})
 	task := asynq.NewTask(queue.TypeURLTagExtraction, payload)
 
 	// Should return nil (skip) when AI manager is not configured
@@ -55,18 +60,152 @@ func TestHandleURLTagExtraction_InvalidUUID(t *testing.T) {
 	h := &handlers{client: client}
 
 	// Create task with invalid UUID
-	payload, _ := json.Marshal(queue.URLTagExtractionPayload{URLID: "not-a-uuid"})
+	payload, _ := json.Marshal(queue.URLTagExtractionPayload{URLContentID: "not-a-uuid"})
 	task := asynq.NewTask(queue.TypeURLTagExtraction, payload)
 
 	err := h.HandleURLTagExtraction(ctx, task)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "parse url id")
+	assert.Contains(t, err.Error(), "parse url content id")
+}
+
+// newFakeAIManager returns a provider manager whose configs for every task
+// select the fake provider.
+func newFakeAIManager(t *testing.T, client *ent.Client, script *ai.FakeScript) *ai.ProviderManager {
+	t.Helper()
+	ctx := context.Background()
+
+	configService := service.NewAIConfigService(client)
+	_, err := configService.Upsert(ctx, service.UpsertAIConfigRequest{
+		TaskType:    "default",
+		Provider:    string(ai.ProviderFake),
+		Model:       ai.DefaultFakeModel,
+		Temperature: 0.3,
+		MaxTokens:   1024,
+		JSONMode:    true,
+		Enabled:     true,
+	})
+	require.NoError(t, err)
+
+	pm, err := ai.NewProviderManager(ctx, ai.Config{}, service.NewAIConfigAdapter(configService), nil)
+	require.NoError(t, err)
+	pm.RegisterProvider(ai.NewFakeProvider(script))
+	return pm
 }
 
 func TestHandleURLTagExtraction_URLNotFound(t *testing.T) {
-	// We need a mock AI manager, but since we don't have URL, it will fail first
-	// For now, skip this test as it requires a mock
-	t.Skip("Requires AI manager mock")
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	h := &handlers{
+		client:    client,
+		aiManager: newFakeAIManager(t, client, nil),
+	}
+
+	payload, _ := json.Marshal(queue.URLTagExtractionPayload{URLContentID: uuid.New().String()})
+	task := asynq.NewTask(queue.TypeURLTagExtraction, payload)
+
+	err := h.HandleURLTagExtraction(ctx, task)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "get url content")
+}
+
+func createTagExtractionContent(t *testing.T, client *ent.Client) *ent.URLContent {
+	t.Helper()
+	ctx := context.Background()
+
+	u, err := client.User.Create().
+		SetEmail("test-tags-" + uuid.New().String() + "@example.com").
+		SetPasswordHash("hashed").
+		Save(ctx)
+	require.NoError(t, err)
+
+	pageURL := "https://example.com/" + uuid.New().String()
+	page, err := client.URL.Create().
+		SetURL(pageURL).
+		SetURLHash(uuid.New().String()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	c, err := client.URLContent.Create().
+		SetUser(u).
+		SetURL(page).
+		SetTitle("Go Generics Tutorial").
+		SetContent("Type parameters let functions work on many types.").
+		Save(ctx)
+	require.NoError(t, err)
+	return c
+}
+
+func TestHandleURLTagExtraction_WithFakeProvider(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	h := &handlers{
+		client:    client,
+		aiManager: newFakeAIManager(t, client, nil),
+	}
+	c := createTagExtractionContent(t, client)
+
+	payload, _ := json.Marshal(queue.URLTagExtractionPayload{URLContentID: c.ID.String()})
+	err := h.HandleURLTagExtraction(ctx, asynq.NewTask(queue.TypeURLTagExtraction, payload))
+	require.NoError(t, err)
+
+	updated, err := client.URLContent.Get(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "generics", "tutorial"}, updated.Keywords)
+	assert.NotEmpty(t, updated.Summary)
+}
+
+func TestHandleURLTagExtraction_UsesPreferredLanguage(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	fake := ai.NewFakeProvider(nil)
+	pm := newFakeAIManager(t, client, nil)
+	pm.RegisterProvider(fake)
+	h := &handlers{client: client, aiManager: pm}
+
+	c := createTagExtractionContent(t, client)
+	userID, err := c.QueryUser().OnlyID(ctx)
+	require.NoError(t, err)
+	_, err = client.UserSettings.Create().SetUserID(userID).SetLanguage("en").Save(ctx)
+	require.NoError(t, err)
+
+	payload, _ := json.Marshal(queue.URLTagExtractionPayload{URLContentID: c.ID.String()})
+	require.NoError(t, h.HandleURLTagExtraction(ctx, asynq.NewTask(queue.TypeURLTagExtraction, payload)))
+
+	calls := fake.Calls()
+	require.Len(t, calls, 1)
+	assert.Contains(t, calls[0].UserPrompt, "(English)")
+	assert.Equal(t, prompt.TagExtraction, calls[0].Metadata["prompt_name"])
+	assert.Equal(t, "en", calls[0].Metadata["prompt_locale"])
+	assert.Equal(t, "1", calls[0].Metadata["prompt_version"])
+	assert.Equal(t, c.ID.String(), calls[0].Metadata["url_content_id"])
+}
+
+func TestHandleURLTagExtraction_FakeProviderInvalidJSON(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, client)
+
+	ctx := context.Background()
+	h := &handlers{
+		client: client,
+		aiManager: newFakeAIManager(t, client, &ai.FakeScript{Rules: []ai.FakeRule{
+			{Task: ai.TaskTagExtraction, InvalidJSON: true},
+		}}),
+	}
+	c := createTagExtractionContent(t, client)
+
+	payload, _ := json.Marshal(queue.URLTagExtractionPayload{URLContentID: c.ID.String()})
+	err := h.HandleURLTagExtraction(ctx, asynq.NewTask(queue.TypeURLTagExtraction, payload))
+	assert.ErrorIs(t, err, ai.ErrInvalidJSON)
+
+	updated, err := client.URLContent.Get(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Keywords)
 }
 
 func TestTruncateContent(t *testing.T) {
@@ -94,6 +233,12 @@ func TestTruncateContent(t *testing.T) {
 			maxLen:   5,
 			expected: "12345...",
 		},
+		{
+			name:     "multi-byte character at the limit",
+			content:  "한국어",
+			maxLen:   4,
+			expected: "한...",
+		},
 		{
 			name:     "empty content",
 			content:  "",
@@ -364,6 +364,16 @@ func seedAIConfigs(ctx context.Context, client *ent.Client) error {
 			ThinkingBudget:    0,
 			JSONMode:          true,
 		},
+		{
+			TaskType:          "summarize",
+			Provider:          "gemini",
+			Model:             "gemini-2.0-flash-exp",
+			FallbackProviders: []string{"openai"},
+			Temperature:       0.3,
+			MaxTokens:         2048,
+			ThinkingBudget:    0,
+			JSONMode:          true,
+		},
 		{
 			TaskType:          "mindmap",
 			Provider:          "claude",
@@ -1,4 +1,4 @@
-import { apiClient } from "./client";
+import { apiClient, API_BASE_URL } from "./client";
 import type {
   MindmapMindmap,
   MindmapMindmapResponse,
@@ -25,4 +25,35 @@ export const mindmapApi = {
     );
     return response.data.mindmap;
   },
+
+  // 스트림 전용 단기 티켓 발급 (액세스 토큰이 URL에 남지 않도록)
+  streamTicket: async (sessionId: string): Promise<string> => {
+    const response = await apiClient.post<{ ticket: string; expires_in: number }>(
+      `/sessions/${sessionId}/mindmap/stream/ticket`
+    );
+    return response.data.ticket;
+  },
 };
+
+export type MindmapStreamStage = "loading" | "generating" | "saving" | "retrying";
+
+export interface MindmapStreamTopic {
+  id: string;
+  label: string;
+  keywords?: string[];
+  description?: string;
+}
+
+// Events of GET /v1/sessions/{id}/mindmap/stream
+export type MindmapStreamEvent =
+  | { type: "status"; status: MindmapMindmap["status"]; mindmap_id: string }
+  | { type: "stage"; stage: MindmapStreamStage }
+  | { type: "thinking" | "content"; delta: string }
+  | { type: "topic"; topic: MindmapStreamTopic }
+  | { type: "done"; mindmap_id: string }
+  | { type: "error"; error?: string };
+
+// EventSource는 헤더를 설정할 수 없어 스트림 티켓을 쿼리로 전달
+export function mindmapStreamUrl(sessionId: string, ticket: string): string {
+  return `${API_BASE_URL}/v1/sessions/${sessionId}/mindmap/stream?ticket=${encodeURIComponent(ticket)}`;
+}
@@ -1,7 +1,15 @@
 "use client";
 
+import { useEffect, useState } from "react";
 import { useQuery, useMutation, useQueryClient } from "@tanstack/react-query";
-import { mindmapApi } from "@/lib/api/mindmap";
+import {
+  mindmapApi,
+  mindmapStreamUrl,
+  type MindmapStreamEvent,
+  type MindmapStreamStage,
+  type MindmapStreamTopic,
+} from "@/lib/api/mindmap";
+import { useAuthStore } from "@/stores/auth-store";
 import type {
   MindmapMindmap,
   MindmapGenerateMindmapRequest,
@@ -43,3 +51,94 @@ export function useGenerateMindmap() {
     },
   });
 }
+
+export interface MindmapStreamState {
+  stage: MindmapStreamStage | null;
+  thinking: string;
+  content: string;
+  topics: MindmapStreamTopic[];
+  error: string | null;
+  done: boolean;
+}
+
+const initialStreamState: MindmapStreamState = {
+  stage: null,
+  thinking: "",
+  content: "",
+  topics: [],
+  error: null,
+  done: false,
+};
+
+const streamEventTypes = ["status", "stage", "thinking", "content", "topic", "done", "error"] as const;
+
+export function reduceMindmapStream(
+  state: MindmapStreamState,
+  event: MindmapStreamEvent
+): MindmapStreamState {
+  switch (event.type) {
+    case "stage":
+      // 재시도 시 이전 시도의 출력은 버림
+      return event.stage === "retrying"
+        ? { ...initialStreamState, stage: event.stage }
+        : { ...state, stage: event.stage };
+    case "thinking":
+      return { ...state, thinking: state.thinking + event.delta };
+    case "content":
+      return { ...state, content: state.content + event.delta };
+    case "topic":
+      return { ...state, topics: [...state.topics, event.topic] };
+    case "done":
+      return { ...state, done: true };
+    case "error":
+      return { ...state, error: event.error ?? "mindmap generation failed", done: true };
+    default:
+      return state;
+  }
+}
+
+// 마인드맵 생성 진행 상황을 SSE로 구독하고, 완료되면 마인드맵을 다시 불러옴
+export function useMindmapStream(sessionId: string, enabled: boolean) {
+  const queryClient = useQueryClient();
+  const accessToken = useAuthStore((state) => state.accessToken);
+  const [state, setState] = useState<MindmapStreamState>(initialStreamState);
+
+  useEffect(() => {
+    if (!enabled || !sessionId || !accessToken) return;
+
+    setState(initialStreamState);
+    let source: EventSource | undefined;
+    let cancelled = false;
+
+    mindmapApi
+      .streamTicket(sessionId)
+      .then((ticket) => {
+        if (cancelled) return;
+        const stream = new EventSource(mindmapStreamUrl(sessionId, ticket));
+        source = stream;
+
+        const onEvent = (message: MessageEvent<string>) => {
+          const event = JSON.parse(message.data) as MindmapStreamEvent;
+          setState((prev) => reduceMindmapStream(prev, event));
+          if (event.type === "done" || event.type === "error") {
+            stream.close();
+            queryClient.invalidateQueries({ queryKey: mindmapKeys.detail(sessionId) });
+          }
+        };
+
+        for (const type of streamEventTypes) {
+          stream.addEventListener(type, onEvent as EventListener);
+        }
+      })
+      .catch(() => {
+        // 스트림 없이도 마인드맵 조회는 동작하므로 무시
+      });
+
+    return () => {
+      cancelled = true;
+      source?.close();
+    };
+  }, [sessionId, enabled, accessToken, queryClient]);
+
+  return state;
+}